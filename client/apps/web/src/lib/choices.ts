@@ -1590,10 +1590,16 @@ export const ediConnectionMethodChoices = [
 export const ediTransactionSetChoices = [
   { label: "204 Load Tender", value: "204" },
   { label: "210 Freight Invoice", value: "210" },
+  { label: "211 Bill of Lading", value: "211" },
+  { label: "212 Delivery Manifest", value: "212" },
   { label: "214 Shipment Status", value: "214" },
+  { label: "820 Remittance Advice", value: "820" },
   { label: "990 Tender Response", value: "990" },
   { label: "997 Functional Ack", value: "997" },
   { label: "999 Implementation Ack", value: "999" },
+  { label: "IFTMIN Instruction (EDIFACT)", value: "IFTMIN" },
+  { label: "IFTSTA Status Report (EDIFACT)", value: "IFTSTA" },
+  { label: "INVOIC Invoice (EDIFACT)", value: "INVOIC" },
 ];
 
 export const ediDocumentDirectionChoices = [
@@ -21,7 +21,10 @@ export type EDIDocumentContextFilters = {
 export const functionalGroupByTransactionSet: Record<string, string> = {
   "204": "SM",
   "210": "IM",
+  "211": "BL",
+  "212": "GR",
   "214": "QM",
+  "820": "RA",
   "990": "GF",
   "997": "FA",
   "999": "FA",
@@ -219,6 +219,7 @@ export const ediPartnerFormSchema = z.object({
     },
     { error: "Settings must be a valid JSON object" },
   ),
+  inboundTransformScript: z.string(),
   version: z.number().optional(),
 });
 
@@ -244,6 +245,7 @@ export function getPartnerFormDefaults(partner?: EDIPartner | null): EDIPartnerF
     defaultTransportId: partner?.defaultTransportId ?? "",
     defaultMappingProfileId: partner?.defaultMappingProfileId ?? "",
     settingsJson: JSON.stringify(partner?.settings ?? {}, null, 2),
+    inboundTransformScript: partner?.inboundTransformScript ?? "",
     version: partner?.version,
   };
 }
@@ -266,6 +268,7 @@ export function toPartnerRequest(values: EDIPartnerFormValues): UpsertEDIPartner
     enabledForInbound: values.enabledForInbound,
     enabledForOutbound: values.enabledForOutbound,
     settings: JSON.parse(values.settingsJson) as Record<string, unknown>,
+    inboundTransformScript: emptyToUndefined(values.inboundTransformScript),
     version: values.version,
   };
 
@@ -233,6 +233,17 @@ export function PartnerDetailsForm({
               minHeight="220px"
             />
           </FormControl>
+          <FormControl cols="full">
+            <TextareaField
+              control={control}
+              name="inboundTransformScript"
+              label="Inbound Transform"
+              placeholder={"def transform(document):\n    return document"}
+              description="Starlark transform(document) run on every inbound document before routing. Edit the document in place or return a replacement."
+              disabled={disabled}
+              className="min-h-[180px] font-mono text-xs"
+            />
+          </FormControl>
         </FormGroup>
       </FormSection>
     </Form>
@@ -76,6 +76,7 @@ type Documents = {
     "fragment OrderTableRowFields on Order {\n  id\n  ownerId\n  businessUnitId\n  organizationId\n  customerId\n  status\n  orderNumber\n  poNumber\n  bol\n  currencyCode\n  quotedAmount\n  baseAmount\n  totalAmount\n  version\n  createdAt\n  updatedAt\n  customer {\n    id\n    name\n    code\n  }\n}\n\nquery OrderTable($input: DataTableConnectionInput!) {\n  orders(input: $input) {\n    edges {\n      node {\n        ...OrderTableRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}": typeof types.OrderTableRowFieldsFragmentDoc,
     "fragment OrganizationSettingsStateFields on UsState {\n  id\n  name\n  abbreviation\n}\n\nfragment OrganizationSettingsFields on Organization {\n  id\n  version\n  createdAt\n  updatedAt\n  bucketName\n  businessUnitId\n  loginSlug\n  name\n  scacCode\n  dotNumber\n  logoUrl\n  addressLine1\n  addressLine2\n  city\n  stateId\n  postalCode\n  timezone\n  taxId\n  brokerageEnabled\n  assetOperationsEnabled\n  state {\n    ...OrganizationSettingsStateFields\n  }\n}\n\nquery OrganizationSettings($id: ID!, $includeState: Boolean = true, $includeBu: Boolean = false) {\n  organization(id: $id, includeState: $includeState, includeBu: $includeBu) {\n    ...OrganizationSettingsFields\n  }\n}\n\nmutation UpdateOrganizationSettings($id: ID!, $input: OrganizationInput!) {\n  updateOrganization(id: $id, input: $input) {\n    ...OrganizationSettingsFields\n  }\n}": typeof types.OrganizationSettingsStateFieldsFragmentDoc,
     "fragment RateAgreementRowFields on RateAgreement {\n  id\n  businessUnitId\n  organizationId\n  partyType\n  customerId\n  carrierId\n  code\n  name\n  description\n  agreementType\n  status\n  contractRef\n  priority\n  effectiveFrom\n  effectiveTo\n  autoRenew\n  renewalNoticeDays\n  currency\n  defaultMinCharge\n  defaultMaxCharge\n  marginFloorPercent\n  maxPayPercentOfSell\n  submittedById\n  submittedAt\n  approvedById\n  approvedAt\n  reviewComment\n  currentVersionNumber\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateZoneRowFields on RateZone {\n  id\n  businessUnitId\n  organizationId\n  code\n  name\n  description\n  status\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateMatrixRowFields on RateMatrix {\n  id\n  businessUnitId\n  organizationId\n  code\n  name\n  description\n  status\n  formulaTemplateId\n  formulaTemplateName\n  currency\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateQuoteRowFields on RateQuote {\n  id\n  businessUnitId\n  organizationId\n  shipmentId\n  partyType\n  partyId\n  purpose\n  outcome\n  rateAgreementId\n  rateAgreementRuleId\n  formulaTemplateId\n  specificityScore\n  currency\n  billingCurrency\n  linehaulAmount\n  totalAmount\n  billingAmount\n  foregoneAmount\n  overrideReason\n  asOf\n  ratedAt\n  ratedById\n  engineVersion\n  createdAt\n}\n\nquery RateAgreementTable($input: DataTableConnectionInput!) {\n  rateAgreements(input: $input) {\n    edges {\n      node {\n        ...RateAgreementRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateZoneTable($input: DataTableConnectionInput!) {\n  rateZones(input: $input) {\n    edges {\n      node {\n        ...RateZoneRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateMatrixTable($input: DataTableConnectionInput!) {\n  rateMatrices(input: $input) {\n    edges {\n      node {\n        ...RateMatrixRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateQuoteTable($input: DataTableConnectionInput!) {\n  rateQuotes(input: $input) {\n    edges {\n      node {\n        ...RateQuoteRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}": typeof types.RateAgreementRowFieldsFragmentDoc,
+    "subscription ShipmentChanged($shipmentId: ID) {\n  shipmentChanged(shipmentId: $shipmentId) {\n    eventId\n    type\n    action\n    shipmentId\n    fields\n    actorUserId\n    occurredAt\n    shipment {\n      id\n      proNumber\n      status\n      version\n      updatedAt\n    }\n  }\n}\n\nsubscription ShipmentTimeline($shipmentId: ID!) {\n  shipmentTimeline(shipmentId: $shipmentId) {\n    ...ShipmentEventFields\n  }\n}\n\nsubscription TenderResponses($shipmentId: ID) {\n  tenderResponses(shipmentId: $shipmentId) {\n    eventId\n    action\n    shipmentId\n    occurredAt\n    tenders {\n      id\n      shipmentId\n      shipmentMoveId\n      mode\n      status\n      currentRank\n      acceptedOfferId\n      acceptedAt\n      exhaustedAt\n      canceledAt\n      version\n      updatedAt\n    }\n  }\n}\n\nsubscription DispatchBoardChanged {\n  dispatchBoardChanged {\n    resources\n    shipmentIds\n    recordIds\n    occurredAt\n  }\n}\n\nsubscription NotificationReceived {\n  notificationReceived {\n    ...NotificationFields\n  }\n}": typeof types.ShipmentChangedDocument,
     "fragment RecurringShipmentTableRowFields on RecurringShipment {\n  id\n  businessUnitId\n  organizationId\n  sourceShipmentId\n  customerId\n  originLocationId\n  destinationLocationId\n  name\n  description\n  status\n  cronExpression\n  timezone\n  startDate\n  endDate\n  maxOccurrences\n  leadTimeDays\n  skipWeekends\n  exceptionPolicy\n  blackoutDates\n  autoGenerate\n  nextOccurrenceAt\n  lastOccurrenceAt\n  lastRunAt\n  generationCount\n  consecutiveFailures\n  version\n  createdAt\n  updatedAt\n  customer {\n    id\n    name\n    code\n  }\n  originLocation {\n    id\n    name\n    code\n  }\n  destinationLocation {\n    id\n    name\n    code\n  }\n}\n\nquery RecurringShipmentTable($input: DataTableConnectionInput!) {\n  recurringShipments(input: $input) {\n    edges {\n      node {\n        ...RecurringShipmentTableRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}": typeof types.RecurringShipmentTableRowFieldsFragmentDoc,
     "query CannedReports {\n  cannedReports {\n    key\n    version\n    name\n    description\n    category\n    tags\n    defaultFormat\n    definition\n  }\n}": typeof types.CannedReportsDocument,
     "query ReportCatalog {\n  reportCatalog {\n    version\n    entities {\n      key\n      resource\n      label\n      pluralLabel\n      description\n      category\n      ownScopeSupported\n      fields {\n        key\n        label\n        description\n        type\n        format\n        nullable\n        enumValues {\n          value\n          label\n        }\n        aggregations\n        filterable\n        groupable\n        accessible\n        sensitivity\n      }\n      edges {\n        name\n        label\n        target\n        cardinality\n        traversable\n      }\n    }\n  }\n}": typeof types.ReportCatalogDocument,
@@ -166,6 +167,7 @@ const documents: Documents = {
     "fragment OrderTableRowFields on Order {\n  id\n  ownerId\n  businessUnitId\n  organizationId\n  customerId\n  status\n  orderNumber\n  poNumber\n  bol\n  currencyCode\n  quotedAmount\n  baseAmount\n  totalAmount\n  version\n  createdAt\n  updatedAt\n  customer {\n    id\n    name\n    code\n  }\n}\n\nquery OrderTable($input: DataTableConnectionInput!) {\n  orders(input: $input) {\n    edges {\n      node {\n        ...OrderTableRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}": types.OrderTableRowFieldsFragmentDoc,
     "fragment OrganizationSettingsStateFields on UsState {\n  id\n  name\n  abbreviation\n}\n\nfragment OrganizationSettingsFields on Organization {\n  id\n  version\n  createdAt\n  updatedAt\n  bucketName\n  businessUnitId\n  loginSlug\n  name\n  scacCode\n  dotNumber\n  logoUrl\n  addressLine1\n  addressLine2\n  city\n  stateId\n  postalCode\n  timezone\n  taxId\n  brokerageEnabled\n  assetOperationsEnabled\n  state {\n    ...OrganizationSettingsStateFields\n  }\n}\n\nquery OrganizationSettings($id: ID!, $includeState: Boolean = true, $includeBu: Boolean = false) {\n  organization(id: $id, includeState: $includeState, includeBu: $includeBu) {\n    ...OrganizationSettingsFields\n  }\n}\n\nmutation UpdateOrganizationSettings($id: ID!, $input: OrganizationInput!) {\n  updateOrganization(id: $id, input: $input) {\n    ...OrganizationSettingsFields\n  }\n}": types.OrganizationSettingsStateFieldsFragmentDoc,
     "fragment RateAgreementRowFields on RateAgreement {\n  id\n  businessUnitId\n  organizationId\n  partyType\n  customerId\n  carrierId\n  code\n  name\n  description\n  agreementType\n  status\n  contractRef\n  priority\n  effectiveFrom\n  effectiveTo\n  autoRenew\n  renewalNoticeDays\n  currency\n  defaultMinCharge\n  defaultMaxCharge\n  marginFloorPercent\n  maxPayPercentOfSell\n  submittedById\n  submittedAt\n  approvedById\n  approvedAt\n  reviewComment\n  currentVersionNumber\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateZoneRowFields on RateZone {\n  id\n  businessUnitId\n  organizationId\n  code\n  name\n  description\n  status\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateMatrixRowFields on RateMatrix {\n  id\n  businessUnitId\n  organizationId\n  code\n  name\n  description\n  status\n  formulaTemplateId\n  formulaTemplateName\n  currency\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateQuoteRowFields on RateQuote {\n  id\n  businessUnitId\n  organizationId\n  shipmentId\n  partyType\n  partyId\n  purpose\n  outcome\n  rateAgreementId\n  rateAgreementRuleId\n  formulaTemplateId\n  specificityScore\n  currency\n  billingCurrency\n  linehaulAmount\n  totalAmount\n  billingAmount\n  foregoneAmount\n  overrideReason\n  asOf\n  ratedAt\n  ratedById\n  engineVersion\n  createdAt\n}\n\nquery RateAgreementTable($input: DataTableConnectionInput!) {\n  rateAgreements(input: $input) {\n    edges {\n      node {\n        ...RateAgreementRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateZoneTable($input: DataTableConnectionInput!) {\n  rateZones(input: $input) {\n    edges {\n      node {\n        ...RateZoneRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateMatrixTable($input: DataTableConnectionInput!) {\n  rateMatrices(input: $input) {\n    edges {\n      node {\n        ...RateMatrixRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateQuoteTable($input: DataTableConnectionInput!) {\n  rateQuotes(input: $input) {\n    edges {\n      node {\n        ...RateQuoteRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}": types.RateAgreementRowFieldsFragmentDoc,
+    "subscription ShipmentChanged($shipmentId: ID) {\n  shipmentChanged(shipmentId: $shipmentId) {\n    eventId\n    type\n    action\n    shipmentId\n    fields\n    actorUserId\n    occurredAt\n    shipment {\n      id\n      proNumber\n      status\n      version\n      updatedAt\n    }\n  }\n}\n\nsubscription ShipmentTimeline($shipmentId: ID!) {\n  shipmentTimeline(shipmentId: $shipmentId) {\n    ...ShipmentEventFields\n  }\n}\n\nsubscription TenderResponses($shipmentId: ID) {\n  tenderResponses(shipmentId: $shipmentId) {\n    eventId\n    action\n    shipmentId\n    occurredAt\n    tenders {\n      id\n      shipmentId\n      shipmentMoveId\n      mode\n      status\n      currentRank\n      acceptedOfferId\n      acceptedAt\n      exhaustedAt\n      canceledAt\n      version\n      updatedAt\n    }\n  }\n}\n\nsubscription DispatchBoardChanged {\n  dispatchBoardChanged {\n    resources\n    shipmentIds\n    recordIds\n    occurredAt\n  }\n}\n\nsubscription NotificationReceived {\n  notificationReceived {\n    ...NotificationFields\n  }\n}": types.ShipmentChangedDocument,
     "fragment RecurringShipmentTableRowFields on RecurringShipment {\n  id\n  businessUnitId\n  organizationId\n  sourceShipmentId\n  customerId\n  originLocationId\n  destinationLocationId\n  name\n  description\n  status\n  cronExpression\n  timezone\n  startDate\n  endDate\n  maxOccurrences\n  leadTimeDays\n  skipWeekends\n  exceptionPolicy\n  blackoutDates\n  autoGenerate\n  nextOccurrenceAt\n  lastOccurrenceAt\n  lastRunAt\n  generationCount\n  consecutiveFailures\n  version\n  createdAt\n  updatedAt\n  customer {\n    id\n    name\n    code\n  }\n  originLocation {\n    id\n    name\n    code\n  }\n  destinationLocation {\n    id\n    name\n    code\n  }\n}\n\nquery RecurringShipmentTable($input: DataTableConnectionInput!) {\n  recurringShipments(input: $input) {\n    edges {\n      node {\n        ...RecurringShipmentTableRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}": types.RecurringShipmentTableRowFieldsFragmentDoc,
     "query CannedReports {\n  cannedReports {\n    key\n    version\n    name\n    description\n    category\n    tags\n    defaultFormat\n    definition\n  }\n}": types.CannedReportsDocument,
     "query ReportCatalog {\n  reportCatalog {\n    version\n    entities {\n      key\n      resource\n      label\n      pluralLabel\n      description\n      category\n      ownScopeSupported\n      fields {\n        key\n        label\n        description\n        type\n        format\n        nullable\n        enumValues {\n          value\n          label\n        }\n        aggregations\n        filterable\n        groupable\n        accessible\n        sensitivity\n      }\n      edges {\n        name\n        label\n        target\n        cardinality\n        traversable\n      }\n    }\n  }\n}": types.ReportCatalogDocument,
@@ -439,6 +441,10 @@ export function graphql(source: "fragment OrganizationSettingsStateFields on UsS
  * The graphql function is used to parse GraphQL queries into a document that can be used by GraphQL clients.
  */
 export function graphql(source: "fragment RateAgreementRowFields on RateAgreement {\n  id\n  businessUnitId\n  organizationId\n  partyType\n  customerId\n  carrierId\n  code\n  name\n  description\n  agreementType\n  status\n  contractRef\n  priority\n  effectiveFrom\n  effectiveTo\n  autoRenew\n  renewalNoticeDays\n  currency\n  defaultMinCharge\n  defaultMaxCharge\n  marginFloorPercent\n  maxPayPercentOfSell\n  submittedById\n  submittedAt\n  approvedById\n  approvedAt\n  reviewComment\n  currentVersionNumber\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateZoneRowFields on RateZone {\n  id\n  businessUnitId\n  organizationId\n  code\n  name\n  description\n  status\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateMatrixRowFields on RateMatrix {\n  id\n  businessUnitId\n  organizationId\n  code\n  name\n  description\n  status\n  formulaTemplateId\n  formulaTemplateName\n  currency\n  version\n  createdAt\n  updatedAt\n}\n\nfragment RateQuoteRowFields on RateQuote {\n  id\n  businessUnitId\n  organizationId\n  shipmentId\n  partyType\n  partyId\n  purpose\n  outcome\n  rateAgreementId\n  rateAgreementRuleId\n  formulaTemplateId\n  specificityScore\n  currency\n  billingCurrency\n  linehaulAmount\n  totalAmount\n  billingAmount\n  foregoneAmount\n  overrideReason\n  asOf\n  ratedAt\n  ratedById\n  engineVersion\n  createdAt\n}\n\nquery RateAgreementTable($input: DataTableConnectionInput!) {\n  rateAgreements(input: $input) {\n    edges {\n      node {\n        ...RateAgreementRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateZoneTable($input: DataTableConnectionInput!) {\n  rateZones(input: $input) {\n    edges {\n      node {\n        ...RateZoneRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateMatrixTable($input: DataTableConnectionInput!) {\n  rateMatrices(input: $input) {\n    edges {\n      node {\n        ...RateMatrixRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}\n\nquery RateQuoteTable($input: DataTableConnectionInput!) {\n  rateQuotes(input: $input) {\n    edges {\n      node {\n        ...RateQuoteRowFields\n      }\n    }\n    totalCount\n    pageInfo {\n      ...DataTablePageInfoFields\n    }\n  }\n}"): typeof import('./graphql').RateAgreementRowFieldsFragmentDoc;
+/**
+ * The graphql function is used to parse GraphQL queries into a document that can be used by GraphQL clients.
+ */
+export function graphql(source: "subscription ShipmentChanged($shipmentId: ID) {\n  shipmentChanged(shipmentId: $shipmentId) {\n    eventId\n    type\n    action\n    shipmentId\n    fields\n    actorUserId\n    occurredAt\n    shipment {\n      id\n      proNumber\n      status\n      version\n      updatedAt\n    }\n  }\n}\n\nsubscription ShipmentTimeline($shipmentId: ID!) {\n  shipmentTimeline(shipmentId: $shipmentId) {\n    ...ShipmentEventFields\n  }\n}\n\nsubscription TenderResponses($shipmentId: ID) {\n  tenderResponses(shipmentId: $shipmentId) {\n    eventId\n    action\n    shipmentId\n    occurredAt\n    tenders {\n      id\n      shipmentId\n      shipmentMoveId\n      mode\n      status\n      currentRank\n      acceptedOfferId\n      acceptedAt\n      exhaustedAt\n      canceledAt\n      version\n      updatedAt\n    }\n  }\n}\n\nsubscription DispatchBoardChanged {\n  dispatchBoardChanged {\n    resources\n    shipmentIds\n    recordIds\n    occurredAt\n  }\n}\n\nsubscription NotificationReceived {\n  notificationReceived {\n    ...NotificationFields\n  }\n}"): typeof import('./graphql').ShipmentChangedDocument;
 /**
  * The graphql function is used to parse GraphQL queries into a document that can be used by GraphQL clients.
  */
@@ -5203,6 +5203,37 @@ export type RateQuoteTableQueryVariables = Exact<{
 
 export type RateQuoteTableQuery = { rateQuotes: { totalCount: number | null, edges: Array<{ node: { ' $fragmentRefs'?: { 'RateQuoteRowFieldsFragment': RateQuoteRowFieldsFragment } } }>, pageInfo: { ' $fragmentRefs'?: { 'DataTablePageInfoFieldsFragment': DataTablePageInfoFieldsFragment } } } };
 
+export type ShipmentChangedSubscriptionVariables = Exact<{
+  shipmentId?: string | number | null | undefined;
+}>;
+
+
+export type ShipmentChangedSubscription = { shipmentChanged: { eventId: string, type: string, action: string, shipmentId: string, fields: Array<string>, actorUserId: string | null, occurredAt: number, shipment: { id: string, proNumber: string, status: ShipmentStatus, version: number, updatedAt: number } | null } };
+
+export type ShipmentTimelineSubscriptionVariables = Exact<{
+  shipmentId: string | number;
+}>;
+
+
+export type ShipmentTimelineSubscription = { shipmentTimeline: { ' $fragmentRefs'?: { 'ShipmentEventFieldsFragment': ShipmentEventFieldsFragment } } };
+
+export type TenderResponsesSubscriptionVariables = Exact<{
+  shipmentId?: string | number | null | undefined;
+}>;
+
+
+export type TenderResponsesSubscription = { tenderResponses: { eventId: string, action: string, shipmentId: string, occurredAt: number, tenders: Array<{ id: string, shipmentId: string, shipmentMoveId: string, mode: TenderMode, status: TenderStatus, currentRank: number, acceptedOfferId: string | null, acceptedAt: number | null, exhaustedAt: number | null, canceledAt: number | null, version: number, updatedAt: number }> } };
+
+export type DispatchBoardChangedSubscriptionVariables = Exact<{ [key: string]: never; }>;
+
+
+export type DispatchBoardChangedSubscription = { dispatchBoardChanged: { resources: Array<string>, shipmentIds: Array<string>, recordIds: Array<string>, occurredAt: number } };
+
+export type NotificationReceivedSubscriptionVariables = Exact<{ [key: string]: never; }>;
+
+
+export type NotificationReceivedSubscription = { notificationReceived: { ' $fragmentRefs'?: { 'NotificationFieldsFragment': NotificationFieldsFragment } } };
+
 export type RecurringShipmentTableRowFieldsFragment = { id: string, businessUnitId: string, organizationId: string, sourceShipmentId: string, customerId: string | null, originLocationId: string | null, destinationLocationId: string | null, name: string, description: string, status: RecurringShipmentStatus, cronExpression: string, timezone: string, startDate: number | null, endDate: number | null, maxOccurrences: number | null, leadTimeDays: number, skipWeekends: boolean, exceptionPolicy: RecurringShipmentExceptionPolicy, blackoutDates: Array<string> | null, autoGenerate: boolean, nextOccurrenceAt: number | null, lastOccurrenceAt: number | null, lastRunAt: number | null, generationCount: number, consecutiveFailures: number, version: number, createdAt: number, updatedAt: number, customer: { id: string, name: string, code: string } | null, originLocation: { id: string, name: string, code: string } | null, destinationLocation: { id: string, name: string, code: string } | null } & { ' $fragmentName'?: 'RecurringShipmentTableRowFieldsFragment' };
 
 export type RecurringShipmentTableQueryVariables = Exact<{
@@ -18293,6 +18324,139 @@ fragment RateQuoteRowFields on RateQuote {
   engineVersion
   createdAt
 }`, {"hash":"sha256:a6f96656a47e367e900d37944525cacd8e0b3b742f8bf504572cce3a7b1fd499"}) as unknown as TypedDocumentString<RateQuoteTableQuery, RateQuoteTableQueryVariables>;
+export const ShipmentChangedDocument = new TypedDocumentString(`
+    subscription ShipmentChanged($shipmentId: ID) {
+  shipmentChanged(shipmentId: $shipmentId) {
+    eventId
+    type
+    action
+    shipmentId
+    fields
+    actorUserId
+    occurredAt
+    shipment {
+      id
+      proNumber
+      status
+      version
+      updatedAt
+    }
+  }
+}
+    `, {"hash":"sha256:5be6baa09e85d7b65f1a1eb7035ab2bad470fd0403695863f6f7212cea660ea6"}) as unknown as TypedDocumentString<ShipmentChangedSubscription, ShipmentChangedSubscriptionVariables>;
+export const ShipmentTimelineDocument = new TypedDocumentString(`
+    subscription ShipmentTimeline($shipmentId: ID!) {
+  shipmentTimeline(shipmentId: $shipmentId) {
+    ...ShipmentEventFields
+  }
+}
+    fragment ShipmentEventFields on ShipmentEvent {
+  id
+  organizationId
+  businessUnitId
+  shipmentId
+  moveId
+  stopId
+  assignmentId
+  commentId
+  holdId
+  type
+  severity
+  actorType
+  actorId
+  actorLabel
+  summary
+  proNumber
+  previousStatus
+  newStatus
+  reason
+  previousOwnerId
+  newOwnerId
+  primaryWorkerId
+  secondaryWorkerId
+  tractorId
+  trailerId
+  driverName
+  holdType
+  holdSeverity
+  holdSource
+  commentBody
+  commentType
+  commentVisibility
+  commentPriority
+  mentionedUserIds
+  metadata
+  occurredAt
+  correlationId
+  actor {
+    id
+    name
+    emailAddress
+    profilePicUrl
+    thumbnailUrl
+  }
+  shipment {
+    id
+    proNumber
+  }
+}`, {"hash":"sha256:1b3bcc696ef454a59caf0846e927e199995d519c404cc7a31a2aa179d4645790"}) as unknown as TypedDocumentString<ShipmentTimelineSubscription, ShipmentTimelineSubscriptionVariables>;
+export const TenderResponsesDocument = new TypedDocumentString(`
+    subscription TenderResponses($shipmentId: ID) {
+  tenderResponses(shipmentId: $shipmentId) {
+    eventId
+    action
+    shipmentId
+    occurredAt
+    tenders {
+      id
+      shipmentId
+      shipmentMoveId
+      mode
+      status
+      currentRank
+      acceptedOfferId
+      acceptedAt
+      exhaustedAt
+      canceledAt
+      version
+      updatedAt
+    }
+  }
+}
+    `, {"hash":"sha256:33374dfb6236064e23b8d31fdf5f1b0fc923e2e21d3ecfdf7a6b507540884da0"}) as unknown as TypedDocumentString<TenderResponsesSubscription, TenderResponsesSubscriptionVariables>;
+export const DispatchBoardChangedDocument = new TypedDocumentString(`
+    subscription DispatchBoardChanged {
+  dispatchBoardChanged {
+    resources
+    shipmentIds
+    recordIds
+    occurredAt
+  }
+}
+    `, {"hash":"sha256:0b28c2829c77e654611d047d12dda7bdef72f96f770f019e2628c3afd31cc418"}) as unknown as TypedDocumentString<DispatchBoardChangedSubscription, DispatchBoardChangedSubscriptionVariables>;
+export const NotificationReceivedDocument = new TypedDocumentString(`
+    subscription NotificationReceived {
+  notificationReceived {
+    ...NotificationFields
+  }
+}
+    fragment NotificationFields on Notification {
+  id
+  organizationId
+  businessUnitId
+  targetUserId
+  eventType
+  priority
+  channel
+  title
+  message
+  data
+  relatedEntities
+  source
+  readAt
+  dismissedAt
+  createdAt
+}`, {"hash":"sha256:49fabb01e41d9d954d4c44079c4399fb0237d5a328818c653cc600f12bb432e4"}) as unknown as TypedDocumentString<NotificationReceivedSubscription, NotificationReceivedSubscriptionVariables>;
 export const RecurringShipmentTableDocument = new TypedDocumentString(`
     query RecurringShipmentTable($input: DataTableConnectionInput!) {
   recurringShipments(input: $input) {
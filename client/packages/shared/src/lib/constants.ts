@@ -22,6 +22,8 @@ export const TERMS_URL =
 export const PRIVACY_URL =
   (import.meta.env.VITE_PRIVACY_URL as string | undefined) ??
   "https://trenova.app/legal/privacy/";
+export const REALTIME_PROVIDER =
+  (import.meta.env.VITE_REALTIME_PROVIDER as string | undefined) ?? "foony";
 
 export const US_CENTER = { lat: 39.8, lng: -98.5 };
 export const DEFAULT_ZOOM = 4;
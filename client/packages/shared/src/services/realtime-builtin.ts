@@ -0,0 +1,383 @@
+/**
+ * Client for the API's builtin realtime provider (`realtime.provider: builtin`).
+ *
+ * It mirrors the slice of the `@foony/realtime` surface the app uses — connection
+ * state events, channel subscribe/publish, and presence — so hooks work the same
+ * against either provider. Channel names and capabilities are identical; only
+ * the transport differs.
+ */
+
+type ConnectionState =
+  | "initialized"
+  | "connecting"
+  | "connected"
+  | "disconnected"
+  | "closing"
+  | "closed";
+
+type ChannelState = "initialized" | "attaching" | "attached" | "detached";
+
+type PresenceAction = "enter" | "update" | "leave" | "absent";
+
+export interface BuiltinMessage {
+  name?: string;
+  data?: unknown;
+  clientId?: string;
+  connectionId?: string;
+}
+
+export interface BuiltinPresenceMessage {
+  action: PresenceAction;
+  clientId?: string;
+  connectionId?: string;
+  data?: unknown;
+}
+
+interface ServerFrame {
+  action: string;
+  id?: number;
+  channel?: string;
+  name?: string;
+  data?: unknown;
+  clientId?: string;
+  connectionId?: string;
+  presence?: BuiltinPresenceMessage;
+  members?: { clientId: string; connectionId: string; data?: unknown }[];
+  error?: string;
+}
+
+interface PendingRequest {
+  resolve: (frame: ServerFrame) => void;
+  reject: (error: Error) => void;
+}
+
+interface BuiltinRealtimeOptions {
+  url: string;
+  authCallback: () => Promise<string>;
+}
+
+const INITIAL_RETRY_DELAY_MS = 1_000;
+const MAX_RETRY_DELAY_MS = 30_000;
+
+class BuiltinPresence {
+  constructor(private readonly channel: BuiltinChannel) {}
+
+  public async enter(data?: unknown) {
+    await this.channel.request("presence.enter", { data });
+    this.channel.enteredData = { data };
+  }
+
+  public async update(data?: unknown) {
+    await this.channel.request("presence.update", { data });
+    this.channel.enteredData = { data };
+  }
+
+  public async leave() {
+    this.channel.enteredData = null;
+    await this.channel.request("presence.leave");
+  }
+
+  /**
+   * Subscribing replays the current members as enter events, then delivers live
+   * transitions, as Foony does.
+   */
+  public subscribe(listener: (message: BuiltinPresenceMessage) => void) {
+    this.channel.presenceListeners.add(listener);
+    void this.channel
+      .request("presence.get")
+      .then((frame) => {
+        for (const member of frame.members ?? []) {
+          listener({ action: "enter", ...member });
+        }
+      })
+      .catch(() => {
+        // The snapshot is re-requested on the next subscribe; live events still flow.
+      });
+
+    return () => {
+      this.channel.presenceListeners.delete(listener);
+    };
+  }
+}
+
+class BuiltinChannel {
+  public state: ChannelState = "initialized";
+  public readonly presence: BuiltinPresence;
+  public readonly messageListeners = new Set<(message: BuiltinMessage) => void>();
+  public readonly presenceListeners = new Set<(message: BuiltinPresenceMessage) => void>();
+  public enteredData: { data?: unknown } | null = null;
+
+  constructor(
+    public readonly name: string,
+    private readonly client: BuiltinRealtime,
+  ) {
+    this.presence = new BuiltinPresence(this);
+  }
+
+  public subscribe(listener: (message: BuiltinMessage) => void) {
+    this.messageListeners.add(listener);
+    void this.attach().catch(() => {
+      // Reattached automatically once the connection recovers.
+    });
+  }
+
+  public unsubscribe(listener: (message: BuiltinMessage) => void) {
+    this.messageListeners.delete(listener);
+  }
+
+  public async publish(name: string, data?: unknown) {
+    await this.request("publish", { name, data });
+  }
+
+  public async attach() {
+    if (this.state === "attached") {
+      return;
+    }
+
+    this.state = "attaching";
+    try {
+      await this.client.send({ action: "attach", channel: this.name });
+      this.state = "attached";
+    } catch (error) {
+      this.state = "detached";
+      throw error;
+    }
+  }
+
+  public async request(action: string, fields: Record<string, unknown> = {}) {
+    return this.client.send({ ...fields, action, channel: this.name });
+  }
+
+  /** Restores the attachment and presence a dropped connection lost. */
+  public async restore() {
+    if (this.state !== "attached" && this.state !== "attaching") {
+      return;
+    }
+
+    this.state = "detached";
+    await this.attach();
+    if (this.enteredData) {
+      await this.client.send({
+        action: "presence.enter",
+        channel: this.name,
+        data: this.enteredData.data,
+      });
+    }
+  }
+
+  public handle(frame: ServerFrame) {
+    if (frame.action === "message") {
+      const message: BuiltinMessage = {
+        name: frame.name,
+        data: frame.data,
+        clientId: frame.clientId,
+        connectionId: frame.connectionId,
+      };
+      this.messageListeners.forEach((listener) => listener(message));
+      return;
+    }
+
+    if (frame.action === "presence" && frame.presence) {
+      const message = frame.presence;
+      this.presenceListeners.forEach((listener) => listener(message));
+    }
+  }
+}
+
+export class BuiltinRealtime {
+  public readonly channels = {
+    get: (name: string) => this.getChannel(name),
+  };
+
+  public readonly connection = {
+    on: (listener: (state: string) => void) => {
+      this.stateListeners.add(listener);
+    },
+    off: (listener: (state: string) => void) => {
+      this.stateListeners.delete(listener);
+    },
+  };
+
+  private state: ConnectionState = "initialized";
+  private socket: WebSocket | null = null;
+  private nextRequestId = 1;
+  private retryDelay = INITIAL_RETRY_DELAY_MS;
+  private retryTimer: ReturnType<typeof setTimeout> | null = null;
+  private closedByUser = false;
+  private readonly channelsByName = new Map<string, BuiltinChannel>();
+  private readonly pending = new Map<number, PendingRequest>();
+  private readonly stateListeners = new Set<(state: string) => void>();
+  private readonly connectedWaiters = new Set<PendingRequest>();
+
+  constructor(private readonly options: BuiltinRealtimeOptions) {
+    void this.open();
+  }
+
+  public getState() {
+    return this.state;
+  }
+
+  public close() {
+    this.closedByUser = true;
+    if (this.retryTimer !== null) {
+      clearTimeout(this.retryTimer);
+      this.retryTimer = null;
+    }
+
+    this.setState("closing");
+    this.socket?.close(1000);
+    this.socket = null;
+    this.failPending(new Error("Realtime connection closed"));
+    this.setState("closed");
+  }
+
+  public async send(frame: Record<string, unknown>): Promise<ServerFrame> {
+    await this.whenConnected();
+
+    const socket = this.socket;
+    if (!socket) {
+      throw new Error("Realtime connection is not open");
+    }
+
+    const id = this.nextRequestId++;
+    return new Promise<ServerFrame>((resolve, reject) => {
+      this.pending.set(id, { resolve, reject });
+      socket.send(JSON.stringify({ ...frame, id }));
+    });
+  }
+
+  private getChannel(name: string) {
+    let channel = this.channelsByName.get(name);
+    if (!channel) {
+      channel = new BuiltinChannel(name, this);
+      this.channelsByName.set(name, channel);
+    }
+    return channel;
+  }
+
+  private async open() {
+    this.setState("connecting");
+
+    let token: string;
+    try {
+      token = await this.options.authCallback();
+    } catch {
+      this.scheduleReconnect();
+      return;
+    }
+
+    if (this.closedByUser) {
+      return;
+    }
+
+    const url = new URL(this.options.url);
+    url.searchParams.set("token", token);
+
+    const socket = new WebSocket(url);
+    this.socket = socket;
+    socket.onmessage = (event: MessageEvent<string>) => {
+      this.handleFrame(JSON.parse(event.data) as ServerFrame);
+    };
+    socket.onclose = () => {
+      if (this.socket !== socket) {
+        return;
+      }
+
+      this.socket = null;
+      this.failPending(new Error("Realtime connection lost"));
+      if (!this.closedByUser) {
+        this.setState("disconnected");
+        this.scheduleReconnect();
+      }
+    };
+  }
+
+  private scheduleReconnect() {
+    if (this.closedByUser || this.retryTimer !== null) {
+      return;
+    }
+
+    const delay = this.retryDelay;
+    this.retryDelay = Math.min(this.retryDelay * 2, MAX_RETRY_DELAY_MS);
+    this.retryTimer = setTimeout(() => {
+      this.retryTimer = null;
+      void this.open();
+    }, delay);
+  }
+
+  private handleFrame(frame: ServerFrame) {
+    switch (frame.action) {
+      case "connected":
+        this.retryDelay = INITIAL_RETRY_DELAY_MS;
+        this.setState("connected");
+        this.connectedWaiters.forEach((waiter) => waiter.resolve(frame));
+        this.connectedWaiters.clear();
+        this.channelsByName.forEach((channel) => {
+          void channel.restore().catch(() => {
+            // Retried on the next reconnect.
+          });
+        });
+        return;
+      case "ack":
+      case "presence.snapshot":
+      case "error":
+        this.settle(frame);
+        return;
+      case "message":
+      case "presence":
+        if (frame.channel) {
+          this.channelsByName.get(frame.channel)?.handle(frame);
+        }
+        return;
+    }
+  }
+
+  private settle(frame: ServerFrame) {
+    if (frame.id === undefined) {
+      return;
+    }
+
+    const request = this.pending.get(frame.id);
+    if (!request) {
+      return;
+    }
+
+    this.pending.delete(frame.id);
+    if (frame.action === "error") {
+      request.reject(new Error(frame.error ?? "Realtime request failed"));
+      return;
+    }
+    request.resolve(frame);
+  }
+
+  private whenConnected() {
+    if (this.state === "connected") {
+      return Promise.resolve();
+    }
+    if (this.closedByUser) {
+      return Promise.reject(new Error("Realtime connection closed"));
+    }
+
+    return new Promise<void>((resolve, reject) => {
+      this.connectedWaiters.add({ resolve: () => resolve(), reject });
+    });
+  }
+
+  private failPending(error: Error) {
+    this.pending.forEach((request) => request.reject(error));
+    this.pending.clear();
+    if (this.closedByUser) {
+      this.connectedWaiters.forEach((waiter) => waiter.reject(error));
+      this.connectedWaiters.clear();
+    }
+  }
+
+  private setState(state: ConnectionState) {
+    if (this.state === state) {
+      return;
+    }
+
+    this.state = state;
+    this.stateListeners.forEach((listener) => listener(state));
+  }
+}
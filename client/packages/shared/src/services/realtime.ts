@@ -1,4 +1,6 @@
 import { api } from "@trenova/shared/lib/api";
+import { API_BASE_URL, REALTIME_PROVIDER } from "@trenova/shared/lib/constants";
+import { BuiltinRealtime } from "@trenova/shared/services/realtime-builtin";
 import { type Channel, Realtime } from "@foony/realtime";
 
 interface RealtimeTokenResponse {
@@ -15,9 +17,16 @@ export class RealtimeService {
       return this.client;
     }
 
-    this.client = new Realtime({
-      authCallback: () => this.authorize(),
-    });
+    this.client =
+      REALTIME_PROVIDER === "builtin"
+        ? // The builtin client implements the subset of the Foony surface the app uses.
+          (new BuiltinRealtime({
+            url: realtimeSocketUrl(),
+            authCallback: () => this.authorize(),
+          }) as unknown as Realtime)
+        : new Realtime({
+            authCallback: () => this.authorize(),
+          });
 
     return this.client;
   }
@@ -101,4 +110,10 @@ export class RealtimeService {
   }
 }
 
+function realtimeSocketUrl() {
+  const url = new URL(`${API_BASE_URL}/realtime/ws/`, window.location.href);
+  url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+  return url.toString();
+}
+
 export const realtimeService = new RealtimeService();
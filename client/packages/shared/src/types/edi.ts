@@ -50,8 +50,21 @@ export const ediTransferChangeStatusSchema = z.enum([
 ]);
 export const ediTransferChangeConflictStatusSchema = z.enum(["None", "Conflict", "Resolved"]);
 export const ediDocumentDirectionSchema = z.enum(["Inbound", "Outbound"]);
-export const ediStandardSchema = z.enum(["X12"]);
-export const ediTransactionSetSchema = z.enum(["204", "210", "214", "990", "997", "999"]);
+export const ediStandardSchema = z.enum(["X12", "EDIFACT"]);
+export const ediTransactionSetSchema = z.enum([
+  "204",
+  "210",
+  "211",
+  "212",
+  "214",
+  "820",
+  "990",
+  "997",
+  "999",
+  "IFTMIN",
+  "IFTSTA",
+  "INVOIC",
+]);
 export const ediDocumentStatusSchema = z.enum(["Active", "Inactive"]);
 export const ediTemplateStatusSchema = z.enum([
   "Draft",
@@ -166,6 +179,7 @@ export const ediPartnerSchema = z.object({
   enabledForInbound: z.boolean(),
   enabledForOutbound: z.boolean(),
   settings: z.record(z.string(), z.unknown()).nullish(),
+  inboundTransformScript: z.string().nullish(),
   version: z.number().default(0),
   updatedAt: z.number().nullish(),
   internalOrganization: z
@@ -445,7 +459,10 @@ const shipmentStatusPayloadSchema = z
     references: z.record(z.string(), z.string()).nullish(),
   })
   .catchall(z.unknown());
+const billOfLadingPayloadSchema = z.object({}).catchall(z.unknown());
+const deliveryManifestPayloadSchema = z.object({}).catchall(z.unknown());
 const tenderResponsePayloadSchema = z.object({}).catchall(z.unknown());
+const remittanceAdvicePayloadSchema = z.object({}).catchall(z.unknown());
 const functionalAcknowledgmentPayloadSchema = z
   .object({ diagnostics: z.array(ediAcknowledgmentDiagnosticSchema).nullish() })
   .catchall(z.unknown());
@@ -457,7 +474,10 @@ export const ediDocumentPayloadSchema = z.object({
   shipment: loadTenderPayloadSchema.nullish(),
   invoice: freightInvoicePayloadSchema.nullish(),
   shipmentStatus: shipmentStatusPayloadSchema.nullish(),
+  billOfLading: billOfLadingPayloadSchema.nullish(),
+  deliveryManifest: deliveryManifestPayloadSchema.nullish(),
   tenderResponse: tenderResponsePayloadSchema.nullish(),
+  remittanceAdvice: remittanceAdvicePayloadSchema.nullish(),
   functionalAck: functionalAcknowledgmentPayloadSchema.nullish(),
   implementationAck: implementationAcknowledgmentPayloadSchema.nullish(),
 });
@@ -557,6 +577,7 @@ export type EDITemplateTransformStep = z.infer<typeof ediTemplateTransformStepSc
 
 export const ediTemplateElementSchema = z.object({
   position: z.number(),
+  component: z.number().optional(),
   name: z.string(),
   source: ediTemplateElementSourceSchema,
   value: z.string().nullish(),
@@ -780,6 +801,7 @@ export const ediX12EnvelopeSettingsSchema = z.object({
   segmentTerminator: z.string().default("~"),
   componentSeparator: z.string().default(">"),
   repetitionSeparator: z.string().default("^"),
+  releaseCharacter: z.string().optional(),
 });
 
 export type EDIX12EnvelopeSettings = z.infer<typeof ediX12EnvelopeSettingsSchema>;
@@ -1270,6 +1292,7 @@ export type UpsertEDIPartnerRequest = {
   enabledForInbound?: boolean;
   enabledForOutbound?: boolean;
   settings?: Record<string, unknown>;
+  inboundTransformScript?: string;
   version?: number;
 };
 
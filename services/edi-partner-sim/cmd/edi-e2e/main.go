@@ -515,45 +515,106 @@ func (r *runner) run(email, password, inboundURL string) bool {
 		return nil
 	})
 
-	if !r.runSFTP(suffix, shipmentID) {
-		return false
+	for _, phase := range mailboxPhases() {
+		if !r.runMailbox(phase, suffix, shipmentID) {
+			return false
+		}
 	}
 
 	return true
 }
 
-//nolint:funlen // The SFTP phase is a second linear scenario over the same session.
-func (r *runner) runSFTP(suffix, shipmentID string) bool {
-	fmt.Println("\n--- SFTP mailbox phase ---")
-
-	var sftpInfo struct {
-		Host              string `json:"host"`
-		Port              string `json:"port"`
-		Username          string `json:"username"`
-		Password          string `json:"password"`
-		KnownHostKey      string `json:"knownHostKey"`
-		InboundDirectory  string `json:"inboundDirectory"`
-		OutboundDirectory string `json:"outboundDirectory"`
-		ArchiveDirectory  string `json:"archiveDirectory"`
-	}
-	if !r.step("read simulator SFTP mailbox configuration", func() error {
-		return r.getJSON(r.simBase+"/control/sftp", &sftpInfo)
+// mailboxPhase describes one polled mailbox transport. Every phase runs the same
+// scenario against the simulator's /control/{control} endpoints; only the
+// profile configuration differs.
+type mailboxPhase struct {
+	label   string
+	control string
+	config  func(info map[string]string) map[string]any
+	secrets func(info map[string]string) map[string]string
+}
+
+func mailboxPhases() []mailboxPhase {
+	directories := func(info map[string]string, config map[string]any) map[string]any {
+		config["inboundDirectory"] = info["inboundDirectory"]
+		config["outboundDirectory"] = info["outboundDirectory"]
+		config["archiveDirectory"] = info["archiveDirectory"]
+		return config
+	}
+	passwordSecret := func(info map[string]string) map[string]string {
+		return map[string]string{"password": info["password"]}
+	}
+	return []mailboxPhase{
+		{
+			label:   "SFTP",
+			control: "sftp",
+			config: func(info map[string]string) map[string]any {
+				return directories(info, map[string]any{
+					"host":         info["host"],
+					"port":         info["port"],
+					"username":     info["username"],
+					"authMode":     "password",
+					"knownHostKey": info["knownHostKey"],
+				})
+			},
+			secrets: passwordSecret,
+		},
+		{
+			label:   "FTPS",
+			control: "ftps",
+			config: func(info map[string]string) map[string]any {
+				return directories(info, map[string]any{
+					"host":           info["host"],
+					"port":           info["port"],
+					"username":       info["username"],
+					"tlsCertificate": info["tlsCertificate"],
+				})
+			},
+			secrets: passwordSecret,
+		},
+		{
+			label:   "S3",
+			control: "s3",
+			config: func(info map[string]string) map[string]any {
+				return directories(info, map[string]any{
+					"endpoint":    info["endpoint"],
+					"bucket":      info["bucket"],
+					"region":      info["region"],
+					"accessKeyId": info["accessKeyId"],
+				})
+			},
+			secrets: func(info map[string]string) map[string]string {
+				return map[string]string{"secretAccessKey": info["secretAccessKey"]}
+			},
+		},
+	}
+}
+
+//nolint:funlen // Each mailbox phase is a second linear scenario over the same session.
+func (r *runner) runMailbox(phase mailboxPhase, suffix, shipmentID string) bool {
+	fmt.Printf("\n--- %s mailbox phase ---\n", phase.label)
+	controlBase := r.simBase + "/control/" + phase.control
+
+	var info map[string]string
+	if !r.step("read simulator "+phase.label+" mailbox configuration", func() error {
+		return r.getJSON(controlBase, &info)
 	}) {
 		return false
 	}
 
+	senderID := phase.label + "SIM" + suffix
 	var partner struct {
 		ID string `json:"id"`
 	}
-	if !r.step("create external partner for SFTP", func() error {
+	if !r.step("create external partner for "+phase.label+"", func() error {
 		return r.postJSON(r.apiBase+"/edi/partners/", map[string]any{
 			"kind":               "External",
 			"status":             "Active",
-			"code":               "SFTP" + suffix,
-			"name":               "SFTP Partner Simulator " + suffix,
+			"code":               phase.label + suffix,
+			"name":               phase.label + " Partner Simulator " + suffix,
 			"country":            "US",
-			"contactName":        "SFTP Operator",
-			"contactEmail":       "sftp@partner.example",
+			"contactName":        phase.label + " Operator",
+			"contactEmail":       phase.control + "@partner.example",
 			"timezone":           "America/Chicago",
 			"enabledForInbound":  true,
 			"enabledForOutbound": true,
@@ -566,40 +627,30 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 	var profile struct {
 		ID string `json:"id"`
 	}
-	if !r.step("create SFTP communication profile", func() error {
+	config := phase.config(info)
+	config["fileNamingPattern"] = "{partnerId}-{transactionSet}-{messageId}.edi"
+	config["isaSenderQualifier"] = "ZZ"
+	config["isaSenderId"] = "TRENOVA"
+	config["isaReceiverQualifier"] = "ZZ"
+	config["isaReceiverId"] = senderID
+	config["gsSenderId"] = "TRENOVA"
+	config["gsReceiverId"] = senderID
+	config["x12Version"] = "004010"
+	config["environment"] = "test"
+	if !r.step("create "+phase.label+" communication profile", func() error {
 		return r.postJSON(r.apiBase+"/edi/communication-profiles/", map[string]any{
 			"ediPartnerId": partner.ID,
-			"method":       "SFTP",
+			"method":       phase.label,
 			"status":       "Active",
-			"name":         "Simulator SFTP " + suffix,
-			"config": map[string]any{
-				"host":                 sftpInfo.Host,
-				"port":                 sftpInfo.Port,
-				"username":             sftpInfo.Username,
-				"authMode":             "password",
-				"knownHostKey":         sftpInfo.KnownHostKey,
-				"inboundDirectory":     sftpInfo.InboundDirectory,
-				"outboundDirectory":    sftpInfo.OutboundDirectory,
-				"archiveDirectory":     sftpInfo.ArchiveDirectory,
-				"fileNamingPattern":    "{partnerId}-{transactionSet}-{messageId}.edi",
-				"isaSenderQualifier":   "ZZ",
-				"isaSenderId":          "TRENOVA",
-				"isaReceiverQualifier": "ZZ",
-				"isaReceiverId":        "SFTPSIM" + suffix,
-				"gsSenderId":           "TRENOVA",
-				"gsReceiverId":         "SFTPSIM" + suffix,
-				"x12Version":           "004010",
-				"environment":          "test",
-			},
-			"secrets": map[string]string{
-				"password": sftpInfo.Password,
-			},
+			"name":         "Simulator " + phase.label + " " + suffix,
+			"config":       config,
+			"secrets":      phase.secrets(info),
 		}, &profile)
 	}) {
 		return false
 	}
 
-	r.step("SFTP test-connection reports success", func() error {
+	r.step(""+phase.label+" test-connection reports success", func() error {
 		var result struct {
 			Success bool `json:"success"`
 			Checks  []struct {
@@ -619,7 +670,7 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 			fmt.Printf("      · %-22s %-8s %s\n", check.Name, check.Status, check.Message)
 		}
 		if !result.Success {
-			return fmt.Errorf("SFTP connection test reported failure")
+			return fmt.Errorf("%s connection test reported failure", phase.label)
 		}
 		return nil
 	})
@@ -627,10 +678,10 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 	var documentProfile struct {
 		ID string `json:"id"`
 	}
-	if !r.step("create outbound 204 document profile for SFTP", func() error {
+	if !r.step("create outbound 204 document profile for "+phase.label+"", func() error {
 		return r.postJSON(r.apiBase+"/edi/document-profiles/", map[string]any{
 			"ediPartnerId":   partner.ID,
-			"name":           "SFTP 204 Outbound " + suffix,
+			"name":           phase.label + " 204 Outbound " + suffix,
 			"status":         "Active",
 			"validationMode": "WarnOnly",
 			"acknowledgment": map[string]any{"expected": false, "type": "None"},
@@ -645,7 +696,7 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 	var message struct {
 		ID string `json:"id"`
 	}
-	if !r.step("generate outbound 204 for SFTP delivery", func() error {
+	if !r.step("generate outbound 204 for "+phase.label+" delivery", func() error {
 		return r.postJSON(r.apiBase+"/edi/documents/generate/", map[string]any{
 			"partnerDocumentProfileId": documentProfile.ID,
 			"ediPartnerId":             partner.ID,
@@ -657,7 +708,7 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 		return false
 	}
 
-	r.step("message is pushed to the SFTP outbound mailbox (Sent)", func() error {
+	r.step("message is pushed to the "+phase.label+" outbound mailbox (Sent)", func() error {
 		if err := r.pollMessage(message.ID, 90*time.Second, func(m map[string]any) (bool, error) {
 			status, _ := m["deliveryStatus"].(string)
 			switch status {
@@ -677,7 +728,7 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 				Contents string `json:"contents"`
 			} `json:"files"`
 		}
-		if err := r.getJSON(r.simBase+"/control/sftp/outbound", &outbound); err != nil {
+		if err := r.getJSON(controlBase+"/outbound", &outbound); err != nil {
 			return err
 		}
 		for _, file := range outbound.Files {
@@ -686,13 +737,13 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 				return nil
 			}
 		}
-		return fmt.Errorf("no 204 file landed in the SFTP outbound directory")
+		return fmt.Errorf("no 204 file landed in the %s outbound mailbox", phase.label)
 	})
 
-	tenderRef := "SFTP" + suffix
-	r.step("drop an inbound 204 into the SFTP mailbox", func() error {
+	tenderRef := phase.label + suffix
+	r.step("drop an inbound 204 into the "+phase.label+" mailbox", func() error {
 		payload := sim.BuildLoadTender204(sim.BuildLoadTenderInput{
-			SenderID:      "SFTPSIM" + suffix,
+			SenderID:      senderID,
 			ReceiverID:    "TRENOVA",
 			ControlNumber: time.Now().Unix() % 1000000,
 			ShipmentID:    tenderRef,
@@ -700,7 +751,7 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 		var drop struct {
 			FileName string `json:"fileName"`
 		}
-		return r.postJSON(r.simBase+"/control/sftp/drop", map[string]any{
+		return r.postJSON(controlBase+"/drop", map[string]any{
 			"fileName": "inbound-204-" + suffix + ".edi",
 			"payload":  payload,
 		}, &drop)
@@ -742,18 +793,18 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 			return err
 		}
 		for _, file := range files.Results {
-			if file.Method != "SFTP" {
+			if file.Method != phase.label {
 				continue
 			}
 			switch file.Status {
 			case "Processed", "PartiallyProcessed":
-				fmt.Printf("      · SFTP inbound file status %s\n", file.Status)
+				fmt.Printf("      · %s inbound file status %s\n", phase.label, file.Status)
 				return nil
 			case "Quarantined":
 				return fmt.Errorf("inbound file quarantined: %s", file.FailureReason)
 			}
 		}
-		return fmt.Errorf("no processed SFTP inbound file for the partner")
+		return fmt.Errorf("no processed %s inbound file for the partner", phase.label)
 	})
 
 	r.step("processed file was archived on the mailbox", func() error {
@@ -765,7 +816,7 @@ func (r *runner) runSFTP(suffix, shipmentID string) bool {
 				Name string `json:"name"`
 			} `json:"archive"`
 		}
-		if err := r.getJSON(r.simBase+"/control/sftp/inbound", &mailbox); err != nil {
+		if err := r.getJSON(controlBase+"/inbound", &mailbox); err != nil {
 			return err
 		}
 		if len(mailbox.Archive) == 0 {
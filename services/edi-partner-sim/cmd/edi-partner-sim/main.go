@@ -29,10 +29,15 @@ func run() int {
 		"Trenova AS2 inbound receiver URL",
 	)
 	autoAck := flag.Bool("auto-ack", true, "automatically send a 997 for received documents")
+	autoRespond := flag.Bool(
+		"auto-respond",
+		false,
+		"answer received 204 load tenders with a 990 and a 214 status sequence",
+	)
 	identityDir := flag.String(
 		"identity-dir",
 		"",
-		"directory to persist the AS2 keypair and SFTP host key across restarts (empty = ephemeral)",
+		"directory to persist the AS2 keypair, SFTP host key and FTPS certificate (empty = ephemeral)",
 	)
 	sftpListen := flag.String(
 		"sftp-listen",
@@ -46,6 +51,25 @@ func run() int {
 		"SFTP password Trenova authenticates with",
 	)
 	sftpRoot := flag.String("sftp-root", "", "SFTP mailbox root directory (empty = temp dir)")
+	ftpsListen := flag.String(
+		"ftps-listen",
+		":9221",
+		"address the explicit-TLS FTPS mailbox listens on (empty to disable)",
+	)
+	ftpsRoot := flag.String("ftps-root", "", "FTPS mailbox root directory (empty = temp dir)")
+	s3Listen := flag.String(
+		"s3-listen",
+		":9223",
+		"address the S3-compatible mailbox listens on (empty to disable)",
+	)
+	s3Bucket := flag.String("s3-bucket", "edi-mailbox", "bucket name the S3 mailbox serves")
+	s3AccessKey := flag.String("s3-access-key", "trenova", "S3 access key ID Trenova signs with")
+	s3SecretKey := flag.String(
+		"s3-secret-key",
+		"trenova-sim-secret",
+		"S3 secret access key Trenova signs with",
+	)
+	s3Root := flag.String("s3-root", "", "S3 mailbox root directory (empty = temp dir)")
 	flag.Parse()
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
@@ -78,13 +102,70 @@ func run() int {
 		}()
 	}
 
+	var ftpsServer *sim.FTPSServer
+	if strings.TrimSpace(*ftpsListen) != "" {
+		created, err := sim.NewFTPSServer(sim.FTPSOptions{
+			Listen:      *ftpsListen,
+			Username:    *sftpUser,
+			Password:    *sftpPassword,
+			RootDir:     *ftpsRoot,
+			IdentityDir: *identityDir,
+			Logger:      logger,
+		})
+		if err != nil {
+			logger.Error("failed to initialize FTPS mailbox", "error", err)
+			return 1
+		}
+		ftpsServer = created
+		go func() {
+			logger.Info("FTPS mailbox listening",
+				"address", ftpsServer.Addr(),
+				"username", *sftpUser,
+				"root", ftpsServer.Root(),
+			)
+			if err := ftpsServer.Serve(); err != nil {
+				logger.Error("FTPS mailbox failed", "error", err)
+			}
+		}()
+	}
+
+	var s3Server *sim.S3Server
+	if strings.TrimSpace(*s3Listen) != "" {
+		created, err := sim.NewS3Server(sim.S3Options{
+			Listen:          *s3Listen,
+			AccessKeyID:     *s3AccessKey,
+			SecretAccessKey: *s3SecretKey,
+			Bucket:          *s3Bucket,
+			RootDir:         *s3Root,
+			Logger:          logger,
+		})
+		if err != nil {
+			logger.Error("failed to initialize S3 mailbox", "error", err)
+			return 1
+		}
+		s3Server = created
+		go func() {
+			logger.Info("S3 mailbox listening",
+				"address", s3Server.Addr(),
+				"bucket", s3Server.Bucket(),
+				"root", s3Server.Root(),
+			)
+			if err := s3Server.Serve(); err != nil {
+				logger.Error("S3 mailbox failed", "error", err)
+			}
+		}()
+	}
+
 	server, err := sim.NewServer(sim.Options{
 		AS2ID:           *as2ID,
 		RemoteAS2ID:     *remoteAS2ID,
 		TrenovaInbound:  *trenovaInbound,
 		AutoAcknowledge: *autoAck,
+		AutoRespond:     *autoRespond,
 		IdentityDir:     *identityDir,
 		SFTP:            sftpServer,
+		FTPS:            ftpsServer,
+		S3:              s3Server,
 		Logger:          logger,
 	})
 	if err != nil {
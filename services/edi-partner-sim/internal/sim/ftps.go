@@ -0,0 +1,471 @@
+package sim
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const ftpsDataTimeout = 30 * time.Second
+
+type FTPSOptions struct {
+	Listen      string
+	Username    string
+	Password    string
+	RootDir     string
+	IdentityDir string
+	Logger      *slog.Logger
+}
+
+type FTPSServer struct {
+	options   FTPSOptions
+	logger    *slog.Logger
+	listener  net.Listener
+	identity  *Identity
+	tlsConfig *tls.Config
+	closeOnce sync.Once
+
+	*Mailbox
+}
+
+// NewFTPSServer starts an explicit-TLS FTP server over the same mailbox layout as
+// the SFTP server. Clients must upgrade with AUTH TLS before logging in and use
+// PROT P passive data channels. Sessions are jailed to the mailbox root, so the
+// directories are /inbound, /outbound and /archive on the wire.
+//
+//nolint:gocritic // Options is a constructor value struct by design.
+func NewFTPSServer(options FTPSOptions) (*FTPSServer, error) {
+	mailbox, err := NewMailbox(options.RootDir, "edi-partner-sim-ftps-")
+	if err != nil {
+		return nil, err
+	}
+	identity, err := LoadOrCreateTLSIdentity(options.IdentityDir)
+	if err != nil {
+		return nil, err
+	}
+	listenConfig := &net.ListenConfig{}
+	listener, err := listenConfig.Listen(context.Background(), "tcp", options.Listen)
+	if err != nil {
+		return nil, fmt.Errorf("listen for ftps: %w", err)
+	}
+	return &FTPSServer{
+		options:  options,
+		logger:   options.Logger,
+		listener: listener,
+		identity: identity,
+		tlsConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			Certificates: []tls.Certificate{{
+				Certificate: [][]byte{identity.Certificate.Raw},
+				PrivateKey:  identity.Key,
+			}},
+		},
+		Mailbox: mailbox,
+	}, nil
+}
+
+func (s *FTPSServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// CertificatePEM returns the server certificate, suitable for Trenova's FTPS
+// profile tlsCertificate pin.
+func (s *FTPSServer) CertificatePEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: s.identity.Certificate.Raw,
+	}))
+}
+
+// RemoteDir returns the path a client sees for one of the mailbox directories.
+func (s *FTPSServer) RemoteDir(dir string) string {
+	relative, err := filepath.Rel(s.Root(), dir)
+	if err != nil {
+		return "/"
+	}
+	return "/" + filepath.ToSlash(relative)
+}
+
+func (s *FTPSServer) Serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *FTPSServer) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		err = s.listener.Close()
+	})
+	return err
+}
+
+type ftpsSession struct {
+	server     *FTPSServer
+	conn       net.Conn
+	text       *textproto.Conn
+	secured    bool
+	user       string
+	loggedIn   bool
+	protected  bool
+	passive    net.Listener
+	renameFrom string
+}
+
+func (s *FTPSServer) handleConn(conn net.Conn) {
+	session := &ftpsSession{server: s, conn: conn, text: textproto.NewConn(conn)}
+	defer session.close()
+	session.reply(220, "EDI partner simulator FTPS mailbox ready")
+	for {
+		line, err := session.text.ReadLine()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				s.logger.Debug("ftps session ended", "error", err)
+			}
+			return
+		}
+		command, argument, _ := strings.Cut(line, " ")
+		if !session.handle(strings.ToUpper(strings.TrimSpace(command)), argument) {
+			return
+		}
+	}
+}
+
+func (c *ftpsSession) close() {
+	if c.passive != nil {
+		_ = c.passive.Close()
+	}
+	_ = c.conn.Close()
+}
+
+func (c *ftpsSession) reply(code int, message string) {
+	_ = c.text.PrintfLine("%d %s", code, message)
+}
+
+// handle runs one control command and reports whether the session should continue.
+//
+//nolint:gocyclo,cyclop,funlen // One flat dispatch over the FTP command set.
+func (c *ftpsSession) handle(command, argument string) bool {
+	switch command {
+	case "QUIT":
+		c.reply(221, "Goodbye")
+		return false
+	case "FEAT":
+		_ = c.text.PrintfLine("211-Features:")
+		// MLSD is advertised through its MLST feature line (RFC 3659 7.8);
+		// clients look for MLST before they list with MLSD instead of LIST.
+		for _, feature := range []string{
+			"AUTH TLS", "PBSZ", "PROT", "EPSV", "MLST type*;size*;modify*;", "SIZE",
+		} {
+			_ = c.text.PrintfLine(" %s", feature)
+		}
+		c.reply(211, "End")
+		return true
+	case "AUTH":
+		return c.handleAuth(argument)
+	}
+	if !c.secured {
+		c.reply(530, "Explicit TLS is required; send AUTH TLS first")
+		return true
+	}
+
+	switch command {
+	case "USER":
+		c.user = argument
+		c.loggedIn = false
+		c.reply(331, "Password required")
+		return true
+	case "PASS":
+		if c.user == c.server.options.Username && argument == c.server.options.Password {
+			c.loggedIn = true
+			c.reply(230, "Logged in")
+		} else {
+			c.reply(530, "Invalid credentials")
+		}
+		return true
+	case "PBSZ":
+		c.reply(200, "PBSZ=0")
+		return true
+	case "PROT":
+		if !strings.EqualFold(strings.TrimSpace(argument), "P") {
+			c.reply(534, "Only PROT P is supported")
+			return true
+		}
+		c.protected = true
+		c.reply(200, "Data channel protection set to private")
+		return true
+	}
+	if !c.loggedIn {
+		c.reply(530, "Not logged in")
+		return true
+	}
+
+	switch command {
+	case "SYST":
+		c.reply(215, "UNIX Type: L8")
+	case "TYPE", "OPTS", "NOOP", "MODE", "STRU":
+		c.reply(200, "OK")
+	case "PWD":
+		c.reply(257, `"/" is the current directory`)
+	case "CWD":
+		if info, err := os.Stat(c.server.resolve(argument)); err != nil || !info.IsDir() {
+			c.reply(550, "Directory not found")
+		} else {
+			c.reply(250, "Directory exists")
+		}
+	case "EPSV":
+		port, err := c.openPassive()
+		if err != nil {
+			c.reply(425, "Cannot open passive connection")
+			return true
+		}
+		c.reply(229, fmt.Sprintf("Entering Extended Passive Mode (|||%d|)", port))
+	case "PASV":
+		c.handlePASV()
+	case "MLSD":
+		c.handleMLSD(argument)
+	case "RETR":
+		c.handleRETR(argument)
+	case "STOR":
+		c.handleSTOR(argument)
+	case "SIZE":
+		info, err := os.Stat(c.server.resolve(argument))
+		if err != nil || info.IsDir() {
+			c.reply(550, "File not found")
+		} else {
+			c.reply(213, strconv.FormatInt(info.Size(), 10))
+		}
+	case "MKD":
+		if err := os.Mkdir(c.server.resolve(argument), 0o755); err != nil {
+			c.reply(550, "Directory could not be created")
+		} else {
+			c.reply(257, fmt.Sprintf("%q created", argument))
+		}
+	case "DELE":
+		if err := os.Remove(c.server.resolve(argument)); err != nil {
+			c.reply(550, "File could not be deleted")
+		} else {
+			c.reply(250, "File deleted")
+		}
+	case "RNFR":
+		if _, err := os.Stat(c.server.resolve(argument)); err != nil {
+			c.reply(550, "File not found")
+			return true
+		}
+		c.renameFrom = argument
+		c.reply(350, "Ready for RNTO")
+	case "RNTO":
+		from := c.renameFrom
+		c.renameFrom = ""
+		if from == "" {
+			c.reply(503, "RNFR required first")
+			return true
+		}
+		if err := os.Rename(c.server.resolve(from), c.server.resolve(argument)); err != nil {
+			c.reply(553, "Rename failed")
+			return true
+		}
+		c.reply(250, "Rename successful")
+	default:
+		c.reply(502, "Command not implemented")
+	}
+	return true
+}
+
+func (c *ftpsSession) handleAuth(argument string) bool {
+	if c.secured {
+		c.reply(503, "TLS is already active")
+		return true
+	}
+	mechanism := strings.ToUpper(strings.TrimSpace(argument))
+	if mechanism != "TLS" && mechanism != "SSL" {
+		c.reply(504, "Only AUTH TLS is supported")
+		return true
+	}
+	c.reply(234, "Proceed with TLS negotiation")
+	tlsConn := tls.Server(c.conn, c.server.tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		// Clients that reject the pinned certificate abort here.
+		c.server.logger.Debug("ftps TLS handshake did not complete", "error", err)
+		return false
+	}
+	c.conn = tlsConn
+	c.text = textproto.NewConn(tlsConn)
+	c.secured = true
+	return true
+}
+
+func (c *ftpsSession) handlePASV() {
+	port, err := c.openPassive()
+	if err != nil {
+		c.reply(425, "Cannot open passive connection")
+		return
+	}
+	host, _, _ := net.SplitHostPort(c.conn.LocalAddr().String())
+	ip := net.ParseIP(host).To4()
+	if ip == nil {
+		ip = net.IPv4(127, 0, 0, 1).To4()
+	}
+	c.reply(227, fmt.Sprintf(
+		"Entering Passive Mode (%d,%d,%d,%d,%d,%d)",
+		ip[0], ip[1], ip[2], ip[3], port>>8, port&0xff,
+	))
+}
+
+func (c *ftpsSession) openPassive() (int, error) {
+	if c.passive != nil {
+		_ = c.passive.Close()
+		c.passive = nil
+	}
+	host, _, err := net.SplitHostPort(c.conn.LocalAddr().String())
+	if err != nil {
+		return 0, err
+	}
+	listenConfig := &net.ListenConfig{}
+	listener, err := listenConfig.Listen(
+		context.Background(),
+		"tcp",
+		net.JoinHostPort(host, "0"),
+	)
+	if err != nil {
+		return 0, err
+	}
+	c.passive = listener
+	tcpAddr, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		return 0, errors.New("passive listener is not TCP")
+	}
+	return tcpAddr.Port, nil
+}
+
+// acceptData accepts the client's passive data connection and wraps it in TLS.
+// A transfer without PROT P is refused so nothing crosses the wire in the clear.
+func (c *ftpsSession) acceptData() (net.Conn, bool) {
+	if !c.protected {
+		c.reply(521, "Data connections must be protected; send PROT P first")
+		return nil, false
+	}
+	if c.passive == nil {
+		c.reply(425, "Use EPSV or PASV first")
+		return nil, false
+	}
+	listener := c.passive
+	c.passive = nil
+	defer listener.Close()
+
+	c.reply(150, "Opening data connection")
+	if tcpListener, ok := listener.(*net.TCPListener); ok {
+		_ = tcpListener.SetDeadline(time.Now().Add(ftpsDataTimeout))
+	}
+	conn, err := listener.Accept()
+	if err != nil {
+		c.reply(425, "Data connection was not opened")
+		return nil, false
+	}
+	tlsConn := tls.Server(conn, c.server.tlsConfig)
+	_ = tlsConn.SetDeadline(time.Now().Add(ftpsDataTimeout))
+	if err = tlsConn.Handshake(); err != nil {
+		_ = conn.Close()
+		c.reply(425, "Data connection TLS negotiation failed")
+		return nil, false
+	}
+	return tlsConn, true
+}
+
+func (c *ftpsSession) handleMLSD(argument string) {
+	dir := c.server.resolve(argument)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		c.reply(550, "Directory not found")
+		return
+	}
+	data, ok := c.acceptData()
+	if !ok {
+		return
+	}
+	for _, entry := range entries {
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			continue
+		}
+		kind := "file"
+		if entry.IsDir() {
+			kind = "dir"
+		}
+		_, _ = fmt.Fprintf(
+			data,
+			"type=%s;size=%d;modify=%s; %s\r\n",
+			kind,
+			info.Size(),
+			info.ModTime().UTC().Format("20060102150405"),
+			entry.Name(),
+		)
+	}
+	_ = data.Close()
+	c.reply(226, "Listing complete")
+}
+
+func (c *ftpsSession) handleRETR(argument string) {
+	file, err := os.Open(c.server.resolve(argument))
+	if err != nil {
+		c.reply(550, "File not found")
+		return
+	}
+	defer file.Close()
+	data, ok := c.acceptData()
+	if !ok {
+		return
+	}
+	_, copyErr := io.Copy(data, file)
+	_ = data.Close()
+	if copyErr != nil {
+		c.reply(426, "Transfer aborted")
+		return
+	}
+	c.reply(226, "Transfer complete")
+}
+
+func (c *ftpsSession) handleSTOR(argument string) {
+	target := c.server.resolve(argument)
+	if info, err := os.Stat(filepath.Dir(target)); err != nil || !info.IsDir() {
+		c.reply(553, "Target directory does not exist")
+		return
+	}
+	data, ok := c.acceptData()
+	if !ok {
+		return
+	}
+	defer data.Close()
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		c.reply(553, "File could not be created")
+		return
+	}
+	_, copyErr := io.Copy(file, data)
+	closeErr := file.Close()
+	if copyErr != nil || closeErr != nil {
+		c.reply(426, "Transfer aborted")
+		return
+	}
+	c.server.logger.Info("ftps file stored", "path", c.server.RemoteDir(target))
+	c.reply(226, "Transfer complete")
+}
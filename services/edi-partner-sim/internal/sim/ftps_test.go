@@ -0,0 +1,199 @@
+package sim
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFTPSServerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewFTPSServer(FTPSOptions{
+		Listen:      "127.0.0.1:0",
+		Username:    "trenova",
+		Password:    "secret",
+		IdentityDir: t.TempDir(),
+		Logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("new ftps server: %v", err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	go func() { _ = server.Serve() }()
+
+	if _, err := server.DropInbound("tender.edi", []byte("ISA*...~")); err != nil {
+		t.Fatalf("drop inbound: %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(server.CertificatePEM())) {
+		t.Fatal("server certificate PEM did not parse")
+	}
+	client := dialTestFTPS(t, server.Addr(), &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            roots,
+		ServerName:         "localhost",
+		ClientSessionCache: tls.NewLRUClientSessionCache(4),
+	})
+
+	client.expect(t, 331, "USER trenova")
+	client.expect(t, 230, "PASS secret")
+
+	// Data transfers are refused until the channel is protected.
+	client.expect(t, 229, "EPSV")
+	client.expect(t, 521, "MLSD %s", server.RemoteDir(server.InboundDir()))
+
+	client.expect(t, 200, "PBSZ 0")
+	client.expect(t, 200, "PROT P")
+
+	listing := client.transfer(t, "MLSD "+server.RemoteDir(server.InboundDir()), nil)
+	if !strings.Contains(listing, "type=file;size=8;") || !strings.Contains(listing, " tender.edi") {
+		t.Fatalf("unexpected inbound listing: %q", listing)
+	}
+	contents := client.transfer(t, "RETR /inbound/tender.edi", nil)
+	if contents != "ISA*...~" {
+		t.Fatalf("unexpected inbound contents: %q", contents)
+	}
+
+	client.transfer(t, "STOR /outbound/outbound-204.x12", []byte("ISA*...~ST*204~"))
+	written, err := os.ReadFile(filepath.Join(server.OutboundDir(), "outbound-204.x12"))
+	if err != nil {
+		t.Fatalf("read written outbound file: %v", err)
+	}
+	if string(written) != "ISA*...~ST*204~" {
+		t.Fatalf("unexpected outbound contents: %q", written)
+	}
+
+	// Archive the inbound file the way the FTPS transport does.
+	client.expect(t, 350, "RNFR /inbound/tender.edi")
+	client.expect(t, 250, "RNTO /archive/tender.edi")
+	if _, err := os.Stat(filepath.Join(server.ArchiveDir(), "tender.edi")); err != nil {
+		t.Fatalf("archived file missing: %v", err)
+	}
+
+	// Paths cannot climb out of the mailbox root.
+	client.expect(t, 550, "RETR ../../etc/passwd")
+}
+
+func TestFTPSServerRequiresTLS(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewFTPSServer(FTPSOptions{
+		Listen:      "127.0.0.1:0",
+		Username:    "trenova",
+		Password:    "secret",
+		IdentityDir: t.TempDir(),
+		Logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("new ftps server: %v", err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	go func() { _ = server.Serve() }()
+
+	conn, err := net.Dial("tcp", server.Addr())
+	if err != nil {
+		t.Fatalf("dial ftps: %v", err)
+	}
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	if _, _, err := text.ReadResponse(220); err != nil {
+		t.Fatalf("read greeting: %v", err)
+	}
+	id, err := text.Cmd("USER trenova")
+	if err != nil {
+		t.Fatalf("send USER: %v", err)
+	}
+	text.StartResponse(id)
+	code, _, _ := text.ReadResponse(0)
+	text.EndResponse(id)
+	if code != 530 {
+		t.Fatalf("expected 530 before AUTH TLS, got %d", code)
+	}
+}
+
+type testFTPSClient struct {
+	conn      net.Conn
+	text      *textproto.Conn
+	tlsConfig *tls.Config
+}
+
+func dialTestFTPS(t *testing.T, addr string, tlsConfig *tls.Config) *testFTPSClient {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("dial ftps: %v", err)
+	}
+	client := &testFTPSClient{conn: conn, text: textproto.NewConn(conn), tlsConfig: tlsConfig}
+	t.Cleanup(func() { _ = client.conn.Close() })
+	if _, _, err := client.text.ReadResponse(220); err != nil {
+		t.Fatalf("read greeting: %v", err)
+	}
+	client.expect(t, 234, "AUTH TLS")
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		t.Fatalf("control TLS handshake: %v", err)
+	}
+	client.conn = tlsConn
+	client.text = textproto.NewConn(tlsConn)
+	return client
+}
+
+func (c *testFTPSClient) expect(t *testing.T, code int, format string, args ...any) string {
+	t.Helper()
+
+	id, err := c.text.Cmd(format, args...)
+	if err != nil {
+		t.Fatalf("send %q: %v", format, err)
+	}
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+	got, message, err := c.text.ReadResponse(code)
+	if err != nil {
+		t.Fatalf("%q: expected %d, got %d %s", format, code, got, message)
+	}
+	return message
+}
+
+// transfer opens an EPSV data channel, runs command and returns what the server
+// sent back, writing upload to the channel first when it is non-nil.
+func (c *testFTPSClient) transfer(t *testing.T, command string, upload []byte) string {
+	t.Helper()
+
+	message := c.expect(t, 229, "EPSV")
+	start := strings.Index(message, "(|||")
+	end := strings.LastIndex(message, "|)")
+	if start < 0 || end < start {
+		t.Fatalf("unexpected EPSV reply: %q", message)
+	}
+	host, _, _ := net.SplitHostPort(c.conn.RemoteAddr().String())
+	dataConn, err := net.Dial("tcp", net.JoinHostPort(host, message[start+4:end]))
+	if err != nil {
+		t.Fatalf("dial data connection: %v", err)
+	}
+	data := tls.Client(dataConn, c.tlsConfig)
+	c.expect(t, 150, "%s", command)
+
+	var received []byte
+	if upload != nil {
+		if _, err = data.Write(upload); err != nil {
+			t.Fatalf("write data: %v", err)
+		}
+	} else if received, err = io.ReadAll(data); err != nil {
+		t.Fatalf("read data: %v", err)
+	}
+	_ = data.Close()
+	if _, _, err = c.text.ReadResponse(226); err != nil {
+		t.Fatalf("%s did not complete: %v", command, err)
+	}
+	return string(received)
+}
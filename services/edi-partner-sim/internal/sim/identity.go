@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net"
 	"os"
 	"path/filepath"
 	"time"
@@ -33,23 +34,39 @@ func NewIdentity(commonName string) (*Identity, error) {
 // generates a fresh identity and persists it so restarts keep the same keypair (and
 // therefore keep working against communication profiles created in an earlier run).
 func LoadOrCreateIdentity(dir, commonName string) (*Identity, error) {
-	if dir == "" {
+	return loadOrCreateIdentityFiles(dir, "as2", func() (*Identity, error) {
 		return NewIdentity(commonName)
+	})
+}
+
+// LoadOrCreateTLSIdentity is the FTPS counterpart of LoadOrCreateIdentity: a
+// self-signed server certificate for localhost, persisted next to the AS2 keypair
+// so Trenova's pinned tlsCertificate keeps matching across restarts.
+func LoadOrCreateTLSIdentity(dir string) (*Identity, error) {
+	return loadOrCreateIdentityFiles(dir, "ftps", newTLSIdentity)
+}
+
+func loadOrCreateIdentityFiles(
+	dir, prefix string,
+	create func() (*Identity, error),
+) (*Identity, error) {
+	if dir == "" {
+		return create()
 	}
-	certPath := filepath.Join(dir, "as2-cert.pem")
-	keyPath := filepath.Join(dir, "as2-key.pem")
+	certPath := filepath.Join(dir, prefix+"-cert.pem")
+	keyPath := filepath.Join(dir, prefix+"-key.pem")
 
 	certPEM, certErr := os.ReadFile(certPath)
 	keyPEM, keyErr := os.ReadFile(keyPath)
 	if certErr == nil && keyErr == nil {
 		identity, err := identityFromPEM(certPEM, keyPEM)
 		if err != nil {
-			return nil, fmt.Errorf("load persisted AS2 identity: %w", err)
+			return nil, fmt.Errorf("load persisted %s identity: %w", prefix, err)
 		}
 		return identity, nil
 	}
 
-	identity, err := NewIdentity(commonName)
+	identity, err := create()
 	if err != nil {
 		return nil, err
 	}
@@ -57,20 +74,43 @@ func LoadOrCreateIdentity(dir, commonName string) (*Identity, error) {
 		return nil, fmt.Errorf("create identity directory: %w", mkErr)
 	}
 	if writeErr := os.WriteFile(certPath, []byte(identity.CertificatePEM), 0o600); writeErr != nil {
-		return nil, fmt.Errorf("persist AS2 certificate: %w", writeErr)
+		return nil, fmt.Errorf("persist %s certificate: %w", prefix, writeErr)
 	}
 	if writeErr := os.WriteFile(keyPath, []byte(identity.KeyPEM), 0o600); writeErr != nil {
-		return nil, fmt.Errorf("persist AS2 key: %w", writeErr)
+		return nil, fmt.Errorf("persist %s key: %w", prefix, writeErr)
 	}
 	return identity, nil
 }
 
+func newTLSIdentity() (*Identity, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generate simulator TLS key: %w", err)
+	}
+	template, err := certificateTemplate("localhost")
+	if err != nil {
+		return nil, err
+	}
+	template.DNSNames = []string{"localhost"}
+	template.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	return selfSignedIdentity(template, key)
+}
+
 func identityFromKey(commonName string, key *rsa.PrivateKey) (*Identity, error) {
+	template, err := certificateTemplate(commonName)
+	if err != nil {
+		return nil, err
+	}
+	return selfSignedIdentity(template, key)
+}
+
+func certificateTemplate(commonName string) (*x509.Certificate, error) {
 	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 	if err != nil {
 		return nil, fmt.Errorf("generate certificate serial: %w", err)
 	}
-	template := &x509.Certificate{
+	return &x509.Certificate{
 		SerialNumber: serial,
 		Subject: pkix.Name{
 			CommonName:   commonName,
@@ -80,7 +120,10 @@ func identityFromKey(commonName string, key *rsa.PrivateKey) (*Identity, error)
 		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
 		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 		BasicConstraintsValid: true,
-	}
+	}, nil
+}
+
+func selfSignedIdentity(template *x509.Certificate, key *rsa.PrivateKey) (*Identity, error) {
 	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
 	if err != nil {
 		return nil, fmt.Errorf("create simulator certificate: %w", err)
@@ -0,0 +1,96 @@
+package sim
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Mailbox is the directory layout every simulated drop zone shares: Trenova pushes
+// outbound documents into outbound/, polls inbound/ for files the partner drops,
+// and archives processed files into archive/. The SFTP, FTPS and S3 front ends only
+// differ in how they expose these directories.
+type Mailbox struct {
+	root     string
+	inbound  string
+	outbound string
+	archive  string
+}
+
+func NewMailbox(root, tempPrefix string) (*Mailbox, error) {
+	if root == "" {
+		created, err := os.MkdirTemp("", tempPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("create mailbox root: %w", err)
+		}
+		root = created
+	}
+	mailbox := &Mailbox{
+		root:     root,
+		inbound:  filepath.Join(root, "inbound"),
+		outbound: filepath.Join(root, "outbound"),
+		archive:  filepath.Join(root, "archive"),
+	}
+	for _, dir := range []string{mailbox.inbound, mailbox.outbound, mailbox.archive} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create mailbox directory: %w", err)
+		}
+	}
+	return mailbox, nil
+}
+
+func (m *Mailbox) Root() string        { return m.root }
+func (m *Mailbox) InboundDir() string  { return m.inbound }
+func (m *Mailbox) OutboundDir() string { return m.outbound }
+func (m *Mailbox) ArchiveDir() string  { return m.archive }
+
+func (m *Mailbox) DropInbound(name string, contents []byte) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", errors.New("file name is required")
+	}
+	cleaned := filepath.Base(name)
+	target := filepath.Join(m.inbound, cleaned)
+	if err := os.WriteFile(target, contents, 0o600); err != nil {
+		return "", fmt.Errorf("write inbound file: %w", err)
+	}
+	return target, nil
+}
+
+type MailboxFile struct {
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	Contents string `json:"contents"`
+}
+
+func (m *Mailbox) ListDir(dir string) ([]MailboxFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]MailboxFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			continue
+		}
+		contents, _ := os.ReadFile(filepath.Join(dir, entry.Name()))
+		files = append(files, MailboxFile{
+			Name:     entry.Name(),
+			Size:     info.Size(),
+			Contents: string(contents),
+		})
+	}
+	return files, nil
+}
+
+// resolve maps a client-supplied slash path onto the mailbox root. Paths are
+// cleaned against "/" first so a client cannot climb out of the mailbox.
+func (m *Mailbox) resolve(name string) string {
+	cleaned := filepath.Clean("/" + strings.ReplaceAll(name, "\\", "/"))
+	return filepath.Join(m.root, filepath.FromSlash(cleaned))
+}
@@ -0,0 +1,422 @@
+package sim
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // S3 ETags are MD5 digests by definition.
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3XMLNamespace  = "http://s3.amazonaws.com/doc/2006-03-01/"
+	s3TimeFormat    = "2006-01-02T15:04:05.000Z"
+	s3DefaultBucket = "edi-mailbox"
+)
+
+type S3Options struct {
+	Listen          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Bucket          string
+	RootDir         string
+	Logger          *slog.Logger
+}
+
+type S3Server struct {
+	options  S3Options
+	logger   *slog.Logger
+	listener net.Listener
+	server   *http.Server
+
+	*Mailbox
+}
+
+// NewS3Server starts a path-style S3-compatible endpoint exposing the mailbox as a
+// single bucket: object keys are paths below the mailbox root, so the drop zones
+// are the inbound/, outbound/ and archive/ prefixes. It implements the object
+// calls a mailbox transport makes (list v2, get, head, put, copy, delete) and
+// checks the request's access key ID but does not verify the SigV4 signature.
+//
+//nolint:gocritic // Options is a constructor value struct by design.
+func NewS3Server(options S3Options) (*S3Server, error) {
+	if strings.TrimSpace(options.Bucket) == "" {
+		options.Bucket = s3DefaultBucket
+	}
+	mailbox, err := NewMailbox(options.RootDir, "edi-partner-sim-s3-")
+	if err != nil {
+		return nil, err
+	}
+	listenConfig := &net.ListenConfig{}
+	listener, err := listenConfig.Listen(context.Background(), "tcp", options.Listen)
+	if err != nil {
+		return nil, fmt.Errorf("listen for s3: %w", err)
+	}
+	server := &S3Server{
+		options:  options,
+		logger:   options.Logger,
+		listener: listener,
+		Mailbox:  mailbox,
+	}
+	server.server = &http.Server{
+		Handler:           http.HandlerFunc(server.handle),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server, nil
+}
+
+func (s *S3Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *S3Server) Bucket() string {
+	return s.options.Bucket
+}
+
+// Prefix returns the object key prefix for one of the mailbox directories.
+func (s *S3Server) Prefix(dir string) string {
+	relative, err := filepath.Rel(s.Root(), dir)
+	if err != nil {
+		return ""
+	}
+	return filepath.ToSlash(relative) + "/"
+}
+
+func (s *S3Server) Serve() error {
+	if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *S3Server) Close() error {
+	return s.server.Close()
+}
+
+type s3Error struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+type s3ListResult struct {
+	XMLName        xml.Name         `xml:"ListBucketResult"`
+	Xmlns          string           `xml:"xmlns,attr"`
+	Name           string           `xml:"Name"`
+	Prefix         string           `xml:"Prefix"`
+	Delimiter      string           `xml:"Delimiter,omitempty"`
+	EncodingType   string           `xml:"EncodingType,omitempty"`
+	KeyCount       int              `xml:"KeyCount"`
+	MaxKeys        int              `xml:"MaxKeys"`
+	IsTruncated    bool             `xml:"IsTruncated"`
+	Contents       []s3ObjectEntry  `xml:"Contents"`
+	CommonPrefixes []s3CommonPrefix `xml:"CommonPrefixes"`
+}
+
+type s3ObjectEntry struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type s3CommonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type s3CopyResult struct {
+	XMLName      xml.Name `xml:"CopyObjectResult"`
+	LastModified string   `xml:"LastModified"`
+	ETag         string   `xml:"ETag"`
+}
+
+func (s *S3Server) handle(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if !s.authorized(r) {
+		s.writeError(w, http.StatusForbidden, "InvalidAccessKeyId", "unknown access key", r.URL.Path)
+		return
+	}
+	if bucket != s.options.Bucket {
+		s.writeError(w, http.StatusNotFound, "NoSuchBucket", "bucket does not exist", bucket)
+		return
+	}
+	if key == "" {
+		s.handleBucket(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.handleGetObject(w, r, key)
+	case http.MethodPut:
+		if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+			s.handleCopyObject(w, key, source)
+			return
+		}
+		s.handlePutObject(w, r, key)
+	case http.MethodDelete:
+		if err := os.Remove(s.resolve(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeError(w, http.StatusNotImplemented, "NotImplemented", "unsupported call", key)
+	}
+}
+
+// authorized accepts SigV4 requests issued with the configured access key ID.
+func (s *S3Server) authorized(r *http.Request) bool {
+	authorization := r.Header.Get("Authorization")
+	return strings.Contains(authorization, "Credential="+s.options.AccessKeyID+"/")
+}
+
+func (s *S3Server) handleBucket(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && query.Has("location"):
+		s.writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"LocationConstraint"`
+			Xmlns   string   `xml:"xmlns,attr"`
+		}{Xmlns: s3XMLNamespace})
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		s.handleListObjects(w, query)
+	default:
+		s.writeError(w, http.StatusNotImplemented, "NotImplemented", "unsupported call", "")
+	}
+}
+
+func (s *S3Server) handleListObjects(w http.ResponseWriter, query url.Values) {
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	result := s3ListResult{
+		Xmlns:     s3XMLNamespace,
+		Name:      s.options.Bucket,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   1000,
+	}
+	seenPrefixes := make(map[string]struct{})
+	err := filepath.WalkDir(s.Root(), func(current string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil || entry.IsDir() {
+			return walkErr
+		}
+		relative, relErr := filepath.Rel(s.Root(), current)
+		if relErr != nil {
+			return relErr
+		}
+		key := filepath.ToSlash(relative)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		if delimiter != "" {
+			if index := strings.Index(key[len(prefix):], delimiter); index >= 0 {
+				common := key[:len(prefix)+index+len(delimiter)]
+				if _, seen := seenPrefixes[common]; !seen {
+					seenPrefixes[common] = struct{}{}
+					result.CommonPrefixes = append(
+						result.CommonPrefixes,
+						s3CommonPrefix{Prefix: common},
+					)
+				}
+				return nil
+			}
+		}
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			return infoErr
+		}
+		etag, etagErr := fileETag(current)
+		if etagErr != nil {
+			return etagErr
+		}
+		result.Contents = append(result.Contents, s3ObjectEntry{
+			Key:          key,
+			LastModified: info.ModTime().UTC().Format(s3TimeFormat),
+			ETag:         etag,
+			Size:         info.Size(),
+			StorageClass: "STANDARD",
+		})
+		return nil
+	})
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), prefix)
+		return
+	}
+	slices.SortFunc(result.Contents, func(a, b s3ObjectEntry) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	if query.Get("encoding-type") == "url" {
+		result.EncodingType = "url"
+		for index := range result.Contents {
+			result.Contents[index].Key = url.QueryEscape(result.Contents[index].Key)
+		}
+		for index := range result.CommonPrefixes {
+			result.CommonPrefixes[index].Prefix = url.QueryEscape(
+				result.CommonPrefixes[index].Prefix,
+			)
+		}
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+	s.writeXML(w, http.StatusOK, result)
+}
+
+func (s *S3Server) handleGetObject(w http.ResponseWriter, r *http.Request, key string) {
+	target := s.resolve(key)
+	info, err := os.Stat(target)
+	if err != nil || info.IsDir() {
+		s.writeError(w, http.StatusNotFound, "NoSuchKey", "object does not exist", key)
+		return
+	}
+	file, err := os.Open(target)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+		return
+	}
+	defer file.Close()
+	etag, err := fileETag(target)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
+
+func (s *S3Server) handlePutObject(w http.ResponseWriter, r *http.Request, key string) {
+	target := s.resolve(key)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBody))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error(), key)
+		return
+	}
+	if isAWSChunked(r) {
+		if body, err = decodeAWSChunked(body); err != nil {
+			s.writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error(), key)
+			return
+		}
+	}
+	if err = os.WriteFile(target, body, 0o600); err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+		return
+	}
+	s.logger.Info("s3 object stored", "key", key, "size", len(body))
+	w.Header().Set("ETag", contentETag(body))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *S3Server) handleCopyObject(w http.ResponseWriter, key, source string) {
+	decoded, err := url.PathUnescape(source)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "InvalidArgument", "invalid copy source", key)
+		return
+	}
+	decoded, _, _ = strings.Cut(strings.TrimPrefix(decoded, "/"), "?")
+	sourceBucket, sourceKey, _ := strings.Cut(decoded, "/")
+	if sourceBucket != s.options.Bucket || sourceKey == "" {
+		s.writeError(w, http.StatusNotFound, "NoSuchKey", "copy source does not exist", source)
+		return
+	}
+	contents, err := os.ReadFile(s.resolve(sourceKey))
+	if err != nil {
+		s.writeError(w, http.StatusNotFound, "NoSuchKey", "copy source does not exist", source)
+		return
+	}
+	target := s.resolve(key)
+	if err = os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+		return
+	}
+	if err = os.WriteFile(target, contents, 0o600); err != nil {
+		s.writeError(w, http.StatusInternalServerError, "InternalError", err.Error(), key)
+		return
+	}
+	s.writeXML(w, http.StatusOK, s3CopyResult{
+		LastModified: time.Now().UTC().Format(s3TimeFormat),
+		ETag:         contentETag(contents),
+	})
+}
+
+func (s *S3Server) writeXML(w http.ResponseWriter, status int, payload any) {
+	encoded, err := xml.Marshal(payload)
+	if err != nil {
+		s.logger.Warn("failed to encode s3 response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	if _, err = w.Write(encoded); err != nil {
+		s.logger.Warn("failed to write s3 response", "error", err)
+	}
+}
+
+func (s *S3Server) writeError(w http.ResponseWriter, status int, code, message, resource string) {
+	s.writeXML(w, status, s3Error{Code: code, Message: message, Resource: resource})
+}
+
+// isAWSChunked reports whether the client streamed the payload with SigV4
+// chunk signatures, which SDKs do by default for uploads over plain HTTP.
+func isAWSChunked(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") ||
+		strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked")
+}
+
+// decodeAWSChunked strips the "<hex-size>;chunk-signature=...\r\n" framing
+// from a streamed upload. Chunk signatures are not verified; the simulator
+// only checks which access key signed the request.
+func decodeAWSChunked(body []byte) ([]byte, error) {
+	decoded := make([]byte, 0, len(body))
+	for {
+		header, rest, found := bytes.Cut(body, []byte("\r\n"))
+		if !found {
+			return nil, errors.New("malformed aws-chunked body")
+		}
+		sizeField, _, _ := bytes.Cut(header, []byte(";"))
+		size, err := strconv.ParseInt(string(sizeField), 16, 64)
+		if err != nil || size < 0 || size > int64(len(rest)) {
+			return nil, errors.New("malformed aws-chunked chunk size")
+		}
+		if size == 0 {
+			return decoded, nil
+		}
+		decoded = append(decoded, rest[:size]...)
+		body = bytes.TrimPrefix(rest[size:], []byte("\r\n"))
+	}
+}
+
+func fileETag(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return contentETag(contents), nil
+}
+
+func contentETag(contents []byte) string {
+	sum := md5.Sum(contents) //nolint:gosec // S3 ETags are MD5 digests by definition.
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
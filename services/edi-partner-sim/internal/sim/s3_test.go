@@ -0,0 +1,156 @@
+package sim
+
+import (
+	"encoding/xml"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testS3Authorization = "AWS4-HMAC-SHA256 Credential=trenova/20260101/us-east-1/s3/aws4_request"
+
+func TestS3ServerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewS3Server(S3Options{
+		Listen:          "127.0.0.1:0",
+		AccessKeyID:     "trenova",
+		SecretAccessKey: "secret",
+		Logger:          slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("new s3 server: %v", err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	go func() { _ = server.Serve() }()
+
+	if _, err := server.DropInbound("tender.edi", []byte("ISA*...~")); err != nil {
+		t.Fatalf("drop inbound: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(server.InboundDir(), "processed"), 0o755); err != nil {
+		t.Fatalf("create nested prefix: %v", err)
+	}
+	if err := os.WriteFile(
+		filepath.Join(server.InboundDir(), "processed", "old.edi"),
+		[]byte("old"),
+		0o600,
+	); err != nil {
+		t.Fatalf("write nested object: %v", err)
+	}
+	bucketURL := "http://" + server.Addr() + "/" + server.Bucket()
+
+	// A delimited listing returns the dropped file and rolls nested keys up into
+	// a common prefix.
+	response := doS3Request(t, http.MethodGet, bucketURL+
+		"?list-type=2&delimiter=%2F&prefix="+server.Prefix(server.InboundDir()), nil, nil)
+	var listing s3ListResult
+	if err := xml.NewDecoder(response.Body).Decode(&listing); err != nil {
+		t.Fatalf("decode listing: %v", err)
+	}
+	_ = response.Body.Close()
+	if len(listing.Contents) != 1 || listing.Contents[0].Key != "inbound/tender.edi" {
+		t.Fatalf("unexpected inbound listing: %+v", listing.Contents)
+	}
+	if len(listing.CommonPrefixes) != 1 ||
+		listing.CommonPrefixes[0].Prefix != "inbound/processed/" {
+		t.Fatalf("unexpected common prefixes: %+v", listing.CommonPrefixes)
+	}
+
+	response = doS3Request(t, http.MethodGet, bucketURL+"/inbound/tender.edi", nil, nil)
+	contents, _ := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if string(contents) != "ISA*...~" || response.Header.Get("ETag") == "" {
+		t.Fatalf("unexpected object: %q etag=%q", contents, response.Header.Get("ETag"))
+	}
+
+	// Streaming SigV4 uploads arrive chunk-framed and are stored decoded.
+	chunked := "f;chunk-signature=abc\r\nISA*...~ST*204~\r\n0;chunk-signature=def\r\n\r\n"
+	response = doS3Request(t, http.MethodPut, bucketURL+"/outbound/outbound-204.x12",
+		strings.NewReader(chunked), map[string]string{
+			"X-Amz-Content-Sha256": "STREAMING-AWS4-HMAC-SHA256-PAYLOAD",
+		})
+	_ = response.Body.Close()
+	written, err := os.ReadFile(filepath.Join(server.OutboundDir(), "outbound-204.x12"))
+	if err != nil {
+		t.Fatalf("read written outbound object: %v", err)
+	}
+	if string(written) != "ISA*...~ST*204~" {
+		t.Fatalf("unexpected outbound contents: %q", written)
+	}
+
+	// Archive the way the S3 transport does: copy, then delete the original.
+	response = doS3Request(t, http.MethodPut, bucketURL+"/archive/tender.edi", nil,
+		map[string]string{"X-Amz-Copy-Source": "/" + server.Bucket() + "/inbound/tender.edi"})
+	_ = response.Body.Close()
+	response = doS3Request(t, http.MethodDelete, bucketURL+"/inbound/tender.edi", nil, nil)
+	_ = response.Body.Close()
+	if _, err := os.Stat(filepath.Join(server.ArchiveDir(), "tender.edi")); err != nil {
+		t.Fatalf("archived object missing: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(server.InboundDir(), "tender.edi")); !os.IsNotExist(err) {
+		t.Fatalf("inbound object was not removed: %v", err)
+	}
+}
+
+func TestS3ServerRejectsUnknownAccessKey(t *testing.T) {
+	t.Parallel()
+
+	server, err := NewS3Server(S3Options{
+		Listen:      "127.0.0.1:0",
+		AccessKeyID: "trenova",
+		Logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatalf("new s3 server: %v", err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+	go func() { _ = server.Serve() }()
+
+	request, err := http.NewRequest(
+		http.MethodHead,
+		"http://"+server.Addr()+"/"+server.Bucket(),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	request.Header.Set("Authorization", strings.Replace(testS3Authorization, "trenova", "other", 1))
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("head bucket: %v", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", response.StatusCode)
+	}
+}
+
+func doS3Request(
+	t *testing.T,
+	method, target string,
+	body io.Reader,
+	headers map[string]string,
+) *http.Response {
+	t.Helper()
+
+	request, err := http.NewRequest(method, target, body)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	request.Header.Set("Authorization", testS3Authorization)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, target, err)
+	}
+	if response.StatusCode >= http.StatusMultipleChoices {
+		t.Fatalf("%s %s: unexpected status %d", method, target, response.StatusCode)
+	}
+	return response
+}
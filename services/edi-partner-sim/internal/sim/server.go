@@ -29,8 +29,11 @@ type Options struct {
 	RemoteAS2ID     string
 	TrenovaInbound  string
 	AutoAcknowledge bool
+	AutoRespond     bool
 	IdentityDir     string
 	SFTP            *SFTPServer
+	FTPS            *FTPSServer
+	S3              *S3Server
 	Logger          *slog.Logger
 }
 
@@ -62,6 +65,8 @@ type Server struct {
 	client   *http.Client
 
 	sftp *SFTPServer
+	ftps *FTPSServer
+	s3   *S3Server
 
 	mu             sync.RWMutex
 	as2ID          string
@@ -69,6 +74,7 @@ type Server struct {
 	partnerCert    *x509.Certificate
 	trenovaInbound string
 	autoAck        bool
+	autoRespond    bool
 	received       []*ReceivedDocument
 	sent           []*SentRecord
 
@@ -90,10 +96,13 @@ func NewServer(options Options) (*Server, error) {
 		logger:         options.Logger,
 		client:         &http.Client{Timeout: sendTimeout},
 		sftp:           options.SFTP,
+		ftps:           options.FTPS,
+		s3:             options.S3,
 		as2ID:          options.AS2ID,
 		remoteAS2ID:    options.RemoteAS2ID,
 		trenovaInbound: options.TrenovaInbound,
 		autoAck:        options.AutoAcknowledge,
+		autoRespond:    options.AutoRespond,
 		received:       make([]*ReceivedDocument, 0),
 		sent:           make([]*SentRecord, 0),
 	}
@@ -113,9 +122,13 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("POST /control/send-tender", s.handleSendTender)
 	mux.HandleFunc("POST /control/reset", s.handleReset)
 	mux.HandleFunc("GET /control/sftp", s.handleSFTPInfo)
-	mux.HandleFunc("POST /control/sftp/drop", s.handleSFTPDrop)
-	mux.HandleFunc("GET /control/sftp/outbound", s.handleSFTPOutbound)
-	mux.HandleFunc("GET /control/sftp/inbound", s.handleSFTPInbound)
+	mux.HandleFunc("GET /control/ftps", s.handleFTPSInfo)
+	mux.HandleFunc("GET /control/s3", s.handleS3Info)
+	for name, mailbox := range s.mailboxes() {
+		mux.HandleFunc("POST /control/"+name+"/drop", s.handleMailboxDrop(name, mailbox))
+		mux.HandleFunc("GET /control/"+name+"/outbound", s.handleMailboxOutbound(name, mailbox))
+		mux.HandleFunc("GET /control/"+name+"/inbound", s.handleMailboxInbound(name, mailbox))
+	}
 	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
@@ -136,6 +149,7 @@ type configurePartnerRequest struct {
 	CertificatePEM string `json:"certificatePem"`
 	InboundURL     string `json:"inboundUrl"`
 	AutoAck        *bool  `json:"autoAck"`
+	AutoRespond    *bool  `json:"autoRespond"`
 	AS2ID          string `json:"as2Id"`
 	RemoteAS2ID    string `json:"remoteAs2Id"`
 }
@@ -162,6 +176,9 @@ func (s *Server) handleConfigurePartner(w http.ResponseWriter, r *http.Request)
 	if req.AutoAck != nil {
 		s.autoAck = *req.AutoAck
 	}
+	if req.AutoRespond != nil {
+		s.autoRespond = *req.AutoRespond
+	}
 	if strings.TrimSpace(req.AS2ID) != "" {
 		s.as2ID = strings.TrimSpace(req.AS2ID)
 	}
@@ -172,6 +189,7 @@ func (s *Server) handleConfigurePartner(w http.ResponseWriter, r *http.Request)
 		"hasCertificate", s.partnerCert != nil,
 		"inboundUrl", s.trenovaInbound,
 		"autoAck", s.autoAck,
+		"autoRespond", s.autoRespond,
 	)
 	s.writeJSON(w, http.StatusOK, map[string]any{"status": "ok"})
 }
@@ -244,6 +262,7 @@ func (s *Server) handleAS2(w http.ResponseWriter, r *http.Request) {
 	s.mu.Lock()
 	s.received = append(s.received, document)
 	autoAck := s.autoAck
+	autoRespond := s.autoRespond
 	s.mu.Unlock()
 
 	mdn, err := as2.BuildMDN(&as2.BuildMDNOptions{
@@ -264,6 +283,10 @@ func (s *Server) handleAS2(w http.ResponseWriter, r *http.Request) {
 		document.Envelope.TransactionSet != "997" && document.Envelope.TransactionSet != "999" {
 		go s.sendFunctionalAck(document)
 	}
+	if parseErr == nil && autoRespond && document.Envelope != nil &&
+		document.Envelope.TransactionSet == "204" {
+		go s.respondToLoadTender(document)
+	}
 
 	for key, values := range mdn.Headers {
 		for _, value := range values {
@@ -302,6 +325,88 @@ func (s *Server) sendFunctionalAck(document *ReceivedDocument) {
 	)
 }
 
+// certificationStatusSequence is the 214 sequence a carrier sends while a
+// certification load moves from pickup to delivery.
+var certificationStatusSequence = []struct {
+	code  string
+	city  string
+	state string
+}{
+	{code: "X3", city: "Chicago", state: "IL"},
+	{code: "AF", city: "Chicago", state: "IL"},
+	{code: "X1", city: "Dallas", state: "TX"},
+	{code: "D1", city: "Dallas", state: "TX"},
+}
+
+type outboundDocument struct {
+	transactionSet string
+	payload        string
+}
+
+// respondToLoadTender plays the carrier side of a certification run: it
+// accepts the tender with a 990 and then reports the load's progress with a
+// 214 for each status in certificationStatusSequence.
+func (s *Server) respondToLoadTender(document *ReceivedDocument) {
+	s.mu.RLock()
+	senderID := s.as2ID
+	receiverID := s.remoteAS2ID
+	s.mu.RUnlock()
+	scac, shipmentRef := LoadTenderReference(document.Payload)
+	if scac == "" {
+		scac = "SIML"
+	}
+
+	payloads := []outboundDocument{
+		{
+			transactionSet: "990",
+			payload: BuildTenderResponse990(BuildTenderResponseInput{
+				SenderID:      senderID,
+				ReceiverID:    receiverID,
+				ControlNumber: s.controlNumber.Add(1),
+				SCAC:          scac,
+				ShipmentRef:   shipmentRef,
+				ResponseCode:  "A",
+			}),
+		},
+	}
+	eventAt := time.Now().UTC()
+	for index, status := range certificationStatusSequence {
+		payloads = append(payloads, outboundDocument{
+			transactionSet: "214",
+			payload: BuildShipmentStatus214(BuildShipmentStatusInput{
+				SenderID:      senderID,
+				ReceiverID:    receiverID,
+				ControlNumber: s.controlNumber.Add(1),
+				SCAC:          scac,
+				ShipmentRef:   shipmentRef,
+				StatusCode:    status.code,
+				City:          status.city,
+				State:         status.state,
+				EventAt:       eventAt.Add(time.Duration(index) * time.Hour),
+			}),
+		})
+	}
+
+	for _, outbound := range payloads {
+		record, err := s.sendToTrenova(outbound.payload, outbound.transactionSet)
+		if err != nil {
+			s.logger.Error("failed to deliver load tender response",
+				"transactionSet", outbound.transactionSet,
+				"shipmentRef", shipmentRef,
+				"error", err,
+			)
+			return
+		}
+		s.mu.Lock()
+		s.sent = append(s.sent, record)
+		s.mu.Unlock()
+	}
+	s.logger.Info("load tender responses delivered",
+		"shipmentRef", shipmentRef,
+		"statusCount", len(certificationStatusSequence),
+	)
+}
+
 type sendRequest struct {
 	Payload  string `json:"payload"`
 	FileName string `json:"fileName"`
@@ -483,19 +588,12 @@ func (s *Server) handleReset(w http.ResponseWriter, _ *http.Request) {
 	s.writeJSON(w, http.StatusOK, map[string]any{"status": "ok"})
 }
 
-func (s *Server) handleSFTPInfo(w http.ResponseWriter, r *http.Request) {
+func (s *Server) handleSFTPInfo(w http.ResponseWriter, _ *http.Request) {
 	if s.sftp == nil {
 		s.writeError(w, http.StatusNotFound, "SFTP is not enabled on this simulator")
 		return
 	}
-	host, port, err := net.SplitHostPort(s.sftp.Addr())
-	if err != nil {
-		host = "localhost"
-		port = ""
-	}
-	if host == "::" || host == "0.0.0.0" || host == "" {
-		host = "localhost"
-	}
+	host, port := localHostPort(s.sftp.Addr())
 	s.writeJSON(w, http.StatusOK, map[string]any{
 		"host":              host,
 		"port":              port,
@@ -506,69 +604,145 @@ func (s *Server) handleSFTPInfo(w http.ResponseWriter, r *http.Request) {
 		"outboundDirectory": s.sftp.OutboundDir(),
 		"archiveDirectory":  s.sftp.ArchiveDir(),
 	})
-	_ = r
-}
-
-type sftpDropRequest struct {
-	FileName string `json:"fileName"`
-	Payload  string `json:"payload"`
 }
 
-func (s *Server) handleSFTPDrop(w http.ResponseWriter, r *http.Request) {
-	if s.sftp == nil {
-		s.writeError(w, http.StatusNotFound, "SFTP is not enabled on this simulator")
-		return
-	}
-	req := new(sftpDropRequest)
-	if err := decodeJSON(r.Body, req); err != nil {
-		s.writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
-		return
+// mailboxes returns the drop zones by control-path name; a disabled mailbox maps
+// to nil so its control endpoints answer 404 instead of disappearing.
+func (s *Server) mailboxes() map[string]*Mailbox {
+	mailboxes := map[string]*Mailbox{"sftp": nil, "ftps": nil, "s3": nil}
+	if s.sftp != nil {
+		mailboxes["sftp"] = s.sftp.Mailbox
 	}
-	if strings.TrimSpace(req.Payload) == "" {
-		s.writeError(w, http.StatusBadRequest, "payload is required")
-		return
+	if s.ftps != nil {
+		mailboxes["ftps"] = s.ftps.Mailbox
 	}
-	name := strings.TrimSpace(req.FileName)
-	if name == "" {
-		name = fmt.Sprintf("sim-%d.edi", s.controlNumber.Add(1))
+	if s.s3 != nil {
+		mailboxes["s3"] = s.s3.Mailbox
 	}
-	path, err := s.sftp.DropInbound(name, []byte(req.Payload))
-	if err != nil {
-		s.writeError(w, http.StatusInternalServerError, err.Error())
+	return mailboxes
+}
+
+func (s *Server) handleFTPSInfo(w http.ResponseWriter, _ *http.Request) {
+	if s.ftps == nil {
+		s.writeError(w, http.StatusNotFound, "FTPS is not enabled on this simulator")
 		return
 	}
-	s.writeJSON(w, http.StatusOK, map[string]any{"path": path, "fileName": name})
+	host, port := localHostPort(s.ftps.Addr())
+	s.writeJSON(w, http.StatusOK, map[string]any{
+		"host":              host,
+		"port":              port,
+		"username":          s.ftps.options.Username,
+		"password":          s.ftps.options.Password,
+		"tlsCertificate":    s.ftps.CertificatePEM(),
+		"inboundDirectory":  s.ftps.RemoteDir(s.ftps.InboundDir()),
+		"outboundDirectory": s.ftps.RemoteDir(s.ftps.OutboundDir()),
+		"archiveDirectory":  s.ftps.RemoteDir(s.ftps.ArchiveDir()),
+	})
 }
 
-func (s *Server) handleSFTPOutbound(w http.ResponseWriter, _ *http.Request) {
-	if s.sftp == nil {
-		s.writeError(w, http.StatusNotFound, "SFTP is not enabled on this simulator")
+func (s *Server) handleS3Info(w http.ResponseWriter, _ *http.Request) {
+	if s.s3 == nil {
+		s.writeError(w, http.StatusNotFound, "S3 is not enabled on this simulator")
 		return
 	}
-	files, err := s.sftp.ListDir(s.sftp.OutboundDir())
+	host, port := localHostPort(s.s3.Addr())
+	s.writeJSON(w, http.StatusOK, map[string]any{
+		"endpoint":          "http://" + net.JoinHostPort(host, port),
+		"bucket":            s.s3.Bucket(),
+		"region":            "us-east-1",
+		"accessKeyId":       s.s3.options.AccessKeyID,
+		"secretAccessKey":   s.s3.options.SecretAccessKey,
+		"inboundDirectory":  s.s3.Prefix(s.s3.InboundDir()),
+		"outboundDirectory": s.s3.Prefix(s.s3.OutboundDir()),
+		"archiveDirectory":  s.s3.Prefix(s.s3.ArchiveDir()),
+	})
+}
+
+func localHostPort(address string) (host, port string) {
+	host, port, err := net.SplitHostPort(address)
 	if err != nil {
-		s.writeError(w, http.StatusInternalServerError, err.Error())
-		return
+		return "localhost", ""
 	}
-	s.writeJSON(w, http.StatusOK, map[string]any{"files": files})
+	if host == "::" || host == "0.0.0.0" || host == "" {
+		host = "localhost"
+	}
+	return host, port
 }
 
-func (s *Server) handleSFTPInbound(w http.ResponseWriter, _ *http.Request) {
-	if s.sftp == nil {
-		s.writeError(w, http.StatusNotFound, "SFTP is not enabled on this simulator")
-		return
+type mailboxDropRequest struct {
+	FileName string `json:"fileName"`
+	Payload  string `json:"payload"`
+}
+
+func (s *Server) handleMailboxDrop(name string, mailbox *Mailbox) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if mailbox == nil {
+			s.writeMailboxDisabled(w, name)
+			return
+		}
+		req := new(mailboxDropRequest)
+		if err := decodeJSON(r.Body, req); err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return
+		}
+		if strings.TrimSpace(req.Payload) == "" {
+			s.writeError(w, http.StatusBadRequest, "payload is required")
+			return
+		}
+		fileName := strings.TrimSpace(req.FileName)
+		if fileName == "" {
+			fileName = fmt.Sprintf("sim-%d.edi", s.controlNumber.Add(1))
+		}
+		path, err := mailbox.DropInbound(fileName, []byte(req.Payload))
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		s.writeJSON(w, http.StatusOK, map[string]any{"path": path, "fileName": fileName})
 	}
-	inbound, err := s.sftp.ListDir(s.sftp.InboundDir())
-	if err != nil {
-		s.writeError(w, http.StatusInternalServerError, err.Error())
-		return
+}
+
+func (s *Server) handleMailboxOutbound(name string, mailbox *Mailbox) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if mailbox == nil {
+			s.writeMailboxDisabled(w, name)
+			return
+		}
+		files, err := mailbox.ListDir(mailbox.OutboundDir())
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		s.writeJSON(w, http.StatusOK, map[string]any{"files": files})
 	}
-	archive, err := s.sftp.ListDir(s.sftp.ArchiveDir())
-	if err != nil {
-		s.writeError(w, http.StatusInternalServerError, err.Error())
-		return
+}
+
+func (s *Server) handleMailboxInbound(name string, mailbox *Mailbox) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if mailbox == nil {
+			s.writeMailboxDisabled(w, name)
+			return
+		}
+		inbound, err := mailbox.ListDir(mailbox.InboundDir())
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		archive, err := mailbox.ListDir(mailbox.ArchiveDir())
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		s.writeJSON(w, http.StatusOK, map[string]any{"inbound": inbound, "archive": archive})
 	}
-	s.writeJSON(w, http.StatusOK, map[string]any{"inbound": inbound, "archive": archive})
+}
+
+func (s *Server) writeMailboxDisabled(w http.ResponseWriter, name string) {
+	s.writeError(
+		w,
+		http.StatusNotFound,
+		strings.ToUpper(name)+" is not enabled on this simulator",
+	)
 }
 
 func (s *Server) writeJSON(w http.ResponseWriter, status int, payload any) {
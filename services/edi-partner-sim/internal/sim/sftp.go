@@ -35,12 +35,9 @@ type SFTPServer struct {
 	listener      net.Listener
 	signer        ssh.Signer
 	hostPublicKey string
+	closeOnce     sync.Once
 
-	root      string
-	inbound   string
-	outbound  string
-	archive   string
-	closeOnce sync.Once
+	*Mailbox
 }
 
 // NewSFTPServer starts an SSH+SFTP server that mimics a partner mailbox: Trenova
@@ -49,26 +46,14 @@ type SFTPServer struct {
 //
 //nolint:gocritic // Options is a constructor value struct by design.
 func NewSFTPServer(options SFTPOptions) (*SFTPServer, error) {
-	root := options.RootDir
-	if root == "" {
-		created, err := os.MkdirTemp("", "edi-partner-sim-sftp-")
-		if err != nil {
-			return nil, fmt.Errorf("create sftp root: %w", err)
-		}
-		root = created
+	mailbox, err := NewMailbox(options.RootDir, "edi-partner-sim-sftp-")
+	if err != nil {
+		return nil, err
 	}
 	server := &SFTPServer{
-		options:  options,
-		logger:   options.Logger,
-		root:     root,
-		inbound:  filepath.Join(root, "inbound"),
-		outbound: filepath.Join(root, "outbound"),
-		archive:  filepath.Join(root, "archive"),
-	}
-	for _, dir := range []string{server.inbound, server.outbound, server.archive} {
-		if err := os.MkdirAll(dir, 0o755); err != nil {
-			return nil, fmt.Errorf("create mailbox directory: %w", err)
-		}
+		options: options,
+		logger:  options.Logger,
+		Mailbox: mailbox,
 	}
 
 	signer, publicKey, err := loadOrCreateHostKey(options.IdentityDir)
@@ -97,52 +82,6 @@ func (s *SFTPServer) HostAuthorizedKey() string {
 	return s.hostPublicKey
 }
 
-func (s *SFTPServer) InboundDir() string  { return s.inbound }
-func (s *SFTPServer) OutboundDir() string { return s.outbound }
-func (s *SFTPServer) ArchiveDir() string  { return s.archive }
-
-func (s *SFTPServer) DropInbound(name string, contents []byte) (string, error) {
-	if strings.TrimSpace(name) == "" {
-		return "", errors.New("file name is required")
-	}
-	cleaned := filepath.Base(name)
-	target := filepath.Join(s.inbound, cleaned)
-	if err := os.WriteFile(target, contents, 0o600); err != nil {
-		return "", fmt.Errorf("write inbound file: %w", err)
-	}
-	return target, nil
-}
-
-type MailboxFile struct {
-	Name     string `json:"name"`
-	Size     int64  `json:"size"`
-	Contents string `json:"contents"`
-}
-
-func (s *SFTPServer) ListDir(dir string) ([]MailboxFile, error) {
-	entries, err := os.ReadDir(dir)
-	if err != nil {
-		return nil, err
-	}
-	files := make([]MailboxFile, 0, len(entries))
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		info, infoErr := entry.Info()
-		if infoErr != nil {
-			continue
-		}
-		contents, _ := os.ReadFile(filepath.Join(dir, entry.Name()))
-		files = append(files, MailboxFile{
-			Name:     entry.Name(),
-			Size:     info.Size(),
-			Contents: string(contents),
-		})
-	}
-	return files, nil
-}
-
 func (s *SFTPServer) Serve() error {
 	config := &ssh.ServerConfig{
 		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
@@ -144,3 +144,110 @@ func BuildLoadTender204(input BuildLoadTenderInput) string {
 	}
 	return strings.Join(segments, "~") + "~"
 }
+
+// LoadTenderReference extracts the carrier SCAC and shipment reference from a
+// received 204. Standard 204s leave B2-01 empty and carry the SCAC and
+// shipment in B2-02 and B2-04; Trenova's template shifts both one position
+// left, which a populated B2-01 identifies.
+func LoadTenderReference(raw string) (scac, shipmentRef string) {
+	trimmed := strings.TrimSpace(raw)
+	if len(trimmed) < 106 {
+		return "", ""
+	}
+	elementSeparator := string(trimmed[3])
+	for _, segment := range strings.Split(trimmed, string(trimmed[105])) {
+		elements := strings.Split(strings.TrimSpace(segment), elementSeparator)
+		if elements[0] != "B2" {
+			continue
+		}
+		element := func(position int) string {
+			if position < len(elements) {
+				return strings.TrimSpace(elements[position])
+			}
+			return ""
+		}
+		if element(1) == "" {
+			return element(2), element(4)
+		}
+		return element(1), element(2)
+	}
+	return "", ""
+}
+
+type BuildTenderResponseInput struct {
+	SenderID      string
+	ReceiverID    string
+	ControlNumber int64
+	SCAC          string
+	ShipmentRef   string
+	ResponseCode  string
+}
+
+func BuildTenderResponse990(input BuildTenderResponseInput) string {
+	now := time.Now().UTC()
+	responseCode := input.ResponseCode
+	if responseCode == "" {
+		responseCode = "A"
+	}
+	isaControl := fmt.Sprintf("%09d", input.ControlNumber)
+	control := strconv.FormatInt(input.ControlNumber, 10)
+	segments := []string{
+		"ISA*00*          *00*          *ZZ*" + padISA(input.SenderID) +
+			"*ZZ*" + padISA(input.ReceiverID) +
+			"*" + now.Format("060102") + "*" + now.Format("1504") +
+			"*^*00401*" + isaControl + "*0*T*>",
+		"GS*GF*" + input.SenderID + "*" + input.ReceiverID +
+			"*" + now.Format("20060102") + "*" + now.Format("1504") +
+			"*" + control + "*X*004010",
+		"ST*990*0001",
+		"B1*" + input.SCAC + "*" + input.ShipmentRef + "*" + now.Format("20060102") +
+			"*" + responseCode,
+		"L11*" + input.ShipmentRef + "*CN",
+		"SE*4*0001",
+		"GE*1*" + control,
+		"IEA*1*" + isaControl,
+	}
+	return strings.Join(segments, "~") + "~"
+}
+
+type BuildShipmentStatusInput struct {
+	SenderID      string
+	ReceiverID    string
+	ControlNumber int64
+	SCAC          string
+	ShipmentRef   string
+	StatusCode    string
+	City          string
+	State         string
+	EventAt       time.Time
+}
+
+func BuildShipmentStatus214(input BuildShipmentStatusInput) string {
+	now := time.Now().UTC()
+	eventAt := input.EventAt
+	if eventAt.IsZero() {
+		eventAt = now
+	}
+	isaControl := fmt.Sprintf("%09d", input.ControlNumber)
+	control := strconv.FormatInt(input.ControlNumber, 10)
+	segments := []string{
+		"ISA*00*          *00*          *ZZ*" + padISA(input.SenderID) +
+			"*ZZ*" + padISA(input.ReceiverID) +
+			"*" + now.Format("060102") + "*" + now.Format("1504") +
+			"*^*00401*" + isaControl + "*0*T*>",
+		"GS*QM*" + input.SenderID + "*" + input.ReceiverID +
+			"*" + now.Format("20060102") + "*" + now.Format("1504") +
+			"*" + control + "*X*004010",
+		"ST*214*0001",
+		"B10*SIM" + control + "*" + input.ShipmentRef + "*" + input.SCAC,
+		"L11*" + input.ShipmentRef + "*CN",
+		"LX*1",
+		"AT7*" + input.StatusCode + "*NS***" + eventAt.Format("20060102") +
+			"*" + eventAt.Format("1504") + "*UT",
+		"MS1*" + input.City + "*" + input.State + "*US",
+		"SE*7*0001",
+		"GE*1*" + control,
+		"IEA*1*" + isaControl,
+	}
+	return strings.Join(segments, "~") + "~"
+}
@@ -48,3 +48,79 @@ func TestParseX12EnvelopeAnd997RoundTrip(t *testing.T) {
 		t.Fatalf("unexpected 997 envelope: %+v", ackEnvelope)
 	}
 }
+
+func TestLoadTenderResponsesEchoTenderReference(t *testing.T) {
+	t.Parallel()
+
+	tender := BuildLoadTender204(BuildLoadTenderInput{
+		SenderID:      "SIMPARTNER",
+		ReceiverID:    "TRENOVA",
+		ControlNumber: 42,
+		ShipmentID:    "SIM000042",
+	})
+	scac, shipmentRef := LoadTenderReference(tender)
+	if scac != "SIML" || shipmentRef != "SIM000042" {
+		t.Fatalf("unexpected tender reference: scac=%q ref=%q", scac, shipmentRef)
+	}
+
+	response := BuildTenderResponse990(BuildTenderResponseInput{
+		SenderID:      "SIMPARTNER",
+		ReceiverID:    "TRENOVA",
+		ControlNumber: 43,
+		SCAC:          scac,
+		ShipmentRef:   shipmentRef,
+	})
+	envelope, err := ParseX12Envelope(response)
+	if err != nil {
+		t.Fatalf("parse 990 envelope: %v", err)
+	}
+	if envelope.TransactionSet != "990" || envelope.FunctionalGroupID != "GF" {
+		t.Fatalf("unexpected 990 envelope: %+v", envelope)
+	}
+	if !strings.Contains(response, "B1*SIML*SIM000042*") ||
+		!strings.Contains(response, "*A~L11*") {
+		t.Fatalf("990 does not accept the tender: %s", response)
+	}
+
+	status := BuildShipmentStatus214(BuildShipmentStatusInput{
+		SenderID:      "SIMPARTNER",
+		ReceiverID:    "TRENOVA",
+		ControlNumber: 44,
+		SCAC:          scac,
+		ShipmentRef:   shipmentRef,
+		StatusCode:    "D1",
+		City:          "Dallas",
+		State:         "TX",
+	})
+	envelope, err = ParseX12Envelope(status)
+	if err != nil {
+		t.Fatalf("parse 214 envelope: %v", err)
+	}
+	if envelope.TransactionSet != "214" || envelope.FunctionalGroupID != "QM" {
+		t.Fatalf("unexpected 214 envelope: %+v", envelope)
+	}
+	if !strings.Contains(status, "B10*SIM44*SIM000042*SIML~") ||
+		!strings.Contains(status, "AT7*D1*NS***") {
+		t.Fatalf("214 missing status detail: %s", status)
+	}
+}
+
+func TestLoadTenderReferenceReadsTrenovaB2Layout(t *testing.T) {
+	t.Parallel()
+
+	tender := strings.Replace(
+		BuildLoadTender204(BuildLoadTenderInput{
+			SenderID:      "TRENOVA",
+			ReceiverID:    "SIMPARTNER",
+			ControlNumber: 9,
+			ShipmentID:    "unused",
+		}),
+		"B2**SIML**unused**PP",
+		"B2*CARR*sp_01J0000000000000000000000**PP",
+		1,
+	)
+	scac, shipmentRef := LoadTenderReference(tender)
+	if scac != "CARR" || shipmentRef != "sp_01J0000000000000000000000" {
+		t.Fatalf("unexpected tender reference: scac=%q ref=%q", scac, shipmentRef)
+	}
+}
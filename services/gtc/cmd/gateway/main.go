@@ -16,7 +16,9 @@ import (
 	"github.com/emoss08/gtc/internal/adapters/primary/postgres"
 	"github.com/emoss08/gtc/internal/adapters/primary/wal"
 	gtcmeili "github.com/emoss08/gtc/internal/adapters/secondary/meilisearch"
+	gtcpostgres "github.com/emoss08/gtc/internal/adapters/secondary/postgres"
 	gtcredis "github.com/emoss08/gtc/internal/adapters/secondary/redis"
+	"github.com/emoss08/gtc/internal/adapters/secondary/webhook"
 	"github.com/emoss08/gtc/internal/core/domain"
 	"github.com/emoss08/gtc/internal/core/ports"
 	"github.com/emoss08/gtc/internal/core/services"
@@ -53,6 +55,8 @@ func runCLI(logger *zap.Logger, args []string) error {
 		return runBackfill(logger, args)
 	case "replay-dlq":
 		return runReplayDLQ(logger, args)
+	case "replay-outbox":
+		return runReplayOutbox(logger, args)
 	default:
 		return fmt.Errorf("unknown command %q", command)
 	}
@@ -71,9 +75,12 @@ func runServer(logger *zap.Logger) error {
 	defer stopMonitor()
 
 	httpServer := server.New(server.ServerParams{
-		Config:  server.Config{Port: app.cfg.HTTPPort},
-		Checker: health,
-		Logger:  logger,
+		Config:     server.Config{Port: app.cfg.HTTPPort},
+		Checker:    health,
+		Snapshots:  app.runtime,
+		Admin:      app.runtime,
+		AdminToken: app.cfg.AdminToken,
+		Logger:     logger,
 	})
 
 	serverErrCh := make(chan error, 1)
@@ -134,8 +141,10 @@ func runBackfill(logger *zap.Logger, args []string) error {
 	flags := flag.NewFlagSet("backfill", flag.ContinueOnError)
 	var projectionArg string
 	var tableArg string
+	var rebuild bool
 	flags.StringVar(&projectionArg, "projection", "", "comma-separated projection names")
 	flags.StringVar(&tableArg, "table", "", "comma-separated full table names")
+	flags.BoolVar(&rebuild, "rebuild", false, "clear each projection's target before backfilling")
 	if err := flags.Parse(args); err != nil {
 		return err
 	}
@@ -147,11 +156,19 @@ func runBackfill(logger *zap.Logger, args []string) error {
 	defer app.close()
 	defer func() { _ = app.runtime.Stop(context.Background()) }()
 
-	if err := app.runtime.Backfill(context.Background(), csvList(projectionArg), csvList(tableArg)); err != nil {
+	backfill := app.runtime.Backfill
+	if rebuild {
+		backfill = app.runtime.Rebuild
+	}
+	if err := backfill(context.Background(), csvList(projectionArg), csvList(tableArg)); err != nil {
 		return err
 	}
 
-	logger.Info("backfill completed", zap.String("projections", projectionArg), zap.String("tables", tableArg))
+	logger.Info("backfill completed",
+		zap.String("projections", projectionArg),
+		zap.String("tables", tableArg),
+		zap.Bool("rebuild", rebuild),
+	)
 	return nil
 }
 
@@ -172,7 +189,7 @@ func runReplayDLQ(logger *zap.Logger, args []string) error {
 	defer app.close()
 	defer func() { _ = app.runtime.Stop(context.Background()) }()
 
-	entries, err := app.dlq.Read(context.Background(), int64(limit))
+	entries, err := app.dlq.Read(context.Background(), "", int64(limit))
 	if err != nil {
 		return err
 	}
@@ -201,6 +218,75 @@ func runReplayDLQ(logger *zap.Logger, args []string) error {
 	return nil
 }
 
+func runReplayOutbox(logger *zap.Logger, args []string) error {
+	flags := flag.NewFlagSet("replay-outbox", flag.ContinueOnError)
+	var projection string
+	var sinceArg string
+	var untilArg string
+	var eventTypeArg string
+	var aggregateID string
+	flags.StringVar(&projection, "projection", "", "outbox_stream projection to replay through")
+	flags.StringVar(&sinceArg, "since", "", "replay events that occurred at or after this RFC 3339 time")
+	flags.StringVar(&untilArg, "until", "", "replay events that occurred before this RFC 3339 time")
+	flags.StringVar(&eventTypeArg, "event-type", "", "comma-separated event types")
+	flags.StringVar(&aggregateID, "aggregate-id", "", "only replay events for this aggregate")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	query, err := outboxQuery(sinceArg, untilArg, eventTypeArg, aggregateID)
+	if err != nil {
+		return err
+	}
+	if projection == "" {
+		return fmt.Errorf("-projection is required")
+	}
+
+	app, _, _, err := buildApplication(logger)
+	if err != nil {
+		return err
+	}
+	defer app.close()
+	defer func() { _ = app.runtime.Stop(context.Background()) }()
+
+	replayed, err := app.runtime.ReplayOutbox(context.Background(), projection, query)
+	if err != nil {
+		return fmt.Errorf("replay outbox after %d events: %w", replayed, err)
+	}
+
+	logger.Info("replayed outbox events",
+		zap.String("projection", projection),
+		zap.Int("count", replayed),
+	)
+	return nil
+}
+
+func outboxQuery(sinceArg string, untilArg string, eventTypeArg string, aggregateID string) (domain.OutboxQuery, error) {
+	if sinceArg == "" {
+		return domain.OutboxQuery{}, fmt.Errorf("-since is required")
+	}
+	since, err := time.Parse(time.RFC3339, sinceArg)
+	if err != nil {
+		return domain.OutboxQuery{}, fmt.Errorf("parse -since: %w", err)
+	}
+
+	query := domain.OutboxQuery{
+		Since:       since,
+		EventTypes:  csvList(eventTypeArg),
+		AggregateID: strings.TrimSpace(aggregateID),
+	}
+	if untilArg != "" {
+		if query.Until, err = time.Parse(time.RFC3339, untilArg); err != nil {
+			return domain.OutboxQuery{}, fmt.Errorf("parse -until: %w", err)
+		}
+		if !query.Until.After(since) {
+			return domain.OutboxQuery{}, fmt.Errorf("-until must be after -since")
+		}
+	}
+
+	return query, nil
+}
+
 type application struct {
 	cfg     *config.Config
 	pool    interface{ Close() }
@@ -218,6 +304,9 @@ func buildApplication(logger *zap.Logger) (*application, context.Context, contex
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if cfg.PostgresSinkURL == "" && usesDestination(projections, domain.DestinationPostgres) {
+		return nil, nil, nil, fmt.Errorf("POSTGRES_SINK_URL is required for postgres destinations")
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 
@@ -234,6 +323,8 @@ func buildApplication(logger *zap.Logger) (*application, context.Context, contex
 		checkpoints,
 		cfg.SnapshotBatchSize,
 		cfg.SnapshotConcurrency,
+		cfg.SnapshotChunkRows,
+		cfg.SnapshotMaxChunks,
 		logger,
 	)
 
@@ -281,6 +372,13 @@ func buildApplication(logger *zap.Logger) (*application, context.Context, contex
 		return nil, nil, nil, err
 	}
 
+	outboxSink, err := gtcredis.NewOutboxStreamSink(cfg.RedisURL, cfg.OutboxDedupeWindow, logger)
+	if err != nil {
+		stop()
+		pool.Close()
+		return nil, nil, nil, err
+	}
+
 	runtime, err := services.NewRuntime(services.RuntimeParams{
 		TailReader:    tailer,
 		Snapshotter:   snapshotter,
@@ -293,13 +391,18 @@ func buildApplication(logger *zap.Logger) (*application, context.Context, contex
 			redisStreamSink,
 			meiliSink,
 			tcaStreamSink,
+			outboxSink,
+			webhook.NewSink(logger),
+			gtcpostgres.NewSink(cfg.PostgresSinkURL, logger),
 		},
-		ProcessTimeout:  cfg.ProcessTimeout,
-		WorkerCount:     cfg.WorkerCount,
-		WorkerQueueSize: cfg.WorkerQueueSize,
-		RetryMax:        cfg.RetryMaxAttempts,
-		RetryBackoff:    cfg.RetryBackoff,
-		Logger:          logger,
+		ProcessTimeout:   cfg.ProcessTimeout,
+		WorkerCount:      cfg.WorkerCount,
+		WorkerQueueSize:  cfg.WorkerQueueSize,
+		RetryMax:         cfg.RetryMaxAttempts,
+		RetryBackoff:     cfg.RetryBackoff,
+		RetryBudget:      cfg.RetryBudget,
+		PauseMaxLagBytes: cfg.PauseMaxLagBytes,
+		Logger:           logger,
 	})
 	if err != nil {
 		stop()
@@ -332,6 +435,12 @@ func projectionTables(projections []domain.Projection) []string {
 	return tables
 }
 
+func usesDestination(projections []domain.Projection, kind domain.DestinationKind) bool {
+	return slices.ContainsFunc(projections, func(projection domain.Projection) bool {
+		return projection.Destination.Kind == kind
+	})
+}
+
 func csvList(raw string) []string {
 	if strings.TrimSpace(raw) == "" {
 		return nil
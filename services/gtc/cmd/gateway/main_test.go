@@ -2,6 +2,7 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	"github.com/emoss08/gtc/internal/core/domain"
 )
@@ -31,3 +32,22 @@ func TestCSVList(t *testing.T) {
 		t.Fatalf("expected 3 items, got %v", items)
 	}
 }
+
+func TestOutboxQueryRequiresOrderedWindow(t *testing.T) {
+	t.Parallel()
+
+	query, err := outboxQuery("2026-10-01T00:00:00Z", "2026-10-02T00:00:00Z", "tender.accepted, invoice.posted", "")
+	if err != nil {
+		t.Fatalf("outboxQuery returned error: %v", err)
+	}
+	if len(query.EventTypes) != 2 || query.Until.Sub(query.Since) != 24*time.Hour {
+		t.Fatalf("unexpected query: %+v", query)
+	}
+
+	if _, err := outboxQuery("", "", "", ""); err == nil {
+		t.Fatalf("expected a missing -since to be rejected")
+	}
+	if _, err := outboxQuery("2026-10-02T00:00:00Z", "2026-10-01T00:00:00Z", "", ""); err == nil {
+		t.Fatalf("expected -until before -since to be rejected")
+	}
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/emoss08/gtc/internal/core/ports"
@@ -12,8 +13,10 @@ import (
 )
 
 const (
-	bootstrapLSNKey = "bootstrap_lsn"
-	walLSNKey       = "wal_lsn"
+	bootstrapLSNKey        = "bootstrap_lsn"
+	walLSNKey              = "wal_lsn"
+	schemaVersionKeyPrefix = "schema_version:"
+	pausedKeyPrefix        = "paused:"
 )
 
 type CheckpointStore struct {
@@ -62,6 +65,11 @@ func (s *CheckpointStore) Ensure(ctx context.Context) error {
 			quoteIdentifier(s.schema),
 			quoteIdentifier(s.snapshotTable),
 		),
+		fmt.Sprintf(
+			"ALTER TABLE %s.%s ADD COLUMN IF NOT EXISTS chunk_bounds TEXT NOT NULL DEFAULT ''",
+			quoteIdentifier(s.schema),
+			quoteIdentifier(s.snapshotTable),
+		),
 		fmt.Sprintf(`
 			DO $$
 			BEGIN
@@ -108,18 +116,63 @@ func (s *CheckpointStore) SaveWALLSN(ctx context.Context, lsn string) error {
 	return s.saveKey(ctx, walLSNKey, lsn)
 }
 
+// LoadSchemaVersion returns the source table layout a projection was last
+// brought up to date with, or an empty string if none has been recorded.
+func (s *CheckpointStore) LoadSchemaVersion(ctx context.Context, projection string) (string, error) {
+	return s.loadKey(ctx, schemaVersionKeyPrefix+projection)
+}
+
+func (s *CheckpointStore) SaveSchemaVersion(ctx context.Context, projection string, version string) error {
+	return s.saveKey(ctx, schemaVersionKeyPrefix+projection, version)
+}
+
+// LoadPausedProjections returns the projections an operator paused, so they
+// come back paused after a restart.
+func (s *CheckpointStore) LoadPausedProjections(ctx context.Context) ([]string, error) {
+	query := fmt.Sprintf(
+		"SELECT name FROM %s.%s WHERE starts_with(name, $1) AND value = 'true' ORDER BY name",
+		quoteIdentifier(s.schema),
+		quoteIdentifier(s.table),
+	)
+
+	rows, err := s.pool.Query(ctx, query, pausedKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("load paused projections: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("load paused projections: %w", err)
+	}
+
+	projections := make([]string, 0, len(names))
+	for _, name := range names {
+		projections = append(projections, strings.TrimPrefix(name, pausedKeyPrefix))
+	}
+
+	return projections, nil
+}
+
+func (s *CheckpointStore) SaveProjectionPaused(ctx context.Context, projection string, paused bool) error {
+	return s.saveKey(ctx, pausedKeyPrefix+projection, strconv.FormatBool(paused))
+}
+
 func (s *CheckpointStore) LoadSnapshotProgress(
 	ctx context.Context,
 	tableName string,
 ) (ports.SnapshotProgress, error) {
 	query := fmt.Sprintf(
-		"SELECT table_name, cursor, completed FROM %s.%s WHERE table_name = $1",
+		"SELECT table_name, cursor, completed, chunk_bounds FROM %s.%s WHERE table_name = $1",
 		quoteIdentifier(s.schema),
 		quoteIdentifier(s.snapshotTable),
 	)
 
 	var progress ports.SnapshotProgress
-	err := s.pool.QueryRow(ctx, query, tableName).Scan(&progress.TableName, &progress.Cursor, &progress.Completed)
+	err := s.pool.QueryRow(ctx, query, tableName).Scan(
+		&progress.TableName,
+		&progress.Cursor,
+		&progress.Completed,
+		&progress.ChunkBounds,
+	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return ports.SnapshotProgress{TableName: tableName}, nil
@@ -135,13 +188,24 @@ func (s *CheckpointStore) SaveSnapshotProgress(
 	progress ports.SnapshotProgress,
 ) error {
 	query := fmt.Sprintf(`
-		INSERT INTO %s.%s (table_name, cursor, completed, updated_at)
-		VALUES ($1, $2, $3, NOW())
+		INSERT INTO %s.%s (table_name, cursor, completed, chunk_bounds, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
 		ON CONFLICT (table_name)
-		DO UPDATE SET cursor = EXCLUDED.cursor, completed = EXCLUDED.completed, updated_at = NOW()
+		DO UPDATE SET
+			cursor = EXCLUDED.cursor,
+			completed = EXCLUDED.completed,
+			chunk_bounds = EXCLUDED.chunk_bounds,
+			updated_at = NOW()
 	`, quoteIdentifier(s.schema), quoteIdentifier(s.snapshotTable))
 
-	_, err := s.pool.Exec(ctx, query, progress.TableName, progress.Cursor, progress.Completed)
+	_, err := s.pool.Exec(
+		ctx,
+		query,
+		progress.TableName,
+		progress.Cursor,
+		progress.Completed,
+		progress.ChunkBounds,
+	)
 	if err != nil {
 		return fmt.Errorf("save snapshot progress: %w", err)
 	}
@@ -60,9 +60,84 @@ func (s *MetadataStore) LoadTableMetadata(
 		return domain.TableMetadata{}, fmt.Errorf("table %s.%s has no primary key", schema, table)
 	}
 
+	columns, err := s.loadColumns(ctx, schema, table)
+	if err != nil {
+		return domain.TableMetadata{}, err
+	}
+
 	return domain.TableMetadata{
 		Schema:      schema,
 		Table:       table,
 		PrimaryKeys: keys,
+		Columns:     columns,
 	}, nil
 }
+
+// loadColumns lists the columns logical replication publishes, in the order
+// relation messages announce them. Generated columns are never published.
+func (s *MetadataStore) loadColumns(
+	ctx context.Context,
+	schema string,
+	table string,
+) ([]domain.ColumnMetadata, error) {
+	const query = `
+		SELECT a.attname, t.typname, t.typcategory, a.atttypid
+		FROM pg_attribute a
+		JOIN pg_class c ON c.oid = a.attrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_type t ON t.oid = a.atttypid
+		WHERE a.attnum > 0
+		  AND NOT a.attisdropped
+		  AND a.attgenerated = ''
+		  AND n.nspname = $1
+		  AND c.relname = $2
+		ORDER BY a.attnum
+	`
+
+	rows, err := s.pool.Query(ctx, query, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("load columns for %s.%s: %w", schema, table, err)
+	}
+	defer rows.Close()
+
+	columns := make([]domain.ColumnMetadata, 0, 16)
+	for rows.Next() {
+		var name, typeName, category string
+		var typeOID uint32
+		if err := rows.Scan(&name, &typeName, &category, &typeOID); err != nil {
+			return nil, fmt.Errorf("scan column for %s.%s: %w", schema, table, err)
+		}
+		columns = append(columns, domain.ColumnMetadata{
+			Name:    name,
+			Type:    columnType(typeName, category),
+			TypeOID: typeOID,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate columns for %s.%s: %w", schema, table, err)
+	}
+
+	return columns, nil
+}
+
+// columnType maps a Postgres type to the type filters and computed fields see.
+// Timestamps and uuids are compared as the strings the snapshot emits them as.
+func columnType(typeName string, category string) domain.ColumnType {
+	switch typeName {
+	case "int2", "int4", "int8":
+		return domain.ColumnTypeInt
+	case "float4", "float8", "numeric":
+		return domain.ColumnTypeFloat
+	case "bool":
+		return domain.ColumnTypeBool
+	case "uuid":
+		return domain.ColumnTypeString
+	}
+
+	switch category {
+	case "S", "E", "D":
+		return domain.ColumnTypeString
+	default:
+		return domain.ColumnTypeUnknown
+	}
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+)
+
+func TestColumnTypeMapsPostgresTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		typeName string
+		category string
+		expected domain.ColumnType
+	}{
+		{typeName: "varchar", category: "S", expected: domain.ColumnTypeString},
+		{typeName: "shipment_status_enum", category: "E", expected: domain.ColumnTypeString},
+		{typeName: "timestamptz", category: "D", expected: domain.ColumnTypeString},
+		{typeName: "uuid", category: "U", expected: domain.ColumnTypeString},
+		{typeName: "int8", category: "N", expected: domain.ColumnTypeInt},
+		{typeName: "numeric", category: "N", expected: domain.ColumnTypeFloat},
+		{typeName: "bool", category: "B", expected: domain.ColumnTypeBool},
+		{typeName: "jsonb", category: "U", expected: domain.ColumnTypeUnknown},
+	}
+
+	for _, test := range tests {
+		if got := columnType(test.typeName, test.category); got != test.expected {
+			t.Fatalf("%s: expected %s, got %s", test.typeName, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+)
+
+var _ ports.OutboxReader = (*SnapshotReader)(nil)
+
+// outboxCursor is the last event a replay page returned. Events are read in
+// occurred_at order with the ID breaking ties.
+type outboxCursor struct {
+	occurredAt int64
+	id         string
+}
+
+// ReadOutbox pages through the outbox events the query selects in the order
+// they occurred, handing each to the handler as an insert.
+func (r *SnapshotReader) ReadOutbox(
+	ctx context.Context,
+	binding domain.SnapshotBinding,
+	query domain.OutboxQuery,
+	handler ports.RecordHandler,
+) error {
+	tableName := binding.FullTableName()
+	var cursor *outboxCursor
+
+	for {
+		sql, args := buildOutboxQuery(binding, query, cursor, r.batchSize)
+		rows, err := r.pool.Query(ctx, sql, args...)
+		if err != nil {
+			return fmt.Errorf("query outbox events from %s: %w", tableName, err)
+		}
+
+		count := 0
+		for rows.Next() {
+			values, mapErr := scanRowMap(rows)
+			if mapErr != nil {
+				rows.Close()
+				return fmt.Errorf("scan outbox event from %s: %w", tableName, mapErr)
+			}
+
+			event, parseErr := domain.ParseOutboxEvent(values)
+			if parseErr != nil {
+				rows.Close()
+				return parseErr
+			}
+
+			record := domain.SourceRecord{
+				Operation: domain.OperationInsert,
+				Schema:    binding.Schema,
+				Table:     binding.Table,
+				NewData:   values,
+				Metadata:  domain.RecordMetadata{Timestamp: time.Now().UTC()},
+			}
+			if err := handler(ctx, record); err != nil {
+				rows.Close()
+				return err
+			}
+
+			cursor = &outboxCursor{occurredAt: event.OccurredAt, id: event.ID}
+			count++
+		}
+
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("iterate outbox events from %s: %w", tableName, err)
+		}
+		rows.Close()
+
+		if count < r.batchSize {
+			return nil
+		}
+	}
+}
+
+func buildOutboxQuery(
+	binding domain.SnapshotBinding,
+	query domain.OutboxQuery,
+	cursor *outboxCursor,
+	batchSize int,
+) (string, []any) {
+	var (
+		predicates []string
+		args       []any
+	)
+	add := func(format string, value any) {
+		args = append(args, value)
+		predicates = append(predicates, fmt.Sprintf(format, len(args)))
+	}
+
+	add(`"occurred_at" >= $%d`, query.Since.Unix())
+	if !query.Until.IsZero() {
+		add(`"occurred_at" < $%d`, query.Until.Unix())
+	}
+	if len(query.EventTypes) > 0 {
+		add(`"event_type" = ANY($%d)`, query.EventTypes)
+	}
+	if query.AggregateID != "" {
+		add(`"aggregate_id" = $%d`, query.AggregateID)
+	}
+	if cursor != nil {
+		args = append(args, cursor.occurredAt, cursor.id)
+		predicates = append(predicates, fmt.Sprintf(`("occurred_at", "id") > ($%d, $%d)`, len(args)-1, len(args)))
+	}
+
+	args = append(args, batchSize)
+
+	return fmt.Sprintf(
+		`SELECT * FROM %s.%s WHERE %s ORDER BY "occurred_at", "id" LIMIT $%d`,
+		quoteIdentifier(binding.Schema),
+		quoteIdentifier(binding.Table),
+		strings.Join(predicates, " AND "),
+		len(args),
+	), args
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+)
+
+func TestBuildOutboxQueryPagesByOccurredAt(t *testing.T) {
+	t.Parallel()
+
+	since := time.Unix(1_700_000_000, 0)
+	query, args := buildOutboxQuery(
+		domain.SnapshotBinding{Schema: "public", Table: "domain_event_outbox"},
+		domain.OutboxQuery{
+			Since:      since,
+			Until:      since.Add(time.Hour),
+			EventTypes: []string{"tender.accepted"},
+		},
+		&outboxCursor{occurredAt: 1_700_000_100, id: "devt_1"},
+		100,
+	)
+
+	expected := `SELECT * FROM "public"."domain_event_outbox" WHERE "occurred_at" >= $1 AND "occurred_at" < $2 AND "event_type" = ANY($3) AND ("occurred_at", "id") > ($4, $5) ORDER BY "occurred_at", "id" LIMIT $6`
+	if query != expected {
+		t.Fatalf("unexpected query:\n%s", query)
+	}
+	if args[0] != int64(1_700_000_000) || args[1] != int64(1_700_003_600) {
+		t.Fatalf("unexpected window args: %v", args[:2])
+	}
+	if types, ok := args[2].([]string); !ok || !slices.Equal(types, []string{"tender.accepted"}) {
+		t.Fatalf("unexpected event type arg: %v", args[2])
+	}
+	if args[3] != int64(1_700_000_100) || args[4] != "devt_1" || args[5] != 100 {
+		t.Fatalf("unexpected cursor args: %v", args[3:])
+	}
+}
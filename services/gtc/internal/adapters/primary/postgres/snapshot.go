@@ -3,12 +3,16 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/emoss08/gtc/internal/core/domain"
 	"github.com/emoss08/gtc/internal/core/ports"
+	"github.com/emoss08/gtc/internal/infrastructure/metrics"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
@@ -19,14 +23,24 @@ type SnapshotReader struct {
 	checkpoints ports.CheckpointStore
 	batchSize   int
 	concurrency int
+	chunkRows   int
+	maxChunks   int
 	logger      *zap.Logger
+	progressMu  sync.Mutex
+	progress    map[string]*domain.SnapshotChunkStatus
 }
 
+// NewSnapshotReader builds a reader that copies tables in primary-key order.
+// Tables estimated at more than chunkRows rows are split into up to maxChunks
+// key ranges that are copied concurrently; a chunkRows of zero disables
+// chunking. Concurrency bounds the chunks in flight across all tables.
 func NewSnapshotReader(
 	pool *pgxpool.Pool,
 	checkpoints ports.CheckpointStore,
 	batchSize int,
 	concurrency int,
+	chunkRows int,
+	maxChunks int,
 	logger *zap.Logger,
 ) *SnapshotReader {
 	return &SnapshotReader{
@@ -34,7 +48,10 @@ func NewSnapshotReader(
 		checkpoints: checkpoints,
 		batchSize:   batchSize,
 		concurrency: concurrency,
+		chunkRows:   chunkRows,
+		maxChunks:   maxChunks,
 		logger:      logger.Named("snapshot_reader"),
+		progress:    make(map[string]*domain.SnapshotChunkStatus),
 	}
 }
 
@@ -62,6 +79,26 @@ func (r *SnapshotReader) Backfill(
 	return r.run(ctx, bindings, handler, false)
 }
 
+// ChunkProgress returns the progress of every chunk this process has started,
+// ordered by table and chunk.
+func (r *SnapshotReader) ChunkProgress() []domain.SnapshotChunkStatus {
+	r.progressMu.Lock()
+	defer r.progressMu.Unlock()
+
+	statuses := make([]domain.SnapshotChunkStatus, 0, len(r.progress))
+	for _, status := range r.progress {
+		statuses = append(statuses, *status)
+	}
+	slices.SortFunc(statuses, func(a, b domain.SnapshotChunkStatus) int {
+		if byTable := strings.Compare(a.Table, b.Table); byTable != 0 {
+			return byTable
+		}
+		return a.Chunk - b.Chunk
+	})
+
+	return statuses
+}
+
 func (r *SnapshotReader) run(
 	ctx context.Context,
 	bindings []domain.SnapshotBinding,
@@ -73,11 +110,20 @@ func (r *SnapshotReader) run(
 
 	sem := make(chan struct{}, r.concurrency)
 	errCh := make(chan error, 1)
+	fail := func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+		cancel()
+	}
 	var wg sync.WaitGroup
 
 	for _, binding := range bindings {
+		progress := ports.SnapshotProgress{TableName: binding.FullTableName()}
 		if persistProgress {
-			progress, err := r.checkpoints.LoadSnapshotProgress(ctx, binding.FullTableName())
+			var err error
+			progress, err = r.checkpoints.LoadSnapshotProgress(ctx, binding.FullTableName())
 			if err != nil {
 				return err
 			}
@@ -91,6 +137,52 @@ func (r *SnapshotReader) run(
 		go func() {
 			defer wg.Done()
 
+			if err := r.snapshotTable(ctx, binding, progress, sem, handler, persistProgress); err != nil {
+				fail(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (r *SnapshotReader) HealthCheck(ctx context.Context) error {
+	return r.pool.Ping(ctx)
+}
+
+// snapshotTable copies a table's chunks, each holding a slot of sem while it
+// runs, and marks a chunked table completed once every chunk has finished.
+func (r *SnapshotReader) snapshotTable(
+	ctx context.Context,
+	binding domain.SnapshotBinding,
+	progress ports.SnapshotProgress,
+	sem chan struct{},
+	handler ports.RecordHandler,
+	persistProgress bool,
+) error {
+	chunks, err := r.planChunks(ctx, binding, progress, persistProgress)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	var wg sync.WaitGroup
+	for _, chunk := range chunks {
+		chunk := chunk
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
 			select {
 			case <-ctx.Done():
 				return
@@ -98,7 +190,7 @@ func (r *SnapshotReader) run(
 			}
 			defer func() { <-sem }()
 
-			if err := r.snapshotTable(ctx, binding, handler, persistProgress); err != nil {
+			if err := r.snapshotChunk(ctx, chunk, handler, persistProgress); err != nil {
 				select {
 				case errCh <- err:
 				default:
@@ -107,46 +199,149 @@ func (r *SnapshotReader) run(
 			}
 		}()
 	}
-
 	wg.Wait()
 
 	select {
 	case err := <-errCh:
 		return err
 	default:
-		return nil
 	}
-}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
-func (r *SnapshotReader) HealthCheck(ctx context.Context) error {
-	return r.pool.Ping(ctx)
+	if persistProgress && len(chunks) > 1 {
+		progress.Completed = true
+		if err := r.checkpoints.SaveSnapshotProgress(ctx, progress); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (r *SnapshotReader) snapshotTable(
+// planChunks splits a table into primary-key ranges. Saved boundaries are
+// reused so a restarted snapshot resumes the same chunks; a table with a saved
+// single cursor from before chunking keeps snapshotting as one chunk.
+func (r *SnapshotReader) planChunks(
 	ctx context.Context,
 	binding domain.SnapshotBinding,
+	progress ports.SnapshotProgress,
+	persistProgress bool,
+) ([]snapshotChunk, error) {
+	tableName := binding.FullTableName()
+	if progress.ChunkBounds != "" {
+		bounds, err := domain.ParseCursor(progress.ChunkBounds)
+		if err != nil {
+			return nil, fmt.Errorf("parse snapshot chunk bounds for %s: %w", tableName, err)
+		}
+		return splitChunks(binding, bounds.Values), nil
+	}
+	if progress.Cursor != "" || r.chunkRows <= 0 || r.maxChunks <= 1 {
+		return splitChunks(binding, nil), nil
+	}
+
+	bounds, err := r.sampleBounds(ctx, binding)
+	if err != nil {
+		return nil, err
+	}
+	chunks := splitChunks(binding, bounds)
+	if len(chunks) == 1 || !persistProgress {
+		return chunks, nil
+	}
+
+	payload, err := domain.Cursor{Values: bounds}.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	progress.ChunkBounds = payload
+	if err := r.checkpoints.SaveSnapshotProgress(ctx, progress); err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("planned snapshot chunks", zap.String("table", tableName), zap.Int("chunks", len(chunks)))
+	return chunks, nil
+}
+
+// sampleBounds picks chunk boundaries on the leading primary key column from
+// a block sample, so planning stays cheap on tables large enough to need it.
+func (r *SnapshotReader) sampleBounds(ctx context.Context, binding domain.SnapshotBinding) ([]any, error) {
+	tableName := binding.FullTableName()
+
+	var estimate float64
+	if err := r.pool.QueryRow(ctx, estimateRowsQuery, binding.Schema, binding.Table).Scan(&estimate); err != nil {
+		return nil, fmt.Errorf("estimate rows for %s: %w", tableName, err)
+	}
+
+	count := min(int(math.Ceil(estimate/float64(r.chunkRows))), r.maxChunks)
+	if count <= 1 {
+		return nil, nil
+	}
+
+	fractions := make([]float64, 0, count-1)
+	for idx := 1; idx < count; idx++ {
+		fractions = append(fractions, float64(idx)/float64(count))
+	}
+	samplePercent := min(100, max(0.01, 100*float64(sampleRowsPerChunk*count)/estimate))
+
+	rows, err := r.pool.Query(ctx, buildBoundsQuery(binding), fractions, samplePercent)
+	if err != nil {
+		return nil, fmt.Errorf("sample chunk bounds for %s: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var sampled []any
+	if rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, fmt.Errorf("scan chunk bounds for %s: %w", tableName, err)
+		}
+		sampled, _ = values[0].([]any)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sample chunk bounds for %s: %w", tableName, err)
+	}
+
+	return distinctBounds(sampled), nil
+}
+
+func (r *SnapshotReader) snapshotChunk(
+	ctx context.Context,
+	chunk snapshotChunk,
 	handler ports.RecordHandler,
 	persistProgress bool,
 ) error {
+	binding := chunk.binding
 	tableName := binding.FullTableName()
-	progress := ports.SnapshotProgress{TableName: tableName}
+	progressKey := chunk.progressKey()
+	progress := ports.SnapshotProgress{TableName: progressKey}
 	var err error
 	if persistProgress {
-		progress, err = r.checkpoints.LoadSnapshotProgress(ctx, tableName)
+		progress, err = r.checkpoints.LoadSnapshotProgress(ctx, progressKey)
 		if err != nil {
 			return err
 		}
+		if progress.Completed {
+			r.trackChunk(chunk, 0, true)
+			return nil
+		}
 	}
 
 	cursor, err := domain.ParseCursor(progress.Cursor)
 	if err != nil {
-		return fmt.Errorf("parse snapshot cursor for %s: %w", tableName, err)
+		return fmt.Errorf("parse snapshot cursor for %s: %w", progressKey, err)
 	}
 
-	r.logger.Info("snapshotting table", zap.String("table", tableName), zap.Any("cursor", cursor.Values))
+	r.logger.Info("snapshotting table",
+		zap.String("table", tableName),
+		zap.Int("chunk", chunk.index),
+		zap.Int("chunks", chunk.count),
+		zap.Any("cursor", cursor.Values),
+	)
+	r.trackChunk(chunk, 0, false)
 
 	for {
-		query, args := buildSnapshotQuery(binding, cursor, r.batchSize)
+		query, args := buildChunkSnapshotQuery(binding, chunk.keyRange, cursor, r.batchSize)
 		rows, err := r.pool.Query(ctx, query, args...)
 		if err != nil {
 			return fmt.Errorf("query snapshot rows for %s: %w", tableName, err)
@@ -196,36 +391,172 @@ func (r *SnapshotReader) snapshotTable(
 			return err
 		}
 
+		completed := count < r.batchSize
 		if persistProgress {
-			if err := r.checkpoints.SaveSnapshotProgress(ctx, ports.SnapshotProgress{
-				TableName: tableName,
-				Cursor:    cursorPayload,
-				Completed: count < r.batchSize,
-			}); err != nil {
+			progress.Cursor = cursorPayload
+			progress.Completed = completed
+			if err := r.checkpoints.SaveSnapshotProgress(ctx, progress); err != nil {
 				return err
 			}
 		}
-
-		if count < r.batchSize {
-			r.logger.Info("snapshot complete", zap.String("table", tableName), zap.Any("cursor", cursor.Values))
+		r.trackChunk(chunk, int64(count), completed)
+
+		if completed {
+			r.logger.Info("snapshot complete",
+				zap.String("table", tableName),
+				zap.Int("chunk", chunk.index),
+				zap.Any("cursor", cursor.Values),
+			)
 			return nil
 		}
 	}
 }
 
+func (r *SnapshotReader) trackChunk(chunk snapshotChunk, rows int64, completed bool) {
+	r.progressMu.Lock()
+	defer r.progressMu.Unlock()
+
+	key := chunk.progressKey()
+	status, ok := r.progress[key]
+	if !ok {
+		status = &domain.SnapshotChunkStatus{
+			Table:  chunk.binding.FullTableName(),
+			Chunk:  chunk.index,
+			Chunks: chunk.count,
+		}
+		r.progress[key] = status
+	}
+	status.RowsCopied += rows
+	status.Completed = completed
+
+	label := strconv.Itoa(chunk.index)
+	metrics.SnapshotChunkRows.WithLabelValues(status.Table, label).Set(float64(status.RowsCopied))
+	completedValue := 0.0
+	if completed {
+		completedValue = 1
+	}
+	metrics.SnapshotChunkCompleted.WithLabelValues(status.Table, label).Set(completedValue)
+}
+
+// snapshotChunk is one range of a table's leading primary key column. A nil
+// bound leaves that side open, so rows beyond the sampled keys still belong
+// to the first or last chunk.
+type snapshotChunk struct {
+	binding  domain.SnapshotBinding
+	index    int
+	count    int
+	keyRange keyRange
+}
+
+type keyRange struct {
+	lower any
+	upper any
+}
+
+// progressKey keeps an unchunked table's progress under the table name, as it
+// was stored before chunking existed.
+func (c snapshotChunk) progressKey() string {
+	if c.count == 1 {
+		return c.binding.FullTableName()
+	}
+	return fmt.Sprintf("%s#%d", c.binding.FullTableName(), c.index)
+}
+
+func splitChunks(binding domain.SnapshotBinding, bounds []any) []snapshotChunk {
+	count := len(bounds) + 1
+	chunks := make([]snapshotChunk, 0, count)
+	for idx := range count {
+		chunk := snapshotChunk{binding: binding, index: idx, count: count}
+		if idx > 0 {
+			chunk.keyRange.lower = bounds[idx-1]
+		}
+		if idx < len(bounds) {
+			chunk.keyRange.upper = bounds[idx]
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+func distinctBounds(sampled []any) []any {
+	bounds := make([]any, 0, len(sampled))
+	for _, value := range sampled {
+		if value == nil {
+			continue
+		}
+		value = normalizeValue(value)
+		if len(bounds) > 0 && bounds[len(bounds)-1] == value {
+			continue
+		}
+		bounds = append(bounds, value)
+	}
+
+	return bounds
+}
+
+const (
+	estimateRowsQuery = `
+		SELECT GREATEST(c.reltuples, 0)::float8
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2
+	`
+
+	// sampleRowsPerChunk is roughly how many sampled rows back each boundary.
+	sampleRowsPerChunk = 1000
+)
+
+func buildBoundsQuery(binding domain.SnapshotBinding) string {
+	return fmt.Sprintf(
+		`SELECT percentile_disc($1::float8[]) WITHIN GROUP (ORDER BY %s) FROM %s.%s TABLESAMPLE SYSTEM ($2)`,
+		quoteIdentifier(binding.PrimaryKeys[0]),
+		quoteIdentifier(binding.Schema),
+		quoteIdentifier(binding.Table),
+	)
+}
+
 func buildSnapshotQuery(binding domain.SnapshotBinding, cursor domain.Cursor, batchSize int) (string, []any) {
+	return buildChunkSnapshotQuery(binding, keyRange{}, cursor, batchSize)
+}
+
+func buildChunkSnapshotQuery(
+	binding domain.SnapshotBinding,
+	bounds keyRange,
+	cursor domain.Cursor,
+	batchSize int,
+) (string, []any) {
 	var (
-		predicate string
-		args      []any
+		predicates []string
+		args       []any
 	)
 
 	if !cursor.IsZero() && len(cursor.Values) == len(binding.PrimaryKeys) {
+		var predicate string
 		predicate, args = buildCursorPredicate(binding.PrimaryKeys, cursor.Values)
+		predicates = append(predicates, predicate)
+	}
+
+	leading := quoteIdentifier(binding.PrimaryKeys[0])
+	if bounds.lower != nil {
+		args = append(args, bounds.lower)
+		predicates = append(predicates, fmt.Sprintf("%s >= $%d", leading, len(args)))
+	}
+	if bounds.upper != nil {
+		args = append(args, bounds.upper)
+		predicates = append(predicates, fmt.Sprintf("%s < $%d", leading, len(args)))
 	}
 
 	whereClause := ""
-	if predicate != "" {
-		whereClause = " WHERE " + predicate
+	switch len(predicates) {
+	case 0:
+	case 1:
+		whereClause = " WHERE " + predicates[0]
+	default:
+		if strings.Contains(predicates[0], " OR ") {
+			predicates[0] = "(" + predicates[0] + ")"
+		}
+		whereClause = " WHERE " + strings.Join(predicates, " AND ")
 	}
 
 	args = append(args, batchSize)
@@ -32,3 +32,52 @@ func TestBuildSnapshotQueryCompositeCursor(t *testing.T) {
 		t.Fatalf("expected batch size to be last arg, got %v", args[len(args)-1])
 	}
 }
+
+func TestBuildChunkSnapshotQueryBoundsCursorToRange(t *testing.T) {
+	t.Parallel()
+
+	query, args := buildChunkSnapshotQuery(
+		domain.SnapshotBinding{
+			Schema:      "public",
+			Table:       "shipments",
+			PrimaryKeys: []string{"id", "organization_id"},
+		},
+		keyRange{lower: "shp_100", upper: "shp_200"},
+		domain.Cursor{Values: []any{"shp_150", "org_1"}},
+		250,
+	)
+
+	expected := `SELECT * FROM "public"."shipments" WHERE (("id" > $1) OR ("id" = $2 AND "organization_id" > $3)) AND "id" >= $4 AND "id" < $5 ORDER BY "id", "organization_id" LIMIT $6`
+	if query != expected {
+		t.Fatalf("unexpected query:\n%s", query)
+	}
+	if args[3] != "shp_100" || args[4] != "shp_200" || args[5] != 250 {
+		t.Fatalf("unexpected args: %v", args)
+	}
+}
+
+func TestSplitChunksLeavesOuterRangesOpen(t *testing.T) {
+	t.Parallel()
+
+	binding := domain.SnapshotBinding{Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}}
+	chunks := splitChunks(binding, distinctBounds([]any{nil, "b", "b", "d"}))
+
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(chunks))
+	}
+	if chunks[0].keyRange.lower != nil || chunks[0].keyRange.upper != "b" {
+		t.Fatalf("unexpected first chunk: %+v", chunks[0].keyRange)
+	}
+	if chunks[1].keyRange.lower != "b" || chunks[1].keyRange.upper != "d" {
+		t.Fatalf("unexpected middle chunk: %+v", chunks[1].keyRange)
+	}
+	if chunks[2].keyRange.lower != "d" || chunks[2].keyRange.upper != nil {
+		t.Fatalf("unexpected last chunk: %+v", chunks[2].keyRange)
+	}
+	if key := chunks[2].progressKey(); key != "public.shipments#2" {
+		t.Fatalf("unexpected progress key %q", key)
+	}
+	if key := splitChunks(binding, nil)[0].progressKey(); key != "public.shipments" {
+		t.Fatalf("expected an unchunked table to keep its progress key, got %q", key)
+	}
+}
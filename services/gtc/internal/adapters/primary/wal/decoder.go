@@ -22,6 +22,7 @@ type transactionState struct {
 	xid        uint32
 	commitTime time.Time
 	records    []domain.SourceRecord
+	schemas    []domain.TableSchema
 }
 
 func NewDecoder() *Decoder {
@@ -89,12 +90,14 @@ func (d *Decoder) decodeWALData(walData []byte, lsn pglogrepl.LSN) (*domain.Tran
 			TransactionID: d.currentTransaction.xid,
 			Timestamp:     d.currentTransaction.commitTime,
 			Records:       d.currentTransaction.records,
+			Schemas:       d.currentTransaction.schemas,
 		}
 		d.currentTransaction = transactionState{}
 		return tx, nil
 
 	case *pglogrepl.RelationMessageV2:
 		d.relations[msg.RelationID] = msg
+		d.currentTransaction.schemas = append(d.currentTransaction.schemas, relationSchema(msg))
 
 	case *pglogrepl.InsertMessageV2:
 		rel := d.relations[msg.RelationID]
@@ -161,6 +164,23 @@ func (d *Decoder) decodeWALData(walData []byte, lsn pglogrepl.LSN) (*domain.Tran
 	return nil, nil
 }
 
+// relationSchema carries a relation message's column layout to the runtime,
+// which compares it with the layout its projections were resolved against.
+// pgoutput sends a relation before its first change in each session and again
+// after the table's definition changes.
+func relationSchema(rel *pglogrepl.RelationMessageV2) domain.TableSchema {
+	columns := make([]domain.ColumnMetadata, 0, len(rel.Columns))
+	for _, column := range rel.Columns {
+		columns = append(columns, domain.ColumnMetadata{Name: column.Name, TypeOID: column.DataType})
+	}
+
+	return domain.TableSchema{
+		Schema:  rel.Namespace,
+		Table:   rel.RelationName,
+		Columns: columns,
+	}
+}
+
 func (d *Decoder) appendRecord(record domain.SourceRecord) {
 	if d.currentTransaction.records == nil {
 		d.currentTransaction.records = make([]domain.SourceRecord, 0, 8)
@@ -50,6 +50,63 @@ func TestDecoderEmitsCommittedTransaction(t *testing.T) {
 	}
 }
 
+func TestDecoderCarriesRelationSchemaOnTransaction(t *testing.T) {
+	t.Parallel()
+
+	decoder := NewDecoder()
+	beginTime := time.Date(2026, 3, 20, 20, 12, 34, 0, time.UTC)
+
+	if _, err := decoder.decodeWALData(encodeBeginMessage(pglogrepl.LSN(100), beginTime, 42), pglogrepl.LSN(90)); err != nil {
+		t.Fatalf("decode begin: %v", err)
+	}
+	relation := encodeRelationMessage(16384, "public", "shipments", []relationColumn{
+		{name: "id", typeOID: 25},
+		{name: "weight", typeOID: 1700},
+	})
+	if _, err := decoder.decodeWALData(relation, pglogrepl.LSN(95)); err != nil {
+		t.Fatalf("decode relation: %v", err)
+	}
+
+	tx, err := decoder.decodeWALData(
+		encodeCommitMessage(pglogrepl.LSN(120), pglogrepl.LSN(121), beginTime.Add(time.Second)),
+		pglogrepl.LSN(110),
+	)
+	if err != nil {
+		t.Fatalf("decode commit: %v", err)
+	}
+	if len(tx.Schemas) != 1 {
+		t.Fatalf("expected relation schema on the transaction, got %d", len(tx.Schemas))
+	}
+	schema := tx.Schemas[0]
+	if schema.FullTableName() != "public.shipments" || len(schema.Columns) != 2 {
+		t.Fatalf("unexpected relation schema: %+v", schema)
+	}
+	if schema.Columns[1].Name != "weight" || schema.Columns[1].TypeOID != 1700 {
+		t.Fatalf("expected column names and type oids, got %+v", schema.Columns)
+	}
+}
+
+type relationColumn struct {
+	name    string
+	typeOID uint32
+}
+
+func encodeRelationMessage(relationID uint32, namespace string, name string, columns []relationColumn) []byte {
+	buf := []byte{byte(pglogrepl.MessageTypeRelation)}
+	buf = binary.BigEndian.AppendUint32(buf, relationID)
+	buf = append(append(buf, namespace...), 0)
+	buf = append(append(buf, name...), 0)
+	buf = append(buf, 'd')
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(columns)))
+	for _, column := range columns {
+		buf = append(buf, 0)
+		buf = append(append(buf, column.name...), 0)
+		buf = binary.BigEndian.AppendUint32(buf, column.typeOID)
+		buf = binary.BigEndian.AppendUint32(buf, 0xFFFFFFFF)
+	}
+	return buf
+}
+
 func encodeBeginMessage(finalLSN pglogrepl.LSN, commitTime time.Time, xid uint32) []byte {
 	buf := make([]byte, 1+8+8+4)
 	buf[0] = byte(pglogrepl.MessageTypeBegin)
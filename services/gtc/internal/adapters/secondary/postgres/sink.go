@@ -0,0 +1,536 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	DeletedAtColumn = "deleted_at"
+	ValidFromColumn = "valid_from"
+	ValidToColumn   = "valid_to"
+)
+
+// Sink replicates projected rows into a table in another PostgreSQL database,
+// keyed on the projection's primary keys. A committed transaction's records
+// are applied in one target transaction.
+type Sink struct {
+	databaseURL string
+	logger      *zap.Logger
+	mu          sync.Mutex
+	pool        *pgxpool.Pool
+	now         func() time.Time
+}
+
+var (
+	_ ports.BatchSink       = (*Sink)(nil)
+	_ ports.RebuildableSink = (*Sink)(nil)
+)
+
+type queryer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type statement struct {
+	sql  string
+	args []any
+}
+
+// NewSink creates the sink. An empty databaseURL leaves it unconfigured, which
+// is only valid when no projection targets PostgreSQL.
+func NewSink(databaseURL string, logger *zap.Logger) *Sink {
+	return &Sink{
+		databaseURL: databaseURL,
+		logger:      logger.Named("postgres_sink"),
+		now:         time.Now,
+	}
+}
+
+func (s *Sink) Kind() domain.DestinationKind {
+	return domain.DestinationPostgres
+}
+
+func (s *Sink) Name() string {
+	return "postgres"
+}
+
+func (s *Sink) Initialize(ctx context.Context) error {
+	if s.databaseURL == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pool != nil {
+		return s.pool.Ping(ctx)
+	}
+
+	pool, err := pgxpool.New(ctx, s.databaseURL)
+	if err != nil {
+		return fmt.Errorf("create postgres sink pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return fmt.Errorf("ping postgres sink: %w", err)
+	}
+
+	s.pool = pool
+	return nil
+}
+
+func (s *Sink) Write(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error {
+	return s.WriteBatch(ctx, projection, []domain.SourceRecord{record})
+}
+
+func (s *Sink) WriteBatch(ctx context.Context, projection domain.Projection, records []domain.SourceRecord) error {
+	pool, err := s.connection()
+	if err != nil {
+		return err
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin postgres sink transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, record := range records {
+		if err := s.apply(ctx, tx, projection, record); err != nil {
+			return fmt.Errorf("apply %s to %s: %w", record.Operation, projection.Destination.Table, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit postgres sink transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Rebuild empties the projection's target table so a backfill can repopulate
+// it. History mode loses its closed versions.
+func (s *Sink) Rebuild(ctx context.Context, projection domain.Projection) error {
+	pool, err := s.connection()
+	if err != nil {
+		return err
+	}
+
+	if _, err := pool.Exec(ctx, "TRUNCATE TABLE "+targetTable(projection.Destination)); err != nil {
+		return fmt.Errorf("truncate %s: %w", projection.Destination.Table, err)
+	}
+
+	s.logger.Info("truncated replica table for rebuild",
+		zap.String("projection", projection.Name),
+		zap.String("table", projection.Destination.Table),
+	)
+	return nil
+}
+
+func (s *Sink) HealthCheck(ctx context.Context) error {
+	s.mu.Lock()
+	pool := s.pool
+	s.mu.Unlock()
+	if pool == nil {
+		return nil
+	}
+
+	return pool.Ping(ctx)
+}
+
+func (s *Sink) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pool != nil {
+		s.pool.Close()
+		s.pool = nil
+	}
+
+	return nil
+}
+
+func (s *Sink) connection() (*pgxpool.Pool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pool == nil {
+		return nil, fmt.Errorf("postgres sink is not initialized; set POSTGRES_SINK_URL")
+	}
+
+	return s.pool, nil
+}
+
+func (s *Sink) apply(ctx context.Context, q queryer, projection domain.Projection, record domain.SourceRecord) error {
+	at := record.Metadata.Timestamp
+	if at.IsZero() {
+		at = s.now()
+	}
+	at = at.UTC()
+
+	if record.Operation == domain.OperationTruncate {
+		return execAll(ctx, q, buildTruncate(projection.Destination, at))
+	}
+
+	if record.Operation == domain.OperationDelete {
+		stmt, err := buildDelete(projection, record.OldData, at)
+		if err != nil {
+			return err
+		}
+		return execAll(ctx, q, stmt)
+	}
+
+	// A key change leaves the old key behind, so it is removed first, the
+	// same way the Redis JSON sink drops a re-keyed document.
+	if record.OldData != nil && record.NewData != nil {
+		oldKey, oldErr := domain.PrimaryKey(domain.SourceRecord{OldData: record.OldData}, projection.PrimaryKeys)
+		newKey, newErr := domain.PrimaryKey(domain.SourceRecord{NewData: record.NewData}, projection.PrimaryKeys)
+		if oldErr == nil && newErr == nil && domain.KeyString(oldKey) != domain.KeyString(newKey) {
+			stmt, err := buildDelete(projection, record.OldData, at)
+			if err != nil {
+				return err
+			}
+			if err := execAll(ctx, q, stmt); err != nil {
+				return err
+			}
+		}
+	}
+
+	if projection.Destination.Mode != domain.ReplicaModeHistory {
+		stmt, err := buildUpsert(projection, record.PrimaryData())
+		if err != nil {
+			return err
+		}
+		return execAll(ctx, q, stmt)
+	}
+
+	version, err := buildHistoryVersion(projection, record.PrimaryData(), at)
+	if err != nil {
+		return err
+	}
+	if err := execAll(ctx, q, version.close); err != nil {
+		return err
+	}
+
+	// Every change in a source transaction shares its commit time, so a later
+	// change to the same key revises the version the transaction opened.
+	if version.revise.sql != "" {
+		tag, err := q.Exec(ctx, version.revise.sql, version.revise.args...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() > 0 {
+			return nil
+		}
+	}
+
+	var current bool
+	if err := q.QueryRow(ctx, version.current.sql, version.current.args...).Scan(&current); err != nil {
+		return fmt.Errorf("check current version: %w", err)
+	}
+	if current {
+		return nil
+	}
+
+	return execAll(ctx, q, version.insert)
+}
+
+func execAll(ctx context.Context, q queryer, statements ...statement) error {
+	for _, stmt := range statements {
+		if _, err := q.Exec(ctx, stmt.sql, stmt.args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// targetRow applies the fields allowlist and column renames to a source row.
+// Primary key columns are always kept so the row can be addressed.
+func targetRow(projection domain.Projection, data map[string]any) (map[string]any, error) {
+	selected, err := domain.SelectFields(data, projection.Fields)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range projection.PrimaryKeys {
+		if _, ok := selected[key]; !ok {
+			selected[key] = data[key]
+		}
+	}
+
+	row := make(map[string]any, len(selected))
+	for column, value := range selected {
+		row[targetColumn(projection.Destination, column)] = value
+	}
+
+	return row, nil
+}
+
+func targetColumn(destination domain.Destination, column string) string {
+	if renamed, ok := destination.Columns[column]; ok && renamed != "" {
+		return renamed
+	}
+	return column
+}
+
+func targetTable(destination domain.Destination) string {
+	schema, table, err := domain.ParseFullTableName(destination.Table)
+	if err != nil {
+		return pgx.Identifier{destination.Table}.Sanitize()
+	}
+	return pgx.Identifier{schema, table}.Sanitize()
+}
+
+func keyColumns(projection domain.Projection) []string {
+	columns := make([]string, 0, len(projection.PrimaryKeys))
+	for _, key := range projection.PrimaryKeys {
+		columns = append(columns, targetColumn(projection.Destination, key))
+	}
+	return columns
+}
+
+// keyPredicate renders "k1 = $n AND k2 = $n+1" for the row's primary key,
+// numbering placeholders after the args already bound.
+func keyPredicate(projection domain.Projection, data map[string]any, args []any) (string, []any, error) {
+	values, err := domain.RecordKey(data, projection.PrimaryKeys)
+	if err != nil {
+		return "", nil, err
+	}
+
+	columns := keyColumns(projection)
+	clauses := make([]string, 0, len(columns))
+	for idx, column := range columns {
+		args = append(args, values[idx])
+		clauses = append(clauses, fmt.Sprintf("%s = $%d", quote(column), len(args)))
+	}
+
+	return strings.Join(clauses, " AND "), args, nil
+}
+
+func buildUpsert(projection domain.Projection, data map[string]any) (statement, error) {
+	row, err := targetRow(projection, data)
+	if err != nil {
+		return statement{}, err
+	}
+	if projection.Destination.Mode == domain.ReplicaModeSoftDelete {
+		row[DeletedAtColumn] = nil
+	}
+
+	columns := slices.Sorted(maps.Keys(row))
+	keys := keyColumns(projection)
+	quoted := make([]string, 0, len(columns))
+	placeholders := make([]string, 0, len(columns))
+	updates := make([]string, 0, len(columns))
+	args := make([]any, 0, len(columns))
+	for _, column := range columns {
+		args = append(args, row[column])
+		quoted = append(quoted, quote(column))
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		if !slices.Contains(keys, column) {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", quote(column), quote(column)))
+		}
+	}
+
+	conflict := "DO NOTHING"
+	if len(updates) > 0 {
+		conflict = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+
+	return statement{
+		sql: fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) %s",
+			targetTable(projection.Destination),
+			strings.Join(quoted, ", "),
+			strings.Join(placeholders, ", "),
+			quoteAll(keys),
+			conflict,
+		),
+		args: args,
+	}, nil
+}
+
+func buildDelete(projection domain.Projection, data map[string]any, at time.Time) (statement, error) {
+	table := targetTable(projection.Destination)
+
+	switch projection.Destination.Mode {
+	case domain.ReplicaModeSoftDelete:
+		predicate, args, err := keyPredicate(projection, data, []any{at})
+		if err != nil {
+			return statement{}, err
+		}
+		return statement{
+			sql: fmt.Sprintf(
+				"UPDATE %s SET %s = $1 WHERE %s AND %s IS NULL",
+				table, quote(DeletedAtColumn), predicate, quote(DeletedAtColumn),
+			),
+			args: args,
+		}, nil
+	case domain.ReplicaModeHistory:
+		predicate, args, err := keyPredicate(projection, data, []any{at})
+		if err != nil {
+			return statement{}, err
+		}
+		return statement{
+			sql: fmt.Sprintf(
+				"UPDATE %s SET %s = $1 WHERE %s AND %s IS NULL",
+				table, quote(ValidToColumn), predicate, quote(ValidToColumn),
+			),
+			args: args,
+		}, nil
+	default:
+		predicate, args, err := keyPredicate(projection, data, nil)
+		if err != nil {
+			return statement{}, err
+		}
+		return statement{
+			sql:  fmt.Sprintf("DELETE FROM %s WHERE %s", table, predicate),
+			args: args,
+		}, nil
+	}
+}
+
+func buildTruncate(destination domain.Destination, at time.Time) statement {
+	table := targetTable(destination)
+
+	switch destination.Mode {
+	case domain.ReplicaModeSoftDelete:
+		return statement{
+			sql: fmt.Sprintf(
+				"UPDATE %s SET %s = $1 WHERE %s IS NULL",
+				table, quote(DeletedAtColumn), quote(DeletedAtColumn),
+			),
+			args: []any{at},
+		}
+	case domain.ReplicaModeHistory:
+		return statement{
+			sql: fmt.Sprintf(
+				"UPDATE %s SET %s = $1 WHERE %s IS NULL",
+				table, quote(ValidToColumn), quote(ValidToColumn),
+			),
+			args: []any{at},
+		}
+	default:
+		return statement{sql: "DELETE FROM " + table}
+	}
+}
+
+// historyVersion holds the statements that record one SCD2 change.
+type historyVersion struct {
+	close   statement
+	revise  statement
+	current statement
+	insert  statement
+}
+
+// buildHistoryVersion returns the statements that record a new SCD2 version:
+// close a version opened before the commit time when any column differs,
+// revise the version opened at the commit time by an earlier change in the
+// same transaction, check whether a current version remains, and insert the
+// new one. Replaying a record finds its own version current and unchanged, so
+// nothing is written twice.
+func buildHistoryVersion(
+	projection domain.Projection,
+	data map[string]any,
+	at time.Time,
+) (historyVersion, error) {
+	row, err := targetRow(projection, data)
+	if err != nil {
+		return historyVersion{}, err
+	}
+
+	table := targetTable(projection.Destination)
+	validTo := quote(ValidToColumn)
+	columns := slices.Sorted(maps.Keys(row))
+	keys := keyColumns(projection)
+
+	predicate, closeArgs, err := keyPredicate(projection, data, []any{at})
+	if err != nil {
+		return historyVersion{}, err
+	}
+	changes := make([]string, 0, len(columns))
+	assignments := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if slices.Contains(keys, column) {
+			continue
+		}
+		closeArgs = append(closeArgs, row[column])
+		changes = append(changes, fmt.Sprintf("%s IS DISTINCT FROM $%d", quote(column), len(closeArgs)))
+		assignments = append(assignments, fmt.Sprintf("%s = $%d", quote(column), len(closeArgs)))
+	}
+	closeSQL := fmt.Sprintf(
+		"UPDATE %s SET %s = $1 WHERE %s AND %s IS NULL AND %s < $1",
+		table, validTo, predicate, validTo, quote(ValidFromColumn),
+	)
+	if len(changes) > 0 {
+		closeSQL += " AND (" + strings.Join(changes, " OR ") + ")"
+	}
+
+	var revise statement
+	if len(assignments) > 0 {
+		revise = statement{
+			sql: fmt.Sprintf(
+				"UPDATE %s SET %s WHERE %s AND %s IS NULL AND %s = $1",
+				table, strings.Join(assignments, ", "), predicate, validTo, quote(ValidFromColumn),
+			),
+			args: closeArgs,
+		}
+	}
+
+	currentPredicate, currentArgs, err := keyPredicate(projection, data, nil)
+	if err != nil {
+		return historyVersion{}, err
+	}
+
+	quoted := make([]string, 0, len(columns)+1)
+	placeholders := make([]string, 0, len(columns)+1)
+	insertArgs := make([]any, 0, len(columns)+1)
+	for _, column := range columns {
+		insertArgs = append(insertArgs, row[column])
+		quoted = append(quoted, quote(column))
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(insertArgs)))
+	}
+	insertArgs = append(insertArgs, at)
+	quoted = append(quoted, quote(ValidFromColumn))
+	placeholders = append(placeholders, fmt.Sprintf("$%d", len(insertArgs)))
+
+	return historyVersion{
+		close:  statement{sql: closeSQL, args: closeArgs},
+		revise: revise,
+		current: statement{
+			sql: fmt.Sprintf(
+				"SELECT EXISTS (SELECT 1 FROM %s WHERE %s AND %s IS NULL)",
+				table, currentPredicate, validTo,
+			),
+			args: currentArgs,
+		},
+		insert: statement{
+			sql: fmt.Sprintf(
+				"INSERT INTO %s (%s) VALUES (%s)",
+				table, strings.Join(quoted, ", "), strings.Join(placeholders, ", "),
+			),
+			args: insertArgs,
+		},
+	}, nil
+}
+
+func quote(column string) string {
+	return pgx.Identifier{column}.Sanitize()
+}
+
+func quoteAll(columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, quote(column))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,292 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+type fakeRow struct {
+	exists bool
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	*(dest[0].(*bool)) = r.exists
+	return nil
+}
+
+type fakeQueryer struct {
+	statements []string
+	current    bool
+}
+
+func (f *fakeQueryer) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	f.statements = append(f.statements, sql)
+	return pgconn.CommandTag{}, nil
+}
+
+func (f *fakeQueryer) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	f.statements = append(f.statements, sql)
+	return fakeRow{exists: f.current}
+}
+
+func replicaProjection(mode domain.ReplicaMode) domain.Projection {
+	return domain.Projection{
+		Name:         "shipment-replica",
+		SourceSchema: "public",
+		SourceTable:  "shipments",
+		PrimaryKeys:  []string{"id", "organization_id"},
+		Fields:       []string{"id", "status", "pro_number"},
+		Destination: domain.Destination{
+			Kind:    domain.DestinationPostgres,
+			Table:   "analytics.shipments",
+			Columns: map[string]string{"id": "shipment_id", "pro_number": "pro"},
+			Mode:    mode,
+		},
+	}
+}
+
+var shipmentRow = map[string]any{
+	"id":              "shp_1",
+	"organization_id": "org_1",
+	"status":          "InTransit",
+	"pro_number":      "PRO-1",
+	"internal_notes":  "not replicated",
+}
+
+func TestBuildUpsertAppliesFieldsAndRenames(t *testing.T) {
+	t.Parallel()
+
+	stmt, err := buildUpsert(replicaProjection(domain.ReplicaModeUpsert), shipmentRow)
+	if err != nil {
+		t.Fatalf("buildUpsert returned error: %v", err)
+	}
+
+	expected := `INSERT INTO "analytics"."shipments" ("organization_id", "pro", "shipment_id", "status") VALUES ($1, $2, $3, $4) ON CONFLICT ("shipment_id", "organization_id") DO UPDATE SET "pro" = EXCLUDED."pro", "status" = EXCLUDED."status"`
+	if stmt.sql != expected {
+		t.Fatalf("unexpected upsert:\n%s", stmt.sql)
+	}
+	if len(stmt.args) != 4 || stmt.args[1] != "PRO-1" || stmt.args[2] != "shp_1" {
+		t.Fatalf("unexpected upsert args: %v", stmt.args)
+	}
+}
+
+func TestBuildUpsertClearsSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	stmt, err := buildUpsert(replicaProjection(domain.ReplicaModeSoftDelete), shipmentRow)
+	if err != nil {
+		t.Fatalf("buildUpsert returned error: %v", err)
+	}
+
+	expected := `INSERT INTO "analytics"."shipments" ("deleted_at", "organization_id", "pro", "shipment_id", "status") VALUES ($1, $2, $3, $4, $5) ON CONFLICT ("shipment_id", "organization_id") DO UPDATE SET "deleted_at" = EXCLUDED."deleted_at", "pro" = EXCLUDED."pro", "status" = EXCLUDED."status"`
+	if stmt.sql != expected {
+		t.Fatalf("unexpected upsert:\n%s", stmt.sql)
+	}
+	if stmt.args[0] != nil {
+		t.Fatalf("expected deleted_at to be cleared, got %v", stmt.args[0])
+	}
+}
+
+func TestBuildDeleteByMode(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[domain.ReplicaMode]string{
+		domain.ReplicaModeUpsert:     `DELETE FROM "analytics"."shipments" WHERE "shipment_id" = $1 AND "organization_id" = $2`,
+		domain.ReplicaModeSoftDelete: `UPDATE "analytics"."shipments" SET "deleted_at" = $1 WHERE "shipment_id" = $2 AND "organization_id" = $3 AND "deleted_at" IS NULL`,
+		domain.ReplicaModeHistory:    `UPDATE "analytics"."shipments" SET "valid_to" = $1 WHERE "shipment_id" = $2 AND "organization_id" = $3 AND "valid_to" IS NULL`,
+	}
+
+	for mode, expected := range tests {
+		stmt, err := buildDelete(replicaProjection(mode), shipmentRow, at)
+		if err != nil {
+			t.Fatalf("%s: buildDelete returned error: %v", mode, err)
+		}
+		if stmt.sql != expected {
+			t.Fatalf("%s: unexpected delete:\n%s", mode, stmt.sql)
+		}
+	}
+}
+
+func TestBuildHistoryVersionClosesOnlyChangedVersions(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	version, err := buildHistoryVersion(
+		replicaProjection(domain.ReplicaModeHistory),
+		shipmentRow,
+		at,
+	)
+	if err != nil {
+		t.Fatalf("buildHistoryVersion returned error: %v", err)
+	}
+
+	expectedClose := `UPDATE "analytics"."shipments" SET "valid_to" = $1 WHERE "shipment_id" = $2 AND "organization_id" = $3 AND "valid_to" IS NULL AND "valid_from" < $1 AND ("pro" IS DISTINCT FROM $4 OR "status" IS DISTINCT FROM $5)`
+	if version.close.sql != expectedClose {
+		t.Fatalf("unexpected close:\n%s", version.close.sql)
+	}
+	expectedRevise := `UPDATE "analytics"."shipments" SET "pro" = $4, "status" = $5 WHERE "shipment_id" = $2 AND "organization_id" = $3 AND "valid_to" IS NULL AND "valid_from" = $1`
+	if version.revise.sql != expectedRevise {
+		t.Fatalf("unexpected revise:\n%s", version.revise.sql)
+	}
+	expectedCurrent := `SELECT EXISTS (SELECT 1 FROM "analytics"."shipments" WHERE "shipment_id" = $1 AND "organization_id" = $2 AND "valid_to" IS NULL)`
+	if version.current.sql != expectedCurrent {
+		t.Fatalf("unexpected current check:\n%s", version.current.sql)
+	}
+	expectedInsert := `INSERT INTO "analytics"."shipments" ("organization_id", "pro", "shipment_id", "status", "valid_from") VALUES ($1, $2, $3, $4, $5)`
+	if version.insert.sql != expectedInsert {
+		t.Fatalf("unexpected insert:\n%s", version.insert.sql)
+	}
+	if version.insert.args[4] != at {
+		t.Fatalf("expected valid_from to be the commit time, got %v", version.insert.args[4])
+	}
+}
+
+func TestApplyHistorySkipsInsertWhenVersionIsCurrent(t *testing.T) {
+	t.Parallel()
+
+	sink := NewSink("", zap.NewNop())
+	record := domain.SourceRecord{
+		Operation: domain.OperationUpdate,
+		Schema:    "public",
+		Table:     "shipments",
+		NewData:   shipmentRow,
+		Metadata:  domain.RecordMetadata{Timestamp: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	unchanged := &fakeQueryer{current: true}
+	if err := sink.apply(context.Background(), unchanged, replicaProjection(domain.ReplicaModeHistory), record); err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+	if len(unchanged.statements) != 3 {
+		t.Fatalf("expected close, revise, and current check only, got %v", unchanged.statements)
+	}
+
+	changed := &fakeQueryer{}
+	if err := sink.apply(context.Background(), changed, replicaProjection(domain.ReplicaModeHistory), record); err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+	if len(changed.statements) != 4 {
+		t.Fatalf("expected a new version to be inserted, got %v", changed.statements)
+	}
+}
+
+// historyTable models one key's versions in a history-mode replica of
+// replicaProjection, enough to run the statements apply issues against it.
+type historyTable struct {
+	versions []*historyVersionRow
+}
+
+type historyVersionRow struct {
+	status    any
+	validFrom time.Time
+	validTo   *time.Time
+}
+
+func (h *historyTable) open() *historyVersionRow {
+	for _, version := range h.versions {
+		if version.validTo == nil {
+			return version
+		}
+	}
+	return nil
+}
+
+// Exec reads the arguments in the layout buildHistoryVersion binds: the
+// commit time, the two key columns, then pro and status; inserts bind the
+// sorted columns followed by valid_from.
+func (h *historyTable) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	open := h.open()
+	switch {
+	case strings.HasPrefix(sql, "INSERT"):
+		h.versions = append(h.versions, &historyVersionRow{status: args[3], validFrom: args[4].(time.Time)})
+		return pgconn.NewCommandTag("INSERT 0 1"), nil
+	case strings.Contains(sql, `"valid_from" < $1`):
+		at := args[0].(time.Time)
+		if open != nil && open.validFrom.Before(at) && open.status != args[4] {
+			open.validTo = &at
+			return pgconn.NewCommandTag("UPDATE 1"), nil
+		}
+	case strings.Contains(sql, `"valid_from" = $1`):
+		if open != nil && open.validFrom.Equal(args[0].(time.Time)) {
+			open.status = args[4]
+			return pgconn.NewCommandTag("UPDATE 1"), nil
+		}
+	}
+	return pgconn.NewCommandTag("UPDATE 0"), nil
+}
+
+func (h *historyTable) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return fakeRow{exists: h.open() != nil}
+}
+
+func TestApplyHistoryKeepsLastChangeWithinTransaction(t *testing.T) {
+	t.Parallel()
+
+	sink := NewSink("", zap.NewNop())
+	projection := replicaProjection(domain.ReplicaModeHistory)
+	firstCommit := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	secondCommit := firstCommit.Add(time.Minute)
+	change := func(operation domain.Operation, status string, at time.Time) domain.SourceRecord {
+		return domain.SourceRecord{
+			Operation: operation,
+			Schema:    "public",
+			Table:     "shipments",
+			NewData:   map[string]any{"id": "shp_1", "organization_id": "org_1", "status": status, "pro_number": "PRO-1"},
+			Metadata:  domain.RecordMetadata{Timestamp: at},
+		}
+	}
+
+	table := &historyTable{}
+	for _, record := range []domain.SourceRecord{
+		change(domain.OperationInsert, "New", firstCommit),
+		change(domain.OperationUpdate, "Assigned", firstCommit),
+		change(domain.OperationUpdate, "InTransit", firstCommit),
+		change(domain.OperationUpdate, "Delivered", secondCommit),
+		change(domain.OperationUpdate, "Delivered", secondCommit),
+	} {
+		if err := sink.apply(context.Background(), table, projection, record); err != nil {
+			t.Fatalf("apply returned error: %v", err)
+		}
+	}
+
+	if len(table.versions) != 2 {
+		t.Fatalf("expected one version per transaction, got %d", len(table.versions))
+	}
+	first, second := table.versions[0], table.versions[1]
+	if first.status != "InTransit" || first.validTo == nil || !first.validTo.Equal(secondCommit) {
+		t.Fatalf("expected the first transaction's last change closed at the second commit, got %+v", first)
+	}
+	if second.status != "Delivered" || second.validTo != nil || !second.validFrom.Equal(secondCommit) {
+		t.Fatalf("expected the second transaction's change to be current, got %+v", second)
+	}
+}
+
+func TestApplyDeletesOldKeyWhenPrimaryKeyChanges(t *testing.T) {
+	t.Parallel()
+
+	sink := NewSink("", zap.NewNop())
+	oldRow := map[string]any{"id": "shp_0", "organization_id": "org_1", "status": "New"}
+	record := domain.SourceRecord{
+		Operation: domain.OperationUpdate,
+		Schema:    "public",
+		Table:     "shipments",
+		OldData:   oldRow,
+		NewData:   shipmentRow,
+	}
+
+	queryer := &fakeQueryer{}
+	if err := sink.apply(context.Background(), queryer, replicaProjection(domain.ReplicaModeUpsert), record); err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+	if len(queryer.statements) != 2 {
+		t.Fatalf("expected delete of the old key then upsert, got %v", queryer.statements)
+	}
+}
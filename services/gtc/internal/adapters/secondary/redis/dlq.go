@@ -8,6 +8,7 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/emoss08/gtc/internal/core/domain"
 	"github.com/emoss08/gtc/internal/core/ports"
+	goredis "github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
@@ -16,7 +17,7 @@ type DeadLetterWriter struct {
 	stream string
 }
 
-var _ ports.DeadLetterWriter = (*DeadLetterWriter)(nil)
+var _ ports.DeadLetterQueue = (*DeadLetterWriter)(nil)
 
 func NewDeadLetterWriter(redisURL string, stream string, logger *zap.Logger) (*DeadLetterWriter, error) {
 	base, err := newBaseSink(redisURL, logger.With(zap.String("mode", "dlq")))
@@ -43,22 +44,60 @@ func (w *DeadLetterWriter) Close() error {
 	return w.client.Close()
 }
 
-type DeadLetterEntry struct {
-	ID     string
-	Record domain.DeadLetterRecord
-}
-
-func (w *DeadLetterWriter) Read(ctx context.Context, limit int64) ([]DeadLetterEntry, error) {
+// Read returns up to limit entries stored after the given stream ID, or from
+// the start of the stream when after is empty.
+func (w *DeadLetterWriter) Read(ctx context.Context, after string, limit int64) ([]domain.DeadLetterEntry, error) {
 	if limit <= 0 {
 		limit = 100
 	}
 
-	entries, err := w.client.XRangeN(ctx, w.stream, "-", "+", limit).Result()
+	start := "-"
+	if after != "" {
+		start = "(" + after
+	}
+
+	entries, err := w.client.XRangeN(ctx, w.stream, start, "+", limit).Result()
 	if err != nil {
 		return nil, fmt.Errorf("read dlq stream: %w", err)
 	}
 
-	results := make([]DeadLetterEntry, 0, len(entries))
+	return decodeEntries(entries)
+}
+
+// Get returns the entries stored under the given stream IDs, skipping IDs
+// that are no longer in the stream.
+func (w *DeadLetterWriter) Get(ctx context.Context, ids ...string) ([]domain.DeadLetterEntry, error) {
+	results := make([]domain.DeadLetterEntry, 0, len(ids))
+	for _, id := range ids {
+		entries, err := w.client.XRange(ctx, w.stream, id, id).Result()
+		if err != nil {
+			return nil, fmt.Errorf("read dlq entry %s: %w", id, err)
+		}
+
+		decoded, err := decodeEntries(entries)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, decoded...)
+	}
+
+	return results, nil
+}
+
+func (w *DeadLetterWriter) Delete(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := w.client.XDel(ctx, w.stream, ids...).Err(); err != nil {
+		return fmt.Errorf("delete dlq entries: %w", err)
+	}
+
+	return nil
+}
+
+func decodeEntries(entries []goredis.XMessage) ([]domain.DeadLetterEntry, error) {
+	results := make([]domain.DeadLetterEntry, 0, len(entries))
 	for _, entry := range entries {
 		raw, ok := entry.Values["payload"]
 		if !ok {
@@ -75,7 +114,7 @@ func (w *DeadLetterWriter) Read(ctx context.Context, limit int64) ([]DeadLetterE
 			return nil, fmt.Errorf("decode dlq payload %s: %w", entry.ID, err)
 		}
 
-		results = append(results, DeadLetterEntry{
+		results = append(results, domain.DeadLetterEntry{
 			ID:     entry.ID,
 			Record: record,
 		})
@@ -84,18 +123,6 @@ func (w *DeadLetterWriter) Read(ctx context.Context, limit int64) ([]DeadLetterE
 	return results, nil
 }
 
-func (w *DeadLetterWriter) Delete(ctx context.Context, ids ...string) error {
-	if len(ids) == 0 {
-		return nil
-	}
-
-	if err := w.client.XDel(ctx, w.stream, ids...).Err(); err != nil {
-		return fmt.Errorf("delete dlq entries: %w", err)
-	}
-
-	return nil
-}
-
 func valueToString(value any) (string, error) {
 	switch typed := value.(type) {
 	case string:
@@ -0,0 +1,156 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// relayScript claims an event's idempotency key and appends the event to the
+// stream in one step, so a relay retried after a crash cannot publish the
+// event twice. A replay (ARGV[1] = "0") publishes regardless and refreshes the
+// claim.
+var relayScript = goredis.NewScript(`
+if ARGV[1] == "1" then
+	if not redis.call("SET", KEYS[2], "1", "NX", "PX", ARGV[2]) then
+		return 0
+	end
+else
+	redis.call("SET", KEYS[2], "1", "PX", ARGV[2])
+end
+redis.call("XADD", KEYS[1], "*", unpack(ARGV, 3))
+return 1
+`)
+
+// OutboxStreamSink relays rows inserted into a transactional outbox table to
+// a Redis stream. Only inserts are events; snapshot rows are history and
+// updates or deletes are the application pruning the table, so all three are
+// skipped. Use Replay to publish history again.
+type OutboxStreamSink struct {
+	*baseSink
+	dedupeWindow time.Duration
+}
+
+var _ ports.OutboxRelay = (*OutboxStreamSink)(nil)
+
+func NewOutboxStreamSink(redisURL string, dedupeWindow time.Duration, logger *zap.Logger) (*OutboxStreamSink, error) {
+	base, err := newBaseSink(redisURL, logger.With(zap.String("mode", "outbox_stream")))
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxStreamSink{baseSink: base, dedupeWindow: dedupeWindow}, nil
+}
+
+func (s *OutboxStreamSink) Kind() domain.DestinationKind {
+	return domain.DestinationOutbox
+}
+
+func (s *OutboxStreamSink) Name() string {
+	return "outbox_stream"
+}
+
+func (s *OutboxStreamSink) Initialize(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *OutboxStreamSink) Write(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error {
+	if record.Operation != domain.OperationInsert {
+		return nil
+	}
+
+	return s.relay(ctx, projection, record, false)
+}
+
+func (s *OutboxStreamSink) Replay(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error {
+	return s.relay(ctx, projection, record, true)
+}
+
+func (s *OutboxStreamSink) HealthCheck(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *OutboxStreamSink) Shutdown(ctx context.Context) error {
+	return s.client.Close()
+}
+
+func (s *OutboxStreamSink) relay(
+	ctx context.Context,
+	projection domain.Projection,
+	record domain.SourceRecord,
+	replay bool,
+) error {
+	event, err := domain.ParseOutboxEvent(record.NewData)
+	if err != nil {
+		return err
+	}
+
+	stream, err := s.renderTemplate(projection.Name, projection.Destination.Stream, projection.PrimaryKeys, record)
+	if err != nil {
+		return err
+	}
+
+	fields, err := outboxFields(event, replay)
+	if err != nil {
+		return err
+	}
+
+	args := make([]any, 0, len(fields)+2)
+	args = append(args, boolFlag(!replay), s.dedupeWindow.Milliseconds())
+	args = append(args, fields...)
+
+	published, err := relayScript.Run(ctx, s.client, []string{stream, relayKey(stream, event.IdempotencyKey)}, args...).Int()
+	if err != nil {
+		return fmt.Errorf("relay outbox event %s: %w", event.ID, err)
+	}
+	if published == 0 {
+		s.logger.Debug("outbox event already relayed",
+			zap.String("stream", stream),
+			zap.String("event_id", event.ID),
+			zap.String("idempotency_key", event.IdempotencyKey),
+		)
+	}
+
+	return nil
+}
+
+// relayKey shares the stream's hash slot so the relay script stays valid on a
+// Redis cluster.
+func relayKey(stream string, idempotencyKey string) string {
+	return "outbox:{" + stream + "}:" + idempotencyKey
+}
+
+func outboxFields(event domain.OutboxEvent, replay bool) ([]any, error) {
+	payload, err := sonic.Marshal(event.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal outbox payload for %s: %w", event.ID, err)
+	}
+
+	return []any{
+		"event_id", event.ID,
+		"idempotency_key", event.IdempotencyKey,
+		"event_type", event.EventType,
+		"event_version", strconv.FormatInt(event.EventVersion, 10),
+		"aggregate_type", event.AggregateType,
+		"aggregate_id", event.AggregateID,
+		"organization_id", event.OrganizationID,
+		"business_unit_id", event.BusinessUnitID,
+		"occurred_at", strconv.FormatInt(event.OccurredAt, 10),
+		"payload", string(payload),
+		"replayed", boolFlag(replay),
+	}, nil
+}
+
+func boolFlag(value bool) string {
+	if value {
+		return "1"
+	}
+	return "0"
+}
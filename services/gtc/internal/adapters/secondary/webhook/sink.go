@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+const (
+	SignatureHeader      = "X-GTC-Signature"
+	TimestampHeader      = "X-GTC-Timestamp"
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// DefaultMaxConcurrency bounds in-flight deliveries to one endpoint when
+	// the destination does not set max_concurrency.
+	DefaultMaxConcurrency = 2
+
+	maxResponseDrain = 64 << 10
+)
+
+// Sink POSTs each committed transaction's records for a projection to the
+// destination URL as one signed JSON batch. Deliveries are limited per
+// endpoint URL, so a slow receiver can occupy at most max_concurrency
+// workers; the rest wait for a slot only until the process timeout and then
+// fail through the runtime's retry and DLQ path.
+type Sink struct {
+	client    *http.Client
+	logger    *zap.Logger
+	now       func() time.Time
+	mu        sync.Mutex
+	endpoints map[string]chan struct{}
+}
+
+var _ ports.BatchSink = (*Sink)(nil)
+
+type batchPayload struct {
+	Projection    string          `json:"projection"`
+	TransactionID uint32          `json:"transaction_id,omitempty"`
+	CommitLSN     string          `json:"commit_lsn,omitempty"`
+	Timestamp     *time.Time      `json:"timestamp,omitempty"`
+	Records       []recordPayload `json:"records"`
+}
+
+type recordPayload struct {
+	Operation     domain.Operation `json:"operation"`
+	Schema        string           `json:"schema"`
+	Table         string           `json:"table"`
+	PrimaryKey    map[string]any   `json:"primary_key,omitempty"`
+	NewData       map[string]any   `json:"new_data,omitempty"`
+	OldData       map[string]any   `json:"old_data,omitempty"`
+	ChangedFields []string         `json:"changed_fields,omitempty"`
+	LSN           string           `json:"lsn,omitempty"`
+	Snapshot      bool             `json:"snapshot,omitempty"`
+}
+
+func NewSink(logger *zap.Logger) *Sink {
+	return &Sink{
+		client:    &http.Client{},
+		logger:    logger.Named("webhook_sink"),
+		now:       time.Now,
+		endpoints: make(map[string]chan struct{}),
+	}
+}
+
+func (s *Sink) Kind() domain.DestinationKind {
+	return domain.DestinationWebhook
+}
+
+func (s *Sink) Name() string {
+	return "webhook"
+}
+
+func (s *Sink) Initialize(ctx context.Context) error {
+	return nil
+}
+
+func (s *Sink) Write(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error {
+	return s.WriteBatch(ctx, projection, []domain.SourceRecord{record})
+}
+
+func (s *Sink) WriteBatch(ctx context.Context, projection domain.Projection, records []domain.SourceRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	body, err := encodeBatch(projection, records)
+	if err != nil {
+		return err
+	}
+
+	release, err := s.acquire(ctx, projection.Destination)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, projection.Destination.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	digest := sha256.Sum256(body)
+	for name, value := range projection.Destination.Headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(projection.Destination.Secret, timestamp, body))
+	req.Header.Set(IdempotencyKeyHeader, hex.EncodeToString(digest[:]))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook %s: %w", projection.Name, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseDrain))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook %s returned %s", projection.Name, resp.Status)
+	}
+
+	s.logger.Debug("delivered webhook batch",
+		zap.String("projection", projection.Name),
+		zap.Int("records", len(records)),
+		zap.Int("status", resp.StatusCode),
+	)
+
+	return nil
+}
+
+func (s *Sink) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+func (s *Sink) Shutdown(ctx context.Context) error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// Sign returns the signature header value for a delivery: the hex HMAC-SHA256
+// of the timestamp, a dot, and the raw request body.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Sink) acquire(ctx context.Context, destination domain.Destination) (func(), error) {
+	slots := s.slots(destination)
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait for webhook delivery slot: %w", ctx.Err())
+	}
+}
+
+func (s *Sink) slots(destination domain.Destination) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slots, ok := s.endpoints[destination.URL]
+	if !ok {
+		limit := destination.MaxConcurrency
+		if limit <= 0 {
+			limit = DefaultMaxConcurrency
+		}
+		slots = make(chan struct{}, limit)
+		s.endpoints[destination.URL] = slots
+	}
+
+	return slots
+}
+
+func encodeBatch(projection domain.Projection, records []domain.SourceRecord) ([]byte, error) {
+	first := records[0].Metadata
+	payload := batchPayload{
+		Projection:    projection.Name,
+		TransactionID: first.TransactionID,
+		CommitLSN:     first.CommitLSN,
+		Records:       make([]recordPayload, 0, len(records)),
+	}
+	if !first.Timestamp.IsZero() {
+		timestamp := first.Timestamp.UTC()
+		payload.Timestamp = &timestamp
+	}
+
+	for _, record := range records {
+		encoded, err := encodeRecord(projection, record)
+		if err != nil {
+			return nil, err
+		}
+		payload.Records = append(payload.Records, encoded)
+	}
+
+	// Sorted keys keep the body, and so the idempotency key, stable across
+	// retries of the same batch.
+	body, err := sonic.ConfigStd.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	return body, nil
+}
+
+func encodeRecord(projection domain.Projection, record domain.SourceRecord) (recordPayload, error) {
+	encoded := recordPayload{
+		Operation: record.Operation,
+		Schema:    record.Schema,
+		Table:     record.Table,
+		LSN:       record.Metadata.LSN,
+		Snapshot:  record.Metadata.Snapshot,
+	}
+	// A truncate carries no row, so the receiver gets the operation alone.
+	if record.Operation == domain.OperationTruncate {
+		return encoded, nil
+	}
+
+	keyValues, err := domain.PrimaryKey(record, projection.PrimaryKeys)
+	if err != nil {
+		return recordPayload{}, err
+	}
+	encoded.PrimaryKey = make(map[string]any, len(keyValues))
+	for idx, field := range projection.PrimaryKeys {
+		encoded.PrimaryKey[field] = keyValues[idx]
+	}
+	if record.NewData != nil {
+		if encoded.NewData, err = domain.SelectFields(record.NewData, projection.Fields); err != nil {
+			return recordPayload{}, err
+		}
+	}
+	if record.OldData != nil {
+		if encoded.OldData, err = domain.SelectFields(record.OldData, projection.Fields); err != nil {
+			return recordPayload{}, err
+		}
+	}
+	if record.Operation == domain.OperationUpdate {
+		encoded.ChangedFields = domain.ChangedFields(record.OldData, record.NewData)
+		// Only name the columns the projection selects; the raw rows carry
+		// every column of the source table.
+		if len(projection.Fields) > 0 {
+			encoded.ChangedFields = slices.DeleteFunc(encoded.ChangedFields, func(field string) bool {
+				return !slices.Contains(projection.Fields, field)
+			})
+		}
+		slices.Sort(encoded.ChangedFields)
+	}
+
+	return encoded, nil
+}
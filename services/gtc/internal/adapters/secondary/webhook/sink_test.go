@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+func webhookProjection(url string) domain.Projection {
+	return domain.Projection{
+		Name:         "shipment-lake",
+		SourceSchema: "public",
+		SourceTable:  "shipments",
+		PrimaryKeys:  []string{"id"},
+		Destination: domain.Destination{
+			Kind:    domain.DestinationWebhook,
+			URL:     url,
+			Secret:  "lake-secret",
+			Headers: map[string]string{"X-Source": "gtc"},
+		},
+	}
+}
+
+func TestWriteBatchPostsSignedTransactionBatch(t *testing.T) {
+	t.Parallel()
+
+	type delivery struct {
+		header http.Header
+		body   []byte
+	}
+	deliveries := make(chan delivery, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		deliveries <- delivery{header: r.Header.Clone(), body: body}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := NewSink(zap.NewNop())
+	sink.now = func() time.Time { return time.Unix(1_700_000_000, 0) }
+	metadata := domain.RecordMetadata{CommitLSN: "0/20", TransactionID: 42}
+	records := []domain.SourceRecord{
+		{
+			Operation: domain.OperationInsert,
+			Schema:    "public",
+			Table:     "shipments",
+			NewData:   map[string]any{"id": "shp_1", "status": "New"},
+			Metadata:  metadata,
+		},
+		{
+			Operation: domain.OperationUpdate,
+			Schema:    "public",
+			Table:     "shipments",
+			OldData:   map[string]any{"id": "shp_2", "status": "New"},
+			NewData:   map[string]any{"id": "shp_2", "status": "InTransit"},
+			Metadata:  metadata,
+		},
+	}
+
+	if err := sink.WriteBatch(context.Background(), webhookProjection(server.URL), records); err != nil {
+		t.Fatalf("WriteBatch returned error: %v", err)
+	}
+
+	got := <-deliveries
+	if got.header.Get(TimestampHeader) != "1700000000" {
+		t.Fatalf("expected delivery timestamp header, got %q", got.header.Get(TimestampHeader))
+	}
+	if want := Sign("lake-secret", "1700000000", got.body); got.header.Get(SignatureHeader) != want {
+		t.Fatalf("expected signature %q, got %q", want, got.header.Get(SignatureHeader))
+	}
+	if got.header.Get("X-Source") != "gtc" {
+		t.Fatalf("expected configured header to be sent")
+	}
+	if got.header.Get(IdempotencyKeyHeader) == "" {
+		t.Fatalf("expected idempotency key header")
+	}
+
+	var payload batchPayload
+	if err := json.Unmarshal(got.body, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.CommitLSN != "0/20" || payload.TransactionID != 42 {
+		t.Fatalf("expected transaction metadata on the batch, got %+v", payload)
+	}
+	if len(payload.Records) != 2 {
+		t.Fatalf("expected both records in one batch, got %d", len(payload.Records))
+	}
+	if payload.Records[1].PrimaryKey["id"] != "shp_2" {
+		t.Fatalf("expected primary key on record, got %v", payload.Records[1].PrimaryKey)
+	}
+	if len(payload.Records[1].ChangedFields) != 1 || payload.Records[1].ChangedFields[0] != "status" {
+		t.Fatalf("expected changed fields on update, got %v", payload.Records[1].ChangedFields)
+	}
+}
+
+func TestWriteBatchNamesOnlySelectedChangedFields(t *testing.T) {
+	t.Parallel()
+
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- body
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	projection := webhookProjection(server.URL)
+	projection.Fields = []string{"id", "status"}
+	records := []domain.SourceRecord{{
+		Operation: domain.OperationUpdate,
+		Schema:    "public",
+		Table:     "shipments",
+		OldData:   map[string]any{"id": "shp_1", "status": "New", "internal_notes": "a"},
+		NewData:   map[string]any{"id": "shp_1", "status": "InTransit", "internal_notes": "b"},
+		Metadata:  domain.RecordMetadata{CommitLSN: "0/20", TransactionID: 42},
+	}}
+
+	if err := NewSink(zap.NewNop()).WriteBatch(context.Background(), projection, records); err != nil {
+		t.Fatalf("WriteBatch returned error: %v", err)
+	}
+
+	var payload batchPayload
+	if err := json.Unmarshal(<-bodies, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	changed := payload.Records[0].ChangedFields
+	if len(changed) != 1 || changed[0] != "status" {
+		t.Fatalf("expected only selected changed fields, got %v", changed)
+	}
+}
+
+func TestWriteBatchReturnsErrorForNonSuccessStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink := NewSink(zap.NewNop())
+	record := domain.SourceRecord{
+		Operation: domain.OperationDelete,
+		Schema:    "public",
+		Table:     "shipments",
+		OldData:   map[string]any{"id": "shp_1"},
+	}
+
+	if err := sink.Write(context.Background(), webhookProjection(server.URL), record); err == nil {
+		t.Fatalf("expected error for 503 response")
+	}
+}
+
+func TestWriteBatchLimitsConcurrencyPerEndpoint(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	sink := NewSink(zap.NewNop())
+	projection := webhookProjection(server.URL)
+	projection.Destination.MaxConcurrency = 1
+	record := domain.SourceRecord{
+		Operation: domain.OperationInsert,
+		Schema:    "public",
+		Table:     "shipments",
+		NewData:   map[string]any{"id": "shp_1"},
+	}
+
+	go func() {
+		_ = sink.Write(context.Background(), projection, record)
+	}()
+	deadline := time.Now().Add(time.Second)
+	for len(sink.slots(projection.Destination)) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("first delivery never took the endpoint slot")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := sink.Write(ctx, projection, record)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected second delivery to time out waiting for a slot, got %v", err)
+	}
+}
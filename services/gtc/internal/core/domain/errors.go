@@ -11,4 +11,9 @@ var (
 	ErrWALConnectionLost  = errors.New("WAL connection lost")
 	ErrInvalidEvent       = errors.New("invalid CDC event")
 	ErrCircuitOpen        = errors.New("circuit breaker is open")
+	ErrProjectionNotFound = errors.New("projection not found")
+	ErrProjectionState    = errors.New("projection state does not allow this operation")
+	ErrBackfillRunning    = errors.New("a backfill is already running")
+	ErrDeadLetterNotFound = errors.New("dead-letter entry not found")
+	ErrDeadLetterQueue    = errors.New("dead-letter queue does not support inspection")
 )
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// OutboxEvent is a row of a transactional outbox table. The application
+// writes it in the same transaction as the change it describes, so the event
+// is relayed exactly when the change commits.
+type OutboxEvent struct {
+	ID             string
+	IdempotencyKey string
+	EventType      string
+	EventVersion   int64
+	AggregateType  string
+	AggregateID    string
+	OrganizationID string
+	BusinessUnitID string
+	OccurredAt     int64
+	Payload        any
+}
+
+// OutboxQuery selects the outbox events a replay publishes again. Since and
+// Until bound occurred_at; a zero Until leaves the window open.
+type OutboxQuery struct {
+	Since       time.Time
+	Until       time.Time
+	EventTypes  []string
+	AggregateID string
+}
+
+// ParseOutboxEvent reads an outbox row as decoded from the WAL or a snapshot.
+func ParseOutboxEvent(data map[string]any) (OutboxEvent, error) {
+	if data == nil {
+		return OutboxEvent{}, fmt.Errorf("%w: outbox row is empty", ErrInvalidEvent)
+	}
+
+	version, err := outboxInt(data["event_version"])
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("%w: event_version: %w", ErrInvalidEvent, err)
+	}
+	occurredAt, err := outboxInt(data["occurred_at"])
+	if err != nil {
+		return OutboxEvent{}, fmt.Errorf("%w: occurred_at: %w", ErrInvalidEvent, err)
+	}
+
+	event := OutboxEvent{
+		ID:             outboxString(data["id"]),
+		IdempotencyKey: outboxString(data["idempotency_key"]),
+		EventType:      outboxString(data["event_type"]),
+		EventVersion:   version,
+		AggregateType:  outboxString(data["aggregate_type"]),
+		AggregateID:    outboxString(data["aggregate_id"]),
+		OrganizationID: outboxString(data["organization_id"]),
+		BusinessUnitID: outboxString(data["business_unit_id"]),
+		OccurredAt:     occurredAt,
+		Payload:        data["payload"],
+	}
+	if event.ID == "" || event.IdempotencyKey == "" || event.EventType == "" {
+		return OutboxEvent{}, fmt.Errorf("%w: outbox row requires id, idempotency_key and event_type", ErrInvalidEvent)
+	}
+
+	return event, nil
+}
+
+func outboxString(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case []byte:
+		return string(typed)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+func outboxInt(value any) (int64, error) {
+	switch typed := value.(type) {
+	case nil:
+		return 0, nil
+	case int16:
+		return int64(typed), nil
+	case int32:
+		return int64(typed), nil
+	case int64:
+		return typed, nil
+	case int:
+		return int64(typed), nil
+	case float64:
+		return int64(typed), nil
+	case string:
+		return strconv.ParseInt(typed, 10, 64)
+	default:
+		return 0, fmt.Errorf("unsupported integer value %T", value)
+	}
+}
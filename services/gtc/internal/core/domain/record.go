@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -30,6 +32,37 @@ const (
 	DestinationRedisJSON   DestinationKind = "redis_json"
 	DestinationRedisStream DestinationKind = "redis_stream"
 	DestinationTCAStream   DestinationKind = "tca_stream"
+	DestinationWebhook     DestinationKind = "webhook"
+	DestinationPostgres    DestinationKind = "postgres"
+	DestinationOutbox      DestinationKind = "outbox_stream"
+)
+
+// ReplicaMode controls how a postgres destination applies deletes and
+// updates to its target table.
+type ReplicaMode string
+
+const (
+	ReplicaModeUpsert     ReplicaMode = "upsert"
+	ReplicaModeSoftDelete ReplicaMode = "soft_delete"
+	ReplicaModeHistory    ReplicaMode = "history"
+)
+
+// DeadLetterPolicy controls whether a write that is dead-lettered still fails
+// its transaction, holding the WAL checkpoint, or lets the stream move past it.
+type DeadLetterPolicy string
+
+const (
+	DeadLetterStop     DeadLetterPolicy = "stop"
+	DeadLetterContinue DeadLetterPolicy = "continue"
+)
+
+// SchemaChangePolicy controls what a projection does when the columns of its
+// source table change while GTC is replicating it.
+type SchemaChangePolicy string
+
+const (
+	SchemaChangePause      SchemaChangePolicy = "pause"
+	SchemaChangeResnapshot SchemaChangePolicy = "resnapshot"
 )
 
 type RecordMetadata struct {
@@ -59,18 +92,72 @@ type TransactionRecords struct {
 	TransactionID uint32
 	Timestamp     time.Time
 	Records       []SourceRecord
+	Schemas       []TableSchema
+}
+
+// TableSchema is a table's column layout as announced by a relation message
+// in the replication stream.
+type TableSchema struct {
+	Schema  string
+	Table   string
+	Columns []ColumnMetadata
+}
+
+func (t TableSchema) FullTableName() string {
+	return fmt.Sprintf("%s.%s", t.Schema, t.Table)
 }
 
+// DeadLetterRecord is a record a projection could not apply. Parked records
+// were set aside while the projection was paused rather than after failed
+// writes, and are replayed when it resumes. RulesPending marks a record whose
+// filter or computed fields failed, so it is stored as it left the source and
+// a replay runs the rules again before writing it.
 type DeadLetterRecord struct {
 	TransactionID uint32
 	CommitLSN     string
 	Projection    string
 	Error         string
 	Attempts      int
+	Parked        bool
+	RulesPending  bool
 	Record        SourceRecord
 	CreatedAt     time.Time
 }
 
+// DeadLetterEntry is a stored dead-letter record and the ID it is stored
+// under.
+type DeadLetterEntry struct {
+	ID     string
+	Record DeadLetterRecord
+}
+
+// ProjectionState is how a projection is currently handling its changes.
+type ProjectionState string
+
+const (
+	ProjectionRunning     ProjectionState = "running"
+	ProjectionPaused      ProjectionState = "paused"
+	ProjectionBackfilling ProjectionState = "backfilling"
+	// ProjectionSchemaPaused projections stopped on a source schema change and
+	// need a backfill and a restart rather than a resume.
+	ProjectionSchemaPaused ProjectionState = "schema_paused"
+)
+
+// ProjectionStatus reports a projection's state, how far it trails the
+// replication stream, and its write failures since GTC started.
+type ProjectionStatus struct {
+	Name          string          `json:"name"`
+	Table         string          `json:"table"`
+	Destination   DestinationKind `json:"destination"`
+	State         ProjectionState `json:"state"`
+	Reason        string          `json:"reason,omitempty"`
+	LagBytes      uint64          `json:"lag_bytes"`
+	HeldRecords   int             `json:"held_records"`
+	WriteErrors   int64           `json:"write_errors"`
+	DeadLetters   int64           `json:"dead_letters"`
+	LastCommitLSN string          `json:"last_commit_lsn,omitempty"`
+}
+
 func (r SourceRecord) FullTableName() string {
 	return fmt.Sprintf("%s.%s", r.Schema, r.Table)
 }
@@ -83,10 +170,29 @@ func (r SourceRecord) PrimaryData() map[string]any {
 }
 
 type Destination struct {
-	Kind        DestinationKind
-	Index       string
-	KeyTemplate string
-	Stream      string
+	Kind           DestinationKind
+	Index          string
+	KeyTemplate    string
+	Stream         string
+	URL            string
+	Secret         string
+	Headers        map[string]string
+	MaxConcurrency int
+	Table          string
+	Columns        map[string]string
+	Mode           ReplicaMode
+	OnDeadLetter   DeadLetterPolicy
+}
+
+// HoldsRowState reports whether the destination keeps the latest state of
+// each row, so writing an older change over it would lose a newer one.
+func (d Destination) HoldsRowState() bool {
+	switch d.Kind {
+	case DestinationMeilisearch, DestinationRedisJSON, DestinationPostgres:
+		return true
+	default:
+		return false
+	}
 }
 
 type Projection struct {
@@ -98,6 +204,9 @@ type Projection struct {
 	SearchableFields []string
 	FilterableFields []string
 	IgnoredUpdates   []string
+	Filter           string
+	ComputedFields   map[string]string
+	OnSchemaChange   SchemaChangePolicy
 	Destination      Destination
 }
 
@@ -111,14 +220,54 @@ type SnapshotBinding struct {
 	PrimaryKeys []string
 }
 
+// SnapshotChunkStatus reports one primary-key range of a table snapshot.
+// Tables small enough to snapshot in one pass report a single chunk.
+type SnapshotChunkStatus struct {
+	Table      string `json:"table"`
+	Chunk      int    `json:"chunk"`
+	Chunks     int    `json:"chunks"`
+	RowsCopied int64  `json:"rows_copied"`
+	Completed  bool   `json:"completed"`
+}
+
 func (b SnapshotBinding) FullTableName() string {
 	return fmt.Sprintf("%s.%s", b.Schema, b.Table)
 }
 
+// ColumnType is the expression type a source column is checked as. Values
+// are normalized to it before filters and computed fields run.
+type ColumnType string
+
+const (
+	ColumnTypeString  ColumnType = "string"
+	ColumnTypeInt     ColumnType = "int"
+	ColumnTypeFloat   ColumnType = "float"
+	ColumnTypeBool    ColumnType = "bool"
+	ColumnTypeUnknown ColumnType = "unknown"
+)
+
+type ColumnMetadata struct {
+	Name    string
+	Type    ColumnType
+	TypeOID uint32
+}
+
+// SchemaVersion fingerprints a column layout by column order, name, and type,
+// so a column that is added, dropped, renamed, or retyped changes the version.
+func SchemaVersion(columns []ColumnMetadata) string {
+	hasher := sha256.New()
+	for _, column := range columns {
+		fmt.Fprintf(hasher, "%s:%d\n", column.Name, column.TypeOID)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil))[:16]
+}
+
 type TableMetadata struct {
 	Schema      string
 	Table       string
 	PrimaryKeys []string
+	Columns     []ColumnMetadata
 }
 
 func (m TableMetadata) FullTableName() string {
@@ -23,10 +23,14 @@ type SnapshotReader interface {
 	HealthCheck(ctx context.Context) error
 }
 
+// SnapshotProgress is the resumable position of a table snapshot, or of one
+// chunk of it. A chunked table's own entry records the chunk boundaries so a
+// restart resumes the same ranges.
 type SnapshotProgress struct {
-	TableName string
-	Cursor    string
-	Completed bool
+	TableName   string
+	Cursor      string
+	Completed   bool
+	ChunkBounds string
 }
 
 type MetadataStore interface {
@@ -42,6 +46,10 @@ type CheckpointStore interface {
 	SaveWALLSN(ctx context.Context, lsn string) error
 	LoadSnapshotProgress(ctx context.Context, tableName string) (SnapshotProgress, error)
 	SaveSnapshotProgress(ctx context.Context, progress SnapshotProgress) error
+	LoadSchemaVersion(ctx context.Context, projection string) (string, error)
+	SaveSchemaVersion(ctx context.Context, projection string, version string) error
+	LoadPausedProjections(ctx context.Context) ([]string, error)
+	SaveProjectionPaused(ctx context.Context, projection string, paused bool) error
 }
 
 type Sink interface {
@@ -53,6 +61,52 @@ type Sink interface {
 	Shutdown(ctx context.Context) error
 }
 
+// BatchSink is implemented by sinks that deliver every record a committed
+// transaction produced for a projection in a single write. Snapshot, backfill,
+// and DLQ replay records still arrive one at a time through Write.
+type BatchSink interface {
+	Sink
+	WriteBatch(ctx context.Context, projection domain.Projection, records []domain.SourceRecord) error
+}
+
+// RebuildableSink is implemented by sinks that can clear a projection's target
+// so a backfill repopulates it from scratch.
+type RebuildableSink interface {
+	Sink
+	Rebuild(ctx context.Context, projection domain.Projection) error
+}
+
+// OutboxRelay is implemented by sinks that publish transactional outbox
+// events. Write skips events it has already published; Replay publishes them
+// again regardless.
+type OutboxRelay interface {
+	Sink
+	Replay(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error
+}
+
+// OutboxReader is implemented by snapshot readers that can page through an
+// outbox table by the time its events occurred.
+type OutboxReader interface {
+	ReadOutbox(
+		ctx context.Context,
+		binding domain.SnapshotBinding,
+		query domain.OutboxQuery,
+		handler RecordHandler,
+	) error
+}
+
 type DeadLetterWriter interface {
 	Write(ctx context.Context, entry domain.DeadLetterRecord) error
 }
+
+// DeadLetterQueue is implemented by dead-letter writers whose entries can be
+// read back and removed. Pausing projections and the admin DLQ operations
+// require it.
+type DeadLetterQueue interface {
+	DeadLetterWriter
+	// Read returns up to limit entries stored after the given ID, or from the
+	// start of the queue when after is empty.
+	Read(ctx context.Context, after string, limit int64) ([]domain.DeadLetterEntry, error)
+	Get(ctx context.Context, ids ...string) ([]domain.DeadLetterEntry, error)
+	Delete(ctx context.Context, ids ...string) error
+}
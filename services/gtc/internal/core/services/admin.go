@@ -0,0 +1,673 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+	"github.com/jackc/pglogrepl"
+	"go.uber.org/zap"
+)
+
+const (
+	parkedReason   = "projection paused"
+	parkedPageSize = 100
+)
+
+// projectionHold collects the live changes of a paused projection. Held
+// transactions keep the WAL checkpoint where it was until the projection
+// resumes, or until the checkpoint would trail the stream by more than the
+// pause lag bound; the held records are then parked on the DLQ so the other
+// projections keep confirming WAL.
+type projectionHold struct {
+	batches  []heldBatch
+	records  int
+	spills   int
+	pausedAt uint64
+	operator bool
+	backfill bool
+}
+
+type heldBatch struct {
+	seq     uint64
+	records []domain.SourceRecord
+}
+
+type projectionStats struct {
+	writeErrors   atomic.Int64
+	deadLetters   atomic.Int64
+	lastCommitLSN atomic.Uint64
+}
+
+// Pause stops writing the projection's changes while the rest keep running.
+// The pause is recorded with the checkpoints, so the projection stays paused
+// across restarts until it is resumed.
+func (r *Runtime) Pause(ctx context.Context, name string) error {
+	if _, err := r.projectionByName(name); err != nil {
+		return err
+	}
+	if _, err := r.deadLetterQueue(); err != nil {
+		return fmt.Errorf("pause projection %s: %w", name, err)
+	}
+
+	if err := r.checkpoints.SaveProjectionPaused(ctx, name, true); err != nil {
+		return fmt.Errorf("save paused projection %s: %w", name, err)
+	}
+	r.hold(name, true)
+
+	r.logger.Info("projection paused by operator", zap.String("projection", name))
+	return nil
+}
+
+// Resume applies the records parked and held while the projection was paused,
+// oldest first, and then routes its changes to the sink again.
+func (r *Runtime) Resume(ctx context.Context, name string) error {
+	if _, err := r.projectionByName(name); err != nil {
+		return err
+	}
+
+	r.holdMu.Lock()
+	hold, held := r.held[name]
+	r.holdMu.Unlock()
+	if !held {
+		return fmt.Errorf("%w: projection %s is not paused", domain.ErrProjectionState, name)
+	}
+	if hold.backfill {
+		return fmt.Errorf("%w: projection %s is backfilling", domain.ErrProjectionState, name)
+	}
+
+	if err := r.release(ctx, name); err != nil {
+		return fmt.Errorf("resume projection %s: %w", name, err)
+	}
+	if err := r.checkpoints.SaveProjectionPaused(ctx, name, false); err != nil {
+		return fmt.Errorf("save resumed projection %s: %w", name, err)
+	}
+
+	r.logger.Info("projection resumed by operator", zap.String("projection", name))
+	return nil
+}
+
+// StartBackfill backfills the selected projections in the background while
+// the runtime keeps tailing. Each projection is held for the duration, so
+// changes made during the copy are applied after the snapshot rows instead of
+// being overwritten by them.
+func (r *Runtime) StartBackfill(projectionNames []string, tableNames []string, rebuild bool) error {
+	r.projectionMu.RLock()
+	projections, err := r.filterProjections(projectionNames, tableNames)
+	r.projectionMu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if rebuild {
+		if _, err := r.rebuilders(projections); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, 0, len(projections))
+	for _, projection := range projections {
+		names = append(names, projection.Name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.backfillMu.Lock()
+	if r.backfillCancel != nil {
+		r.backfillMu.Unlock()
+		cancel()
+		return domain.ErrBackfillRunning
+	}
+	r.backfillCancel = cancel
+	r.backfillMu.Unlock()
+
+	held := make([]string, 0, len(names))
+	for _, name := range names {
+		if r.holdForBackfill(name) {
+			held = append(held, name)
+		}
+	}
+
+	r.backfills.Add(1)
+	go func() {
+		defer r.backfills.Done()
+		defer func() {
+			r.backfillMu.Lock()
+			r.backfillCancel = nil
+			r.backfillMu.Unlock()
+			cancel()
+		}()
+
+		backfill := r.Backfill
+		if rebuild {
+			backfill = r.Rebuild
+		}
+		if err := backfill(ctx, names, nil); err != nil {
+			r.logger.Error("backfill failed", zap.Strings("projections", names), zap.Error(err))
+		} else {
+			r.logger.Info("backfill completed", zap.Strings("projections", names), zap.Bool("rebuild", rebuild))
+		}
+
+		for _, name := range held {
+			if err := r.releaseBackfill(ctx, name); err != nil {
+				r.logger.Error("projection stays paused after backfill",
+					zap.String("projection", name),
+					zap.Error(err),
+				)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ProjectionStatuses reports every projection's state, lag behind the
+// replication stream, and write failures since GTC started.
+func (r *Runtime) ProjectionStatuses() []domain.ProjectionStatus {
+	received := r.receivedLSN.Load()
+	checkpoint := r.checkpointLSN.Load()
+
+	r.projectionMu.RLock()
+	projections := slices.Clone(r.projections)
+	paused := maps.Clone(r.paused)
+	r.projectionMu.RUnlock()
+
+	statuses := make([]domain.ProjectionStatus, 0, len(projections))
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+	for _, projection := range projections {
+		status := domain.ProjectionStatus{
+			Name:        projection.Name,
+			Table:       projection.FullTableName(),
+			Destination: projection.Destination.Kind,
+			State:       domain.ProjectionRunning,
+		}
+
+		applied := checkpoint
+		if hold, ok := r.held[projection.Name]; ok {
+			status.State = domain.ProjectionPaused
+			if hold.backfill && !hold.operator {
+				status.State = domain.ProjectionBackfilling
+			}
+			status.HeldRecords = hold.records
+			if hold.pausedAt != 0 {
+				applied = min(applied, hold.pausedAt)
+			}
+		}
+		if reason, ok := paused[projection.Name]; ok {
+			status.State = domain.ProjectionSchemaPaused
+			status.Reason = reason
+		}
+		if received > applied {
+			status.LagBytes = received - applied
+		}
+
+		if stats, ok := r.stats[projection.Name]; ok {
+			status.WriteErrors = stats.writeErrors.Load()
+			status.DeadLetters = stats.deadLetters.Load()
+			if lsn := stats.lastCommitLSN.Load(); lsn != 0 {
+				status.LastCommitLSN = pglogrepl.LSN(lsn).String()
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// DeadLetters returns up to limit DLQ entries stored after the given ID.
+func (r *Runtime) DeadLetters(ctx context.Context, after string, limit int64) ([]domain.DeadLetterEntry, error) {
+	queue, err := r.deadLetterQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	return queue.Read(ctx, after, limit)
+}
+
+func (r *Runtime) DeadLetter(ctx context.Context, id string) (domain.DeadLetterEntry, error) {
+	entries, err := r.deadLetterEntries(ctx, []string{id})
+	if err != nil {
+		return domain.DeadLetterEntry{}, err
+	}
+
+	return entries[0], nil
+}
+
+// ReplayDeadLetterEntries writes the given DLQ entries through their
+// projections and deletes each one once it is applied. A failed entry stays
+// on the DLQ and stops the replay.
+func (r *Runtime) ReplayDeadLetterEntries(ctx context.Context, ids []string) (int, error) {
+	if err := r.prepare(ctx, false); err != nil {
+		return 0, err
+	}
+
+	entries, err := r.deadLetterEntries(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	for idx, entry := range entries {
+		projection, err := r.projectionByName(entry.Record.Projection)
+		if err != nil {
+			return idx, err
+		}
+		if entry.Record.Parked && r.isHeld(projection.Name) {
+			return idx, fmt.Errorf(
+				"%w: projection %s is paused; resume it to replay entry %s",
+				domain.ErrProjectionState,
+				projection.Name,
+				entry.ID,
+			)
+		}
+		if err := r.replayEntry(ctx, projection, entry); err != nil {
+			return idx, err
+		}
+	}
+
+	return len(entries), nil
+}
+
+func (r *Runtime) PurgeDeadLetters(ctx context.Context, ids []string) error {
+	queue, err := r.deadLetterQueue()
+	if err != nil {
+		return err
+	}
+
+	return queue.Delete(ctx, ids...)
+}
+
+func (r *Runtime) deadLetterQueue() (ports.DeadLetterQueue, error) {
+	queue, ok := r.dlqWriter.(ports.DeadLetterQueue)
+	if !ok {
+		return nil, domain.ErrDeadLetterQueue
+	}
+
+	return queue, nil
+}
+
+func (r *Runtime) deadLetterEntries(ctx context.Context, ids []string) ([]domain.DeadLetterEntry, error) {
+	queue, err := r.deadLetterQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := queue.Get(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		found := slices.ContainsFunc(entries, func(entry domain.DeadLetterEntry) bool {
+			return entry.ID == id
+		})
+		if !found {
+			return nil, fmt.Errorf("%w: %s", domain.ErrDeadLetterNotFound, id)
+		}
+	}
+
+	return entries, nil
+}
+
+// replayEntry writes a DLQ entry without dead-lettering it again on failure,
+// so a failed replay leaves the original entry in place. An entry whose rules
+// failed has them run first, and is dropped if the filter now excludes it.
+func (r *Runtime) replayEntry(ctx context.Context, projection domain.Projection, entry domain.DeadLetterEntry) error {
+	sink, err := r.projectionSink(projection)
+	if err != nil {
+		return err
+	}
+	queue, err := r.deadLetterQueue()
+	if err != nil {
+		return err
+	}
+
+	record := entry.Record.Record
+	if !entry.Record.Parked {
+		if err := r.checkReplayOrder(projection, record); err != nil {
+			return fmt.Errorf("replay dlq entry %s: %w", entry.ID, err)
+		}
+	}
+	if entry.Record.RulesPending {
+		var ok bool
+		record, ok, err = r.evaluateRules(projection, record)
+		if err != nil {
+			return fmt.Errorf("replay dlq entry %s: %w", entry.ID, err)
+		}
+		if !ok {
+			return queue.Delete(ctx, entry.ID)
+		}
+	}
+
+	_, _, lastErr := r.writeWithRetry(ctx, projection, sink, func(writeCtx context.Context) error {
+		return sink.Write(writeCtx, projection, record)
+	}, zap.String("operation", record.Operation.String()), zap.String("table", record.FullTableName()))
+	if lastErr != nil {
+		return fmt.Errorf("replay dlq entry %s: %w", entry.ID, lastErr)
+	}
+
+	return queue.Delete(ctx, entry.ID)
+}
+
+// restorePauses holds the projections an operator left paused before the
+// last shutdown.
+func (r *Runtime) restorePauses(ctx context.Context) error {
+	names, err := r.checkpoints.LoadPausedProjections(ctx)
+	if err != nil {
+		return fmt.Errorf("load paused projections: %w", err)
+	}
+
+	for _, name := range names {
+		if _, err := r.projectionByName(name); err != nil {
+			r.logger.Warn("ignoring pause of unknown projection", zap.String("projection", name))
+			continue
+		}
+		r.hold(name, true)
+		r.logger.Warn("projection is paused", zap.String("projection", name))
+	}
+
+	return nil
+}
+
+func (r *Runtime) hold(name string, operator bool) {
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+
+	hold, ok := r.held[name]
+	if !ok {
+		hold = &projectionHold{pausedAt: r.receivedLSN.Load()}
+		r.held[name] = hold
+	}
+	hold.operator = hold.operator || operator
+}
+
+// holdForBackfill holds a projection for a backfill and reports whether the
+// backfill placed the hold, which makes it responsible for releasing it.
+func (r *Runtime) holdForBackfill(name string) bool {
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+
+	if hold, ok := r.held[name]; ok {
+		hold.backfill = true
+		return false
+	}
+	r.held[name] = &projectionHold{pausedAt: r.receivedLSN.Load(), backfill: true}
+	return true
+}
+
+// releaseBackfill resumes a projection held for a backfill, unless an
+// operator paused it while the backfill ran.
+func (r *Runtime) releaseBackfill(ctx context.Context, name string) error {
+	r.holdMu.Lock()
+	hold, ok := r.held[name]
+	if ok {
+		hold.backfill = false
+	}
+	r.holdMu.Unlock()
+	if !ok || hold.operator {
+		return nil
+	}
+
+	return r.release(ctx, name)
+}
+
+func (r *Runtime) isHeld(name string) bool {
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+
+	_, ok := r.held[name]
+	return ok
+}
+
+// holdIfPaused sets a transaction's records aside when the projection is
+// paused.
+func (r *Runtime) holdIfPaused(name string, seq uint64, records []domain.SourceRecord) bool {
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+
+	hold, ok := r.held[name]
+	if !ok {
+		return false
+	}
+	hold.batches = append(hold.batches, heldBatch{seq: seq, records: records})
+	hold.records += len(records)
+	return true
+}
+
+// parkIfPaused parks a snapshot record for a paused projection. Snapshot
+// progress does not wait for the projection, so the record cannot be held.
+func (r *Runtime) parkIfPaused(ctx context.Context, projection domain.Projection, record domain.SourceRecord) (bool, error) {
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+
+	hold, ok := r.held[projection.Name]
+	if !ok {
+		return false, nil
+	}
+	hold.spills++
+	return true, r.park(ctx, projection, []domain.SourceRecord{record})
+}
+
+// releaseCheckpoint reports whether the WAL checkpoint may advance to a
+// transaction's commit. It may not while a paused projection holds records of
+// that transaction or an earlier one, unless the checkpoint would then trail
+// the commit by more than the pause lag bound, in which case every held
+// record is parked first.
+func (r *Runtime) releaseCheckpoint(ctx context.Context, seq uint64, commitLSN string) (bool, error) {
+	r.holdMu.Lock()
+	defer r.holdMu.Unlock()
+
+	blocked := false
+	for _, hold := range r.held {
+		if len(hold.batches) > 0 && hold.batches[0].seq <= seq {
+			blocked = true
+			break
+		}
+	}
+	if !blocked {
+		return true, nil
+	}
+
+	lsn, err := pglogrepl.ParseLSN(commitLSN)
+	if err != nil {
+		return false, fmt.Errorf("parse commit lsn %s: %w", commitLSN, err)
+	}
+	checkpoint := r.checkpointLSN.Load()
+	if uint64(lsn) <= checkpoint || uint64(lsn)-checkpoint <= r.pauseMaxLag {
+		return false, nil
+	}
+
+	for name, hold := range r.held {
+		if len(hold.batches) == 0 {
+			continue
+		}
+		projection, err := r.projectionByName(name)
+		if err != nil {
+			return false, err
+		}
+
+		records := make([]domain.SourceRecord, 0, hold.records)
+		for _, batch := range hold.batches {
+			records = append(records, batch.records...)
+		}
+		if err := r.park(ctx, projection, records); err != nil {
+			return false, err
+		}
+
+		r.logger.Warn("parked held records of paused projection",
+			zap.String("projection", name),
+			zap.Int("records", len(records)),
+			zap.String("commit_lsn", commitLSN),
+		)
+		hold.batches = nil
+		hold.records = 0
+		hold.spills++
+	}
+
+	return true, nil
+}
+
+// park writes records for a paused projection to the DLQ, after its filter
+// and computed fields, as DLQ replays bypass them.
+func (r *Runtime) park(ctx context.Context, projection domain.Projection, records []domain.SourceRecord) error {
+	queue, err := r.deadLetterQueue()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if shouldSuppressRecord(projection, record) {
+			continue
+		}
+		record, ok, err := r.applyRules(ctx, projection, record)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		entry := domain.DeadLetterRecord{
+			TransactionID: record.Metadata.TransactionID,
+			CommitLSN:     record.Metadata.CommitLSN,
+			Projection:    projection.Name,
+			Error:         parkedReason,
+			Parked:        true,
+			Record:        record,
+			CreatedAt:     time.Now().UTC(),
+		}
+		if err := queue.Write(ctx, entry); err != nil {
+			return fmt.Errorf("park record for projection %s: %w", projection.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// release drains a paused projection and removes its hold. Parked records
+// are older than held ones, so they are replayed first; if held records are
+// parked while draining, the loop goes back to the parked records. Held
+// batches stay in place until written, so the checkpoint keeps waiting for
+// them.
+func (r *Runtime) release(ctx context.Context, name string) error {
+	r.releaseMu.Lock()
+	defer r.releaseMu.Unlock()
+
+	projection, err := r.projectionByName(name)
+	if err != nil {
+		return err
+	}
+
+	for {
+		r.holdMu.Lock()
+		hold, ok := r.held[name]
+		r.holdMu.Unlock()
+		if !ok {
+			return nil
+		}
+		spills := hold.spills
+
+		if err := r.replayParked(ctx, projection); err != nil {
+			return err
+		}
+
+		r.holdMu.Lock()
+		if hold.spills != spills {
+			r.holdMu.Unlock()
+			continue
+		}
+		if len(hold.batches) == 0 {
+			delete(r.held, name)
+			r.holdMu.Unlock()
+			return nil
+		}
+		batches := slices.Clone(hold.batches)
+		r.holdMu.Unlock()
+
+		for _, batch := range batches {
+			if err := r.writeRecords(ctx, []domain.Projection{projection}, batch.records); err != nil {
+				return err
+			}
+		}
+
+		r.holdMu.Lock()
+		if hold.spills == spills {
+			hold.batches = hold.batches[len(batches):]
+			for _, batch := range batches {
+				hold.records -= len(batch.records)
+			}
+		}
+		r.holdMu.Unlock()
+	}
+}
+
+func (r *Runtime) replayParked(ctx context.Context, projection domain.Projection) error {
+	queue, err := r.deadLetterQueue()
+	if err != nil {
+		if errors.Is(err, domain.ErrDeadLetterQueue) {
+			return nil
+		}
+		return err
+	}
+
+	after := ""
+	for {
+		entries, err := queue.Read(ctx, after, parkedPageSize)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		for _, entry := range entries {
+			after = entry.ID
+			if !entry.Record.Parked || entry.Record.Projection != projection.Name {
+				continue
+			}
+			if err := r.replayEntry(ctx, projection, entry); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// checkReplayOrder refuses to replay a dead letter over a destination that
+// holds row state once the projection has applied a later commit, as the
+// older change could overwrite newer row state. Such a projection is
+// re-snapshotted instead.
+func (r *Runtime) checkReplayOrder(projection domain.Projection, record domain.SourceRecord) error {
+	if !projection.Destination.HoldsRowState() || record.Metadata.CommitLSN == "" {
+		return nil
+	}
+	stats, ok := r.stats[projection.Name]
+	if !ok {
+		return nil
+	}
+	applied := stats.lastCommitLSN.Load()
+	lsn, err := pglogrepl.ParseLSN(record.Metadata.CommitLSN)
+	if err != nil || applied <= uint64(lsn) {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: projection %s has applied commit %s after %s; re-snapshot it instead of replaying",
+		domain.ErrProjectionState,
+		projection.Name,
+		pglogrepl.LSN(applied),
+		record.Metadata.CommitLSN,
+	)
+}
+
+func (r *Runtime) recordWrite(projection string, commitLSN string) {
+	stats, ok := r.stats[projection]
+	if !ok || commitLSN == "" {
+		return
+	}
+	if lsn, err := pglogrepl.ParseLSN(commitLSN); err == nil {
+		stats.lastCommitLSN.Store(uint64(lsn))
+	}
+}
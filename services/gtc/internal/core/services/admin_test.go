@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+)
+
+func pausedRuntime(
+	t *testing.T,
+	pauseMaxLagBytes int64,
+	transactions ...domain.TransactionRecords,
+) (*Runtime, *fakeCheckpointStore, *fakeDLQ, *fakeSink, *fakeSink) {
+	t.Helper()
+
+	tailer := &fakeTailReader{transactions: transactions}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{bootstrapLSN: "0/10", walLSN: "0/10"}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	searchSink := &fakeSink{kind: domain.DestinationMeilisearch}
+	cacheSink := &fakeSink{kind: domain.DestinationRedisJSON}
+	dlq := &fakeDLQ{}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, searchSink, cacheSink)
+	params.DeadLetter = dlq
+	params.PauseMaxLagBytes = pauseMaxLagBytes
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination:  domain.Destination{Kind: domain.DestinationMeilisearch, Index: "shipments"},
+		},
+		{
+			Name:         "shipment-cache",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination:  domain.Destination{Kind: domain.DestinationRedisJSON, KeyTemplate: "shipment:{{.id}}"},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+	if err := runtime.Pause(context.Background(), "shipment-search"); err != nil {
+		t.Fatalf("Pause returned error: %v", err)
+	}
+
+	return runtime, checkpoints, dlq, searchSink, cacheSink
+}
+
+func shipmentTransaction(commitLSN string, id string) domain.TransactionRecords {
+	return domain.TransactionRecords{
+		CommitLSN: commitLSN,
+		Records: []domain.SourceRecord{
+			{
+				Operation: domain.OperationInsert,
+				Schema:    "public",
+				Table:     "shipments",
+				NewData:   map[string]any{"id": id},
+			},
+		},
+	}
+}
+
+func TestPausedProjectionHoldsCheckpointUntilResumed(t *testing.T) {
+	t.Parallel()
+
+	runtime, checkpoints, dlq, searchSink, cacheSink := pausedRuntime(t, 1<<20,
+		shipmentTransaction("0/20", "shp_1"),
+		shipmentTransaction("0/30", "shp_2"),
+	)
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if cacheSink.writes != 3 {
+		t.Fatalf("expected the running projection to keep writing, got %d writes", cacheSink.writes)
+	}
+	if searchSink.writes != 0 {
+		t.Fatalf("expected the paused projection to write nothing, got %d writes", searchSink.writes)
+	}
+	if len(checkpoints.saveCalls) != 0 {
+		t.Fatalf("expected held records to keep the checkpoint in place, got %v", checkpoints.saveCalls)
+	}
+	if len(dlq.records) != 1 || !dlq.records[0].Parked {
+		t.Fatalf("expected the snapshot row to be parked, got %+v", dlq.records)
+	}
+
+	statuses := runtime.ProjectionStatuses()
+	if statuses[0].State != domain.ProjectionPaused || statuses[0].HeldRecords != 2 || statuses[0].LagBytes != 0x20 {
+		t.Fatalf("unexpected paused status: %+v", statuses[0])
+	}
+
+	if err := runtime.Resume(context.Background(), "shipment-search"); err != nil {
+		t.Fatalf("Resume returned error: %v", err)
+	}
+	expected := []string{
+		"shipment-search:public.shipments:snapshot-1",
+		"shipment-search:public.shipments:shp_1",
+		"shipment-search:public.shipments:shp_2",
+	}
+	if !slices.Equal(searchSink.writeLog, expected) {
+		t.Fatalf("expected parked then held records in order, got %v", searchSink.writeLog)
+	}
+	if len(dlq.records) != 0 {
+		t.Fatalf("expected replayed parked records to be deleted, got %d", len(dlq.records))
+	}
+	if checkpoints.paused["shipment-search"] {
+		t.Fatalf("expected the resume to be recorded")
+	}
+}
+
+func TestPausedProjectionParksHeldRecordsPastLagBound(t *testing.T) {
+	t.Parallel()
+
+	runtime, checkpoints, dlq, searchSink, _ := pausedRuntime(t, 0x18,
+		shipmentTransaction("0/20", "shp_1"),
+		shipmentTransaction("0/30", "shp_2"),
+	)
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if !slices.Equal(checkpoints.saveCalls, []string{"0/30"}) {
+		t.Fatalf("expected the checkpoint to advance once the bound was exceeded, got %v", checkpoints.saveCalls)
+	}
+	if len(dlq.records) != 3 {
+		t.Fatalf("expected the snapshot row and both held records to be parked, got %d", len(dlq.records))
+	}
+	if runtime.ProjectionStatuses()[0].HeldRecords != 0 {
+		t.Fatalf("expected no held records after parking")
+	}
+
+	if err := runtime.Resume(context.Background(), "shipment-search"); err != nil {
+		t.Fatalf("Resume returned error: %v", err)
+	}
+	if searchSink.writes != 3 || len(dlq.records) != 0 {
+		t.Fatalf("expected parked records to be replayed, got %d writes and %d entries", searchSink.writes, len(dlq.records))
+	}
+}
+
+func TestPausedProjectionStaysPausedAcrossRestart(t *testing.T) {
+	t.Parallel()
+
+	runtime, checkpoints, _, _, _ := pausedRuntime(t, 1<<20)
+	if !checkpoints.paused["shipment-search"] {
+		t.Fatalf("expected the pause to be recorded")
+	}
+
+	restarted, err := NewRuntime(RuntimeParams{
+		TailReader:       &fakeTailReader{transactions: []domain.TransactionRecords{shipmentTransaction("0/20", "shp_1")}},
+		Snapshotter:      &fakeSnapshotReader{currentLSN: "0/10"},
+		Checkpoints:      checkpoints,
+		MetadataStore:    runtime.metadataStore,
+		DeadLetter:       &fakeDLQ{},
+		Projections:      runtime.projections,
+		Sinks:            []ports.Sink{&fakeSink{kind: domain.DestinationMeilisearch}, &fakeSink{kind: domain.DestinationRedisJSON}},
+		ProcessTimeout:   runtime.processTimeout,
+		WorkerCount:      1,
+		WorkerQueueSize:  1,
+		RetryMax:         1,
+		RetryBackoff:     runtime.retryBackoff,
+		RetryBudget:      runtime.retryBudget,
+		PauseMaxLagBytes: 1 << 20,
+		Logger:           runtime.logger,
+	})
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+	if err := restarted.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if state := restarted.ProjectionStatuses()[0].State; state != domain.ProjectionPaused {
+		t.Fatalf("expected the projection to come back paused, got %s", state)
+	}
+}
+
+func TestReplayDeadLetterEntriesRejectsParkedEntriesWhilePaused(t *testing.T) {
+	t.Parallel()
+
+	runtime, _, dlq, _, _ := pausedRuntime(t, 1<<20)
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	_, err := runtime.ReplayDeadLetterEntries(context.Background(), []string{dlq.ids[0]})
+	if !errors.Is(err, domain.ErrProjectionState) {
+		t.Fatalf("expected a projection state error, got %v", err)
+	}
+
+	_, err = runtime.ReplayDeadLetterEntries(context.Background(), []string{"missing-0"})
+	if !errors.Is(err, domain.ErrDeadLetterNotFound) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestReplayDeadLetterEntriesRefusesEntriesOlderThanAppliedCommit(t *testing.T) {
+	t.Parallel()
+
+	transaction := shipmentTransaction("0/30", "shp_2")
+	transaction.Records[0].Metadata.CommitLSN = "0/30"
+	tailer := &fakeTailReader{transactions: []domain.TransactionRecords{transaction}}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{bootstrapLSN: "0/10", walLSN: "0/10"}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	searchSink := &fakeSink{kind: domain.DestinationMeilisearch}
+	dlq := &fakeDLQ{}
+	stale := shipmentTransaction("0/20", "shp_1").Records[0]
+	stale.Metadata.CommitLSN = "0/20"
+	if err := dlq.Write(context.Background(), domain.DeadLetterRecord{
+		CommitLSN:  "0/20",
+		Projection: "shipment-search",
+		Record:     stale,
+	}); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, searchSink)
+	params.DeadLetter = dlq
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination:  domain.Destination{Kind: domain.DestinationMeilisearch, Index: "shipments"},
+		},
+	}
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	_, err = runtime.ReplayDeadLetterEntries(context.Background(), []string{dlq.ids[0]})
+	if !errors.Is(err, domain.ErrProjectionState) {
+		t.Fatalf("expected a projection state error, got %v", err)
+	}
+	if len(dlq.ids) != 1 {
+		t.Fatalf("expected the refused entry to stay on the dlq, got %d entries", len(dlq.ids))
+	}
+}
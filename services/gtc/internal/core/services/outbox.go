@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// ReplayOutbox publishes the outbox events a query selects through an
+// outbox_stream projection again, for consumers that fell behind the stream's
+// retention or joined after the events were relayed. Replayed events keep
+// their idempotency keys, so consumers drop the ones they already handled.
+func (r *Runtime) ReplayOutbox(ctx context.Context, projectionName string, query domain.OutboxQuery) (int, error) {
+	if err := r.prepare(ctx, false); err != nil {
+		return 0, err
+	}
+
+	projection, err := r.projectionByName(projectionName)
+	if err != nil {
+		return 0, err
+	}
+	sink, err := r.projectionSink(projection)
+	if err != nil {
+		return 0, err
+	}
+	relay, ok := sink.(ports.OutboxRelay)
+	if !ok {
+		return 0, fmt.Errorf("projection %s sink %s does not relay an outbox", projection.Name, sink.Name())
+	}
+	reader, ok := r.snapshotter.(ports.OutboxReader)
+	if !ok {
+		return 0, fmt.Errorf("snapshot reader cannot read outbox tables")
+	}
+
+	binding := domain.SnapshotBinding{
+		Schema:      projection.SourceSchema,
+		Table:       projection.SourceTable,
+		PrimaryKeys: projection.PrimaryKeys,
+	}
+
+	replayed := 0
+	err = reader.ReadOutbox(ctx, binding, query, func(runCtx context.Context, record domain.SourceRecord) error {
+		record, ok, err := r.evaluateRules(projection, record)
+		if err != nil || !ok {
+			return err
+		}
+
+		_, exhausted, err := r.writeWithRetry(runCtx, projection, sink, func(writeCtx context.Context) error {
+			return relay.Replay(writeCtx, projection, record)
+		}, zap.String("operation", "REPLAY"), zap.String("table", record.FullTableName()))
+		if exhausted {
+			return fmt.Errorf("replay outbox event through %s: %w", projection.Name, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		replayed++
+		return nil
+	})
+
+	return replayed, err
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/emoss08/gtc/internal/core/ports"
+)
+
+type fakeOutboxReader struct {
+	fakeSnapshotReader
+	rows  []map[string]any
+	query domain.OutboxQuery
+}
+
+func (f *fakeOutboxReader) ReadOutbox(
+	ctx context.Context,
+	binding domain.SnapshotBinding,
+	query domain.OutboxQuery,
+	handler ports.RecordHandler,
+) error {
+	f.query = query
+	for _, row := range f.rows {
+		record := domain.SourceRecord{
+			Operation: domain.OperationInsert,
+			Schema:    binding.Schema,
+			Table:     binding.Table,
+			NewData:   row,
+		}
+		if err := handler(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeRelay struct {
+	fakeSink
+	replayed []string
+}
+
+func (f *fakeRelay) Replay(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.replayed = append(f.replayed, recordID(record))
+	return nil
+}
+
+func TestReplayOutboxAppliesProjectionFilter(t *testing.T) {
+	t.Parallel()
+
+	reader := &fakeOutboxReader{rows: []map[string]any{
+		{"id": "devt_1", "event_type": "tender.accepted"},
+		{"id": "devt_2", "event_type": "invoice.posted"},
+		{"id": "devt_3", "event_type": "tender.accepted"},
+	}}
+	relay := &fakeRelay{fakeSink: fakeSink{kind: domain.DestinationOutbox}}
+	search := &fakeSink{kind: domain.DestinationMeilisearch}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.domain_event_outbox": {
+			Schema:      "public",
+			Table:       "domain_event_outbox",
+			PrimaryKeys: []string{"id"},
+			Columns: []domain.ColumnMetadata{
+				{Name: "id", Type: domain.ColumnTypeString},
+				{Name: "event_type", Type: domain.ColumnTypeString},
+			},
+		},
+	}}
+
+	params := baseRuntimeParams(&fakeTailReader{}, &reader.fakeSnapshotReader, &fakeCheckpointStore{}, metadataStore, relay, search)
+	params.Snapshotter = reader
+	params.Projections = []domain.Projection{
+		{
+			Name:         "tender-events",
+			SourceSchema: "public",
+			SourceTable:  "domain_event_outbox",
+			Filter:       `event_type == "tender.accepted"`,
+			Destination:  domain.Destination{Kind: domain.DestinationOutbox, Stream: "tms:events"},
+		},
+		{
+			Name:         "outbox-search",
+			SourceSchema: "public",
+			SourceTable:  "domain_event_outbox",
+			Destination:  domain.Destination{Kind: domain.DestinationMeilisearch, Index: "events"},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	query := domain.OutboxQuery{AggregateID: "tnd_1"}
+	replayed, err := runtime.ReplayOutbox(context.Background(), "tender-events", query)
+	if err != nil {
+		t.Fatalf("ReplayOutbox returned error: %v", err)
+	}
+	if replayed != 2 || !slices.Equal(relay.replayed, []string{"devt_1", "devt_3"}) {
+		t.Fatalf("expected the filtered events to be replayed, got %d %v", replayed, relay.replayed)
+	}
+	if reader.query.AggregateID != "tnd_1" {
+		t.Fatalf("expected the query to reach the reader, got %+v", reader.query)
+	}
+	if relay.writes != 0 {
+		t.Fatalf("expected replays to bypass Write, got %d writes", relay.writes)
+	}
+
+	if _, err := runtime.ReplayOutbox(context.Background(), "outbox-search", query); err == nil {
+		t.Fatalf("expected a projection without an outbox relay to be rejected")
+	}
+}
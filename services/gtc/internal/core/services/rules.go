@@ -0,0 +1,412 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/vm"
+	"github.com/expr-lang/expr/vm/runtime"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// projectionRules is a projection's compiled filter and computed fields. Both
+// are type-checked against the discovered table columns when the runtime
+// prepares, so validate-config rejects expressions that reference unknown
+// columns or mix types.
+type projectionRules struct {
+	columns  []string
+	filter   *compiledExpression
+	computed []computedField
+}
+
+type compiledExpression struct {
+	program *vm.Program
+	columns []string
+}
+
+type computedField struct {
+	name string
+	compiledExpression
+}
+
+// ruleFunctions are available to every filter and computed field expression.
+var ruleFunctions = []expr.Option{
+	expr.Function("sha256", func(params ...any) (any, error) {
+		value, ok := params[0].(string)
+		if !ok {
+			return nil, nil
+		}
+		digest := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(digest[:]), nil
+	}, new(func(string) string)),
+	expr.Function("mask", func(params ...any) (any, error) {
+		value, ok := params[0].(string)
+		if !ok {
+			return nil, nil
+		}
+		keep := params[1].(int)
+		runes := []rune(value)
+		if keep < 0 {
+			keep = 0
+		}
+		for idx := 0; idx < len(runes)-keep; idx++ {
+			runes[idx] = '*'
+		}
+		return string(runes), nil
+	}, new(func(string, int) string)),
+}
+
+// Operand types the NULL-aware operators are overloaded for.
+var (
+	orderedComparisons = []any{
+		new(func(string, string) bool),
+		new(func(int, int) bool),
+		new(func(float64, float64) bool),
+		new(func(int, float64) bool),
+		new(func(float64, int) bool),
+	}
+	equalities = []any{
+		new(func(string, string) bool),
+		new(func(int, int) bool),
+		new(func(float64, float64) bool),
+		new(func(int, float64) bool),
+		new(func(float64, int) bool),
+		new(func(bool, bool) bool),
+	}
+	numberArithmetic = []any{
+		new(func(int, int) int),
+		new(func(float64, float64) float64),
+		new(func(int, float64) float64),
+		new(func(float64, int) float64),
+	}
+	numberDivision = []any{
+		new(func(int, int) float64),
+		new(func(float64, float64) float64),
+		new(func(int, float64) float64),
+		new(func(float64, int) float64),
+	}
+)
+
+// nullOperators let expressions read NULL columns the way SQL does instead of
+// failing the record: arithmetic on NULL is NULL, an ordering comparison with
+// NULL is false, NULL equals only NULL, and concatenation reads NULL as an
+// empty string. A filter that evaluates to NULL does not match.
+var nullOperators = slices.Concat(
+	nullOperator("+", "nullAdd", func(a, b any) (any, error) {
+		left, leftText := a.(string)
+		right, rightText := b.(string)
+		if leftText || rightText {
+			return left + right, nil
+		}
+		return nullArithmetic(runtime.Add)(a, b)
+	}, append([]any{new(func(string, string) string)}, numberArithmetic...)...),
+	nullOperator("-", "nullSubtract", nullArithmetic(runtime.Subtract), numberArithmetic...),
+	nullOperator("*", "nullMultiply", nullArithmetic(runtime.Multiply), numberArithmetic...),
+	nullOperator("/", "nullDivide", nullArithmetic(func(a, b any) any {
+		return runtime.Divide(a, b)
+	}), numberDivision...),
+	nullOperator("==", "nullEqual", func(a, b any) (any, error) {
+		return runtime.Equal(a, b), nil
+	}, equalities...),
+	nullOperator("!=", "nullNotEqual", func(a, b any) (any, error) {
+		return !runtime.Equal(a, b), nil
+	}, equalities...),
+	nullOperator("<", "nullLess", nullComparison(runtime.Less), orderedComparisons...),
+	nullOperator(">", "nullMore", nullComparison(runtime.More), orderedComparisons...),
+	nullOperator("<=", "nullLessOrEqual", nullComparison(runtime.LessOrEqual), orderedComparisons...),
+	nullOperator(">=", "nullMoreOrEqual", nullComparison(runtime.MoreOrEqual), orderedComparisons...),
+)
+
+// nullOperator replaces a binary operator with fn for the given operand types.
+func nullOperator(operator, name string, fn func(a, b any) (any, error), types ...any) []expr.Option {
+	return []expr.Option{
+		expr.Function(name, func(params ...any) (any, error) {
+			return fn(params[0], params[1])
+		}, types...),
+		expr.Operator(operator, name),
+	}
+}
+
+func nullArithmetic(op func(a, b any) any) func(a, b any) (any, error) {
+	return func(a, b any) (any, error) {
+		if a == nil || b == nil {
+			return nil, nil
+		}
+		return op(a, b), nil
+	}
+}
+
+func nullComparison(op func(a, b any) bool) func(a, b any) (any, error) {
+	return func(a, b any) (any, error) {
+		if a == nil || b == nil {
+			return false, nil
+		}
+		return op(a, b), nil
+	}
+}
+
+func compileRules(projection domain.Projection, metadata domain.TableMetadata) (*projectionRules, error) {
+	if projection.Filter == "" && len(projection.ComputedFields) == 0 {
+		return nil, nil
+	}
+
+	env := make(map[string]any, len(metadata.Columns))
+	columns := make([]string, 0, len(metadata.Columns))
+	for _, column := range metadata.Columns {
+		env[column.Name] = columnZeroValue(column.Type)
+		columns = append(columns, column.Name)
+	}
+
+	rules := &projectionRules{columns: columns}
+	if projection.Filter != "" {
+		filter, err := compileExpression(projection.Filter, env, expr.AsBool())
+		if err != nil {
+			return nil, fmt.Errorf("projection %s filter: %w", projection.Name, err)
+		}
+		rules.filter = filter
+	}
+
+	names := slices.Sorted(maps.Keys(projection.ComputedFields))
+	for _, name := range names {
+		compiled, err := compileExpression(projection.ComputedFields[name], env)
+		if err != nil {
+			return nil, fmt.Errorf("projection %s computed field %s: %w", projection.Name, name, err)
+		}
+		rules.computed = append(rules.computed, computedField{name: name, compiledExpression: *compiled})
+	}
+
+	return rules, nil
+}
+
+func compileExpression(input string, env map[string]any, opts ...expr.Option) (*compiledExpression, error) {
+	options := append([]expr.Option{expr.Env(env)}, ruleFunctions...)
+	options = append(options, nullOperators...)
+	program, err := expr.Compile(input, append(options, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &columnCollector{env: env, seen: make(map[string]struct{})}
+	node := program.Node()
+	ast.Walk(&node, collector)
+	slices.Sort(collector.columns)
+
+	return &compiledExpression{program: program, columns: collector.columns}, nil
+}
+
+// apply filters and reshapes a record for the projection. It returns false
+// when the record should not reach the sink. An update whose row stops
+// matching is turned into a delete and one whose row starts matching into an
+// insert, so the sink drops or gains the row as it would for a real delete
+// or insert. An old image only decides a transition when it carries every
+// column the filter reads, which depends on the table's replica identity.
+func (p *projectionRules) apply(record domain.SourceRecord) (domain.SourceRecord, bool, error) {
+	if p.filter != nil {
+		var ok bool
+		var err error
+		record, ok, err = p.applyFilter(record)
+		if err != nil || !ok {
+			return record, ok, err
+		}
+	}
+
+	if len(p.computed) == 0 {
+		return record, true, nil
+	}
+
+	var err error
+	if record.NewData, err = p.computeFields(record.NewData); err != nil {
+		return record, false, err
+	}
+	if record.OldData, err = p.computeFields(record.OldData); err != nil {
+		return record, false, err
+	}
+
+	return record, true, nil
+}
+
+func (p *projectionRules) applyFilter(record domain.SourceRecord) (domain.SourceRecord, bool, error) {
+	switch record.Operation {
+	case domain.OperationInsert, domain.OperationSnapshot:
+		matches, err := p.matches(record.NewData)
+		return record, matches, err
+	case domain.OperationUpdate:
+		newMatches, err := p.matches(record.NewData)
+		if err != nil {
+			return record, false, err
+		}
+
+		oldDecided, oldMatches, err := p.decide(record.OldData)
+		if err != nil {
+			return record, false, err
+		}
+		if oldDecided && !oldMatches {
+			if !newMatches {
+				return record, false, nil
+			}
+			record.Operation = domain.OperationInsert
+			record.OldData = nil
+			return record, true, nil
+		}
+		if !newMatches {
+			record.Operation = domain.OperationDelete
+			record.OldData = record.NewData
+			record.NewData = nil
+		}
+		return record, true, nil
+	case domain.OperationDelete:
+		oldDecided, oldMatches, err := p.decide(record.OldData)
+		if err != nil {
+			return record, false, err
+		}
+		return record, !oldDecided || oldMatches, nil
+	default:
+		return record, true, nil
+	}
+}
+
+// decide evaluates the filter against an image only when the image carries
+// every column the filter reads.
+func (p *projectionRules) decide(data map[string]any) (decided bool, matches bool, err error) {
+	if data == nil || !hasColumns(data, p.filter.columns) {
+		return false, false, nil
+	}
+
+	matches, err = p.matches(data)
+	return true, matches, err
+}
+
+func (p *projectionRules) matches(data map[string]any) (bool, error) {
+	result, err := expr.Run(p.filter.program, p.env(data))
+	if err != nil {
+		return false, fmt.Errorf("evaluate filter: %w", err)
+	}
+
+	matches, _ := result.(bool)
+	return matches, nil
+}
+
+// computeFields evaluates every computed field against the source row and
+// returns a copy with the results set. A field whose columns are missing from
+// the image is left out, along with any source column of the same name, so a
+// partial old image never leaks a value the projection masks.
+func (p *projectionRules) computeFields(data map[string]any) (map[string]any, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	env := p.env(data)
+	computed := maps.Clone(data)
+	for _, field := range p.computed {
+		if !hasColumns(data, field.columns) {
+			delete(computed, field.name)
+			continue
+		}
+
+		value, err := expr.Run(field.program, env)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate computed field %s: %w", field.name, err)
+		}
+		computed[field.name] = value
+	}
+
+	return computed, nil
+}
+
+// env presents a row to expressions with every known column defined, so a
+// missing value reads as nil rather than failing the lookup.
+func (p *projectionRules) env(data map[string]any) map[string]any {
+	env := make(map[string]any, len(p.columns)+len(data))
+	for _, column := range p.columns {
+		env[column] = nil
+	}
+	for key, value := range data {
+		env[key] = expressionValue(value)
+	}
+
+	return env
+}
+
+func hasColumns(data map[string]any, columns []string) bool {
+	for _, column := range columns {
+		if _, ok := data[column]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func columnZeroValue(columnType domain.ColumnType) any {
+	switch columnType {
+	case domain.ColumnTypeString:
+		return ""
+	case domain.ColumnTypeInt:
+		return 0
+	case domain.ColumnTypeFloat:
+		return float64(0)
+	case domain.ColumnTypeBool:
+		return false
+	default:
+		return nil
+	}
+}
+
+// expressionValue converts a decoded column value to the type it was checked
+// as. Snapshot rows and WAL rows decode some types differently, so both end up
+// in the same representation here.
+func expressionValue(value any) any {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.UTC().Format(time.RFC3339Nano)
+	case [16]byte:
+		return fmt.Sprintf("%x-%x-%x-%x-%x", typed[0:4], typed[4:6], typed[6:8], typed[8:10], typed[10:16])
+	case []byte:
+		return string(typed)
+	case int16:
+		return int(typed)
+	case int32:
+		return int(typed)
+	case int64:
+		return int(typed)
+	case float32:
+		return float64(typed)
+	case pgtype.Numeric:
+		number, err := typed.Float64Value()
+		if err != nil || !number.Valid {
+			return nil
+		}
+		return number.Float64
+	default:
+		return typed
+	}
+}
+
+type columnCollector struct {
+	env     map[string]any
+	seen    map[string]struct{}
+	columns []string
+}
+
+func (c *columnCollector) Visit(node *ast.Node) {
+	identifier, ok := (*node).(*ast.IdentifierNode)
+	if !ok {
+		return
+	}
+	if _, column := c.env[identifier.Value]; !column {
+		return
+	}
+	if _, seen := c.seen[identifier.Value]; seen {
+		return
+	}
+
+	c.seen[identifier.Value] = struct{}{}
+	c.columns = append(c.columns, identifier.Value)
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+)
+
+var customerMetadata = domain.TableMetadata{
+	Schema:      "public",
+	Table:       "customers",
+	PrimaryKeys: []string{"id"},
+	Columns: []domain.ColumnMetadata{
+		{Name: "id", Type: domain.ColumnTypeString},
+		{Name: "status", Type: domain.ColumnTypeString},
+		{Name: "email", Type: domain.ColumnTypeString},
+		{Name: "street", Type: domain.ColumnTypeString},
+		{Name: "city", Type: domain.ColumnTypeString},
+		{Name: "credit_limit", Type: domain.ColumnTypeFloat},
+		{Name: "updated_at", Type: domain.ColumnTypeString},
+	},
+}
+
+func customerProjection() domain.Projection {
+	return domain.Projection{
+		Name:         "customer-lake",
+		SourceSchema: "public",
+		SourceTable:  "customers",
+		PrimaryKeys:  []string{"id"},
+		Filter:       `status == "Active"`,
+		ComputedFields: map[string]string{
+			"email":      "sha256(email)",
+			"email_hint": "mask(email, 4)",
+			"address":    `street + ", " + city`,
+		},
+	}
+}
+
+func TestCompileRulesTypeChecksAgainstColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]domain.Projection{
+		"unknown column":  {Name: "p", Filter: `region == "West"`},
+		"non-bool filter": {Name: "p", Filter: `status`},
+		"mixed types":     {Name: "p", Filter: `credit_limit == "high"`},
+		"bad function":    {Name: "p", ComputedFields: map[string]string{"masked": "mask(email)"}},
+	}
+	for name, projection := range tests {
+		if _, err := compileRules(projection, customerMetadata); err == nil {
+			t.Fatalf("%s: expected compile error", name)
+		}
+	}
+
+	if _, err := compileRules(customerProjection(), customerMetadata); err != nil {
+		t.Fatalf("compileRules returned error: %v", err)
+	}
+}
+
+func TestRulesTurnFilterTransitionsIntoDeletesAndInserts(t *testing.T) {
+	t.Parallel()
+
+	rules, err := compileRules(domain.Projection{Name: "p", Filter: `status == "Active"`}, customerMetadata)
+	if err != nil {
+		t.Fatalf("compileRules returned error: %v", err)
+	}
+
+	stopped, ok, err := rules.apply(domain.SourceRecord{
+		Operation: domain.OperationUpdate,
+		NewData:   map[string]any{"id": "cus_1", "status": "Inactive"},
+	})
+	if err != nil || !ok {
+		t.Fatalf("expected record to pass as a delete, got ok=%v err=%v", ok, err)
+	}
+	if stopped.Operation != domain.OperationDelete || stopped.NewData != nil || stopped.OldData["id"] != "cus_1" {
+		t.Fatalf("expected update leaving the filter to become a delete, got %+v", stopped)
+	}
+
+	started, ok, err := rules.apply(domain.SourceRecord{
+		Operation: domain.OperationUpdate,
+		OldData:   map[string]any{"id": "cus_1", "status": "Inactive"},
+		NewData:   map[string]any{"id": "cus_1", "status": "Active"},
+	})
+	if err != nil || !ok {
+		t.Fatalf("expected record to pass as an insert, got ok=%v err=%v", ok, err)
+	}
+	if started.Operation != domain.OperationInsert || started.OldData != nil {
+		t.Fatalf("expected update entering the filter to become an insert, got %+v", started)
+	}
+
+	_, ok, err = rules.apply(domain.SourceRecord{
+		Operation: domain.OperationUpdate,
+		OldData:   map[string]any{"id": "cus_1", "status": "Inactive"},
+		NewData:   map[string]any{"id": "cus_1", "status": "Closed"},
+	})
+	if err != nil || ok {
+		t.Fatalf("expected update outside the filter to be dropped, got ok=%v err=%v", ok, err)
+	}
+
+	_, ok, err = rules.apply(domain.SourceRecord{
+		Operation: domain.OperationDelete,
+		OldData:   map[string]any{"id": "cus_1"},
+	})
+	if err != nil || !ok {
+		t.Fatalf("expected delete with a key-only old image to pass, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestRulesComputeFieldsOnBothImages(t *testing.T) {
+	t.Parallel()
+
+	projection := customerProjection()
+	projection.Filter = ""
+	rules, err := compileRules(projection, customerMetadata)
+	if err != nil {
+		t.Fatalf("compileRules returned error: %v", err)
+	}
+
+	updatedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	record, ok, err := rules.apply(domain.SourceRecord{
+		Operation: domain.OperationUpdate,
+		OldData:   map[string]any{"id": "cus_1", "email": "old@example.com"},
+		NewData: map[string]any{
+			"id":         "cus_1",
+			"email":      "ops@example.com",
+			"street":     "1 Main St",
+			"city":       "Reno",
+			"updated_at": updatedAt,
+		},
+	})
+	if err != nil || !ok {
+		t.Fatalf("apply returned ok=%v err=%v", ok, err)
+	}
+
+	digest := sha256.Sum256([]byte("ops@example.com"))
+	if record.NewData["email"] != hex.EncodeToString(digest[:]) {
+		t.Fatalf("expected email to be hashed, got %v", record.NewData["email"])
+	}
+	if record.NewData["email_hint"] != "***********.com" {
+		t.Fatalf("expected masked email hint, got %v", record.NewData["email_hint"])
+	}
+	if record.NewData["address"] != "1 Main St, Reno" {
+		t.Fatalf("expected concatenated address, got %v", record.NewData["address"])
+	}
+	if record.NewData["updated_at"] != updatedAt {
+		t.Fatalf("expected untouched columns to keep their type, got %T", record.NewData["updated_at"])
+	}
+	if record.OldData["email"] == "old@example.com" {
+		t.Fatalf("expected old image email to be hashed too")
+	}
+	if _, ok := record.OldData["address"]; ok {
+		t.Fatalf("expected address to be omitted from an image without its columns")
+	}
+}
+
+func TestRulesTreatNullColumnsLikeSQL(t *testing.T) {
+	t.Parallel()
+
+	projection := customerProjection()
+	projection.Filter = `credit_limit > 1000.0 || status == "Active"`
+	projection.ComputedFields["limit_with_buffer"] = "credit_limit * 1.1"
+	rules, err := compileRules(projection, customerMetadata)
+	if err != nil {
+		t.Fatalf("compileRules returned error: %v", err)
+	}
+
+	record, ok, err := rules.apply(domain.SourceRecord{
+		Operation: domain.OperationInsert,
+		NewData: map[string]any{
+			"id":           "cus_1",
+			"status":       "Active",
+			"email":        nil,
+			"street":       nil,
+			"city":         "Tulsa",
+			"credit_limit": nil,
+		},
+	})
+	if err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected the NULL comparison to be false and the status to match")
+	}
+	if record.NewData["address"] != ", Tulsa" {
+		t.Fatalf("expected NULL to concatenate as empty, got %q", record.NewData["address"])
+	}
+	if record.NewData["limit_with_buffer"] != nil || record.NewData["email"] != nil {
+		t.Fatalf("expected NULL arithmetic and hashing to stay NULL, got %+v", record.NewData)
+	}
+
+	_, ok, err = rules.apply(domain.SourceRecord{
+		Operation: domain.OperationInsert,
+		NewData:   map[string]any{"id": "cus_2", "status": nil, "credit_limit": nil},
+	})
+	if err != nil {
+		t.Fatalf("apply returned error: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected a row with only NULLs not to match")
+	}
+}
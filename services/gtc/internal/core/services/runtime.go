@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"hash/fnv"
 	"slices"
@@ -20,6 +21,10 @@ type tailHealthProvider interface {
 	HealthStatuses() map[string]bool
 }
 
+type snapshotProgressProvider interface {
+	ChunkProgress() []domain.SnapshotChunkStatus
+}
+
 type Runtime struct {
 	tailReader      ports.TailReader
 	snapshotter     ports.SnapshotReader
@@ -27,12 +32,28 @@ type Runtime struct {
 	metadataStore   ports.MetadataStore
 	dlqWriter       ports.DeadLetterWriter
 	projections     []domain.Projection
+	rules           map[string]*projectionRules
+	schemaVersions  map[string]string
+	paused          map[string]string
+	unresolved      map[string]error
+	projectionMu    sync.RWMutex
+	held            map[string]*projectionHold
+	holdMu          sync.Mutex
+	releaseMu       sync.Mutex
+	stats           map[string]*projectionStats
+	backfillMu      sync.Mutex
+	backfillCancel  context.CancelFunc
+	backfills       sync.WaitGroup
+	receivedLSN     atomic.Uint64
+	checkpointLSN   atomic.Uint64
+	pauseMaxLag     uint64
 	sinks           map[domain.DestinationKind]ports.Sink
 	processTimeout  time.Duration
 	workerCount     int
 	workerQueueSize int
 	retryMax        int
 	retryBackoff    time.Duration
+	retryBudget     time.Duration
 	logger          *zap.Logger
 	ready           atomic.Bool
 	prepareMu       sync.Mutex
@@ -55,7 +76,13 @@ type RuntimeParams struct {
 	WorkerQueueSize int
 	RetryMax        int
 	RetryBackoff    time.Duration
-	Logger          *zap.Logger
+	// RetryBudget bounds the time one write may spend across all of its
+	// attempts before its records are dead-lettered.
+	RetryBudget time.Duration
+	// PauseMaxLagBytes bounds how far a paused projection may hold the WAL
+	// checkpoint behind the stream before its held records are parked.
+	PauseMaxLagBytes int64
+	Logger           *zap.Logger
 }
 
 func NewRuntime(params RuntimeParams) (*Runtime, error) {
@@ -88,6 +115,17 @@ func NewRuntime(params RuntimeParams) (*Runtime, error) {
 	if params.RetryBackoff <= 0 {
 		return nil, fmt.Errorf("retry backoff must be greater than zero")
 	}
+	if params.RetryBudget <= 0 {
+		return nil, fmt.Errorf("retry budget must be greater than zero")
+	}
+	if params.PauseMaxLagBytes <= 0 {
+		return nil, fmt.Errorf("pause max lag bytes must be greater than zero")
+	}
+
+	stats := make(map[string]*projectionStats, len(params.Projections))
+	for _, projection := range params.Projections {
+		stats[projection.Name] = &projectionStats{}
+	}
 
 	return &Runtime{
 		tailReader:      params.TailReader,
@@ -102,8 +140,14 @@ func NewRuntime(params RuntimeParams) (*Runtime, error) {
 		workerQueueSize: params.WorkerQueueSize,
 		retryMax:        params.RetryMax,
 		retryBackoff:    params.RetryBackoff,
+		retryBudget:     params.RetryBudget,
 		logger:          params.Logger.Named("runtime"),
 		statuses:        make(map[string]bool),
+		paused:          make(map[string]string),
+		unresolved:      make(map[string]error),
+		held:            make(map[string]*projectionHold),
+		stats:           stats,
+		pauseMaxLag:     uint64(params.PauseMaxLagBytes),
 	}, nil
 }
 
@@ -118,11 +162,23 @@ func (r *Runtime) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if lsn, parseErr := pglogrepl.ParseLSN(startLSN); parseErr == nil {
+		r.checkpointLSN.Store(uint64(lsn))
+		r.receivedLSN.Store(uint64(lsn))
+	}
+
+	if err := r.restorePauses(ctx); err != nil {
+		return err
+	}
 
 	if err := r.runSnapshots(ctx); err != nil {
 		return err
 	}
 
+	if err := r.reconcileSchemaVersions(ctx); err != nil {
+		return err
+	}
+
 	if checkpointLSN, loadErr := r.checkpoints.LoadWALLSN(ctx); loadErr == nil && checkpointLSN == "" {
 		if err := r.checkpoints.SaveWALLSN(ctx, startLSN); err != nil {
 			return fmt.Errorf("save initial wal checkpoint: %w", err)
@@ -145,7 +201,9 @@ func (r *Runtime) Start(ctx context.Context) error {
 		processor.drain()
 	} else {
 		processor.stop()
+		r.cancelBackfill()
 	}
+	r.backfills.Wait()
 	r.processor = nil
 
 	if procErr := processor.err(); procErr != nil {
@@ -157,6 +215,15 @@ func (r *Runtime) Start(ctx context.Context) error {
 	return nil
 }
 
+func (r *Runtime) cancelBackfill() {
+	r.backfillMu.Lock()
+	defer r.backfillMu.Unlock()
+
+	if r.backfillCancel != nil {
+		r.backfillCancel()
+	}
+}
+
 func (r *Runtime) Stop(ctx context.Context) error {
 	r.ready.Store(false)
 
@@ -164,6 +231,8 @@ func (r *Runtime) Stop(ctx context.Context) error {
 		r.processor.stop()
 	}
 
+	r.cancelBackfill()
+
 	var stopErr error
 	if err := r.tailReader.Stop(ctx); err != nil {
 		stopErr = err
@@ -181,12 +250,30 @@ func (r *Runtime) Stop(ctx context.Context) error {
 	return stopErr
 }
 
+// Validate resolves every projection against its source table. Unlike Start,
+// which pauses the projections that do not resolve, it reports them all.
 func (r *Runtime) Validate(ctx context.Context) error {
-	return r.prepare(ctx, false)
+	if err := r.prepare(ctx, false); err != nil {
+		return err
+	}
+
+	r.projectionMu.RLock()
+	defer r.projectionMu.RUnlock()
+	errs := make([]error, 0, len(r.unresolved))
+	for _, projection := range r.projections {
+		if err, ok := r.unresolved[projection.Name]; ok {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
+// Backfill re-reads the source tables of the selected projections and writes
+// every row through them. A completed backfill records the projections as
+// current with their source table's layout, which also resumes a projection
+// paused by a schema change once GTC restarts.
 func (r *Runtime) Backfill(ctx context.Context, projectionNames []string, tableNames []string) error {
-	if err := r.prepare(ctx, false); err != nil {
+	if err := r.prepare(ctx, true); err != nil {
 		return err
 	}
 
@@ -195,9 +282,51 @@ func (r *Runtime) Backfill(ctx context.Context, projectionNames []string, tableN
 		return err
 	}
 
-	return r.snapshotter.Backfill(ctx, uniqueBindings(projections), func(runCtx context.Context, record domain.SourceRecord) error {
-		return r.handleRecordWithProjections(runCtx, projections, record)
-	})
+	return r.backfillProjections(ctx, projections)
+}
+
+// Rebuild clears the targets of the selected projections and backfills them
+// from a fresh snapshot. Every selected projection's sink must support
+// rebuilds, so a mixed selection fails before anything is cleared.
+func (r *Runtime) Rebuild(ctx context.Context, projectionNames []string, tableNames []string) error {
+	if err := r.prepare(ctx, true); err != nil {
+		return err
+	}
+
+	projections, err := r.filterProjections(projectionNames, tableNames)
+	if err != nil {
+		return err
+	}
+
+	rebuilders, err := r.rebuilders(projections)
+	if err != nil {
+		return err
+	}
+
+	for idx, projection := range projections {
+		if err := rebuilders[idx].Rebuild(ctx, projection); err != nil {
+			return fmt.Errorf("rebuild projection %s: %w", projection.Name, err)
+		}
+	}
+
+	return r.backfillProjections(ctx, projections)
+}
+
+func (r *Runtime) rebuilders(projections []domain.Projection) ([]ports.RebuildableSink, error) {
+	rebuilders := make([]ports.RebuildableSink, 0, len(projections))
+	for _, projection := range projections {
+		sink, err := r.projectionSink(projection)
+		if err != nil {
+			return nil, err
+		}
+		rebuilder, ok := sink.(ports.RebuildableSink)
+		if !ok {
+			return nil, fmt.Errorf("projection %s sink %s does not support rebuilds", projection.Name, sink.Name())
+		}
+		rebuilders = append(rebuilders, rebuilder)
+	}
+
+	return rebuilders, nil
 }
 
 func (r *Runtime) ReplayDeadLetters(ctx context.Context, entries []domain.DeadLetterRecord) error {
@@ -210,6 +339,9 @@ func (r *Runtime) ReplayDeadLetters(ctx context.Context, entries []domain.DeadLe
 		if err != nil {
 			return err
 		}
+		if err := r.checkReplayOrder(projection, entry.Record); err != nil {
+			return err
+		}
 		if err := r.writeProjection(ctx, projection, entry.Record); err != nil {
 			return err
 		}
@@ -234,6 +366,15 @@ func (r *Runtime) SinkStatuses() map[string]bool {
 	return statuses
 }
 
+// SnapshotChunks reports per-chunk snapshot progress when the snapshot reader
+// tracks it.
+func (r *Runtime) SnapshotChunks() []domain.SnapshotChunkStatus {
+	if provider, ok := r.snapshotter.(snapshotProgressProvider); ok {
+		return provider.ChunkProgress()
+	}
+	return nil
+}
+
 func (r *Runtime) HealthCheck(ctx context.Context) map[string]bool {
 	statuses := make(map[string]bool, len(r.sinks)+2)
 
@@ -252,6 +393,13 @@ func (r *Runtime) HealthCheck(ctx context.Context) map[string]bool {
 		r.setStatus(sink.Name(), healthy)
 	}
 
+	r.projectionMu.RLock()
+	for _, projection := range r.projections {
+		_, paused := r.paused[projection.Name]
+		statuses["projection:"+projection.Name] = !paused
+	}
+	r.projectionMu.RUnlock()
+
 	return statuses
 }
 
@@ -285,7 +433,7 @@ func (r *Runtime) determineStartLSN(ctx context.Context) (string, error) {
 }
 
 func (r *Runtime) runSnapshots(ctx context.Context) error {
-	bindings := uniqueBindings(r.projections)
+	bindings := uniqueBindings(r.resolvedProjections())
 	if len(bindings) == 0 {
 		return nil
 	}
@@ -307,11 +455,32 @@ func (r *Runtime) handleTransaction(ctx context.Context, tx domain.TransactionRe
 		return err
 	}
 
+	if lsn, err := pglogrepl.ParseLSN(tx.CommitLSN); err == nil {
+		for {
+			received := r.receivedLSN.Load()
+			if uint64(lsn) <= received || r.receivedLSN.CompareAndSwap(received, uint64(lsn)) {
+				break
+			}
+		}
+	}
+
 	return r.processor.enqueue(ctx, tx)
 }
 
 func (r *Runtime) handleRecord(ctx context.Context, record domain.SourceRecord) error {
-	return r.handleRecordWithProjections(ctx, r.matchingProjections(record.FullTableName()), record)
+	projections := r.matchingProjections(record.FullTableName())
+	active := make([]domain.Projection, 0, len(projections))
+	for _, projection := range projections {
+		parked, err := r.parkIfPaused(ctx, projection, record)
+		if err != nil {
+			return err
+		}
+		if !parked {
+			active = append(active, projection)
+		}
+	}
+
+	return r.handleRecordWithProjections(ctx, active, record)
 }
 
 func (r *Runtime) handleRecordWithProjections(
@@ -342,6 +511,14 @@ func (r *Runtime) handleRecordWithProjections(
 			continue
 		}
 
+		record, ok, err := r.applyRules(ctx, projection, record)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
 		r.logger.Debug("writing projection",
 			zap.String("projection", projection.Name),
 			zap.String("table", record.FullTableName()),
@@ -356,57 +533,292 @@ func (r *Runtime) handleRecordWithProjections(
 	return nil
 }
 
+// handleRecords applies one table's records from a committed transaction.
+// Paused projections hold the records instead of writing them.
+func (r *Runtime) handleRecords(ctx context.Context, seq uint64, records []domain.SourceRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	projections := make([]domain.Projection, 0)
+	for _, projection := range r.matchingProjections(records[0].FullTableName()) {
+		if !r.holdIfPaused(projection.Name, seq, records) {
+			projections = append(projections, projection)
+		}
+	}
+
+	return r.writeRecords(ctx, projections, records)
+}
+
+// writeRecords writes a table's records through the projections. Projections
+// whose sink accepts batches receive the records in a single write; the rest
+// are written record by record in commit order.
+func (r *Runtime) writeRecords(ctx context.Context, projections []domain.Projection, records []domain.SourceRecord) error {
+	var batched, single []domain.Projection
+	for _, projection := range projections {
+		if _, ok := r.sinks[projection.Destination.Kind].(ports.BatchSink); ok {
+			batched = append(batched, projection)
+			continue
+		}
+		single = append(single, projection)
+	}
+
+	if len(single) > 0 || len(batched) == 0 {
+		for _, record := range records {
+			if err := r.handleRecordWithProjections(ctx, single, record); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, projection := range batched {
+		pending := make([]domain.SourceRecord, 0, len(records))
+		for _, record := range records {
+			if shouldSuppressRecord(projection, record) {
+				continue
+			}
+			record, ok, err := r.applyRules(ctx, projection, record)
+			if err != nil {
+				return err
+			}
+			if ok {
+				pending = append(pending, record)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		r.logger.Debug("writing projection batch",
+			zap.String("projection", projection.Name),
+			zap.String("table", records[0].FullTableName()),
+			zap.String("sink", string(projection.Destination.Kind)),
+			zap.Int("records", len(pending)),
+		)
+
+		if err := r.writeProjectionBatch(ctx, projection, pending); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyRules runs the projection's filter and computed fields on a record. A
+// record the rules cannot evaluate is dead-lettered as it arrived, marked so a
+// replay runs the rules again, and skipped, so one bad row does not stall the
+// stream. It only returns an error when the record cannot be dead-lettered.
+func (r *Runtime) applyRules(
+	ctx context.Context,
+	projection domain.Projection,
+	record domain.SourceRecord,
+) (domain.SourceRecord, bool, error) {
+	applied, ok, err := r.evaluateRules(projection, record)
+	if err == nil {
+		return applied, ok, nil
+	}
+	if r.dlqWriter == nil {
+		return record, false, err
+	}
+
+	entry := domain.DeadLetterRecord{
+		TransactionID: record.Metadata.TransactionID,
+		CommitLSN:     record.Metadata.CommitLSN,
+		Projection:    projection.Name,
+		Error:         err.Error(),
+		Attempts:      1,
+		RulesPending:  true,
+		Record:        record,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if dlqErr := r.dlqWriter.Write(ctx, entry); dlqErr != nil {
+		return record, false, fmt.Errorf("%w; dlq write failed: %w", err, dlqErr)
+	}
+	if stats, ok := r.stats[projection.Name]; ok {
+		stats.deadLetters.Add(1)
+	}
+	r.logger.Error("projection rules failed; record sent to dlq",
+		zap.String("projection", projection.Name),
+		zap.String("table", record.FullTableName()),
+		zap.String("operation", record.Operation.String()),
+		zap.String("commit_lsn", record.Metadata.CommitLSN),
+		zap.Error(err),
+	)
+
+	return record, false, nil
+}
+
+// evaluateRules runs the projection's filter and computed fields on a record.
+// It returns false when the filter drops the record.
+func (r *Runtime) evaluateRules(
+	projection domain.Projection,
+	record domain.SourceRecord,
+) (domain.SourceRecord, bool, error) {
+	r.projectionMu.RLock()
+	rules := r.rules[projection.Name]
+	r.projectionMu.RUnlock()
+	if rules == nil {
+		return record, true, nil
+	}
+
+	applied, ok, err := rules.apply(record)
+	if err != nil {
+		return record, false, fmt.Errorf(
+			"projection %s rules failed for %s at %s: %w",
+			projection.Name,
+			record.FullTableName(),
+			record.Metadata.CommitLSN,
+			err,
+		)
+	}
+	if !ok {
+		r.logger.Debug("filtered record",
+			zap.String("projection", projection.Name),
+			zap.String("table", record.FullTableName()),
+			zap.String("operation", record.Operation.String()),
+		)
+	}
+
+	return applied, ok, nil
+}
+
 func (r *Runtime) writeProjection(ctx context.Context, projection domain.Projection, record domain.SourceRecord) error {
+	sink, err := r.projectionSink(projection)
+	if err != nil {
+		return err
+	}
+
+	attempts, exhausted, lastErr := r.writeWithRetry(ctx, projection, sink, func(writeCtx context.Context) error {
+		return sink.Write(writeCtx, projection, record)
+	}, zap.String("operation", record.Operation.String()), zap.String("table", record.FullTableName()))
+	if !exhausted {
+		if lastErr == nil {
+			r.recordWrite(projection.Name, record.Metadata.CommitLSN)
+		}
+		return lastErr
+	}
+
+	return r.deadLetter(ctx, projection, attempts, lastErr, record)
+}
+
+func (r *Runtime) writeProjectionBatch(
+	ctx context.Context,
+	projection domain.Projection,
+	records []domain.SourceRecord,
+) error {
+	sink, err := r.projectionSink(projection)
+	if err != nil {
+		return err
+	}
+	batchSink, ok := sink.(ports.BatchSink)
+	if !ok {
+		return fmt.Errorf("projection %s sink %s does not support batches", projection.Name, sink.Name())
+	}
+
+	attempts, exhausted, lastErr := r.writeWithRetry(ctx, projection, sink, func(writeCtx context.Context) error {
+		return batchSink.WriteBatch(writeCtx, projection, records)
+	}, zap.String("table", records[0].FullTableName()), zap.Int("records", len(records)))
+	if !exhausted {
+		if lastErr == nil {
+			r.recordWrite(projection.Name, records[0].Metadata.CommitLSN)
+		}
+		return lastErr
+	}
+
+	return r.deadLetter(ctx, projection, attempts, lastErr, records...)
+}
+
+func (r *Runtime) projectionSink(projection domain.Projection) (ports.Sink, error) {
 	sink, ok := r.sinks[projection.Destination.Kind]
 	if !ok {
-		return fmt.Errorf("projection %s uses unknown sink kind %q", projection.Name, projection.Destination.Kind)
+		return nil, fmt.Errorf("projection %s uses unknown sink kind %q", projection.Name, projection.Destination.Kind)
 	}
 
+	return sink, nil
+}
+
+// writeWithRetry calls write up to retryMax times, each under the process
+// timeout, and gives up early once the retry budget is spent so a failing
+// sink holds its worker for a bounded time. It reports how many attempts ran
+// and whether they all failed, as opposed to the context ending between
+// attempts, along with the last error.
+func (r *Runtime) writeWithRetry(
+	ctx context.Context,
+	projection domain.Projection,
+	sink ports.Sink,
+	write func(ctx context.Context) error,
+	fields ...zap.Field,
+) (attempts int, exhausted bool, err error) {
 	var lastErr error
+	deadline := time.Now().Add(r.retryBudget)
 	for attempt := 1; attempt <= r.retryMax; attempt++ {
-		writeCtx, cancel := context.WithTimeout(ctx, r.processTimeout)
-		err := sink.Write(writeCtx, projection, record)
+		attempts = attempt
+		writeCtx, cancel := context.WithTimeout(ctx, min(r.processTimeout, time.Until(deadline)))
+		err = write(writeCtx)
 		cancel()
 		if err == nil {
 			r.setStatus(sink.Name(), true)
-			return nil
+			return attempts, false, nil
 		}
 
 		lastErr = err
 		r.setStatus(sink.Name(), false)
-		r.logger.Warn("projection write failed",
+		if stats, ok := r.stats[projection.Name]; ok {
+			stats.writeErrors.Add(1)
+		}
+		r.logger.Warn("projection write failed", append([]zap.Field{
 			zap.String("projection", projection.Name),
 			zap.String("sink", sink.Name()),
-			zap.String("operation", record.Operation.String()),
-			zap.String("table", record.FullTableName()),
 			zap.Int("attempt", attempt),
 			zap.Int("max_attempts", r.retryMax),
 			zap.Error(err),
-		)
-		if attempt == r.retryMax {
+		}, fields...)...)
+		if attempt == r.retryMax || time.Until(deadline) <= r.retryBackoff {
 			break
 		}
 
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return attempts, false, ctx.Err()
 		case <-time.After(r.retryBackoff):
 		}
 	}
 
-	if r.dlqWriter != nil {
+	return attempts, true, lastErr
+}
+
+// deadLetter records each failed record on the DLQ so it can be replayed one
+// at a time. The error still stops the transaction unless the destination's
+// on_dead_letter policy is continue, in which case the parked transaction
+// counts as handled and its checkpoint advances. Without a DLQ, or when the
+// DLQ write fails, the transaction always stops.
+func (r *Runtime) deadLetter(
+	ctx context.Context,
+	projection domain.Projection,
+	attempts int,
+	lastErr error,
+	records ...domain.SourceRecord,
+) error {
+	if r.dlqWriter == nil {
+		return fmt.Errorf("projection %s failed after %d attempts: %w", projection.Name, attempts, lastErr)
+	}
+
+	for _, record := range records {
 		entry := domain.DeadLetterRecord{
 			TransactionID: record.Metadata.TransactionID,
 			CommitLSN:     record.Metadata.CommitLSN,
 			Projection:    projection.Name,
 			Error:         lastErr.Error(),
-			Attempts:      r.retryMax,
+			Attempts:      attempts,
 			Record:        record,
 			CreatedAt:     time.Now().UTC(),
 		}
 		if err := r.dlqWriter.Write(ctx, entry); err != nil {
 			return fmt.Errorf("projection %s failed and dlq write failed: %w", projection.Name, err)
 		}
+		if stats, ok := r.stats[projection.Name]; ok {
+			stats.deadLetters.Add(1)
+		}
 		r.logger.Error("projection sent to dlq",
 			zap.String("projection", projection.Name),
 			zap.String("table", record.FullTableName()),
@@ -417,12 +829,22 @@ func (r *Runtime) writeProjection(ctx context.Context, projection domain.Project
 		)
 	}
 
-	return fmt.Errorf("projection %s failed after %d attempts: %w", projection.Name, r.retryMax, lastErr)
+	if projection.Destination.OnDeadLetter == domain.DeadLetterContinue {
+		return nil
+	}
+	return fmt.Errorf("projection %s failed after %d attempts: %w", projection.Name, attempts, lastErr)
 }
 
+// matchingProjections returns the table's projections that are not paused.
 func (r *Runtime) matchingProjections(fullTableName string) []domain.Projection {
+	r.projectionMu.RLock()
+	defer r.projectionMu.RUnlock()
+
 	matches := make([]domain.Projection, 0, len(r.projections))
 	for _, projection := range r.projections {
+		if _, paused := r.paused[projection.Name]; paused {
+			continue
+		}
 		if projection.FullTableName() == fullTableName {
 			matches = append(matches, projection)
 		}
@@ -465,8 +887,14 @@ func (r *Runtime) prepare(ctx context.Context, ensureCheckpoints bool) error {
 	return nil
 }
 
+// resolveProjections binds each projection to its source table's discovered
+// keys and columns and compiles its rules. A projection that does not match
+// its table is kept but paused with the reason, so one stale projection does
+// not stop GTC from serving the others.
 func (r *Runtime) resolveProjections(ctx context.Context) error {
 	resolved := make([]domain.Projection, 0, len(r.projections))
+	rules := make(map[string]*projectionRules, len(r.projections))
+	unresolved := make(map[string]error)
 	metadataCache := make(map[string]domain.TableMetadata, len(r.projections))
 
 	for _, projection := range r.projections {
@@ -481,23 +909,72 @@ func (r *Runtime) resolveProjections(ctx context.Context) error {
 			metadataCache[key] = metadata
 		}
 
-		if len(projection.PrimaryKeys) > 0 && !domain.EqualStringSlices(projection.PrimaryKeys, metadata.PrimaryKeys) {
-			return fmt.Errorf(
-				"projection %s primary keys %v do not match discovered keys %v",
-				projection.Name,
-				projection.PrimaryKeys,
-				metadata.PrimaryKeys,
-			)
+		projectionRules, err := resolveProjection(&projection, metadata)
+		if err != nil {
+			unresolved[projection.Name] = err
+		} else if projectionRules != nil {
+			rules[projection.Name] = projectionRules
 		}
-
-		projection.PrimaryKeys = slices.Clone(metadata.PrimaryKeys)
 		resolved = append(resolved, projection)
 	}
 
+	schemaVersions := make(map[string]string, len(metadataCache))
+	for table, metadata := range metadataCache {
+		schemaVersions[table] = domain.SchemaVersion(metadata.Columns)
+	}
+
+	r.projectionMu.Lock()
 	r.projections = resolved
+	r.rules = rules
+	r.schemaVersions = schemaVersions
+	r.unresolved = unresolved
+	r.projectionMu.Unlock()
+
+	for _, projection := range resolved {
+		if err, ok := unresolved[projection.Name]; ok {
+			r.pauseProjection(projection.Name, err.Error())
+		}
+	}
+
 	return nil
 }
 
+// resolveProjection adopts the table's primary keys on the projection and
+// compiles its rules against the table's columns.
+func resolveProjection(projection *domain.Projection, metadata domain.TableMetadata) (*projectionRules, error) {
+	if len(projection.PrimaryKeys) > 0 && !domain.EqualStringSlices(projection.PrimaryKeys, metadata.PrimaryKeys) {
+		return nil, fmt.Errorf(
+			"projection %s primary keys %v do not match discovered keys %v",
+			projection.Name,
+			projection.PrimaryKeys,
+			metadata.PrimaryKeys,
+		)
+	}
+
+	projection.PrimaryKeys = slices.Clone(metadata.PrimaryKeys)
+	if err := checkFields(*projection, metadata); err != nil {
+		return nil, err
+	}
+
+	return compileRules(*projection, metadata)
+}
+
+// resolvedProjections returns the projections that resolved against their
+// source tables.
+func (r *Runtime) resolvedProjections() []domain.Projection {
+	r.projectionMu.RLock()
+	defer r.projectionMu.RUnlock()
+
+	projections := make([]domain.Projection, 0, len(r.projections))
+	for _, projection := range r.projections {
+		if _, ok := r.unresolved[projection.Name]; !ok {
+			projections = append(projections, projection)
+		}
+	}
+
+	return projections
+}
+
 func uniqueBindings(projections []domain.Projection) []domain.SnapshotBinding {
 	seen := make(map[string]struct{}, len(projections))
 	bindings := make([]domain.SnapshotBinding, 0, len(projections))
@@ -521,7 +998,7 @@ func uniqueBindings(projections []domain.Projection) []domain.SnapshotBinding {
 
 func (r *Runtime) filterProjections(projectionNames []string, tableNames []string) ([]domain.Projection, error) {
 	if len(projectionNames) == 0 && len(tableNames) == 0 {
-		return slices.Clone(r.projections), nil
+		return r.refuseUnresolved(slices.Clone(r.projections))
 	}
 
 	projectionSet := make(map[string]struct{}, len(projectionNames))
@@ -548,20 +1025,35 @@ func (r *Runtime) filterProjections(projectionNames []string, tableNames []strin
 		}
 	}
 	if len(filtered) == 0 {
-		return nil, fmt.Errorf("no projections matched the requested filters")
+		return nil, fmt.Errorf("%w: no projections matched the requested filters", domain.ErrProjectionNotFound)
 	}
 
-	return filtered, nil
+	return r.refuseUnresolved(filtered)
+}
+
+// refuseUnresolved keeps backfills away from projections that did not
+// resolve, whose keys and rules do not match their source table.
+func (r *Runtime) refuseUnresolved(projections []domain.Projection) ([]domain.Projection, error) {
+	for _, projection := range projections {
+		if err, ok := r.unresolved[projection.Name]; ok {
+			return nil, fmt.Errorf("%w: projection %s did not resolve: %w", domain.ErrProjectionState, projection.Name, err)
+		}
+	}
+
+	return projections, nil
 }
 
 func (r *Runtime) projectionByName(name string) (domain.Projection, error) {
+	r.projectionMu.RLock()
+	defer r.projectionMu.RUnlock()
+
 	for _, projection := range r.projections {
 		if projection.Name == name {
 			return projection, nil
 		}
 	}
 
-	return domain.Projection{}, fmt.Errorf("unknown projection %q", name)
+	return domain.Projection{}, fmt.Errorf("%w: %q", domain.ErrProjectionNotFound, name)
 }
 
 func shouldSuppressRecord(projection domain.Projection, record domain.SourceRecord) bool {
@@ -622,6 +1114,7 @@ type partitionJob struct {
 	tx        domain.TransactionRecords
 	partition string
 	records   []domain.SourceRecord
+	schemas   []domain.TableSchema
 }
 
 type partitionResult struct {
@@ -776,15 +1269,8 @@ func (p *transactionProcessor) run() {
 					return
 				}
 				if ackState.tx.CommitLSN != "" {
-					if err := p.runtime.checkpoints.SaveWALLSN(p.ctx, ackState.tx.CommitLSN); err != nil {
-						p.setErr(fmt.Errorf("save wal checkpoint: %w", err))
-						return
-					}
-					if checkpointLSN, err := pglogrepl.ParseLSN(ackState.tx.CommitLSN); err == nil {
-						metrics.CheckpointLSNBytes.Set(float64(uint64(checkpointLSN)))
-					}
-					if err := p.runtime.tailReader.AdvanceLSN(ackState.tx.CommitLSN); err != nil {
-						p.setErr(fmt.Errorf("advance tail lsn: %w", err))
+					if err := p.checkpoint(nextAck, ackState.tx.CommitLSN); err != nil {
+						p.setErr(err)
 						return
 					}
 				}
@@ -798,6 +1284,28 @@ func (p *transactionProcessor) run() {
 	}
 }
 
+// checkpoint saves a transaction's commit as the WAL checkpoint and confirms
+// it to PostgreSQL, unless a paused projection still holds its records.
+func (p *transactionProcessor) checkpoint(seq uint64, commitLSN string) error {
+	advance, err := p.runtime.releaseCheckpoint(p.ctx, seq, commitLSN)
+	if err != nil || !advance {
+		return err
+	}
+
+	if err := p.runtime.checkpoints.SaveWALLSN(p.ctx, commitLSN); err != nil {
+		return fmt.Errorf("save wal checkpoint: %w", err)
+	}
+	if checkpointLSN, err := pglogrepl.ParseLSN(commitLSN); err == nil {
+		metrics.CheckpointLSNBytes.Set(float64(uint64(checkpointLSN)))
+		p.runtime.checkpointLSN.Store(uint64(checkpointLSN))
+	}
+	if err := p.runtime.tailReader.AdvanceLSN(commitLSN); err != nil {
+		return fmt.Errorf("advance tail lsn: %w", err)
+	}
+
+	return nil
+}
+
 func (p *transactionProcessor) worker(jobs <-chan partitionJob) {
 	defer p.wg.Done()
 
@@ -810,14 +1318,16 @@ func (p *transactionProcessor) worker(jobs <-chan partitionJob) {
 				return
 			}
 
-			var err error
+			records := make([]domain.SourceRecord, 0, len(job.records))
 			for _, record := range job.records {
 				record.Metadata.CommitLSN = job.tx.CommitLSN
 				record.Metadata.Timestamp = job.tx.Timestamp
 				record.Metadata.TransactionID = job.tx.TransactionID
-				if err = p.runtime.handleRecord(p.ctx, record); err != nil {
-					break
-				}
+				records = append(records, record)
+			}
+			err := p.runtime.handleSchemaChanges(p.ctx, job.schemas)
+			if err == nil {
+				err = p.runtime.handleRecords(p.ctx, job.seq, records)
 			}
 
 			select {
@@ -829,15 +1339,29 @@ func (p *transactionProcessor) worker(jobs <-chan partitionJob) {
 	}
 }
 
+// partitionRecords splits a transaction into one job per table. A table's
+// relation messages travel with its records, so the worker that owns the
+// table handles a schema change before the rows that follow it.
 func partitionRecords(seq uint64, tx domain.TransactionRecords) []partitionJob {
 	grouped := make(map[string][]domain.SourceRecord)
+	schemas := make(map[string][]domain.TableSchema)
 	order := make([]string, 0)
+	seen := make(map[string]struct{})
+	track := func(key string) {
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			order = append(order, key)
+		}
+	}
 
+	for _, schema := range tx.Schemas {
+		key := schema.FullTableName()
+		track(key)
+		schemas[key] = append(schemas[key], schema)
+	}
 	for _, record := range tx.Records {
 		key := record.FullTableName()
-		if _, exists := grouped[key]; !exists {
-			order = append(order, key)
-		}
+		track(key)
 		grouped[key] = append(grouped[key], record)
 	}
 
@@ -848,6 +1372,7 @@ func partitionRecords(seq uint64, tx domain.TransactionRecords) []partitionJob {
 			tx:        tx,
 			partition: key,
 			records:   grouped[key],
+			schemas:   schemas[key],
 		})
 	}
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -20,9 +22,13 @@ type fakeTailReader struct {
 	transactions []domain.TransactionRecords
 	advanceCalls []string
 	advanceErr   error
+	beforeStart  func()
 }
 
 func (f *fakeTailReader) Start(ctx context.Context, startLSN string, handler ports.TransactionHandler) error {
+	if f.beforeStart != nil {
+		f.beforeStart()
+	}
 	f.startLSN = startLSN
 	f.currentLSN = startLSN
 	f.handler = handler
@@ -103,11 +109,13 @@ func (f *fakeSnapshotReader) run(
 func (f *fakeSnapshotReader) HealthCheck(ctx context.Context) error { return nil }
 
 type fakeCheckpointStore struct {
-	bootstrapLSN string
-	walLSN       string
-	saveCalls    []string
-	saveErr      error
-	onSaveWALLSN func(lsn string)
+	bootstrapLSN   string
+	walLSN         string
+	saveCalls      []string
+	saveErr        error
+	onSaveWALLSN   func(lsn string)
+	schemaVersions map[string]string
+	paused         map[string]bool
 }
 
 func (f *fakeCheckpointStore) Ensure(ctx context.Context) error      { return nil }
@@ -143,6 +151,34 @@ func (f *fakeCheckpointStore) SaveSnapshotProgress(
 ) error {
 	return nil
 }
+func (f *fakeCheckpointStore) LoadSchemaVersion(ctx context.Context, projection string) (string, error) {
+	return f.schemaVersions[projection], nil
+}
+func (f *fakeCheckpointStore) SaveSchemaVersion(ctx context.Context, projection string, version string) error {
+	if f.schemaVersions == nil {
+		f.schemaVersions = make(map[string]string)
+	}
+	f.schemaVersions[projection] = version
+	return nil
+}
+
+func (f *fakeCheckpointStore) LoadPausedProjections(ctx context.Context) ([]string, error) {
+	names := make([]string, 0, len(f.paused))
+	for name, paused := range f.paused {
+		if paused {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+func (f *fakeCheckpointStore) SaveProjectionPaused(ctx context.Context, projection string, paused bool) error {
+	if f.paused == nil {
+		f.paused = make(map[string]bool)
+	}
+	f.paused[projection] = paused
+	return nil
+}
 
 type fakeMetadataStore struct {
 	metadata map[string]domain.TableMetadata
@@ -167,6 +203,7 @@ type fakeSink struct {
 	delay    time.Duration
 	failures int
 	writeLog []string
+	records  []domain.SourceRecord
 }
 
 func (f *fakeSink) Kind() domain.DestinationKind         { return f.kind }
@@ -190,20 +227,104 @@ func (f *fakeSink) Write(ctx context.Context, projection domain.Projection, reco
 
 	f.writes++
 	f.writeLog = append(f.writeLog, projection.Name+":"+record.FullTableName()+":"+recordID(record))
+	f.records = append(f.records, record)
 	return nil
 }
 func (f *fakeSink) HealthCheck(ctx context.Context) error { return nil }
 func (f *fakeSink) Shutdown(ctx context.Context) error    { return nil }
 
+type fakeBatchSink struct {
+	fakeSink
+	batchFailures int
+	batches       [][]string
+}
+
+func (f *fakeBatchSink) WriteBatch(
+	ctx context.Context,
+	projection domain.Projection,
+	records []domain.SourceRecord,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.batchFailures > 0 {
+		f.batchFailures--
+		return errors.New("sink failed")
+	}
+
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, recordID(record))
+	}
+	f.batches = append(f.batches, ids)
+	return nil
+}
+
+type fakeRebuildSink struct {
+	fakeSink
+	rebuilt []string
+}
+
+func (f *fakeRebuildSink) Rebuild(ctx context.Context, projection domain.Projection) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rebuilt = append(f.rebuilt, projection.Name)
+	return nil
+}
+
 type fakeDLQ struct {
 	mu      sync.Mutex
 	records []domain.DeadLetterRecord
+	ids     []string
+	nextID  int
 }
 
 func (f *fakeDLQ) Write(ctx context.Context, entry domain.DeadLetterRecord) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	f.nextID++
 	f.records = append(f.records, entry)
+	f.ids = append(f.ids, fmt.Sprintf("%d-0", f.nextID))
+	return nil
+}
+
+func (f *fakeDLQ) Read(ctx context.Context, after string, limit int64) ([]domain.DeadLetterEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	start := 0
+	if after != "" {
+		start = slices.Index(f.ids, after) + 1
+	}
+	entries := make([]domain.DeadLetterEntry, 0)
+	for idx := start; idx < len(f.ids) && int64(len(entries)) < limit; idx++ {
+		entries = append(entries, domain.DeadLetterEntry{ID: f.ids[idx], Record: f.records[idx]})
+	}
+	return entries, nil
+}
+
+func (f *fakeDLQ) Get(ctx context.Context, ids ...string) ([]domain.DeadLetterEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entries := make([]domain.DeadLetterEntry, 0, len(ids))
+	for _, id := range ids {
+		if idx := slices.Index(f.ids, id); idx >= 0 {
+			entries = append(entries, domain.DeadLetterEntry{ID: id, Record: f.records[idx]})
+		}
+	}
+	return entries, nil
+}
+
+func (f *fakeDLQ) Delete(ctx context.Context, ids ...string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, id := range ids {
+		if idx := slices.Index(f.ids, id); idx >= 0 {
+			f.ids = slices.Delete(f.ids, idx, idx+1)
+			f.records = slices.Delete(f.records, idx, idx+1)
+		}
+	}
 	return nil
 }
 
@@ -215,17 +336,19 @@ func baseRuntimeParams(
 	sinks ...ports.Sink,
 ) RuntimeParams {
 	return RuntimeParams{
-		TailReader:      tailer,
-		Snapshotter:     snapshotter,
-		Checkpoints:     checkpoints,
-		MetadataStore:   metadata,
-		ProcessTimeout:  time.Second,
-		WorkerCount:     2,
-		WorkerQueueSize: 8,
-		RetryMax:        3,
-		RetryBackoff:    time.Millisecond,
-		Sinks:           sinks,
-		Logger:          zap.NewNop(),
+		TailReader:       tailer,
+		Snapshotter:      snapshotter,
+		Checkpoints:      checkpoints,
+		MetadataStore:    metadata,
+		ProcessTimeout:   time.Second,
+		WorkerCount:      2,
+		WorkerQueueSize:  8,
+		RetryMax:         3,
+		RetryBackoff:     time.Millisecond,
+		RetryBudget:      time.Second,
+		PauseMaxLagBytes: 1 << 20,
+		Sinks:            sinks,
+		Logger:           zap.NewNop(),
 	}
 }
 
@@ -542,6 +665,44 @@ func TestRuntimeWritesDeadLetterOnExhaustedRetries(t *testing.T) {
 	if len(dlq.records) != 1 {
 		t.Fatalf("expected one dead-letter entry, got %d", len(dlq.records))
 	}
+	if dlq.records[0].Attempts != 2 {
+		t.Fatalf("expected dead letter to record both attempts, got %d", dlq.records[0].Attempts)
+	}
+}
+
+func TestRuntimeFailsExhaustedWriteWithoutDeadLetterQueue(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	failingSink := &fakeSink{kind: domain.DestinationMeilisearch, failures: 5}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, failingSink)
+	params.RetryMax = 2
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:  domain.DestinationMeilisearch,
+				Index: "shipments",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err == nil {
+		t.Fatalf("expected runtime to fail when there is no dlq to park the record")
+	}
 }
 
 func TestRuntimePreservesCheckpointOrderAcrossTransactions(t *testing.T) {
@@ -934,3 +1095,758 @@ func TestRuntimeBackfillFiltersProjection(t *testing.T) {
 		t.Fatalf("expected no cache writes, got %d", redisSink.writes)
 	}
 }
+
+func batchTransaction() domain.TransactionRecords {
+	return domain.TransactionRecords{
+		LSN:           "0/18",
+		CommitLSN:     "0/20",
+		TransactionID: 7,
+		Records: []domain.SourceRecord{
+			{
+				Operation: domain.OperationInsert,
+				Schema:    "public",
+				Table:     "shipments",
+				NewData:   map[string]any{"id": "shp_1"},
+			},
+			{
+				Operation: domain.OperationUpdate,
+				Schema:    "public",
+				Table:     "shipments",
+				OldData:   map[string]any{"id": "shp_2", "updated_at": 1},
+				NewData:   map[string]any{"id": "shp_2", "updated_at": 2},
+			},
+			{
+				Operation: domain.OperationInsert,
+				Schema:    "public",
+				Table:     "shipments",
+				NewData:   map[string]any{"id": "shp_3"},
+			},
+		},
+	}
+}
+
+func TestRuntimeDeliversTransactionBatchToBatchSinks(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{transactions: []domain.TransactionRecords{batchTransaction()}}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{bootstrapLSN: "0/10", walLSN: "0/10"}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	webhookSink := &fakeBatchSink{fakeSink: fakeSink{kind: domain.DestinationWebhook}}
+	meiliSink := &fakeSink{kind: domain.DestinationMeilisearch}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, webhookSink, meiliSink)
+	params.Projections = []domain.Projection{
+		{
+			Name:           "shipment-lake",
+			SourceSchema:   "public",
+			SourceTable:    "shipments",
+			IgnoredUpdates: []string{"updated_at"},
+			Destination: domain.Destination{
+				Kind: domain.DestinationWebhook,
+				URL:  "https://lake.example.com/gtc",
+			},
+		},
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:  domain.DestinationMeilisearch,
+				Index: "shipments",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("runtime.Start returned error: %v", err)
+	}
+
+	if len(webhookSink.batches) != 1 {
+		t.Fatalf("expected one batch for the transaction, got %v", webhookSink.batches)
+	}
+	if got := webhookSink.batches[0]; len(got) != 2 || got[0] != "shp_1" || got[1] != "shp_3" {
+		t.Fatalf("expected suppressed update to be dropped from the batch, got %v", got)
+	}
+	if webhookSink.writes != 1 {
+		t.Fatalf("expected the snapshot record to use single writes, got %d", webhookSink.writes)
+	}
+	if meiliSink.writes != 4 {
+		t.Fatalf("expected per-record writes for non-batch sinks, got %d", meiliSink.writes)
+	}
+	if checkpoints.walLSN != "0/20" {
+		t.Fatalf("expected wal checkpoint to advance to commit lsn, got %s", checkpoints.walLSN)
+	}
+}
+
+func TestRuntimeDeadLettersEveryRecordOfFailedBatch(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{transactions: []domain.TransactionRecords{batchTransaction()}}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{bootstrapLSN: "0/10", walLSN: "0/10"}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	webhookSink := &fakeBatchSink{fakeSink: fakeSink{kind: domain.DestinationWebhook}, batchFailures: 5}
+	dlq := &fakeDLQ{}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, webhookSink)
+	params.DeadLetter = dlq
+	params.RetryMax = 2
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-lake",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:         domain.DestinationWebhook,
+				URL:          "https://lake.example.com/gtc",
+				OnDeadLetter: domain.DeadLetterContinue,
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("expected dead-lettered batch not to stop the runtime, got %v", err)
+	}
+
+	if len(dlq.records) != 3 {
+		t.Fatalf("expected one dead-letter entry per record, got %d", len(dlq.records))
+	}
+	if dlq.records[0].CommitLSN != "0/20" || dlq.records[0].TransactionID != 7 {
+		t.Fatalf("expected transaction metadata on dead letters, got %+v", dlq.records[0])
+	}
+	if checkpoints.walLSN != "0/20" {
+		t.Fatalf("expected wal checkpoint to move past the dead-lettered batch, got %s", checkpoints.walLSN)
+	}
+}
+
+func TestRuntimeDeadLetteredBatchHoldsCheckpointByDefault(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{transactions: []domain.TransactionRecords{batchTransaction()}}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{bootstrapLSN: "0/10", walLSN: "0/10"}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	webhookSink := &fakeBatchSink{fakeSink: fakeSink{kind: domain.DestinationWebhook}, batchFailures: 5}
+	dlq := &fakeDLQ{}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, webhookSink)
+	params.DeadLetter = dlq
+	params.RetryMax = 2
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-lake",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind: domain.DestinationWebhook,
+				URL:  "https://lake.example.com/gtc",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err == nil {
+		t.Fatalf("expected the dead-lettered batch to stop the transaction")
+	}
+
+	if len(dlq.records) != 3 {
+		t.Fatalf("expected one dead-letter entry per record, got %d", len(dlq.records))
+	}
+	if checkpoints.walLSN != "0/10" {
+		t.Fatalf("expected wal checkpoint to stay put, got %s", checkpoints.walLSN)
+	}
+}
+
+func TestRuntimeDeadLettersWhenRetryBudgetIsSpent(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{transactions: []domain.TransactionRecords{batchTransaction()}}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{bootstrapLSN: "0/10", walLSN: "0/10"}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	hungSink := &fakeSink{kind: domain.DestinationMeilisearch, delay: time.Hour}
+	dlq := &fakeDLQ{}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, hungSink)
+	params.DeadLetter = dlq
+	params.RetryMax = 100
+	params.ProcessTimeout = time.Minute
+	params.RetryBudget = 50 * time.Millisecond
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:         domain.DestinationMeilisearch,
+				Index:        "shipments",
+				OnDeadLetter: domain.DeadLetterContinue,
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	started := time.Now()
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if elapsed := time.Since(started); elapsed > 10*time.Second {
+		t.Fatalf("expected the retry budget to bound the stalled writes, took %s", elapsed)
+	}
+	transactionLetters := 0
+	for _, record := range dlq.records {
+		if record.CommitLSN == "0/20" {
+			transactionLetters++
+		}
+		if record.Attempts >= params.RetryMax {
+			t.Fatalf("expected the budget to cut retries short, got %d attempts", record.Attempts)
+		}
+	}
+	if transactionLetters != 3 {
+		t.Fatalf("expected every stalled transaction record to be dead-lettered, got %d", transactionLetters)
+	}
+	if checkpoints.walLSN != "0/20" {
+		t.Fatalf("expected wal checkpoint to advance, got %s", checkpoints.walLSN)
+	}
+}
+
+func TestRuntimeRebuildClearsTargetsBeforeBackfill(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	checkpoints := &fakeCheckpointStore{}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}},
+	}}
+	replicaSink := &fakeRebuildSink{fakeSink: fakeSink{kind: domain.DestinationPostgres}}
+	meiliSink := &fakeSink{kind: domain.DestinationMeilisearch}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, replicaSink, meiliSink)
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-replica",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:  domain.DestinationPostgres,
+				Table: "analytics.shipments",
+			},
+		},
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:  domain.DestinationMeilisearch,
+				Index: "shipments",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Rebuild(context.Background(), nil, []string{"public.shipments"}); err == nil {
+		t.Fatalf("expected rebuild to reject a sink without rebuild support")
+	}
+	if len(replicaSink.rebuilt) != 0 || snapshotter.runCalls != 0 {
+		t.Fatalf("expected nothing to be cleared or backfilled after rejection")
+	}
+
+	if err := runtime.Rebuild(context.Background(), []string{"shipment-replica"}, nil); err != nil {
+		t.Fatalf("Rebuild returned error: %v", err)
+	}
+	if len(replicaSink.rebuilt) != 1 || replicaSink.rebuilt[0] != "shipment-replica" {
+		t.Fatalf("expected replica target to be rebuilt, got %v", replicaSink.rebuilt)
+	}
+	if replicaSink.writes != 1 {
+		t.Fatalf("expected backfill to repopulate the replica, got %d writes", replicaSink.writes)
+	}
+	if meiliSink.writes != 0 {
+		t.Fatalf("expected no search writes, got %d", meiliSink.writes)
+	}
+}
+
+func TestRuntimeEmitsDeleteWhenRowStopsMatchingFilter(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{
+		transactions: []domain.TransactionRecords{
+			{
+				CommitLSN: "0/20",
+				Records: []domain.SourceRecord{
+					{
+						Operation: domain.OperationUpdate,
+						Schema:    "public",
+						Table:     "customers",
+						NewData:   map[string]any{"id": "cus_1", "status": "Inactive", "email": "ops@example.com"},
+					},
+				},
+			},
+		},
+	}
+	snapshotter := &fakeSnapshotReader{
+		currentLSN: "0/10",
+		record: domain.SourceRecord{
+			Operation: domain.OperationSnapshot,
+			NewData:   map[string]any{"id": "cus_2", "status": "Inactive"},
+		},
+	}
+	checkpoints := &fakeCheckpointStore{}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.customers": customerMetadata,
+	}}
+	streamSink := &fakeSink{kind: domain.DestinationRedisStream}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, streamSink)
+	params.Projections = []domain.Projection{
+		{
+			Name:           "customer-stream",
+			SourceSchema:   "public",
+			SourceTable:    "customers",
+			Filter:         `status == "Active"`,
+			ComputedFields: map[string]string{"email": "sha256(email)"},
+			Destination: domain.Destination{
+				Kind:   domain.DestinationRedisStream,
+				Stream: "cdc:customers",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("runtime.Start returned error: %v", err)
+	}
+
+	if len(streamSink.records) != 1 {
+		t.Fatalf("expected only the filter transition to be written, got %d records", len(streamSink.records))
+	}
+	record := streamSink.records[0]
+	if record.Operation != domain.OperationDelete || record.OldData["id"] != "cus_1" {
+		t.Fatalf("expected a delete for the row leaving the filter, got %+v", record)
+	}
+	if record.OldData["email"] == "ops@example.com" {
+		t.Fatalf("expected computed fields to apply to the delete image")
+	}
+}
+
+func TestRuntimeDeadLettersRecordsRulesCannotEvaluate(t *testing.T) {
+	t.Parallel()
+
+	tailer := &fakeTailReader{
+		transactions: []domain.TransactionRecords{
+			{
+				CommitLSN: "0/20",
+				Records: []domain.SourceRecord{
+					{
+						Operation: domain.OperationInsert,
+						Schema:    "public",
+						Table:     "customers",
+						NewData:   map[string]any{"id": "cus_1", "status": nil},
+					},
+					{
+						Operation: domain.OperationInsert,
+						Schema:    "public",
+						Table:     "customers",
+						NewData:   map[string]any{"id": "cus_2", "status": "active"},
+					},
+				},
+			},
+		},
+	}
+	snapshotter := &fakeSnapshotReader{
+		currentLSN: "0/10",
+		record: domain.SourceRecord{
+			Operation: domain.OperationSnapshot,
+			NewData:   map[string]any{"id": "cus_3", "status": "Inactive"},
+		},
+	}
+	checkpoints := &fakeCheckpointStore{}
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.customers": customerMetadata,
+	}}
+	streamSink := &fakeSink{kind: domain.DestinationRedisStream}
+	dlq := &fakeDLQ{}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, streamSink)
+	params.DeadLetter = dlq
+	params.Projections = []domain.Projection{
+		{
+			Name:         "customer-stream",
+			SourceSchema: "public",
+			SourceTable:  "customers",
+			Filter:       `upper(status) == "ACTIVE"`,
+			Destination: domain.Destination{
+				Kind:   domain.DestinationRedisStream,
+				Stream: "cdc:customers",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("expected a rule failure not to stop the runtime, got %v", err)
+	}
+
+	if len(dlq.records) != 1 {
+		t.Fatalf("expected the unevaluable record to be dead-lettered, got %d entries", len(dlq.records))
+	}
+	entry := dlq.records[0]
+	if !entry.RulesPending || entry.Record.NewData["id"] != "cus_1" {
+		t.Fatalf("expected the raw record marked for rule replay, got %+v", entry)
+	}
+	if len(streamSink.records) != 1 || streamSink.records[0].NewData["id"] != "cus_2" {
+		t.Fatalf("expected the rest of the transaction to be written, got %+v", streamSink.records)
+	}
+	if checkpoints.walLSN != "0/20" {
+		t.Fatalf("expected wal checkpoint to advance, got %s", checkpoints.walLSN)
+	}
+}
+
+func TestRuntimeRejectsFilterOnUnknownColumn(t *testing.T) {
+	t.Parallel()
+
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.customers": customerMetadata,
+	}}
+	streamSink := &fakeSink{kind: domain.DestinationRedisStream}
+
+	params := baseRuntimeParams(&fakeTailReader{}, &fakeSnapshotReader{}, &fakeCheckpointStore{}, metadataStore, streamSink)
+	params.Projections = []domain.Projection{
+		{
+			Name:         "customer-stream",
+			SourceSchema: "public",
+			SourceTable:  "customers",
+			Filter:       `region == "West"`,
+			Destination: domain.Destination{
+				Kind:   domain.DestinationRedisStream,
+				Stream: "cdc:customers",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validate to reject a filter on an unknown column")
+	}
+}
+
+func TestRuntimeRejectsFieldsMissingFromTable(t *testing.T) {
+	t.Parallel()
+
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.customers": customerMetadata,
+	}}
+	streamSink := &fakeSink{kind: domain.DestinationRedisStream}
+
+	params := baseRuntimeParams(&fakeTailReader{}, &fakeSnapshotReader{}, &fakeCheckpointStore{}, metadataStore, streamSink)
+	params.Projections = []domain.Projection{
+		{
+			Name:           "customer-stream",
+			SourceSchema:   "public",
+			SourceTable:    "customers",
+			Fields:         []string{"id", "email_hash", "region"},
+			ComputedFields: map[string]string{"email_hash": "sha256(email)"},
+			Destination: domain.Destination{
+				Kind:   domain.DestinationRedisStream,
+				Stream: "cdc:customers",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	err = runtime.Validate(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "[region]") {
+		t.Fatalf("expected validate to reject only the unknown field, got %v", err)
+	}
+}
+
+func TestRuntimeStartPausesProjectionThatDoesNotResolve(t *testing.T) {
+	t.Parallel()
+
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {
+			Schema:      "public",
+			Table:       "shipments",
+			PrimaryKeys: []string{"id", "organization_id", "business_unit_id"},
+		},
+	}}
+	meiliSink := &fakeSink{kind: domain.DestinationMeilisearch}
+	redisSink := &fakeSink{kind: domain.DestinationRedisJSON}
+
+	params := baseRuntimeParams(
+		&fakeTailReader{},
+		&fakeSnapshotReader{currentLSN: "0/16B6C50"},
+		&fakeCheckpointStore{},
+		metadataStore,
+		meiliSink,
+		redisSink,
+	)
+	params.Projections = []domain.Projection{
+		{
+			Name:         "shipment-search",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			Destination: domain.Destination{
+				Kind:  domain.DestinationMeilisearch,
+				Index: "shipments",
+			},
+		},
+		{
+			Name:         "shipment-cache",
+			SourceSchema: "public",
+			SourceTable:  "shipments",
+			PrimaryKeys:  []string{"id"},
+			Destination: domain.Destination{
+				Kind:        domain.DestinationRedisJSON,
+				KeyTemplate: "cache:shipments",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("expected start to pause the stale projection, got %v", err)
+	}
+	if meiliSink.writes == 0 {
+		t.Fatalf("expected the resolved projection to receive the snapshot")
+	}
+	if redisSink.writes != 0 {
+		t.Fatalf("expected the paused projection to receive nothing, got %d writes", redisSink.writes)
+	}
+
+	statuses := runtime.ProjectionStatuses()
+	if statuses[1].State != domain.ProjectionSchemaPaused || !strings.Contains(statuses[1].Reason, "primary keys") {
+		t.Fatalf("expected the stale projection to be paused with its reason, got %+v", statuses[1])
+	}
+	if !runtime.HealthCheck(context.Background())["projection:shipment-search"] {
+		t.Fatalf("expected the resolved projection to stay healthy")
+	}
+	if err := runtime.Validate(context.Background()); err == nil {
+		t.Fatalf("expected validate to report the stale projection")
+	}
+	if err := runtime.Backfill(context.Background(), []string{"shipment-cache"}, nil); !errors.Is(err, domain.ErrProjectionState) {
+		t.Fatalf("expected backfill of the stale projection to be refused, got %v", err)
+	}
+}
+
+var (
+	shipmentColumnsV1 = []domain.ColumnMetadata{
+		{Name: "id", Type: domain.ColumnTypeString, TypeOID: 25},
+		{Name: "status", Type: domain.ColumnTypeString, TypeOID: 25},
+	}
+	shipmentColumnsV2 = append(slices.Clone(shipmentColumnsV1), domain.ColumnMetadata{
+		Name:    "weight",
+		Type:    domain.ColumnTypeFloat,
+		TypeOID: 1700,
+	})
+)
+
+func schemaChangeRuntime(
+	t *testing.T,
+	policy domain.SchemaChangePolicy,
+	checkpoints *fakeCheckpointStore,
+) (*Runtime, *fakeSnapshotReader, *fakeSink) {
+	t.Helper()
+
+	metadataStore := &fakeMetadataStore{metadata: map[string]domain.TableMetadata{
+		"public.shipments": {Schema: "public", Table: "shipments", PrimaryKeys: []string{"id"}, Columns: shipmentColumnsV1},
+	}}
+	tailer := &fakeTailReader{
+		beforeStart: func() {
+			metadataStore.metadata["public.shipments"] = domain.TableMetadata{
+				Schema:      "public",
+				Table:       "shipments",
+				PrimaryKeys: []string{"id"},
+				Columns:     shipmentColumnsV2,
+			}
+		},
+		transactions: []domain.TransactionRecords{
+			{
+				CommitLSN: "0/20",
+				Schemas:   []domain.TableSchema{{Schema: "public", Table: "shipments", Columns: shipmentColumnsV2}},
+				Records: []domain.SourceRecord{
+					{
+						Operation: domain.OperationUpdate,
+						Schema:    "public",
+						Table:     "shipments",
+						NewData:   map[string]any{"id": "shp_1", "status": "InTransit", "weight": 1200.5},
+					},
+				},
+			},
+		},
+	}
+	snapshotter := &fakeSnapshotReader{currentLSN: "0/10"}
+	searchSink := &fakeSink{kind: domain.DestinationMeilisearch}
+
+	params := baseRuntimeParams(tailer, snapshotter, checkpoints, metadataStore, searchSink)
+	params.Projections = []domain.Projection{
+		{
+			Name:           "shipment-search",
+			SourceSchema:   "public",
+			SourceTable:    "shipments",
+			OnSchemaChange: policy,
+			Destination: domain.Destination{
+				Kind:  domain.DestinationMeilisearch,
+				Index: "shipments",
+			},
+		},
+	}
+
+	runtime, err := NewRuntime(params)
+	if err != nil {
+		t.Fatalf("NewRuntime returned error: %v", err)
+	}
+
+	return runtime, snapshotter, searchSink
+}
+
+func TestRuntimeResnapshotsProjectionAfterSchemaChange(t *testing.T) {
+	t.Parallel()
+
+	checkpoints := &fakeCheckpointStore{}
+	runtime, snapshotter, searchSink := schemaChangeRuntime(t, domain.SchemaChangeResnapshot, checkpoints)
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("runtime.Start returned error: %v", err)
+	}
+
+	if snapshotter.runCalls != 2 {
+		t.Fatalf("expected bootstrap snapshot and targeted backfill, got %d runs", snapshotter.runCalls)
+	}
+	if searchSink.writes != 3 {
+		t.Fatalf("expected snapshot, backfill, and update writes, got %d", searchSink.writes)
+	}
+	if got := checkpoints.schemaVersions["shipment-search"]; got != domain.SchemaVersion(shipmentColumnsV2) {
+		t.Fatalf("expected new schema version to be recorded, got %q", got)
+	}
+	if !runtime.HealthCheck(context.Background())["projection:shipment-search"] {
+		t.Fatalf("expected re-snapshotted projection to stay healthy")
+	}
+}
+
+func TestRuntimePausesProjectionAfterSchemaChange(t *testing.T) {
+	t.Parallel()
+
+	checkpoints := &fakeCheckpointStore{}
+	runtime, snapshotter, searchSink := schemaChangeRuntime(t, domain.SchemaChangePause, checkpoints)
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("runtime.Start returned error: %v", err)
+	}
+
+	if snapshotter.runCalls != 1 {
+		t.Fatalf("expected no backfill for a paused projection, got %d runs", snapshotter.runCalls)
+	}
+	if searchSink.writes != 1 {
+		t.Fatalf("expected only the snapshot write, got %d", searchSink.writes)
+	}
+	if got := checkpoints.schemaVersions["shipment-search"]; got != domain.SchemaVersion(shipmentColumnsV1) {
+		t.Fatalf("expected the old schema version to stay recorded, got %q", got)
+	}
+	if runtime.HealthCheck(context.Background())["projection:shipment-search"] {
+		t.Fatalf("expected paused projection to report unhealthy")
+	}
+}
+
+func TestRuntimePausesResnapshotWhenSelectedFieldIsDropped(t *testing.T) {
+	t.Parallel()
+
+	checkpoints := &fakeCheckpointStore{}
+	runtime, snapshotter, searchSink := schemaChangeRuntime(t, domain.SchemaChangeResnapshot, checkpoints)
+	runtime.projections[0].Fields = []string{"id", "status"}
+	metadataStore := runtime.metadataStore.(*fakeMetadataStore)
+	tailer := runtime.tailReader.(*fakeTailReader)
+	tailer.beforeStart = func() {
+		metadataStore.metadata["public.shipments"] = domain.TableMetadata{
+			Schema:      "public",
+			Table:       "shipments",
+			PrimaryKeys: []string{"id"},
+			Columns:     shipmentColumnsV1[:1],
+		}
+	}
+	tailer.transactions[0].Schemas[0].Columns = shipmentColumnsV1[:1]
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("runtime.Start returned error: %v", err)
+	}
+
+	if snapshotter.runCalls != 1 {
+		t.Fatalf("expected no backfill once a selected field is gone, got %d runs", snapshotter.runCalls)
+	}
+	if searchSink.writes != 1 {
+		t.Fatalf("expected only the snapshot write, got %d", searchSink.writes)
+	}
+	if runtime.HealthCheck(context.Background())["projection:shipment-search"] {
+		t.Fatalf("expected projection missing a selected field to report unhealthy")
+	}
+}
+
+func TestRuntimeKeepsProjectionPausedAcrossRestart(t *testing.T) {
+	t.Parallel()
+
+	checkpoints := &fakeCheckpointStore{schemaVersions: map[string]string{
+		"shipment-search": domain.SchemaVersion(shipmentColumnsV2[:1]),
+	}}
+	runtime, _, _ := schemaChangeRuntime(t, domain.SchemaChangePause, checkpoints)
+	runtime.tailReader.(*fakeTailReader).transactions = nil
+
+	if err := runtime.Start(context.Background()); err != nil {
+		t.Fatalf("runtime.Start returned error: %v", err)
+	}
+
+	if runtime.HealthCheck(context.Background())["projection:shipment-search"] {
+		t.Fatalf("expected projection with a stale schema version to start paused")
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/emoss08/gtc/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// handleSchemaChanges reacts to relation messages whose column layout differs
+// from the one the table's projections were resolved against. The catalog is
+// consulted before acting because a publication with a column list announces
+// fewer columns than the table has.
+func (r *Runtime) handleSchemaChanges(ctx context.Context, schemas []domain.TableSchema) error {
+	for _, schema := range schemas {
+		table := schema.FullTableName()
+
+		r.projectionMu.RLock()
+		resolved, tracked := r.schemaVersions[table]
+		r.projectionMu.RUnlock()
+		if !tracked || domain.SchemaVersion(schema.Columns) == resolved {
+			continue
+		}
+
+		metadata, err := r.metadataStore.LoadTableMetadata(ctx, schema.Schema, schema.Table)
+		if err != nil {
+			return fmt.Errorf("reload metadata for %s: %w", table, err)
+		}
+		version := domain.SchemaVersion(metadata.Columns)
+		if version == resolved {
+			continue
+		}
+
+		r.logger.Warn("source table schema changed",
+			zap.String("table", table),
+			zap.String("resolved_version", resolved),
+			zap.String("schema_version", version),
+		)
+		if err := r.applySchemaChange(ctx, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applySchemaChange brings a changed table's projections in line with its new
+// layout. Projections set to resnapshot are re-resolved and backfilled in the
+// background, held so the rows that announced the change are applied after
+// the snapshot; the rest are paused.
+func (r *Runtime) applySchemaChange(ctx context.Context, metadata domain.TableMetadata) error {
+	table := metadata.Schema + "." + metadata.Table
+	version := domain.SchemaVersion(metadata.Columns)
+
+	candidates := r.pauseUnlessResnapshot(
+		r.matchingProjections(table),
+		fmt.Sprintf("source table %s changed to schema version %s", table, version),
+	)
+
+	refreshed := make([]domain.Projection, 0, len(candidates))
+	rules := make(map[string]*projectionRules, len(candidates))
+	for _, projection := range candidates {
+		if !domain.EqualStringSlices(projection.PrimaryKeys, metadata.PrimaryKeys) {
+			r.pauseProjection(projection.Name, fmt.Sprintf(
+				"source table %s primary key changed from %v to %v",
+				table,
+				projection.PrimaryKeys,
+				metadata.PrimaryKeys,
+			))
+			continue
+		}
+
+		if err := checkFields(projection, metadata); err != nil {
+			r.pauseProjection(projection.Name, err.Error())
+			continue
+		}
+		compiled, err := compileRules(projection, metadata)
+		if err != nil {
+			r.pauseProjection(projection.Name, err.Error())
+			continue
+		}
+		refreshed = append(refreshed, projection)
+		rules[projection.Name] = compiled
+	}
+
+	r.projectionMu.Lock()
+	r.schemaVersions[table] = version
+	for name, compiled := range rules {
+		if compiled == nil {
+			delete(r.rules, name)
+			continue
+		}
+		r.rules[name] = compiled
+	}
+	r.projectionMu.Unlock()
+
+	return r.startResnapshot(refreshed)
+}
+
+// startResnapshot re-snapshots projections without blocking the WAL worker
+// that saw the change. When another backfill is already running the
+// projections are paused instead, to be backfilled by an operator.
+func (r *Runtime) startResnapshot(projections []domain.Projection) error {
+	if len(projections) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(projections))
+	for _, projection := range projections {
+		names = append(names, projection.Name)
+	}
+	r.logger.Info("re-snapshotting projections after schema change", zap.Strings("projections", names))
+
+	err := r.StartBackfill(names, nil, false)
+	if !errors.Is(err, domain.ErrBackfillRunning) {
+		return err
+	}
+	for _, name := range names {
+		r.pauseProjection(name, "source table changed while another backfill was running; backfill the projection")
+	}
+
+	return nil
+}
+
+// checkFields reports projection fields that are not columns of the source
+// table. Computed fields count as present because the rules add them.
+func checkFields(projection domain.Projection, metadata domain.TableMetadata) error {
+	columns := make(map[string]struct{}, len(metadata.Columns))
+	for _, column := range metadata.Columns {
+		columns[column.Name] = struct{}{}
+	}
+
+	missing := make([]string, 0)
+	for _, field := range projection.Fields {
+		if _, ok := columns[field]; ok {
+			continue
+		}
+		if _, ok := projection.ComputedFields[field]; ok {
+			continue
+		}
+		missing = append(missing, field)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("projection %s fields %v are not columns of %s", projection.Name, missing, projection.FullTableName())
+	}
+
+	return nil
+}
+
+// reconcileSchemaVersions compares each projection's recorded schema version
+// with its source table's current layout, catching changes made while GTC was
+// stopped and projections still paused from an earlier change.
+func (r *Runtime) reconcileSchemaVersions(ctx context.Context) error {
+	stale := make([]domain.Projection, 0)
+	for _, projection := range r.resolvedProjections() {
+		version := r.schemaVersion(projection)
+		recorded, err := r.checkpoints.LoadSchemaVersion(ctx, projection.Name)
+		if err != nil {
+			return fmt.Errorf("load schema version for %s: %w", projection.Name, err)
+		}
+
+		switch recorded {
+		case "":
+			if err := r.checkpoints.SaveSchemaVersion(ctx, projection.Name, version); err != nil {
+				return fmt.Errorf("save schema version for %s: %w", projection.Name, err)
+			}
+		case version:
+		default:
+			r.logger.Warn("source table schema changed since projection was last synced",
+				zap.String("projection", projection.Name),
+				zap.String("table", projection.FullTableName()),
+				zap.String("recorded_version", recorded),
+				zap.String("schema_version", version),
+			)
+			stale = append(stale, projection)
+		}
+	}
+
+	return r.resnapshot(ctx, r.pauseUnlessResnapshot(
+		stale,
+		"source table changed since the projection was last synced",
+	))
+}
+
+// backfillProjections snapshots the projections' source tables through them
+// and then records the projections as current with those tables' layouts.
+func (r *Runtime) backfillProjections(ctx context.Context, projections []domain.Projection) error {
+	err := r.snapshotter.Backfill(ctx, uniqueBindings(projections), func(runCtx context.Context, record domain.SourceRecord) error {
+		return r.handleRecordWithProjections(runCtx, projections, record)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, projection := range projections {
+		if err := r.checkpoints.SaveSchemaVersion(ctx, projection.Name, r.schemaVersion(projection)); err != nil {
+			return fmt.Errorf("save schema version for %s: %w", projection.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Runtime) resnapshot(ctx context.Context, projections []domain.Projection) error {
+	if len(projections) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(projections))
+	for _, projection := range projections {
+		names = append(names, projection.Name)
+	}
+	r.logger.Info("re-snapshotting projections after schema change", zap.Strings("projections", names))
+
+	if err := r.backfillProjections(ctx, projections); err != nil {
+		return fmt.Errorf("re-snapshot projections %v: %w", names, err)
+	}
+
+	return nil
+}
+
+// pauseUnlessResnapshot pauses every projection whose policy is not
+// resnapshot and returns the ones left to re-snapshot.
+func (r *Runtime) pauseUnlessResnapshot(projections []domain.Projection, reason string) []domain.Projection {
+	resnapshot := make([]domain.Projection, 0, len(projections))
+	for _, projection := range projections {
+		if projection.OnSchemaChange == domain.SchemaChangeResnapshot {
+			resnapshot = append(resnapshot, projection)
+			continue
+		}
+		r.pauseProjection(projection.Name, reason)
+	}
+
+	return resnapshot
+}
+
+// pauseProjection stops routing changes to a projection and reports it as
+// unhealthy. It stays paused until a backfill records it as current and GTC
+// restarts.
+func (r *Runtime) pauseProjection(name string, reason string) {
+	r.projectionMu.Lock()
+	r.paused[name] = reason
+	r.projectionMu.Unlock()
+
+	r.logger.Error("projection paused",
+		zap.String("projection", name),
+		zap.String("reason", reason),
+	)
+}
+
+func (r *Runtime) schemaVersion(projection domain.Projection) string {
+	r.projectionMu.RLock()
+	defer r.projectionMu.RUnlock()
+	return r.schemaVersions[projection.FullTableName()]
+}
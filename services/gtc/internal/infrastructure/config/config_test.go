@@ -113,3 +113,169 @@ func TestProjectionConfigValidateRejectsInvalidDestination(t *testing.T) {
 		t.Fatalf("expected validation error")
 	}
 }
+
+func TestProjectionConfigValidateComputedFields(t *testing.T) {
+	t.Parallel()
+
+	base := ProjectionConfig{
+		Name:        "customer-lake",
+		SourceTable: "public.customers",
+		PrimaryKeys: []string{"id"},
+		Destination: DestinationConfig{Kind: domain.DestinationMeilisearch, Index: "customers"},
+	}
+
+	valid := base
+	valid.Filter = `status == "Active"`
+	valid.ComputedFields = map[string]string{"email": "sha256(email)"}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected computed fields to validate, got %v", err)
+	}
+
+	tests := map[string]map[string]string{
+		"invalid name":         {"email hash": "sha256(email)"},
+		"replaces primary key": {"id": "sha256(id)"},
+		"empty expression":     {"email_hash": " "},
+	}
+	for name, fields := range tests {
+		cfg := base
+		cfg.ComputedFields = fields
+		if err := cfg.Validate(); err == nil {
+			t.Fatalf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestLoadProjectionsResolvesWebhookSecret(t *testing.T) {
+	t.Setenv("GTC_TEST_WEBHOOK_SECRET", "lake-secret")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "gtc.yaml")
+	content := `
+projections:
+  - name: shipment-lake
+    source_table: public.shipments
+    primary_keys: [id]
+    destination:
+      kind: webhook
+      url: https://lake.example.com/gtc
+      secret_env: GTC_TEST_WEBHOOK_SECRET
+      max_concurrency: 4
+      on_dead_letter: continue
+      headers:
+        X-Source: gtc
+`
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	projections, err := LoadProjections(path)
+	if err != nil {
+		t.Fatalf("LoadProjections returned error: %v", err)
+	}
+
+	destination := projections[0].Destination
+	if destination.Secret != "lake-secret" {
+		t.Fatalf("expected webhook secret to resolve from env, got %q", destination.Secret)
+	}
+	if destination.MaxConcurrency != 4 || destination.Headers["X-Source"] != "gtc" {
+		t.Fatalf("expected webhook options to load, got %+v", destination)
+	}
+	if destination.OnDeadLetter != domain.DeadLetterContinue {
+		t.Fatalf("expected on_dead_letter to load, got %q", destination.OnDeadLetter)
+	}
+
+	t.Setenv("GTC_TEST_WEBHOOK_SECRET", "")
+	if _, err := LoadProjections(path); err == nil {
+		t.Fatalf("expected error when the webhook secret is unset")
+	}
+}
+
+func TestDestinationConfigDeadLetterPolicyDefaultsToStop(t *testing.T) {
+	t.Parallel()
+
+	cfg := DestinationConfig{Kind: domain.DestinationMeilisearch, Index: "shipments"}
+	if policy := cfg.deadLetterPolicy(); policy != domain.DeadLetterStop {
+		t.Fatalf("expected dead letters to stop by default, got %q", policy)
+	}
+
+	cfg.OnDeadLetter = "skip"
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected validation error for an unknown on_dead_letter policy")
+	}
+}
+
+func TestDestinationConfigValidateRejectsWebhookWithoutURL(t *testing.T) {
+	t.Parallel()
+
+	cfg := DestinationConfig{
+		Kind:      domain.DestinationWebhook,
+		SecretEnv: "GTC_WEBHOOK_SECRET",
+	}
+
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected validation error")
+	}
+}
+
+func TestDestinationConfigValidatePostgres(t *testing.T) {
+	t.Parallel()
+
+	valid := DestinationConfig{
+		Kind:    domain.DestinationPostgres,
+		Table:   "analytics.shipments",
+		Mode:    domain.ReplicaModeHistory,
+		Columns: map[string]string{"id": "shipment_id"},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected valid postgres destination, got %v", err)
+	}
+
+	invalid := map[string]DestinationConfig{
+		"unqualified table": {Kind: domain.DestinationPostgres, Table: "shipments"},
+		"unknown mode":      {Kind: domain.DestinationPostgres, Table: "analytics.shipments", Mode: "append"},
+		"duplicate target": {
+			Kind:    domain.DestinationPostgres,
+			Table:   "analytics.shipments",
+			Columns: map[string]string{"id": "key", "pro_number": "key"},
+		},
+	}
+	for name, cfg := range invalid {
+		if err := cfg.Validate(); err == nil {
+			t.Fatalf("%s: expected validation error", name)
+		}
+	}
+}
+
+func TestDestinationConfigDefaultsReplicaModeToUpsert(t *testing.T) {
+	t.Parallel()
+
+	cfg := DestinationConfig{Kind: domain.DestinationPostgres, Table: "analytics.shipments"}
+	if cfg.replicaMode() != domain.ReplicaModeUpsert {
+		t.Fatalf("expected upsert default, got %q", cfg.replicaMode())
+	}
+}
+
+func TestProjectionConfigSchemaChangePolicy(t *testing.T) {
+	t.Parallel()
+
+	cfg := ProjectionConfig{
+		Name:        "shipment-search",
+		SourceTable: "public.shipments",
+		PrimaryKeys: []string{"id"},
+		Destination: DestinationConfig{Kind: domain.DestinationMeilisearch, Index: "shipments"},
+	}
+	if cfg.schemaChangePolicy() != domain.SchemaChangePause {
+		t.Fatalf("expected pause default, got %q", cfg.schemaChangePolicy())
+	}
+
+	cfg.OnSchemaChange = domain.SchemaChangeResnapshot
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected resnapshot policy to validate, got %v", err)
+	}
+
+	cfg.OnSchemaChange = "ignore"
+	if err := cfg.Validate(); err == nil {
+		t.Fatalf("expected unknown schema change policy to be rejected")
+	}
+}
@@ -127,4 +127,22 @@ var (
 			Help:      "Last durably persisted checkpoint LSN as a uint64 byte offset",
 		},
 	)
+
+	SnapshotChunkRows = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gtc",
+			Name:      "snapshot_chunk_rows",
+			Help:      "Rows copied by a snapshot chunk since the process started",
+		},
+		[]string{"table", "chunk"},
+	)
+
+	SnapshotChunkCompleted = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "gtc",
+			Name:      "snapshot_chunk_completed",
+			Help:      "Snapshot chunk completion state (1=completed, 0=in progress)",
+		},
+		[]string{"table", "chunk"},
+	)
 )
@@ -0,0 +1,280 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/gtc/internal/core/domain"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+const maxAdminBody = 1 << 20
+
+// Admin is the runtime surface behind the /admin routes.
+type Admin interface {
+	ProjectionStatuses() []domain.ProjectionStatus
+	Pause(ctx context.Context, name string) error
+	Resume(ctx context.Context, name string) error
+	StartBackfill(projectionNames []string, tableNames []string, rebuild bool) error
+	DeadLetters(ctx context.Context, after string, limit int64) ([]domain.DeadLetterEntry, error)
+	DeadLetter(ctx context.Context, id string) (domain.DeadLetterEntry, error)
+	ReplayDeadLetterEntries(ctx context.Context, ids []string) (int, error)
+	PurgeDeadLetters(ctx context.Context, ids []string) error
+}
+
+type backfillRequest struct {
+	Projections []string `json:"projections"`
+	Tables      []string `json:"tables"`
+	Rebuild     bool     `json:"rebuild"`
+}
+
+type deadLetterIDsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+type deadLetterResponse struct {
+	ID            string           `json:"id"`
+	Projection    string           `json:"projection"`
+	Parked        bool             `json:"parked"`
+	RulesPending  bool             `json:"rules_pending,omitempty"`
+	Error         string           `json:"error"`
+	Attempts      int              `json:"attempts"`
+	TransactionID uint32           `json:"transaction_id,omitempty"`
+	CommitLSN     string           `json:"commit_lsn,omitempty"`
+	Operation     domain.Operation `json:"operation"`
+	Table         string           `json:"table"`
+	OldData       map[string]any   `json:"old_data,omitempty"`
+	NewData       map[string]any   `json:"new_data,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+type deadLetterPage struct {
+	Entries   []deadLetterResponse `json:"entries"`
+	NextAfter string               `json:"next_after,omitempty"`
+}
+
+// registerAdminRoutes mounts the admin API behind a bearer token. Without a
+// token the routes are not mounted at all.
+func (s *Server) registerAdminRoutes(token string) {
+	s.router.Route("/admin", func(r chi.Router) {
+		r.Use(bearerAuth(token))
+		r.Get("/projections", s.handleListProjections)
+		r.Post("/projections/{name}/pause", s.handlePauseProjection)
+		r.Post("/projections/{name}/resume", s.handleResumeProjection)
+		r.Post("/backfills", s.handleBackfill)
+		r.Get("/dlq", s.handleListDeadLetters)
+		r.Get("/dlq/{id}", s.handleGetDeadLetter)
+		r.Post("/dlq/replay", s.handleReplayDeadLetters)
+		r.Post("/dlq/purge", s.handlePurgeDeadLetters)
+	})
+}
+
+func bearerAuth(token string) func(http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			provided := []byte(r.Header.Get("Authorization"))
+			if subtle.ConstantTimeCompare(provided, expected) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (s *Server) handleListProjections(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"projections": s.admin.ProjectionStatuses()})
+}
+
+func (s *Server) handlePauseProjection(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	if err := s.admin.Pause(r.Context(), name); err != nil {
+		s.writeAdminError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleResumeProjection waits for the parked and held records to be applied.
+// If the request is cut short the projection stays paused and the call can be
+// repeated.
+func (s *Server) handleResumeProjection(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	if err := s.admin.Resume(r.Context(), name); err != nil {
+		s.writeAdminError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleBackfill(w http.ResponseWriter, r *http.Request) {
+	var req backfillRequest
+	if err := decodeBody(r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	if err := s.admin.StartBackfill(req.Projections, req.Tables, req.Rebuild); err != nil {
+		s.writeAdminError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) handleListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit := int64(100)
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || parsed <= 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "limit must be a positive integer"})
+			return
+		}
+		limit = parsed
+	}
+
+	entries, err := s.admin.DeadLetters(r.Context(), query.Get("after"), limit)
+	if err != nil {
+		s.writeAdminError(w, err)
+		return
+	}
+
+	projection := query.Get("projection")
+	page := deadLetterPage{Entries: make([]deadLetterResponse, 0, len(entries))}
+	for _, entry := range entries {
+		if projection == "" || entry.Record.Projection == projection {
+			page.Entries = append(page.Entries, deadLetterView(entry))
+		}
+	}
+	if int64(len(entries)) == limit {
+		page.NextAfter = entries[len(entries)-1].ID
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) handleGetDeadLetter(w http.ResponseWriter, r *http.Request) {
+	entry, err := s.admin.DeadLetter(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeAdminError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deadLetterView(entry))
+}
+
+func (s *Server) handleReplayDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ids, ok := decodeIDs(w, r)
+	if !ok {
+		return
+	}
+
+	replayed, err := s.admin.ReplayDeadLetterEntries(r.Context(), ids)
+	if err != nil {
+		s.logger.Warn("dlq replay stopped", zap.Int("replayed", replayed), zap.Error(err))
+		s.writeAdminError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]int{"replayed": replayed})
+}
+
+func (s *Server) handlePurgeDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ids, ok := decodeIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.admin.PurgeDeadLetters(r.Context(), ids); err != nil {
+		s.writeAdminError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) writeAdminError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrProjectionNotFound), errors.Is(err, domain.ErrDeadLetterNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrProjectionState), errors.Is(err, domain.ErrBackfillRunning):
+		status = http.StatusConflict
+	case errors.Is(err, domain.ErrDeadLetterQueue):
+		status = http.StatusNotImplemented
+	}
+	if status == http.StatusInternalServerError {
+		s.logger.Error("admin request failed", zap.Error(err))
+	}
+
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func decodeIDs(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	var req deadLetterIDsRequest
+	if err := decodeBody(r, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return nil, false
+	}
+	if len(req.IDs) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "ids is required"})
+		return nil, false
+	}
+
+	return req.IDs, true
+}
+
+func decodeBody(r *http.Request, dest any) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxAdminBody))
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+
+	return sonic.ConfigStd.Unmarshal(body, dest)
+}
+
+func deadLetterView(entry domain.DeadLetterEntry) deadLetterResponse {
+	record := entry.Record
+	return deadLetterResponse{
+		ID:            entry.ID,
+		Projection:    record.Projection,
+		Parked:        record.Parked,
+		RulesPending:  record.RulesPending,
+		Error:         record.Error,
+		Attempts:      record.Attempts,
+		TransactionID: record.TransactionID,
+		CommitLSN:     record.CommitLSN,
+		Operation:     record.Record.Operation,
+		Table:         record.Record.FullTableName(),
+		OldData:       record.Record.OldData,
+		NewData:       record.Record.NewData,
+		CreatedAt:     record.CreatedAt,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	body, err := sonic.ConfigStd.Marshal(value)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
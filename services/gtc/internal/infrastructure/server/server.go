@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/gtc/internal/core/domain"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -17,6 +19,8 @@ type Server struct {
 	httpServer *http.Server
 	logger     *zap.Logger
 	checker    HealthChecker
+	snapshots  SnapshotProgress
+	admin      Admin
 }
 
 type HealthChecker interface {
@@ -24,14 +28,23 @@ type HealthChecker interface {
 	SinkStatuses() map[string]bool
 }
 
+// SnapshotProgress reports the chunks of the initial snapshot or a backfill.
+type SnapshotProgress interface {
+	SnapshotChunks() []domain.SnapshotChunkStatus
+}
+
 type Config struct {
 	Port int
 }
 
 type ServerParams struct {
-	Config  Config
-	Checker HealthChecker
-	Logger  *zap.Logger
+	Config    Config
+	Checker   HealthChecker
+	Snapshots SnapshotProgress
+	// Admin and AdminToken enable the /admin routes; both must be set.
+	Admin      Admin
+	AdminToken string
+	Logger     *zap.Logger
 }
 
 func New(p ServerParams) *Server {
@@ -43,9 +56,11 @@ func New(p ServerParams) *Server {
 	r.Use(middleware.Timeout(30 * time.Second))
 
 	s := &Server{
-		router:  r,
-		logger:  p.Logger.Named("http_server"),
-		checker: p.Checker,
+		router:    r,
+		logger:    p.Logger.Named("http_server"),
+		checker:   p.Checker,
+		snapshots: p.Snapshots,
+		admin:     p.Admin,
 		httpServer: &http.Server{
 			Addr:         fmt.Sprintf(":%d", p.Config.Port),
 			Handler:      r,
@@ -56,6 +71,9 @@ func New(p ServerParams) *Server {
 	}
 
 	s.registerRoutes()
+	if p.Admin != nil && p.AdminToken != "" {
+		s.registerAdminRoutes(p.AdminToken)
+	}
 	return s
 }
 
@@ -65,23 +83,57 @@ func (s *Server) registerRoutes() {
 	s.router.Handle("/metrics", promhttp.Handler())
 }
 
+type healthResponse struct {
+	Status         string                       `json:"status"`
+	SnapshotChunks []domain.SnapshotChunkStatus `json:"snapshot_chunks,omitempty"`
+}
+
 func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+
+	response := healthResponse{Status: "healthy"}
+	if s.snapshots != nil {
+		response.SnapshotChunks = s.snapshots.SnapshotChunks()
+	}
+
+	body, err := sonic.ConfigStd.Marshal(response)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte(`{"status":"healthy"}`))
+	_, _ = w.Write(body)
+}
+
+type readinessResponse struct {
+	Status string          `json:"status"`
+	Checks map[string]bool `json:"checks,omitempty"`
 }
 
+// handleReadiness reports the individual checks alongside the overall status,
+// so a paused projection or failing sink is visible without reading logs.
 func (s *Server) handleReadiness(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
+	response := readinessResponse{Status: "ready"}
+	status := http.StatusOK
 	if s.checker == nil || !s.checker.IsReady() {
-		w.WriteHeader(http.StatusServiceUnavailable)
-		_, _ = w.Write([]byte(`{"status":"not_ready"}`))
+		response.Status = "not_ready"
+		status = http.StatusServiceUnavailable
+	}
+	if s.checker != nil {
+		response.Checks = s.checker.SinkStatuses()
+	}
+
+	body, err := sonic.ConfigStd.Marshal(response)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte(`{"status":"ready"}`))
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
 }
 
 func (s *Server) Start() error {
@@ -0,0 +1,211 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type ServerConfig struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+type AuthConfig struct {
+	APIKeys []string `yaml:"apiKeys"`
+}
+
+type SeedConfig struct {
+	DeterministicSeed string `yaml:"deterministicSeed"`
+	CompanyID         int64  `yaml:"companyId"`
+}
+
+type SimulationConfig struct {
+	FleetSize     int     `yaml:"fleetSize"`
+	TrailerCount  int     `yaml:"trailerCount"`
+	ViolationRate float64 `yaml:"violationRate"`
+	DefectRate    float64 `yaml:"defectRate"`
+}
+
+type GeofenceConfig struct {
+	ID           int64   `yaml:"id"`
+	Name         string  `yaml:"name"`
+	ExternalID   string  `yaml:"externalId"`
+	Lat          float64 `yaml:"lat"`
+	Lon          float64 `yaml:"lon"`
+	RadiusMeters float64 `yaml:"radiusMeters"`
+}
+
+type WebhooksConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	SigningSecret  string        `yaml:"signingSecret"`
+	Targets        []string      `yaml:"targets"`
+	PollInterval   time.Duration `yaml:"pollInterval"`
+	MaxAttempts    int           `yaml:"maxAttempts"`
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+}
+
+type Config struct {
+	Server     ServerConfig     `yaml:"server"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Seed       SeedConfig       `yaml:"seed"`
+	Simulation SimulationConfig `yaml:"simulation"`
+	Geofences  []GeofenceConfig `yaml:"geofences"`
+	Webhooks   WebhooksConfig   `yaml:"webhooks"`
+}
+
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Host: "0.0.0.0",
+			Port: 8092,
+		},
+		Auth: AuthConfig{
+			APIKeys: []string{"dev-motive-key"},
+		},
+		Seed: SeedConfig{
+			DeterministicSeed: "motive-sim-v1",
+			CompanyID:         41000,
+		},
+		Simulation: SimulationConfig{
+			FleetSize:     8,
+			TrailerCount:  10,
+			ViolationRate: 0.15,
+			DefectRate:    0.2,
+		},
+		Geofences: DefaultGeofences(),
+		Webhooks: WebhooksConfig{
+			Enabled:        true,
+			SigningSecret:  "dev-motive-webhook-secret",
+			PollInterval:   30 * time.Second,
+			MaxAttempts:    3,
+			InitialBackoff: 200 * time.Millisecond,
+		},
+	}
+}
+
+// DefaultGeofences is a pair of Dallas and Houston yards the simulated fleet shuttles
+// between. External IDs are left empty so TMS falls back to name matching.
+func DefaultGeofences() []GeofenceConfig {
+	return []GeofenceConfig{
+		{ID: 501, Name: "Dallas Yard", Lat: 32.7767, Lon: -96.7970, RadiusMeters: 800},
+		{ID: 502, Name: "Houston Yard", Lat: 29.7604, Lon: -95.3698, RadiusMeters: 800},
+	}
+}
+
+func Load(path string) (Config, error) {
+	cfg := Default()
+	if strings.TrimSpace(path) == "" {
+		return cfg, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("read config file: %w", err)
+	}
+	if err = yaml.Unmarshal(content, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parse config file: %w", err)
+	}
+	normalizeConfig(&cfg)
+
+	return cfg, nil
+}
+
+func normalizeConfig(cfg *Config) {
+	if cfg == nil {
+		return
+	}
+
+	normalizeServerConfig(&cfg.Server)
+	normalizeAuthConfig(&cfg.Auth)
+	normalizeSeedConfig(&cfg.Seed)
+	normalizeSimulationConfig(&cfg.Simulation)
+	normalizeGeofences(cfg)
+	normalizeWebhooksConfig(&cfg.Webhooks)
+}
+
+func normalizeServerConfig(server *ServerConfig) {
+	if server == nil {
+		return
+	}
+	if server.Host == "" {
+		server.Host = "0.0.0.0"
+	}
+	if server.Port <= 0 {
+		server.Port = 8092
+	}
+}
+
+func normalizeAuthConfig(auth *AuthConfig) {
+	if auth == nil {
+		return
+	}
+	if len(auth.APIKeys) == 0 {
+		auth.APIKeys = []string{"dev-motive-key"}
+	}
+}
+
+func normalizeSeedConfig(seed *SeedConfig) {
+	if seed == nil {
+		return
+	}
+	if seed.DeterministicSeed == "" {
+		seed.DeterministicSeed = "motive-sim-v1"
+	}
+	if seed.CompanyID <= 0 {
+		seed.CompanyID = 41000
+	}
+}
+
+func normalizeSimulationConfig(simulation *SimulationConfig) {
+	if simulation == nil {
+		return
+	}
+	if simulation.FleetSize <= 0 {
+		simulation.FleetSize = 8
+	}
+	if simulation.TrailerCount < 0 {
+		simulation.TrailerCount = 0
+	}
+	simulation.ViolationRate = clampFraction(simulation.ViolationRate, 0.15)
+	simulation.DefectRate = clampFraction(simulation.DefectRate, 0.2)
+}
+
+func normalizeGeofences(cfg *Config) {
+	if len(cfg.Geofences) < 2 {
+		cfg.Geofences = DefaultGeofences()
+	}
+	for i := range cfg.Geofences {
+		if cfg.Geofences[i].ID <= 0 {
+			cfg.Geofences[i].ID = int64(501 + i)
+		}
+		if cfg.Geofences[i].RadiusMeters <= 0 {
+			cfg.Geofences[i].RadiusMeters = 800
+		}
+	}
+}
+
+func normalizeWebhooksConfig(webhooks *WebhooksConfig) {
+	if webhooks == nil {
+		return
+	}
+	if webhooks.PollInterval <= 0 {
+		webhooks.PollInterval = 30 * time.Second
+	}
+	if webhooks.MaxAttempts <= 0 {
+		webhooks.MaxAttempts = 3
+	}
+	if webhooks.InitialBackoff <= 0 {
+		webhooks.InitialBackoff = 200 * time.Millisecond
+	}
+}
+
+func clampFraction(value, fallback float64) float64 {
+	if value < 0 || value > 1 {
+		return fallback
+	}
+	return value
+}
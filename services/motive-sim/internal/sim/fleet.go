@@ -0,0 +1,221 @@
+package sim
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+)
+
+const (
+	vehicleIDBase = int64(1000)
+	driverIDBase  = int64(2000)
+	trailerIDBase = int64(3000)
+
+	earthRadiusMeters = 6_371_000.0
+	metersPerMile     = 1609.344
+	cruiseSpeedMph    = 58.0
+)
+
+var (
+	firstNames = []string{
+		"Avery", "Jordan", "Morgan", "Riley", "Casey", "Quinn", "Rowan", "Skyler",
+		"Emerson", "Finley", "Hayden", "Parker",
+	}
+	lastNames = []string{
+		"Alvarez", "Brooks", "Chen", "Dawson", "Ellis", "Foster", "Garza", "Hughes",
+		"Ibarra", "Jensen", "Keller", "Lopez",
+	}
+	vehicleMakes = []struct {
+		Make  string
+		Model string
+	}{
+		{"Freightliner", "Cascadia"},
+		{"Kenworth", "T680"},
+		{"Peterbilt", "579"},
+		{"Volvo", "VNL 860"},
+		{"International", "LT625"},
+	}
+)
+
+type Vehicle struct {
+	ID       int64
+	Number   string
+	VIN      string
+	Make     string
+	Model    string
+	Year     string
+	Plate    string
+	DriverID int64
+}
+
+type Driver struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Email     string
+	Phone     string
+	Username  string
+	Cycle     string
+	VehicleID int64
+
+	// ShiftOffset staggers shift starts so the fleet is not in lockstep.
+	ShiftOffset time.Duration
+	// RestDayPhase picks which two days of every week the driver is home.
+	RestDayPhase int
+	// UsesSleeper files the overnight rest as sleeper berth instead of off duty.
+	UsesSleeper bool
+	// OverDrives makes the driver run past the 11-hour driving limit on work days.
+	OverDrives bool
+	// Outbound is the geofence the first leg of every workday departs from.
+	Outbound int
+}
+
+type Trailer struct {
+	ID     int64
+	Name   string
+	VIN    string
+	Make   string
+	Model  string
+	Year   string
+	Plate  string
+	Status string
+}
+
+// Fleet is the deterministic set of vehicles, drivers and trailers derived from the
+// configured seed. The same seed always yields the same fleet.
+type Fleet struct {
+	CompanyID  int64
+	Vehicles   []Vehicle
+	Drivers    []Driver
+	Trailers   []Trailer
+	Geofences  []config.GeofenceConfig
+	DefectRate float64
+	seed       uint64
+}
+
+func NewFleet(cfg *config.Config) *Fleet {
+	seed := hashSeed(cfg.Seed.DeterministicSeed)
+	rng := rand.New(rand.NewPCG(seed, seed>>1|1))
+
+	fleet := &Fleet{
+		CompanyID:  cfg.Seed.CompanyID,
+		Vehicles:   make([]Vehicle, 0, cfg.Simulation.FleetSize),
+		Drivers:    make([]Driver, 0, cfg.Simulation.FleetSize),
+		Trailers:   make([]Trailer, 0, cfg.Simulation.TrailerCount),
+		Geofences:  cfg.Geofences,
+		DefectRate: cfg.Simulation.DefectRate,
+		seed:       seed,
+	}
+
+	for i := range cfg.Simulation.FleetSize {
+		vehicleID := vehicleIDBase + int64(i) + 1
+		driverID := driverIDBase + int64(i) + 1
+		model := vehicleMakes[rng.IntN(len(vehicleMakes))]
+		first := firstNames[rng.IntN(len(firstNames))]
+		last := lastNames[rng.IntN(len(lastNames))]
+
+		fleet.Vehicles = append(fleet.Vehicles, Vehicle{
+			ID:       vehicleID,
+			Number:   fmt.Sprintf("MT-%03d", i+1),
+			VIN:      syntheticVIN(rng),
+			Make:     model.Make,
+			Model:    model.Model,
+			Year:     fmt.Sprintf("%d", 2019+rng.IntN(7)),
+			Plate:    fmt.Sprintf("MTV%04d", rng.IntN(10_000)),
+			DriverID: driverID,
+		})
+		fleet.Drivers = append(fleet.Drivers, Driver{
+			ID:           driverID,
+			FirstName:    first,
+			LastName:     last,
+			Email:        fmt.Sprintf("motive.driver%02d@example.com", i+1),
+			Phone:        fmt.Sprintf("+1555%07d", rng.IntN(10_000_000)),
+			Username:     fmt.Sprintf("mdriver%02d", i+1),
+			Cycle:        "70_8",
+			VehicleID:    vehicleID,
+			ShiftOffset:  time.Duration(rng.IntN(9)) * 30 * time.Minute,
+			RestDayPhase: i % 7,
+			UsesSleeper:  i%2 == 1,
+			OverDrives:   rng.Float64() < cfg.Simulation.ViolationRate,
+			Outbound:     i % len(cfg.Geofences),
+		})
+	}
+
+	for i := range cfg.Simulation.TrailerCount {
+		status := "active"
+		if i%9 == 8 {
+			status = "deactivated"
+		}
+		fleet.Trailers = append(fleet.Trailers, Trailer{
+			ID:     trailerIDBase + int64(i) + 1,
+			Name:   fmt.Sprintf("MTR-%03d", i+1),
+			VIN:    syntheticVIN(rng),
+			Make:   "Utility",
+			Model:  "4000D-X",
+			Year:   fmt.Sprintf("%d", 2016+rng.IntN(10)),
+			Plate:  fmt.Sprintf("MTR%04d", rng.IntN(10_000)),
+			Status: status,
+		})
+	}
+
+	return fleet
+}
+
+func (f *Fleet) Driver(id int64) (*Driver, bool) {
+	index := id - driverIDBase - 1
+	if index < 0 || index >= int64(len(f.Drivers)) {
+		return nil, false
+	}
+	return &f.Drivers[index], true
+}
+
+func (f *Fleet) Vehicle(id int64) (*Vehicle, bool) {
+	index := id - vehicleIDBase - 1
+	if index < 0 || index >= int64(len(f.Vehicles)) {
+		return nil, false
+	}
+	return &f.Vehicles[index], true
+}
+
+// route returns the geofences the driver shuttles between on a workday.
+func (f *Fleet) route(driver *Driver) (from, to config.GeofenceConfig) {
+	from = f.Geofences[driver.Outbound%len(f.Geofences)]
+	to = f.Geofences[(driver.Outbound+1)%len(f.Geofences)]
+	return from, to
+}
+
+func syntheticVIN(rng *rand.Rand) string {
+	const alphabet = "ABCDEFGHJKLMNPRSTUVWXYZ0123456789"
+	out := make([]byte, 17)
+	for i := range out {
+		out[i] = alphabet[rng.IntN(len(alphabet))]
+	}
+	return string(out)
+}
+
+func hashSeed(value string) uint64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(value))
+	return hasher.Sum64()
+}
+
+func distanceMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := math.Pi / 180
+	dLat := (lat2 - lat1) * toRad
+	dLon := (lon2 - lon1) * toRad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+func bearingDegrees(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := math.Pi / 180
+	y := math.Sin((lon2-lon1)*toRad) * math.Cos(lat2*toRad)
+	x := math.Cos(lat1*toRad)*math.Sin(lat2*toRad) -
+		math.Sin(lat1*toRad)*math.Cos(lat2*toRad)*math.Cos((lon2-lon1)*toRad)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
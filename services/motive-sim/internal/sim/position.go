@@ -0,0 +1,103 @@
+package sim
+
+import (
+	"time"
+
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+)
+
+// odometerEpoch anchors odometer accumulation so readings only ever grow.
+var odometerEpoch = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type Position struct {
+	Lat         float64
+	Lon         float64
+	Bearing     float64
+	SpeedMph    float64
+	OdometerMi  float64
+	EngineHours float64
+	FuelPercent float64
+	Moving      bool
+	LocatedAt   time.Time
+	Geofence    *config.GeofenceConfig
+}
+
+// legMiles is the one-way distance the driver covers on each leg.
+func (f *Fleet) legMiles(driver *Driver) float64 {
+	from, to := f.route(driver)
+	return distanceMeters(from.Lat, from.Lon, to.Lat, to.Lon) / metersPerMile
+}
+
+// position derives where the driver's vehicle is at now from the duty timeline:
+// interpolated along the current leg while driving, otherwise parked at the end of
+// the most recent leg.
+func (f *Fleet) position(driver *Driver, now time.Time) Position {
+	from, to := f.route(driver)
+	segments := driver.timeline(now.Add(-4*day), now)
+
+	position := Position{Lat: from.Lat, Lon: from.Lon, LocatedAt: now}
+	origin := from
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := segments[i]
+		if segment.Status != DutyStatusDriving {
+			continue
+		}
+		start, end := from, to
+		if segment.Leg == legReturn {
+			start, end = to, from
+		}
+		if segment.End.Equal(now) {
+			progress := float64(now.Sub(segment.FullStart)) / float64(segment.FullDuration())
+			position.Lat = start.Lat + (end.Lat-start.Lat)*progress
+			position.Lon = start.Lon + (end.Lon-start.Lon)*progress
+			position.Bearing = bearingDegrees(start.Lat, start.Lon, end.Lat, end.Lon)
+			position.SpeedMph = f.legMiles(driver) / segment.FullDuration().Hours()
+			position.Moving = true
+		} else {
+			position.Lat, position.Lon = end.Lat, end.Lon
+			origin = end
+		}
+		break
+	}
+
+	if !position.Moving {
+		position.Geofence = &origin
+	}
+	position.OdometerMi, position.EngineHours = f.odometer(driver, now)
+	position.FuelPercent = 95 - float64(int(position.OdometerMi)%700)/10
+	return position
+}
+
+// odometer totals the miles and engine hours the vehicle has accumulated since the
+// epoch, counting whole workdays plus progress through today's shift.
+func (f *Fleet) odometer(driver *Driver, now time.Time) (miles, engineHours float64) {
+	base := 180_000 + float64(driver.ID%97)*1_250
+	legMiles := f.legMiles(driver)
+	today := dayStart(now)
+
+	for cursor := odometerEpoch; cursor.Before(today); cursor = cursor.Add(day) {
+		if driver.worksOn(cursor) {
+			miles += 2 * legMiles
+			engineHours += 11
+		}
+	}
+	for _, segment := range driver.timeline(today, now) {
+		if segment.Status != DutyStatusDriving {
+			continue
+		}
+		progress := float64(segment.Duration()) / float64(segment.FullDuration())
+		miles += legMiles * progress
+		engineHours += segment.Duration().Hours()
+	}
+	return base + miles, 4_000 + engineHours
+}
+
+// geofenceAt returns the configured geofence containing the point, if any.
+func (f *Fleet) geofenceAt(lat, lon float64) (config.GeofenceConfig, bool) {
+	for _, geofence := range f.Geofences {
+		if distanceMeters(lat, lon, geofence.Lat, geofence.Lon) <= geofence.RadiusMeters {
+			return geofence, true
+		}
+	}
+	return config.GeofenceConfig{}, false
+}
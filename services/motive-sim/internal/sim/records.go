@@ -0,0 +1,362 @@
+package sim
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	timeZoneName   = "UTC"
+	violationDrive = "driving_11"
+	violationName  = "11 Hour Driving Limit"
+	certifyDelay   = 26 * time.Hour
+)
+
+var defectCategories = []string{"Tires", "Brakes", "Lights", "Coupling Devices", "Mirrors"}
+
+func (f *Fleet) driverRef(driver *Driver) *Ref {
+	return &Ref{ID: driver.ID, FirstName: driver.FirstName, LastName: driver.LastName}
+}
+
+func (f *Fleet) vehicleRef(vehicleID int64) *Ref {
+	vehicle, ok := f.Vehicle(vehicleID)
+	if !ok {
+		return nil
+	}
+	return &Ref{ID: vehicle.ID, Number: vehicle.Number}
+}
+
+func (f *Fleet) VehicleRecords() []VehicleJSON {
+	records := make([]VehicleJSON, 0, len(f.Vehicles))
+	for i := range f.Vehicles {
+		vehicle := &f.Vehicles[i]
+		record := VehicleJSON{
+			ID:                 vehicle.ID,
+			CompanyID:          f.CompanyID,
+			Number:             vehicle.Number,
+			Status:             "active",
+			Make:               vehicle.Make,
+			Model:              vehicle.Model,
+			Year:               vehicle.Year,
+			VIN:                vehicle.VIN,
+			LicensePlateState:  "TX",
+			LicensePlateNumber: vehicle.Plate,
+		}
+		if driver, ok := f.Driver(vehicle.DriverID); ok {
+			record.CurrentDriver = f.driverRef(driver)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (f *Fleet) VehicleLocationRecords(now time.Time) []VehicleLocationJSON {
+	records := make([]VehicleLocationJSON, 0, len(f.Vehicles))
+	for i := range f.Vehicles {
+		vehicle := &f.Vehicles[i]
+		record := VehicleLocationJSON{ID: vehicle.ID, Number: vehicle.Number, VIN: vehicle.VIN}
+		if driver, ok := f.Driver(vehicle.DriverID); ok {
+			position := f.position(driver, now)
+			fixType := "vehicle_stopped"
+			description := "Parked"
+			if position.Moving {
+				fixType = "vehicle_moving"
+				description = "En route"
+			}
+			if position.Geofence != nil {
+				description = position.Geofence.Name
+			}
+			record.CurrentDriver = f.driverRef(driver)
+			record.CurrentLocation = &LocationJSON{
+				ID:                             fmt.Sprintf("%d-%d", vehicle.ID, now.Unix()),
+				Lat:                            position.Lat,
+				Lon:                            position.Lon,
+				Bearing:                        position.Bearing,
+				LocatedAt:                      formatTime(now),
+				Description:                    description,
+				Speed:                          position.SpeedMph,
+				Odometer:                       &position.OdometerMi,
+				FuelPrimaryRemainingPercentage: &position.FuelPercent,
+				EngineHours:                    &position.EngineHours,
+				Type:                           fixType,
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (f *Fleet) UserRecords(now time.Time) []UserJSON {
+	records := make([]UserJSON, 0, len(f.Drivers))
+	for i := range f.Drivers {
+		driver := &f.Drivers[i]
+		records = append(records, UserJSON{
+			ID:              driver.ID,
+			FirstName:       driver.FirstName,
+			LastName:        driver.LastName,
+			Email:           driver.Email,
+			Phone:           driver.Phone,
+			Username:        driver.Username,
+			Role:            "driver",
+			Status:          "active",
+			DutyStatus:      driver.clocks(now).Status,
+			DriverCompanyID: fmt.Sprintf("MD%04d", driver.ID-driverIDBase),
+			TimeZone:        timeZoneName,
+			EldMode:         "logs",
+			Cycle:           driver.Cycle,
+			CurrentVehicle:  f.vehicleRef(driver.VehicleID),
+		})
+	}
+	return records
+}
+
+func (f *Fleet) AvailableTimeRecords(now time.Time) []AvailableTimeJSON {
+	records := make([]AvailableTimeJSON, 0, len(f.Drivers))
+	for i := range f.Drivers {
+		driver := &f.Drivers[i]
+		clocks := driver.clocks(now)
+		record := AvailableTimeJSON{
+			ID:             driver.ID,
+			FirstName:      driver.FirstName,
+			LastName:       driver.LastName,
+			DutyStatus:     clocks.Status,
+			CurrentVehicle: f.vehicleRef(driver.VehicleID),
+			AvailableTime: AvailableTimeClocksJSON{
+				Break: seconds(clocks.Break),
+				Drive: seconds(clocks.Drive),
+				Shift: seconds(clocks.Shift),
+				Cycle: seconds(clocks.Cycle),
+			},
+			Recap: &RecapJSON{
+				OnDutyDuration: seconds(clocks.OnDutyToday),
+				CycleTomorrow:  seconds(clocks.CycleTomorrow),
+			},
+		}
+		if !clocks.CycleResetTo.IsZero() {
+			record.LastCycleReset = &CycleResetJSON{
+				Type:      "34_hour",
+				StartTime: formatTime(clocks.CycleResetFrom),
+				EndTime:   formatTime(clocks.CycleResetTo),
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// LogRecords returns one log per driver per UTC day in [startDate, endDate], newest
+// day first. Days in the future are omitted and today's log ends at now.
+func (f *Fleet) LogRecords(drivers []*Driver, startDate, endDate, now time.Time) []LogJSON {
+	records := make([]LogJSON, 0, len(drivers)*8)
+	today := dayStart(now)
+	for _, driver := range drivers {
+		legMiles := f.legMiles(driver)
+		for date := dayStart(endDate); !date.Before(dayStart(startDate)); date = date.Add(-day) {
+			if date.After(today) {
+				continue
+			}
+			end := date.Add(day)
+			if end.After(now) {
+				end = now
+			}
+
+			record := LogJSON{
+				ID:        recordID(driver.ID, date, 0),
+				Date:      date.Format(dateLayout),
+				StartTime: formatTime(date),
+				EndTime:   formatTime(date.Add(day)),
+				Driver:    *f.driverRef(driver),
+				Vehicles:  []Ref{*f.vehicleRef(driver.VehicleID)},
+				Events:    []LogEventEnvelope{},
+			}
+			if certifiedAt := date.Add(certifyDelay); !certifiedAt.After(now) {
+				record.Certified = true
+				record.CertifiedAt = formatTime(certifiedAt)
+			}
+			if driver.worksOn(date) {
+				record.ShippingDocs = fmt.Sprintf("SIM-%d-%s", driver.ID, date.Format("0102"))
+			}
+
+			for index, segment := range driver.timeline(date, end) {
+				if segment.Status == DutyStatusDriving {
+					record.TotalMiles += legMiles *
+						float64(segment.Duration()) / float64(segment.FullDuration())
+				}
+				position := f.position(driver, segment.Start)
+				record.Events = append(record.Events, LogEventEnvelope{Event: LogEventJSON{
+					ID:        recordID(driver.ID, date, index+1),
+					Type:      segment.Status,
+					StartTime: formatTime(segment.Start),
+					EndTime:   formatTime(segment.End),
+					Duration:  seconds(segment.Duration()),
+					Location:  locationLabel(position),
+					Lat:       &position.Lat,
+					Lon:       &position.Lon,
+					Vehicle:   f.vehicleRef(driver.VehicleID),
+					Codrivers: []Ref{},
+				}})
+			}
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// ViolationRecords returns the 11-hour driving violations of over-driving drivers
+// whose start falls in [from, to].
+func (f *Fleet) ViolationRecords(drivers []*Driver, from, to, now time.Time) []ViolationJSON {
+	records := make([]ViolationJSON, 0)
+	if to.After(now) {
+		to = now
+	}
+	for _, driver := range drivers {
+		if !driver.OverDrives {
+			continue
+		}
+		for date := dayStart(to); !date.Before(dayStart(from).Add(-day)); date = date.Add(-day) {
+			segments := driver.workday(date)
+			if len(segments) == 0 {
+				continue
+			}
+			// The limit is crossed once the return leg has run past the outbound leg's share.
+			returnLeg := segments[3]
+			startAt := returnLeg.Start.Add(driveLimit - legLength)
+			if startAt.Before(from) || startAt.After(to) {
+				continue
+			}
+			endAt := returnLeg.End
+			if endAt.After(now) {
+				endAt = now
+			}
+			records = append(records, ViolationJSON{
+				ID:        recordID(driver.ID, date, 90),
+				Type:      violationDrive,
+				Name:      violationName,
+				StartTime: formatTime(startAt),
+				EndTime:   formatTime(endAt),
+				Duration:  seconds(endAt.Sub(startAt)),
+				LogDate:   date.Format(dateLayout),
+				User:      Ref{ID: driver.ID, FirstName: driver.FirstName, LastName: driver.LastName},
+			})
+		}
+	}
+	return records
+}
+
+// InspectionRecords returns a pre-trip and post-trip report for every workday shift
+// in [startDate, endDate] that has been signed by now. Some reports carry a defect,
+// which the next day's pre-trip marks resolved.
+func (f *Fleet) InspectionRecords(startDate, endDate, now time.Time) []InspectionReportJSON {
+	records := make([]InspectionReportJSON, 0)
+	for i := range f.Drivers {
+		driver := &f.Drivers[i]
+		for date := dayStart(endDate); !date.Before(dayStart(startDate)); date = date.Add(-day) {
+			segments := driver.workday(date)
+			if len(segments) == 0 {
+				continue
+			}
+			signed := []struct {
+				kind string
+				at   time.Time
+			}{
+				{"pre_trip", segments[0].End},
+				{"post_trip", segments[len(segments)-1].End},
+			}
+			for index, inspection := range signed {
+				if inspection.at.After(now) {
+					continue
+				}
+				position := f.position(driver, inspection.at)
+				record := InspectionReportJSON{
+					ID:             recordID(driver.ID, date, 50+index),
+					Date:           date.Format(dateLayout),
+					Time:           formatTime(inspection.at),
+					Type:           inspection.kind,
+					Status:         "safe",
+					Location:       locationLabel(position),
+					Odometer:       &position.OdometerMi,
+					DriverSignedAt: formatTime(inspection.at),
+					Driver:         f.driverRef(driver),
+					Vehicle:        f.vehicleRef(driver.VehicleID),
+					Defects:        []DefectEnvelope{},
+				}
+				if defect, ok := f.defectFor(driver, date, index, now); ok {
+					record.Status = "unsafe"
+					if defect.Status == "resolved" {
+						record.Status = "resolved"
+					}
+					record.Defects = append(record.Defects, DefectEnvelope{Defect: defect})
+				}
+				records = append(records, record)
+			}
+		}
+	}
+	return records
+}
+
+func (f *Fleet) defectFor(
+	driver *Driver,
+	date time.Time,
+	index int,
+	now time.Time,
+) (DefectJSON, bool) {
+	roll := hashSeed(fmt.Sprintf("%d:%d:%s:%d", f.seed, driver.ID, date.Format(dateLayout), index))
+	if float64(roll%1000)/1000 >= f.DefectRate {
+		return DefectJSON{}, false
+	}
+
+	defect := DefectJSON{
+		ID:       recordID(driver.ID, date, 70+index),
+		Category: defectCategories[roll%uint64(len(defectCategories))],
+		Notes:    "Reported during inspection",
+		Status:   "open",
+	}
+	if resolvedAt := dayStart(date).Add(day + shiftDayStart); !resolvedAt.After(now) {
+		defect.Status = "resolved"
+		defect.ResolvedAt = formatTime(resolvedAt)
+	}
+	return defect, true
+}
+
+func (f *Fleet) AssetRecords() []AssetJSON {
+	records := make([]AssetJSON, 0, len(f.Trailers))
+	for i := range f.Trailers {
+		trailer := &f.Trailers[i]
+		records = append(records, AssetJSON{
+			ID:                 trailer.ID,
+			Name:               trailer.Name,
+			Status:             trailer.Status,
+			Type:               "trailer",
+			Make:               trailer.Make,
+			Model:              trailer.Model,
+			Year:               trailer.Year,
+			VIN:                trailer.VIN,
+			LicensePlateState:  "TX",
+			LicensePlateNumber: trailer.Plate,
+		})
+	}
+	return records
+}
+
+func locationLabel(position Position) string {
+	if position.Geofence != nil {
+		return position.Geofence.Name
+	}
+	return fmt.Sprintf("%.4f, %.4f", position.Lat, position.Lon)
+}
+
+// recordID derives a stable numeric ID from the owner, the day and a slot so repeated
+// reads of the same record always agree.
+func recordID(ownerID int64, date time.Time, slot int) int64 {
+	dayNumber := dayStart(date).Unix() / int64(day/time.Second)
+	return ownerID*10_000_000 + dayNumber*100 + int64(slot)
+}
+
+func seconds(duration time.Duration) int64 {
+	return int64(duration / time.Second)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
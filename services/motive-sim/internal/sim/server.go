@@ -0,0 +1,400 @@
+package sim
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+)
+
+const (
+	defaultPerPage = 25
+	maxPerPage     = 100
+	maxDateRange   = 31 * day
+)
+
+var (
+	errUnauthorized   = errors.New("invalid or missing X-Api-Key")
+	errPerPageInvalid = errors.New("per_page must be between 1 and 100")
+	errPageNoInvalid  = errors.New("page_no must be a positive integer")
+	errDateInvalid    = errors.New("dates must use YYYY-MM-DD")
+	errTimeInvalid    = errors.New("times must use RFC3339")
+	errRangeInvalid   = errors.New("date range must not be reversed or exceed 31 days")
+	errDriverIDs      = errors.New("driver_ids[] must be numeric")
+)
+
+type Server struct {
+	cfg        *config.Config
+	fleet      *Fleet
+	dispatcher *Dispatcher
+	logger     *slog.Logger
+	mux        *http.ServeMux
+	now        func() time.Time
+}
+
+func NewServer(
+	cfg *config.Config,
+	fleet *Fleet,
+	dispatcher *Dispatcher,
+	logger *slog.Logger,
+) *Server {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg == nil {
+		defaultCfg := config.Default()
+		cfg = &defaultCfg
+	}
+
+	srv := &Server{
+		cfg:        cfg,
+		fleet:      fleet,
+		dispatcher: dispatcher,
+		logger:     logger,
+		mux:        http.NewServeMux(),
+		now:        time.Now,
+	}
+	srv.registerRoutes()
+	return srv
+}
+
+func (s *Server) HTTPServer() *http.Server {
+	address := net.JoinHostPort(s.cfg.Server.Host, strconv.Itoa(s.cfg.Server.Port))
+	return &http.Server{
+		Addr:              address,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+func (s *Server) Handler() http.Handler {
+	return s.withAuth(s.mux)
+}
+
+func (s *Server) registerRoutes() {
+	s.mux.HandleFunc("GET /_sim/health", s.handleHealth)
+	s.mux.HandleFunc("POST /_sim/webhooks/geofence", s.handleTriggerGeofence)
+
+	s.mux.HandleFunc("GET /v1/vehicles", s.handleVehicles)
+	s.mux.HandleFunc("GET /v1/vehicle_locations", s.handleVehicleLocations)
+	s.mux.HandleFunc("GET /v1/users", s.handleUsers)
+	s.mux.HandleFunc("GET /v1/available_time", s.handleAvailableTime)
+	s.mux.HandleFunc("GET /v1/logs", s.handleLogs)
+	s.mux.HandleFunc("GET /v1/hos_violations", s.handleViolations)
+	s.mux.HandleFunc("GET /v1/assets", s.handleAssets)
+	s.mux.HandleFunc("GET /v2/inspection_reports", s.handleInspectionReports)
+}
+
+func (s *Server) withAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/_sim/health" || s.authorized(request.Header.Get("X-Api-Key")) {
+			next.ServeHTTP(writer, request)
+			return
+		}
+		s.writeError(writer, http.StatusUnauthorized, errUnauthorized)
+	})
+}
+
+func (s *Server) authorized(key string) bool {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return false
+	}
+	for _, candidate := range s.cfg.Auth.APIKeys {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) handleHealth(writer http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(writer, http.StatusOK, map[string]any{
+		"status":    "ok",
+		"vehicles":  len(s.fleet.Vehicles),
+		"drivers":   len(s.fleet.Drivers),
+		"trailers":  len(s.fleet.Trailers),
+		"geofences": len(s.fleet.Geofences),
+	})
+}
+
+type triggerGeofenceRequest struct {
+	VehicleID  int64  `json:"vehicle_id"`
+	GeofenceID int64  `json:"geofence_id"`
+	EventType  string `json:"event_type"`
+}
+
+func (s *Server) handleTriggerGeofence(writer http.ResponseWriter, request *http.Request) {
+	body := triggerGeofenceRequest{}
+	if err := sonic.ConfigDefault.NewDecoder(request.Body).Decode(&body); err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+
+	event, err := s.dispatcher.GeofenceEvent(body.VehicleID, body.GeofenceID, body.EventType)
+	if err != nil {
+		s.writeError(writer, http.StatusUnprocessableEntity, err)
+		return
+	}
+	delivered := s.dispatcher.Deliver(request.Context(), event)
+	s.writeJSON(writer, http.StatusAccepted, map[string]any{
+		"event":     event,
+		"delivered": delivered,
+		"targets":   len(s.cfg.Webhooks.Targets),
+	})
+}
+
+func (s *Server) handleVehicles(writer http.ResponseWriter, request *http.Request) {
+	records := s.fleet.VehicleRecords()
+	s.writePage(writer, request, "vehicles", wrap(records, "vehicle"))
+}
+
+func (s *Server) handleVehicleLocations(writer http.ResponseWriter, request *http.Request) {
+	records := s.fleet.VehicleLocationRecords(s.now().UTC())
+	s.writePage(writer, request, "vehicles", wrap(records, "vehicle"))
+}
+
+func (s *Server) handleUsers(writer http.ResponseWriter, request *http.Request) {
+	records := s.fleet.UserRecords(s.now().UTC())
+	role := request.URL.Query().Get("role")
+	status := request.URL.Query().Get("status")
+	filtered := make([]UserJSON, 0, len(records))
+	for i := range records {
+		if (role == "" || records[i].Role == role) && (status == "" || records[i].Status == status) {
+			filtered = append(filtered, records[i])
+		}
+	}
+	s.writePage(writer, request, "users", wrap(filtered, "user"))
+}
+
+func (s *Server) handleAvailableTime(writer http.ResponseWriter, request *http.Request) {
+	drivers, err := s.driversFromQuery(request.URL.Query())
+	if err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	records := s.fleet.AvailableTimeRecords(s.now().UTC())
+	filtered := make([]AvailableTimeJSON, 0, len(drivers))
+	for i := range records {
+		if containsDriver(drivers, records[i].ID) {
+			filtered = append(filtered, records[i])
+		}
+	}
+	s.writePage(writer, request, "users", wrap(filtered, "user"))
+}
+
+func (s *Server) handleLogs(writer http.ResponseWriter, request *http.Request) {
+	now := s.now().UTC()
+	drivers, err := s.driversFromQuery(request.URL.Query())
+	if err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	startDate, endDate, err := dateRange(request.URL.Query(), now)
+	if err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	records := s.fleet.LogRecords(drivers, startDate, endDate, now)
+	s.writePage(writer, request, "logs", wrap(records, "log"))
+}
+
+func (s *Server) handleViolations(writer http.ResponseWriter, request *http.Request) {
+	now := s.now().UTC()
+	query := request.URL.Query()
+	drivers, err := s.driversFromQuery(query)
+	if err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+
+	from, to := now.Add(-day), now
+	if from, err = parseTimeParam(query, "min_start_time", from); err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	if to, err = parseTimeParam(query, "max_start_time", to); err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	if to.Before(from) || to.Sub(from) > maxDateRange {
+		s.writeError(writer, http.StatusBadRequest, errRangeInvalid)
+		return
+	}
+
+	records := s.fleet.ViolationRecords(drivers, from, to, now)
+	s.writePage(writer, request, "hos_violations", wrap(records, "hos_violation"))
+}
+
+func (s *Server) handleAssets(writer http.ResponseWriter, request *http.Request) {
+	records := s.fleet.AssetRecords()
+	if assetType := request.URL.Query().Get("type"); assetType != "" {
+		filtered := make([]AssetJSON, 0, len(records))
+		for i := range records {
+			if records[i].Type == assetType {
+				filtered = append(filtered, records[i])
+			}
+		}
+		records = filtered
+	}
+	s.writePage(writer, request, "assets", wrap(records, "asset"))
+}
+
+func (s *Server) handleInspectionReports(writer http.ResponseWriter, request *http.Request) {
+	now := s.now().UTC()
+	startDate, endDate, err := dateRange(request.URL.Query(), now)
+	if err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+	records := s.fleet.InspectionRecords(startDate, endDate, now)
+	s.writePage(writer, request, "inspection_reports", wrap(records, "inspection_report"))
+}
+
+// driversFromQuery resolves driver_ids[] to fleet drivers. Without the filter every
+// driver is returned; unknown IDs are ignored like Motive does.
+func (s *Server) driversFromQuery(query url.Values) ([]*Driver, error) {
+	raw := query["driver_ids[]"]
+	if len(raw) == 0 {
+		drivers := make([]*Driver, 0, len(s.fleet.Drivers))
+		for i := range s.fleet.Drivers {
+			drivers = append(drivers, &s.fleet.Drivers[i])
+		}
+		return drivers, nil
+	}
+
+	drivers := make([]*Driver, 0, len(raw))
+	for _, value := range raw {
+		id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, errDriverIDs
+		}
+		if driver, ok := s.fleet.Driver(id); ok {
+			drivers = append(drivers, driver)
+		}
+	}
+	return drivers, nil
+}
+
+func containsDriver(drivers []*Driver, id int64) bool {
+	for _, driver := range drivers {
+		if driver.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// dateRange reads start_date and end_date, defaulting both to today.
+func dateRange(query url.Values, now time.Time) (time.Time, time.Time, error) {
+	startDate, err := parseDateParam(query, "start_date", dayStart(now))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	endDate, err := parseDateParam(query, "end_date", dayStart(now))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if endDate.Before(startDate) || endDate.Sub(startDate) > maxDateRange {
+		return time.Time{}, time.Time{}, errRangeInvalid
+	}
+	return startDate, endDate, nil
+}
+
+func parseDateParam(query url.Values, name string, fallback time.Time) (time.Time, error) {
+	value := strings.TrimSpace(query.Get(name))
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errDateInvalid
+	}
+	return parsed, nil
+}
+
+func parseTimeParam(query url.Values, name string, fallback time.Time) (time.Time, error) {
+	value := strings.TrimSpace(query.Get(name))
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errTimeInvalid
+	}
+	return parsed.UTC(), nil
+}
+
+// wrap puts every record in Motive's single-key envelope.
+func wrap[T any](records []T, key string) []map[string]any {
+	out := make([]map[string]any, 0, len(records))
+	for i := range records {
+		out = append(out, map[string]any{key: records[i]})
+	}
+	return out
+}
+
+func (s *Server) writePage(
+	writer http.ResponseWriter,
+	request *http.Request,
+	key string,
+	items []map[string]any,
+) {
+	perPage, pageNo, err := pageParams(request.URL.Query())
+	if err != nil {
+		s.writeError(writer, http.StatusBadRequest, err)
+		return
+	}
+
+	start := min((pageNo-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	s.writeJSON(writer, http.StatusOK, map[string]any{
+		key: items[start:end],
+		"pagination": Pagination{
+			PerPage: perPage,
+			PageNo:  pageNo,
+			Total:   len(items),
+		},
+	})
+}
+
+func pageParams(query url.Values) (perPage, pageNo int, err error) {
+	perPage, pageNo = defaultPerPage, 1
+	if value := query.Get("per_page"); value != "" {
+		perPage, err = strconv.Atoi(value)
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			return 0, 0, errPerPageInvalid
+		}
+	}
+	if value := query.Get("page_no"); value != "" {
+		pageNo, err = strconv.Atoi(value)
+		if err != nil || pageNo < 1 {
+			return 0, 0, errPageNoInvalid
+		}
+	}
+	return perPage, pageNo, nil
+}
+
+func (s *Server) writeError(writer http.ResponseWriter, status int, err error) {
+	s.writeJSON(writer, status, map[string]any{"error_message": err.Error()})
+}
+
+func (s *Server) writeJSON(writer http.ResponseWriter, status int, payload any) {
+	body, err := sonic.Marshal(payload)
+	if err != nil {
+		s.logger.Error("failed to encode response", slog.String("error", err.Error()))
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_, _ = writer.Write(body)
+}
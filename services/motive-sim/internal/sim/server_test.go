@@ -0,0 +1,113 @@
+package sim
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	cfg := config.Default()
+	fleet := NewFleet(&cfg)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	srv := NewServer(&cfg, fleet, NewDispatcher(cfg.Webhooks, fleet, logger), logger)
+	srv.now = func() time.Time {
+		return time.Date(2026, time.October, 7, 12, 0, 0, 0, time.UTC)
+	}
+
+	server := httptest.NewServer(srv.Handler())
+	t.Cleanup(server.Close)
+	return server
+}
+
+func get(t *testing.T, server *httptest.Server, path, key string) (int, map[string]any) {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+path, nil)
+	require.NoError(t, err)
+	if key != "" {
+		request.Header.Set("X-Api-Key", key)
+	}
+	response, err := server.Client().Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	body := map[string]any{}
+	require.NoError(t, sonic.ConfigDefault.NewDecoder(response.Body).Decode(&body))
+	return response.StatusCode, body
+}
+
+func TestServerRequiresAPIKey(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+
+	status, body := get(t, server, "/v1/vehicles", "")
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.NotEmpty(t, body["error_message"])
+
+	status, _ = get(t, server, "/v1/vehicles", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status, _ = get(t, server, "/_sim/health", "")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestServerPaginates(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+
+	status, body := get(t, server, "/v1/vehicles?per_page=3&page_no=3", "dev-motive-key")
+	require.Equal(t, http.StatusOK, status)
+
+	vehicles, ok := body["vehicles"].([]any)
+	require.True(t, ok)
+	assert.Len(t, vehicles, 2)
+	first, ok := vehicles[0].(map[string]any)["vehicle"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "MT-007", first["number"])
+
+	pagination, ok := body["pagination"].(map[string]any)
+	require.True(t, ok)
+	assert.InDelta(t, 8, pagination["total"], 0)
+
+	status, _ = get(t, server, "/v1/vehicles?per_page=101", "dev-motive-key")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestServerFiltersLogsByDriver(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+
+	status, body := get(
+		t,
+		server,
+		"/v1/logs?driver_ids[]=2001&start_date=2026-10-01&end_date=2026-10-07",
+		"dev-motive-key",
+	)
+	require.Equal(t, http.StatusOK, status)
+
+	logs, ok := body["logs"].([]any)
+	require.True(t, ok)
+	assert.Len(t, logs, 7)
+	for _, entry := range logs {
+		log, isMap := entry.(map[string]any)["log"].(map[string]any)
+		require.True(t, isMap)
+		assert.InDelta(t, 2001, log["driver"].(map[string]any)["id"], 0)
+	}
+
+	status, _ = get(t, server, "/v1/logs?start_date=2026-10-07&end_date=2026-10-01", "dev-motive-key")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
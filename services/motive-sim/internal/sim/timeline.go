@@ -0,0 +1,273 @@
+package sim
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	DutyStatusOffDuty = "off_duty"
+	DutyStatusSleeper = "sleeper"
+	DutyStatusDriving = "driving"
+	DutyStatusOnDuty  = "on_duty"
+
+	day              = 24 * time.Hour
+	shiftDayStart    = 6 * time.Hour
+	inspectionLength = 30 * time.Minute
+	legLength        = 5 * time.Hour
+	overDriveExtra   = 75 * time.Minute
+	restBreakLength  = 30 * time.Minute
+
+	// Limits mirror FMCSA property-carrying rules under a 70/8 cycle.
+	driveLimit  = 11 * time.Hour
+	shiftLimit  = 14 * time.Hour
+	breakLimit  = 8 * time.Hour
+	cycleLimit  = 70 * time.Hour
+	shiftReset  = 10 * time.Hour
+	cycleReset  = 34 * time.Hour
+	cycleWindow = 8 * day
+)
+
+type legDirection int
+
+const (
+	legNone legDirection = iota
+	legOutbound
+	legReturn
+)
+
+// dutySegment is one contiguous duty status. Start and End are the clipped bounds a
+// caller asked for; FullStart and FullEnd are the unclipped bounds, which rest rules
+// and leg progress are measured against.
+type dutySegment struct {
+	Status    string
+	Start     time.Time
+	End       time.Time
+	FullStart time.Time
+	FullEnd   time.Time
+	Leg       legDirection
+}
+
+func (s dutySegment) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+func (s dutySegment) FullDuration() time.Duration {
+	return s.FullEnd.Sub(s.FullStart)
+}
+
+func (s dutySegment) isRest() bool {
+	return s.Status == DutyStatusOffDuty || s.Status == DutyStatusSleeper
+}
+
+func (s dutySegment) isOnDuty() bool {
+	return s.Status == DutyStatusDriving || s.Status == DutyStatusOnDuty
+}
+
+func dayStart(t time.Time) time.Time {
+	return t.UTC().Truncate(day)
+}
+
+// worksOn reports whether the driver works on the UTC day containing t. Every driver
+// works five days and rests two, with the rest days staggered across the fleet.
+func (d *Driver) worksOn(t time.Time) bool {
+	dayNumber := int(dayStart(t).Unix() / int64(day/time.Second))
+	return (dayNumber+d.RestDayPhase)%7 < 5
+}
+
+// workday returns the on-duty and driving segments for the driver's shift on the
+// UTC day containing t, or nil on a rest day. A shift is a pre-trip inspection, an
+// outbound leg, a rest break, a return leg and a post-trip inspection.
+func (d *Driver) workday(t time.Time) []dutySegment {
+	if !d.worksOn(t) {
+		return nil
+	}
+
+	returnLeg := legLength
+	if d.OverDrives {
+		returnLeg += overDriveExtra
+	}
+
+	start := dayStart(t).Add(shiftDayStart + d.ShiftOffset)
+	steps := []struct {
+		status string
+		length time.Duration
+		leg    legDirection
+	}{
+		{DutyStatusOnDuty, inspectionLength, legNone},
+		{DutyStatusDriving, legLength, legOutbound},
+		{DutyStatusOffDuty, restBreakLength, legNone},
+		{DutyStatusDriving, returnLeg, legReturn},
+		{DutyStatusOnDuty, inspectionLength, legNone},
+	}
+
+	segments := make([]dutySegment, 0, len(steps))
+	cursor := start
+	for _, step := range steps {
+		end := cursor.Add(step.length)
+		segments = append(segments, dutySegment{
+			Status:    step.status,
+			Start:     cursor,
+			End:       end,
+			FullStart: cursor,
+			FullEnd:   end,
+			Leg:       step.leg,
+		})
+		cursor = end
+	}
+	return segments
+}
+
+// timeline returns the driver's contiguous duty history clipped to [from, to). Gaps
+// between shifts are rest; overnight rest is sleeper berth for sleeper drivers and
+// multi-day rest is always off duty.
+func (d *Driver) timeline(from, to time.Time) []dutySegment {
+	if !to.After(from) {
+		return nil
+	}
+
+	// Extend the window so rest periods at the edges keep their full length.
+	windowStart := dayStart(from).Add(-3 * day)
+	windowEnd := dayStart(to).Add(3 * day)
+
+	work := make([]dutySegment, 0, 32)
+	for cursor := windowStart; cursor.Before(windowEnd); cursor = cursor.Add(day) {
+		work = append(work, d.workday(cursor)...)
+	}
+	sort.Slice(work, func(i, j int) bool { return work[i].Start.Before(work[j].Start) })
+
+	full := make([]dutySegment, 0, len(work)*2)
+	cursor := windowStart
+	for _, segment := range work {
+		if segment.Start.After(cursor) {
+			full = append(full, d.restSegment(cursor, segment.Start))
+		}
+		full = append(full, segment)
+		cursor = segment.End
+	}
+	if windowEnd.After(cursor) {
+		full = append(full, d.restSegment(cursor, windowEnd))
+	}
+
+	clipped := make([]dutySegment, 0, len(full))
+	for _, segment := range full {
+		if !segment.End.After(from) || !segment.Start.Before(to) {
+			continue
+		}
+		if segment.Start.Before(from) {
+			segment.Start = from
+		}
+		if segment.End.After(to) {
+			segment.End = to
+		}
+		clipped = append(clipped, segment)
+	}
+	return clipped
+}
+
+func (d *Driver) restSegment(start, end time.Time) dutySegment {
+	status := DutyStatusOffDuty
+	if d.UsesSleeper && end.Sub(start) < day {
+		status = DutyStatusSleeper
+	}
+	return dutySegment{Status: status, Start: start, End: end, FullStart: start, FullEnd: end}
+}
+
+// Clocks are the driver's remaining hours-of-service at a point in time. Values go
+// negative once a limit is exceeded, as Motive reports them.
+type Clocks struct {
+	Status         string
+	Break          time.Duration
+	Drive          time.Duration
+	Shift          time.Duration
+	Cycle          time.Duration
+	CycleTomorrow  time.Duration
+	OnDutyToday    time.Duration
+	CycleResetFrom time.Time
+	CycleResetTo   time.Time
+}
+
+func (d *Driver) clocks(now time.Time) Clocks {
+	segments := d.timeline(now.Add(-cycleWindow), now)
+	clocks := Clocks{
+		Status: DutyStatusOffDuty,
+		Break:  breakLimit,
+		Drive:  driveLimit,
+		Shift:  shiftLimit,
+		Cycle:  cycleLimit,
+	}
+	if len(segments) == 0 {
+		clocks.CycleTomorrow = cycleLimit
+		return clocks
+	}
+	clocks.Status = segments[len(segments)-1].Status
+
+	shiftStart, shiftOpen := time.Time{}, false
+	cycleFrom := now.Add(-cycleWindow)
+	breakFrom := now.Add(-cycleWindow)
+	for _, segment := range segments {
+		observed := segment.End.Sub(segment.FullStart)
+		switch {
+		case segment.isRest() && observed >= cycleReset:
+			cycleFrom = segment.End
+			clocks.CycleResetFrom = segment.FullStart
+			clocks.CycleResetTo = segment.End
+			shiftOpen = false
+		case segment.isRest() && observed >= shiftReset:
+			shiftOpen = false
+		case segment.isOnDuty() && !shiftOpen:
+			shiftStart, shiftOpen = segment.Start, true
+		}
+		if !segment.isOnDuty() && observed >= restBreakLength {
+			breakFrom = segment.End
+		}
+	}
+
+	// Hours worked before today minus seven days no longer count at the start of tomorrow.
+	tomorrowFrom := dayStart(now).Add(day - cycleWindow)
+	driveUsed, cycleUsed, cycleUsedTomorrow := time.Duration(0), time.Duration(0), time.Duration(0)
+	breakUsed := time.Duration(0)
+	today := dayStart(now)
+	for _, segment := range segments {
+		if !segment.isOnDuty() {
+			continue
+		}
+		if shiftOpen && !segment.Start.Before(shiftStart) && segment.Status == DutyStatusDriving {
+			driveUsed += segment.Duration()
+		}
+		if !segment.Start.Before(breakFrom) && segment.Status == DutyStatusDriving {
+			breakUsed += segment.Duration()
+		}
+		cycleUsed += overlap(segment, cycleFrom, now)
+		cycleUsedTomorrow += overlap(segment, laterOf(cycleFrom, tomorrowFrom), now)
+		clocks.OnDutyToday += overlap(segment, today, now)
+	}
+
+	if shiftOpen {
+		clocks.Shift = shiftLimit - now.Sub(shiftStart)
+		clocks.Drive = driveLimit - driveUsed
+		clocks.Break = breakLimit - breakUsed
+	}
+	clocks.Cycle = cycleLimit - cycleUsed
+	clocks.CycleTomorrow = cycleLimit - cycleUsedTomorrow
+	return clocks
+}
+
+func overlap(segment dutySegment, from, to time.Time) time.Duration {
+	start := laterOf(segment.Start, from)
+	end := segment.End
+	if to.Before(end) {
+		end = to
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
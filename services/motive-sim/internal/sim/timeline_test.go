@@ -0,0 +1,154 @@
+package sim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFleet(t *testing.T) *Fleet {
+	t.Helper()
+
+	cfg := config.Default()
+	return NewFleet(&cfg)
+}
+
+// workingDriver returns a driver who works on the day containing at.
+func workingDriver(t *testing.T, fleet *Fleet, at time.Time, overDrives bool) *Driver {
+	t.Helper()
+
+	for i := range fleet.Drivers {
+		driver := fleet.Drivers[i]
+		driver.OverDrives = overDrives
+		if driver.worksOn(at) {
+			return &driver
+		}
+	}
+	t.Fatal("no working driver found")
+	return nil
+}
+
+func TestNewFleetIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	first := testFleet(t)
+	second := testFleet(t)
+
+	require.Len(t, first.Vehicles, 8)
+	require.Len(t, first.Trailers, 10)
+	assert.Equal(t, first.Vehicles, second.Vehicles)
+	assert.Equal(t, first.Drivers, second.Drivers)
+	for i := range first.Drivers {
+		assert.Equal(t, first.Drivers[i].VehicleID, first.Vehicles[i].ID)
+		assert.Equal(t, first.Vehicles[i].DriverID, first.Drivers[i].ID)
+	}
+}
+
+func TestTimelineIsContiguous(t *testing.T) {
+	t.Parallel()
+
+	fleet := testFleet(t)
+	from := time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)
+	to := from.Add(3 * day)
+
+	for i := range fleet.Drivers {
+		segments := fleet.Drivers[i].timeline(from, to)
+		require.NotEmpty(t, segments)
+		assert.Equal(t, from, segments[0].Start)
+		assert.Equal(t, to, segments[len(segments)-1].End)
+		for j := 1; j < len(segments); j++ {
+			assert.Equal(t, segments[j-1].End, segments[j].Start)
+		}
+	}
+}
+
+func TestClocksMidShift(t *testing.T) {
+	t.Parallel()
+
+	fleet := testFleet(t)
+	date := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+	driver := workingDriver(t, fleet, date, false)
+	shiftStart := date.Add(shiftDayStart + driver.ShiftOffset)
+
+	// Two hours into the outbound leg.
+	clocks := driver.clocks(shiftStart.Add(inspectionLength + 2*time.Hour))
+
+	assert.Equal(t, DutyStatusDriving, clocks.Status)
+	assert.Equal(t, 9*time.Hour, clocks.Drive)
+	assert.Equal(t, shiftLimit-(inspectionLength+2*time.Hour), clocks.Shift)
+	assert.Equal(t, 6*time.Hour, clocks.Break)
+	assert.Equal(t, inspectionLength+2*time.Hour, clocks.OnDutyToday)
+	assert.Less(t, clocks.Cycle, cycleLimit)
+}
+
+func TestClocksGoNegativeWhenOverDriving(t *testing.T) {
+	t.Parallel()
+
+	fleet := testFleet(t)
+	date := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+	driver := workingDriver(t, fleet, date, true)
+	returnLeg := driver.workday(date)[3]
+
+	clocks := driver.clocks(returnLeg.End)
+	assert.Equal(t, -(overDriveExtra - time.Hour), clocks.Drive)
+
+	violations := fleet.ViolationRecords(
+		[]*Driver{driver},
+		date,
+		date.Add(day),
+		date.Add(day),
+	)
+	require.Len(t, violations, 1)
+	assert.Equal(t, int64((overDriveExtra - time.Hour).Seconds()), violations[0].Duration)
+	assert.Equal(t, date.Format(dateLayout), violations[0].LogDate)
+}
+
+func TestLogRecordsCoverTheDay(t *testing.T) {
+	t.Parallel()
+
+	fleet := testFleet(t)
+	date := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+	driver := workingDriver(t, fleet, date, false)
+
+	logs := fleet.LogRecords([]*Driver{driver}, date, date, date.Add(3*day))
+	require.Len(t, logs, 1)
+
+	total := int64(0)
+	driving := int64(0)
+	for _, envelope := range logs[0].Events {
+		total += envelope.Event.Duration
+		if envelope.Event.Type == DutyStatusDriving {
+			driving += envelope.Event.Duration
+		}
+	}
+	assert.Equal(t, int64(day.Seconds()), total)
+	assert.Equal(t, int64((2 * legLength).Seconds()), driving)
+	assert.InDelta(t, 2*fleet.legMiles(driver), logs[0].TotalMiles, 0.01)
+	assert.True(t, logs[0].Certified)
+}
+
+func TestPositionFollowsLegs(t *testing.T) {
+	t.Parallel()
+
+	fleet := testFleet(t)
+	date := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+	driver := workingDriver(t, fleet, date, false)
+	from, to := fleet.route(driver)
+	segments := driver.workday(date)
+
+	parked := fleet.position(driver, segments[0].Start)
+	assert.False(t, parked.Moving)
+	assert.Equal(t, from.Name, parked.Geofence.Name)
+
+	moving := fleet.position(driver, segments[1].Start.Add(legLength/2))
+	assert.True(t, moving.Moving)
+	assert.InDelta(t, (from.Lat+to.Lat)/2, moving.Lat, 0.0001)
+
+	atBreak := fleet.position(driver, segments[2].Start.Add(time.Minute))
+	assert.Equal(t, to.Name, atBreak.Geofence.Name)
+
+	assert.Greater(t, fleet.position(driver, segments[4].End).OdometerMi, parked.OdometerMi)
+}
@@ -0,0 +1,203 @@
+package sim
+
+// Wire types mirror the Motive API shapes TMS consumes. Motive wraps every list item
+// in a single-key envelope and pages with per_page/page_no.
+
+type Pagination struct {
+	PerPage int `json:"per_page"`
+	PageNo  int `json:"page_no"`
+	Total   int `json:"total"`
+}
+
+type Ref struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Number    string `json:"number,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+type VehicleJSON struct {
+	ID                 int64  `json:"id"`
+	CompanyID          int64  `json:"company_id"`
+	Number             string `json:"number"`
+	Status             string `json:"status"`
+	Make               string `json:"make"`
+	Model              string `json:"model"`
+	Year               string `json:"year"`
+	VIN                string `json:"vin"`
+	LicensePlateState  string `json:"license_plate_state"`
+	LicensePlateNumber string `json:"license_plate_number"`
+	CurrentDriver      *Ref   `json:"current_driver"`
+}
+
+type LocationJSON struct {
+	ID                             string   `json:"id"`
+	Lat                            float64  `json:"lat"`
+	Lon                            float64  `json:"lon"`
+	Bearing                        float64  `json:"bearing"`
+	LocatedAt                      string   `json:"located_at"`
+	Description                    string   `json:"description"`
+	Speed                          float64  `json:"speed"`
+	Odometer                       *float64 `json:"odometer"`
+	FuelPrimaryRemainingPercentage *float64 `json:"fuel_primary_remaining_percentage"`
+	EngineHours                    *float64 `json:"engine_hours"`
+	Type                           string   `json:"type"`
+}
+
+type VehicleLocationJSON struct {
+	ID              int64         `json:"id"`
+	Number          string        `json:"number"`
+	VIN             string        `json:"vin"`
+	CurrentLocation *LocationJSON `json:"current_location"`
+	CurrentDriver   *Ref          `json:"current_driver"`
+}
+
+type UserJSON struct {
+	ID                     int64  `json:"id"`
+	FirstName              string `json:"first_name"`
+	LastName               string `json:"last_name"`
+	Email                  string `json:"email"`
+	Phone                  string `json:"phone"`
+	Username               string `json:"username"`
+	Role                   string `json:"role"`
+	Status                 string `json:"status"`
+	DutyStatus             string `json:"duty_status"`
+	DriverCompanyID        string `json:"driver_company_id"`
+	TimeZone               string `json:"time_zone"`
+	EldMode                string `json:"eld_mode"`
+	Cycle                  string `json:"cycle"`
+	Exception24HourRestart bool   `json:"exception_24_hour_restart"`
+	Exception8HourBreak    bool   `json:"exception_8_hour_break"`
+	ExceptionShortHaul     bool   `json:"exception_short_haul"`
+	CurrentVehicle         *Ref   `json:"current_vehicle"`
+}
+
+type AvailableTimeClocksJSON struct {
+	Break int64 `json:"break"`
+	Drive int64 `json:"drive"`
+	Shift int64 `json:"shift"`
+	Cycle int64 `json:"cycle"`
+}
+
+type RecapJSON struct {
+	OnDutyDuration int64 `json:"on_duty_duration"`
+	CycleTomorrow  int64 `json:"cycle_tomorrow"`
+}
+
+type CycleResetJSON struct {
+	Type      string `json:"type"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+type AvailableTimeJSON struct {
+	ID             int64                   `json:"id"`
+	FirstName      string                  `json:"first_name"`
+	LastName       string                  `json:"last_name"`
+	DutyStatus     string                  `json:"duty_status"`
+	CurrentVehicle *Ref                    `json:"current_vehicle"`
+	AvailableTime  AvailableTimeClocksJSON `json:"available_time"`
+	Recap          *RecapJSON              `json:"recap"`
+	LastCycleReset *CycleResetJSON         `json:"last_cycle_reset"`
+}
+
+type LogEventJSON struct {
+	ID        int64    `json:"id"`
+	Type      string   `json:"type"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	Duration  int64    `json:"duration"`
+	Location  string   `json:"location"`
+	Lat       *float64 `json:"lat"`
+	Lon       *float64 `json:"lon"`
+	Notes     string   `json:"notes"`
+	Vehicle   *Ref     `json:"vehicle"`
+	Codrivers []Ref    `json:"codrivers"`
+}
+
+type LogEventEnvelope struct {
+	Event LogEventJSON `json:"event"`
+}
+
+type LogJSON struct {
+	ID           int64              `json:"id"`
+	Date         string             `json:"date"`
+	StartTime    string             `json:"start_time"`
+	EndTime      string             `json:"end_time"`
+	Driver       Ref                `json:"driver"`
+	TotalMiles   float64            `json:"total_miles"`
+	Certified    bool               `json:"certified"`
+	CertifiedAt  string             `json:"certified_at"`
+	ShippingDocs string             `json:"shipping_docs"`
+	Vehicles     []Ref              `json:"vehicles"`
+	Events       []LogEventEnvelope `json:"events"`
+}
+
+type ViolationJSON struct {
+	ID        int64  `json:"id"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Duration  int64  `json:"duration"`
+	LogDate   string `json:"log_date"`
+	User      Ref    `json:"user"`
+}
+
+type AssetJSON struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Make               string `json:"make"`
+	Model              string `json:"model"`
+	Year               string `json:"year"`
+	VIN                string `json:"vin"`
+	LicensePlateState  string `json:"license_plate_state"`
+	LicensePlateNumber string `json:"license_plate_number"`
+}
+
+type DefectJSON struct {
+	ID         int64  `json:"id"`
+	Category   string `json:"category"`
+	Notes      string `json:"notes"`
+	Status     string `json:"status"`
+	ResolvedAt string `json:"resolved_at"`
+}
+
+type DefectEnvelope struct {
+	Defect DefectJSON `json:"defect"`
+}
+
+type InspectionReportJSON struct {
+	ID             int64            `json:"id"`
+	Date           string           `json:"date"`
+	Time           string           `json:"time"`
+	Type           string           `json:"type"`
+	Status         string           `json:"status"`
+	Location       string           `json:"location"`
+	Odometer       *float64         `json:"odometer"`
+	DriverSignedAt string           `json:"driver_signed_at"`
+	Driver         *Ref             `json:"driver"`
+	Vehicle        *Ref             `json:"vehicle"`
+	Asset          *Ref             `json:"asset"`
+	Defects        []DefectEnvelope `json:"defects"`
+}
+
+// WebhookEvent is the flat payload Motive posts for every webhook action.
+type WebhookEvent struct {
+	Action             string   `json:"action"`
+	ID                 int64    `json:"id"`
+	EventType          string   `json:"event_type,omitempty"`
+	GeofenceID         int64    `json:"geofence_id,omitempty"`
+	GeofenceName       string   `json:"geofence_name,omitempty"`
+	GeofenceExternalID string   `json:"geofence_external_id,omitempty"`
+	VehicleID          int64    `json:"vehicle_id,omitempty"`
+	VehicleNumber      string   `json:"vehicle_number,omitempty"`
+	VIN                string   `json:"vin,omitempty"`
+	DriverID           int64    `json:"driver_id,omitempty"`
+	Lat                *float64 `json:"lat,omitempty"`
+	Lon                *float64 `json:"lon,omitempty"`
+	StartTime          string   `json:"start_time,omitempty"`
+}
@@ -0,0 +1,277 @@
+package sim
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // Motive signs webhooks with HMAC-SHA1.
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+)
+
+const (
+	HeaderSignature = "X-KT-Webhook-Signature"
+
+	actionGeofenceEvent = "vehicle_geofence_event"
+	geofenceEnter       = "enter"
+	geofenceExit        = "exit"
+)
+
+// Dispatcher watches the fleet for geofence transitions and posts signed Motive
+// webhooks to every configured target.
+type Dispatcher struct {
+	cfg    config.WebhooksConfig
+	fleet  *Fleet
+	logger *slog.Logger
+	client *http.Client
+	now    func() time.Time
+
+	mu     sync.Mutex
+	inside map[int64]int64
+	seq    int64
+
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+func NewDispatcher(cfg config.WebhooksConfig, fleet *Fleet, logger *slog.Logger) *Dispatcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Dispatcher{
+		cfg:    cfg,
+		fleet:  fleet,
+		logger: logger,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+		inside: map[int64]int64{},
+	}
+}
+
+// Start begins polling for geofence transitions. The first poll only records where
+// each vehicle is so a restart does not replay entries for parked vehicles.
+func (d *Dispatcher) Start() {
+	if !d.cfg.Enabled || len(d.cfg.Targets) == 0 {
+		d.logger.Info("webhook delivery disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.stop = cancel
+	d.Poll()
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(d.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, event := range d.Poll() {
+					d.Deliver(ctx, event)
+				}
+			}
+		}
+	}()
+}
+
+func (d *Dispatcher) Shutdown() {
+	if d.stop != nil {
+		d.stop()
+	}
+	d.wg.Wait()
+}
+
+// Poll compares each vehicle's current geofence with the last one seen and returns
+// an exit and/or entry event for every change.
+func (d *Dispatcher) Poll() []WebhookEvent {
+	now := d.now().UTC()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	events := make([]WebhookEvent, 0)
+	for i := range d.fleet.Vehicles {
+		vehicle := &d.fleet.Vehicles[i]
+		driver, ok := d.fleet.Driver(vehicle.DriverID)
+		if !ok {
+			continue
+		}
+		position := d.fleet.position(driver, now)
+		current := int64(0)
+		if geofence, found := d.fleet.geofenceAt(position.Lat, position.Lon); found {
+			current = geofence.ID
+		}
+
+		previous, seen := d.inside[vehicle.ID]
+		d.inside[vehicle.ID] = current
+		if !seen || previous == current {
+			continue
+		}
+		if previous != 0 {
+			events = append(events, d.geofenceEvent(vehicle, previous, geofenceExit, position, now))
+		}
+		if current != 0 {
+			events = append(events, d.geofenceEvent(vehicle, current, geofenceEnter, position, now))
+		}
+	}
+	return events
+}
+
+// GeofenceEvent builds an event for an explicit transition, used by the admin
+// endpoint to trigger deliveries on demand.
+func (d *Dispatcher) GeofenceEvent(
+	vehicleID, geofenceID int64,
+	eventType string,
+) (WebhookEvent, error) {
+	vehicle, ok := d.fleet.Vehicle(vehicleID)
+	if !ok {
+		return WebhookEvent{}, fmt.Errorf("vehicle %d not found", vehicleID)
+	}
+	if _, ok = d.geofence(geofenceID); !ok {
+		return WebhookEvent{}, fmt.Errorf("geofence %d not found", geofenceID)
+	}
+	if eventType != geofenceEnter && eventType != geofenceExit {
+		return WebhookEvent{}, fmt.Errorf("event type must be %q or %q", geofenceEnter, geofenceExit)
+	}
+
+	now := d.now().UTC()
+	position := Position{}
+	if driver, found := d.fleet.Driver(vehicle.DriverID); found {
+		position = d.fleet.position(driver, now)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.geofenceEvent(vehicle, geofenceID, eventType, position, now), nil
+}
+
+func (d *Dispatcher) geofence(id int64) (config.GeofenceConfig, bool) {
+	for _, geofence := range d.fleet.Geofences {
+		if geofence.ID == id {
+			return geofence, true
+		}
+	}
+	return config.GeofenceConfig{}, false
+}
+
+// geofenceEvent must be called with d.mu held.
+func (d *Dispatcher) geofenceEvent(
+	vehicle *Vehicle,
+	geofenceID int64,
+	eventType string,
+	position Position,
+	now time.Time,
+) WebhookEvent {
+	d.seq++
+	geofence, _ := d.geofence(geofenceID)
+	lat, lon := position.Lat, position.Lon
+	return WebhookEvent{
+		Action:             actionGeofenceEvent,
+		ID:                 now.Unix()*1000 + d.seq%1000,
+		EventType:          eventType,
+		GeofenceID:         geofence.ID,
+		GeofenceName:       geofence.Name,
+		GeofenceExternalID: geofence.ExternalID,
+		VehicleID:          vehicle.ID,
+		VehicleNumber:      vehicle.Number,
+		VIN:                vehicle.VIN,
+		DriverID:           vehicle.DriverID,
+		Lat:                &lat,
+		Lon:                &lon,
+		StartTime:          formatTime(now),
+	}
+}
+
+// Deliver posts the event to every target, retrying each with exponential backoff.
+// It returns how many targets accepted the event.
+func (d *Dispatcher) Deliver(ctx context.Context, event WebhookEvent) int {
+	body, err := sonic.Marshal(event)
+	if err != nil {
+		d.logger.Error("failed to encode webhook", slog.String("error", err.Error()))
+		return 0
+	}
+	signature := Sign(d.cfg.SigningSecret, body)
+
+	delivered := 0
+	for _, target := range d.cfg.Targets {
+		if d.deliverTo(ctx, target, body, signature) {
+			delivered++
+		}
+	}
+	return delivered
+}
+
+func (d *Dispatcher) deliverTo(ctx context.Context, target string, body []byte, signature string) bool {
+	backoff := d.cfg.InitialBackoff
+	for attempt := 1; attempt <= d.cfg.MaxAttempts; attempt++ {
+		status, err := d.post(ctx, target, body, signature)
+		if err == nil && status >= 200 && status < 300 {
+			return true
+		}
+
+		attrs := []any{
+			slog.String("target", target),
+			slog.Int("attempt", attempt),
+			slog.Int("status", status),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+		d.logger.Warn("webhook delivery failed", attrs...)
+
+		// Client errors other than throttling will not succeed on retry.
+		if status >= 400 && status < 500 && status != http.StatusTooManyRequests {
+			return false
+		}
+		if attempt == d.cfg.MaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return false
+}
+
+func (d *Dispatcher) post(
+	ctx context.Context,
+	target string,
+	body []byte,
+	signature string,
+) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderSignature, signature)
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	return response.StatusCode, nil
+}
+
+// Sign returns the hex HMAC-SHA1 of body, the form Motive sends in
+// X-KT-Webhook-Signature.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,104 @@
+package sim
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/motive-sim/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignMatchesKnownDigest(t *testing.T) {
+	t.Parallel()
+
+	// HMAC-SHA1("key", "The quick brown fox jumps over the lazy dog").
+	assert.Equal(
+		t,
+		"de7c9b85b8b78aa6bc8a7a36f70a90701c9db4d9",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")),
+	)
+}
+
+func TestPollEmitsGeofenceTransitions(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Default()
+	fleet := NewFleet(&cfg)
+	dispatcher := NewDispatcher(cfg.Webhooks, fleet, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	date := time.Date(2026, time.October, 7, 0, 0, 0, 0, time.UTC)
+	driver := workingDriver(t, fleet, date, false)
+	// Keep only the chosen vehicle so other vehicles' transitions do not interfere.
+	vehicle, _ := fleet.Vehicle(driver.VehicleID)
+	fleet.Vehicles = []Vehicle{*vehicle}
+	segments := driver.workday(date)
+
+	dispatcher.now = func() time.Time { return segments[1].Start }
+	assert.Empty(t, dispatcher.Poll(), "the first poll only records state")
+
+	dispatcher.now = func() time.Time { return segments[1].Start.Add(time.Hour) }
+	events := dispatcher.Poll()
+	require.Len(t, events, 1)
+	assert.Equal(t, geofenceExit, events[0].EventType)
+
+	dispatcher.now = func() time.Time { return segments[2].Start.Add(time.Minute) }
+	events = dispatcher.Poll()
+	require.Len(t, events, 1)
+	assert.Equal(t, geofenceEnter, events[0].EventType)
+	assert.Equal(t, driver.ID, events[0].DriverID)
+	assert.Equal(t, vehicle.ID, events[0].VehicleID)
+}
+
+func TestDeliverSignsAndRetries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		attempts int
+		received []byte
+		header   string
+	)
+	target := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received, _ = io.ReadAll(request.Body)
+		header = request.Header.Get(HeaderSignature)
+		writer.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(target.Close)
+
+	cfg := config.Default()
+	cfg.Webhooks.Targets = []string{target.URL}
+	cfg.Webhooks.InitialBackoff = time.Millisecond
+	fleet := NewFleet(&cfg)
+	dispatcher := NewDispatcher(cfg.Webhooks, fleet, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	event, err := dispatcher.GeofenceEvent(fleet.Vehicles[0].ID, cfg.Geofences[0].ID, geofenceEnter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dispatcher.Deliver(t.Context(), event))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, Sign(cfg.Webhooks.SigningSecret, received), header)
+
+	decoded := WebhookEvent{}
+	require.NoError(t, sonic.Unmarshal(received, &decoded))
+	assert.Equal(t, actionGeofenceEvent, decoded.Action)
+	assert.Equal(t, cfg.Geofences[0].Name, decoded.GeofenceName)
+
+	_, err = dispatcher.GeofenceEvent(fleet.Vehicles[0].ID, 9999, geofenceEnter)
+	require.Error(t, err)
+}
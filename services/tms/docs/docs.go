@@ -34647,13 +34647,19 @@ const docTemplate = `{
                 "Internal",
                 "AS2",
                 "SFTP",
-                "VAN"
+                "VAN",
+                "HTTPS",
+                "FTPS",
+                "S3"
             ],
             "x-enum-varnames": [
                 "ConnectionMethodInternal",
                 "ConnectionMethodAS2",
                 "ConnectionMethodSFTP",
-                "ConnectionMethodVAN"
+                "ConnectionMethodVAN",
+                "ConnectionMethodHTTPS",
+                "ConnectionMethodFTPS",
+                "ConnectionMethodS3"
             ]
         },
         "github_com_emoss08_trenova_internal_core_domain_edi.ConnectionPartnerConfig": {
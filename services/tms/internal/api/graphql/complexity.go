@@ -28,10 +28,26 @@ func complexityRoot() generated.ComplexityRoot {
 	) int {
 		return listComplexity(childComplexity, input.First)
 	}
+	root.Subscription.ShipmentChanged = func(childComplexity int, shipmentID *string) int {
+		return subscriptionComplexity(childComplexity, shipmentID)
+	}
+	root.Subscription.TenderResponses = func(childComplexity int, shipmentID *string) int {
+		return subscriptionComplexity(childComplexity, shipmentID)
+	}
 
 	return root
 }
 
+// subscriptionComplexity charges a tenant-wide subscription like a default
+// page: every change in the tenant resolves the selection again.
+func subscriptionComplexity(childComplexity int, recordID *string) int {
+	if recordID != nil && *recordID != "" {
+		return childComplexity
+	}
+
+	return countComplexity(childComplexity, pagination.DefaultLimit)
+}
+
 func listComplexity(childComplexity int, first *int) int {
 	limit := pagination.DefaultLimit
 	if first != nil {
@@ -79,3 +79,48 @@ func TestCountComplexity(t *testing.T) {
 func intPtrForTest(value int) *int {
 	return new(value)
 }
+
+func TestComplexityRoot_Subscriptions(t *testing.T) {
+	t.Parallel()
+
+	root := complexityRoot()
+	shipmentID := "shp_01"
+
+	tests := []struct {
+		name       string
+		cost       func(childComplexity int, shipmentID *string) int
+		shipmentID *string
+		expected   int
+	}{
+		{
+			name:       "shipment changes for one shipment",
+			cost:       root.Subscription.ShipmentChanged,
+			shipmentID: &shipmentID,
+			expected:   30,
+		},
+		{
+			name:     "shipment changes across the tenant",
+			cost:     root.Subscription.ShipmentChanged,
+			expected: 600,
+		},
+		{
+			name:       "tender responses for one shipment",
+			cost:       root.Subscription.TenderResponses,
+			shipmentID: &shipmentID,
+			expected:   30,
+		},
+		{
+			name:     "tender responses across the tenant",
+			cost:     root.Subscription.TenderResponses,
+			expected: 600,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.cost(30, tt.shipmentID))
+		})
+	}
+}
@@ -62,6 +62,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/tender"
 	"github.com/emoss08/trenova/internal/core/domain/tractor"
 	"github.com/emoss08/trenova/internal/core/domain/trailer"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
 	"github.com/emoss08/trenova/internal/core/domain/worker"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/services/driversettlementservice"
@@ -1008,6 +1009,21 @@ type DetentionWaiverLeakageStat struct {
 	WaivedAmount  string `json:"waivedAmount"`
 }
 
+type DispatchAcceptTourInput struct {
+	RunID  string `json:"runId"`
+	TourID string `json:"tourId"`
+}
+
+type DispatchApplyRelayInput struct {
+	MoveID          string  `json:"moveId"`
+	RelayLocationID string  `json:"relayLocationId"`
+	LeadWorkerID    *string `json:"leadWorkerId,omitempty"`
+	ReliefWorkerID  string  `json:"reliefWorkerId"`
+	Mode            string  `json:"mode"`
+	LeadArrival     int     `json:"leadArrival"`
+	HandoffAt       int     `json:"handoffAt"`
+}
+
 type DispatchAssignMoveInput struct {
 	MoveID            string  `json:"moveId"`
 	PrimaryWorkerID   string  `json:"primaryWorkerId"`
@@ -1078,6 +1094,16 @@ type DispatchBoard struct {
 	GeneratedAt int                    `json:"generatedAt"`
 }
 
+// Changes that affect the dispatch board, coalesced over a short window. The board is costly
+// to rebuild, so subscribers get the resources and records that changed and refetch only the
+// rows they show.
+type DispatchBoardChange struct {
+	Resources   []string `json:"resources"`
+	ShipmentIds []string `json:"shipmentIds"`
+	RecordIds   []string `json:"recordIds"`
+	OccurredAt  int      `json:"occurredAt"`
+}
+
 type DispatchBoardDriver struct {
 	WorkerID               string                `json:"workerId"`
 	FirstName              string                `json:"firstName"`
@@ -1240,8 +1266,15 @@ type DispatchCandidate struct {
 	CycleRemainingMs       int      `json:"cycleRemainingMs"`
 	ProjectedTimeAvailable int      `json:"projectedTimeAvailable"`
 	// Rest strategy that makes this trip legal: currentClocks, splitSleeper,
-	// tenHourReset, or restart34. Empty when no projection ran.
+	// eightHourReset, tenHourReset, offDuty24, restart24, restart34, restart36 or
+	// restart72. Empty when no projection ran.
 	HosStrategy string `json:"hosStrategy"`
+	// Hours-of-service ruleset the projection ran under: usInterstateProperty,
+	// usInterstatePassenger, texasIntrastate, alaskaProperty, canadaCycle1 or
+	// canadaCycle2. Empty when no projection ran.
+	HosRuleset string `json:"hosRuleset"`
+	// Exemption the projection relied on, shortHaul150 or empty.
+	HosExemption string `json:"hosExemption"`
 	// Latest unix time the required pre-departure rest must start; 0 when none is needed.
 	HosRestStartDeadline int                    `json:"hosRestStartDeadline"`
 	HosProjectedDriveMs  int                    `json:"hosProjectedDriveMs"`
@@ -1365,6 +1398,79 @@ type DispatchPlannedAssignment struct {
 	ProjectedDriveRemainingMs int `json:"projectedDriveRemainingMs"`
 }
 
+// The two legs a relay leaves behind. The move is split before either leg is assigned, so
+// a failed relief assignment leaves the relay leg uncovered on the board rather than
+// undoing the split.
+type DispatchRelayApplication struct {
+	OriginalMove     *ShipmentMove       `json:"originalMove"`
+	RelayMove        *ShipmentMove       `json:"relayMove"`
+	LeadAssignment   *ShipmentAssignment `json:"leadAssignment,omitempty"`
+	ReliefAssignment *ShipmentAssignment `json:"reliefAssignment,omitempty"`
+}
+
+// One way to relay a move: the lead driver runs to the relay point on the hours they have
+// left, and the relief driver takes the load the rest of the way.
+type DispatchRelayOption struct {
+	RelayPoint *DispatchRelayPoint `json:"relayPoint"`
+	// TractorSwap when the relief brings their own tractor, SlipSeat when they take over the lead's.
+	Mode         string  `json:"mode"`
+	LeadLegMiles float64 `json:"leadLegMiles"`
+	LeadArrival  int     `json:"leadArrival"`
+	// Drive time the lead has left on their clock on reaching the relay point.
+	LeadDriveMarginMs int     `json:"leadDriveMarginMs"`
+	ReliefWorkerID    string  `json:"reliefWorkerId"`
+	ReliefWorkerName  string  `json:"reliefWorkerName"`
+	ReliefTractorID   *string `json:"reliefTractorId,omitempty"`
+	TrailerID         *string `json:"trailerId,omitempty"`
+	// Null when the relief driver's position is unknown and they are assumed to report from
+	// their home terminal.
+	ReliefDeadheadMiles *float64 `json:"reliefDeadheadMiles,omitempty"`
+	ReliefArrival       int      `json:"reliefArrival"`
+	ReliefLegMiles      float64  `json:"reliefLegMiles"`
+	ReliefResets        int      `json:"reliefResets"`
+	HandoffAt           int      `json:"handoffAt"`
+	ProjectedDelivery   int      `json:"projectedDelivery"`
+	TransitSeconds      int      `json:"transitSeconds"`
+	// How much sooner the relay delivers than the lead driver alone; 0 when that baseline is unknown.
+	SecondsSaved int                `json:"secondsSaved"`
+	Findings     []*DispatchFinding `json:"findings"`
+}
+
+type DispatchRelayPlan struct {
+	MoveID         string  `json:"moveId"`
+	ProNumber      string  `json:"proNumber"`
+	LeadWorkerID   string  `json:"leadWorkerId"`
+	LeadWorkerName string  `json:"leadWorkerName"`
+	TotalMiles     float64 `json:"totalMiles"`
+	DepartsAt      int     `json:"departsAt"`
+	// False when the lead driver would run out of cycle before delivering alone; the
+	// single-driver figures are then zero.
+	SingleDriverFeasible       bool                   `json:"singleDriverFeasible"`
+	SingleDriverTransitSeconds int                    `json:"singleDriverTransitSeconds"`
+	SingleDriverDelivery       int                    `json:"singleDriverDelivery"`
+	SingleDriverResets         int                    `json:"singleDriverResets"`
+	Options                    []*DispatchRelayOption `json:"options"`
+	GeneratedAt                int                    `json:"generatedAt"`
+}
+
+type DispatchRelayPlanInput struct {
+	MoveID string `json:"moveId"`
+	// Required when the move is not yet assigned; an assigned move is led by its driver.
+	LeadWorkerID *string `json:"leadWorkerId,omitempty"`
+	Limit        *int    `json:"limit,omitempty"`
+}
+
+// A geocoded terminal or truck stop where one driver can hand a load to another.
+type DispatchRelayPoint struct {
+	LocationID string  `json:"locationId"`
+	Name       string  `json:"name"`
+	City       string  `json:"city"`
+	State      string  `json:"state"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Category   string  `json:"category"`
+}
+
 // One weighted dimension of a candidate's score, carrying both its numeric contribution and
 // the sentence a dispatcher reads to understand it.
 type DispatchScoreFactor struct {
@@ -1394,6 +1500,27 @@ type DispatchTour struct {
 	EndsAt             int      `json:"endsAt"`
 	TotalScore         int      `json:"totalScore"`
 	TotalDeadheadMiles float64  `json:"totalDeadheadMiles"`
+	LoadedMiles        float64  `json:"loadedMiles"`
+	// Deadhead between the tour's moves plus the empty run home after the last one.
+	EmptyMiles    float64 `json:"emptyMiles"`
+	HomeMiles     float64 `json:"homeMiles"`
+	Revenue       float64 `json:"revenue"`
+	RevenuePerDay float64 `json:"revenuePerDay"`
+	// When the driver is projected to reach home after the last move. Null when the driver
+	// has no home location on file.
+	ProjectedHomeArrival *int `json:"projectedHomeArrival,omitempty"`
+	// The latest home arrival the organization's home-time window allows. Null when no
+	// window is configured.
+	HomeBy *int `json:"homeBy,omitempty"`
+}
+
+// The moves a tour acceptance assigned. Tours are accepted as a whole, so either every
+// pending move in the tour is here or the mutation failed and nothing was assigned.
+type DispatchTourAcceptance struct {
+	RunID       string   `json:"runId"`
+	TourID      string   `json:"tourId"`
+	MoveIds     []string `json:"moveIds"`
+	ProposalIds []string `json:"proposalIds"`
 }
 
 // A move the optimizer could not cover. Reporting these matters more than the successes: a
@@ -3804,6 +3931,19 @@ type ShipmentCancelInput struct {
 	CancelReason *string `json:"cancelReason,omitempty"`
 }
 
+// A change to a shipment, pushed as it happens. shipment is the shipment as it stands after
+// the change, or null when it was deleted.
+type ShipmentChange struct {
+	EventID     string    `json:"eventId"`
+	Type        string    `json:"type"`
+	Action      string    `json:"action"`
+	ShipmentID  string    `json:"shipmentId"`
+	Fields      []string  `json:"fields"`
+	ActorUserID *string   `json:"actorUserId,omitempty"`
+	OccurredAt  int       `json:"occurredAt"`
+	Shipment    *Shipment `json:"shipment,omitempty"`
+}
+
 type ShipmentComment struct {
 	ID              string  `json:"id"`
 	BusinessUnitID  *string `json:"businessUnitId,omitempty"`
@@ -4770,6 +4910,9 @@ type SubmitMyExpenseInput struct {
 	IncurredDate *int    `json:"incurredDate,omitempty"`
 }
 
+type Subscription struct {
+}
+
 type TCASubscriptionConnection struct {
 	Edges      []*TCASubscriptionEdge `json:"edges"`
 	PageInfo   *PageInfo              `json:"pageInfo"`
@@ -4872,6 +5015,17 @@ type TelematicsStatus struct {
 	MappedWorkers     int     `json:"mappedWorkers"`
 }
 
+// A carrier response or waterfall transition on a shipment's tender: an offer sent, accepted,
+// declined or expired, or the waterfall running out. tenders are the shipment's tenders after
+// the change.
+type TenderResponseEvent struct {
+	EventID    string           `json:"eventId"`
+	Action     string           `json:"action"`
+	ShipmentID string           `json:"shipmentId"`
+	OccurredAt int              `json:"occurredAt"`
+	Tenders    []*tender.Tender `json:"tenders"`
+}
+
 type TractorConnection struct {
 	Edges      []*TractorEdge `json:"edges"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -5248,6 +5402,37 @@ type VehiclePosition struct {
 	PrimaryWorkerName *string  `json:"primaryWorkerName,omitempty"`
 }
 
+type WebhookDeliveryConnection struct {
+	Edges      []*WebhookDeliveryEdge `json:"edges"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount *int                   `json:"totalCount,omitempty"`
+}
+
+type WebhookDeliveryEdge struct {
+	Node   *webhook.Delivery `json:"node"`
+	Cursor string            `json:"cursor"`
+}
+
+type WebhookSubscriptionConnection struct {
+	Edges      []*WebhookSubscriptionEdge `json:"edges"`
+	PageInfo   *PageInfo                  `json:"pageInfo"`
+	TotalCount *int                       `json:"totalCount,omitempty"`
+}
+
+type WebhookSubscriptionEdge struct {
+	Node   *webhook.Subscription `json:"node"`
+	Cursor string                `json:"cursor"`
+}
+
+type WebhookSubscriptionInput struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	URL         string   `json:"url"`
+	CustomerID  *string  `json:"customerId,omitempty"`
+	EventTypes  []string `json:"eventTypes"`
+	Version     *int     `json:"version,omitempty"`
+}
+
 type WorkerConnection struct {
 	Edges      []*WorkerEdge `json:"edges"`
 	PageInfo   *PageInfo     `json:"pageInfo"`
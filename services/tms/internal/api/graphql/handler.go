@@ -1,7 +1,9 @@
 package graphql
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
 	"github.com/emoss08/trenova/internal/api/graphql/gqlctx"
@@ -54,6 +56,7 @@ func New(p Params) *Handler {
 
 func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	rg.POST("/graphql", h.handle)
+	rg.GET("/graphql/ws", h.handleSubscriptions)
 }
 
 func (h *Handler) RegisterPlaygroundRoutes(rg *gin.RouterGroup) {
@@ -76,9 +79,33 @@ func (h *Handler) handle(c *gin.Context) {
 		return
 	}
 
+	c.Request = c.Request.WithContext(h.requestContext(c, authCtx))
+	h.l.Debug("handling GraphQL request", zap.String("request_id", requestid.Get(c)))
+	h.server.ServeHTTP(c.Writer, c.Request)
+}
+
+// handleSubscriptions upgrades to graphql-ws. Operations on the socket are
+// checked against the persisted operation safelist by the server itself,
+// since they never pass through a request body. API keys may subscribe, so
+// integrations can follow shipment and tender events without polling; each
+// subscription is still checked against the key's permissions.
+func (h *Handler) handleSubscriptions(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	// The socket outlives the server's read and write timeouts.
+	rc := http.NewResponseController(c.Writer)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	c.Request = c.Request.WithContext(h.requestContext(c, authCtx))
+	h.l.Debug("opening GraphQL subscription socket", zap.String("request_id", requestid.Get(c)))
+	h.server.ServeHTTP(c.Writer, c.Request)
+}
+
+func (h *Handler) requestContext(c *gin.Context, authCtx *authctx.AuthContext) context.Context {
 	reqCtx := gqlctx.WithAuthContext(c.Request.Context(), authCtx)
 	reqCtx = gqlctx.WithRequestID(reqCtx, requestid.Get(c))
-	reqCtx = loaders.WithLoaders(
+	return loaders.WithLoaders(
 		reqCtx,
 		h.loaderFactory.NewForTenant(pagination.TenantInfo{
 			OrgID:  authCtx.OrganizationID,
@@ -86,10 +113,6 @@ func (h *Handler) handle(c *gin.Context) {
 			UserID: authCtx.UserID,
 		}),
 	)
-
-	c.Request = c.Request.WithContext(reqCtx)
-	h.l.Debug("handling GraphQL request", zap.String("request_id", requestid.Get(c)))
-	h.server.ServeHTTP(c.Writer, c.Request)
 }
 
 func (h *Handler) handlePlayground(c *gin.Context) {
@@ -3,14 +3,21 @@ package graphql
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/emoss08/trenova/internal/api/graphql/loaders"
 	"github.com/emoss08/trenova/internal/api/helpers"
 	"github.com/emoss08/trenova/internal/infrastructure/config"
 	"github.com/emoss08/trenova/pkg/authctx"
 	"github.com/emoss08/trenova/shared/pulid"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -45,6 +52,40 @@ func TestHandler_RejectsAPIKeyPrincipal(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "API keys cannot access GraphQL")
 }
 
+func TestHandler_SubscriptionsAcceptAPIKeyPrincipal(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	srv := testserver.New()
+	srv.AddTransport(transport.Websocket{})
+	h := &Handler{
+		l:             zap.NewNop(),
+		loaderFactory: loaders.NewFactory(loaders.FactoryParams{}),
+		server:        srv.Server,
+	}
+
+	router := gin.New()
+	router.GET("/graphql/ws", func(c *gin.Context) {
+		authctx.SetAPIKeyContext(c, pulid.MustNew("ak_"), pulid.MustNew("bu_"), pulid.MustNew("org_"))
+		h.handleSubscriptions(c)
+	})
+	httpSrv := httptest.NewServer(router)
+	t.Cleanup(httpSrv.Close)
+
+	conn, _, err := websocket.Dial(
+		t.Context(),
+		"ws"+strings.TrimPrefix(httpSrv.URL, "http")+"/graphql/ws",
+		&websocket.DialOptions{Subprotocols: []string{"graphql-transport-ws"}},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.CloseNow() })
+
+	require.NoError(t, wsjson.Write(t.Context(), conn, map[string]any{"type": "connection_init"}))
+	var ack map[string]any
+	require.NoError(t, wsjson.Read(t.Context(), conn, &ack))
+	assert.Equal(t, "connection_ack", ack["type"])
+}
+
 func TestHandler_PlaygroundEnabledForDevelopment(t *testing.T) {
 	t.Parallel()
 	gin.SetMode(gin.TestMode)
@@ -193,6 +193,86 @@ func (r *mutationResolver) DispatchPlanAutoAssign(ctx context.Context, input gql
 	return mapDispatchPlan(plan), nil
 }
 
+// DispatchAcceptTour is the resolver for the dispatchAcceptTour field.
+func (r *mutationResolver) DispatchAcceptTour(ctx context.Context, input gqlmodel.DispatchAcceptTourInput) (*gqlmodel.DispatchTourAcceptance, error) {
+	authCtx, err := r.requirePermission(
+		ctx,
+		permission.ResourceShipmentMove,
+		permission.OpAssign,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	runID, err := pulid.Parse(input.RunID)
+	if err != nil {
+		return nil, err
+	}
+	tourID, err := pulid.Parse(input.TourID)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted, err := r.dispatchAutoAssignService.AcceptTour(
+		ctx,
+		&services.DispatchAcceptTourRequest{
+			TenantInfo: tenantInfo(authCtx),
+			RunID:      runID,
+			TourID:     tourID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDispatchTourAcceptance(accepted), nil
+}
+
+// DispatchApplyRelay is the resolver for the dispatchApplyRelay field.
+func (r *mutationResolver) DispatchApplyRelay(ctx context.Context, input gqlmodel.DispatchApplyRelayInput) (*gqlmodel.DispatchRelayApplication, error) {
+	authCtx, err := r.requirePermission(
+		ctx,
+		permission.ResourceShipmentMove,
+		permission.OpAssign,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	moveID, err := requiredID("moveId", input.MoveID)
+	if err != nil {
+		return nil, err
+	}
+	relayLocationID, err := requiredID("relayLocationId", input.RelayLocationID)
+	if err != nil {
+		return nil, err
+	}
+	leadWorkerID, err := optionalScopedID("leadWorkerId", input.LeadWorkerID)
+	if err != nil {
+		return nil, err
+	}
+	reliefWorkerID, err := requiredID("reliefWorkerId", input.ReliefWorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := r.dispatchRelayService.Apply(ctx, &services.DispatchApplyRelayRequest{
+		TenantInfo:      tenantInfo(authCtx),
+		MoveID:          moveID,
+		RelayLocationID: relayLocationID,
+		LeadWorkerID:    leadWorkerID,
+		ReliefWorkerID:  reliefWorkerID,
+		Mode:            services.DispatchRelayMode(input.Mode),
+		LeadArrival:     int64(input.LeadArrival),
+		HandoffAt:       int64(input.HandoffAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDispatchRelayApplication(applied)
+}
+
 // DispatchBoard is the resolver for the dispatchBoard field.
 func (r *queryResolver) DispatchBoard(ctx context.Context, input gqlmodel.DispatchBoardInput) (*gqlmodel.DispatchBoard, error) {
 	authCtx, err := r.requirePermission(
@@ -411,3 +491,36 @@ func (r *queryResolver) DispatchCarrierAssignmentPreview(ctx context.Context, in
 		Warnings: append([]string{}, result.Warnings...),
 	}, nil
 }
+
+// DispatchRelayPlan is the resolver for the dispatchRelayPlan field.
+func (r *queryResolver) DispatchRelayPlan(ctx context.Context, input gqlmodel.DispatchRelayPlanInput) (*gqlmodel.DispatchRelayPlan, error) {
+	authCtx, err := r.requirePermission(
+		ctx,
+		permission.ResourceShipmentMove,
+		permission.OpRead,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	moveID, err := requiredID("moveId", input.MoveID)
+	if err != nil {
+		return nil, err
+	}
+	leadWorkerID, err := optionalScopedID("leadWorkerId", input.LeadWorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := r.dispatchRelayService.Plan(ctx, &services.DispatchRelayPlanRequest{
+		TenantInfo:   tenantInfo(authCtx),
+		MoveID:       moveID,
+		LeadWorkerID: leadWorkerID,
+		Limit:        intValue(input.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDispatchRelayPlan(plan), nil
+}
@@ -1,12 +1,16 @@
 package resolver
 
 import (
+	"fmt"
+
 	"github.com/emoss08/trenova/internal/api/graphql/gqlmodel"
+	shipmentdomain "github.com/emoss08/trenova/internal/core/domain/shipment"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
 	"github.com/emoss08/trenova/internal/core/services/dispatchconsoleservice"
 	"github.com/emoss08/trenova/internal/core/services/dispatcheligibility"
+	"github.com/emoss08/trenova/shared/pulid"
 )
 
 func mapDispatchBoard(board *dispatchconsoleservice.Board) *gqlmodel.DispatchBoard {
@@ -253,6 +257,8 @@ func mapDispatchCandidate(
 		CycleRemainingMs:       int(score.CycleRemainingMs),
 		ProjectedTimeAvailable: int(score.ProjectedAvailable),
 		HosStrategy:            score.HOSStrategy,
+		HosRuleset:             score.HOSRuleset,
+		HosExemption:           score.HOSExemption,
 		HosRestStartDeadline:   int(score.HOSRestStartDeadline),
 		HosProjectedDriveMs:    int(score.HOSProjectedDriveMs),
 		HosProjectedShiftMs:    int(score.HOSProjectedShiftMs),
@@ -326,14 +332,21 @@ func mapDispatchPlan(plan *services.DispatchPlan) *gqlmodel.DispatchPlan {
 		}
 
 		tours = append(tours, &gqlmodel.DispatchTour{
-			TourID:             tour.TourID.String(),
-			WorkerID:           tour.WorkerID.String(),
-			WorkerName:         tour.WorkerName,
-			MoveIds:            moveIDs,
-			StartsAt:           int(tour.StartsAt),
-			EndsAt:             int(tour.EndsAt),
-			TotalScore:         tour.TotalScore,
-			TotalDeadheadMiles: tour.TotalDeadheadMiles,
+			TourID:               tour.TourID.String(),
+			WorkerID:             tour.WorkerID.String(),
+			WorkerName:           tour.WorkerName,
+			MoveIds:              moveIDs,
+			StartsAt:             int(tour.StartsAt),
+			EndsAt:               int(tour.EndsAt),
+			TotalScore:           tour.TotalScore,
+			TotalDeadheadMiles:   tour.TotalDeadheadMiles,
+			LoadedMiles:          tour.LoadedMiles,
+			EmptyMiles:           tour.EmptyMiles,
+			HomeMiles:            tour.HomeMiles,
+			Revenue:              tour.Revenue,
+			RevenuePerDay:        tour.RevenuePerDay,
+			ProjectedHomeArrival: nonZeroInt(tour.ProjectedHomeArrival),
+			HomeBy:               nonZeroInt(tour.HomeBy),
 		})
 	}
 
@@ -349,3 +362,108 @@ func mapDispatchPlan(plan *services.DispatchPlan) *gqlmodel.DispatchPlan {
 		GeneratedAt:  int(plan.GeneratedAt),
 	}
 }
+
+func mapDispatchTourAcceptance(
+	accepted *services.DispatchTourAcceptance,
+) *gqlmodel.DispatchTourAcceptance {
+	return &gqlmodel.DispatchTourAcceptance{
+		RunID:       accepted.RunID.String(),
+		TourID:      accepted.TourID.String(),
+		MoveIds:     pulid.Map(accepted.MoveIDs, pulid.ID.String),
+		ProposalIds: pulid.Map(accepted.ProposalIDs, pulid.ID.String),
+	}
+}
+
+func nonZeroInt(value int64) *int {
+	if value == 0 {
+		return nil
+	}
+	converted := int(value)
+	return &converted
+}
+
+func mapDispatchRelayPlan(plan *services.DispatchRelayPlan) *gqlmodel.DispatchRelayPlan {
+	options := make([]*gqlmodel.DispatchRelayOption, 0, len(plan.Options))
+	for _, option := range plan.Options {
+		options = append(options, mapDispatchRelayOption(option))
+	}
+
+	return &gqlmodel.DispatchRelayPlan{
+		MoveID:                     plan.MoveID.String(),
+		ProNumber:                  plan.ProNumber,
+		LeadWorkerID:               plan.LeadWorkerID.String(),
+		LeadWorkerName:             plan.LeadWorkerName,
+		TotalMiles:                 plan.TotalMiles,
+		DepartsAt:                  int(plan.DepartsAt),
+		SingleDriverFeasible:       plan.SingleDriverFeasible,
+		SingleDriverTransitSeconds: int(plan.SingleDriverTransitSeconds),
+		SingleDriverDelivery:       int(plan.SingleDriverDelivery),
+		SingleDriverResets:         plan.SingleDriverResets,
+		Options:                    options,
+		GeneratedAt:                int(plan.GeneratedAt),
+	}
+}
+
+func mapDispatchRelayOption(option *services.DispatchRelayOption) *gqlmodel.DispatchRelayOption {
+	point := option.RelayPoint
+
+	return &gqlmodel.DispatchRelayOption{
+		RelayPoint: &gqlmodel.DispatchRelayPoint{
+			LocationID: point.LocationID.String(),
+			Name:       point.Name,
+			City:       point.City,
+			State:      point.State,
+			Latitude:   point.Latitude,
+			Longitude:  point.Longitude,
+			Category:   string(point.Category),
+		},
+		Mode:                string(option.Mode),
+		LeadLegMiles:        option.LeadLegMiles,
+		LeadArrival:         int(option.LeadArrival),
+		LeadDriveMarginMs:   int(option.LeadDriveMargin),
+		ReliefWorkerID:      option.ReliefWorkerID.String(),
+		ReliefWorkerName:    option.ReliefWorkerName,
+		ReliefTractorID:     idPtr(option.ReliefTractorID),
+		TrailerID:           idPtr(option.TrailerID),
+		ReliefDeadheadMiles: option.ReliefDeadheadMiles,
+		ReliefArrival:       int(option.ReliefArrival),
+		ReliefLegMiles:      option.ReliefLegMiles,
+		ReliefResets:        option.ReliefResets,
+		HandoffAt:           int(option.HandoffAt),
+		ProjectedDelivery:   int(option.ProjectedDelivery),
+		TransitSeconds:      int(option.TransitSeconds),
+		SecondsSaved:        int(option.SecondsSaved),
+		Findings:            mapDispatchFindings(option.Findings),
+	}
+}
+
+func mapDispatchRelayApplication(
+	applied *services.DispatchRelayApplication,
+) (*gqlmodel.DispatchRelayApplication, error) {
+	moves, err := shipmentMovesToModel([]*shipmentdomain.ShipmentMove{
+		applied.OriginalMove,
+		applied.RelayMove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(moves) != 2 {
+		return nil, fmt.Errorf("relay returned %d of its 2 moves", len(moves))
+	}
+
+	leadAssignment, err := shipmentAssignmentToModel(applied.LeadAssignment)
+	if err != nil {
+		return nil, err
+	}
+	reliefAssignment, err := shipmentAssignmentToModel(applied.ReliefAssignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.DispatchRelayApplication{
+		OriginalMove:     moves[0],
+		RelayMove:        moves[1],
+		LeadAssignment:   leadAssignment,
+		ReliefAssignment: reliefAssignment,
+	}, nil
+}
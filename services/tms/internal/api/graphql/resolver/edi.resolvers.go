@@ -35,12 +35,12 @@ func (r *ediTemplateResolver) TransactionSet(ctx context.Context, obj *edi.EDITe
 }
 
 // TenderPayload is the resolver for the tenderPayload field.
-func (r *ediTransferResolver) TenderPayload(ctx context.Context, obj *edi.EDITransfer) (any, error) {
+func (r *ediTransferResolver) TenderPayload(ctx context.Context, obj *edi.EDITransfer) (interface{}, error) {
 	return obj.TenderPayload, nil
 }
 
 // MappingSnapshot is the resolver for the mappingSnapshot field.
-func (r *ediTransferResolver) MappingSnapshot(ctx context.Context, obj *edi.EDITransfer) (any, error) {
+func (r *ediTransferResolver) MappingSnapshot(ctx context.Context, obj *edi.EDITransfer) (interface{}, error) {
 	return obj.MappingSnapshot, nil
 }
 
@@ -72,6 +72,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/services/trailerservice"
 	"github.com/emoss08/trenova/internal/core/services/userservice"
 	"github.com/emoss08/trenova/internal/core/services/usstateservice"
+	"github.com/emoss08/trenova/internal/core/services/webhookservice"
 	"github.com/emoss08/trenova/internal/core/services/workerptoservice"
 	"github.com/emoss08/trenova/internal/core/services/workerservice"
 	"github.com/emoss08/trenova/pkg/authctx"
@@ -147,6 +148,7 @@ type Params struct {
 	TelematicsService            *telematicsservice.Service
 	DispatchConsoleService       services.DispatchConsoleService
 	DispatchAutoAssignService    services.DispatchAutoAssignService
+	DispatchRelayService         services.DispatchRelayService
 	AssignmentService            services.AssignmentService
 	DocumentPacketRuleService    *documentpacketruleservice.Service
 	DocumentTemplateService      *documenttemplateservice.Service
@@ -172,12 +174,14 @@ type Params struct {
 	UserService                  *userservice.Service
 	APIKeyService                *apikeyservice.Service
 	TableChangeAlertService      *tablechangealertservice.Service
+	WebhookService               *webhookservice.Service
 	TableConfigurationService    *tableconfigurationservice.Service
 	SidebarPreferenceService     *sidebarpreferenceservice.Service
 	HomeLayoutService            *homelayoutservice.Service
 	ReportingService             *reportingservice.Service
 	NotificationService          *notificationservice.Service
 	PermissionEngine             services.PermissionEngine
+	ResourceEvents               services.ResourceEventSource `optional:"true"`
 	DriverPayService             *driverpayservice.Service
 	DriverSettlementService      *driversettlementservice.Service
 	DriverPortalService          *driverportalservice.Service
@@ -261,6 +265,7 @@ type Resolver struct {
 	telematicsService            *telematicsservice.Service
 	dispatchConsoleService       services.DispatchConsoleService
 	dispatchAutoAssignService    services.DispatchAutoAssignService
+	dispatchRelayService         services.DispatchRelayService
 	assignmentService            services.AssignmentService
 	documentPacketRuleService    *documentpacketruleservice.Service
 	documentTemplateService      *documenttemplateservice.Service
@@ -286,6 +291,7 @@ type Resolver struct {
 	userService                  *userservice.Service
 	apiKeyService                *apikeyservice.Service
 	tableChangeAlertService      *tablechangealertservice.Service
+	webhookService               *webhookservice.Service
 	tableConfigurationService    *tableconfigurationservice.Service
 	sidebarPreferenceService     *sidebarpreferenceservice.Service
 	homeLayoutService            *homelayoutservice.Service
@@ -309,6 +315,7 @@ type Resolver struct {
 	carrierCostEventRepo         repositories.CarrierCostEventRepository
 	reportingService             *reportingservice.Service
 	permissionEngine             services.PermissionEngine
+	resourceEvents               services.ResourceEventSource
 }
 
 func New(p Params) *Resolver {
@@ -376,6 +383,7 @@ func New(p Params) *Resolver {
 		telematicsService:            p.TelematicsService,
 		dispatchConsoleService:       p.DispatchConsoleService,
 		dispatchAutoAssignService:    p.DispatchAutoAssignService,
+		dispatchRelayService:         p.DispatchRelayService,
 		assignmentService:            p.AssignmentService,
 		documentPacketRuleService:    p.DocumentPacketRuleService,
 		documentTemplateService:      p.DocumentTemplateService,
@@ -401,12 +409,14 @@ func New(p Params) *Resolver {
 		userService:                  p.UserService,
 		apiKeyService:                p.APIKeyService,
 		tableChangeAlertService:      p.TableChangeAlertService,
+		webhookService:               p.WebhookService,
 		tableConfigurationService:    p.TableConfigurationService,
 		sidebarPreferenceService:     p.SidebarPreferenceService,
 		homeLayoutService:            p.HomeLayoutService,
 		notificationService:          p.NotificationService,
 		reportingService:             p.ReportingService,
 		permissionEngine:             p.PermissionEngine,
+		resourceEvents:               p.ResourceEvents,
 		driverPayService:             p.DriverPayService,
 		driverSettlementService:      p.DriverSettlementService,
 		driverPortalService:          p.DriverPortalService,
@@ -0,0 +1,196 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/api/graphql/generated"
+	"github.com/emoss08/trenova/internal/api/graphql/gqlmodel"
+	"github.com/emoss08/trenova/internal/core/domain/notification"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/domain/tender"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+// Shipment is the resolver for the shipment field.
+func (r *shipmentChangeResolver) Shipment(ctx context.Context, obj *gqlmodel.ShipmentChange) (*gqlmodel.Shipment, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceShipment, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentID, err := pulid.MustParse(obj.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := r.shipmentService.Get(ctx, &repositories.GetShipmentByIDRequest{
+		ID:         shipmentID,
+		TenantInfo: tenantInfo(authCtx),
+	})
+	if err != nil {
+		if errortypes.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return shipmentToModel(entity)
+}
+
+// ShipmentChanged is the resolver for the shipmentChanged field.
+func (r *subscriptionResolver) ShipmentChanged(ctx context.Context, shipmentID *string) (<-chan *gqlmodel.ShipmentChange, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceShipment, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := optionalSubscriptionID(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribeTenant(ctx, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapResourceEvents(ctx, events, func(
+		event *services.ResourceInvalidationEvent,
+	) (*gqlmodel.ShipmentChange, bool) {
+		return shipmentChangeFromEvent(event, filter)
+	}), nil
+}
+
+// ShipmentTimeline is the resolver for the shipmentTimeline field.
+func (r *subscriptionResolver) ShipmentTimeline(ctx context.Context, shipmentID string) (<-chan *gqlmodel.ShipmentEvent, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceShipment, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedShipmentID, err := pulid.MustParse(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribeTenant(ctx, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapResourceEvents(ctx, events, func(
+		event *services.ResourceInvalidationEvent,
+	) (*gqlmodel.ShipmentEvent, bool) {
+		return r.shipmentTimelineFromEvent(event, parsedShipmentID.String())
+	}), nil
+}
+
+// TenderResponses is the resolver for the tenderResponses field.
+func (r *subscriptionResolver) TenderResponses(ctx context.Context, shipmentID *string) (<-chan *gqlmodel.TenderResponseEvent, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceTender, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := optionalSubscriptionID(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribeTenant(ctx, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapResourceEvents(ctx, events, func(
+		event *services.ResourceInvalidationEvent,
+	) (*gqlmodel.TenderResponseEvent, bool) {
+		return tenderResponseFromEvent(event, filter)
+	}), nil
+}
+
+// DispatchBoardChanged is the resolver for the dispatchBoardChanged field.
+func (r *subscriptionResolver) DispatchBoardChanged(ctx context.Context) (<-chan *gqlmodel.DispatchBoardChange, error) {
+	authCtx, err := r.requirePermission(
+		ctx,
+		permission.ResourceShipmentMove,
+		permission.OpRead,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.requirePermission(ctx, permission.ResourceWorker, permission.OpRead); err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribeTenant(ctx, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return coalesceDispatchBoardChanges(ctx, events, dispatchBoardCoalesceWindow), nil
+}
+
+// NotificationReceived is the resolver for the notificationReceived field.
+func (r *subscriptionResolver) NotificationReceived(ctx context.Context) (<-chan *notification.Notification, error) {
+	authCtx, err := r.requireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribeTenant(ctx, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapResourceEvents(ctx, events, func(
+		event *services.ResourceInvalidationEvent,
+	) (*notification.Notification, bool) {
+		return r.notificationFromEvent(event, authCtx)
+	}), nil
+}
+
+// Tenders is the resolver for the tenders field.
+func (r *tenderResponseEventResolver) Tenders(ctx context.Context, obj *gqlmodel.TenderResponseEvent) ([]*tender.Tender, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceTender, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	shipmentID, err := pulid.MustParse(obj.ShipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.tenderService.ListByShipment(ctx, repositories.ListTendersByShipmentRequest{
+		TenantInfo: tenantInfo(authCtx),
+		ShipmentID: shipmentID,
+	})
+}
+
+// ShipmentChange returns generated.ShipmentChangeResolver implementation.
+func (r *Resolver) ShipmentChange() generated.ShipmentChangeResolver {
+	return &shipmentChangeResolver{r}
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+// TenderResponseEvent returns generated.TenderResponseEventResolver implementation.
+func (r *Resolver) TenderResponseEvent() generated.TenderResponseEventResolver {
+	return &tenderResponseEventResolver{r}
+}
+
+type (
+	shipmentChangeResolver      struct{ *Resolver }
+	subscriptionResolver        struct{ *Resolver }
+	tenderResponseEventResolver struct{ *Resolver }
+)
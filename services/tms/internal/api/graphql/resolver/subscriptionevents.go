@@ -0,0 +1,314 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/api/graphql/gqlmodel"
+	"github.com/emoss08/trenova/internal/core/domain/notification"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/domain/shipmentevent"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.uber.org/zap"
+)
+
+// dispatchBoardCoalesceWindow bounds how often a board subscriber hears about
+// changes; an assignment touches the shipment, worker and equipment at once.
+const dispatchBoardCoalesceWindow = time.Second
+
+var (
+	shipmentEventResources = map[string]struct{}{
+		"shipments":                          {},
+		permission.ResourceShipment.String(): {},
+	}
+	// tenderResponseActions are the carrier responses and waterfall outcomes
+	// the tender service publishes against the shipment.
+	tenderResponseActions = map[string]struct{}{
+		"tender_accepted":       {},
+		"tender_offer_declined": {},
+		"tender_offer_expired":  {},
+		"tender_needs_review":   {},
+		"tender_exhausted":      {},
+	}
+	dispatchBoardResources = map[string]struct{}{
+		"shipments":                              {},
+		permission.ResourceShipment.String():     {},
+		permission.ResourceShipmentHold.String(): {},
+		"workers":                                {},
+		permission.ResourceWorker.String():       {},
+		"worker_pto":                             {},
+		"tractors":                               {},
+		permission.ResourceTractor.String():      {},
+		"trailers":                               {},
+	}
+)
+
+const (
+	shipmentTimelineResource = "shipmentEvents"
+	notificationResource     = "notifications"
+)
+
+// subscribeTenant opens the caller's tenant event stream. Like queries,
+// subscriptions are authorized once, when they start; the stream never
+// carries another tenant's events.
+func (r *Resolver) subscribeTenant(
+	ctx context.Context,
+	authCtx *authctx.AuthContext,
+) (<-chan *services.ResourceInvalidationEvent, error) {
+	if r.resourceEvents == nil {
+		return nil, errortypes.NewBusinessError("GraphQL subscriptions are not available")
+	}
+
+	return r.resourceEvents.SubscribeResourceEvents(
+		ctx,
+		authCtx.OrganizationID,
+		authCtx.BusinessUnitID,
+	)
+}
+
+// mapResourceEvents forwards the events convert accepts until ctx ends or the
+// source closes, which ends the subscription.
+func mapResourceEvents[T any](
+	ctx context.Context,
+	events <-chan *services.ResourceInvalidationEvent,
+	convert func(*services.ResourceInvalidationEvent) (*T, bool),
+) <-chan *T {
+	out := make(chan *T)
+	go func() {
+		defer close(out)
+
+		for event := range events {
+			item, ok := convert(event)
+			if !ok {
+				continue
+			}
+
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func shipmentChangeFromEvent(
+	event *services.ResourceInvalidationEvent,
+	shipmentID string,
+) (*gqlmodel.ShipmentChange, bool) {
+	if _, ok := shipmentEventResources[event.Resource]; !ok || event.EntityID == "" {
+		return nil, false
+	}
+	if shipmentID != "" && event.EntityID != shipmentID {
+		return nil, false
+	}
+
+	return &gqlmodel.ShipmentChange{
+		EventID:     event.EventID,
+		Type:        event.Type,
+		Action:      event.Action,
+		ShipmentID:  event.EntityID,
+		Fields:      emptyIfNil(event.Fields),
+		ActorUserID: stringPtrFromValue(event.ActorUserID),
+		OccurredAt:  int(event.OccurredAt.Unix()),
+	}, true
+}
+
+func tenderResponseFromEvent(
+	event *services.ResourceInvalidationEvent,
+	shipmentID string,
+) (*gqlmodel.TenderResponseEvent, bool) {
+	if _, ok := tenderResponseActions[event.Action]; !ok || event.EntityID == "" {
+		return nil, false
+	}
+	if shipmentID != "" && event.EntityID != shipmentID {
+		return nil, false
+	}
+
+	return &gqlmodel.TenderResponseEvent{
+		EventID:    event.EventID,
+		Action:     event.Action,
+		ShipmentID: event.EntityID,
+		OccurredAt: int(event.OccurredAt.Unix()),
+	}, true
+}
+
+func (r *Resolver) shipmentTimelineFromEvent(
+	event *services.ResourceInvalidationEvent,
+	shipmentID string,
+) (*gqlmodel.ShipmentEvent, bool) {
+	if event.Resource != shipmentTimelineResource {
+		return nil, false
+	}
+
+	entity := new(shipmentevent.Event)
+	if !r.decodeEventEntity(event, entity) || entity.ShipmentID.String() != shipmentID {
+		return nil, false
+	}
+
+	item, err := shipmentEventToModel(entity)
+	if err != nil {
+		r.l.Warn("failed to map shipment timeline event", zap.Error(err))
+		return nil, false
+	}
+
+	return item, true
+}
+
+// notificationFromEvent applies the same audience rule as the notifications
+// query: the caller's own notifications, plus global ones unless the caller is
+// a portal user.
+func (r *Resolver) notificationFromEvent(
+	event *services.ResourceInvalidationEvent,
+	authCtx *authctx.AuthContext,
+) (*notification.Notification, bool) {
+	if event.Resource != notificationResource || event.Action != "created" {
+		return nil, false
+	}
+
+	entity := new(notification.Notification)
+	if !r.decodeEventEntity(event, entity) {
+		return nil, false
+	}
+
+	targeted := entity.TargetUserID != nil && *entity.TargetUserID == authCtx.UserID
+	global := entity.Channel == notification.ChannelGlobal && !authCtx.IsPortalUser
+	if !targeted && !global {
+		return nil, false
+	}
+
+	return entity, true
+}
+
+func (r *Resolver) decodeEventEntity(event *services.ResourceInvalidationEvent, dst any) bool {
+	raw, ok := event.Entity.(json.RawMessage)
+	if !ok || len(raw) == 0 {
+		return false
+	}
+
+	if err := sonic.Unmarshal(raw, dst); err != nil {
+		r.l.Warn("failed to decode resource event entity",
+			zap.String("resource", event.Resource),
+			zap.String("eventId", event.EventID),
+			zap.Error(err))
+		return false
+	}
+
+	return true
+}
+
+// dispatchBoardBatch accumulates board-affecting events until the coalesce
+// window closes.
+type dispatchBoardBatch struct {
+	resources   []string
+	shipmentIDs []string
+	recordIDs   []string
+	seen        map[string]struct{}
+}
+
+func newDispatchBoardBatch() *dispatchBoardBatch {
+	return &dispatchBoardBatch{seen: make(map[string]struct{})}
+}
+
+func (b *dispatchBoardBatch) add(event *services.ResourceInvalidationEvent) {
+	if b.markSeen("resource:" + event.Resource) {
+		b.resources = append(b.resources, event.Resource)
+	}
+	if event.EntityID == "" {
+		return
+	}
+
+	if _, ok := shipmentEventResources[event.Resource]; ok {
+		if b.markSeen("shipment:" + event.EntityID) {
+			b.shipmentIDs = append(b.shipmentIDs, event.EntityID)
+		}
+		return
+	}
+	if b.markSeen("record:" + event.EntityID) {
+		b.recordIDs = append(b.recordIDs, event.EntityID)
+	}
+}
+
+func (b *dispatchBoardBatch) markSeen(key string) bool {
+	if _, ok := b.seen[key]; ok {
+		return false
+	}
+	b.seen[key] = struct{}{}
+	return true
+}
+
+func (b *dispatchBoardBatch) change(now time.Time) *gqlmodel.DispatchBoardChange {
+	return &gqlmodel.DispatchBoardChange{
+		Resources:   emptyIfNil(b.resources),
+		ShipmentIds: emptyIfNil(b.shipmentIDs),
+		RecordIds:   emptyIfNil(b.recordIDs),
+		OccurredAt:  int(now.Unix()),
+	}
+}
+
+func coalesceDispatchBoardChanges(
+	ctx context.Context,
+	events <-chan *services.ResourceInvalidationEvent,
+	window time.Duration,
+) <-chan *gqlmodel.DispatchBoardChange {
+	out := make(chan *gqlmodel.DispatchBoardChange)
+	go func() {
+		defer close(out)
+
+		var (
+			batch *dispatchBoardBatch
+			flush <-chan time.Time
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if _, affects := dispatchBoardResources[event.Resource]; !affects {
+					continue
+				}
+				if batch == nil {
+					batch = newDispatchBoardBatch()
+					flush = time.After(window)
+				}
+				batch.add(event)
+			case now := <-flush:
+				change := batch.change(now)
+				batch, flush = nil, nil
+
+				select {
+				case out <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// optionalSubscriptionID validates an optional ID argument, returning "" when
+// the subscription is not narrowed to one record.
+func optionalSubscriptionID(id *string) (string, error) {
+	if id == nil || *id == "" {
+		return "", nil
+	}
+
+	parsed, err := pulid.MustParse(*id)
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,162 @@
+package resolver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/core/domain/notification"
+	servicesport "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestShipmentChangeFromEvent_FiltersByShipment(t *testing.T) {
+	t.Parallel()
+
+	occurredAt := time.Unix(1_800_000_000, 0)
+	event := &servicesport.ResourceInvalidationEvent{
+		EventID:     "evt_1",
+		Type:        "resource.invalidated",
+		Resource:    "shipments",
+		Action:      "updated",
+		EntityID:    "shp_1",
+		Fields:      []string{"status"},
+		ActorUserID: "usr_1",
+		OccurredAt:  occurredAt,
+	}
+
+	change, ok := shipmentChangeFromEvent(event, "")
+	require.True(t, ok)
+	assert.Equal(t, "shp_1", change.ShipmentID)
+	assert.Equal(t, []string{"status"}, change.Fields)
+	assert.Equal(t, "usr_1", *change.ActorUserID)
+	assert.Equal(t, 1_800_000_000, change.OccurredAt)
+
+	_, ok = shipmentChangeFromEvent(event, "shp_2")
+	assert.False(t, ok)
+
+	_, ok = shipmentChangeFromEvent(&servicesport.ResourceInvalidationEvent{
+		Resource: "workers",
+		EntityID: "wrk_1",
+	}, "")
+	assert.False(t, ok)
+}
+
+func TestTenderResponseFromEvent_AcceptsTenderOutcomesOnly(t *testing.T) {
+	t.Parallel()
+
+	response, ok := tenderResponseFromEvent(&servicesport.ResourceInvalidationEvent{
+		Resource: "shipments",
+		Action:   "tender_accepted",
+		EntityID: "shp_1",
+	}, "shp_1")
+	require.True(t, ok)
+	assert.Equal(t, "tender_accepted", response.Action)
+	assert.Equal(t, "shp_1", response.ShipmentID)
+
+	_, ok = tenderResponseFromEvent(&servicesport.ResourceInvalidationEvent{
+		Resource: "shipments",
+		Action:   "updated",
+		EntityID: "shp_1",
+	}, "")
+	assert.False(t, ok)
+}
+
+func TestNotificationFromEvent_AppliesAudience(t *testing.T) {
+	t.Parallel()
+
+	r := &Resolver{l: zap.NewNop()}
+	userID := pulid.MustNew("usr_")
+	otherUserID := pulid.MustNew("usr_")
+
+	eventFor := func(t *testing.T, n *notification.Notification) *servicesport.ResourceInvalidationEvent {
+		t.Helper()
+
+		raw, err := sonic.Marshal(n)
+		require.NoError(t, err)
+		return &servicesport.ResourceInvalidationEvent{
+			Resource: notificationResource,
+			Action:   "created",
+			Entity:   json.RawMessage(raw),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		notification *notification.Notification
+		portalUser   bool
+		expected     bool
+	}{
+		{
+			name: "targeted at the user",
+			notification: &notification.Notification{
+				Channel:      notification.ChannelUser,
+				TargetUserID: &userID,
+			},
+			expected: true,
+		},
+		{
+			name: "targeted at someone else",
+			notification: &notification.Notification{
+				Channel:      notification.ChannelUser,
+				TargetUserID: &otherUserID,
+			},
+		},
+		{
+			name:         "global",
+			notification: &notification.Notification{Channel: notification.ChannelGlobal},
+			expected:     true,
+		},
+		{
+			name:         "global hidden from portal users",
+			notification: &notification.Notification{Channel: notification.ChannelGlobal},
+			portalUser:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authCtx := &authctx.AuthContext{UserID: userID, IsPortalUser: tt.portalUser}
+			_, ok := r.notificationFromEvent(eventFor(t, tt.notification), authCtx)
+			assert.Equal(t, tt.expected, ok)
+		})
+	}
+}
+
+func TestCoalesceDispatchBoardChanges_BatchesWithinWindow(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	events := make(chan *servicesport.ResourceInvalidationEvent)
+	changes := coalesceDispatchBoardChanges(ctx, events, 50*time.Millisecond)
+
+	for _, event := range []*servicesport.ResourceInvalidationEvent{
+		{Resource: "shipments", EntityID: "shp_1"},
+		{Resource: "shipments", EntityID: "shp_1"},
+		{Resource: "workers", EntityID: "wrk_1"},
+		{Resource: "notifications", EntityID: "ntf_1"},
+		{Resource: "tractors", EntityID: "tr_1"},
+	} {
+		events <- event
+	}
+
+	select {
+	case change := <-changes:
+		assert.Equal(t, []string{"shipments", "workers", "tractors"}, change.Resources)
+		assert.Equal(t, []string{"shp_1"}, change.ShipmentIds)
+		assert.Equal(t, []string{"wrk_1", "tr_1"}, change.RecordIds)
+	case <-time.After(time.Second):
+		t.Fatal("expected a coalesced dispatch board change")
+	}
+
+	close(events)
+	_, open := <-changes
+	assert.False(t, open)
+}
@@ -0,0 +1,321 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/api/graphql/generated"
+	"github.com/emoss08/trenova/internal/api/graphql/gqlmodel"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/webhookservice"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+// CreateWebhookSubscription is the resolver for the createWebhookSubscription field.
+func (r *mutationResolver) CreateWebhookSubscription(ctx context.Context, input gqlmodel.WebhookSubscriptionInput) (*webhookservice.SubscriptionWithSecret, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := webhookSubscriptionFromInput(input, pulid.Nil, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.Create(ctx, entity)
+}
+
+// UpdateWebhookSubscription is the resolver for the updateWebhookSubscription field.
+func (r *mutationResolver) UpdateWebhookSubscription(ctx context.Context, id string, input gqlmodel.WebhookSubscriptionInput) (*webhook.Subscription, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := pulid.MustParse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := webhookSubscriptionFromInput(input, subscriptionID, authCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.Update(ctx, entity, webhookCallerAPIKeyID(authCtx))
+}
+
+// DeleteWebhookSubscription is the resolver for the deleteWebhookSubscription field.
+func (r *mutationResolver) DeleteWebhookSubscription(ctx context.Context, id string) (bool, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpDelete)
+	if err != nil {
+		return false, err
+	}
+
+	subscriptionID, err := pulid.MustParse(id)
+	if err != nil {
+		return false, err
+	}
+
+	if err = r.webhookService.Delete(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         subscriptionID,
+		TenantInfo: tenantInfo(authCtx),
+		APIKeyID:   webhookCallerAPIKeyID(authCtx),
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RotateWebhookSigningSecret is the resolver for the rotateWebhookSigningSecret field.
+func (r *mutationResolver) RotateWebhookSigningSecret(ctx context.Context, id string) (*webhookservice.SubscriptionWithSecret, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := pulid.MustParse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.RotateSecret(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         subscriptionID,
+		TenantInfo: tenantInfo(authCtx),
+		APIKeyID:   webhookCallerAPIKeyID(authCtx),
+	})
+}
+
+// EnableWebhookSubscription is the resolver for the enableWebhookSubscription field.
+func (r *mutationResolver) EnableWebhookSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := pulid.MustParse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.Enable(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         subscriptionID,
+		TenantInfo: tenantInfo(authCtx),
+		APIKeyID:   webhookCallerAPIKeyID(authCtx),
+	})
+}
+
+// DisableWebhookSubscription is the resolver for the disableWebhookSubscription field.
+func (r *mutationResolver) DisableWebhookSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := pulid.MustParse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.Disable(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         subscriptionID,
+		TenantInfo: tenantInfo(authCtx),
+		APIKeyID:   webhookCallerAPIKeyID(authCtx),
+	})
+}
+
+// RedeliverWebhook is the resolver for the redeliverWebhook field.
+func (r *mutationResolver) RedeliverWebhook(ctx context.Context, deliveryID string) (*webhook.Delivery, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := pulid.MustParse(deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.Redeliver(
+		ctx,
+		repositories.GetWebhookDeliveryByIDRequest{
+			ID:         id,
+			TenantInfo: tenantInfo(authCtx),
+		},
+		webhookCallerAPIKeyID(authCtx),
+	)
+}
+
+// WebhookSubscriptions is the resolver for the webhookSubscriptions field.
+func (r *queryResolver) WebhookSubscriptions(ctx context.Context, input gqlmodel.DataTableConnectionInput) (*gqlmodel.WebhookSubscriptionConnection, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	tableInput, err := dataTableConnectionFromGraphQL(&input, tenantInfo(authCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.webhookService.ListConnection(
+		ctx,
+		&repositories.ListWebhookSubscriptionConnectionRequest{
+			Filter:   tableInput.Filter,
+			Cursor:   tableInput.Cursor,
+			APIKeyID: webhookCallerAPIKeyID(authCtx),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookSubscriptionConnectionToModel(result)
+}
+
+// WebhookSubscription is the resolver for the webhookSubscription field.
+func (r *queryResolver) WebhookSubscription(ctx context.Context, id string) (*webhook.Subscription, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := pulid.MustParse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.Get(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         subscriptionID,
+		TenantInfo: tenantInfo(authCtx),
+		APIKeyID:   webhookCallerAPIKeyID(authCtx),
+	})
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, subscriptionID string, input gqlmodel.DataTableConnectionInput) (*gqlmodel.WebhookDeliveryConnection, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := pulid.MustParse(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tableInput, err := dataTableConnectionFromGraphQL(&input, tenantInfo(authCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.webhookService.ListDeliveriesConnection(
+		ctx,
+		&repositories.ListWebhookDeliveryConnectionRequest{
+			Filter:         tableInput.Filter,
+			Cursor:         tableInput.Cursor,
+			SubscriptionID: id,
+		},
+		webhookCallerAPIKeyID(authCtx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookDeliveryConnectionToModel(result)
+}
+
+// WebhookDeliveryAttempts is the resolver for the webhookDeliveryAttempts field.
+func (r *queryResolver) WebhookDeliveryAttempts(ctx context.Context, deliveryID string) ([]*webhook.DeliveryAttempt, error) {
+	authCtx, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpRead)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := pulid.MustParse(deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.webhookService.ListDeliveryAttempts(
+		ctx,
+		repositories.GetWebhookDeliveryByIDRequest{
+			ID:         id,
+			TenantInfo: tenantInfo(authCtx),
+		},
+		webhookCallerAPIKeyID(authCtx),
+	)
+}
+
+// WebhookEventTypes is the resolver for the webhookEventTypes field.
+func (r *queryResolver) WebhookEventTypes(ctx context.Context) ([]string, error) {
+	if _, err := r.requirePermission(ctx, permission.ResourceWebhookSubscription, permission.OpRead); err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return eventTypes, nil
+}
+
+// EventType is the resolver for the eventType field.
+func (r *webhookDeliveryResolver) EventType(ctx context.Context, obj *webhook.Delivery) (string, error) {
+	return string(obj.EventType), nil
+}
+
+// Status is the resolver for the status field.
+func (r *webhookDeliveryResolver) Status(ctx context.Context, obj *webhook.Delivery) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Trigger is the resolver for the trigger field.
+func (r *webhookDeliveryAttemptResolver) Trigger(ctx context.Context, obj *webhook.DeliveryAttempt) (string, error) {
+	return string(obj.Trigger), nil
+}
+
+// EventTypes is the resolver for the eventTypes field.
+func (r *webhookSubscriptionResolver) EventTypes(ctx context.Context, obj *webhook.Subscription) ([]string, error) {
+	eventTypes := make([]string, 0, len(obj.EventTypes))
+	for _, eventType := range obj.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return eventTypes, nil
+}
+
+// Status is the resolver for the status field.
+func (r *webhookSubscriptionResolver) Status(ctx context.Context, obj *webhook.Subscription) (string, error) {
+	return string(obj.Status), nil
+}
+
+// WebhookDelivery returns generated.WebhookDeliveryResolver implementation.
+func (r *Resolver) WebhookDelivery() generated.WebhookDeliveryResolver {
+	return &webhookDeliveryResolver{r}
+}
+
+// WebhookDeliveryAttempt returns generated.WebhookDeliveryAttemptResolver implementation.
+func (r *Resolver) WebhookDeliveryAttempt() generated.WebhookDeliveryAttemptResolver {
+	return &webhookDeliveryAttemptResolver{r}
+}
+
+// WebhookSubscription returns generated.WebhookSubscriptionResolver implementation.
+func (r *Resolver) WebhookSubscription() generated.WebhookSubscriptionResolver {
+	return &webhookSubscriptionResolver{r}
+}
+
+type (
+	webhookDeliveryResolver        struct{ *Resolver }
+	webhookDeliveryAttemptResolver struct{ *Resolver }
+	webhookSubscriptionResolver    struct{ *Resolver }
+)
@@ -0,0 +1,99 @@
+package resolver
+
+import (
+	"github.com/emoss08/trenova/internal/api/graphql/gqlmodel"
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+func webhookSubscriptionConnectionToModel(
+	result *pagination.CursorListResult[*webhook.Subscription],
+) (*gqlmodel.WebhookSubscriptionConnection, error) {
+	page, err := entityCursorConnection(
+		result,
+		func(node *webhook.Subscription, cursor string) *gqlmodel.WebhookSubscriptionEdge {
+			return &gqlmodel.WebhookSubscriptionEdge{
+				Node:   node,
+				Cursor: cursor,
+			}
+		},
+		func(edge *gqlmodel.WebhookSubscriptionEdge) string { return edge.Cursor },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.WebhookSubscriptionConnection{
+		Edges:      page.Edges,
+		PageInfo:   page.PageInfo,
+		TotalCount: page.TotalCount,
+	}, nil
+}
+
+func webhookDeliveryConnectionToModel(
+	result *pagination.CursorListResult[*webhook.Delivery],
+) (*gqlmodel.WebhookDeliveryConnection, error) {
+	page, err := entityCursorConnection(
+		result,
+		func(node *webhook.Delivery, cursor string) *gqlmodel.WebhookDeliveryEdge {
+			return &gqlmodel.WebhookDeliveryEdge{
+				Node:   node,
+				Cursor: cursor,
+			}
+		},
+		func(edge *gqlmodel.WebhookDeliveryEdge) string { return edge.Cursor },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.WebhookDeliveryConnection{
+		Edges:      page.Edges,
+		PageInfo:   page.PageInfo,
+		TotalCount: page.TotalCount,
+	}, nil
+}
+
+// webhookSubscriptionFromInput builds the editable part of a subscription. The
+// service owns status, secrets, and failure tracking, so none come from input.
+func webhookSubscriptionFromInput(
+	input gqlmodel.WebhookSubscriptionInput,
+	id pulid.ID,
+	authCtx *authctx.AuthContext,
+) (*webhook.Subscription, error) {
+	customerID, err := optionalID(input.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]domainevent.Type, 0, len(input.EventTypes))
+	for _, eventType := range input.EventTypes {
+		eventTypes = append(eventTypes, domainevent.Type(eventType))
+	}
+
+	return &webhook.Subscription{
+		ID:             id,
+		OrganizationID: authCtx.OrganizationID,
+		BusinessUnitID: authCtx.BusinessUnitID,
+		Name:           input.Name,
+		Description:    stringValue(input.Description),
+		URL:            input.URL,
+		CustomerID:     customerID,
+		APIKeyID:       webhookCallerAPIKeyID(authCtx),
+		EventTypes:     eventTypes,
+		CreatedByID:    authCtx.UserID,
+		Version:        int64Value(input.Version),
+	}, nil
+}
+
+// webhookCallerAPIKeyID scopes webhook access to the calling API key, so an
+// integrator only ever sees the subscriptions it created.
+func webhookCallerAPIKeyID(authCtx *authctx.AuthContext) pulid.ID {
+	if authCtx.IsAPIKey() {
+		return authCtx.APIKeyID
+	}
+	return pulid.Nil
+}
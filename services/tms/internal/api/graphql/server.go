@@ -5,6 +5,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/emoss08/trenova/internal/api/graphql/generated"
+	"github.com/emoss08/trenova/internal/api/graphql/loaders"
 	"github.com/emoss08/trenova/internal/api/graphql/resolver"
 	"github.com/emoss08/trenova/internal/infrastructure/config"
 	"go.uber.org/fx"
@@ -15,8 +16,10 @@ const complexityLimit = 1000
 type ServerParams struct {
 	fx.In
 
-	Config   *config.Config
-	Resolver *resolver.Resolver
+	Config        *config.Config
+	Resolver      *resolver.Resolver
+	LoaderFactory *loaders.Factory
+	PersistedOps  *PersistedOperationManifest
 }
 
 func NewServer(p ServerParams) *gqlhandler.Server {
@@ -24,8 +27,14 @@ func NewServer(p ServerParams) *gqlhandler.Server {
 		Resolvers:  p.Resolver,
 		Complexity: complexityRoot(),
 	}))
+	srv.AddTransport(newWebsocketTransport(p.Config))
 	srv.AddTransport(transport.POST{})
 	srv.Use(extension.FixedComplexityLimit(complexityLimit))
+	srv.Use(persistedOperations{
+		manifest: p.PersistedOps,
+		enforce:  enforcePersistedOperations(p.Config),
+	})
+	srv.Use(subscriptionLoaders{factory: p.LoaderFactory})
 	if p.Config.App.Debug || p.Config.App.IsDevelopment() || p.Config.App.IsTest() {
 		srv.Use(extension.Introspection{})
 	}
@@ -0,0 +1,154 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/coder/websocket"
+	"github.com/emoss08/trenova/internal/api/graphql/gqlctx"
+	"github.com/emoss08/trenova/internal/api/graphql/loaders"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	subscriptionKeepAliveInterval = 15 * time.Second
+	subscriptionInitTimeout       = 10 * time.Second
+)
+
+// newWebsocketTransport serves subscriptions over graphql-ws. The session
+// cookie authenticates the upgrade, so the handshake is held to the same
+// origins CORS allows.
+func newWebsocketTransport(cfg *config.Config) transport.Websocket {
+	acceptOptions := websocket.AcceptOptions{}
+	for _, origin := range cfg.Server.CORS.AllowedOrigins {
+		origin = strings.TrimSpace(origin)
+		switch origin {
+		case "":
+			continue
+		case "*":
+			acceptOptions.InsecureSkipVerify = true
+		default:
+			acceptOptions.OriginPatterns = append(acceptOptions.OriginPatterns, origin)
+		}
+	}
+
+	return transport.Websocket{
+		Implementation: transport.CoderWebsocketImplementation{
+			AcceptOptions: acceptOptions,
+		},
+		KeepAlivePingInterval: subscriptionKeepAliveInterval,
+		InitTimeout:           subscriptionInitTimeout,
+	}
+}
+
+// subscriptionLoaders gives every subscription event its own loaders. The
+// handler's loaders live as long as the socket, and their caches would serve
+// records as they were when the client connected.
+type subscriptionLoaders struct {
+	factory *loaders.Factory
+}
+
+var (
+	_ graphql.HandlerExtension    = subscriptionLoaders{}
+	_ graphql.ResponseInterceptor = subscriptionLoaders{}
+)
+
+func (subscriptionLoaders) ExtensionName() string {
+	return "SubscriptionLoaders"
+}
+
+func (subscriptionLoaders) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e subscriptionLoaders) InterceptResponse(
+	ctx context.Context,
+	next graphql.ResponseHandler,
+) *graphql.Response {
+	if e.factory == nil || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+
+	authCtx, ok := gqlctx.AuthContext(ctx)
+	if !ok {
+		return next(ctx)
+	}
+
+	return next(loaders.WithLoaders(ctx, e.factory.NewForTenant(pagination.TenantInfo{
+		OrgID:  authCtx.OrganizationID,
+		BuID:   authCtx.BusinessUnitID,
+		UserID: authCtx.UserID,
+	})))
+}
+
+// persistedOperations applies the safelist to operations that never pass
+// through the HTTP body rewrite, which is every operation sent over the
+// socket. For rewritten POSTs it resolves the same query again.
+type persistedOperations struct {
+	manifest *PersistedOperationManifest
+	enforce  bool
+}
+
+var (
+	_ graphql.HandlerExtension          = persistedOperations{}
+	_ graphql.OperationParameterMutator = persistedOperations{}
+)
+
+func (persistedOperations) ExtensionName() string {
+	return "PersistedOperations"
+}
+
+func (persistedOperations) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e persistedOperations) MutateOperationParameters(
+	_ context.Context,
+	params *graphql.RawParams,
+) *gqlerror.Error {
+	hash := persistedHashFromExtensions(params.Extensions)
+	if hash == "" {
+		if e.enforce {
+			return gqlerror.WrapIfUnwrapped(persistedOperationError(
+				"extensions.persistedQuery.sha256Hash",
+				"GraphQL persisted operation hash is required",
+			))
+		}
+		return nil
+	}
+
+	query, ok := e.manifest.Query(hash)
+	if !ok {
+		return gqlerror.WrapIfUnwrapped(persistedOperationError(
+			"extensions.persistedQuery.sha256Hash",
+			"GraphQL persisted operation is not safelisted",
+		))
+	}
+
+	params.Query = query
+	return nil
+}
+
+func persistedHashFromExtensions(extensions map[string]any) string {
+	persistedQuery, ok := extensions["persistedQuery"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	if hash, _ := persistedQuery["sha256Hash"].(string); hash != "" {
+		return hash
+	}
+	hash, _ := persistedQuery["hash"].(string)
+	return hash
+}
@@ -0,0 +1,221 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/emoss08/trenova/internal/api/graphql/generated"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestPersistedOperations_ResolvesSocketOperations(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := LoadPersistedOperationManifest(
+		[]byte(`{"sha256:abc":"subscription Changes { shipmentChanged { eventId } }"}`),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		enforce       bool
+		params        *graphql.RawParams
+		expectedQuery string
+		expectedError string
+	}{
+		{
+			name:    "known hash replaces the query",
+			enforce: true,
+			params: &graphql.RawParams{
+				Query: "subscription Other { notificationReceived { id } }",
+				Extensions: map[string]any{
+					"persistedQuery": map[string]any{"version": 1, "sha256Hash": "sha256:abc"},
+				},
+			},
+			expectedQuery: "subscription Changes { shipmentChanged { eventId } }",
+		},
+		{
+			name:    "legacy hash field",
+			enforce: true,
+			params: &graphql.RawParams{
+				Extensions: map[string]any{
+					"persistedQuery": map[string]any{"hash": "sha256:abc"},
+				},
+			},
+			expectedQuery: "subscription Changes { shipmentChanged { eventId } }",
+		},
+		{
+			name: "unknown hash is rejected",
+			params: &graphql.RawParams{
+				Extensions: map[string]any{
+					"persistedQuery": map[string]any{"sha256Hash": "sha256:missing"},
+				},
+			},
+			expectedError: "GraphQL persisted operation is not safelisted",
+		},
+		{
+			name:    "raw operation rejected when enforced",
+			enforce: true,
+			params: &graphql.RawParams{
+				Query: "subscription Raw { dispatchBoardChanged { resources } }",
+			},
+			expectedError: "GraphQL persisted operation hash is required",
+		},
+		{
+			name: "raw operation allowed when not enforced",
+			params: &graphql.RawParams{
+				Query: "subscription Raw { dispatchBoardChanged { resources } }",
+			},
+			expectedQuery: "subscription Raw { dispatchBoardChanged { resources } }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ext := persistedOperations{manifest: manifest, enforce: tt.enforce}
+			gqlErr := ext.MutateOperationParameters(context.Background(), tt.params)
+
+			if tt.expectedError != "" {
+				require.NotNil(t, gqlErr)
+				assert.Contains(t, gqlErr.Error(), tt.expectedError)
+				return
+			}
+
+			require.Nil(t, gqlErr)
+			assert.Equal(t, tt.expectedQuery, tt.params.Query)
+		})
+	}
+}
+
+func TestPersistedOperations_RunsSubscriptionWhenEnforced(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := LoadPersistedOperationManifest(
+		[]byte(`{"sha256:abc":"subscription Name { name }"}`),
+	)
+	require.NoError(t, err)
+
+	srv := testserver.New()
+	srv.AddTransport(transport.Websocket{})
+	srv.Use(persistedOperations{manifest: manifest, enforce: true})
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(httpSrv.Close)
+
+	conn, _, err := websocket.Dial(
+		t.Context(),
+		"ws"+strings.TrimPrefix(httpSrv.URL, "http"),
+		&websocket.DialOptions{Subprotocols: []string{"graphql-transport-ws"}},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.CloseNow() })
+
+	type message struct {
+		ID      string          `json:"id,omitempty"`
+		Type    string          `json:"type"`
+		Payload json.RawMessage `json:"payload,omitempty"`
+	}
+	read := func() message {
+		var msg message
+		require.NoError(t, wsjson.Read(t.Context(), conn, &msg))
+		return msg
+	}
+
+	require.NoError(t, wsjson.Write(t.Context(), conn, message{Type: "connection_init"}))
+	require.Equal(t, "connection_ack", read().Type)
+
+	require.NoError(t, wsjson.Write(t.Context(), conn, message{
+		ID:      "raw",
+		Type:    "subscribe",
+		Payload: json.RawMessage(`{"query":"subscription Name { name }"}`),
+	}))
+	rejected := read()
+	assert.Equal(t, "raw", rejected.ID)
+	assert.Contains(t, string(rejected.Payload), "GraphQL persisted operation hash is required")
+	require.Equal(t, "complete", read().Type)
+
+	require.NoError(t, wsjson.Write(t.Context(), conn, message{
+		ID:   "persisted",
+		Type: "subscribe",
+		Payload: json.RawMessage(
+			`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"sha256:abc"}}}`,
+		),
+	}))
+	srv.SendNextSubscriptionMessage()
+	next := read()
+	assert.Equal(t, "next", next.Type)
+	assert.Equal(t, "persisted", next.ID)
+	assert.JSONEq(t, `{"data":{"name":"test"}}`, string(next.Payload))
+}
+
+func TestNewPersistedOperationManifest_CoversEverySubscription(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := NewPersistedOperationManifest()
+	require.NoError(t, err)
+
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	covered := make(map[string]bool)
+	for _, hash := range manifest.KnownHashes() {
+		query, _ := manifest.Query(hash)
+		doc, gqlErr := gqlparser.LoadQuery(schema, query)
+		require.Empty(t, gqlErr, "persisted operation %s does not validate", hash)
+		for _, operation := range doc.Operations {
+			if operation.Operation != "subscription" {
+				continue
+			}
+			for _, selection := range operation.SelectionSet {
+				if field, ok := selection.(*ast.Field); ok {
+					covered[field.Name] = true
+				}
+			}
+		}
+	}
+
+	for _, field := range schema.Subscription.Fields {
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		assert.True(t, covered[field.Name], "no persisted subscription selects %s", field.Name)
+	}
+}
+
+func TestNewWebsocketTransport_UsesCORSOrigins(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			CORS: config.CORSConfig{
+				AllowedOrigins: []string{"https://app.trenova.test", " ", "http://localhost:5173"},
+			},
+		},
+	}
+
+	ws := newWebsocketTransport(cfg)
+	impl, ok := ws.Implementation.(transport.CoderWebsocketImplementation)
+	require.True(t, ok)
+	assert.Equal(
+		t,
+		[]string{"https://app.trenova.test", "http://localhost:5173"},
+		impl.AcceptOptions.OriginPatterns,
+	)
+	assert.False(t, impl.AcceptOptions.InsecureSkipVerify)
+
+	cfg.Server.CORS.AllowedOrigins = []string{"*"}
+	impl, ok = newWebsocketTransport(cfg).Implementation.(transport.CoderWebsocketImplementation)
+	require.True(t, ok)
+	assert.True(t, impl.AcceptOptions.InsecureSkipVerify)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/emoss08/trenova/internal/core/services/ediinboundservice"
+	"github.com/emoss08/trenova/internal/core/services/ediservice"
 	"github.com/gin-gonic/gin"
 )
 
@@ -14,6 +15,28 @@ const as2MaxRequestBody = 32 << 20
 
 func (h *Handler) RegisterPublicRoutes(rg *gin.RouterGroup) {
 	rg.POST("/edi/as2/inbound/", h.receiveAS2Message)
+	rg.POST("/edi/as2/mdn/", h.receiveAS2MDN)
+	rg.POST("/edi/https/inbound/:profileID/", h.receiveHTTPSDocument)
+}
+
+// receiveAS2MDN is the Receipt-Delivery-Option target for partners that return
+// MDNs asynchronously. MDNs posted to the inbound endpoint take the same path.
+func (h *Handler) receiveAS2MDN(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, as2MaxRequestBody))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	if err = h.service.ApplyAS2MDN(c.Request.Context(), &ediservice.ApplyAS2MDNRequest{
+		From:        c.GetHeader("AS2-From"),
+		To:          c.GetHeader("AS2-To"),
+		ContentType: c.GetHeader("Content-Type"),
+		Body:        body,
+	}); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
 }
 
 func (h *Handler) receiveAS2Message(c *gin.Context) {
@@ -0,0 +1,105 @@
+package edihandler
+
+import (
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/api/actorutil"
+	"github.com/emoss08/trenova/internal/api/helpers"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/ediservice"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) registerCertificationRoutes(runs *gin.RouterGroup) {
+	runs.GET(
+		"/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.listCertificationRuns,
+	)
+	runs.POST(
+		"/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpCreate),
+		h.startCertificationRun,
+	)
+	runs.GET(
+		"/:runID/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.getCertificationRun,
+	)
+}
+
+func (h *Handler) listCertificationRuns(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req := pagination.NewQueryOptions(c, authCtx)
+	partnerID, _ := pulid.MustParse(helpers.QueryString(c, "ediPartnerId", ""))
+	pagination.List(
+		c,
+		req,
+		h.eh,
+		func() (*pagination.ListResult[*edi.EDICertificationRun], error) {
+			return h.service.ListCertificationRuns(
+				c.Request.Context(),
+				&repositories.ListEDICertificationRunsRequest{
+					Filter:       req,
+					EDIPartnerID: partnerID,
+					Status: edi.CertificationRunStatus(
+						helpers.QueryString(c, "status", ""),
+					),
+				},
+			)
+		},
+	)
+}
+
+func (h *Handler) startCertificationRun(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req := new(ediservice.StartEDICertificationRunRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	req.TenantInfo = pagination.TenantInfo{
+		OrgID:  authCtx.OrganizationID,
+		BuID:   authCtx.BusinessUnitID,
+		UserID: authCtx.UserID,
+	}
+	run, err := h.service.StartCertificationRun(
+		c.Request.Context(),
+		req,
+		actorutil.FromAuthContext(authCtx),
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, run)
+}
+
+func (h *Handler) getCertificationRun(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	runID, err := pulid.MustParse(c.Param("runID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	run, err := h.service.GetCertificationRun(
+		c.Request.Context(),
+		repositories.GetEDICertificationRunByIDRequest{
+			ID: runID,
+			TenantInfo: pagination.TenantInfo{
+				OrgID: authCtx.OrganizationID,
+				BuID:  authCtx.BusinessUnitID,
+			},
+		},
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, run)
+}
@@ -0,0 +1,69 @@
+package edihandler
+
+import (
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/api/helpers"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) listControlNumberLedger(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	partnerID, err := pulid.MustParse(c.Param("partnerID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	req := pagination.NewQueryOptions(c, authCtx)
+	pagination.List(
+		c,
+		req,
+		h.eh,
+		func() (*pagination.ListResult[*edi.EDIControlNumberLedgerEntry], error) {
+			return h.service.ListControlNumberLedger(
+				c.Request.Context(),
+				&repositories.ListEDIControlNumberLedgerRequest{
+					Filter:       req,
+					EDIPartnerID: partnerID,
+					Direction: edi.DocumentDirection(
+						helpers.QueryString(c, "direction", ""),
+					),
+					Kind: edi.ControlNumberKind(helpers.QueryString(c, "kind", "")),
+					Anomaly: edi.ControlNumberAnomaly(
+						helpers.QueryString(c, "anomaly", ""),
+					),
+				},
+			)
+		},
+	)
+}
+
+func (h *Handler) summarizeControlNumberLedger(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	partnerID, err := pulid.MustParse(c.Param("partnerID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	summaries, err := h.service.SummarizeControlNumberLedger(
+		c.Request.Context(),
+		repositories.SummarizeEDIControlNumberLedgerRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID:  authCtx.OrganizationID,
+				BuID:   authCtx.BusinessUnitID,
+				UserID: authCtx.UserID,
+			},
+			EDIPartnerID: partnerID,
+		},
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, summaries)
+}
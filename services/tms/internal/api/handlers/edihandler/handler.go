@@ -57,6 +57,7 @@ type partnerRequest struct {
 	EnabledForInbound       *bool           `json:"enabledForInbound"`
 	EnabledForOutbound      *bool           `json:"enabledForOutbound"`
 	Settings                map[string]any  `json:"settings"`
+	InboundTransformScript  string          `json:"inboundTransformScript"`
 	Version                 int64           `json:"version"`
 }
 
@@ -87,6 +88,8 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	h.registerInboundFileRoutes(api.Group("/inbound-files"))
 	h.registerX12Routes(api.Group("/x12"))
 	h.registerTestCaseRoutes(api.Group("/test-cases"))
+	h.registerImplementationGuideRoutes(api.Group("/implementation-guides"))
+	h.registerCertificationRoutes(api.Group("/certification-runs"))
 	api.POST(
 		"/load-tenders/",
 		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpCreate),
@@ -114,6 +117,21 @@ func (h *Handler) registerPartnerRoutes(partners *gin.RouterGroup) {
 		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
 		h.getPartnerReadiness,
 	)
+	partners.GET(
+		"/:partnerID/control-number-ledger/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.listControlNumberLedger,
+	)
+	partners.GET(
+		"/:partnerID/control-number-ledger/summary/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.summarizeControlNumberLedger,
+	)
+	partners.POST(
+		"/:partnerID/inbound-transform/dry-run/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.dryRunInboundTransform,
+	)
 	partners.GET(
 		"/select-options/",
 		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
@@ -866,6 +884,7 @@ func (r *partnerRequest) toEntity(defaultEnabled bool) *edi.EDIPartner {
 		EnabledForInbound:       enabledForInbound,
 		EnabledForOutbound:      enabledForOutbound,
 		Settings:                r.Settings,
+		InboundTransformScript:  r.InboundTransformScript,
 		Version:                 r.Version,
 	}
 }
@@ -0,0 +1,43 @@
+package edihandler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/core/services/ediinboundservice"
+	"github.com/emoss08/trenova/internal/core/services/editransport"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+)
+
+const httpsMaxRequestBody = 8 << 20
+
+// receiveHTTPSDocument accepts a JSON document from a partner that exchanges
+// tenders and status updates over HTTPS. The document is processed
+// asynchronously, so 202 only confirms it was signed correctly and staged.
+func (h *Handler) receiveHTTPSDocument(c *gin.Context) {
+	profileID, err := pulid.MustParse(c.Param("profileID"))
+	if err != nil {
+		h.eh.HandleError(c, ediinboundservice.ErrHTTPSProfileNotFound)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, httpsMaxRequestBody))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	result, err := h.inboundService.ReceiveHTTPSDocument(
+		c.Request.Context(),
+		&ediinboundservice.ReceiveHTTPSDocumentRequest{
+			ProfileID: profileID,
+			Timestamp: c.GetHeader(editransport.HTTPSHeaderTimestamp),
+			Signature: c.GetHeader(editransport.HTTPSHeaderSignature),
+			Body:      body,
+		},
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, result)
+}
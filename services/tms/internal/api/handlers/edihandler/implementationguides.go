@@ -0,0 +1,195 @@
+package edihandler
+
+import (
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/api/helpers"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/ediservice"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) registerImplementationGuideRoutes(guides *gin.RouterGroup) {
+	guides.GET(
+		"/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.listImplementationGuides,
+	)
+	guides.POST(
+		"/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpCreate),
+		h.createImplementationGuide,
+	)
+	guides.GET(
+		"/:guideID/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpRead),
+		h.getImplementationGuide,
+	)
+	guides.PUT(
+		"/:guideID/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpUpdate),
+		h.updateImplementationGuide,
+	)
+	guides.DELETE(
+		"/:guideID/",
+		h.pm.RequirePermission(permission.ResourceEDI.String(), permission.OpDelete),
+		h.deleteImplementationGuide,
+	)
+}
+
+func (h *Handler) listImplementationGuides(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req := pagination.NewQueryOptions(c, authCtx)
+	partnerID, _ := pulid.MustParse(helpers.QueryString(c, "ediPartnerId", ""))
+	pagination.List(
+		c,
+		req,
+		h.eh,
+		func() (*pagination.ListResult[*edi.EDIImplementationGuide], error) {
+			return h.service.ListImplementationGuides(
+				c.Request.Context(),
+				&repositories.ListEDIImplementationGuidesRequest{
+					Filter:       req,
+					EDIPartnerID: partnerID,
+					TransactionSet: edi.TransactionSet(
+						helpers.QueryString(c, "transactionSet", ""),
+					),
+				},
+			)
+		},
+	)
+}
+
+type implementationGuideRequest struct {
+	EDIPartnerID   pulid.ID                          `json:"ediPartnerId"`
+	Name           string                            `json:"name"`
+	Description    string                            `json:"description"`
+	Direction      edi.DocumentDirection             `json:"direction"`
+	TransactionSet edi.TransactionSet                `json:"transactionSet"`
+	X12Version     string                            `json:"x12Version"`
+	GuideRevision  string                            `json:"guideRevision"`
+	IsActive       bool                              `json:"isActive"`
+	Definition     edi.ImplementationGuideDefinition `json:"definition"`
+	Version        int64                             `json:"version"`
+}
+
+func (r *implementationGuideRequest) toServiceRequest(
+	guideID pulid.ID,
+	tenantInfo pagination.TenantInfo,
+) *ediservice.SaveEDIImplementationGuideRequest {
+	return &ediservice.SaveEDIImplementationGuideRequest{
+		TenantInfo:     tenantInfo,
+		ID:             guideID,
+		EDIPartnerID:   r.EDIPartnerID,
+		Name:           r.Name,
+		Description:    r.Description,
+		Direction:      r.Direction,
+		TransactionSet: r.TransactionSet,
+		X12Version:     r.X12Version,
+		GuideRevision:  r.GuideRevision,
+		IsActive:       r.IsActive,
+		Definition:     r.Definition,
+		Version:        r.Version,
+	}
+}
+
+func (h *Handler) createImplementationGuide(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req := new(implementationGuideRequest)
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	created, err := h.service.CreateImplementationGuide(
+		c.Request.Context(),
+		req.toServiceRequest(pulid.Nil, pagination.TenantInfo{
+			OrgID:  authCtx.OrganizationID,
+			BuID:   authCtx.BusinessUnitID,
+			UserID: authCtx.UserID,
+		}),
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *Handler) getImplementationGuide(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	guideID, err := pulid.MustParse(c.Param("guideID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	guide, err := h.service.GetImplementationGuide(
+		c.Request.Context(),
+		repositories.GetEDIImplementationGuideByIDRequest{
+			ID: guideID,
+			TenantInfo: pagination.TenantInfo{
+				OrgID: authCtx.OrganizationID,
+				BuID:  authCtx.BusinessUnitID,
+			},
+		},
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, guide)
+}
+
+func (h *Handler) updateImplementationGuide(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	guideID, err := pulid.MustParse(c.Param("guideID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	req := new(implementationGuideRequest)
+	if err = c.ShouldBindJSON(req); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	updated, err := h.service.UpdateImplementationGuide(
+		c.Request.Context(),
+		req.toServiceRequest(guideID, pagination.TenantInfo{
+			OrgID:  authCtx.OrganizationID,
+			BuID:   authCtx.BusinessUnitID,
+			UserID: authCtx.UserID,
+		}),
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, updated)
+}
+
+func (h *Handler) deleteImplementationGuide(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	guideID, err := pulid.MustParse(c.Param("guideID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	if err = h.service.DeleteImplementationGuide(
+		c.Request.Context(),
+		repositories.DeleteEDIImplementationGuideRequest{
+			ID: guideID,
+			TenantInfo: pagination.TenantInfo{
+				OrgID: authCtx.OrganizationID,
+				BuID:  authCtx.BusinessUnitID,
+			},
+		},
+	); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,41 @@
+package edihandler
+
+import (
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/core/services/ediinboundservice"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+)
+
+// dryRunInboundTransform runs a sample interchange through the partner's
+// inbound transform, or an unsaved script from the body, and returns each
+// transaction's payload before and after without processing anything.
+func (h *Handler) dryRunInboundTransform(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	partnerID, err := pulid.MustParse(c.Param("partnerID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	req := new(ediinboundservice.DryRunInboundTransformRequest)
+	if err = c.ShouldBindJSON(req); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	req.PartnerID = partnerID
+	req.TenantInfo = pagination.TenantInfo{
+		OrgID:  authCtx.OrganizationID,
+		BuID:   authCtx.BusinessUnitID,
+		UserID: authCtx.UserID,
+	}
+
+	result, err := h.inboundService.DryRunInboundTransform(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,557 @@
+package iftahandler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/emoss08/trenova/internal/api/actorutil"
+	"github.com/emoss08/trenova/internal/api/helpers"
+	"github.com/emoss08/trenova/internal/api/middleware"
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/iftaservice"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+
+	Service              *iftaservice.Service
+	ErrorHandler         *helpers.ErrorHandler
+	PermissionMiddleware *middleware.PermissionMiddleware
+}
+
+type Handler struct {
+	service *iftaservice.Service
+	eh      *helpers.ErrorHandler
+	pm      *middleware.PermissionMiddleware
+}
+
+func New(p Params) *Handler {
+	return &Handler{
+		service: p.Service,
+		eh:      p.ErrorHandler,
+		pm:      p.PermissionMiddleware,
+	}
+}
+
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	resource := permission.ResourceIFTA.String()
+
+	api := rg.Group("/ifta")
+
+	purchases := api.Group("/purchases")
+	purchases.POST("/", h.pm.RequirePermission(resource, permission.OpCreate), h.createPurchase)
+	purchases.POST(
+		"/import/",
+		h.pm.RequirePermission(resource, permission.OpImport),
+		h.importPurchases,
+	)
+	purchases.GET(
+		"/:purchaseID/",
+		h.pm.RequirePermission(resource, permission.OpRead),
+		h.getPurchase,
+	)
+	purchases.PUT(
+		"/:purchaseID/",
+		h.pm.RequirePermission(resource, permission.OpUpdate),
+		h.updatePurchase,
+	)
+	purchases.DELETE(
+		"/:purchaseID/",
+		h.pm.RequirePermission(resource, permission.OpDelete),
+		h.deletePurchase,
+	)
+
+	quarters := api.Group("/quarters/:year/:quarter")
+	quarters.GET(
+		"/purchases/",
+		h.pm.RequirePermission(resource, permission.OpRead),
+		h.listPurchases,
+	)
+	quarters.GET("/mileage/", h.pm.RequirePermission(resource, permission.OpRead), h.mileage)
+	quarters.GET(
+		"/tax-rates/",
+		h.pm.RequirePermission(resource, permission.OpRead),
+		h.listTaxRates,
+	)
+	quarters.PUT(
+		"/tax-rates/",
+		h.pm.RequirePermission(resource, permission.OpUpdate),
+		h.upsertTaxRates,
+	)
+	quarters.GET("/return/", h.pm.RequirePermission(resource, permission.OpRead), h.taxReturn)
+	quarters.GET(
+		"/return/export/",
+		h.pm.RequirePermission(resource, permission.OpExport),
+		h.exportReturn,
+	)
+}
+
+// @Summary List a quarter's fuel purchases
+// @ID listIFTAFuelPurchases
+// @Tags IFTA
+// @Produce json
+// @Param year path int true "Year"
+// @Param quarter path int true "Quarter, 1 to 4"
+// @Param tractorId query string false "Narrow to one tractor's purchases"
+// @Success 200 {array} ifta.FuelPurchase
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/quarters/{year}/{quarter}/purchases/ [get]
+func (h *Handler) listPurchases(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	period, err := h.period(c)
+	if err != nil {
+		return
+	}
+
+	entities, err := h.service.ListPurchases(
+		c.Request.Context(),
+		&iftaservice.ListPurchasesRequest{
+			TenantInfo: actorutil.TenantInfoFrom(authCtx),
+			Period:     period,
+			TractorID:  helpers.QueryPulid(c, "tractorId"),
+		},
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entities)
+}
+
+// @Summary Get a fuel purchase
+// @ID getIFTAFuelPurchase
+// @Tags IFTA
+// @Produce json
+// @Param purchaseID path string true "Fuel purchase ID"
+// @Success 200 {object} ifta.FuelPurchase
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/purchases/{purchaseID}/ [get]
+func (h *Handler) getPurchase(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	purchaseID, err := pulid.MustParse(c.Param("purchaseID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	entity, err := h.service.GetPurchase(
+		c.Request.Context(),
+		&repositories.GetFuelPurchaseByIDRequest{
+			TenantInfo: actorutil.TenantInfoFrom(authCtx),
+			PurchaseID: purchaseID,
+		},
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity)
+}
+
+// @Summary Record a fuel purchase
+// @ID createIFTAFuelPurchase
+// @Tags IFTA
+// @Accept json
+// @Produce json
+// @Param request body ifta.FuelPurchase true "Fuel purchase payload"
+// @Success 201 {object} ifta.FuelPurchase
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 422 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/purchases/ [post]
+func (h *Handler) createPurchase(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	entity := new(ifta.FuelPurchase)
+	if err := c.ShouldBindJSON(entity); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	entity.OrganizationID = authCtx.OrganizationID
+	entity.BusinessUnitID = authCtx.BusinessUnitID
+
+	created, err := h.service.CreatePurchase(c.Request.Context(), entity, authCtx.UserID)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Update a fuel purchase
+// @ID updateIFTAFuelPurchase
+// @Tags IFTA
+// @Accept json
+// @Produce json
+// @Param purchaseID path string true "Fuel purchase ID"
+// @Param request body ifta.FuelPurchase true "Fuel purchase payload"
+// @Success 200 {object} ifta.FuelPurchase
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 409 {object} helpers.ProblemDetail
+// @Failure 422 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/purchases/{purchaseID}/ [put]
+func (h *Handler) updatePurchase(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	purchaseID, err := pulid.MustParse(c.Param("purchaseID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	entity := new(ifta.FuelPurchase)
+	if err = c.ShouldBindJSON(entity); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	entity.ID = purchaseID
+	entity.OrganizationID = authCtx.OrganizationID
+	entity.BusinessUnitID = authCtx.BusinessUnitID
+
+	updated, err := h.service.UpdatePurchase(c.Request.Context(), entity, authCtx.UserID)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// @Summary Delete a fuel purchase
+// @ID deleteIFTAFuelPurchase
+// @Tags IFTA
+// @Param purchaseID path string true "Fuel purchase ID"
+// @Success 204
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/purchases/{purchaseID}/ [delete]
+func (h *Handler) deletePurchase(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	purchaseID, err := pulid.MustParse(c.Param("purchaseID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	if err = h.service.DeletePurchase(
+		c.Request.Context(),
+		&repositories.GetFuelPurchaseByIDRequest{
+			TenantInfo: actorutil.TenantInfoFrom(authCtx),
+			PurchaseID: purchaseID,
+		},
+		authCtx.UserID,
+	); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Import fuel purchases
+// @Description Reads a fuel card CSV export with date, unit, jurisdiction and gallons columns, and optionally fuel type, total cost, vendor, receipt and tax paid. The file is imported whole or not at all, and a receipt already on file for the same unit is skipped.
+// @ID importIFTAFuelPurchases
+// @Tags IFTA
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "The fuel purchase CSV"
+// @Success 201 {object} iftaservice.ImportPurchasesResult
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 422 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/purchases/import/ [post]
+func (h *Handler) importPurchases(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	content, err := readUpload(c)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	result, err := h.service.ImportPurchases(
+		c.Request.Context(),
+		&iftaservice.ImportPurchasesRequest{
+			TenantInfo: actorutil.TenantInfoFrom(authCtx),
+			Content:    content,
+		},
+		authCtx.UserID,
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func readUpload(c *gin.Context) ([]byte, error) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+
+	opened, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = opened.Close() }()
+
+	return io.ReadAll(opened)
+}
+
+// @Summary Attribute a quarter's miles to jurisdictions
+// @Description Returns each tracked tractor's miles per jurisdiction from its position history, with the coverage gaps behind any estimated miles.
+// @ID getIFTAMileage
+// @Tags IFTA
+// @Produce json
+// @Param year path int true "Year"
+// @Param quarter path int true "Quarter, 1 to 4"
+// @Success 200 {array} ifta.VehicleMileage
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/quarters/{year}/{quarter}/mileage/ [get]
+func (h *Handler) mileage(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	period, err := h.period(c)
+	if err != nil {
+		return
+	}
+
+	vehicles, err := h.service.Mileage(c.Request.Context(), &iftaservice.QuarterRequest{
+		TenantInfo: actorutil.TenantInfoFrom(authCtx),
+		Period:     period,
+	})
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, vehicles)
+}
+
+// @Summary List a quarter's tax rates
+// @ID listIFTATaxRates
+// @Tags IFTA
+// @Produce json
+// @Param year path int true "Year"
+// @Param quarter path int true "Quarter, 1 to 4"
+// @Success 200 {array} ifta.TaxRate
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/quarters/{year}/{quarter}/tax-rates/ [get]
+func (h *Handler) listTaxRates(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	period, err := h.period(c)
+	if err != nil {
+		return
+	}
+
+	rates, err := h.service.ListTaxRates(c.Request.Context(), &iftaservice.QuarterRequest{
+		TenantInfo: actorutil.TenantInfoFrom(authCtx),
+		Period:     period,
+	})
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
+
+// @Summary Record a quarter's tax rates
+// @Description Sets the rate and surcharge for each jurisdiction and fuel type in the body, replacing any already on file for the quarter.
+// @ID upsertIFTATaxRates
+// @Tags IFTA
+// @Accept json
+// @Produce json
+// @Param year path int true "Year"
+// @Param quarter path int true "Quarter, 1 to 4"
+// @Param request body []ifta.TaxRate true "Tax rates"
+// @Success 200 {array} ifta.TaxRate
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 422 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/quarters/{year}/{quarter}/tax-rates/ [put]
+func (h *Handler) upsertTaxRates(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	period, err := h.period(c)
+	if err != nil {
+		return
+	}
+
+	rates := make([]*ifta.TaxRate, 0)
+	if err = c.ShouldBindJSON(&rates); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	updated, err := h.service.UpsertTaxRates(
+		c.Request.Context(),
+		&iftaservice.UpsertTaxRatesRequest{
+			TenantInfo: actorutil.TenantInfoFrom(authCtx),
+			Period:     period,
+			Rates:      rates,
+		},
+		authCtx.UserID,
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// @Summary Compute a quarter's IFTA return
+// @Description Computes fleet MPG and each jurisdiction's taxable gallons, tax-paid credit and net tax or credit for one fuel type.
+// @ID getIFTAReturn
+// @Tags IFTA
+// @Produce json
+// @Param year path int true "Year"
+// @Param quarter path int true "Quarter, 1 to 4"
+// @Param fuelType query string false "Diesel or Gasoline; defaults to Diesel"
+// @Success 200 {object} ifta.TaxReturn
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 422 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/quarters/{year}/{quarter}/return/ [get]
+func (h *Handler) taxReturn(c *gin.Context) {
+	req, err := h.returnRequest(c)
+	if err != nil {
+		return
+	}
+
+	result, err := h.service.Return(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Export a quarter's IFTA return
+// @Description Returns the return as a CSV in the jurisdiction schedule layout, followed by the coverage gaps behind any estimated miles.
+// @ID exportIFTAReturn
+// @Tags IFTA
+// @Produce text/csv
+// @Param year path int true "Year"
+// @Param quarter path int true "Quarter, 1 to 4"
+// @Param fuelType query string false "Diesel or Gasoline; defaults to Diesel"
+// @Success 200 {file} file
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 422 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /ifta/quarters/{year}/{quarter}/return/export/ [get]
+func (h *Handler) exportReturn(c *gin.Context) {
+	req, err := h.returnRequest(c)
+	if err != nil {
+		return
+	}
+
+	fileName, content, err := h.service.ExportReturn(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\""+fileName+"\"")
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", content)
+}
+
+func (h *Handler) returnRequest(c *gin.Context) (*iftaservice.ReturnRequest, error) {
+	authCtx := authctx.GetAuthContext(c)
+
+	period, err := h.period(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iftaservice.ReturnRequest{
+		TenantInfo: actorutil.TenantInfoFrom(authCtx),
+		Period:     period,
+		FuelType: ifta.FuelType(
+			helpers.QueryString(c, "fuelType", ifta.FuelTypeDiesel.String()),
+		),
+	}, nil
+}
+
+// period reads the quarter from the path. A segment that is not a number is
+// reported the same way an out-of-range one is, against the field it names.
+func (h *Handler) period(c *gin.Context) (ifta.Quarter, error) {
+	year, yearErr := strconv.Atoi(c.Param("year"))
+	quarter, quarterErr := strconv.Atoi(c.Param("quarter"))
+
+	multiErr := errortypes.NewMultiError()
+	if yearErr != nil {
+		multiErr.Add("year", errortypes.ErrInvalid, "Year must be a number")
+	}
+	if quarterErr != nil {
+		multiErr.Add("quarter", errortypes.ErrInvalid, "Quarter must be a number")
+	}
+	if multiErr.HasErrors() {
+		h.eh.HandleError(c, multiErr)
+		return ifta.Quarter{}, multiErr
+	}
+
+	return ifta.Quarter{Year: year, Quarter: quarter}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/emoss08/trenova/internal/api/helpers"
 	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/infrastructure/realtimehub"
 	"github.com/emoss08/trenova/pkg/authctx"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
@@ -14,17 +15,20 @@ type Params struct {
 	fx.In
 
 	Service      services.RealtimeService
+	Hub          *realtimehub.Hub `optional:"true"`
 	ErrorHandler *helpers.ErrorHandler
 }
 
 type Handler struct {
 	service services.RealtimeService
+	hub     *realtimehub.Hub
 	eh      *helpers.ErrorHandler
 }
 
 func New(p Params) *Handler {
 	return &Handler{
 		service: p.Service,
+		hub:     p.Hub,
 		eh:      p.ErrorHandler,
 	}
 }
@@ -34,6 +38,19 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	api.GET("/token-request/", h.getTokenRequest)
 }
 
+// RegisterPublicRoutes mounts the builtin provider's connection endpoints.
+// They authenticate with the realtime token rather than the session, since a
+// browser cannot attach headers to a WebSocket handshake.
+func (h *Handler) RegisterPublicRoutes(rg *gin.RouterGroup) {
+	if h.hub == nil || !h.hub.Enabled() {
+		return
+	}
+
+	api := rg.Group("/realtime")
+	api.GET("/ws/", h.connectWebSocket)
+	api.GET("/stream/", h.connectStream)
+}
+
 // @Summary Get realtime token
 // @Description Returns a signed realtime access token for the authenticated actor.
 // @ID getRealtimeToken
@@ -61,3 +78,32 @@ func (h *Handler) getTokenRequest(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// @Summary Open realtime WebSocket
+// @Description Upgrades to the builtin realtime provider's WebSocket protocol. Only available when realtime.provider is builtin.
+// @ID connectRealtimeWebSocket
+// @Tags Realtime
+// @Param token query string true "Token from /realtime/token-request/"
+// @Success 101
+// @Failure 401 {string} string
+// @Failure 404 {string} string
+// @Router /realtime/ws/ [get]
+func (h *Handler) connectWebSocket(c *gin.Context) {
+	h.hub.ServeWebSocket(c.Writer, c.Request)
+}
+
+// @Summary Open realtime event stream
+// @Description Streams messages for the given channels as server-sent events. Receive only; presence and publishing need the WebSocket.
+// @ID connectRealtimeStream
+// @Tags Realtime
+// @Produce text/event-stream
+// @Param token query string true "Token from /realtime/token-request/"
+// @Param channel query []string true "Channels to receive" collectionFormat(multi)
+// @Success 200
+// @Failure 401 {string} string
+// @Failure 403 {string} string
+// @Failure 404 {string} string
+// @Router /realtime/stream/ [get]
+func (h *Handler) connectStream(c *gin.Context) {
+	h.hub.ServeStream(c.Writer, c.Request)
+}
@@ -10,7 +10,8 @@ import (
 	"github.com/emoss08/trenova/internal/api/helpers"
 	"github.com/emoss08/trenova/internal/core/services/telematicsservice"
 	"github.com/emoss08/trenova/pkg/errortypes"
-	"github.com/emoss08/trenova/shared/samsara/webhooks"
+	motivewebhooks "github.com/emoss08/trenova/shared/motive/webhooks"
+	samsarawebhooks "github.com/emoss08/trenova/shared/samsara/webhooks"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
 )
@@ -38,10 +39,27 @@ func New(p Params) *Handler {
 
 var webhookProviders = map[string]integration.Type{
 	"samsara": integration.TypeSamsara,
+	"motive":  integration.TypeMotive,
+}
+
+// webhookHeaders names the signature and timestamp headers each provider sends.
+// Motive signs the body alone and sends no timestamp.
+var webhookHeaders = map[integration.Type]struct {
+	signature string
+	timestamp string
+}{
+	integration.TypeSamsara: {
+		signature: samsarawebhooks.HeaderSignature,
+		timestamp: samsarawebhooks.HeaderTimestamp,
+	},
+	integration.TypeMotive: {
+		signature: motivewebhooks.HeaderSignature,
+	},
 }
 
 func (h *Handler) RegisterPublicRoutes(rg *gin.RouterGroup) {
 	rg.POST("/webhooks/samsara/:webhookToken/", h.handleProviderWebhook(integration.TypeSamsara))
+	rg.POST("/webhooks/motive/:webhookToken/", h.handleProviderWebhook(integration.TypeMotive))
 	rg.POST("/webhooks/telematics/:provider/:webhookToken/", h.handleTelematicsWebhook)
 }
 
@@ -68,13 +86,18 @@ func (h *Handler) processWebhook(c *gin.Context, providerType integration.Type)
 		return
 	}
 
-	err = h.service.ProcessWebhook(c.Request.Context(), &telematicsservice.ProcessWebhookRequest{
+	headers := webhookHeaders[providerType]
+	req := &telematicsservice.ProcessWebhookRequest{
 		ProviderType: providerType,
 		Token:        token,
 		Body:         body,
-		Signature:    c.GetHeader(webhooks.HeaderSignature),
-		Timestamp:    c.GetHeader(webhooks.HeaderTimestamp),
-	})
+		Signature:    c.GetHeader(headers.signature),
+	}
+	if headers.timestamp != "" {
+		req.Timestamp = c.GetHeader(headers.timestamp)
+	}
+
+	err = h.service.ProcessWebhook(c.Request.Context(), req)
 	if err != nil {
 		if errortypes.IsError(err) {
 			c.Status(http.StatusUnauthorized)
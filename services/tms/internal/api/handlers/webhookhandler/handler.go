@@ -0,0 +1,485 @@
+package webhookhandler
+
+import (
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/api/helpers"
+	"github.com/emoss08/trenova/internal/api/middleware"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/webhookservice"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+type Params struct {
+	fx.In
+
+	Service              *webhookservice.Service
+	ErrorHandler         *helpers.ErrorHandler
+	PermissionMiddleware *middleware.PermissionMiddleware
+}
+
+// Handler serves webhook subscriptions to both users and API keys. A request
+// made with an API key only sees the subscriptions that key created, so an
+// integrator can manage its own endpoints without seeing anyone else's.
+type Handler struct {
+	service *webhookservice.Service
+	eh      *helpers.ErrorHandler
+	pm      *middleware.PermissionMiddleware
+}
+
+func New(p Params) *Handler {
+	return &Handler{
+		service: p.Service,
+		eh:      p.ErrorHandler,
+		pm:      p.PermissionMiddleware,
+	}
+}
+
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	resource := permission.ResourceWebhookSubscription.String()
+
+	subs := rg.Group("/webhook-subscriptions")
+	subs.GET("/", h.pm.RequirePermission(resource, permission.OpRead), h.list)
+	subs.POST("/", h.pm.RequirePermission(resource, permission.OpCreate), h.create)
+	subs.GET("/:subscriptionID/", h.pm.RequirePermission(resource, permission.OpRead), h.get)
+	subs.PUT("/:subscriptionID/", h.pm.RequirePermission(resource, permission.OpUpdate), h.update)
+	subs.DELETE(
+		"/:subscriptionID/",
+		h.pm.RequirePermission(resource, permission.OpDelete),
+		h.delete,
+	)
+	subs.POST(
+		"/:subscriptionID/rotate-secret/",
+		h.pm.RequirePermission(resource, permission.OpUpdate),
+		h.rotateSecret,
+	)
+	subs.POST(
+		"/:subscriptionID/enable/",
+		h.pm.RequirePermission(resource, permission.OpUpdate),
+		h.enable,
+	)
+	subs.POST(
+		"/:subscriptionID/disable/",
+		h.pm.RequirePermission(resource, permission.OpUpdate),
+		h.disable,
+	)
+	subs.GET(
+		"/:subscriptionID/deliveries/",
+		h.pm.RequirePermission(resource, permission.OpRead),
+		h.listDeliveries,
+	)
+
+	deliveries := rg.Group("/webhook-deliveries")
+	deliveries.GET(
+		"/:deliveryID/attempts/",
+		h.pm.RequirePermission(resource, permission.OpRead),
+		h.listAttempts,
+	)
+	deliveries.POST(
+		"/:deliveryID/redeliver/",
+		h.pm.RequirePermission(resource, permission.OpUpdate),
+		h.redeliver,
+	)
+}
+
+// @Summary List webhook subscriptions
+// @ID listWebhookSubscriptions
+// @Tags Webhooks
+// @Produce json
+// @Param query query string false "Search query"
+// @Param limit query int false "Page size" minimum(1) maximum(100)
+// @Param offset query int false "Page offset" minimum(0)
+// @Success 200 {object} pagination.Response[[]webhook.Subscription]
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/ [get]
+func (h *Handler) list(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req := pagination.NewQueryOptions(c, authCtx)
+
+	pagination.List(c, req, h.eh, func() (*pagination.ListResult[*webhook.Subscription], error) {
+		return h.service.List(c.Request.Context(), &repositories.ListWebhookSubscriptionsRequest{
+			Filter:   req,
+			APIKeyID: callerAPIKeyID(authCtx),
+		})
+	})
+}
+
+// @Summary Create a webhook subscription
+// @Description The response carries the signing secret. It is not shown again.
+// @ID createWebhookSubscription
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param request body webhook.Subscription true "Webhook subscription"
+// @Success 201 {object} webhookservice.SubscriptionWithSecret
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/ [post]
+func (h *Handler) create(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	entity := new(webhook.Subscription)
+	if err := c.ShouldBindJSON(entity); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	entity.ID = pulid.Nil
+	entity.OrganizationID = authCtx.OrganizationID
+	entity.BusinessUnitID = authCtx.BusinessUnitID
+	entity.CreatedByID = authCtx.UserID
+	if authCtx.IsAPIKey() {
+		entity.APIKeyID = authCtx.APIKeyID
+	}
+
+	result, err := h.service.Create(c.Request.Context(), entity)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// @Summary Get a webhook subscription
+// @ID getWebhookSubscription
+// @Tags Webhooks
+// @Produce json
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Success 200 {object} webhook.Subscription
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/ [get]
+func (h *Handler) get(c *gin.Context) {
+	req, ok := h.subscriptionRequest(c)
+	if !ok {
+		return
+	}
+
+	entity, err := h.service.Get(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity)
+}
+
+// @Summary Update a webhook subscription
+// @Description Changes the name, description, URL, customer, and event types.
+// @ID updateWebhookSubscription
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Param request body webhook.Subscription true "Webhook subscription"
+// @Success 200 {object} webhook.Subscription
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 409 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/ [put]
+func (h *Handler) update(c *gin.Context) {
+	req, ok := h.subscriptionRequest(c)
+	if !ok {
+		return
+	}
+
+	entity := new(webhook.Subscription)
+	if err := c.ShouldBindJSON(entity); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+	entity.ID = req.ID
+	entity.OrganizationID = req.TenantInfo.OrgID
+	entity.BusinessUnitID = req.TenantInfo.BuID
+
+	updated, err := h.service.Update(c.Request.Context(), entity, req.APIKeyID)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// @Summary Delete a webhook subscription
+// @ID deleteWebhookSubscription
+// @Tags Webhooks
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Success 204
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/ [delete]
+func (h *Handler) delete(c *gin.Context) {
+	req, ok := h.subscriptionRequest(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), req); err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Rotate a webhook signing secret
+// @Description Issues a new secret. Deliveries are signed with both the new and
+// @Description the previous secret for 24 hours.
+// @ID rotateWebhookSigningSecret
+// @Tags Webhooks
+// @Produce json
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Success 200 {object} webhookservice.SubscriptionWithSecret
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/rotate-secret/ [post]
+func (h *Handler) rotateSecret(c *gin.Context) {
+	req, ok := h.subscriptionRequest(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.RotateSecret(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Enable a webhook subscription
+// @ID enableWebhookSubscription
+// @Tags Webhooks
+// @Produce json
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Success 200 {object} webhook.Subscription
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/enable/ [post]
+func (h *Handler) enable(c *gin.Context) {
+	req, ok := h.subscriptionRequest(c)
+	if !ok {
+		return
+	}
+
+	entity, err := h.service.Enable(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity)
+}
+
+// @Summary Disable a webhook subscription
+// @ID disableWebhookSubscription
+// @Tags Webhooks
+// @Produce json
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Success 200 {object} webhook.Subscription
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/disable/ [post]
+func (h *Handler) disable(c *gin.Context) {
+	req, ok := h.subscriptionRequest(c)
+	if !ok {
+		return
+	}
+
+	entity, err := h.service.Disable(c.Request.Context(), req)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entity)
+}
+
+// @Summary List a subscription's webhook deliveries
+// @ID listWebhookDeliveries
+// @Tags Webhooks
+// @Produce json
+// @Param subscriptionID path string true "Webhook subscription ID"
+// @Param limit query int false "Page size" minimum(1) maximum(100)
+// @Param offset query int false "Page offset" minimum(0)
+// @Success 200 {object} pagination.Response[[]webhook.Delivery]
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-subscriptions/{subscriptionID}/deliveries/ [get]
+func (h *Handler) listDeliveries(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+
+	subscriptionID, err := pulid.MustParse(c.Param("subscriptionID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	req := pagination.NewQueryOptions(c, authCtx)
+	pagination.List(c, req, h.eh, func() (*pagination.ListResult[*webhook.Delivery], error) {
+		return h.service.ListDeliveries(
+			c.Request.Context(),
+			&repositories.ListWebhookDeliveriesRequest{
+				Filter:         req,
+				SubscriptionID: subscriptionID,
+			},
+			callerAPIKeyID(authCtx),
+		)
+	})
+}
+
+// @Summary List a webhook delivery's attempts
+// @ID listWebhookDeliveryAttempts
+// @Tags Webhooks
+// @Produce json
+// @Param deliveryID path string true "Webhook delivery ID"
+// @Success 200 {array} webhook.DeliveryAttempt
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-deliveries/{deliveryID}/attempts/ [get]
+func (h *Handler) listAttempts(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req, ok := h.deliveryRequest(c, authCtx)
+	if !ok {
+		return
+	}
+
+	attempts, err := h.service.ListDeliveryAttempts(
+		c.Request.Context(),
+		req,
+		callerAPIKeyID(authCtx),
+	)
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
+
+// @Summary Redeliver a webhook
+// @Description Sends the delivery once more, even to a disabled subscription.
+// @ID redeliverWebhook
+// @Tags Webhooks
+// @Produce json
+// @Param deliveryID path string true "Webhook delivery ID"
+// @Success 202 {object} webhook.Delivery
+// @Failure 400 {object} helpers.ProblemDetail
+// @Failure 401 {object} helpers.ProblemDetail
+// @Failure 403 {object} helpers.ProblemDetail
+// @Failure 404 {object} helpers.ProblemDetail
+// @Failure 500 {object} helpers.ProblemDetail
+// @Security BearerAuth
+// @Router /webhook-deliveries/{deliveryID}/redeliver/ [post]
+func (h *Handler) redeliver(c *gin.Context) {
+	authCtx := authctx.GetAuthContext(c)
+	req, ok := h.deliveryRequest(c, authCtx)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.service.Redeliver(c.Request.Context(), req, callerAPIKeyID(authCtx))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+func (h *Handler) subscriptionRequest(
+	c *gin.Context,
+) (repositories.GetWebhookSubscriptionByIDRequest, bool) {
+	authCtx := authctx.GetAuthContext(c)
+
+	id, err := pulid.MustParse(c.Param("subscriptionID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return repositories.GetWebhookSubscriptionByIDRequest{}, false
+	}
+
+	return repositories.GetWebhookSubscriptionByIDRequest{
+		ID: id,
+		TenantInfo: pagination.TenantInfo{
+			OrgID:  authCtx.OrganizationID,
+			BuID:   authCtx.BusinessUnitID,
+			UserID: authCtx.UserID,
+		},
+		APIKeyID: callerAPIKeyID(authCtx),
+	}, true
+}
+
+func (h *Handler) deliveryRequest(
+	c *gin.Context,
+	authCtx *authctx.AuthContext,
+) (repositories.GetWebhookDeliveryByIDRequest, bool) {
+	id, err := pulid.MustParse(c.Param("deliveryID"))
+	if err != nil {
+		h.eh.HandleError(c, err)
+		return repositories.GetWebhookDeliveryByIDRequest{}, false
+	}
+
+	return repositories.GetWebhookDeliveryByIDRequest{
+		ID: id,
+		TenantInfo: pagination.TenantInfo{
+			OrgID:  authCtx.OrganizationID,
+			BuID:   authCtx.BusinessUnitID,
+			UserID: authCtx.UserID,
+		},
+	}, true
+}
+
+func callerAPIKeyID(authCtx *authctx.AuthContext) pulid.ID {
+	if authCtx.IsAPIKey() {
+		return authCtx.APIKeyID
+	}
+	return pulid.Nil
+}
@@ -0,0 +1,167 @@
+package webhookhandler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/api/handlers/webhookhandler"
+	"github.com/emoss08/trenova/internal/api/helpers"
+	"github.com/emoss08/trenova/internal/api/middleware"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/encryptionservice"
+	"github.com/emoss08/trenova/internal/core/services/webhookservice"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/authctx"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	sharedtestutil "github.com/emoss08/trenova/shared/testutil"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupWebhookHandler(
+	t *testing.T,
+) (*webhookhandler.Handler, *mocks.MockWebhookSubscriptionRepository) {
+	t.Helper()
+
+	cfg := &config.Config{
+		App: config.AppConfig{Debug: true},
+		Security: config.SecurityConfig{
+			Encryption: config.EncryptionConfig{
+				Key: "unit-test-encryption-key-with-at-least-32-bytes",
+			},
+		},
+	}
+	errorHandler := helpers.NewErrorHandler(helpers.ErrorHandlerParams{
+		Logger: zap.NewNop(),
+		Config: cfg,
+	})
+	pm := middleware.NewPermissionMiddleware(middleware.PermissionMiddlewareParams{
+		PermissionEngine: &mocks.AllowAllPermissionEngine{},
+		ErrorHandler:     errorHandler,
+	})
+	subscriptionRepo := mocks.NewMockWebhookSubscriptionRepository(t)
+	svc := webhookservice.New(webhookservice.Params{
+		Logger:           zap.NewNop(),
+		SubscriptionRepo: subscriptionRepo,
+		DeliveryRepo:     mocks.NewMockWebhookDeliveryRepository(t),
+		Encryption:       encryptionservice.New(encryptionservice.Params{Config: cfg}),
+		WorkflowStarter:  mocks.NewMockWorkflowStarter(t),
+	})
+
+	return webhookhandler.New(webhookhandler.Params{
+		Service:              svc,
+		ErrorHandler:         errorHandler,
+		PermissionMiddleware: pm,
+	}), subscriptionRepo
+}
+
+func withAPIKeyAuth(
+	g *sharedtestutil.GinTestContext,
+	apiKeyID pulid.ID,
+) *sharedtestutil.GinTestContext {
+	authctx.SetAPIKeyContext(g.Context, apiKeyID, sharedtestutil.TestBuID, sharedtestutil.TestOrgID)
+	g.Engine.Use(func(c *gin.Context) {
+		authctx.SetAPIKeyContext(c, apiKeyID, sharedtestutil.TestBuID, sharedtestutil.TestOrgID)
+		c.Next()
+	})
+	return g
+}
+
+func TestWebhookHandlerListScopesAPIKeyCallers(t *testing.T) {
+	t.Parallel()
+
+	h, repo := setupWebhookHandler(t)
+	apiKeyID := pulid.MustNew("ak_")
+	repo.EXPECT().
+		List(mock.Anything, mock.MatchedBy(func(req *repositories.ListWebhookSubscriptionsRequest) bool {
+			return req.APIKeyID == apiKeyID &&
+				req.Filter.TenantInfo.OrgID == sharedtestutil.TestOrgID
+		})).
+		Return(&pagination.ListResult[*webhook.Subscription]{}, nil).
+		Once()
+
+	ginCtx := withAPIKeyAuth(
+		sharedtestutil.NewGinTestContext().
+			WithMethod(http.MethodGet).
+			WithPath("/api/v1/webhook-subscriptions/"),
+		apiKeyID,
+	)
+
+	h.RegisterRoutes(ginCtx.Engine.Group("/api/v1"))
+	ginCtx.Engine.ServeHTTP(ginCtx.Recorder, ginCtx.Context.Request)
+
+	assert.Equal(t, http.StatusOK, ginCtx.ResponseCode())
+}
+
+func TestWebhookHandlerCreateReturnsSecretOnce(t *testing.T) {
+	t.Parallel()
+
+	h, repo := setupWebhookHandler(t)
+	apiKeyID := pulid.MustNew("ak_")
+	repo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(entity *webhook.Subscription) bool {
+			return entity.APIKeyID == apiKeyID &&
+				entity.OrganizationID == sharedtestutil.TestOrgID &&
+				entity.BusinessUnitID == sharedtestutil.TestBuID &&
+				entity.Status == webhook.StatusActive
+		})).
+		RunAndReturn(func(_ context.Context, entity *webhook.Subscription) (*webhook.Subscription, error) {
+			return entity, nil
+		}).
+		Once()
+
+	ginCtx := withAPIKeyAuth(
+		sharedtestutil.NewGinTestContext().
+			WithMethod(http.MethodPost).
+			WithPath("/api/v1/webhook-subscriptions/").
+			WithJSONBody(map[string]any{
+				"name":           "Status feed",
+				"url":            "https://hooks.example.com/trenova",
+				"eventTypes":     []string{"shipment.status_changed"},
+				"organizationId": pulid.MustNew("org_").String(),
+				"apiKeyId":       pulid.MustNew("ak_").String(),
+				"status":         "Disabled",
+			}),
+		apiKeyID,
+	)
+
+	h.RegisterRoutes(ginCtx.Engine.Group("/api/v1"))
+	ginCtx.Engine.ServeHTTP(ginCtx.Recorder, ginCtx.Context.Request)
+
+	require.Equal(t, http.StatusCreated, ginCtx.ResponseCode(), string(ginCtx.ResponseBody()))
+	var resp map[string]any
+	require.NoError(t, ginCtx.ResponseJSON(&resp))
+	assert.Contains(t, resp["signingSecret"], "whsec_")
+	subscription, ok := resp["subscription"].(map[string]any)
+	require.True(t, ok)
+	assert.NotContains(t, subscription, "signingSecret")
+}
+
+func TestWebhookHandlerCreateRejectsPrivateEndpoint(t *testing.T) {
+	t.Parallel()
+
+	h, _ := setupWebhookHandler(t)
+
+	ginCtx := sharedtestutil.NewGinTestContext().
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/webhook-subscriptions/").
+		WithJSONBody(map[string]any{
+			"name":       "Internal",
+			"url":        "https://169.254.169.254/latest",
+			"customerId": pulid.MustNew("cus_").String(),
+			"eventTypes": []string{"shipment.status_changed"},
+		}).
+		WithDefaultAuthContext()
+
+	h.RegisterRoutes(ginCtx.Engine.Group("/api/v1"))
+	ginCtx.Engine.ServeHTTP(ginCtx.Recorder, ginCtx.Context.Request)
+
+	assert.Equal(t, http.StatusBadRequest, ginCtx.ResponseCode())
+}
@@ -64,6 +64,7 @@ import (
 	"github.com/emoss08/trenova/internal/api/handlers/hazmatsegregationrulehandler"
 	"github.com/emoss08/trenova/internal/api/handlers/holdreasonhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/iamhandler"
+	"github.com/emoss08/trenova/internal/api/handlers/iftahandler"
 	"github.com/emoss08/trenova/internal/api/handlers/integrationhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/invoiceadjustmentcontrolhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/invoiceadjustmenthandler"
@@ -116,6 +117,7 @@ import (
 	"github.com/emoss08/trenova/internal/api/handlers/usstatehandler"
 	"github.com/emoss08/trenova/internal/api/handlers/versionhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/weatheralerthandler"
+	"github.com/emoss08/trenova/internal/api/handlers/webhookhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/workerhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/workerptohandler"
 	"github.com/emoss08/trenova/internal/api/helpers"
@@ -193,6 +195,7 @@ type RouterParams struct {
 	ShipmentMoveHandler             *shipmentmovehandler.Handler
 	ShipmentHandler                 *shipmenthandler.Handler
 	PermitHandler                   *permithandler.Handler
+	IFTAHandler                     *iftahandler.Handler
 	JurisdictionRuleHandler         *jurisdictionrulehandler.Handler
 	ShipmentEventHandler            *shipmenteventhandler.Handler
 	ShipmentTypeHandler             *shipmenttypehandler.Handler
@@ -256,6 +259,7 @@ type RouterParams struct {
 	AnalyticsHandler                *analyticshandler.Handler
 	ApiKeyHandler                   *apikeyhandler.Handler //nolint:revive // field name follows existing router wiring
 	TableChangeAlertHandler         *tablechangealerthandler.Handler
+	WebhookHandler                  *webhookhandler.Handler
 	DocumentPacketRuleHandler       *documentpacketrulehandler.Handler
 	DocumentTemplateHandler         *documenttemplatehandler.Handler
 }
@@ -291,6 +295,7 @@ type Router struct {
 	shipmentMoveHandler             *shipmentmovehandler.Handler
 	shipmentHandler                 *shipmenthandler.Handler
 	permitHandler                   *permithandler.Handler
+	iftaHandler                     *iftahandler.Handler
 	jurisdictionRuleHandler         *jurisdictionrulehandler.Handler
 	shipmentEventHandler            *shipmenteventhandler.Handler
 	equipmentManufacturerHandler    *equipmentmanufacturerhandler.Handler
@@ -381,6 +386,7 @@ type Router struct {
 	analyticsHandler                *analyticshandler.Handler
 	apiKeyHandler                   *apikeyhandler.Handler
 	tableChangeAlertHandler         *tablechangealerthandler.Handler
+	webhookHandler                  *webhookhandler.Handler
 	documentPacketRuleHandler       *documentpacketrulehandler.Handler
 	documentTemplateHandler         *documenttemplatehandler.Handler
 }
@@ -418,6 +424,7 @@ func NewRouter(p RouterParams) *Router {
 		shipmentMoveHandler:             p.ShipmentMoveHandler,
 		shipmentHandler:                 p.ShipmentHandler,
 		permitHandler:                   p.PermitHandler,
+		iftaHandler:                     p.IFTAHandler,
 		jurisdictionRuleHandler:         p.JurisdictionRuleHandler,
 		shipmentEventHandler:            p.ShipmentEventHandler,
 		equipmentManufacturerHandler:    p.EquipmentManufacturerHandler,
@@ -508,6 +515,7 @@ func NewRouter(p RouterParams) *Router {
 		analyticsHandler:                p.AnalyticsHandler,
 		apiKeyHandler:                   p.ApiKeyHandler,
 		tableChangeAlertHandler:         p.TableChangeAlertHandler,
+		webhookHandler:                  p.WebhookHandler,
 		documentPacketRuleHandler:       p.DocumentPacketRuleHandler,
 		documentTemplateHandler:         p.DocumentTemplateHandler,
 	}
@@ -556,6 +564,7 @@ func (r *Router) setupMiddleware() {
 			gzip.WithExcludedPaths([]string{"/metrics", "/health"}),
 			gzip.WithExcludedPathsRegexs([]string{
 				`^/api/v1/documents/[^/]+/(download|view|preview)/$`,
+				`^/api/v1/realtime/stream/$`,
 			}),
 		),
 	)
@@ -592,6 +601,7 @@ func (r *Router) setupPublicRoutes(rg *gin.RouterGroup) {
 	r.ediHandler.RegisterPublicRoutes(rg)
 	r.tenderPublicHandler.RegisterPublicRoutes(rg)
 	r.rateConfirmationPublicHandler.RegisterPublicRoutes(rg)
+	r.realtimeHandler.RegisterPublicRoutes(rg)
 }
 
 //nolint:funlen // existing workflow or route registration is intentionally kept together
@@ -641,6 +651,7 @@ func (r *Router) setupProtectedRoutes(rg *gin.RouterGroup) {
 	r.shipmentMoveHandler.RegisterRoutes(protected)
 	r.shipmentHandler.RegisterRoutes(protected)
 	r.permitHandler.RegisterRoutes(protected)
+	r.iftaHandler.RegisterRoutes(protected)
 	r.jurisdictionRuleHandler.RegisterRoutes(protected)
 	r.shipmentEventHandler.RegisterRoutes(protected)
 	r.shipmentTypeHandler.RegisterRoutes(protected)
@@ -702,6 +713,7 @@ func (r *Router) setupProtectedRoutes(rg *gin.RouterGroup) {
 	r.analyticsHandler.RegisterRoutes(protected)
 	r.apiKeyHandler.RegisterRoutes(protected)
 	r.tableChangeAlertHandler.RegisterRoutes(protected)
+	r.webhookHandler.RegisterRoutes(protected)
 	r.documentPacketRuleHandler.RegisterRoutes(protected)
 	r.documentTemplateHandler.RegisterRoutes(protected)
 }
@@ -42,6 +42,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/temporaljobs/tenderjobs"
 	"github.com/emoss08/trenova/internal/core/temporaljobs/thumbnailjobs"
 	"github.com/emoss08/trenova/internal/core/temporaljobs/weatheralertjobs"
+	"github.com/emoss08/trenova/internal/core/temporaljobs/webhookjobs"
 	"github.com/emoss08/trenova/internal/infrastructure/agentcompletion/anthropiccompletionservice"
 	"github.com/emoss08/trenova/internal/infrastructure/config"
 	reportingexecutor "github.com/emoss08/trenova/internal/infrastructure/reporting/executor"
@@ -101,6 +102,7 @@ func Options() fx.Option {
 		compliancejobs.Module,
 		dispatchjobs.Module,
 		weatheralertjobs.Module,
+		webhookjobs.Module,
 		fiscaljobs.Module,
 		invoiceadjustmentjobs.Module,
 		reportjobs.Module,
@@ -136,6 +138,7 @@ func APIOptions() fx.Option {
 		modulesinfra.PDFRenderModule,
 		modulesinfra.TemplatingModule,
 		modulesinfra.FoonyClientModule,
+		modulesinfra.RealtimeHubModule,
 		modulesinfra.MeilisearchClientModule,
 	)
 }
@@ -144,6 +147,7 @@ func WorkerOptions() fx.Option {
 	return fx.Options(
 		modulesinfra.StorageModule,
 		modulesinfra.FoonyClientModule,
+		modulesinfra.RealtimeHubModule,
 		modulesinfra.MeilisearchClientModule,
 		modulesinfra.SMSModule,
 		modulesinfra.PDFRenderModule,
@@ -61,6 +61,7 @@ import (
 	"github.com/emoss08/trenova/internal/api/handlers/hazmatsegregationrulehandler"
 	"github.com/emoss08/trenova/internal/api/handlers/holdreasonhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/iamhandler"
+	"github.com/emoss08/trenova/internal/api/handlers/iftahandler"
 	"github.com/emoss08/trenova/internal/api/handlers/integrationhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/invoiceadjustmentcontrolhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/invoiceadjustmenthandler"
@@ -113,6 +114,7 @@ import (
 	"github.com/emoss08/trenova/internal/api/handlers/usstatehandler"
 	"github.com/emoss08/trenova/internal/api/handlers/versionhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/weatheralerthandler"
+	"github.com/emoss08/trenova/internal/api/handlers/webhookhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/workerhandler"
 	"github.com/emoss08/trenova/internal/api/handlers/workerptohandler"
 	"go.uber.org/fx"
@@ -173,6 +175,7 @@ var HandlersModule = fx.Module("api-handlers", fx.Provide(
 	shipmentmovehandler.New,
 	shipmenthandler.New,
 	permithandler.New,
+	iftahandler.New,
 	jurisdictionrulehandler.New,
 	shipmenttypehandler.New,
 	hazardousmaterialhandler.New,
@@ -232,4 +235,5 @@ var HandlersModule = fx.Module("api-handlers", fx.Provide(
 	analyticshandler.New,
 	apikeyhandler.New,
 	tablechangealerthandler.New,
+	webhookhandler.New,
 ))
@@ -45,6 +45,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
 	"github.com/emoss08/trenova/internal/core/services/dispatchconsoleservice"
 	"github.com/emoss08/trenova/internal/core/services/dispatchcontrolservice"
+	"github.com/emoss08/trenova/internal/core/services/dispatchrelayservice"
 	"github.com/emoss08/trenova/internal/core/services/distancecalculationservice"
 	"github.com/emoss08/trenova/internal/core/services/distancecontrolservice"
 	"github.com/emoss08/trenova/internal/core/services/distanceoverrideservice"
@@ -84,6 +85,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/services/holdreasonservice"
 	"github.com/emoss08/trenova/internal/core/services/homelayoutservice"
 	"github.com/emoss08/trenova/internal/core/services/iamservice"
+	"github.com/emoss08/trenova/internal/core/services/iftaservice"
 	"github.com/emoss08/trenova/internal/core/services/internaledistatussync"
 	"github.com/emoss08/trenova/internal/core/services/invoiceadjustmentcontrolservice"
 	"github.com/emoss08/trenova/internal/core/services/invoiceadjustmentservice"
@@ -147,6 +149,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/services/usstateservice"
 	"github.com/emoss08/trenova/internal/core/services/versionservice"
 	"github.com/emoss08/trenova/internal/core/services/weatheralertservice"
+	"github.com/emoss08/trenova/internal/core/services/webhookservice"
 	"github.com/emoss08/trenova/internal/core/services/webpushservice"
 	"github.com/emoss08/trenova/internal/core/services/workerptoservice"
 	"github.com/emoss08/trenova/internal/core/services/workerservice"
@@ -217,6 +220,7 @@ var ServiceModule = fx.Module("api-services", fx.Provide(
 	customfieldservice.NewValuesService,
 	databasesessionservice.New,
 	realtimeservice.New,
+	realtimeservice.NewEventSource,
 	globalsearchservice.New,
 	thumbnailservice.NewGenerator,
 	documentintelligenceservice.New,
@@ -242,6 +246,10 @@ var ServiceModule = fx.Module("api-services", fx.Provide(
 		dispatchconsoleservice.New,
 		fx.As(new(services.DispatchConsoleService)),
 	),
+	fx.Annotate(
+		dispatchrelayservice.New,
+		fx.As(new(services.DispatchRelayService)),
+	),
 	fx.Annotate(
 		bankreceiptbatchservice.New,
 		fx.As(new(services.BankReceiptBatchService)),
@@ -370,6 +378,7 @@ var ServiceModule = fx.Module("api-services", fx.Provide(
 	func(s *ratesimulationservice.Service) services.RateSimulationRunner { return s },
 	modeprofileservice.NewService,
 	permitservice.NewService,
+	iftaservice.New,
 	jurisdictionruleservice.NewService,
 	detentionservice.New,
 	invoiceadjustmentcontrolservice.New,
@@ -435,6 +444,8 @@ var ServiceModule = fx.Module("api-services", fx.Provide(
 	shipmentimportassistantservice.New,
 	tablechangealertservice.New,
 	tablechangealertservice.NewConsumer,
+	webhookservice.New,
+	webhookservice.NewDispatcher,
 	fx.Annotate(
 		apikeyservice.NewUsageBufferWithLifecycle,
 		fx.As(new(services.UsageRecorder)),
@@ -462,4 +473,5 @@ var ServiceModule = fx.Module("api-services", fx.Provide(
 		fx.ParamTags(``, `group:"shipment_mutation_observers"`),
 	),
 	func(*controlplane.HeartbeatReporter) {},
+	func(*webhookservice.Dispatcher) {},
 ))
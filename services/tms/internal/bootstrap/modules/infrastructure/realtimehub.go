@@ -0,0 +1,8 @@
+package infrastructure
+
+import (
+	"github.com/emoss08/trenova/internal/infrastructure/realtimehub"
+	"go.uber.org/fx"
+)
+
+var RealtimeHubModule = fx.Module("realtime-hub", fx.Provide(realtimehub.New))
@@ -58,15 +58,19 @@ import (
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/documenttemplaterepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/documenttyperepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/documentuploadrepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/domaineventrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/dothazmatreferencerepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/driverpayrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/driverportalrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/driversettlementrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edicarrierinvoicerepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edicertificationrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edicommunicationprofilerepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/ediconnectionrepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edicontrolnumberledgerrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edicontrolnumberrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edidocumenttyperepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/ediimplementationguiderepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/ediinboundfilerepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edimappingprofilerepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/edimessagerepository"
@@ -99,6 +103,7 @@ import (
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/holdreasonrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/homelayoutrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/iamrepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/iftarepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/integrationrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/invoiceadjustmentcontrolrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/invoiceadjustmentrepository"
@@ -161,6 +166,7 @@ import (
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/userrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/usstaterepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/weatheralertrepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/webhookrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/workerptorepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/workerrepository"
 	"github.com/emoss08/trenova/pkg/seqgen"
@@ -222,6 +228,7 @@ var PostgresRepositoryModule = fx.Module("postgres-repositories", fx.Provide(
 	documentsearchprojectionrepository.New,
 	documentshipmentdraftrepository.New,
 	documentuploadrepository.New,
+	domaineventrepository.New,
 	accessorialchargerepository.New,
 	agentrunrepository.New,
 	agentproposalrepository.New,
@@ -270,6 +277,9 @@ var PostgresRepositoryModule = fx.Module("postgres-repositories", fx.Provide(
 	edipartnerdocumentprofilerepository.New,
 	edicontrolnumberrepository.New,
 	edimessagerepository.New,
+	ediimplementationguiderepository.New,
+	edicertificationrepository.New,
+	edicontrolnumberledgerrepository.New,
 	editestcaserepository.New,
 	ediinboundfilerepository.New,
 	edicarrierinvoicerepository.New,
@@ -339,6 +349,7 @@ var PostgresRepositoryModule = fx.Module("postgres-repositories", fx.Provide(
 	exchangeraterepository.New,
 	permitrepository.New,
 	permitrepository.NewJurisdictionRuleRepository,
+	iftarepository.New,
 	modeprofilerepository.NewProfileRepository,
 	modeprofilerepository.NewDeviationRepository,
 	detentionrepository.NewPolicyRepository,
@@ -357,6 +368,8 @@ var PostgresRepositoryModule = fx.Module("postgres-repositories", fx.Provide(
 	fuelsurchargerepository.NewPriceRepository,
 	fuelsurchargerepository.NewProgramRepository,
 	tcasubscriptionrepository.New,
+	webhookrepository.NewSubscriptionRepository,
+	webhookrepository.NewDeliveryRepository,
 	tcaallowlistrepository.New,
 	notificationrepository.New,
 	ailogrepository.New,
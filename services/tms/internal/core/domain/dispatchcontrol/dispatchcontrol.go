@@ -23,6 +23,8 @@ const (
 
 	DefaultHorizonSearchIterations = int16(25)
 	MaxHorizonSearchIterations     = int16(500)
+
+	MaxHorizonDaysOut = int16(30)
 )
 
 var defaultAutoAssignConfidenceThreshold = decimal.NewFromFloat(0.85)
@@ -59,6 +61,7 @@ type DispatchControl struct {
 	PlanningMode                         PlanningMode               `json:"planningMode"                         bun:"planning_mode,type:dispatch_planning_mode_enum,notnull,default:'Immediate'"`
 	HorizonMaxMovesPerDriver             int16                      `json:"horizonMaxMovesPerDriver"             bun:"horizon_max_moves_per_driver,type:SMALLINT,notnull,default:3"`
 	HorizonSearchIterations              *int16                     `json:"horizonSearchIterations"              bun:"horizon_search_iterations,type:SMALLINT,nullzero"`
+	HorizonMaxDaysOut                    *int16                     `json:"horizonMaxDaysOut"                    bun:"horizon_max_days_out,type:SMALLINT,nullzero"`
 	ComplianceEnforcementLevel           ComplianceEnforcementLevel `json:"complianceEnforcementLevel"           bun:"compliance_enforcement_level,type:compliance_enforcement_level_enum,notnull,default:'Warning'"`
 	RecordServiceFailures                ServiceIncidentType        `json:"recordServiceFailures"                bun:"record_service_failures,type:service_incident_type_enum,notnull,default:'Never'"`
 	ServiceFailureTarget                 *float64                   `json:"serviceFailureTarget"                 bun:"service_failure_target,type:FLOAT,nullzero"`
@@ -116,6 +119,24 @@ func (dc *DispatchControl) HorizonSearchRounds() int {
 	return min(int(*dc.HorizonSearchIterations), int(MaxHorizonSearchIterations))
 }
 
+// HomeTimeWindowSeconds is how long a horizon tour may keep a driver away from home,
+// measured from the tour start. A driver's own days-out setting wins over the
+// organization default; false means neither sets a home-time rule.
+func (dc *DispatchControl) HomeTimeWindowSeconds(driverMaxDaysOut *int16) (int64, bool) {
+	daysOut := driverMaxDaysOut
+	if daysOut == nil || *daysOut <= 0 {
+		if dc == nil {
+			return 0, false
+		}
+		daysOut = dc.HorizonMaxDaysOut
+	}
+	if daysOut == nil || *daysOut <= 0 {
+		return 0, false
+	}
+
+	return int64(min(*daysOut, MaxHorizonDaysOut)) * 86400, true
+}
+
 func (dc *DispatchControl) ConfidenceThreshold() decimal.Decimal {
 	if dc == nil || dc.AutoAssignConfidenceThreshold.IsZero() {
 		return defaultAutoAssignConfidenceThreshold
@@ -188,6 +209,14 @@ func (dc *DispatchControl) Validate(multiErr *errortypes.MultiError) {
 				"Horizon search iterations must be 500 or fewer",
 			),
 		),
+		validation.Field(&dc.HorizonMaxDaysOut,
+			validation.Min(int16(1)).Error(
+				"Horizon max days out must be greater than 0",
+			),
+			validation.Max(MaxHorizonDaysOut).Error(
+				"Horizon max days out must be 30 or fewer",
+			),
+		),
 		validation.Field(&dc.HorizonMaxMovesPerDriver,
 			validation.Min(int16(1)).Error(
 				"Horizon max moves per driver must be greater than 0",
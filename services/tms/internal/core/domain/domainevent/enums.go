@@ -0,0 +1,58 @@
+package domainevent
+
+type Type string
+
+// Event types are past-tense facts named <aggregate>.<fact>. A type is never
+// renamed once consumers subscribe to it; an incompatible payload change ships
+// as a new version of the same type instead.
+const (
+	TypeShipmentStatusChanged     = Type("shipment.status_changed")
+	TypeShipmentETAChanged        = Type("shipment.eta_changed")
+	TypeShipmentPODAvailable      = Type("shipment.pod_available")
+	TypeTenderAccepted            = Type("tender.accepted")
+	TypeInvoicePosted             = Type("invoice.posted")
+	TypeCarrierSettlementApproved = Type("carrier_settlement.approved")
+	TypeDriverSettlementApproved  = Type("driver_settlement.approved")
+)
+
+type AggregateType string
+
+const (
+	AggregateShipment          = AggregateType("shipment")
+	AggregateTender            = AggregateType("tender")
+	AggregateInvoice           = AggregateType("invoice")
+	AggregateCarrierSettlement = AggregateType("carrier_settlement")
+	AggregateDriverSettlement  = AggregateType("driver_settlement")
+)
+
+var AllTypes = []Type{
+	TypeShipmentStatusChanged,
+	TypeShipmentETAChanged,
+	TypeShipmentPODAvailable,
+	TypeTenderAccepted,
+	TypeInvoicePosted,
+	TypeCarrierSettlementApproved,
+	TypeDriverSettlementApproved,
+}
+
+func (v Type) IsValid() bool {
+	for _, t := range AllTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (v AggregateType) IsValid() bool {
+	switch v {
+	case AggregateShipment,
+		AggregateTender,
+		AggregateInvoice,
+		AggregateCarrierSettlement,
+		AggregateDriverSettlement:
+		return true
+	default:
+		return false
+	}
+}
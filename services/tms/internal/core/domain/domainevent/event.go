@@ -0,0 +1,89 @@
+package domainevent
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/pkg/domainvalidation"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/uptrace/bun"
+)
+
+var _ bun.BeforeAppendModelHook = (*Event)(nil)
+
+// Event is a row in the transactional outbox. It is written in the same
+// transaction as the change it describes, so an event exists exactly when the
+// change committed; GTC relays new rows to the tms:events Redis stream.
+type Event struct {
+	bun.BaseModel `bun:"table:domain_event_outbox,alias:deo"`
+
+	ID             pulid.ID      `json:"id"             bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID pulid.ID      `json:"businessUnitId" bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	OrganizationID pulid.ID      `json:"organizationId" bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+	Type           Type          `json:"eventType"      bun:"event_type,type:VARCHAR(100),notnull"`
+	Version        int           `json:"eventVersion"   bun:"event_version,type:INTEGER,notnull,default:1"`
+	AggregateType  AggregateType `json:"aggregateType"  bun:"aggregate_type,type:VARCHAR(100),notnull"`
+	AggregateID    pulid.ID      `json:"aggregateId"    bun:"aggregate_id,type:VARCHAR(100),notnull"`
+	IdempotencyKey string        `json:"idempotencyKey" bun:"idempotency_key,type:VARCHAR(255),notnull"`
+	Payload        any           `json:"payload"        bun:"payload,type:JSONB,notnull"`
+	OccurredAt     int64         `json:"occurredAt"     bun:"occurred_at,type:BIGINT,notnull"`
+	CreatedAt      int64         `json:"createdAt"      bun:"created_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+}
+
+func (e *Event) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); ok {
+		if e.ID.IsNil() {
+			e.ID = pulid.MustNew("devt_")
+		}
+		if e.OccurredAt == 0 {
+			e.OccurredAt = timeutils.NowUnix()
+		}
+	}
+	return nil
+}
+
+func (e *Event) GetID() pulid.ID {
+	return e.ID
+}
+
+func (e *Event) GetOrganizationID() pulid.ID {
+	return e.OrganizationID
+}
+
+func (e *Event) GetBusinessUnitID() pulid.ID {
+	return e.BusinessUnitID
+}
+
+func (e *Event) Validate(multiErr *errortypes.MultiError) {
+	multiErr.AddOzzoError(validation.ValidateStruct(e,
+		validation.Field(&e.OrganizationID,
+			validation.Required.Error("Organization is required"),
+		),
+		validation.Field(&e.BusinessUnitID,
+			validation.Required.Error("Business unit is required"),
+		),
+		validation.Field(&e.Type,
+			validation.Required.Error("Event type is required"),
+			domainvalidation.ValidEnum[Type]("Event type is invalid"),
+		),
+		validation.Field(&e.Version,
+			validation.Required.Error("Event version is required"),
+			validation.Min(1).Error("Event version must be at least 1"),
+		),
+		validation.Field(&e.AggregateType,
+			validation.Required.Error("Aggregate type is required"),
+			domainvalidation.ValidEnum[AggregateType]("Aggregate type is invalid"),
+		),
+		validation.Field(&e.AggregateID, validation.Required.Error("Aggregate is required")),
+		validation.Field(&e.IdempotencyKey,
+			validation.Required.Error("Idempotency key is required"),
+			validation.Length(1, maxIdempotencyKeyLength).
+				Error("Idempotency key cannot be longer than 255 characters"),
+		),
+		validation.Field(&e.Payload, validation.Required.Error("Payload is required")),
+	))
+}
+
+const maxIdempotencyKeyLength = 255
@@ -0,0 +1,233 @@
+package domainevent
+
+import (
+	"fmt"
+
+	"github.com/emoss08/trenova/internal/core/domain/carriersettlement"
+	"github.com/emoss08/trenova/internal/core/domain/driversettlement"
+	"github.com/emoss08/trenova/internal/core/domain/invoice"
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/internal/core/domain/tender"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/shopspring/decimal"
+)
+
+// Payload structs are the published schema of an event version. Fields may be
+// added to a version, but renaming, removing, or retyping one requires a new
+// version with its own struct, written alongside the old one until every
+// consumer has moved.
+
+// ShipmentStatusChangedV1 is written by the shipments status trigger in the
+// domain_event_outbox migration rather than from Go; the struct documents the
+// payload for consumers decoding it.
+type ShipmentStatusChangedV1 struct {
+	ShipmentID     pulid.ID        `json:"shipmentId"`
+	ProNumber      string          `json:"proNumber"`
+	CustomerID     pulid.ID        `json:"customerId"`
+	PreviousStatus shipment.Status `json:"previousStatus"`
+	Status         shipment.Status `json:"status"`
+	Version        int64           `json:"version"`
+	ChangedAt      int64           `json:"changedAt"`
+}
+
+// ShipmentETAChangedV1 is written by the stops trigger in the
+// webhook_subscriptions migration when an open stop's appointment window
+// moves. The window is the committed arrival time the customer sees.
+type ShipmentETAChangedV1 struct {
+	ShipmentID          pulid.ID          `json:"shipmentId"`
+	ProNumber           string            `json:"proNumber"`
+	CustomerID          pulid.ID          `json:"customerId"`
+	ShipmentMoveID      pulid.ID          `json:"shipmentMoveId"`
+	StopID              pulid.ID          `json:"stopId"`
+	StopType            shipment.StopType `json:"stopType"`
+	StopSequence        int64             `json:"stopSequence"`
+	PreviousWindowStart int64             `json:"previousWindowStart"`
+	PreviousWindowEnd   *int64            `json:"previousWindowEnd"`
+	WindowStart         int64             `json:"windowStart"`
+	WindowEnd           *int64            `json:"windowEnd"`
+	ChangedAt           int64             `json:"changedAt"`
+}
+
+// ShipmentPODAvailableV1 is written by the documents trigger in the
+// webhook_subscriptions migration once per document row, so a replacement POD
+// publishes again.
+type ShipmentPODAvailableV1 struct {
+	ShipmentID     pulid.ID `json:"shipmentId"`
+	ProNumber      string   `json:"proNumber"`
+	CustomerID     pulid.ID `json:"customerId"`
+	DocumentID     pulid.ID `json:"documentId"`
+	LineageID      pulid.ID `json:"lineageId"`
+	DocumentTypeID pulid.ID `json:"documentTypeId"`
+	FileName       string   `json:"fileName"`
+	AvailableAt    int64    `json:"availableAt"`
+}
+
+type TenderAcceptedV1 struct {
+	TenderID       pulid.ID        `json:"tenderId"`
+	OfferID        pulid.ID        `json:"offerId"`
+	ShipmentID     pulid.ID        `json:"shipmentId"`
+	ShipmentMoveID pulid.ID        `json:"shipmentMoveId"`
+	CarrierID      pulid.ID        `json:"carrierId"`
+	Rate           decimal.Decimal `json:"rate"`
+	ResponseSource string          `json:"responseSource"`
+	AcceptedAt     int64           `json:"acceptedAt"`
+}
+
+type InvoicePostedV1 struct {
+	InvoiceID         pulid.ID        `json:"invoiceId"`
+	Number            string          `json:"number"`
+	CustomerID        pulid.ID        `json:"customerId"`
+	ShipmentID        pulid.ID        `json:"shipmentId,omitempty"`
+	ShipmentProNumber string          `json:"shipmentProNumber,omitempty"`
+	OrderID           pulid.ID        `json:"orderId,omitempty"`
+	TotalAmount       decimal.Decimal `json:"totalAmount"`
+	TotalAmountMinor  int64           `json:"totalAmountMinor"`
+	CurrencyCode      string          `json:"currencyCode"`
+	DueDate           *int64          `json:"dueDate,omitempty"`
+	PostedAt          int64           `json:"postedAt"`
+}
+
+type CarrierSettlementApprovedV1 struct {
+	SettlementID     pulid.ID `json:"settlementId"`
+	SettlementNumber string   `json:"settlementNumber"`
+	CarrierID        pulid.ID `json:"carrierId"`
+	NetPayableMinor  int64    `json:"netPayableMinor"`
+	CurrencyCode     string   `json:"currencyCode"`
+	ApprovedByID     pulid.ID `json:"approvedById"`
+	ApprovedAt       int64    `json:"approvedAt"`
+}
+
+type DriverSettlementApprovedV1 struct {
+	SettlementID     pulid.ID `json:"settlementId"`
+	SettlementNumber string   `json:"settlementNumber"`
+	WorkerID         pulid.ID `json:"workerId"`
+	NetPayMinor      int64    `json:"netPayMinor"`
+	CurrencyCode     string   `json:"currencyCode"`
+	ApprovedByID     pulid.ID `json:"approvedById"`
+	ApprovedAt       int64    `json:"approvedAt"`
+}
+
+// NewTenderAccepted expects the offer's tender to be loaded.
+func NewTenderAccepted(
+	offer *tender.TenderOffer,
+	source tender.ResponseSource,
+	acceptedAt int64,
+) *Event {
+	return &Event{
+		BusinessUnitID: offer.BusinessUnitID,
+		OrganizationID: offer.OrganizationID,
+		Type:           TypeTenderAccepted,
+		Version:        1,
+		AggregateType:  AggregateTender,
+		AggregateID:    offer.TenderID,
+		IdempotencyKey: idempotencyKey(TypeTenderAccepted, offer.TenderID, offer.ID),
+		OccurredAt:     acceptedAt,
+		Payload: TenderAcceptedV1{
+			TenderID:       offer.TenderID,
+			OfferID:        offer.ID,
+			ShipmentID:     offer.Tender.ShipmentID,
+			ShipmentMoveID: offer.Tender.ShipmentMoveID,
+			CarrierID:      offer.CarrierID,
+			Rate:           offer.Rate,
+			ResponseSource: string(source),
+			AcceptedAt:     acceptedAt,
+		},
+	}
+}
+
+// NewInvoicePosted is written once per invoice; reposting an already-posted
+// invoice is a no-op and publishes nothing.
+func NewInvoicePosted(entity *invoice.Invoice) *Event {
+	postedAt := derefUnix(entity.PostedAt)
+
+	return &Event{
+		BusinessUnitID: entity.BusinessUnitID,
+		OrganizationID: entity.OrganizationID,
+		Type:           TypeInvoicePosted,
+		Version:        1,
+		AggregateType:  AggregateInvoice,
+		AggregateID:    entity.ID,
+		IdempotencyKey: idempotencyKey(TypeInvoicePosted, entity.ID),
+		OccurredAt:     postedAt,
+		Payload: InvoicePostedV1{
+			InvoiceID:         entity.ID,
+			Number:            entity.Number,
+			CustomerID:        entity.CustomerID,
+			ShipmentID:        entity.ShipmentID,
+			ShipmentProNumber: entity.ShipmentProNumber,
+			OrderID:           entity.OrderID,
+			TotalAmount:       entity.TotalAmount,
+			TotalAmountMinor:  entity.TotalAmountMinor,
+			CurrencyCode:      entity.CurrencyCode,
+			DueDate:           entity.DueDate,
+			PostedAt:          postedAt,
+		},
+	}
+}
+
+// NewCarrierSettlementApproved keys on the settlement version, so a settlement
+// that is reopened and approved again publishes a second event.
+func NewCarrierSettlementApproved(entity *carriersettlement.CarrierSettlement) *Event {
+	approvedAt := derefUnix(entity.ApprovedAt)
+
+	return &Event{
+		BusinessUnitID: entity.BusinessUnitID,
+		OrganizationID: entity.OrganizationID,
+		Type:           TypeCarrierSettlementApproved,
+		Version:        1,
+		AggregateType:  AggregateCarrierSettlement,
+		AggregateID:    entity.ID,
+		IdempotencyKey: idempotencyKey(TypeCarrierSettlementApproved, entity.ID, entity.Version),
+		OccurredAt:     approvedAt,
+		Payload: CarrierSettlementApprovedV1{
+			SettlementID:     entity.ID,
+			SettlementNumber: entity.SettlementNumber,
+			CarrierID:        entity.CarrierID,
+			NetPayableMinor:  entity.NetPayableMinor,
+			CurrencyCode:     entity.CurrencyCode,
+			ApprovedByID:     entity.ApprovedByID,
+			ApprovedAt:       approvedAt,
+		},
+	}
+}
+
+func NewDriverSettlementApproved(entity *driversettlement.Settlement) *Event {
+	approvedAt := derefUnix(entity.ApprovedAt)
+
+	return &Event{
+		BusinessUnitID: entity.BusinessUnitID,
+		OrganizationID: entity.OrganizationID,
+		Type:           TypeDriverSettlementApproved,
+		Version:        1,
+		AggregateType:  AggregateDriverSettlement,
+		AggregateID:    entity.ID,
+		IdempotencyKey: idempotencyKey(TypeDriverSettlementApproved, entity.ID, entity.Version),
+		OccurredAt:     approvedAt,
+		Payload: DriverSettlementApprovedV1{
+			SettlementID:     entity.ID,
+			SettlementNumber: entity.SettlementNumber,
+			WorkerID:         entity.WorkerID,
+			NetPayMinor:      entity.NetPayMinor,
+			CurrencyCode:     entity.CurrencyCode,
+			ApprovedByID:     entity.ApprovedByID,
+			ApprovedAt:       approvedAt,
+		},
+	}
+}
+
+func idempotencyKey(eventType Type, parts ...any) string {
+	key := string(eventType)
+	for _, part := range parts {
+		key += fmt.Sprintf(":%v", part)
+	}
+	return key
+}
+
+// derefUnix leaves a missing timestamp at zero so BeforeAppendModel stamps the
+// insert time instead.
+func derefUnix(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
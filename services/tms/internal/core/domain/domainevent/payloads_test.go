@@ -0,0 +1,77 @@
+package domainevent_test
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/carriersettlement"
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/internal/core/domain/invoice"
+	"github.com/emoss08/trenova/internal/core/domain/tender"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInvoicePosted_KeysOnInvoice(t *testing.T) {
+	postedAt := int64(1_700_000_000)
+	entity := &invoice.Invoice{
+		ID:             pulid.MustNew("inv_"),
+		OrganizationID: pulid.MustNew("org_"),
+		BusinessUnitID: pulid.MustNew("bu_"),
+		CustomerID:     pulid.MustNew("cus_"),
+		Number:         "INV-1",
+		TotalAmount:    decimal.NewFromInt(100),
+		CurrencyCode:   "USD",
+		PostedAt:       &postedAt,
+	}
+
+	event := domainevent.NewInvoicePosted(entity)
+
+	me := errortypes.NewMultiError()
+	event.Validate(me)
+	require.False(t, me.HasErrors(), me.Error())
+
+	assert.Equal(t, "invoice.posted:"+entity.ID.String(), event.IdempotencyKey)
+	assert.Equal(t, postedAt, event.OccurredAt)
+	payload, ok := event.Payload.(domainevent.InvoicePostedV1)
+	require.True(t, ok)
+	assert.Equal(t, "INV-1", payload.Number)
+}
+
+func TestNewTenderAccepted_KeysOnOffer(t *testing.T) {
+	offer := &tender.TenderOffer{
+		ID:             pulid.MustNew("tof_"),
+		OrganizationID: pulid.MustNew("org_"),
+		BusinessUnitID: pulid.MustNew("bu_"),
+		TenderID:       pulid.MustNew("tnd_"),
+		CarrierID:      pulid.MustNew("car_"),
+		Tender:         &tender.Tender{ShipmentID: pulid.MustNew("shp_")},
+	}
+
+	event := domainevent.NewTenderAccepted(offer, tender.ResponseSourceManual, 1_700_000_000)
+
+	assert.Equal(t, offer.TenderID, event.AggregateID)
+	assert.Equal(
+		t,
+		"tender.accepted:"+offer.TenderID.String()+":"+offer.ID.String(),
+		event.IdempotencyKey,
+	)
+}
+
+func TestNewCarrierSettlementApproved_KeysOnVersion(t *testing.T) {
+	entity := &carriersettlement.CarrierSettlement{
+		ID:             pulid.MustNew("cstl_"),
+		OrganizationID: pulid.MustNew("org_"),
+		BusinessUnitID: pulid.MustNew("bu_"),
+		Version:        3,
+	}
+
+	first := domainevent.NewCarrierSettlementApproved(entity)
+	entity.Version = 5
+	second := domainevent.NewCarrierSettlementApproved(entity)
+
+	assert.NotEqual(t, first.IdempotencyKey, second.IdempotencyKey,
+		"a settlement approved again after reopening must publish a new event")
+}
@@ -0,0 +1,260 @@
+package edi
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/pkg/domaintypes"
+	"github.com/emoss08/trenova/pkg/domainvalidation"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook      = (*EDICertificationRun)(nil)
+	_ domaintypes.PostgresSearchable = (*EDICertificationRun)(nil)
+)
+
+const (
+	DefaultCertificationResponseTimeoutSeconds = int64(15 * 60)
+	MaxCertificationResponseTimeoutSeconds     = int64(24 * 60 * 60)
+	DefaultCertificationStatusCount            = int64(1)
+)
+
+type CertificationRunStatus string
+
+const (
+	CertificationRunStatusRunning = CertificationRunStatus("Running")
+	CertificationRunStatusPassed  = CertificationRunStatus("Passed")
+	CertificationRunStatusFailed  = CertificationRunStatus("Failed")
+)
+
+func (s CertificationRunStatus) IsValid() bool {
+	switch s {
+	case CertificationRunStatusRunning,
+		CertificationRunStatusPassed,
+		CertificationRunStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+type CertificationStepKey string
+
+const (
+	// CertificationStepSendLoadTender generates and delivers a 204 for the run's
+	// shipment.
+	CertificationStepSendLoadTender = CertificationStepKey("SendLoadTender")
+	// CertificationStepAwaitTenderResponse waits for the partner's 990.
+	CertificationStepAwaitTenderResponse = CertificationStepKey("AwaitTenderResponse")
+	// CertificationStepAwaitShipmentStatus waits for the partner's 214 sequence.
+	CertificationStepAwaitShipmentStatus = CertificationStepKey("AwaitShipmentStatus")
+	// CertificationStepSendFreightInvoice generates and delivers a 210 for the
+	// run's invoice.
+	CertificationStepSendFreightInvoice = CertificationStepKey("SendFreightInvoice")
+	// CertificationStepVerifyAcknowledgments waits for an accepting 997 on every
+	// document the run sent.
+	CertificationStepVerifyAcknowledgments = CertificationStepKey("VerifyAcknowledgments")
+)
+
+type CertificationStepStatus string
+
+const (
+	CertificationStepStatusPending = CertificationStepStatus("Pending")
+	CertificationStepStatusRunning = CertificationStepStatus("Running")
+	CertificationStepStatusPassed  = CertificationStepStatus("Passed")
+	CertificationStepStatusFailed  = CertificationStepStatus("Failed")
+	CertificationStepStatusSkipped = CertificationStepStatus("Skipped")
+)
+
+// CertificationStep is one scripted exchange in a certification run and the
+// outcome recorded for it.
+type CertificationStep struct {
+	Key         CertificationStepKey    `json:"key"`
+	Name        string                  `json:"name"`
+	Status      CertificationStepStatus `json:"status"`
+	Detail      string                  `json:"detail"`
+	MessageIDs  []pulid.ID              `json:"messageIds"`
+	StartedAt   *int64                  `json:"startedAt"`
+	CompletedAt *int64                  `json:"completedAt"`
+}
+
+// NewCertificationSteps returns the scripted partner certification sequence.
+func NewCertificationSteps() []CertificationStep {
+	steps := []CertificationStep{
+		{Key: CertificationStepSendLoadTender, Name: "Send 204 load tender"},
+		{Key: CertificationStepAwaitTenderResponse, Name: "Receive 990 tender response"},
+		{Key: CertificationStepAwaitShipmentStatus, Name: "Receive 214 shipment status sequence"},
+		{Key: CertificationStepSendFreightInvoice, Name: "Send 210 freight invoice"},
+		{Key: CertificationStepVerifyAcknowledgments, Name: "Verify 997 acknowledgments"},
+	}
+	for i := range steps {
+		steps[i].Status = CertificationStepStatusPending
+		steps[i].MessageIDs = []pulid.ID{}
+	}
+	return steps
+}
+
+// EDICertificationRun is a scripted onboarding exchange with one trading
+// partner over one communication profile. External connections are only
+// activated after their latest run passes.
+type EDICertificationRun struct {
+	bun.BaseModel             `json:"-" bun:"table:edi_certification_runs,alias:ecr"`
+	pagination.CursorValueSet `json:"-" bun:",embed"`
+
+	ID                     pulid.ID               `json:"id"                     bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID         pulid.ID               `json:"businessUnitId"         bun:"business_unit_id,type:VARCHAR(100),pk,notnull"`
+	OrganizationID         pulid.ID               `json:"organizationId"         bun:"organization_id,type:VARCHAR(100),pk,notnull"`
+	EDIPartnerID           pulid.ID               `json:"ediPartnerId"           bun:"edi_partner_id,type:VARCHAR(100),notnull"`
+	CommunicationProfileID pulid.ID               `json:"communicationProfileId" bun:"communication_profile_id,type:VARCHAR(100),notnull"`
+	EDIConnectionID        pulid.ID               `json:"ediConnectionId"        bun:"edi_connection_id,type:VARCHAR(100),nullzero"`
+	ShipmentID             pulid.ID               `json:"shipmentId"             bun:"shipment_id,type:VARCHAR(100),notnull"`
+	InvoiceID              pulid.ID               `json:"invoiceId"              bun:"invoice_id,type:VARCHAR(100),notnull"`
+	Status                 CertificationRunStatus `json:"status"                 bun:"status,type:edi_certification_run_status_enum,notnull,default:'Running'"`
+	Steps                  []CertificationStep    `json:"steps"                  bun:"steps,type:JSONB,notnull,default:'[]'"`
+	ResponseTimeoutSeconds int64                  `json:"responseTimeoutSeconds" bun:"response_timeout_seconds,type:BIGINT,notnull"`
+	ExpectedStatusCount    int64                  `json:"expectedStatusCount"    bun:"expected_status_count,type:BIGINT,notnull"`
+	FailureReason          string                 `json:"failureReason"          bun:"failure_reason,type:TEXT,nullzero"`
+	StartedByID            pulid.ID               `json:"startedById"            bun:"started_by_id,type:VARCHAR(100),nullzero"`
+	StartedAt              int64                  `json:"startedAt"              bun:"started_at,type:BIGINT,notnull"`
+	CompletedAt            *int64                 `json:"completedAt"            bun:"completed_at,type:BIGINT,nullzero"`
+	Version                int64                  `json:"version"                bun:"version,type:BIGINT,notnull"`
+	CreatedAt              int64                  `json:"createdAt"              bun:"created_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+	UpdatedAt              int64                  `json:"updatedAt"              bun:"updated_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+
+	Partner              *EDIPartner              `json:"partner,omitempty"              bun:"rel:belongs-to,join:edi_partner_id=id"`
+	CommunicationProfile *EDICommunicationProfile `json:"communicationProfile,omitempty" bun:"rel:belongs-to,join:communication_profile_id=id"`
+}
+
+func (r *EDICertificationRun) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	now := timeutils.NowUnix()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if r.ID.IsNil() {
+			r.ID = pulid.MustNew("edicr_")
+		}
+		if r.StartedAt == 0 {
+			r.StartedAt = now
+		}
+		r.CreatedAt = now
+	case *bun.UpdateQuery:
+		r.UpdatedAt = now
+	}
+	return nil
+}
+
+func (r *EDICertificationRun) GetID() pulid.ID {
+	return r.ID
+}
+
+func (r *EDICertificationRun) GetTableName() string {
+	return "edi_certification_runs"
+}
+
+func (r *EDICertificationRun) GetOrganizationID() pulid.ID {
+	return r.OrganizationID
+}
+
+func (r *EDICertificationRun) GetBusinessUnitID() pulid.ID {
+	return r.BusinessUnitID
+}
+
+func (r *EDICertificationRun) GetPostgresSearchConfig() domaintypes.PostgresSearchConfig {
+	return domaintypes.PostgresSearchConfig{
+		TableAlias: "ecr",
+		SearchableFields: []domaintypes.SearchableField{
+			{Name: "status", Type: domaintypes.FieldTypeEnum, Weight: domaintypes.SearchWeightA},
+		},
+	}
+}
+
+// CurrentStep returns the first step that has not finished, or nil once every
+// step has passed.
+func (r *EDICertificationRun) CurrentStep() *CertificationStep {
+	for i := range r.Steps {
+		switch r.Steps[i].Status {
+		case CertificationStepStatusPending, CertificationStepStatusRunning:
+			return &r.Steps[i]
+		case CertificationStepStatusPassed,
+			CertificationStepStatusFailed,
+			CertificationStepStatusSkipped:
+		}
+	}
+	return nil
+}
+
+// Step returns the step with the given key, or nil when the run has none.
+func (r *EDICertificationRun) Step(key CertificationStepKey) *CertificationStep {
+	for i := range r.Steps {
+		if r.Steps[i].Key == key {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// Fail records the failing step and skips every step after it.
+func (r *EDICertificationRun) Fail(step *CertificationStep, detail string, now int64) {
+	step.Status = CertificationStepStatusFailed
+	step.Detail = detail
+	step.CompletedAt = &now
+	for i := range r.Steps {
+		if r.Steps[i].Status == CertificationStepStatusPending {
+			r.Steps[i].Status = CertificationStepStatusSkipped
+		}
+	}
+	r.Status = CertificationRunStatusFailed
+	r.FailureReason = step.Name + ": " + detail
+	r.CompletedAt = &now
+}
+
+// Pass records a passing step and completes the run when it was the last one.
+func (r *EDICertificationRun) Pass(step *CertificationStep, detail string, now int64) {
+	step.Status = CertificationStepStatusPassed
+	step.Detail = detail
+	step.CompletedAt = &now
+	if r.CurrentStep() == nil {
+		r.Status = CertificationRunStatusPassed
+		r.CompletedAt = &now
+	}
+}
+
+func (r *EDICertificationRun) Validate(multiErr *errortypes.MultiError) {
+	multiErr.AddOzzoError(validation.ValidateStruct(r,
+		validation.Field(&r.OrganizationID,
+			validation.Required.Error("Organization is required"),
+		),
+		validation.Field(&r.BusinessUnitID,
+			validation.Required.Error("Business unit is required"),
+		),
+		validation.Field(&r.EDIPartnerID,
+			validation.Required.Error("EDI partner is required"),
+		),
+		validation.Field(&r.CommunicationProfileID,
+			validation.Required.Error("Communication profile is required"),
+		),
+		validation.Field(&r.ShipmentID,
+			validation.Required.Error("Shipment is required to send the 204 load tender"),
+		),
+		validation.Field(&r.InvoiceID,
+			validation.Required.Error("Invoice is required to send the 210 freight invoice"),
+		),
+		validation.Field(&r.Status,
+			validation.Required.Error("Status is required"),
+			domainvalidation.ValidEnum[CertificationRunStatus]("Status is invalid"),
+		),
+		validation.Field(&r.ResponseTimeoutSeconds,
+			validation.Min(int64(60)).Error("Response timeout must be at least one minute"),
+			validation.Max(MaxCertificationResponseTimeoutSeconds).
+				Error("Response timeout cannot be longer than 24 hours"),
+		),
+		validation.Field(&r.ExpectedStatusCount,
+			validation.Min(int64(1)).Error("At least one 214 status update must be expected"),
+			validation.Max(int64(50)).Error("No more than 50 214 status updates can be expected"),
+		),
+	))
+}
@@ -0,0 +1,151 @@
+package edi
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/pkg/domaintypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook      = (*EDIControlNumberLedgerEntry)(nil)
+	_ domaintypes.PostgresSearchable = (*EDIControlNumberLedgerEntry)(nil)
+)
+
+type ControlNumberAnomaly string
+
+const (
+	ControlNumberAnomalyNone       = ControlNumberAnomaly("None")
+	ControlNumberAnomalyGap        = ControlNumberAnomaly("Gap")
+	ControlNumberAnomalyDuplicate  = ControlNumberAnomaly("Duplicate")
+	ControlNumberAnomalyOutOfOrder = ControlNumberAnomaly("OutOfOrder")
+)
+
+func (a ControlNumberAnomaly) IsValid() bool {
+	switch a {
+	case ControlNumberAnomalyNone,
+		ControlNumberAnomalyGap,
+		ControlNumberAnomalyDuplicate,
+		ControlNumberAnomalyOutOfOrder:
+		return true
+	default:
+		return false
+	}
+}
+
+// ControlNumberOutcome tracks what became of an inbound transaction. A
+// Received entry holds the transaction's content against resends while it is
+// processed; a Failed one releases it so the partner can send it again.
+type ControlNumberOutcome string
+
+const (
+	ControlNumberOutcomeReceived  = ControlNumberOutcome("Received")
+	ControlNumberOutcomeProcessed = ControlNumberOutcome("Processed")
+	ControlNumberOutcomeFailed    = ControlNumberOutcome("Failed")
+)
+
+func (o ControlNumberOutcome) IsValid() bool {
+	switch o {
+	case ControlNumberOutcomeReceived,
+		ControlNumberOutcomeProcessed,
+		ControlNumberOutcomeFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// EDIControlNumberLedgerEntry records one ISA13, GS06 or ST02 seen on an
+// exchange with a partner. Entries are append-only; each is evaluated against
+// the earlier entries in the same sequence when it is recorded.
+//
+// SequenceScope separates sequences that number independently: outbound numbers
+// are allocated per document type, and inbound ST02s restart in every
+// functional group. Rejected marks a resend that was not processed again.
+// Only inbound transactions move through Outcome; every other entry is
+// recorded as Processed.
+type EDIControlNumberLedgerEntry struct {
+	bun.BaseModel             `json:"-" bun:"table:edi_control_number_ledger_entries,alias:ecnl"`
+	pagination.CursorValueSet `json:"-" bun:",embed"`
+
+	ID                    pulid.ID             `json:"id"                    bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID        pulid.ID             `json:"businessUnitId"        bun:"business_unit_id,type:VARCHAR(100),pk,notnull"`
+	OrganizationID        pulid.ID             `json:"organizationId"        bun:"organization_id,type:VARCHAR(100),pk,notnull"`
+	EDIPartnerID          pulid.ID             `json:"ediPartnerId"          bun:"edi_partner_id,type:VARCHAR(100),notnull"`
+	Direction             DocumentDirection    `json:"direction"             bun:"direction,type:edi_document_direction_enum,notnull"`
+	Kind                  ControlNumberKind    `json:"kind"                  bun:"kind,type:edi_control_number_kind_enum,notnull"`
+	SequenceScope         string               `json:"sequenceScope"         bun:"sequence_scope,type:VARCHAR(255),notnull,default:''"`
+	ControlNumber         string               `json:"controlNumber"         bun:"control_number,type:VARCHAR(20),notnull"`
+	Sequence              int64                `json:"sequence"              bun:"sequence,type:BIGINT,notnull"`
+	TransactionSet        TransactionSet       `json:"transactionSet"        bun:"transaction_set,type:edi_transaction_set_enum,nullzero"`
+	InboundFileID         pulid.ID             `json:"inboundFileId"         bun:"inbound_file_id,type:VARCHAR(100),nullzero"`
+	MessageID             pulid.ID             `json:"messageId"             bun:"message_id,type:VARCHAR(100),nullzero"`
+	ContentHash           string               `json:"contentHash"           bun:"content_hash,type:VARCHAR(64),nullzero"`
+	Anomaly               ControlNumberAnomaly `json:"anomaly"               bun:"anomaly,type:edi_control_number_anomaly_enum,notnull,default:'None'"`
+	ExpectedControlNumber string               `json:"expectedControlNumber" bun:"expected_control_number,type:VARCHAR(20),nullzero"`
+	DuplicateOfID         pulid.ID             `json:"duplicateOfId"         bun:"duplicate_of_id,type:VARCHAR(100),nullzero"`
+	Rejected              bool                 `json:"rejected"              bun:"rejected,type:BOOLEAN,notnull,default:false"`
+	Outcome               ControlNumberOutcome `json:"outcome"               bun:"outcome,type:edi_control_number_outcome_enum,notnull,default:'Processed'"`
+	RecordedAt            int64                `json:"recordedAt"            bun:"recorded_at,type:BIGINT,notnull"`
+	CreatedAt             int64                `json:"createdAt"             bun:"created_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+}
+
+func (e *EDIControlNumberLedgerEntry) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); ok {
+		now := timeutils.NowUnix()
+		if e.ID.IsNil() {
+			e.ID = pulid.MustNew("edicnl_")
+		}
+		if e.RecordedAt == 0 {
+			e.RecordedAt = now
+		}
+		e.CreatedAt = now
+	}
+	return nil
+}
+
+func (e *EDIControlNumberLedgerEntry) GetID() pulid.ID {
+	return e.ID
+}
+
+func (e *EDIControlNumberLedgerEntry) GetTableName() string {
+	return "edi_control_number_ledger_entries"
+}
+
+func (e *EDIControlNumberLedgerEntry) GetOrganizationID() pulid.ID {
+	return e.OrganizationID
+}
+
+func (e *EDIControlNumberLedgerEntry) GetBusinessUnitID() pulid.ID {
+	return e.BusinessUnitID
+}
+
+func (e *EDIControlNumberLedgerEntry) GetPostgresSearchConfig() domaintypes.PostgresSearchConfig {
+	return domaintypes.PostgresSearchConfig{
+		TableAlias: "ecnl",
+		SearchableFields: []domaintypes.SearchableField{
+			{
+				Name:   "control_number",
+				Type:   domaintypes.FieldTypeText,
+				Weight: domaintypes.SearchWeightA,
+			},
+		},
+	}
+}
+
+// ControlNumberLedgerSummary rolls up one partner's ledger for a direction and
+// control number kind.
+type ControlNumberLedgerSummary struct {
+	Direction      DocumentDirection `json:"direction"      bun:"direction"`
+	Kind           ControlNumberKind `json:"kind"           bun:"kind"`
+	EntryCount     int64             `json:"entryCount"     bun:"entry_count"`
+	HighestNumber  int64             `json:"highestNumber"  bun:"highest_number"`
+	GapCount       int64             `json:"gapCount"       bun:"gap_count"`
+	DuplicateCount int64             `json:"duplicateCount" bun:"duplicate_count"`
+	OutOfOrder     int64             `json:"outOfOrder"     bun:"out_of_order"`
+	RejectedCount  int64             `json:"rejectedCount"  bun:"rejected_count"`
+	LastRecordedAt int64             `json:"lastRecordedAt" bun:"last_recorded_at"`
+}
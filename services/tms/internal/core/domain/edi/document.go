@@ -11,7 +11,10 @@ import (
 	"github.com/uptrace/bun"
 )
 
-const DefaultX12204Version = "004010"
+const (
+	DefaultX12204Version  = "004010"
+	DefaultEDIFACTVersion = "D96A"
+)
 
 type AcknowledgmentConfig struct {
 	Expected           bool               `json:"expected"`
@@ -32,6 +35,9 @@ type X12EnvelopeSettings struct {
 	SegmentTerminator            string `json:"segmentTerminator"`
 	ComponentSeparator           string `json:"componentSeparator"`
 	RepetitionSeparator          string `json:"repetitionSeparator"`
+	// ReleaseCharacter escapes delimiters inside EDIFACT data elements. X12
+	// has no release character and ignores it.
+	ReleaseCharacter string `json:"releaseCharacter,omitempty"`
 }
 
 func DefaultX12EnvelopeSettings() X12EnvelopeSettings {
@@ -50,6 +56,41 @@ func DefaultX12EnvelopeSettings() X12EnvelopeSettings {
 	}
 }
 
+func DefaultEDIFACTEnvelopeSettings() X12EnvelopeSettings {
+	return DefaultX12EnvelopeSettings().WithDefaultDelimiters(EDIStandardEDIFACT)
+}
+
+// DefaultEnvelopeSettings returns the seeded envelope for the standard.
+func DefaultEnvelopeSettings(standard EDIStandard) X12EnvelopeSettings {
+	if standard == EDIStandardEDIFACT {
+		return DefaultEDIFACTEnvelopeSettings()
+	}
+	return DefaultX12EnvelopeSettings()
+}
+
+// WithDefaultDelimiters keeps the partner identifiers but swaps every
+// delimiter for the standard's defaults. Profiles copied from a partner's X12
+// envelope use it so an EDIFACT profile never inherits X12 separators. The
+// EDIFACT repetition separator stays unset so interchanges go out under
+// syntax version 3 unless a partner configures one.
+func (s X12EnvelopeSettings) WithDefaultDelimiters(standard EDIStandard) X12EnvelopeSettings {
+	if standard == EDIStandardEDIFACT {
+		s.ElementSeparator = "+"
+		s.SegmentTerminator = "'"
+		s.ComponentSeparator = ":"
+		s.RepetitionSeparator = ""
+		s.ReleaseCharacter = "?"
+		return s
+	}
+	defaults := DefaultX12EnvelopeSettings()
+	s.ElementSeparator = defaults.ElementSeparator
+	s.SegmentTerminator = defaults.SegmentTerminator
+	s.ComponentSeparator = defaults.ComponentSeparator
+	s.RepetitionSeparator = defaults.RepetitionSeparator
+	s.ReleaseCharacter = ""
+	return s
+}
+
 type TemplateElementSource string
 
 const (
@@ -107,6 +148,9 @@ type TemplateElement struct {
 	Condition               string                     `json:"condition"`
 	Validation              TemplateValidationRule     `json:"validation"`
 	ImplementationGuideNote string                     `json:"implementationGuideNote"`
+	// Component places the value inside a composite element (1-based). Zero
+	// means the element is simple and owns the whole position.
+	Component int `json:"component,omitempty"`
 }
 
 type EDIDocumentType struct {
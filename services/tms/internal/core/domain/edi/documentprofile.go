@@ -48,7 +48,7 @@ type EDIPartnerDocumentProfile struct {
 func (p *EDIPartnerDocumentProfile) BeforeAppendModel(_ context.Context, query bun.Query) error {
 	now := timeutils.NowUnix()
 	if p.Envelope.ElementSeparator == "" {
-		p.Envelope = DefaultX12EnvelopeSettings()
+		p.Envelope = DefaultEnvelopeSettings(p.Standard)
 	}
 	if p.PartnerSettings == nil {
 		p.PartnerSettings = map[string]any{}
@@ -84,13 +84,19 @@ func (p *EDIPartnerDocumentProfile) GetBusinessUnitID() pulid.ID {
 }
 
 func FunctionalGroupDefault(transactionSet TransactionSet) string {
-	switch transactionSet {
+	switch transactionSet.X12Equivalent() {
 	case TransactionSet210:
 		return "IM"
 	case TransactionSet204:
 		return "SM"
+	case TransactionSet211:
+		return "BL"
+	case TransactionSet212:
+		return "GR"
 	case TransactionSet214:
 		return "QM"
+	case TransactionSet820:
+		return "RA"
 	case TransactionSet990:
 		return "GF"
 	case TransactionSet997, TransactionSet999:
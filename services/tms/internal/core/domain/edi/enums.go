@@ -26,6 +26,9 @@ const (
 	ConnectionMethodAS2      = ConnectionMethod("AS2")
 	ConnectionMethodSFTP     = ConnectionMethod("SFTP")
 	ConnectionMethodVAN      = ConnectionMethod("VAN")
+	ConnectionMethodHTTPS    = ConnectionMethod("HTTPS")
+	ConnectionMethodFTPS     = ConnectionMethod("FTPS")
+	ConnectionMethodS3       = ConnectionMethod("S3")
 )
 
 type ConnectionStatus string
@@ -97,7 +100,8 @@ const (
 type EDIStandard string
 
 const (
-	EDIStandardX12 = EDIStandard("X12")
+	EDIStandardX12     = EDIStandard("X12")
+	EDIStandardEDIFACT = EDIStandard("EDIFACT")
 )
 
 type TransactionSet string
@@ -105,10 +109,17 @@ type TransactionSet string
 const (
 	TransactionSet204 = TransactionSet("204")
 	TransactionSet210 = TransactionSet("210")
+	TransactionSet211 = TransactionSet("211")
+	TransactionSet212 = TransactionSet("212")
 	TransactionSet214 = TransactionSet("214")
+	TransactionSet820 = TransactionSet("820")
 	TransactionSet990 = TransactionSet("990")
 	TransactionSet997 = TransactionSet("997")
 	TransactionSet999 = TransactionSet("999")
+
+	TransactionSetIFTMIN = TransactionSet("IFTMIN")
+	TransactionSetIFTSTA = TransactionSet("IFTSTA")
+	TransactionSetINVOIC = TransactionSet("INVOIC")
 )
 
 type DocumentStatus string
@@ -355,7 +366,10 @@ func (m ConnectionMethod) IsValid() bool {
 	case ConnectionMethodInternal,
 		ConnectionMethodAS2,
 		ConnectionMethodSFTP,
-		ConnectionMethodVAN:
+		ConnectionMethodVAN,
+		ConnectionMethodHTTPS,
+		ConnectionMethodFTPS,
+		ConnectionMethodS3:
 		return true
 	default:
 		return false
@@ -420,7 +434,8 @@ func (d DocumentDirection) IsValid() bool {
 
 func (s EDIStandard) IsValid() bool {
 	switch s {
-	case EDIStandardX12:
+	case EDIStandardX12,
+		EDIStandardEDIFACT:
 		return true
 	default:
 		return false
@@ -431,16 +446,48 @@ func (t TransactionSet) IsValid() bool {
 	switch t {
 	case TransactionSet204,
 		TransactionSet210,
+		TransactionSet211,
+		TransactionSet212,
 		TransactionSet214,
+		TransactionSet820,
 		TransactionSet990,
 		TransactionSet997,
-		TransactionSet999:
+		TransactionSet999,
+		TransactionSetIFTMIN,
+		TransactionSetIFTSTA,
+		TransactionSetINVOIC:
 		return true
 	default:
 		return false
 	}
 }
 
+// Standard reports which EDI standard a transaction set belongs to.
+func (t TransactionSet) Standard() EDIStandard {
+	switch t {
+	case TransactionSetIFTMIN, TransactionSetIFTSTA, TransactionSetINVOIC:
+		return EDIStandardEDIFACT
+	default:
+		return EDIStandardX12
+	}
+}
+
+// X12Equivalent maps EDIFACT messages onto the X12 transaction set that
+// carries the same business document, so payload building and inbound routing
+// can share one code path per document kind.
+func (t TransactionSet) X12Equivalent() TransactionSet {
+	switch t {
+	case TransactionSetIFTMIN:
+		return TransactionSet204
+	case TransactionSetIFTSTA:
+		return TransactionSet214
+	case TransactionSetINVOIC:
+		return TransactionSet210
+	default:
+		return t
+	}
+}
+
 func (s DocumentStatus) IsValid() bool {
 	switch s {
 	case DocumentStatusActive,
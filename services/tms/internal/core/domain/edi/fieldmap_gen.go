@@ -11,6 +11,13 @@ func (e *CarrierInvoice) GetStaticFieldMap() map[string]string {
 	return buncolgen.CarrierInvoiceFieldMap
 }
 
+// GetStaticFieldMap returns the pre-computed JSON→database column mapping for [EDICertificationRun].
+// This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
+// the generated [buncolgen.EDICertificationRunFieldMap] instead of parsing struct tags via reflection.
+func (e *EDICertificationRun) GetStaticFieldMap() map[string]string {
+	return buncolgen.EDICertificationRunFieldMap
+}
+
 // GetStaticFieldMap returns the pre-computed JSON→database column mapping for [EDICodeListDefinition].
 // This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
 // the generated [buncolgen.EDICodeListDefinitionFieldMap] instead of parsing struct tags via reflection.
@@ -32,6 +39,13 @@ func (e *EDIConnection) GetStaticFieldMap() map[string]string {
 	return buncolgen.EDIConnectionFieldMap
 }
 
+// GetStaticFieldMap returns the pre-computed JSON→database column mapping for [EDIControlNumberLedgerEntry].
+// This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
+// the generated [buncolgen.EDIControlNumberLedgerEntryFieldMap] instead of parsing struct tags via reflection.
+func (e *EDIControlNumberLedgerEntry) GetStaticFieldMap() map[string]string {
+	return buncolgen.EDIControlNumberLedgerEntryFieldMap
+}
+
 // GetStaticFieldMap returns the pre-computed JSON→database column mapping for [EDIControlNumberSequence].
 // This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
 // the generated [buncolgen.EDIControlNumberSequenceFieldMap] instead of parsing struct tags via reflection.
@@ -46,6 +60,13 @@ func (e *EDIDocumentType) GetStaticFieldMap() map[string]string {
 	return buncolgen.EDIDocumentTypeFieldMap
 }
 
+// GetStaticFieldMap returns the pre-computed JSON→database column mapping for [EDIImplementationGuide].
+// This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
+// the generated [buncolgen.EDIImplementationGuideFieldMap] instead of parsing struct tags via reflection.
+func (e *EDIImplementationGuide) GetStaticFieldMap() map[string]string {
+	return buncolgen.EDIImplementationGuideFieldMap
+}
+
 // GetStaticFieldMap returns the pre-computed JSON→database column mapping for [EDIInboundFile].
 // This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
 // the generated [buncolgen.EDIInboundFileFieldMap] instead of parsing struct tags via reflection.
@@ -0,0 +1,332 @@
+package edi
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/pkg/domaintypes"
+	"github.com/emoss08/trenova/pkg/domainvalidation"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook      = (*EDIImplementationGuide)(nil)
+	_ domaintypes.PostgresSearchable = (*EDIImplementationGuide)(nil)
+)
+
+type GuideUsage string
+
+const (
+	GuideUsageMandatory   = GuideUsage("Mandatory")
+	GuideUsageOptional    = GuideUsage("Optional")
+	GuideUsageSituational = GuideUsage("Situational")
+	GuideUsageNotUsed     = GuideUsage("NotUsed")
+)
+
+func (u GuideUsage) IsValid() bool {
+	switch u {
+	case GuideUsageMandatory, GuideUsageOptional, GuideUsageSituational, GuideUsageNotUsed:
+		return true
+	default:
+		return false
+	}
+}
+
+// GuideSyntaxRuleType is an X12 segment syntax note relating two or more
+// element positions.
+type GuideSyntaxRuleType string
+
+const (
+	// GuideSyntaxRulePaired (P) requires all positions when any is present.
+	GuideSyntaxRulePaired = GuideSyntaxRuleType("Paired")
+	// GuideSyntaxRuleRequired (R) requires at least one of the positions.
+	GuideSyntaxRuleRequired = GuideSyntaxRuleType("Required")
+	// GuideSyntaxRuleExclusion (E) allows at most one of the positions.
+	GuideSyntaxRuleExclusion = GuideSyntaxRuleType("Exclusion")
+	// GuideSyntaxRuleConditional (C) requires the remaining positions when the
+	// first is present.
+	GuideSyntaxRuleConditional = GuideSyntaxRuleType("Conditional")
+	// GuideSyntaxRuleListConditional (L) requires at least one of the remaining
+	// positions when the first is present.
+	GuideSyntaxRuleListConditional = GuideSyntaxRuleType("ListConditional")
+)
+
+func (t GuideSyntaxRuleType) IsValid() bool {
+	switch t {
+	case GuideSyntaxRulePaired,
+		GuideSyntaxRuleRequired,
+		GuideSyntaxRuleExclusion,
+		GuideSyntaxRuleConditional,
+		GuideSyntaxRuleListConditional:
+		return true
+	default:
+		return false
+	}
+}
+
+// ImplementationGuideDefinition is the partner's structure for one transaction
+// set body, from the first segment after ST through the last segment before SE.
+type ImplementationGuideDefinition struct {
+	Nodes []ImplementationGuideNode `json:"nodes"`
+}
+
+// ImplementationGuideNode is either a segment (SegmentID set) or a loop
+// (LoopID set). A loop's first child is its trigger segment: each occurrence
+// of that segment starts a new repetition of the loop.
+type ImplementationGuideNode struct {
+	SegmentID   string                       `json:"segmentId,omitempty"`
+	LoopID      string                       `json:"loopId,omitempty"`
+	Name        string                       `json:"name,omitempty"`
+	Usage       GuideUsage                   `json:"usage"`
+	MinUse      int                          `json:"minUse,omitempty"`
+	MaxUse      int                          `json:"maxUse,omitempty"`
+	Elements    []ImplementationGuideElement `json:"elements,omitempty"`
+	SyntaxRules []GuideSyntaxRule            `json:"syntaxRules,omitempty"`
+	Children    []ImplementationGuideNode    `json:"children,omitempty"`
+}
+
+func (n *ImplementationGuideNode) IsLoop() bool {
+	return n.LoopID != ""
+}
+
+// TriggerSegmentID is the segment that opens a loop, or the node's own segment.
+func (n *ImplementationGuideNode) TriggerSegmentID() string {
+	if !n.IsLoop() {
+		return n.SegmentID
+	}
+	if len(n.Children) == 0 {
+		return ""
+	}
+	return n.Children[0].TriggerSegmentID()
+}
+
+// MinOccurs is the minimum number of segment uses or loop repetitions.
+func (n *ImplementationGuideNode) MinOccurs() int {
+	if n.Usage == GuideUsageMandatory {
+		return max(n.MinUse, 1)
+	}
+	return n.MinUse
+}
+
+// MaxOccurs is the maximum number of segment uses or loop repetitions. Zero
+// means unbounded for loops; segments without a MaxUse default to one use.
+func (n *ImplementationGuideNode) MaxOccurs() int {
+	if n.MaxUse == 0 && !n.IsLoop() {
+		return 1
+	}
+	return n.MaxUse
+}
+
+type ImplementationGuideElement struct {
+	Position  int        `json:"position"`
+	Name      string     `json:"name,omitempty"`
+	Usage     GuideUsage `json:"usage"`
+	MinLength int        `json:"minLength,omitempty"`
+	MaxLength int        `json:"maxLength,omitempty"`
+	// Codes restricts the element to a code list when non-empty.
+	Codes []string `json:"codes,omitempty"`
+	// RequiredWhen makes a situational element required when another element
+	// in the same segment carries one of the listed values, or any value when
+	// the list is empty.
+	RequiredWhen *GuideElementCondition `json:"requiredWhen,omitempty"`
+}
+
+type GuideElementCondition struct {
+	Position int      `json:"position"`
+	Values   []string `json:"values,omitempty"`
+}
+
+type GuideSyntaxRule struct {
+	Type      GuideSyntaxRuleType `json:"type"`
+	Positions []int               `json:"positions"`
+}
+
+func (d *ImplementationGuideDefinition) Validate(multiErr *errortypes.MultiError) {
+	if len(d.Nodes) == 0 {
+		multiErr.Add("nodes", errortypes.ErrRequired, "Guide must define at least one segment")
+		return
+	}
+	validateGuideNodes(multiErr, d.Nodes)
+}
+
+func validateGuideNodes(multiErr *errortypes.MultiError, nodes []ImplementationGuideNode) {
+	for index := range nodes {
+		node := &nodes[index]
+		nodeErr := multiErr.WithIndex("nodes", index)
+		if (node.SegmentID == "") == (node.LoopID == "") {
+			nodeErr.Add(
+				"segmentId",
+				errortypes.ErrInvalid,
+				"Guide node must set exactly one of segment ID or loop ID",
+			)
+			continue
+		}
+		if !node.Usage.IsValid() {
+			nodeErr.Add("usage", errortypes.ErrInvalid, "Usage is invalid")
+		}
+		if node.MinUse < 0 || node.MaxUse < 0 {
+			nodeErr.Add("maxUse", errortypes.ErrInvalid, "Use counts cannot be negative")
+		}
+		if node.MaxUse > 0 && node.MinUse > node.MaxUse {
+			nodeErr.Add("maxUse", errortypes.ErrInvalid, "Maximum use cannot be below minimum use")
+		}
+		if node.IsLoop() {
+			if len(node.Children) == 0 || node.Children[0].IsLoop() {
+				nodeErr.Add(
+					"children",
+					errortypes.ErrInvalid,
+					"Loop must start with a trigger segment",
+				)
+				continue
+			}
+			validateGuideNodes(nodeErr.WithPrefix("children"), node.Children)
+			continue
+		}
+		validateGuideElements(nodeErr, node)
+	}
+}
+
+func validateGuideElements(multiErr *errortypes.MultiError, node *ImplementationGuideNode) {
+	for index := range node.Elements {
+		element := &node.Elements[index]
+		elementErr := multiErr.WithIndex("elements", index)
+		if element.Position <= 0 {
+			elementErr.Add("position", errortypes.ErrInvalid, "Element position must be positive")
+		}
+		if !element.Usage.IsValid() {
+			elementErr.Add("usage", errortypes.ErrInvalid, "Usage is invalid")
+		}
+		if element.MaxLength > 0 && element.MinLength > element.MaxLength {
+			elementErr.Add(
+				"maxLength",
+				errortypes.ErrInvalid,
+				"Maximum length cannot be below minimum length",
+			)
+		}
+	}
+	for index := range node.SyntaxRules {
+		rule := &node.SyntaxRules[index]
+		ruleErr := multiErr.WithIndex("syntaxRules", index)
+		if !rule.Type.IsValid() {
+			ruleErr.Add("type", errortypes.ErrInvalid, "Syntax rule type is invalid")
+		}
+		if len(rule.Positions) < 2 {
+			ruleErr.Add(
+				"positions",
+				errortypes.ErrInvalid,
+				"Syntax rule must relate at least two element positions",
+			)
+		}
+	}
+}
+
+// EDIImplementationGuide is a versioned partner implementation guide for one
+// transaction set and X12 version. Guides without a partner apply to every
+// partner that has no guide of its own.
+type EDIImplementationGuide struct {
+	bun.BaseModel             `json:"-" bun:"table:edi_implementation_guides,alias:eig"`
+	pagination.CursorValueSet `json:"-" bun:",embed"`
+
+	ID             pulid.ID                      `json:"id"             bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID pulid.ID                      `json:"businessUnitId" bun:"business_unit_id,type:VARCHAR(100),pk,notnull"`
+	OrganizationID pulid.ID                      `json:"organizationId" bun:"organization_id,type:VARCHAR(100),pk,notnull"`
+	EDIPartnerID   pulid.ID                      `json:"ediPartnerId"   bun:"edi_partner_id,type:VARCHAR(100),nullzero"`
+	Name           string                        `json:"name"           bun:"name,type:VARCHAR(200),notnull"`
+	Description    string                        `json:"description"    bun:"description,type:TEXT,nullzero"`
+	Direction      DocumentDirection             `json:"direction"      bun:"direction,type:edi_document_direction_enum,notnull"`
+	TransactionSet TransactionSet                `json:"transactionSet" bun:"transaction_set,type:edi_transaction_set_enum,notnull"`
+	X12Version     string                        `json:"x12Version"     bun:"x12_version,type:VARCHAR(20),notnull"`
+	GuideRevision  string                        `json:"guideRevision"  bun:"guide_revision,type:VARCHAR(50),notnull"`
+	IsActive       bool                          `json:"isActive"       bun:"is_active,type:BOOLEAN,notnull"`
+	Definition     ImplementationGuideDefinition `json:"definition"     bun:"definition,type:JSONB,notnull"`
+	Version        int64                         `json:"version"        bun:"version,type:BIGINT,notnull"`
+	CreatedAt      int64                         `json:"createdAt"      bun:"created_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+	UpdatedAt      int64                         `json:"updatedAt"      bun:"updated_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+
+	Partner *EDIPartner `json:"partner,omitempty" bun:"rel:belongs-to,join:edi_partner_id=id"`
+}
+
+func (g *EDIImplementationGuide) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	now := timeutils.NowUnix()
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if g.ID.IsNil() {
+			g.ID = pulid.MustNew("ediig_")
+		}
+		g.CreatedAt = now
+	case *bun.UpdateQuery:
+		g.UpdatedAt = now
+	}
+	return nil
+}
+
+func (g *EDIImplementationGuide) GetID() pulid.ID {
+	return g.ID
+}
+
+func (g *EDIImplementationGuide) GetTableName() string {
+	return "edi_implementation_guides"
+}
+
+func (g *EDIImplementationGuide) GetOrganizationID() pulid.ID {
+	return g.OrganizationID
+}
+
+func (g *EDIImplementationGuide) GetBusinessUnitID() pulid.ID {
+	return g.BusinessUnitID
+}
+
+func (g *EDIImplementationGuide) GetPostgresSearchConfig() domaintypes.PostgresSearchConfig {
+	return domaintypes.PostgresSearchConfig{
+		TableAlias: "eig",
+		SearchableFields: []domaintypes.SearchableField{
+			{Name: "name", Type: domaintypes.FieldTypeText, Weight: domaintypes.SearchWeightA},
+			{Name: "guide_revision", Type: domaintypes.FieldTypeText},
+			{Name: "transaction_set", Type: domaintypes.FieldTypeText},
+		},
+	}
+}
+
+func (g *EDIImplementationGuide) Validate(multiErr *errortypes.MultiError) {
+	multiErr.AddOzzoError(validation.ValidateStruct(g,
+		validation.Field(&g.OrganizationID,
+			validation.Required.Error("Organization is required"),
+		),
+		validation.Field(&g.BusinessUnitID,
+			validation.Required.Error("Business unit is required"),
+		),
+		validation.Field(&g.Name,
+			validation.Required.Error("Name is required"),
+			validation.Length(1, maxEDINameLength).
+				Error("Name cannot be longer than 200 characters"),
+		),
+		validation.Field(&g.Direction,
+			validation.Required.Error("Direction is required"),
+			domainvalidation.ValidEnum[DocumentDirection]("Direction is invalid"),
+		),
+		validation.Field(&g.TransactionSet,
+			validation.Required.Error("Transaction set is required"),
+			domainvalidation.ValidEnum[TransactionSet]("Transaction set is invalid"),
+		),
+		validation.Field(&g.X12Version,
+			validation.Required.Error("X12 version is required"),
+			validation.Length(1, 20).Error("X12 version cannot be longer than 20 characters"),
+		),
+		validation.Field(&g.GuideRevision,
+			validation.Required.Error("Guide revision is required"),
+			validation.Length(1, 50).Error("Guide revision cannot be longer than 50 characters"),
+		),
+	))
+	if g.TransactionSet.Standard() != EDIStandardX12 {
+		multiErr.Add(
+			"transactionSet",
+			errortypes.ErrInvalid,
+			"Implementation guides are only supported for X12 transaction sets",
+		)
+	}
+	g.Definition.Validate(multiErr.WithPrefix("definition"))
+}
@@ -45,6 +45,7 @@ type EDIPartner struct {
 	EnabledForInbound       bool               `json:"enabledForInbound"       bun:"enabled_for_inbound,type:BOOLEAN,notnull,default:true"`
 	EnabledForOutbound      bool               `json:"enabledForOutbound"      bun:"enabled_for_outbound,type:BOOLEAN,notnull,default:true"`
 	Settings                map[string]any     `json:"settings"                bun:"settings,type:JSONB,notnull,default:'{}'"`
+	InboundTransformScript  string             `json:"inboundTransformScript"  bun:"inbound_transform_script,type:TEXT,nullzero"`
 	SearchVector            string             `json:"-"                       bun:"search_vector,type:TSVECTOR,scanonly"`
 	Rank                    string             `json:"-"                       bun:"rank,type:VARCHAR(100),scanonly"`
 	Version                 int64              `json:"version"                 bun:"version,type:BIGINT"`
@@ -20,7 +20,10 @@ type DocumentPayload struct {
 	Shipment                       *LoadTenderPayload               `json:"shipment,omitempty"`
 	FreightInvoice                 *FreightInvoicePayload           `json:"invoice,omitempty"`
 	ShipmentStatus                 *ShipmentStatusPayload           `json:"shipmentStatus,omitempty"`
+	BillOfLading                   *BillOfLadingPayload             `json:"billOfLading,omitempty"`
+	DeliveryManifest               *DeliveryManifestPayload         `json:"deliveryManifest,omitempty"`
 	TenderResponse                 *TenderResponsePayload           `json:"tenderResponse,omitempty"`
+	RemittanceAdvice               *RemittanceAdvicePayload         `json:"remittanceAdvice,omitempty"`
 	FunctionalAcknowledgment       *FunctionalAcknowledgmentPayload `json:"functionalAck,omitempty"`
 	ImplementationAcknowledgment   *ImplementationAckPayload        `json:"implementationAck,omitempty"`
 }
@@ -56,6 +59,30 @@ type FreightInvoiceCharge struct {
 	Weight      *int64              `json:"weight,omitempty"`
 }
 
+// RemittanceAdvicePayload is an inbound 820 describing one customer payment
+// and the invoices it settles.
+type RemittanceAdvicePayload struct {
+	TraceNumber   string              `json:"traceNumber,omitempty"`
+	PaymentMethod string              `json:"paymentMethod,omitempty"`
+	PaymentDate   int64               `json:"paymentDate,omitempty"`
+	CurrencyCode  string              `json:"currencyCode,omitempty"`
+	TotalAmount   decimal.NullDecimal `json:"totalAmount"`
+	PayerName     string              `json:"payerName,omitempty"`
+	PayerID       string              `json:"payerId,omitempty"`
+	Lines         []RemittanceLine    `json:"lines,omitempty"`
+}
+
+type RemittanceLine struct {
+	Sequence             int64               `json:"sequence"`
+	ReferenceQualifier   string              `json:"referenceQualifier,omitempty"`
+	InvoiceNumber        string              `json:"invoiceNumber,omitempty"`
+	PaidAmount           decimal.Decimal     `json:"paidAmount"`
+	InvoiceAmount        decimal.NullDecimal `json:"invoiceAmount"`
+	DiscountAmount       decimal.NullDecimal `json:"discountAmount"`
+	AdjustmentAmount     decimal.NullDecimal `json:"adjustmentAmount"`
+	AdjustmentReasonCode string              `json:"adjustmentReasonCode,omitempty"`
+}
+
 type ShipmentStatusPayload struct {
 	ShipmentID                 pulid.ID          `json:"shipmentId,omitempty"`
 	BOL                        string            `json:"bol,omitempty"`
@@ -94,6 +121,97 @@ type ShipmentStatusPayload struct {
 	References                 map[string]string `json:"references,omitempty"`
 }
 
+// BillOfLadingPayload is an outbound 211 describing one shipment's lading,
+// including the hazardous material detail carried on the bill.
+type BillOfLadingPayload struct {
+	ShipmentID         pulid.ID                `json:"shipmentId,omitempty"`
+	BOL                string                  `json:"bol,omitempty"`
+	ProNumber          string                  `json:"proNumber,omitempty"`
+	ShipDate           int64                   `json:"shipDate,omitempty"`
+	DeliveryDate       int64                   `json:"deliveryDate,omitempty"`
+	Pieces             *int64                  `json:"pieces,omitempty"`
+	Weight             *int64                  `json:"weight,omitempty"`
+	TotalChargeAmount  decimal.NullDecimal     `json:"totalChargeAmount"`
+	Shipper            LadingParty             `json:"shipper"`
+	Consignee          LadingParty             `json:"consignee"`
+	LineItems          []LadingLineItem        `json:"lineItems,omitempty"`
+	HazardousMaterials []HazardousMaterialLine `json:"hazardousMaterials,omitempty"`
+	ReferenceNumbers   map[string]string       `json:"referenceNumbers,omitempty"`
+}
+
+// DeliveryManifestPayload is an outbound 212 listing the deliveries made on
+// one trip, either a single move or every move of the shipment.
+type DeliveryManifestPayload struct {
+	ShipmentID         pulid.ID                `json:"shipmentId,omitempty"`
+	ShipmentMoveID     pulid.ID                `json:"shipmentMoveId,omitempty"`
+	BOL                string                  `json:"bol,omitempty"`
+	ProNumber          string                  `json:"proNumber,omitempty"`
+	ManifestDate       int64                   `json:"manifestDate,omitempty"`
+	EquipmentNumber    string                  `json:"equipmentNumber,omitempty"`
+	StopCount          int64                   `json:"stopCount,omitempty"`
+	Stops              []ManifestStop          `json:"stops,omitempty"`
+	HazardousMaterials []HazardousMaterialLine `json:"hazardousMaterials,omitempty"`
+}
+
+type LadingParty struct {
+	LocationID   pulid.ID `json:"locationId,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Code         string   `json:"code,omitempty"`
+	AddressLine1 string   `json:"addressLine1,omitempty"`
+	AddressLine2 string   `json:"addressLine2,omitempty"`
+	City         string   `json:"city,omitempty"`
+	StateCode    string   `json:"stateCode,omitempty"`
+	PostalCode   string   `json:"postalCode,omitempty"`
+}
+
+type LadingLineItem struct {
+	Sequence    int64    `json:"sequence"`
+	CommodityID pulid.ID `json:"commodityId,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Pieces      int64    `json:"pieces"`
+	Weight      int64    `json:"weight"`
+	Hazardous   bool     `json:"hazardous,omitempty"`
+}
+
+type ManifestStop struct {
+	Sequence             int64    `json:"sequence"`
+	StopID               pulid.ID `json:"stopId,omitempty"`
+	Type                 string   `json:"type,omitempty"`
+	LocationID           pulid.ID `json:"locationId,omitempty"`
+	LocationName         string   `json:"locationName,omitempty"`
+	LocationCode         string   `json:"locationCode,omitempty"`
+	AddressLine1         string   `json:"addressLine1,omitempty"`
+	City                 string   `json:"city,omitempty"`
+	StateCode            string   `json:"stateCode,omitempty"`
+	PostalCode           string   `json:"postalCode,omitempty"`
+	ScheduledWindowStart int64    `json:"scheduledWindowStart,omitempty"`
+	ActualArrival        *int64   `json:"actualArrival,omitempty"`
+	Pieces               *int64   `json:"pieces,omitempty"`
+	Weight               *int64   `json:"weight,omitempty"`
+}
+
+// HazardousMaterialLine carries the DOT shipping description of one hazardous
+// lading line. HazardClass is the class/division code such as "2.1", and the
+// indicator fields hold the notation printed on the bill ("RQ", "MARINE
+// POLLUTANT", "INHALATION HAZARD") or stay empty when they do not apply.
+type HazardousMaterialLine struct {
+	Sequence                    int64    `json:"sequence"`
+	CommodityID                 pulid.ID `json:"commodityId,omitempty"`
+	UNNumber                    string   `json:"unNumber,omitempty"`
+	HazardClass                 string   `json:"hazardClass,omitempty"`
+	SubsidiaryHazardClass       string   `json:"subsidiaryHazardClass,omitempty"`
+	PackingGroup                string   `json:"packingGroup,omitempty"`
+	ProperShippingName          string   `json:"properShippingName,omitempty"`
+	Pieces                      int64    `json:"pieces"`
+	Weight                      int64    `json:"weight"`
+	ReportableQuantity          string   `json:"reportableQuantity,omitempty"`
+	MarinePollutant             string   `json:"marinePollutant,omitempty"`
+	InhalationHazard            string   `json:"inhalationHazard,omitempty"`
+	ErgGuideNumber              string   `json:"ergGuideNumber,omitempty"`
+	EmergencyContact            string   `json:"emergencyContact,omitempty"`
+	EmergencyContactPhoneNumber string   `json:"emergencyContactPhoneNumber,omitempty"`
+}
+
 type TenderResponsePayload struct {
 	TransferID      pulid.ID       `json:"transferId,omitempty"`
 	ShipmentID      pulid.ID       `json:"shipmentId,omitempty"`
@@ -167,6 +285,10 @@ func (p *DocumentPayload) Normalize() {
 			p.TransactionSet = TransactionSet210
 		case p.ShipmentStatus != nil:
 			p.TransactionSet = TransactionSet214
+		case p.BillOfLading != nil:
+			p.TransactionSet = TransactionSet211
+		case p.DeliveryManifest != nil:
+			p.TransactionSet = TransactionSet212
 		case p.TenderResponse != nil:
 			p.TransactionSet = TransactionSet990
 		case p.FunctionalAcknowledgment != nil:
@@ -228,6 +350,8 @@ func (p DocumentPayload) HasBranch() bool {
 		p.Shipment != nil ||
 		p.FreightInvoice != nil ||
 		p.ShipmentStatus != nil ||
+		p.BillOfLading != nil ||
+		p.DeliveryManifest != nil ||
 		p.TenderResponse != nil ||
 		p.FunctionalAcknowledgment != nil ||
 		p.ImplementationAcknowledgment != nil
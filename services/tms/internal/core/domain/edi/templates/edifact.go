@@ -0,0 +1,227 @@
+package templates
+
+import (
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+// component marks element as one component of the composite at its position.
+func component(element edi.TemplateElement, index int) edi.TemplateElement {
+	element.Component = index
+	return element
+}
+
+//nolint:funlen // Starter templates are declarative segment definitions kept in one ordered list.
+func IFTMINSegments(
+	tenantInfo pagination.TenantInfo,
+	versionID pulid.ID,
+) []*edi.EDITemplateSegment {
+	b := base204Builder{tenantInfo: tenantInfo, versionID: versionID}
+	segments := append([]*edi.EDITemplateSegment{}, edifactHeader(b, edi.TransactionSetIFTMIN)...)
+	segments = append(
+		segments,
+		b.segment(30, "BGM", "Beginning of Message", "", true, []edi.TemplateElement{
+			component(
+				b.el(1, "Document Name Code", edi.TemplateElementSourceConstant, "610", true),
+				1,
+			),
+			b.field(2, "Document Number", "shipmentId", "", true),
+			b.field(3, "Message Function Code", "purposeCode", "9"),
+		}),
+		b.segment(40, "DTM", "Document Date", "", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "137"), 1),
+			component(b.runtime(1, "Date", "documentDateTime"), 2),
+			component(b.el(1, "Format", edi.TemplateElementSourceConstant, "203"), 3),
+		}),
+		b.segment(50, "RFF", "Bill of Lading Reference", "", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "BM"), 1),
+			component(b.field(1, "Reference", "bol", ""), 2),
+		}),
+		b.segment(60, "TDT", "Details of Transport", "", false, []edi.TemplateElement{
+			b.el(1, "Transport Stage Qualifier", edi.TemplateElementSourceConstant, "20"),
+			component(b.partner(5, "Carrier Identification", "carrier.scac"), 1),
+		}),
+		b.segment(70, "NAD", "Stop Party", "moves.0.stops", false, []edi.TemplateElement{
+			b.repeat(1, "Party Function Code", "type", "SF"),
+			component(b.repeat(4, "Party Name", "locationName", ""), 1),
+			component(b.repeat(5, "Street", "locationAddressLine1", ""), 1),
+			b.repeat(6, "City Name", "locationCity", ""),
+			b.repeat(7, "Country Sub-entity", "locationStateCode", ""),
+			b.repeat(8, "Postal Code", "locationPostalCode", ""),
+		}),
+		b.segment(80, "GID", "Goods Item Details", "commodities", false, []edi.TemplateElement{
+			b.repeat(1, "Goods Item Number", "sequence", ""),
+		}),
+		b.segment(90, "FTX", "Goods Description", "commodities", false, []edi.TemplateElement{
+			b.el(1, "Text Subject Qualifier", edi.TemplateElementSourceConstant, "AAA"),
+			component(b.repeat(4, "Description", "commodityDescription", ""), 1),
+		}),
+		b.segment(100, "MEA", "Gross Weight", "", false, []edi.TemplateElement{
+			b.el(1, "Measurement Purpose", edi.TemplateElementSourceConstant, "WT"),
+			b.el(2, "Measured Attribute", edi.TemplateElementSourceConstant, "G"),
+			component(b.el(3, "Unit", edi.TemplateElementSourceConstant, "LBR"), 1),
+			component(b.field(3, "Weight", "weight", ""), 2),
+		}),
+	)
+	segments = append(segments, edifactTrailers(b, 110)...)
+	return segments
+}
+
+func IFTSTASegments(
+	tenantInfo pagination.TenantInfo,
+	versionID pulid.ID,
+) []*edi.EDITemplateSegment {
+	b := base204Builder{tenantInfo: tenantInfo, versionID: versionID}
+	segments := append([]*edi.EDITemplateSegment{}, edifactHeader(b, edi.TransactionSetIFTSTA)...)
+	segments = append(
+		segments,
+		b.segment(30, "BGM", "Beginning of Message", "", true, []edi.TemplateElement{
+			component(b.el(1, "Document Name Code", edi.TemplateElementSourceConstant, "77"), 1),
+			b.field(2, "Document Number", "shipmentStatus.shipmentId", "", true),
+			b.el(3, "Message Function Code", edi.TemplateElementSourceConstant, "9"),
+		}),
+		b.segment(40, "RFF", "Bill of Lading Reference", "", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "BM"), 1),
+			component(b.field(1, "Reference", "shipmentStatus.bol", ""), 2),
+		}),
+		b.segment(50, "STS", "Status", "", true, []edi.TemplateElement{
+			component(b.el(1, "Status Category", edi.TemplateElementSourceConstant, "1"), 1),
+			component(b.field(2, "Status Code", "shipmentStatus.statusCode", "X3", true), 1),
+			component(b.field(3, "Status Reason", "shipmentStatus.statusReasonCode", ""), 1),
+		}),
+		b.segment(60, "DTM", "Event Date", "", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "334"), 1),
+			component(b.field(1, "Date", "shipmentStatus.eventDate", ""), 2),
+			component(b.el(1, "Format", edi.TemplateElementSourceConstant, "102"), 3),
+		}),
+		b.segment(70, "LOC", "Event Location", "", false, []edi.TemplateElement{
+			b.el(1, "Location Qualifier", edi.TemplateElementSourceConstant, "175"),
+			component(b.field(2, "City", "shipmentStatus.city", ""), 1),
+			component(b.field(2, "State", "shipmentStatus.stateCode", ""), 4),
+		}),
+		b.segment(80, "EQD", "Equipment Details", "", false, []edi.TemplateElement{
+			b.el(1, "Equipment Qualifier", edi.TemplateElementSourceConstant, "TE"),
+			component(b.field(2, "Equipment Number", "shipmentStatus.equipmentNumber", ""), 1),
+		}),
+	)
+	segments = append(segments, edifactTrailers(b, 90)...)
+	return segments
+}
+
+//nolint:funlen // Starter templates are declarative segment definitions kept in one ordered list.
+func INVOICSegments(
+	tenantInfo pagination.TenantInfo,
+	versionID pulid.ID,
+) []*edi.EDITemplateSegment {
+	b := base204Builder{tenantInfo: tenantInfo, versionID: versionID}
+	segments := append([]*edi.EDITemplateSegment{}, edifactHeader(b, edi.TransactionSetINVOIC)...)
+	segments = append(
+		segments,
+		b.segment(30, "BGM", "Beginning of Message", "", true, []edi.TemplateElement{
+			component(b.el(1, "Document Name Code", edi.TemplateElementSourceConstant, "380"), 1),
+			b.field(2, "Invoice Number", "invoice.invoiceNumber", "", true),
+			b.el(3, "Message Function Code", edi.TemplateElementSourceConstant, "9"),
+		}),
+		b.segment(40, "DTM", "Invoice Date", "", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "3"), 1),
+			component(b.field(1, "Date", "invoice.invoiceDate", ""), 2),
+			component(b.el(1, "Format", edi.TemplateElementSourceConstant, "102"), 3),
+		}),
+		b.segment(50, "RFF", "Bill of Lading Reference", "", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "BM"), 1),
+			component(b.field(1, "Reference", "invoice.bol", ""), 2),
+		}),
+		b.segment(60, "NAD", "Bill-To Party", "", false, []edi.TemplateElement{
+			b.el(1, "Party Function Code", edi.TemplateElementSourceConstant, "BT"),
+			component(b.field(4, "Party Name", "invoice.billToName", ""), 1),
+			component(b.field(5, "Street", "invoice.billToAddressLine1", ""), 1),
+			b.field(6, "City Name", "invoice.billToCity", ""),
+			b.field(7, "Country Sub-entity", "invoice.billToStateCode", ""),
+			b.field(8, "Postal Code", "invoice.billToPostalCode", ""),
+		}),
+		b.segment(70, "CUX", "Currencies", "", false, []edi.TemplateElement{
+			component(b.el(1, "Usage Qualifier", edi.TemplateElementSourceConstant, "2"), 1),
+			component(b.field(1, "Currency", "invoice.currencyCode", "USD"), 2),
+			component(b.el(1, "Currency Qualifier", edi.TemplateElementSourceConstant, "4"), 3),
+		}),
+		b.segment(80, "LIN", "Line Item", "invoice.lineCharges", false, []edi.TemplateElement{
+			b.repeat(1, "Line Item Number", "sequence", "", true),
+		}),
+		b.segment(
+			90,
+			"IMD",
+			"Item Description",
+			"invoice.lineCharges",
+			false,
+			[]edi.TemplateElement{
+				b.el(1, "Description Format", edi.TemplateElementSourceConstant, "F"),
+				component(b.repeat(3, "Charge Code", "code", ""), 1),
+				component(b.repeat(3, "Description", "description", ""), 4),
+			},
+		),
+		b.segment(100, "MOA", "Line Amount", "invoice.lineCharges", false, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "203"), 1),
+			component(b.repeat(1, "Amount", "amount", ""), 2),
+		}),
+		b.segment(110, "UNS", "Section Control", "", true, []edi.TemplateElement{
+			b.el(1, "Section Identification", edi.TemplateElementSourceConstant, "S", true),
+		}),
+		b.segment(120, "MOA", "Invoice Total", "", true, []edi.TemplateElement{
+			component(b.el(1, "Qualifier", edi.TemplateElementSourceConstant, "77"), 1),
+			component(b.field(1, "Amount", "invoice.totalAmount", "", true), 2),
+		}),
+	)
+	segments = append(segments, edifactTrailers(b, 130)...)
+	return segments
+}
+
+func edifactHeader(
+	b base204Builder,
+	transactionSet edi.TransactionSet,
+) []*edi.EDITemplateSegment {
+	return []*edi.EDITemplateSegment{
+		b.segment(10, "UNB", "Interchange Header", "", true, []edi.TemplateElement{
+			component(b.runtime(1, "Syntax Identifier", "syntaxIdentifier", true), 1),
+			component(b.runtime(1, "Syntax Version", "syntaxVersion", true), 2),
+			component(b.runtime(2, "Sender Identification", "interchangeSenderId", true), 1),
+			component(b.runtime(2, "Sender Qualifier", "interchangeSenderQualifier"), 2),
+			component(b.runtime(3, "Recipient Identification", "interchangeReceiverId", true), 1),
+			component(b.runtime(3, "Recipient Qualifier", "interchangeReceiverQualifier"), 2),
+			component(b.runtime(4, "Date", "interchangeDate", true), 1),
+			component(b.runtime(4, "Time", "interchangeTime", true), 2),
+			b.runtime(5, "Interchange Control Reference", "isaControlNumber", true),
+			b.runtime(7, "Application Reference", "applicationReference"),
+			b.runtime(11, "Test Indicator", "testIndicator"),
+		}),
+		b.segment(20, "UNH", "Message Header", "", true, []edi.TemplateElement{
+			b.runtime(1, "Message Reference Number", "transactionControlNumber", true),
+			component(
+				b.el(
+					2,
+					"Message Type",
+					edi.TemplateElementSourceConstant,
+					string(transactionSet),
+					true,
+				),
+				1,
+			),
+			component(b.runtime(2, "Message Version", "messageVersion", true), 2),
+			component(b.runtime(2, "Message Release", "messageRelease", true), 3),
+			component(b.runtime(2, "Controlling Agency", "controllingAgency", true), 4),
+		}),
+	}
+}
+
+func edifactTrailers(b base204Builder, start int64) []*edi.EDITemplateSegment {
+	return []*edi.EDITemplateSegment{
+		b.segment(start, "UNT", "Message Trailer", "", true, []edi.TemplateElement{
+			b.runtime(1, "Number of Segments", "transactionSegmentCount"),
+			b.runtime(2, "Message Reference Number", "transactionControlNumber", true),
+		}),
+		b.segment(start+10, "UNZ", "Interchange Trailer", "", true, []edi.TemplateElement{
+			b.el(1, "Interchange Control Count", edi.TemplateElementSourceConstant, "1", true),
+			b.runtime(2, "Interchange Control Reference", "isaControlNumber", true),
+		}),
+	}
+}
@@ -0,0 +1,346 @@
+package templates
+
+import (
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+//nolint:funlen // Starter templates are declarative segment definitions kept in one ordered list.
+func Base211Segments(
+	tenantInfo pagination.TenantInfo,
+	versionID pulid.ID,
+) []*edi.EDITemplateSegment {
+	b := base204Builder{tenantInfo: tenantInfo, versionID: versionID}
+	segments := make([]*edi.EDITemplateSegment, 0, 26)
+	segments = append(segments, b.envelopeSegments()...)
+	segments = append(
+		segments,
+		x12ST(b, edi.TransactionSet211),
+		b.segment(
+			40,
+			"BOL",
+			"Beginning Segment for the Motor Carrier Bill of Lading",
+			"",
+			true,
+			[]edi.TemplateElement{
+				b.partner(1, "Standard Carrier Alpha Code", "carrier.scac"),
+				b.el(
+					2,
+					"Shipment Method of Payment",
+					edi.TemplateElementSourceConstant,
+					"PP",
+					true,
+				),
+				b.field(3, "Shipment Identification Number", "billOfLading.bol", "", true),
+				b.field(4, "Date", "billOfLading.shipDate", "", true),
+			},
+		),
+		b.segment(50, "B2A", "Set Purpose", "", true, []edi.TemplateElement{
+			b.el(1, "Transaction Set Purpose Code", edi.TemplateElementSourceConstant, "00", true),
+		}),
+		when(
+			b.segment(60, "L11", "Reference Identification", "", false, []edi.TemplateElement{
+				b.field(1, "Reference Identification", "billOfLading.proNumber", ""),
+				b.el(
+					2,
+					"Reference Identification Qualifier",
+					edi.TemplateElementSourceConstant,
+					"CN",
+				),
+			}),
+			"billOfLading.proNumber",
+		),
+		when(
+			b.segment(70, "G62", "Date Time", "", false, []edi.TemplateElement{
+				b.el(1, "Date Qualifier", edi.TemplateElementSourceConstant, "11"),
+				b.field(2, "Date", "billOfLading.shipDate", ""),
+			}),
+			"billOfLading.shipDate",
+		),
+	)
+	segments = append(segments, ladingPartySegments(b, 80, "SH", "billOfLading.shipper")...)
+	segments = append(segments, ladingPartySegments(b, 110, "CN", "billOfLading.consignee")...)
+	segments = append(
+		segments,
+		b.segment(
+			140,
+			"AT1",
+			"Bill of Lading Line Item Number",
+			"billOfLading.lineItems",
+			false,
+			[]edi.TemplateElement{
+				b.repeat(1, "Lading Line Item Number", "sequence", "", true),
+			},
+		),
+		b.segment(
+			150,
+			"AT4",
+			"Bill of Lading Description",
+			"billOfLading.lineItems",
+			false,
+			[]edi.TemplateElement{
+				b.repeat(1, "Lading Description", "description", ""),
+			},
+		),
+		when(
+			b.segment(
+				160,
+				"AT2",
+				"Bill of Lading Line Item Detail",
+				"billOfLading.lineItems",
+				false,
+				[]edi.TemplateElement{
+					b.repeat(1, "Lading Quantity", "pieces", ""),
+					b.el(2, "Packaging Form Code", edi.TemplateElementSourceConstant, "PCS"),
+					b.el(3, "Weight Qualifier", edi.TemplateElementSourceConstant, "G"),
+					b.el(4, "Weight Unit Code", edi.TemplateElementSourceConstant, "L"),
+					b.repeat(5, "Weight", "weight", ""),
+				},
+			),
+			"repeat.sequence",
+		),
+	)
+	segments = append(
+		segments,
+		hazardousMaterialSegments(b, 170, "billOfLading.hazardousMaterials")...,
+	)
+	segments = append(
+		segments,
+		b.segment(210, "L3", "Total Weight and Charges", "", false, []edi.TemplateElement{
+			b.field(1, "Weight", "billOfLading.weight", ""),
+			b.el(2, "Weight Qualifier", edi.TemplateElementSourceConstant, "G"),
+			b.field(5, "Charge", "billOfLading.totalChargeAmount", ""),
+			b.field(11, "Lading Quantity", "billOfLading.pieces", ""),
+		}),
+	)
+	segments = append(segments, x12Trailers(b, 220)...)
+	return segments
+}
+
+//nolint:funlen // Starter templates are declarative segment definitions kept in one ordered list.
+func Base212Segments(
+	tenantInfo pagination.TenantInfo,
+	versionID pulid.ID,
+) []*edi.EDITemplateSegment {
+	b := base204Builder{tenantInfo: tenantInfo, versionID: versionID}
+	segments := make([]*edi.EDITemplateSegment, 0, 20)
+	segments = append(segments, b.envelopeSegments()...)
+	segments = append(
+		segments,
+		x12ST(b, edi.TransactionSet212),
+		b.segment(
+			40,
+			"ATA",
+			"Transportation Carrier Identification",
+			"",
+			true,
+			[]edi.TemplateElement{
+				b.partner(1, "Standard Carrier Alpha Code", "carrier.scac"),
+				b.field(2, "Date", "deliveryManifest.manifestDate", "", true),
+				b.field(5, "Equipment Number", "deliveryManifest.equipmentNumber", ""),
+			},
+		),
+		when(
+			b.segment(50, "L11", "Reference Identification", "", false, []edi.TemplateElement{
+				b.field(1, "Reference Identification", "deliveryManifest.bol", ""),
+				b.el(
+					2,
+					"Reference Identification Qualifier",
+					edi.TemplateElementSourceConstant,
+					"BM",
+				),
+			}),
+			"deliveryManifest.bol",
+		),
+		b.segment(
+			60,
+			"LX",
+			"Transaction Set Line Number",
+			"deliveryManifest.stops",
+			false,
+			[]edi.TemplateElement{
+				b.repeat(1, "Assigned Number", "sequence", "", true),
+			},
+		),
+		when(
+			b.segment(70, "N1", "Name", "deliveryManifest.stops", false, []edi.TemplateElement{
+				b.el(1, "Entity Identifier Code", edi.TemplateElementSourceConstant, "CN"),
+				b.repeat(2, "Name", "locationName", ""),
+				b.el(3, "Identification Code Qualifier", edi.TemplateElementSourceConstant, "93"),
+				b.repeat(4, "Identification Code", "locationCode", ""),
+			}),
+			"repeat.locationName",
+		),
+		b.segment(80, "N3", "Address", "deliveryManifest.stops", false, []edi.TemplateElement{
+			b.repeat(1, "Address Information", "addressLine1", ""),
+		}),
+		b.segment(
+			90,
+			"N4",
+			"Geographic Location",
+			"deliveryManifest.stops",
+			false,
+			[]edi.TemplateElement{
+				b.repeat(1, "City Name", "city", ""),
+				b.repeat(2, "State or Province Code", "stateCode", ""),
+				b.repeat(3, "Postal Code", "postalCode", ""),
+			},
+		),
+		when(
+			b.segment(
+				100,
+				"G62",
+				"Date Time",
+				"deliveryManifest.stops",
+				false,
+				[]edi.TemplateElement{
+					b.el(1, "Date Qualifier", edi.TemplateElementSourceConstant, "68"),
+					b.repeat(2, "Date", "scheduledWindowStart", ""),
+				},
+			),
+			"repeat.scheduledWindowStart",
+		),
+		when(
+			b.segment(
+				110,
+				"AT8",
+				"Shipment Weight Packaging and Quantity Data",
+				"deliveryManifest.stops",
+				false,
+				[]edi.TemplateElement{
+					b.el(1, "Weight Qualifier", edi.TemplateElementSourceConstant, "G"),
+					b.el(2, "Weight Unit Code", edi.TemplateElementSourceConstant, "L"),
+					b.repeat(3, "Weight", "weight", ""),
+					b.repeat(4, "Lading Quantity", "pieces", ""),
+				},
+			),
+			"repeat.weight",
+		),
+	)
+	segments = append(
+		segments,
+		hazardousMaterialSegments(b, 120, "deliveryManifest.hazardousMaterials")...,
+	)
+	segments = append(segments, x12Trailers(b, 160)...)
+	return segments
+}
+
+func ladingPartySegments(
+	b base204Builder,
+	start int64,
+	entityCode, root string,
+) []*edi.EDITemplateSegment {
+	return []*edi.EDITemplateSegment{
+		when(
+			b.segment(start, "N1", "Name", "", false, []edi.TemplateElement{
+				b.el(1, "Entity Identifier Code", edi.TemplateElementSourceConstant, entityCode),
+				b.field(2, "Name", root+".name", ""),
+			}),
+			root+".name",
+		),
+		b.segment(start+10, "N3", "Address", "", false, []edi.TemplateElement{
+			b.field(1, "Address Information", root+".addressLine1", ""),
+			b.field(2, "Address Information", root+".addressLine2", ""),
+		}),
+		b.segment(start+20, "N4", "Geographic Location", "", false, []edi.TemplateElement{
+			b.field(1, "City Name", root+".city", ""),
+			b.field(2, "State or Province Code", root+".stateCode", ""),
+			b.field(3, "Postal Code", root+".postalCode", ""),
+		}),
+	}
+}
+
+// hazardousMaterialSegments emits the LH1/LH2/LH3 shipping description and
+// the PER emergency response contact for each hazardous lading line.
+func hazardousMaterialSegments(
+	b base204Builder,
+	start int64,
+	repeatPath string,
+) []*edi.EDITemplateSegment {
+	return []*edi.EDITemplateSegment{
+		when(
+			b.segment(
+				start,
+				"LH1",
+				"Hazardous Identification Information",
+				repeatPath,
+				false,
+				[]edi.TemplateElement{
+					b.el(
+						1,
+						"Unit or Basis for Measurement Code",
+						edi.TemplateElementSourceConstant,
+						"PC",
+					),
+					b.repeat(2, "Lading Quantity", "pieces", ""),
+					b.repeat(3, "UN/NA Identification Code", "unNumber", "", true),
+					b.repeat(8, "Reportable Quantity Code", "reportableQuantity", ""),
+					b.repeat(9, "Packing Group Code", "packingGroup", ""),
+				},
+			),
+			"repeat.unNumber",
+		),
+		when(
+			b.segment(
+				start+10,
+				"LH2",
+				"Hazardous Classification Information",
+				repeatPath,
+				false,
+				[]edi.TemplateElement{
+					b.repeat(1, "Hazardous Classification", "hazardClass", "", true),
+					b.el(2, "Hazardous Class Qualifier", edi.TemplateElementSourceConstant, "P"),
+				},
+			),
+			"repeat.hazardClass",
+		),
+		when(
+			b.segment(
+				start+20,
+				"LH3",
+				"Hazardous Material Shipping Name",
+				repeatPath,
+				false,
+				[]edi.TemplateElement{
+					b.repeat(1, "Hazardous Material Shipping Name", "properShippingName", "", true),
+					b.el(
+						2,
+						"Hazardous Material Shipping Name Qualifier",
+						edi.TemplateElementSourceConstant,
+						"D",
+					),
+				},
+			),
+			"repeat.properShippingName",
+		),
+		when(
+			b.segment(
+				start+30,
+				"PER",
+				"Administrative Communications Contact",
+				repeatPath,
+				false,
+				[]edi.TemplateElement{
+					b.el(1, "Contact Function Code", edi.TemplateElementSourceConstant, "HM"),
+					b.repeat(2, "Name", "emergencyContact", ""),
+					b.el(
+						3,
+						"Communication Number Qualifier",
+						edi.TemplateElementSourceConstant,
+						"TE",
+					),
+					b.repeat(4, "Communication Number", "emergencyContactPhoneNumber", "", true),
+				},
+			),
+			"repeat.emergencyContactPhoneNumber",
+		),
+	}
+}
+
+// when limits a starter segment to payloads where condition holds, so
+// constant qualifiers do not emit a segment for data the document lacks.
+func when(segment *edi.EDITemplateSegment, condition string) *edi.EDITemplateSegment {
+	segment.Condition = condition
+	return segment
+}
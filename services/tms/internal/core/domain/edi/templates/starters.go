@@ -18,6 +18,10 @@ func StarterSegments(
 		return Base204Segments(tenantInfo, versionID), nil
 	case edi.TransactionSet210:
 		return Base210Segments(tenantInfo, versionID), nil
+	case edi.TransactionSet211:
+		return Base211Segments(tenantInfo, versionID), nil
+	case edi.TransactionSet212:
+		return Base212Segments(tenantInfo, versionID), nil
 	case edi.TransactionSet214:
 		return Base214Segments(tenantInfo, versionID), nil
 	case edi.TransactionSet990:
@@ -26,8 +30,14 @@ func StarterSegments(
 		return Base997Segments(tenantInfo, versionID), nil
 	case edi.TransactionSet999:
 		return Base999Segments(tenantInfo, versionID), nil
+	case edi.TransactionSetIFTMIN:
+		return IFTMINSegments(tenantInfo, versionID), nil
+	case edi.TransactionSetIFTSTA:
+		return IFTSTASegments(tenantInfo, versionID), nil
+	case edi.TransactionSetINVOIC:
+		return INVOICSegments(tenantInfo, versionID), nil
 	default:
-		return nil, fmt.Errorf("unsupported X12 transaction set %q", transactionSet)
+		return nil, fmt.Errorf("unsupported transaction set %q", transactionSet)
 	}
 }
 
@@ -0,0 +1,184 @@
+package ifta
+
+import (
+	"math"
+	"sort"
+
+	"github.com/emoss08/trenova/pkg/postgis"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+	"github.com/uptrace/bun"
+)
+
+// JurisdictionBoundary is the outline of one state or province, loaded from
+// the Census Bureau and Statistics Canada boundary files. It is reference data
+// shared by every tenant.
+type JurisdictionBoundary struct {
+	bun.BaseModel `bun:"table:ifta_jurisdiction_boundaries,alias:ijb" json:"-"`
+
+	Jurisdiction string            `json:"jurisdiction" bun:"jurisdiction,pk,type:VARCHAR(2),notnull"`
+	Geometry     *postgis.Geometry `json:"-"            bun:"geometry,type:geometry,notnull"`
+}
+
+type boundaryArea struct {
+	jurisdiction string
+	shape        orb.MultiPolygon
+	bound        orb.Bound
+}
+
+// BoundaryMap places coordinates in jurisdictions by their outlines rather than
+// by whatever the telematics provider geocoded, which is often blank and never
+// says where between two fixes a border was crossed.
+type BoundaryMap struct {
+	areas []boundaryArea
+}
+
+type boundaryShare struct {
+	jurisdiction string
+	fraction     float64
+}
+
+// NewBoundaryMap returns nil when no usable outline was loaded, which callers
+// take to mean fixes are placed from their location text alone.
+func NewBoundaryMap(boundaries []*JurisdictionBoundary) *BoundaryMap {
+	areas := make([]boundaryArea, 0, len(boundaries))
+	for _, boundary := range boundaries {
+		if boundary == nil || boundary.Geometry == nil {
+			continue
+		}
+
+		var shape orb.MultiPolygon
+		switch geometry := boundary.Geometry.Geometry.(type) {
+		case orb.MultiPolygon:
+			shape = geometry
+		case orb.Polygon:
+			shape = orb.MultiPolygon{geometry}
+		default:
+			continue
+		}
+		areas = append(areas, boundaryArea{
+			jurisdiction: boundary.Jurisdiction,
+			shape:        shape,
+			bound:        shape.Bound(),
+		})
+	}
+	if len(areas) == 0 {
+		return nil
+	}
+
+	return &BoundaryMap{areas: areas}
+}
+
+// Locate returns the jurisdiction containing the coordinate, or "" when it
+// falls outside every outline. The hint is checked first: consecutive fixes
+// are nearly always in the same jurisdiction.
+func (m *BoundaryMap) Locate(latitude, longitude float64, hint string) string {
+	return m.locate(orb.Point{longitude, latitude}, hint)
+}
+
+func (m *BoundaryMap) locate(point orb.Point, hint string) string {
+	if hint != "" {
+		for i := range m.areas {
+			area := &m.areas[i]
+			if area.jurisdiction == hint && area.contains(point) {
+				return area.jurisdiction
+			}
+		}
+	}
+
+	for i := range m.areas {
+		area := &m.areas[i]
+		if area.jurisdiction != hint && area.contains(point) {
+			return area.jurisdiction
+		}
+	}
+
+	return ""
+}
+
+func (a *boundaryArea) contains(point orb.Point) bool {
+	return a.bound.Contains(point) && planar.MultiPolygonContains(a.shape, point)
+}
+
+// split cuts the straight line between two fixes wherever it crosses an
+// outline and returns the share of its length in each jurisdiction, in order.
+// A stretch outside every outline, such as a bridge over a boundary river
+// clipped from the file, goes to the jurisdiction before it.
+func (m *BoundaryMap) split(from, to *TrackPoint) []boundaryShare {
+	if m == nil {
+		return nil
+	}
+
+	start := orb.Point{from.Longitude, from.Latitude}
+	end := orb.Point{to.Longitude, to.Latitude}
+	segment := orb.Bound{Min: start, Max: start}.Extend(end)
+
+	cuts := []float64{0, 1}
+	for i := range m.areas {
+		area := &m.areas[i]
+		if !area.bound.Intersects(segment) {
+			continue
+		}
+		for _, polygon := range area.shape {
+			for _, ring := range polygon {
+				for k := 1; k < len(ring); k++ {
+					if t, ok := crossingAt(start, end, ring[k-1], ring[k]); ok {
+						cuts = append(cuts, t)
+					}
+				}
+			}
+		}
+	}
+	sort.Float64s(cuts)
+
+	shares := make([]boundaryShare, 0, 2)
+	hint := ""
+	for k := 1; k < len(cuts); k++ {
+		width := cuts[k] - cuts[k-1]
+		if width <= 0 {
+			continue
+		}
+		t := (cuts[k-1] + cuts[k]) / 2
+		code := m.locate(orb.Point{
+			start[0] + (end[0]-start[0])*t,
+			start[1] + (end[1]-start[1])*t,
+		}, hint)
+		if code == "" && len(shares) > 0 {
+			code = shares[len(shares)-1].jurisdiction
+		}
+		if n := len(shares); n > 0 && shares[n-1].jurisdiction == code {
+			shares[n-1].fraction += width
+			continue
+		}
+		if n := len(shares); n == 1 && shares[0].jurisdiction == "" {
+			shares[0] = boundaryShare{jurisdiction: code, fraction: shares[0].fraction + width}
+			hint = code
+			continue
+		}
+		shares = append(shares, boundaryShare{jurisdiction: code, fraction: width})
+		hint = code
+	}
+
+	return shares
+}
+
+// crossingAt returns how far along start→end the segment meets the edge a→b,
+// as a fraction of its length. Parallel edges never cut it: a line running
+// along a border stays on whichever side its midpoint places it.
+func crossingAt(start, end, a, b orb.Point) (float64, bool) {
+	dx, dy := end[0]-start[0], end[1]-start[1]
+	ex, ey := b[0]-a[0], b[1]-a[1]
+	denominator := dx*ey - dy*ex
+	if math.Abs(denominator) < 1e-15 {
+		return 0, false
+	}
+
+	ax, ay := a[0]-start[0], a[1]-start[1]
+	t := (ax*ey - ay*ex) / denominator
+	u := (ax*dy - ay*dx) / denominator
+	if t <= 0 || t >= 1 || u < 0 || u > 1 {
+		return 0, false
+	}
+
+	return t, true
+}
@@ -0,0 +1,52 @@
+package ifta
+
+type FuelType string
+
+const (
+	FuelTypeDiesel   = FuelType("Diesel")
+	FuelTypeGasoline = FuelType("Gasoline")
+)
+
+func (f FuelType) String() string { return string(f) }
+
+func (f FuelType) IsValid() bool {
+	switch f {
+	case FuelTypeDiesel, FuelTypeGasoline:
+		return true
+	default:
+		return false
+	}
+}
+
+// PurchaseSource records how a fuel purchase reached the ledger, so an auditor
+// can tell a receipt someone typed in from a row that came off a card statement.
+type PurchaseSource string
+
+const (
+	PurchaseSourceManual = PurchaseSource("Manual")
+	PurchaseSourceImport = PurchaseSource("Import")
+)
+
+func (s PurchaseSource) String() string { return string(s) }
+
+func (s PurchaseSource) IsValid() bool {
+	switch s {
+	case PurchaseSourceManual, PurchaseSourceImport:
+		return true
+	default:
+		return false
+	}
+}
+
+type GapKind string
+
+const (
+	// GapKindCoverage is a stretch where the truck moved but reported no
+	// position for longer than the feed normally goes quiet.
+	GapKindCoverage = GapKind("CoverageGap")
+	// GapKindUnlocated is a stretch whose fixes carried no recognisable
+	// jurisdiction, usually because the provider returned no reverse geocode.
+	GapKindUnlocated = GapKind("UnknownJurisdiction")
+)
+
+func (k GapKind) String() string { return string(k) }
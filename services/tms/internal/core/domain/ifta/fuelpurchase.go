@@ -0,0 +1,97 @@
+package ifta
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/tractor"
+	"github.com/emoss08/trenova/pkg/domainvalidation"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+var _ bun.BeforeAppendModelHook = (*FuelPurchase)(nil)
+
+// FuelPurchase is one fill-up as it appears on the receipt. Tax-paid purchases
+// are what the return credits against each jurisdiction's taxable gallons; fuel
+// drawn from an untaxed bulk tank still counts toward fleet MPG.
+type FuelPurchase struct {
+	bun.BaseModel `bun:"table:ifta_fuel_purchases,alias:ifp" json:"-"`
+
+	ID             pulid.ID `json:"id"             bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID pulid.ID `json:"businessUnitId" bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	OrganizationID pulid.ID `json:"organizationId" bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+	TractorID      pulid.ID `json:"tractorId"      bun:"tractor_id,type:VARCHAR(100),notnull"`
+
+	Jurisdiction  string              `json:"jurisdiction"  bun:"jurisdiction,type:VARCHAR(2),notnull"`
+	FuelType      FuelType            `json:"fuelType"      bun:"fuel_type,type:ifta_fuel_type_enum,notnull,default:'Diesel'"`
+	PurchasedAt   int64               `json:"purchasedAt"   bun:"purchased_at,type:BIGINT,notnull"`
+	Gallons       decimal.Decimal     `json:"gallons"       bun:"gallons,type:NUMERIC(12,3),notnull"`
+	TotalCost     decimal.NullDecimal `json:"totalCost"     bun:"total_cost,type:NUMERIC(19,4),nullzero"`
+	TaxPaid       bool                `json:"taxPaid"       bun:"tax_paid,type:BOOLEAN,notnull,default:true"`
+	Vendor        string              `json:"vendor"        bun:"vendor,type:VARCHAR(255),nullzero"`
+	ReceiptNumber string              `json:"receiptNumber" bun:"receipt_number,type:VARCHAR(100),nullzero"`
+	Source        PurchaseSource      `json:"source"        bun:"source,type:ifta_purchase_source_enum,notnull,default:'Manual'"`
+
+	Version   int64 `json:"version"   bun:"version,type:BIGINT"`
+	CreatedAt int64 `json:"createdAt" bun:"created_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+	UpdatedAt int64 `json:"updatedAt" bun:"updated_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+
+	Tractor *tractor.Tractor `json:"tractor,omitempty" bun:"rel:belongs-to,join:tractor_id=id,join:organization_id=organization_id,join:business_unit_id=business_unit_id"`
+}
+
+func (p *FuelPurchase) Validate(multiErr *errortypes.MultiError) {
+	multiErr.AddOzzoError(validation.ValidateStruct(p,
+		validation.Field(&p.TractorID, validation.Required.Error("Tractor is required")),
+		validation.Field(&p.Jurisdiction,
+			validation.Required.Error("Jurisdiction is required"),
+			validation.By(validJurisdiction),
+		),
+		validation.Field(&p.FuelType,
+			validation.Required.Error("Fuel type is required"),
+			domainvalidation.ValidEnum[FuelType]("Fuel type is invalid"),
+		),
+		validation.Field(&p.PurchasedAt,
+			validation.Required.Error("Purchase date is required"),
+			validation.Min(int64(1)).Error("Purchase date must be a valid timestamp"),
+		),
+		validation.Field(&p.Source, domainvalidation.ValidEnum[PurchaseSource]("Source is invalid")),
+		validation.Field(&p.Vendor,
+			validation.Length(0, 255).Error("Vendor cannot be longer than 255 characters"),
+		),
+		validation.Field(&p.ReceiptNumber,
+			validation.Length(0, 100).Error("Receipt number cannot be longer than 100 characters"),
+		),
+	))
+
+	if !p.Gallons.IsPositive() {
+		multiErr.Add("gallons", errortypes.ErrInvalid, "Gallons must be greater than zero")
+	}
+	if p.TotalCost.Valid && p.TotalCost.Decimal.IsNegative() {
+		multiErr.Add("totalCost", errortypes.ErrInvalid, "Total cost cannot be negative")
+	}
+}
+
+func (p *FuelPurchase) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	now := timeutils.NowUnix()
+
+	if p.Source == "" {
+		p.Source = PurchaseSourceManual
+	}
+
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if p.ID.IsNil() {
+			p.ID = pulid.MustNew("ifp_")
+		}
+		p.CreatedAt = now
+		p.UpdatedAt = now
+	case *bun.UpdateQuery:
+		p.UpdatedAt = now
+	}
+
+	return nil
+}
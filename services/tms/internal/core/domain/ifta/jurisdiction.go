@@ -0,0 +1,157 @@
+package ifta
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+var errUnknownJurisdiction = errors.New(
+	"Jurisdiction must be a US state or Canadian province code",
+)
+
+type jurisdictionInfo struct {
+	name   string
+	member bool
+}
+
+// jurisdictions are the codes a position can be attributed to. Alaska, Hawaii,
+// the District of Columbia and the Canadian territories are not IFTA members:
+// miles driven there count toward fleet MPG but are never taxable.
+var jurisdictions = map[string]jurisdictionInfo{
+	"AL": {name: "Alabama", member: true},
+	"AZ": {name: "Arizona", member: true},
+	"AR": {name: "Arkansas", member: true},
+	"CA": {name: "California", member: true},
+	"CO": {name: "Colorado", member: true},
+	"CT": {name: "Connecticut", member: true},
+	"DE": {name: "Delaware", member: true},
+	"FL": {name: "Florida", member: true},
+	"GA": {name: "Georgia", member: true},
+	"ID": {name: "Idaho", member: true},
+	"IL": {name: "Illinois", member: true},
+	"IN": {name: "Indiana", member: true},
+	"IA": {name: "Iowa", member: true},
+	"KS": {name: "Kansas", member: true},
+	"KY": {name: "Kentucky", member: true},
+	"LA": {name: "Louisiana", member: true},
+	"ME": {name: "Maine", member: true},
+	"MD": {name: "Maryland", member: true},
+	"MA": {name: "Massachusetts", member: true},
+	"MI": {name: "Michigan", member: true},
+	"MN": {name: "Minnesota", member: true},
+	"MS": {name: "Mississippi", member: true},
+	"MO": {name: "Missouri", member: true},
+	"MT": {name: "Montana", member: true},
+	"NE": {name: "Nebraska", member: true},
+	"NV": {name: "Nevada", member: true},
+	"NH": {name: "New Hampshire", member: true},
+	"NJ": {name: "New Jersey", member: true},
+	"NM": {name: "New Mexico", member: true},
+	"NY": {name: "New York", member: true},
+	"NC": {name: "North Carolina", member: true},
+	"ND": {name: "North Dakota", member: true},
+	"OH": {name: "Ohio", member: true},
+	"OK": {name: "Oklahoma", member: true},
+	"OR": {name: "Oregon", member: true},
+	"PA": {name: "Pennsylvania", member: true},
+	"RI": {name: "Rhode Island", member: true},
+	"SC": {name: "South Carolina", member: true},
+	"SD": {name: "South Dakota", member: true},
+	"TN": {name: "Tennessee", member: true},
+	"TX": {name: "Texas", member: true},
+	"UT": {name: "Utah", member: true},
+	"VT": {name: "Vermont", member: true},
+	"VA": {name: "Virginia", member: true},
+	"WA": {name: "Washington", member: true},
+	"WV": {name: "West Virginia", member: true},
+	"WI": {name: "Wisconsin", member: true},
+	"WY": {name: "Wyoming", member: true},
+	"AB": {name: "Alberta", member: true},
+	"BC": {name: "British Columbia", member: true},
+	"MB": {name: "Manitoba", member: true},
+	"NB": {name: "New Brunswick", member: true},
+	"NL": {name: "Newfoundland and Labrador", member: true},
+	"NS": {name: "Nova Scotia", member: true},
+	"ON": {name: "Ontario", member: true},
+	"PE": {name: "Prince Edward Island", member: true},
+	"QC": {name: "Quebec", member: true},
+	"SK": {name: "Saskatchewan", member: true},
+	"AK": {name: "Alaska"},
+	"HI": {name: "Hawaii"},
+	"DC": {name: "District of Columbia"},
+	"YT": {name: "Yukon"},
+	"NT": {name: "Northwest Territories"},
+	"NU": {name: "Nunavut"},
+}
+
+var jurisdictionsByName = func() map[string]string {
+	byName := make(map[string]string, len(jurisdictions))
+	for code, info := range jurisdictions {
+		byName[strings.ToUpper(info.name)] = code
+	}
+	return byName
+}()
+
+// IsJurisdiction reports whether code is a state, province or territory miles
+// can be attributed to.
+func IsJurisdiction(code string) bool {
+	_, ok := jurisdictions[code]
+	return ok
+}
+
+// IsMember reports whether the jurisdiction participates in IFTA, i.e. whether
+// its miles are taxable on the return.
+func IsMember(code string) bool {
+	return jurisdictions[code].member
+}
+
+func JurisdictionName(code string) string {
+	return jurisdictions[code].name
+}
+
+func validJurisdiction(value any) error {
+	code, _ := value.(string)
+	if code != "" && !IsJurisdiction(code) {
+		return errUnknownJurisdiction
+	}
+	return nil
+}
+
+// NormalizeJurisdiction accepts a code or a full name in any case and returns
+// the code, or "" when it names nothing miles can be attributed to.
+func NormalizeJurisdiction(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if IsJurisdiction(value) {
+		return value
+	}
+	return jurisdictionsByName[value]
+}
+
+// JurisdictionFromLocation reads the jurisdiction out of a provider's reverse
+// geocode. Samsara and Motive both end the string with the state or province
+// ("Joliet, IL", "2.1 mi NNE of Dallas, TX", "Main St, Regina, SK S4P 3Y2"), so
+// the comma-separated parts are read from the end and the first one that
+// starts with a jurisdiction wins. It returns "" when none does.
+func JurisdictionFromLocation(formatted string) string {
+	parts := strings.Split(formatted, ",")
+	for i := len(parts) - 1; i >= 0; i-- {
+		part := strings.TrimSpace(parts[i])
+		if part == "" {
+			continue
+		}
+		if code := NormalizeJurisdiction(part); code != "" {
+			return code
+		}
+
+		fields := strings.FieldsFunc(part, func(r rune) bool {
+			return unicode.IsSpace(r) || r == '.'
+		})
+		if len(fields) > 1 {
+			if code := NormalizeJurisdiction(fields[0]); code != "" && len(fields[0]) == 2 {
+				return code
+			}
+		}
+	}
+	return ""
+}
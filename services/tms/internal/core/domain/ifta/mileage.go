@@ -0,0 +1,250 @@
+package ifta
+
+import (
+	"math"
+	"sort"
+
+	"github.com/emoss08/trenova/shared/geoutils"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+const (
+	// MaxFixIntervalSeconds is how long the feed may go quiet between fixes
+	// before the stretch counts as a coverage gap. Positions are polled every
+	// minute, so anything past a quarter of an hour means the truck was not
+	// reporting.
+	MaxFixIntervalSeconds = int64(15 * 60)
+
+	// minGapMiles keeps a truck parked with its gateway asleep from reading as
+	// a gap: it has to have moved for the silence to matter.
+	minGapMiles = 1.0
+
+	// maxPlausibleMph bounds the odometer delta a segment may claim; a faster
+	// implied speed means the odometer reset or jumped and the straight-line
+	// distance is used instead.
+	maxPlausibleMph = 90.0
+
+	metersPerMile = 1609.344
+)
+
+// TrackPoint is one position fix as attribution reads it. Jurisdiction is the
+// one geocoded from the provider's location text, "" when there was none; it
+// only places the fix when no boundary outline does.
+type TrackPoint struct {
+	RecordedAt     int64
+	Latitude       float64
+	Longitude      float64
+	OdometerMeters *int64
+	Jurisdiction   string
+}
+
+type JurisdictionMiles struct {
+	Jurisdiction string  `json:"jurisdiction"`
+	Miles        float64 `json:"miles"`
+	// EstimatedMiles is the part of Miles attributed across a gap, where the
+	// route between the fixes either side is unknown.
+	EstimatedMiles float64 `json:"estimatedMiles"`
+}
+
+// Gap is a stretch of a vehicle's quarter the position history cannot fully
+// account for. Its miles are still attributed where an end of it was placed,
+// but an auditor will ask about it, so it is reported rather than smoothed over.
+type Gap struct {
+	TractorID        pulid.ID `json:"tractorId"`
+	TractorCode      string   `json:"tractorCode"`
+	Kind             GapKind  `json:"kind"`
+	StartedAt        int64    `json:"startedAt"`
+	EndedAt          int64    `json:"endedAt"`
+	FromJurisdiction string   `json:"fromJurisdiction"`
+	ToJurisdiction   string   `json:"toJurisdiction"`
+	Miles            float64  `json:"miles"`
+}
+
+type VehicleMileage struct {
+	TractorID     pulid.ID             `json:"tractorId"`
+	TractorCode   string               `json:"tractorCode"`
+	Jurisdictions []*JurisdictionMiles `json:"jurisdictions"`
+	TotalMiles    float64              `json:"totalMiles"`
+	// UnattributedMiles were driven between fixes neither of which could be
+	// placed. They are left off the return rather than guessed at, which only
+	// ever lowers fleet MPG and so overstates tax rather than understating it.
+	UnattributedMiles float64 `json:"unattributedMiles"`
+	Gaps              []*Gap  `json:"gaps"`
+	FirstFixAt        int64   `json:"firstFixAt"`
+	LastFixAt         int64   `json:"lastFixAt"`
+}
+
+// AttributeMileage walks a vehicle's fixes in time order and assigns the miles
+// between each pair to the jurisdictions they were driven in. Fixes are placed
+// against the boundary outlines, and a segment whose ends lie in different
+// jurisdictions is cut where its line crosses each border, so a long segment
+// through a jurisdiction neither end is in still credits it. Without outlines
+// for the crossing the segment is split at its midpoint instead.
+func AttributeMileage(
+	tractorID pulid.ID,
+	points []TrackPoint,
+	boundaries *BoundaryMap,
+) *VehicleMileage {
+	result := &VehicleMileage{
+		TractorID:     tractorID,
+		Jurisdictions: []*JurisdictionMiles{},
+		Gaps:          []*Gap{},
+	}
+	if len(points) == 0 {
+		return result
+	}
+
+	result.FirstFixAt = points[0].RecordedAt
+	result.LastFixAt = points[len(points)-1].RecordedAt
+
+	placed := placeFixes(points, boundaries)
+	byJurisdiction := make(map[string]*JurisdictionMiles)
+	credit := func(code string, miles float64, estimated bool) {
+		line, ok := byJurisdiction[code]
+		if !ok {
+			line = &JurisdictionMiles{Jurisdiction: code}
+			byJurisdiction[code] = line
+		}
+		line.Miles += miles
+		if estimated {
+			line.EstimatedMiles += miles
+		}
+	}
+
+	var open *Gap
+	for i := 1; i < len(points); i++ {
+		from, to := &points[i-1], &points[i]
+		fromCode, toCode := placed[i-1], placed[i]
+
+		miles := segmentMiles(from, to)
+		if miles <= 0 {
+			continue
+		}
+
+		kind := GapKind("")
+		switch {
+		case fromCode == "" || toCode == "":
+			kind = GapKindUnlocated
+		case to.RecordedAt-from.RecordedAt > MaxFixIntervalSeconds && miles > minGapMiles:
+			kind = GapKindCoverage
+		}
+		estimated := kind != ""
+
+		switch {
+		case fromCode == "" && toCode == "":
+			result.UnattributedMiles += miles
+		case fromCode == "":
+			credit(toCode, miles, estimated)
+		case toCode == "" || fromCode == toCode:
+			credit(fromCode, miles, estimated)
+		default:
+			shares := boundaries.split(from, to)
+			if len(shares) == 0 || shares[0].jurisdiction == "" {
+				credit(fromCode, miles/2, estimated)
+				credit(toCode, miles/2, estimated)
+				break
+			}
+			for _, share := range shares {
+				credit(share.jurisdiction, miles*share.fraction, estimated)
+			}
+		}
+
+		if kind == "" {
+			open = nil
+			continue
+		}
+		if open != nil && open.Kind == kind && open.EndedAt == from.RecordedAt {
+			open.EndedAt = to.RecordedAt
+			open.ToJurisdiction = toCode
+			open.Miles += miles
+			continue
+		}
+		open = &Gap{
+			TractorID:        tractorID,
+			Kind:             kind,
+			StartedAt:        from.RecordedAt,
+			EndedAt:          to.RecordedAt,
+			FromJurisdiction: fromCode,
+			ToJurisdiction:   toCode,
+			Miles:            miles,
+		}
+		result.Gaps = append(result.Gaps, open)
+	}
+
+	for _, line := range byJurisdiction {
+		line.Miles = roundTenth(line.Miles)
+		line.EstimatedMiles = roundTenth(line.EstimatedMiles)
+		result.TotalMiles += line.Miles
+		result.Jurisdictions = append(result.Jurisdictions, line)
+	}
+	sort.Slice(result.Jurisdictions, func(i, j int) bool {
+		return result.Jurisdictions[i].Jurisdiction < result.Jurisdictions[j].Jurisdiction
+	})
+	for _, gap := range result.Gaps {
+		gap.Miles = roundTenth(gap.Miles)
+	}
+	result.TotalMiles = roundTenth(result.TotalMiles)
+	result.UnattributedMiles = roundTenth(result.UnattributedMiles)
+
+	return result
+}
+
+// placeFixes returns each fix's jurisdiction, from the outline it falls in or
+// else its location text, filling in an unplaced run of fixes when the fixes
+// either side of it agree: a truck that reports from Illinois before and after
+// a few blank geocodes never left Illinois.
+func placeFixes(points []TrackPoint, boundaries *BoundaryMap) []string {
+	placed := make([]string, len(points))
+	hint := ""
+	for i := range points {
+		if boundaries != nil {
+			placed[i] = boundaries.Locate(points[i].Latitude, points[i].Longitude, hint)
+		}
+		if placed[i] == "" {
+			placed[i] = points[i].Jurisdiction
+		}
+		if placed[i] != "" {
+			hint = placed[i]
+		}
+	}
+
+	for i := 0; i < len(placed); i++ {
+		if placed[i] != "" {
+			continue
+		}
+		end := i
+		for end < len(placed) && placed[end] == "" {
+			end++
+		}
+		if i > 0 && end < len(placed) && placed[i-1] == placed[end] {
+			for k := i; k < end; k++ {
+				placed[k] = placed[end]
+			}
+		}
+		i = end
+	}
+
+	return placed
+}
+
+// segmentMiles prefers the odometer, which measures the road actually driven,
+// and falls back to the great-circle distance when either fix lacks a reading
+// or the delta is implausible. The straight line is a floor on road miles, so
+// an odometer delta short of it is treated as bad data too.
+func segmentMiles(from, to *TrackPoint) float64 {
+	straight := geoutils.HaversineMiles(from.Latitude, from.Longitude, to.Latitude, to.Longitude)
+	if from.OdometerMeters == nil || to.OdometerMeters == nil {
+		return straight
+	}
+
+	odometer := float64(*to.OdometerMeters-*from.OdometerMeters) / metersPerMile
+	hours := float64(to.RecordedAt-from.RecordedAt) / 3600
+	if odometer < straight-0.5 || odometer > hours*maxPlausibleMph+1 {
+		return straight
+	}
+	return odometer
+}
+
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
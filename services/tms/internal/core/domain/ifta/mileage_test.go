@@ -0,0 +1,273 @@
+package ifta
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/pkg/postgis"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/paulmach/orb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixStart = int64(1_780_000_000)
+
+func odometer(miles float64) *int64 {
+	meters := int64(miles * metersPerMile)
+	return &meters
+}
+
+// fix places a point east along the 40th parallel, where a degree of longitude
+// is about 53 miles, so the tests can reason in rough miles.
+func fix(offsetSeconds int64, longitude float64, jurisdiction string) TrackPoint {
+	return TrackPoint{
+		RecordedAt:   fixStart + offsetSeconds,
+		Latitude:     40,
+		Longitude:    longitude,
+		Jurisdiction: jurisdiction,
+	}
+}
+
+func milesFor(t *testing.T, result *VehicleMileage, code string) *JurisdictionMiles {
+	t.Helper()
+
+	for _, line := range result.Jurisdictions {
+		if line.Jurisdiction == code {
+			return line
+		}
+	}
+	require.Failf(t, "jurisdiction missing", "no miles attributed to %s", code)
+	return nil
+}
+
+func TestAttributeMileage_OdometerDeltaWinsOverStraightLine(t *testing.T) {
+	t.Parallel()
+
+	from := fix(0, -90.0, "IL")
+	from.OdometerMeters = odometer(1000)
+	to := fix(900, -89.8, "IL")
+	to.OdometerMeters = odometer(1012.5)
+
+	result := AttributeMileage(pulid.MustNew("tr_"), []TrackPoint{from, to}, nil)
+
+	require.Len(t, result.Jurisdictions, 1)
+	assert.InDelta(t, 12.5, milesFor(t, result, "IL").Miles, 0.1)
+	assert.Empty(t, result.Gaps)
+}
+
+func TestAttributeMileage_ImplausibleOdometerFallsBackToDistance(t *testing.T) {
+	t.Parallel()
+
+	from := fix(0, -90.0, "IL")
+	from.OdometerMeters = odometer(1000)
+	to := fix(60, -89.9, "IL")
+	to.OdometerMeters = odometer(10) // the unit was swapped and reset
+
+	result := AttributeMileage(pulid.MustNew("tr_"), []TrackPoint{from, to}, nil)
+
+	assert.InDelta(t, 5.3, result.TotalMiles, 0.2)
+}
+
+func TestAttributeMileage_CrossingWithoutOutlinesSplitsAtTheMidpoint(t *testing.T) {
+	t.Parallel()
+
+	points := []TrackPoint{
+		fix(0, -88.0, "IL"),
+		fix(60, -87.98, "IL"),
+		fix(120, -87.96, "IN"),
+		fix(180, -87.94, "IN"),
+	}
+
+	result := AttributeMileage(pulid.MustNew("tr_"), points, nil)
+
+	illinois := milesFor(t, result, "IL")
+	indiana := milesFor(t, result, "IN")
+	assert.InDelta(t, illinois.Miles, indiana.Miles, 0.1, "the crossing is split evenly")
+	assert.Zero(t, illinois.EstimatedMiles, "a one-minute crossing is not a gap")
+	assert.Empty(t, result.Gaps)
+}
+
+// stripes outlines three jurisdictions as bands of longitude, so a fix's
+// longitude alone says where it is: IL west of -87.5, IN to -84.8, OH beyond.
+func stripes() *BoundaryMap {
+	band := func(code string, west, east float64) *JurisdictionBoundary {
+		return &JurisdictionBoundary{
+			Jurisdiction: code,
+			Geometry: &postgis.Geometry{Geometry: orb.Polygon{{
+				{west, 37}, {east, 37}, {east, 42}, {west, 42}, {west, 37},
+			}}},
+		}
+	}
+
+	return NewBoundaryMap([]*JurisdictionBoundary{
+		band("IL", -91.5, -87.5),
+		band("IN", -87.5, -84.8),
+		band("OH", -84.8, -80.5),
+	})
+}
+
+func TestAttributeMileage_CrossingSplitsWhereTheLineMeetsTheBorder(t *testing.T) {
+	t.Parallel()
+
+	points := []TrackPoint{
+		fix(0, -87.6, "IL"),
+		fix(60, -87.2, "IN"),
+	}
+
+	result := AttributeMileage(pulid.MustNew("tr_"), points, stripes())
+
+	illinois := milesFor(t, result, "IL")
+	indiana := milesFor(t, result, "IN")
+	assert.InDelta(t, indiana.Miles, 3*illinois.Miles, 0.2,
+		"a quarter of the line lies west of the border")
+}
+
+func TestAttributeMileage_CreditsJurisdictionsCrossedBetweenFixes(t *testing.T) {
+	t.Parallel()
+
+	points := []TrackPoint{
+		fix(0, -88.0, "IL"),
+		fix(900, -84.0, "OH"),
+	}
+
+	result := AttributeMileage(pulid.MustNew("tr_"), points, stripes())
+
+	require.Len(t, result.Jurisdictions, 3)
+	total := result.TotalMiles
+	assert.InDelta(t, total*0.5/4, milesFor(t, result, "IL").Miles, 0.2)
+	assert.InDelta(t, total*2.7/4, milesFor(t, result, "IN").Miles, 0.2)
+	assert.InDelta(t, total*0.8/4, milesFor(t, result, "OH").Miles, 0.2)
+}
+
+func TestAttributeMileage_OutlinesPlaceFixesWithoutLocationText(t *testing.T) {
+	t.Parallel()
+
+	points := []TrackPoint{
+		fix(0, -88.0, ""),
+		fix(60, -87.98, ""),
+		fix(120, -87.4, ""),
+	}
+
+	result := AttributeMileage(pulid.MustNew("tr_"), points, stripes())
+
+	assert.Empty(t, result.Gaps, "every fix fell inside an outline")
+	assert.Zero(t, result.UnattributedMiles)
+	assert.Positive(t, milesFor(t, result, "IL").Miles)
+	assert.Positive(t, milesFor(t, result, "IN").Miles)
+}
+
+func TestAttributeMileage_SilenceWhileMovingIsACoverageGap(t *testing.T) {
+	t.Parallel()
+
+	points := []TrackPoint{
+		fix(0, -90.0, "MO"),
+		fix(60, -89.98, "MO"),
+		fix(3600, -89.0, "IL"),
+		fix(3660, -88.98, "IL"),
+	}
+
+	result := AttributeMileage(pulid.MustNew("tr_"), points, nil)
+
+	require.Len(t, result.Gaps, 1)
+	gap := result.Gaps[0]
+	assert.Equal(t, GapKindCoverage, gap.Kind)
+	assert.Equal(t, fixStart+60, gap.StartedAt)
+	assert.Equal(t, fixStart+3600, gap.EndedAt)
+	assert.Equal(t, "MO", gap.FromJurisdiction)
+	assert.Equal(t, "IL", gap.ToJurisdiction)
+	assert.Greater(t, milesFor(t, result, "MO").EstimatedMiles, 20.0)
+	assert.Greater(t, milesFor(t, result, "IL").EstimatedMiles, 20.0)
+}
+
+func TestAttributeMileage_ParkedSilenceIsNotAGap(t *testing.T) {
+	t.Parallel()
+
+	points := []TrackPoint{
+		fix(0, -90.0, "MO"),
+		fix(8*3600, -90.0001, "MO"),
+	}
+
+	result := AttributeMileage(pulid.MustNew("tr_"), points, nil)
+
+	assert.Empty(t, result.Gaps)
+}
+
+func TestAttributeMileage_UnplacedFixes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("between fixes in the same jurisdiction are filled in", func(t *testing.T) {
+		t.Parallel()
+
+		points := []TrackPoint{
+			fix(0, -90.0, "MO"),
+			fix(60, -89.98, ""),
+			fix(120, -89.96, ""),
+			fix(180, -89.94, "MO"),
+		}
+
+		result := AttributeMileage(pulid.MustNew("tr_"), points, nil)
+
+		assert.Empty(t, result.Gaps)
+		assert.Zero(t, milesFor(t, result, "MO").EstimatedMiles)
+	})
+
+	t.Run("at a change of jurisdiction are credited to the placed end", func(t *testing.T) {
+		t.Parallel()
+
+		points := []TrackPoint{
+			fix(0, -90.0, "MO"),
+			fix(60, -89.98, ""),
+			fix(120, -89.96, "IL"),
+		}
+
+		result := AttributeMileage(pulid.MustNew("tr_"), points, nil)
+
+		require.Len(t, result.Gaps, 1, "adjacent unplaced segments merge into one gap")
+		assert.Equal(t, GapKindUnlocated, result.Gaps[0].Kind)
+		assert.Equal(t, "MO", result.Gaps[0].FromJurisdiction)
+		assert.Equal(t, "IL", result.Gaps[0].ToJurisdiction)
+		assert.Positive(t, milesFor(t, result, "MO").EstimatedMiles)
+		assert.Positive(t, milesFor(t, result, "IL").EstimatedMiles)
+		assert.Zero(t, result.UnattributedMiles)
+	})
+
+	t.Run("with nothing placed are left unattributed", func(t *testing.T) {
+		t.Parallel()
+
+		points := []TrackPoint{
+			fix(0, -90.0, ""),
+			fix(60, -89.98, ""),
+		}
+
+		result := AttributeMileage(pulid.MustNew("tr_"), points, nil)
+
+		assert.Empty(t, result.Jurisdictions)
+		assert.Positive(t, result.UnattributedMiles)
+		assert.Zero(t, result.TotalMiles)
+	})
+}
+
+func TestJurisdictionFromLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		formatted string
+		want      string
+	}{
+		{formatted: "Joliet, IL", want: "IL"},
+		{formatted: "2.1 mi NNE of Dallas, TX", want: "TX"},
+		{formatted: "I-80, Des Moines, IA 50309", want: "IA"},
+		{formatted: "Main St, Regina, SK S4P 3Y2", want: "SK"},
+		{formatted: "Toronto, Ontario, Canada", want: "ON"},
+		{formatted: "Anchorage, AK, US", want: "AK"},
+		{formatted: "Somewhere in the desert", want: ""},
+		{formatted: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.formatted, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, JurisdictionFromLocation(tt.formatted))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package ifta
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/emoss08/trenova/pkg/errortypes"
+)
+
+// Quarter is an IFTA reporting period. Bounds are calendar quarters in UTC,
+// the same clock position timestamps and purchase dates are stored in.
+type Quarter struct {
+	Year    int `json:"year"`
+	Quarter int `json:"quarter"`
+}
+
+func (q Quarter) String() string {
+	return fmt.Sprintf("%dQ%d", q.Year, q.Quarter)
+}
+
+func (q Quarter) Validate(multiErr *errortypes.MultiError) {
+	if q.Year < 2000 || q.Year > 9999 {
+		multiErr.Add("year", errortypes.ErrInvalid, "Year must be a four-digit year from 2000")
+	}
+	if q.Quarter < 1 || q.Quarter > 4 {
+		multiErr.Add("quarter", errortypes.ErrInvalid, "Quarter must be between 1 and 4")
+	}
+}
+
+// Bounds returns the quarter as a half-open [start, end) range of unix seconds.
+func (q Quarter) Bounds() (start, end int64) {
+	first := time.Date(q.Year, time.Month((q.Quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+	return first.Unix(), first.AddDate(0, 3, 0).Unix()
+}
+
+// QuarterOf returns the quarter a unix timestamp falls in.
+func QuarterOf(timestamp int64) Quarter {
+	at := time.Unix(timestamp, 0).UTC()
+	return Quarter{Year: at.Year(), Quarter: (int(at.Month())-1)/3 + 1}
+}
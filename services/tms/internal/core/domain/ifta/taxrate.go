@@ -0,0 +1,82 @@
+package ifta
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/pkg/domainvalidation"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+var _ bun.BeforeAppendModelHook = (*TaxRate)(nil)
+
+// TaxRate is one line of the quarterly rate matrix IFTA, Inc. publishes, in
+// dollars per US gallon. Carriers key it in (or paste it) each quarter, so the
+// return is always computed against the rates they will actually file with.
+// The surcharge is the separate per-gallon levy a few jurisdictions collect on
+// every taxable gallon; fuel purchased there never credits against it.
+type TaxRate struct {
+	bun.BaseModel `bun:"table:ifta_tax_rates,alias:itr" json:"-"`
+
+	ID             pulid.ID `json:"id"             bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID pulid.ID `json:"businessUnitId" bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	OrganizationID pulid.ID `json:"organizationId" bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+
+	Year          int             `json:"year"          bun:"year,type:SMALLINT,notnull"`
+	Quarter       int             `json:"quarter"       bun:"quarter,type:SMALLINT,notnull"`
+	Jurisdiction  string          `json:"jurisdiction"  bun:"jurisdiction,type:VARCHAR(2),notnull"`
+	FuelType      FuelType        `json:"fuelType"      bun:"fuel_type,type:ifta_fuel_type_enum,notnull,default:'Diesel'"`
+	Rate          decimal.Decimal `json:"rate"          bun:"rate,type:NUMERIC(10,4),notnull"`
+	SurchargeRate decimal.Decimal `json:"surchargeRate" bun:"surcharge_rate,type:NUMERIC(10,4),notnull,default:0"`
+
+	Version   int64 `json:"version"   bun:"version,type:BIGINT"`
+	CreatedAt int64 `json:"createdAt" bun:"created_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+	UpdatedAt int64 `json:"updatedAt" bun:"updated_at,type:BIGINT,notnull,default:extract(epoch from current_timestamp)::bigint"`
+}
+
+func (r *TaxRate) Period() Quarter {
+	return Quarter{Year: r.Year, Quarter: r.Quarter}
+}
+
+func (r *TaxRate) Validate(multiErr *errortypes.MultiError) {
+	r.Period().Validate(multiErr)
+
+	multiErr.AddOzzoError(validation.ValidateStruct(r,
+		validation.Field(&r.Jurisdiction,
+			validation.Required.Error("Jurisdiction is required"),
+			validation.By(validJurisdiction),
+		),
+		validation.Field(&r.FuelType,
+			validation.Required.Error("Fuel type is required"),
+			domainvalidation.ValidEnum[FuelType]("Fuel type is invalid"),
+		),
+	))
+
+	if r.Rate.IsNegative() {
+		multiErr.Add("rate", errortypes.ErrInvalid, "Tax rate cannot be negative")
+	}
+	if r.SurchargeRate.IsNegative() {
+		multiErr.Add("surchargeRate", errortypes.ErrInvalid, "Surcharge rate cannot be negative")
+	}
+}
+
+func (r *TaxRate) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	now := timeutils.NowUnix()
+
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if r.ID.IsNil() {
+			r.ID = pulid.MustNew("itr_")
+		}
+		r.CreatedAt = now
+		r.UpdatedAt = now
+	case *bun.UpdateQuery:
+		r.UpdatedAt = now
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package ifta
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// ReturnLine is one jurisdiction's row on the quarterly schedule. TaxDue is
+// negative when the fleet bought more tax-paid fuel there than it burned, which
+// is a credit against the other jurisdictions.
+type ReturnLine struct {
+	Jurisdiction      string          `json:"jurisdiction"`
+	JurisdictionName  string          `json:"jurisdictionName"`
+	Member            bool            `json:"member"`
+	TotalMiles        decimal.Decimal `json:"totalMiles"`
+	TaxableMiles      decimal.Decimal `json:"taxableMiles"`
+	EstimatedMiles    decimal.Decimal `json:"estimatedMiles"`
+	TaxableGallons    decimal.Decimal `json:"taxableGallons"`
+	TaxPaidGallons    decimal.Decimal `json:"taxPaidGallons"`
+	NetTaxableGallons decimal.Decimal `json:"netTaxableGallons"`
+	TaxRate           decimal.Decimal `json:"taxRate"`
+	SurchargeRate     decimal.Decimal `json:"surchargeRate"`
+	TaxDue            decimal.Decimal `json:"taxDue"`
+	SurchargeDue      decimal.Decimal `json:"surchargeDue"`
+	NetDue            decimal.Decimal `json:"netDue"`
+	// RateMissing marks a member jurisdiction with taxable miles but no rate
+	// on file for the quarter, whose tax is shown as zero until one is added.
+	RateMissing bool `json:"rateMissing"`
+}
+
+type TaxReturn struct {
+	Period            Quarter           `json:"period"`
+	FuelType          FuelType          `json:"fuelType"`
+	TotalMiles        decimal.Decimal   `json:"totalMiles"`
+	TaxableMiles      decimal.Decimal   `json:"taxableMiles"`
+	UnattributedMiles decimal.Decimal   `json:"unattributedMiles"`
+	TotalGallons      decimal.Decimal   `json:"totalGallons"`
+	FleetMPG          decimal.Decimal   `json:"fleetMpg"`
+	Lines             []*ReturnLine     `json:"lines"`
+	TotalDue          decimal.Decimal   `json:"totalDue"`
+	Vehicles          []*VehicleMileage `json:"vehicles"`
+	Gaps              []*Gap            `json:"gaps"`
+	Warnings          []string          `json:"warnings"`
+}
+
+type ReturnInput struct {
+	Period    Quarter
+	FuelType  FuelType
+	Vehicles  []*VehicleMileage
+	Purchases []*FuelPurchase
+	Rates     []*TaxRate
+}
+
+// ComputeReturn builds the quarterly return the way the IFTA-100 schedule
+// does: one fleet MPG from every mile and every gallon, each member
+// jurisdiction's taxable gallons from its miles at that MPG, less the tax-paid
+// gallons bought there. Miles and gallons are whole numbers and MPG has two
+// decimals, as the form asks, and each step works from the rounded figures
+// before it so the schedule adds up on paper.
+func ComputeReturn(input *ReturnInput) *TaxReturn {
+	result := &TaxReturn{
+		Period:   input.Period,
+		FuelType: input.FuelType,
+		Lines:    []*ReturnLine{},
+		Vehicles: input.Vehicles,
+		Gaps:     []*Gap{},
+		Warnings: []string{},
+	}
+
+	lines := make(map[string]*ReturnLine)
+	lineFor := func(code string) *ReturnLine {
+		line, ok := lines[code]
+		if !ok {
+			line = &ReturnLine{
+				Jurisdiction:     code,
+				JurisdictionName: JurisdictionName(code),
+				Member:           IsMember(code),
+			}
+			lines[code] = line
+		}
+		return line
+	}
+
+	unattributed := 0.0
+	for _, vehicle := range input.Vehicles {
+		for _, jurisdiction := range vehicle.Jurisdictions {
+			line := lineFor(jurisdiction.Jurisdiction)
+			line.TotalMiles = line.TotalMiles.Add(decimal.NewFromFloat(jurisdiction.Miles))
+			line.EstimatedMiles = line.EstimatedMiles.Add(
+				decimal.NewFromFloat(jurisdiction.EstimatedMiles),
+			)
+		}
+		unattributed += vehicle.UnattributedMiles
+		result.Gaps = append(result.Gaps, vehicle.Gaps...)
+	}
+	result.UnattributedMiles = decimal.NewFromFloat(unattributed).Round(0)
+
+	totalGallons := decimal.Zero
+	for _, purchase := range input.Purchases {
+		totalGallons = totalGallons.Add(purchase.Gallons)
+		if purchase.TaxPaid && IsMember(purchase.Jurisdiction) {
+			line := lineFor(purchase.Jurisdiction)
+			line.TaxPaidGallons = line.TaxPaidGallons.Add(purchase.Gallons)
+		}
+	}
+	result.TotalGallons = totalGallons.Round(0)
+
+	for _, line := range lines {
+		line.TotalMiles = line.TotalMiles.Round(0)
+		line.EstimatedMiles = line.EstimatedMiles.Round(0)
+		line.TaxPaidGallons = line.TaxPaidGallons.Round(0)
+		if line.Member {
+			line.TaxableMiles = line.TotalMiles
+		}
+		result.TotalMiles = result.TotalMiles.Add(line.TotalMiles)
+		result.TaxableMiles = result.TaxableMiles.Add(line.TaxableMiles)
+		result.Lines = append(result.Lines, line)
+	}
+	sort.Slice(result.Lines, func(i, j int) bool {
+		return result.Lines[i].Jurisdiction < result.Lines[j].Jurisdiction
+	})
+
+	if result.TotalGallons.IsPositive() {
+		result.FleetMPG = result.TotalMiles.DivRound(result.TotalGallons, 2)
+	}
+	switch {
+	case result.TotalMiles.IsPositive() && !result.FleetMPG.IsPositive():
+		result.Warnings = append(result.Warnings,
+			"No fuel purchases are recorded for the quarter, so fleet MPG and taxable "+
+				"gallons cannot be computed")
+	case !result.TotalMiles.IsPositive() && result.TotalGallons.IsPositive():
+		result.Warnings = append(result.Warnings,
+			"Fuel was purchased but no miles were recorded for the quarter")
+	}
+
+	rates := make(map[string]*TaxRate, len(input.Rates))
+	for _, rate := range input.Rates {
+		rates[rate.Jurisdiction] = rate
+	}
+
+	for _, line := range result.Lines {
+		if !line.Member {
+			continue
+		}
+		if result.FleetMPG.IsPositive() {
+			line.TaxableGallons = line.TaxableMiles.DivRound(result.FleetMPG, 0)
+		}
+		line.NetTaxableGallons = line.TaxableGallons.Sub(line.TaxPaidGallons)
+
+		rate, ok := rates[line.Jurisdiction]
+		if !ok {
+			line.RateMissing = line.TaxableGallons.IsPositive() || line.TaxPaidGallons.IsPositive()
+			continue
+		}
+		line.TaxRate = rate.Rate
+		line.SurchargeRate = rate.SurchargeRate
+		line.TaxDue = line.NetTaxableGallons.Mul(rate.Rate).Round(2)
+		line.SurchargeDue = line.TaxableGallons.Mul(rate.SurchargeRate).Round(2)
+		line.NetDue = line.TaxDue.Add(line.SurchargeDue)
+		result.TotalDue = result.TotalDue.Add(line.NetDue)
+	}
+
+	for _, line := range result.Lines {
+		if line.RateMissing {
+			result.Warnings = append(result.Warnings,
+				"No "+input.FuelType.String()+" tax rate is on file for "+line.JurisdictionName+
+					" in "+input.Period.String())
+		}
+	}
+	if len(result.Gaps) > 0 {
+		result.Warnings = append(result.Warnings,
+			"Position history has gaps; review the estimated miles before filing")
+	}
+
+	return result
+}
@@ -0,0 +1,188 @@
+package ifta
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPeriod = Quarter{Year: 2026, Quarter: 3}
+
+func purchase(jurisdiction string, gallons float64, taxPaid bool) *FuelPurchase {
+	return &FuelPurchase{
+		Jurisdiction: jurisdiction,
+		FuelType:     FuelTypeDiesel,
+		Gallons:      decimal.NewFromFloat(gallons),
+		TaxPaid:      taxPaid,
+	}
+}
+
+func rate(jurisdiction string, perGallon, surcharge float64) *TaxRate {
+	return &TaxRate{
+		Year:          testPeriod.Year,
+		Quarter:       testPeriod.Quarter,
+		Jurisdiction:  jurisdiction,
+		FuelType:      FuelTypeDiesel,
+		Rate:          decimal.NewFromFloat(perGallon),
+		SurchargeRate: decimal.NewFromFloat(surcharge),
+	}
+}
+
+func lineFor(t *testing.T, result *TaxReturn, code string) *ReturnLine {
+	t.Helper()
+
+	for _, line := range result.Lines {
+		if line.Jurisdiction == code {
+			return line
+		}
+	}
+	require.Failf(t, "line missing", "no return line for %s", code)
+	return nil
+}
+
+func TestComputeReturn_SchedulesTaxAndCreditPerJurisdiction(t *testing.T) {
+	t.Parallel()
+
+	result := ComputeReturn(&ReturnInput{
+		Period:   testPeriod,
+		FuelType: FuelTypeDiesel,
+		Vehicles: []*VehicleMileage{
+			{
+				TractorID: pulid.MustNew("tr_"),
+				Jurisdictions: []*JurisdictionMiles{
+					{Jurisdiction: "IL", Miles: 3000},
+					{Jurisdiction: "IN", Miles: 1000.4},
+				},
+			},
+			{
+				TractorID: pulid.MustNew("tr_"),
+				Jurisdictions: []*JurisdictionMiles{
+					{Jurisdiction: "IL", Miles: 1000},
+					{Jurisdiction: "AK", Miles: 500},
+				},
+			},
+		},
+		Purchases: []*FuelPurchase{
+			purchase("IL", 300, true),
+			purchase("IN", 500, true),
+			purchase("IL", 100, false), // drawn from the yard's bulk tank
+		},
+		Rates: []*TaxRate{
+			rate("IL", 0.7, 0),
+			rate("IN", 0.6, 0.1),
+		},
+	})
+
+	assert.True(t, decimal.NewFromInt(5500).Equal(result.TotalMiles))
+	assert.True(t, decimal.NewFromInt(5000).Equal(result.TaxableMiles), "Alaska is not a member")
+	assert.True(t, decimal.NewFromInt(900).Equal(result.TotalGallons))
+	assert.Equal(t, "6.11", result.FleetMPG.String())
+
+	illinois := lineFor(t, result, "IL")
+	assert.Equal(t, "655", illinois.TaxableGallons.String(), "4000 / 6.11")
+	assert.Equal(t, "300", illinois.TaxPaidGallons.String(), "only tax-paid fuel credits")
+	assert.Equal(t, "355", illinois.NetTaxableGallons.String())
+	assert.Equal(t, "248.5", illinois.TaxDue.String())
+
+	indiana := lineFor(t, result, "IN")
+	assert.Equal(t, "164", indiana.TaxableGallons.String())
+	assert.Equal(t, "-336", indiana.NetTaxableGallons.String())
+	assert.Equal(t, "-201.6", indiana.TaxDue.String(), "overbuying is a credit")
+	assert.Equal(t, "16.4", indiana.SurchargeDue.String(), "surcharge is on every taxable gallon")
+	assert.Equal(t, "-185.2", indiana.NetDue.String())
+
+	alaska := lineFor(t, result, "AK")
+	assert.False(t, alaska.Member)
+	assert.True(t, alaska.TaxableGallons.IsZero())
+
+	assert.Equal(t, "63.3", result.TotalDue.String())
+	assert.Empty(t, result.Warnings)
+}
+
+func TestComputeReturn_FlagsMissingRatesAndFuel(t *testing.T) {
+	t.Parallel()
+
+	vehicles := []*VehicleMileage{{
+		TractorID:     pulid.MustNew("tr_"),
+		Jurisdictions: []*JurisdictionMiles{{Jurisdiction: "OH", Miles: 600}},
+	}}
+
+	t.Run("missing rate", func(t *testing.T) {
+		t.Parallel()
+
+		result := ComputeReturn(&ReturnInput{
+			Period:    testPeriod,
+			FuelType:  FuelTypeDiesel,
+			Vehicles:  vehicles,
+			Purchases: []*FuelPurchase{purchase("OH", 100, true)},
+		})
+
+		ohio := lineFor(t, result, "OH")
+		assert.True(t, ohio.RateMissing)
+		assert.True(t, ohio.TaxDue.IsZero())
+		require.Len(t, result.Warnings, 1)
+		assert.Contains(t, result.Warnings[0], "Ohio")
+	})
+
+	t.Run("no fuel", func(t *testing.T) {
+		t.Parallel()
+
+		result := ComputeReturn(&ReturnInput{
+			Period:   testPeriod,
+			FuelType: FuelTypeDiesel,
+			Vehicles: vehicles,
+			Rates:    []*TaxRate{rate("OH", 0.47, 0)},
+		})
+
+		assert.True(t, result.FleetMPG.IsZero())
+		assert.True(t, lineFor(t, result, "OH").TaxableGallons.IsZero())
+		require.NotEmpty(t, result.Warnings)
+		assert.Contains(t, result.Warnings[0], "No fuel purchases")
+	})
+}
+
+func TestComputeReturn_CarriesGapsAndEstimatedMiles(t *testing.T) {
+	t.Parallel()
+
+	tractorID := pulid.MustNew("tr_")
+	result := ComputeReturn(&ReturnInput{
+		Period:   testPeriod,
+		FuelType: FuelTypeDiesel,
+		Vehicles: []*VehicleMileage{{
+			TractorID: tractorID,
+			Jurisdictions: []*JurisdictionMiles{
+				{Jurisdiction: "MO", Miles: 120, EstimatedMiles: 40},
+			},
+			UnattributedMiles: 12.6,
+			Gaps: []*Gap{{
+				TractorID: tractorID,
+				Kind:      GapKindCoverage,
+				Miles:     40,
+			}},
+		}},
+		Purchases: []*FuelPurchase{purchase("MO", 20, true)},
+		Rates:     []*TaxRate{rate("MO", 0.22, 0)},
+	})
+
+	assert.Equal(t, "40", lineFor(t, result, "MO").EstimatedMiles.String())
+	assert.Equal(t, "13", result.UnattributedMiles.String())
+	assert.True(t, decimal.NewFromInt(120).Equal(result.TotalMiles), "unattributed miles stay off")
+	require.Len(t, result.Gaps, 1)
+	assert.Contains(t, result.Warnings,
+		"Position history has gaps; review the estimated miles before filing")
+}
+
+func TestQuarterBounds(t *testing.T) {
+	t.Parallel()
+
+	start, end := Quarter{Year: 2026, Quarter: 4}.Bounds()
+
+	assert.Equal(t, int64(1_790_812_800), start, "2026-10-01T00:00:00Z")
+	assert.Equal(t, int64(1_798_761_600), end, "2027-01-01T00:00:00Z")
+	assert.Equal(t, Quarter{Year: 2026, Quarter: 4}, QuarterOf(start))
+	assert.Equal(t, Quarter{Year: 2026, Quarter: 4}, QuarterOf(end-1))
+	assert.Equal(t, Quarter{Year: 2027, Quarter: 1}, QuarterOf(end))
+}
@@ -74,6 +74,37 @@ var ConfigSpecs = map[Type]IntegrationSpec{
 		},
 		SupportsTestConnect: true,
 	},
+	TypeMotive: {
+		Fields: []ConfigFieldSpec{
+			{
+				Key:       "apiKey",
+				Label:     "API Key",
+				Type:      ConfigFieldTypePassword,
+				Required:  true,
+				Sensitive: true,
+			},
+			{
+				Key:     "baseUrl",
+				Label:   "Base URL",
+				Type:    ConfigFieldTypeURL,
+				Default: "https://api.gomotive.com",
+			},
+			{
+				Key:       "webhookSecret",
+				Label:     "Webhook Secret",
+				Type:      ConfigFieldTypePassword,
+				Sensitive: true,
+				HelpText:  "Shared secret from the Motive webhook configuration, used to verify X-KT-Webhook-Signature on inbound events.",
+			},
+			{
+				Key:       "webhookToken",
+				Label:     "Webhook Token",
+				Type:      ConfigFieldTypeString,
+				Sensitive: false,
+			},
+		},
+		SupportsTestConnect: true,
+	},
 	TypeHERE: {
 		Fields: []ConfigFieldSpec{
 			{
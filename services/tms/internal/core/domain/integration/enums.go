@@ -5,6 +5,7 @@ type Type string
 const (
 	TypeGoogleMaps         = Type("GoogleMaps")
 	TypeSamsara            = Type("Samsara")
+	TypeMotive             = Type("Motive")
 	TypeHERE               = Type("HERE")
 	TypeOpenAI             = Type("OpenAI")
 	TypeAnthropic          = Type("Anthropic")
@@ -17,7 +18,6 @@ const (
 	TypeSendGrid           = Type("SendGrid")
 	TypeMailgun            = Type("Mailgun")
 	TypePostmark           = Type("Postmark")
-	// TypeMotive     Type = "Motive"
 )
 
 type Category string
@@ -36,6 +36,7 @@ func (v Type) IsValid() bool {
 	switch v {
 	case TypeGoogleMaps,
 		TypeSamsara,
+		TypeMotive,
 		TypeHERE,
 		TypeOpenAI,
 		TypeAnthropic,
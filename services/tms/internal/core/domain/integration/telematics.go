@@ -1,11 +1,17 @@
 package integration
 
+import (
+	"slices"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
 // SupportsTelematics reports whether the integration type is a telematics provider the
 // platform can actually drive. It is the single source of truth shared by the provider
 // factory and the dispatch scoring gate, so the two can never disagree about which
 // organizations have a live ELD feed.
 func (t Type) SupportsTelematics() bool {
-	return t == TypeSamsara
+	return t == TypeSamsara || t == TypeMotive
 }
 
 // HasEnabledTelematics reports whether any of the organization's integrations is an
@@ -25,3 +31,36 @@ func HasEnabledTelematics(records []*Integration) bool {
 	}
 	return false
 }
+
+// EnabledTelematicsTypes returns the enabled, supported telematics providers in record
+// order, without duplicates. The first is the organization's primary provider: units
+// that are not pinned to a provider report through it.
+func EnabledTelematicsTypes(records []*Integration) []Type {
+	types := make([]Type, 0, 1)
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		if record.Category != CategoryTelematics || !record.Enabled {
+			continue
+		}
+		if !record.Type.SupportsTelematics() || slices.Contains(types, record.Type) {
+			continue
+		}
+		types = append(types, record.Type)
+	}
+	return types
+}
+
+// ValidTelematicsProvider is an ozzo rule for a unit's telematics provider: empty, or a
+// type the platform can drive.
+var ValidTelematicsProvider = validation.By(func(value any) error {
+	typ, ok := value.(Type)
+	if !ok || typ == "" || typ.SupportsTelematics() {
+		return nil
+	}
+	return validation.NewError(
+		"validation_telematics_provider",
+		"Telematics provider must be Samsara or Motive",
+	)
+})
@@ -59,6 +59,17 @@ func TestHasEnabledTelematics(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "enabled motive integration",
+			records: []*integration.Integration{
+				{
+					Type:     integration.TypeMotive,
+					Category: integration.CategoryTelematics,
+					Enabled:  true,
+				},
+			},
+			want: true,
+		},
 		{
 			name:    "no integrations",
 			records: nil,
@@ -99,6 +110,7 @@ func TestType_SupportsTelematics(t *testing.T) {
 	t.Parallel()
 
 	assert.True(t, integration.TypeSamsara.SupportsTelematics())
+	assert.True(t, integration.TypeMotive.SupportsTelematics())
 	assert.False(t, integration.TypeGoogleMaps.SupportsTelematics())
 	assert.False(t, integration.TypePCMiler.SupportsTelematics())
 }
@@ -534,6 +534,36 @@ func (r *Registry) registerAdministrationResources() {
 		DefaultSensitivity: SensitivityRestricted,
 	})
 
+	_ = r.Register(&ResourceDefinition{
+		Resource:    ResourceWebhookSubscription.String(),
+		DisplayName: "Webhook Subscription",
+		Description: "Outbound webhook subscriptions, signing secrets, and delivery logs",
+		Category:    "Administration",
+		Operations: []OperationDefinition{
+			{
+				Operation:   OpRead,
+				DisplayName: "Read",
+				Description: "View webhook subscriptions and their delivery attempts",
+			},
+			{
+				Operation:   OpCreate,
+				DisplayName: "Create",
+				Description: "Create webhook subscriptions",
+			},
+			{
+				Operation:   OpUpdate,
+				DisplayName: "Update",
+				Description: "Modify, enable, and disable subscriptions, rotate secrets, and redeliver",
+			},
+			{
+				Operation:   OpDelete,
+				DisplayName: "Delete",
+				Description: "Delete webhook subscriptions",
+			},
+		},
+		DefaultSensitivity: SensitivityRestricted,
+	})
+
 	_ = r.Register(&ResourceDefinition{
 		Resource:    ResourceHomeLayoutPreset.String(),
 		DisplayName: "Home Screen Preset",
@@ -2286,6 +2316,15 @@ func (r *Registry) registerComplianceResources() {
 		Operations:         GetAllOperations(),
 		DefaultSensitivity: SensitivityInternal,
 	})
+
+	_ = r.Register(&ResourceDefinition{
+		Resource:           ResourceIFTA.String(),
+		DisplayName:        "IFTA",
+		Description:        "Fuel purchases, fuel tax rates, and quarterly IFTA returns",
+		Category:           "Compliance",
+		Operations:         standardOpsWithDelete,
+		DefaultSensitivity: SensitivityRestricted,
+	})
 }
 
 func (r *Registry) registerReferenceDataResources() {
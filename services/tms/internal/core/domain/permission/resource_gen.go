@@ -31,6 +31,7 @@ const (
 	ResourceExternalIdentity      Resource = "external_identity"
 	ResourceMFAAuthenticator      Resource = "mfa_authenticator"
 	ResourceTableChangeAlert      Resource = "table_change_alert"
+	ResourceWebhookSubscription   Resource = "webhook_subscription"
 	ResourceHomeLayoutPreset      Resource = "home_layout_preset"
 	ResourceDocumentTemplate      Resource = "document_template"
 
@@ -149,6 +150,7 @@ const (
 	ResourceDocumentType           Resource = "document_type"
 	ResourceDocumentControl        Resource = "document_control"
 	ResourceDocumentParsingRule    Resource = "document_parsing_rule"
+	ResourceIFTA                   Resource = "ifta"
 
 	// Reference Data
 	ResourceShipmentType Resource = "shipment_type"
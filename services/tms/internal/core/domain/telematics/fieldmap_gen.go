@@ -53,6 +53,13 @@ func (e *VehiclePosition) GetStaticFieldMap() map[string]string {
 	return buncolgen.VehiclePositionFieldMap
 }
 
+// GetStaticFieldMap returns the pre-computed JSON→database column mapping for [VehiclePositionHistory].
+// This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
+// the generated [buncolgen.VehiclePositionHistoryFieldMap] instead of parsing struct tags via reflection.
+func (e *VehiclePositionHistory) GetStaticFieldMap() map[string]string {
+	return buncolgen.VehiclePositionHistoryFieldMap
+}
+
 // GetStaticFieldMap returns the pre-computed JSON→database column mapping for [WorkerHOSLog].
 // This implements [querybuilder.StaticFieldMapper], allowing the QueryBuilder to use
 // the generated [buncolgen.WorkerHOSLogFieldMap] instead of parsing struct tags via reflection.
@@ -0,0 +1,40 @@
+package telematics
+
+import (
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/uptrace/bun"
+)
+
+// VehiclePositionHistory is every fix a tractor reported, where VehiclePosition
+// keeps only the latest. Fuel tax reporting attributes miles to jurisdictions
+// from it, and IFTA requires the underlying distance records to be kept for
+// four years, so it is retained far longer than the rest of the feed.
+type VehiclePositionHistory struct {
+	bun.BaseModel `bun:"table:telematics_vehicle_position_history,alias:tvph" json:"-"`
+
+	OrganizationID    pulid.ID `json:"organizationId"    bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID    pulid.ID `json:"businessUnitId"    bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	TractorID         pulid.ID `json:"tractorId"         bun:"tractor_id,pk,type:VARCHAR(100),notnull"`
+	RecordedAt        int64    `json:"recordedAt"        bun:"recorded_at,pk,type:BIGINT,notnull"`
+	Provider          string   `json:"provider"          bun:"provider,type:VARCHAR(32),notnull"`
+	Latitude          float64  `json:"latitude"          bun:"latitude,type:DOUBLE PRECISION,notnull"`
+	Longitude         float64  `json:"longitude"         bun:"longitude,type:DOUBLE PRECISION,notnull"`
+	SpeedMph          float64  `json:"speedMph"          bun:"speed_mph,type:DOUBLE PRECISION,notnull"`
+	OdometerMeters    *int64   `json:"odometerMeters"    bun:"odometer_meters,type:BIGINT,nullzero"`
+	FormattedLocation string   `json:"formattedLocation" bun:"formatted_location,type:TEXT,nullzero"`
+}
+
+func HistoryFromPosition(position *VehiclePosition) *VehiclePositionHistory {
+	return &VehiclePositionHistory{
+		OrganizationID:    position.OrganizationID,
+		BusinessUnitID:    position.BusinessUnitID,
+		TractorID:         position.TractorID,
+		RecordedAt:        position.RecordedAt,
+		Provider:          position.Provider,
+		Latitude:          position.Latitude,
+		Longitude:         position.Longitude,
+		SpeedMph:          position.SpeedMph,
+		OdometerMeters:    position.OdometerMeters,
+		FormattedLocation: position.FormattedLocation,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/equipmentmanufacturer"
 	"github.com/emoss08/trenova/internal/core/domain/equipmenttype"
 	"github.com/emoss08/trenova/internal/core/domain/fleetcode"
+	"github.com/emoss08/trenova/internal/core/domain/integration"
 	"github.com/emoss08/trenova/internal/core/domain/tenant"
 	"github.com/emoss08/trenova/internal/core/domain/usstate"
 	"github.com/emoss08/trenova/internal/core/domain/worker"
@@ -51,6 +52,7 @@ type Tractor struct {
 	RegistrationExpiry      *int64                      `json:"registrationExpiry"      bun:"registration_expiry,type:BIGINT,nullzero"`
 	Vin                     string                      `json:"vin"                     bun:"vin,type:vin_code_optional,nullzero"`
 	ExternalID              string                      `json:"externalId"              bun:"external_id,type:TEXT,nullzero"`
+	TelematicsProvider      integration.Type            `json:"telematicsProvider"      bun:"telematics_provider,type:VARCHAR(50),nullzero"`
 	OwnershipType           domaintypes.OwnershipType   `json:"ownershipType"           bun:"ownership_type,type:VARCHAR(50),notnull,default:'CompanyOwned'"`
 	OwnerWorkerID           *pulid.ID                   `json:"ownerWorkerId"           bun:"owner_worker_id,type:VARCHAR(100),nullzero"`
 	LessorName              string                      `json:"lessorName"              bun:"lessor_name,type:VARCHAR(150),nullzero"`
@@ -79,6 +81,7 @@ func (t *Tractor) Validate(multiErr *errortypes.MultiError) {
 	multiErr.AddOzzoError(validation.ValidateStruct(
 		t,
 		validation.Field(&t.Code, validation.Required),
+		validation.Field(&t.TelematicsProvider, integration.ValidTelematicsProvider),
 		validation.Field(
 			&t.Code,
 			validation.Length(1, 50).Error("Code must be between 1 and 50 characters"),
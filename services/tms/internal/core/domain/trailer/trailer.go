@@ -7,6 +7,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/equipmentmanufacturer"
 	"github.com/emoss08/trenova/internal/core/domain/equipmenttype"
 	"github.com/emoss08/trenova/internal/core/domain/fleetcode"
+	"github.com/emoss08/trenova/internal/core/domain/integration"
 	"github.com/emoss08/trenova/internal/core/domain/tenant"
 	"github.com/emoss08/trenova/internal/core/domain/usstate"
 	"github.com/emoss08/trenova/pkg/dbtype"
@@ -47,6 +48,7 @@ type Trailer struct {
 	LicensePlateNumber      string                      `json:"licensePlateNumber"      bun:"license_plate_number,type:VARCHAR(50),nullzero"`
 	Vin                     string                      `json:"vin"                     bun:"vin,type:vin_code_optional,nullzero"`
 	ExternalID              string                      `json:"externalId"              bun:"external_id,type:TEXT,nullzero"`
+	TelematicsProvider      integration.Type            `json:"telematicsProvider"      bun:"telematics_provider,type:VARCHAR(50),nullzero"`
 	RegistrationNumber      string                      `json:"registrationNumber"      bun:"registration_number,type:VARCHAR(50),nullzero"`
 	MaxLoadWeight           *int                        `json:"maxLoadWeight"           bun:"max_load_weight,type:INT,nullzero"`
 	OwnershipType           domaintypes.OwnershipType   `json:"ownershipType"           bun:"ownership_type,type:VARCHAR(50),notnull,default:'CompanyOwned'"`
@@ -77,6 +79,7 @@ func (t *Trailer) Validate(multiErr *errortypes.MultiError) {
 	multiErr.AddOzzoError(validation.ValidateStruct(
 		t,
 		validation.Field(&t.Code, validation.Required),
+		validation.Field(&t.TelematicsProvider, integration.ValidTelematicsProvider),
 		validation.Field(
 			&t.Code,
 			validation.Length(1, 50).Error("Code must be between 1 and 50 characters"),
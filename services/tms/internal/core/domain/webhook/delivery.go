@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/pkg/domaintypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook      = (*Delivery)(nil)
+	_ bun.BeforeAppendModelHook      = (*DeliveryAttempt)(nil)
+	_ domaintypes.PostgresSearchable = (*Delivery)(nil)
+)
+
+// MaxResponseBodyLength bounds how much of a receiver's response an attempt
+// keeps; enough to read an error message, not enough to store a page.
+const MaxResponseBodyLength = 2048
+
+// Delivery is one event owed to one subscription. Its payload is the exact
+// body sent on every attempt, so a redelivery is byte-for-byte the original.
+type Delivery struct {
+	bun.BaseModel `bun:"table:webhook_deliveries,alias:whd" json:"-"`
+
+	pagination.CursorValueSet `json:"-" bun:",embed"`
+
+	ID             pulid.ID         `json:"id"             bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID pulid.ID         `json:"businessUnitId" bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	OrganizationID pulid.ID         `json:"organizationId" bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+	SubscriptionID pulid.ID         `json:"subscriptionId" bun:"subscription_id,type:VARCHAR(100),notnull"`
+	EventID        pulid.ID         `json:"eventId"        bun:"event_id,type:VARCHAR(100),notnull"`
+	EventType      domainevent.Type `json:"eventType"      bun:"event_type,type:VARCHAR(100),notnull"`
+	EventVersion   int              `json:"eventVersion"   bun:"event_version,type:INTEGER,notnull,default:1"`
+	IdempotencyKey string           `json:"idempotencyKey" bun:"idempotency_key,type:VARCHAR(255),notnull"`
+	Payload        map[string]any   `json:"payload"        bun:"payload,type:JSONB,notnull"`
+	OccurredAt     int64            `json:"occurredAt"     bun:"occurred_at,notnull"`
+	Status         DeliveryStatus   `json:"status"         bun:"status,type:webhook_delivery_status_enum,notnull,default:'Pending'"`
+	AttemptCount   int              `json:"attemptCount"   bun:"attempt_count,type:INTEGER,notnull,default:0"`
+	LastStatusCode int              `json:"lastStatusCode" bun:"last_status_code,type:INTEGER,nullzero"`
+	LastError      string           `json:"lastError"      bun:"last_error,type:TEXT,nullzero"`
+	LastAttemptAt  int64            `json:"lastAttemptAt"  bun:"last_attempt_at,nullzero"`
+	DeliveredAt    int64            `json:"deliveredAt"    bun:"delivered_at,nullzero"`
+	CreatedAt      int64            `json:"createdAt"      bun:"created_at,notnull"`
+	UpdatedAt      int64            `json:"updatedAt"      bun:"updated_at,notnull"`
+}
+
+func (d *Delivery) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	now := timeutils.NowUnix()
+
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if d.ID.IsNil() {
+			d.ID = pulid.MustNew("whd_")
+		}
+		d.CreatedAt = now
+		d.UpdatedAt = now
+	case *bun.UpdateQuery:
+		d.UpdatedAt = now
+	}
+
+	return nil
+}
+
+func (d *Delivery) GetID() pulid.ID {
+	return d.ID
+}
+
+func (d *Delivery) GetCreatedAt() int64 {
+	return d.CreatedAt
+}
+
+func (d *Delivery) GetOrganizationID() pulid.ID {
+	return d.OrganizationID
+}
+
+func (d *Delivery) GetBusinessUnitID() pulid.ID {
+	return d.BusinessUnitID
+}
+
+func (d *Delivery) GetTableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *Delivery) GetPostgresSearchConfig() domaintypes.PostgresSearchConfig {
+	return domaintypes.PostgresSearchConfig{
+		TableAlias: "whd",
+		SearchableFields: []domaintypes.SearchableField{
+			{Name: "eventType", Type: domaintypes.FieldTypeText},
+			{Name: "status", Type: domaintypes.FieldTypeEnum},
+			{Name: "lastStatusCode", Type: domaintypes.FieldTypeNumber},
+			{Name: "createdAt", Type: domaintypes.FieldTypeNumber},
+		},
+	}
+}
+
+// DeliveryAttempt is one HTTP request made for a delivery, kept so an
+// integrator can see what their endpoint answered.
+type DeliveryAttempt struct {
+	bun.BaseModel `bun:"table:webhook_delivery_attempts,alias:wha" json:"-"`
+
+	ID             pulid.ID       `json:"id"             bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID pulid.ID       `json:"businessUnitId" bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	OrganizationID pulid.ID       `json:"organizationId" bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+	DeliveryID     pulid.ID       `json:"deliveryId"     bun:"delivery_id,type:VARCHAR(100),notnull"`
+	SubscriptionID pulid.ID       `json:"subscriptionId" bun:"subscription_id,type:VARCHAR(100),notnull"`
+	AttemptNumber  int            `json:"attemptNumber"  bun:"attempt_number,type:INTEGER,notnull"`
+	Trigger        AttemptTrigger `json:"trigger"        bun:"trigger,type:VARCHAR(20),notnull,default:'Automatic'"`
+	StatusCode     int            `json:"statusCode"     bun:"status_code,type:INTEGER,nullzero"`
+	ResponseBody   string         `json:"responseBody"   bun:"response_body,type:TEXT,nullzero"`
+	Error          string         `json:"error"          bun:"error,type:TEXT,nullzero"`
+	DurationMs     int64          `json:"durationMs"     bun:"duration_ms,type:BIGINT,notnull,default:0"`
+	Succeeded      bool           `json:"succeeded"      bun:"succeeded,type:BOOLEAN,notnull,default:false"`
+	AttemptedAt    int64          `json:"attemptedAt"    bun:"attempted_at,notnull"`
+}
+
+func (a *DeliveryAttempt) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	if _, ok := query.(*bun.InsertQuery); ok {
+		if a.ID.IsNil() {
+			a.ID = pulid.MustNew("wha_")
+		}
+		if a.AttemptedAt == 0 {
+			a.AttemptedAt = timeutils.NowUnix()
+		}
+	}
+
+	return nil
+}
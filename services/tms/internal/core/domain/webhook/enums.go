@@ -0,0 +1,55 @@
+package webhook
+
+import "github.com/emoss08/trenova/internal/core/domain/domainevent"
+
+type Status string
+
+const (
+	StatusActive   = Status("Active")
+	StatusDisabled = Status("Disabled")
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   = DeliveryStatus("Pending")
+	DeliveryStatusSucceeded = DeliveryStatus("Succeeded")
+	DeliveryStatusFailed    = DeliveryStatus("Failed")
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+type AttemptTrigger string
+
+const (
+	// AttemptTriggerAutomatic covers the first send and every scheduled retry.
+	AttemptTriggerAutomatic = AttemptTrigger("Automatic")
+	// AttemptTriggerManual is an operator or integrator asking for a
+	// delivery to be sent again.
+	AttemptTriggerManual = AttemptTrigger("Manual")
+)
+
+// EventTypes are the domain events a customer can subscribe to. Each payload
+// carries the shipment's customer, which is what customer-scoped
+// subscriptions filter on. Carrier and settlement events stay internal.
+var EventTypes = []domainevent.Type{
+	domainevent.TypeShipmentStatusChanged,
+	domainevent.TypeShipmentETAChanged,
+	domainevent.TypeShipmentPODAvailable,
+	domainevent.TypeInvoicePosted,
+}
+
+func IsSubscribableEventType(eventType domainevent.Type) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/pkg/domaintypes"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/pkg/validationframework"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook          = (*Subscription)(nil)
+	_ validationframework.TenantedEntity = (*Subscription)(nil)
+	_ domaintypes.PostgresSearchable     = (*Subscription)(nil)
+)
+
+const (
+	// AutoDisableThreshold is how many deliveries in a row may exhaust their
+	// retries before the subscription is disabled. A success resets the count.
+	AutoDisableThreshold = 10
+
+	// SecretRotationGrace is how long deliveries stay signed with the previous
+	// secret as well after a rotation, so receivers can deploy the new one.
+	SecretRotationGrace int64 = 24 * 60 * 60
+
+	maxNameLength = 255
+	maxURLLength  = 2048
+)
+
+type Subscription struct {
+	bun.BaseModel `bun:"table:webhook_subscriptions,alias:whs" json:"-"`
+
+	pagination.CursorValueSet `json:"-" bun:",embed"`
+
+	ID                      pulid.ID           `json:"id"                      bun:"id,pk,type:VARCHAR(100),notnull"`
+	BusinessUnitID          pulid.ID           `json:"businessUnitId"          bun:"business_unit_id,pk,type:VARCHAR(100),notnull"`
+	OrganizationID          pulid.ID           `json:"organizationId"          bun:"organization_id,pk,type:VARCHAR(100),notnull"`
+	Name                    string             `json:"name"                    bun:"name,type:VARCHAR(255),notnull"`
+	Description             string             `json:"description"             bun:"description,type:TEXT,nullzero"`
+	URL                     string             `json:"url"                     bun:"url,type:VARCHAR(2048),notnull"`
+	CustomerID              pulid.ID           `json:"customerId"              bun:"customer_id,type:VARCHAR(100),nullzero"`
+	APIKeyID                pulid.ID           `json:"apiKeyId"                bun:"api_key_id,type:VARCHAR(100),nullzero"`
+	EventTypes              []domainevent.Type `json:"eventTypes"              bun:"event_types,type:JSONB,notnull,default:'[]'"`
+	Status                  Status             `json:"status"                  bun:"status,type:webhook_subscription_status_enum,notnull,default:'Active'"`
+	SigningSecret           string             `json:"-"                       bun:"signing_secret,type:TEXT,notnull"`
+	SecretHint              string             `json:"secretHint"              bun:"secret_hint,type:VARCHAR(32),notnull"`
+	PreviousSigningSecret   string             `json:"-"                       bun:"previous_signing_secret,type:TEXT,nullzero"`
+	PreviousSecretExpiresAt int64              `json:"previousSecretExpiresAt" bun:"previous_secret_expires_at,nullzero"`
+	SecretRotatedAt         int64              `json:"secretRotatedAt"         bun:"secret_rotated_at,nullzero"`
+	ConsecutiveFailures     int                `json:"consecutiveFailures"     bun:"consecutive_failures,type:INTEGER,notnull,default:0"`
+	DisabledAt              int64              `json:"disabledAt"              bun:"disabled_at,nullzero"`
+	DisabledReason          string             `json:"disabledReason"          bun:"disabled_reason,type:TEXT,nullzero"`
+	LastDeliveredAt         int64              `json:"lastDeliveredAt"         bun:"last_delivered_at,nullzero"`
+	CreatedByID             pulid.ID           `json:"createdById"             bun:"created_by_id,type:VARCHAR(100),nullzero"`
+	Version                 int64              `json:"version"                 bun:"version,type:BIGINT"`
+	CreatedAt               int64              `json:"createdAt"               bun:"created_at,notnull"`
+	UpdatedAt               int64              `json:"updatedAt"               bun:"updated_at,notnull"`
+}
+
+func (s *Subscription) Validate(multiErr *errortypes.MultiError) {
+	multiErr.AddOzzoError(validation.ValidateStruct(s,
+		validation.Field(&s.OrganizationID,
+			validation.Required.Error("Organization is required"),
+		),
+		validation.Field(&s.BusinessUnitID,
+			validation.Required.Error("Business unit is required"),
+		),
+		validation.Field(&s.Name,
+			validation.Required.Error("Name is required"),
+			validation.Length(1, maxNameLength).
+				Error("Name cannot be longer than 255 characters"),
+		),
+		validation.Field(&s.URL,
+			validation.Required.Error("URL is required"),
+			validation.Length(1, maxURLLength).
+				Error("URL cannot be longer than 2048 characters"),
+			validation.By(validateEndpointURL),
+		),
+		validation.Field(&s.EventTypes,
+			validation.Required.Error("At least one event type is required"),
+		),
+		validation.Field(&s.Status,
+			validation.Required.Error("Status is required"),
+			validation.In(StatusActive, StatusDisabled).Error("Status is invalid"),
+		),
+		// The secret is generated by the service and never accepted from a
+		// caller, so an empty one means the subscription could sign nothing.
+		validation.Field(&s.SigningSecret,
+			validation.Required.Error("Signing secret is required"),
+		),
+	))
+
+	// A subscription with neither scope would receive every customer's
+	// shipments in the organization.
+	if s.CustomerID.IsNil() && s.APIKeyID.IsNil() {
+		multiErr.Add(
+			"customerId",
+			errortypes.ErrRequired,
+			"A subscription must be scoped to a customer or an API key",
+		)
+	}
+
+	seen := make(map[domainevent.Type]struct{}, len(s.EventTypes))
+	for _, eventType := range s.EventTypes {
+		if !IsSubscribableEventType(eventType) {
+			multiErr.Add(
+				"eventTypes",
+				errortypes.ErrInvalid,
+				"Event type "+string(eventType)+" cannot be subscribed to",
+			)
+			continue
+		}
+		if _, ok := seen[eventType]; ok {
+			multiErr.Add(
+				"eventTypes",
+				errortypes.ErrDuplicate,
+				"Event type "+string(eventType)+" is listed more than once",
+			)
+		}
+		seen[eventType] = struct{}{}
+	}
+}
+
+// Matches reports whether an event belongs to this subscription. A
+// customer-scoped subscription only receives events about that customer; one
+// scoped to an API key alone receives the organization's events of the
+// subscribed types.
+func (s *Subscription) Matches(eventType domainevent.Type, customerID pulid.ID) bool {
+	if s.Status != StatusActive {
+		return false
+	}
+	if s.CustomerID.IsNotNil() && s.CustomerID != customerID {
+		return false
+	}
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPreviousSecret reports whether deliveries at now are still signed with
+// the secret replaced by the last rotation.
+func (s *Subscription) HasPreviousSecret(now int64) bool {
+	return s.PreviousSigningSecret != "" && s.PreviousSecretExpiresAt > now
+}
+
+func (s *Subscription) Enable() {
+	s.Status = StatusActive
+	s.ConsecutiveFailures = 0
+	s.DisabledAt = 0
+	s.DisabledReason = ""
+}
+
+func (s *Subscription) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	now := timeutils.NowUnix()
+
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if s.ID.IsNil() {
+			s.ID = pulid.MustNew("whs_")
+		}
+		s.CreatedAt = now
+		s.UpdatedAt = now
+	case *bun.UpdateQuery:
+		s.UpdatedAt = now
+	}
+
+	return nil
+}
+
+func (s *Subscription) GetID() pulid.ID {
+	return s.ID
+}
+
+func (s *Subscription) GetCreatedAt() int64 {
+	return s.CreatedAt
+}
+
+func (s *Subscription) GetOrganizationID() pulid.ID {
+	return s.OrganizationID
+}
+
+func (s *Subscription) GetBusinessUnitID() pulid.ID {
+	return s.BusinessUnitID
+}
+
+func (s *Subscription) GetTableName() string {
+	return "webhook_subscriptions"
+}
+
+func (s *Subscription) GetPostgresSearchConfig() domaintypes.PostgresSearchConfig {
+	return domaintypes.PostgresSearchConfig{
+		TableAlias: "whs",
+		SearchableFields: []domaintypes.SearchableField{
+			{Name: "name", Type: domaintypes.FieldTypeText},
+			{Name: "url", Type: domaintypes.FieldTypeText},
+			{Name: "status", Type: domaintypes.FieldTypeEnum},
+			{Name: "lastDeliveredAt", Type: domaintypes.FieldTypeNumber},
+			{Name: "createdAt", Type: domaintypes.FieldTypeNumber},
+			{Name: "updatedAt", Type: domaintypes.FieldTypeNumber},
+		},
+	}
+}
+
+var errEndpointURL = errors.New("URL must be an absolute https URL to a public host")
+
+// validateEndpointURL keeps integrators from pointing deliveries back into
+// the network the TMS runs in. Host names are checked again when the
+// delivery connects, since DNS can resolve anywhere.
+func validateEndpointURL(value any) error {
+	raw, _ := value.(string)
+	if raw == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme != "https" || parsed.Hostname() == "" || parsed.User != nil {
+		return errEndpointURL
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errEndpointURL
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return errEndpointURL
+	}
+
+	return nil
+}
+
+// IsPublicIP reports whether a delivery may connect to ip.
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/emoss08/trenova/internal/core/domain/customfield"
+	"github.com/emoss08/trenova/internal/core/domain/dispatchcontrol"
 	"github.com/emoss08/trenova/internal/core/domain/fleetcode"
+	"github.com/emoss08/trenova/internal/core/domain/integration"
 	"github.com/emoss08/trenova/internal/core/domain/tenant"
 	"github.com/emoss08/trenova/internal/core/domain/usstate"
 	"github.com/emoss08/trenova/pkg/domaintypes"
@@ -40,6 +42,8 @@ type Worker struct {
 	OrganizationID        pulid.ID           `json:"organizationId"              bun:"organization_id,type:VARCHAR(100),notnull,pk"`
 	StateID               pulid.ID           `json:"stateId"                     bun:"state_id,type:VARCHAR(100),notnull"`
 	FleetCodeID           pulid.ID           `json:"fleetCodeId"                 bun:"fleet_code_id,type:VARCHAR(100),nullzero"`
+	HomeLocationID        pulid.ID           `json:"homeLocationId"              bun:"home_location_id,type:VARCHAR(100),nullzero"`
+	HomeTimeMaxDaysOut    *int16             `json:"homeTimeMaxDaysOut"          bun:"home_time_max_days_out,type:SMALLINT,nullzero"`
 	ManagerID             pulid.ID           `json:"managerId"                   bun:"manager_id,type:VARCHAR(100),nullzero"`
 	UserID                pulid.ID           `json:"userId"                      bun:"user_id,type:VARCHAR(100),nullzero"`
 	Status                domaintypes.Status `json:"status"                      bun:"status,type:status_enum,notnull,default:'Active'"`
@@ -58,6 +62,7 @@ type Worker struct {
 	EmergencyContactName  string             `json:"emergencyContactName"        bun:"emergency_contact_name,type:VARCHAR(100),nullzero"`
 	EmergencyContactPhone string             `json:"emergencyContactPhone"       bun:"emergency_contact_phone,type:VARCHAR(20),nullzero"`
 	ExternalID            string             `json:"externalId"                  bun:"external_id,type:TEXT,nullzero"`
+	TelematicsProvider    integration.Type   `json:"telematicsProvider"          bun:"telematics_provider,type:VARCHAR(50),nullzero"`
 	SearchVector          string             `json:"-"                           bun:"search_vector,type:TSVECTOR,scanonly"`
 	Rank                  string             `json:"-"                           bun:"rank,type:VARCHAR(100),scanonly"`
 	AssignmentBlocked     string             `json:"assignmentBlocked,omitempty" bun:"assignment_blocked,type:VARCHAR(255),nullzero"`
@@ -85,6 +90,7 @@ func (w *Worker) Validate(multiErr *errortypes.MultiError) {
 			validation.Required.Error("Type is required"),
 			domainvalidation.ValidEnum[WorkerType]("type must be either Employee or Contractor"),
 		),
+		validation.Field(&w.TelematicsProvider, integration.ValidTelematicsProvider),
 		validation.Field(&w.FirstName,
 			validation.Required.Error("First Name is required"),
 			validation.Length(1, 100).Error("First Name must be between 1 and 100 characters"),
@@ -128,6 +134,12 @@ func (w *Worker) Validate(multiErr *errortypes.MultiError) {
 				return nil
 			}),
 		),
+		validation.Field(&w.HomeTimeMaxDaysOut,
+			validation.Min(int16(1)).Error("Home time max days out must be greater than 0"),
+			validation.Max(dispatchcontrol.MaxHorizonDaysOut).Error(
+				"Home time max days out must be 30 or fewer",
+			),
+		),
 		validation.Field(&w.Status,
 			validation.Required.Error("Status is required"),
 			validation.In(domaintypes.StatusActive, domaintypes.StatusInactive).
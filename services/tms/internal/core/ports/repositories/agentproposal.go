@@ -34,6 +34,12 @@ type ExpireAgentProposalsByRunRequest struct {
 	TenantInfo pagination.TenantInfo `json:"-"`
 }
 
+type ListAgentProposalsByTourRequest struct {
+	RunID      pulid.ID              `json:"runId"`
+	TourID     pulid.ID              `json:"tourId"`
+	TenantInfo pagination.TenantInfo `json:"-"`
+}
+
 type AgentProposalRepository interface {
 	List(
 		ctx context.Context,
@@ -50,4 +56,8 @@ type AgentProposalRepository interface {
 		req UpdateAgentProposalStatusRequest,
 	) (*agent.AgentProposal, error)
 	ExpirePendingByRun(ctx context.Context, req ExpireAgentProposalsByRunRequest) (int, error)
+	ListPendingByTour(
+		ctx context.Context,
+		req ListAgentProposalsByTourRequest,
+	) ([]*agent.AgentProposal, error)
 }
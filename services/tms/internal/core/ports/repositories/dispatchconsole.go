@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 
+	"github.com/emoss08/trenova/internal/core/domain/locationcategory"
 	"github.com/emoss08/trenova/internal/core/domain/shipment"
 	"github.com/emoss08/trenova/internal/core/domain/tractor"
 	"github.com/emoss08/trenova/internal/core/domain/trailer"
@@ -110,6 +111,11 @@ type BoardDriver struct {
 	TractorStatusOK bool     `bun:"tractor_status_ok" json:"tractorAvailable"`
 
 	OpenAssignments int `bun:"open_assignments" json:"openAssignments"`
+
+	HomeLocationID     pulid.ID `bun:"home_location_id"       json:"homeLocationId"`
+	HomeLatitude       *float64 `bun:"home_latitude"          json:"homeLatitude"`
+	HomeLongitude      *float64 `bun:"home_longitude"         json:"homeLongitude"`
+	HomeTimeMaxDaysOut *int16   `bun:"home_time_max_days_out" json:"homeTimeMaxDaysOut"`
 }
 
 type WorkerCommitment struct {
@@ -201,6 +207,28 @@ type ListEquipmentByIDsRequest struct {
 	TrailerIDs []pulid.ID
 }
 
+type ListRelayPointsRequest struct {
+	TenantInfo   pagination.TenantInfo
+	Categories   []locationcategory.Category
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+	Limit        int
+}
+
+// RelayPoint is a geocoded location where one driver can hand a load, or a tractor, to
+// another: a company terminal, a truck stop or any other category the caller asks for.
+type RelayPoint struct {
+	LocationID pulid.ID                  `bun:"location_id" json:"locationId"`
+	Name       string                    `bun:"name"        json:"name"`
+	City       string                    `bun:"city"        json:"city"`
+	State      string                    `bun:"state_abbr"  json:"state"`
+	Latitude   float64                   `bun:"latitude"    json:"latitude"`
+	Longitude  float64                   `bun:"longitude"   json:"longitude"`
+	Category   locationcategory.Category `bun:"category"    json:"category"`
+}
+
 type DispatchConsoleRepository interface {
 	ListBoardMoves(ctx context.Context, filter *DispatchBoardFilter) ([]*BoardMove, error)
 	ListBoardDrivers(ctx context.Context, filter *DispatchBoardFilter) ([]*BoardDriver, error)
@@ -232,4 +260,5 @@ type DispatchConsoleRepository interface {
 		ctx context.Context,
 		req *ListEquipmentByIDsRequest,
 	) ([]*tractor.Tractor, []*trailer.Trailer, error)
+	ListRelayPoints(ctx context.Context, req *ListRelayPointsRequest) ([]*RelayPoint, error)
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+)
+
+type DomainEventOutboxRepository interface {
+	// Append writes events through the transaction carried by ctx, so they
+	// commit or roll back with the change they describe. An event whose
+	// idempotency key is already in the outbox is skipped.
+	Append(ctx context.Context, events ...*domainevent.Event) error
+}
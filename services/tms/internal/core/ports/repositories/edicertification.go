@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+type ListEDICertificationRunsRequest struct {
+	Filter       *pagination.QueryOptions   `json:"filter"`
+	EDIPartnerID pulid.ID                   `json:"ediPartnerId"`
+	Status       edi.CertificationRunStatus `json:"status"`
+}
+
+type GetEDICertificationRunByIDRequest struct {
+	ID         pulid.ID              `json:"id"`
+	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
+}
+
+// GetLatestEDICertificationRunRequest resolves the most recent run for a
+// partner or, when EDIConnectionID is set, for a connection. Connection
+// lookups are scoped by business unit only because the accepting side of a
+// connection does not own the run.
+type GetLatestEDICertificationRunRequest struct {
+	TenantInfo      pagination.TenantInfo `json:"tenantInfo"`
+	EDIPartnerID    pulid.ID              `json:"ediPartnerId"`
+	EDIConnectionID pulid.ID              `json:"ediConnectionId"`
+}
+
+// ListEDICertificationMessagesRequest selects the partner messages a
+// certification step judges: either the explicit MessageIDs the run sent, or
+// every message in a direction and transaction set created since a step
+// started.
+type ListEDICertificationMessagesRequest struct {
+	TenantInfo     pagination.TenantInfo `json:"tenantInfo"`
+	EDIPartnerID   pulid.ID              `json:"ediPartnerId"`
+	MessageIDs     []pulid.ID            `json:"messageIds"`
+	Direction      edi.DocumentDirection `json:"direction"`
+	TransactionSet edi.TransactionSet    `json:"transactionSet"`
+	Since          int64                 `json:"since"`
+}
+
+type EDICertificationRunRepository interface {
+	ListCertificationRuns(
+		ctx context.Context,
+		req *ListEDICertificationRunsRequest,
+	) (*pagination.ListResult[*edi.EDICertificationRun], error)
+	GetCertificationRunByID(
+		ctx context.Context,
+		req GetEDICertificationRunByIDRequest,
+	) (*edi.EDICertificationRun, error)
+	GetLatestCertificationRun(
+		ctx context.Context,
+		req GetLatestEDICertificationRunRequest,
+	) (*edi.EDICertificationRun, error)
+	CreateCertificationRun(
+		ctx context.Context,
+		entity *edi.EDICertificationRun,
+	) (*edi.EDICertificationRun, error)
+	UpdateCertificationRun(
+		ctx context.Context,
+		entity *edi.EDICertificationRun,
+	) (*edi.EDICertificationRun, error)
+	ListCertificationMessages(
+		ctx context.Context,
+		req ListEDICertificationMessagesRequest,
+	) ([]*edi.EDIMessage, error)
+}
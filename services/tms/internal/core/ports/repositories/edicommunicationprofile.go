@@ -38,6 +38,10 @@ type GetActiveAS2ProfileByIdentifiersRequest struct {
 	PartnerAS2ID string `json:"partnerAs2Id"`
 }
 
+type GetActiveHTTPSProfileByIDRequest struct {
+	ID pulid.ID `json:"id"`
+}
+
 type RecordEDIProfilePollOutcomeRequest struct {
 	ProfileID  pulid.ID
 	TenantInfo pagination.TenantInfo
@@ -51,6 +55,10 @@ type EDICommunicationProfileRepository interface {
 		ctx context.Context,
 		req GetActiveAS2ProfileByIdentifiersRequest,
 	) (*edi.EDICommunicationProfile, error)
+	GetActiveHTTPSProfileByID(
+		ctx context.Context,
+		req GetActiveHTTPSProfileByIDRequest,
+	) (*edi.EDICommunicationProfile, error)
 	ListProfiles(
 		ctx context.Context,
 		req *ListEDICommunicationProfilesRequest,
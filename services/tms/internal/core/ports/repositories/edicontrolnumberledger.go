@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+type ListEDIControlNumberLedgerRequest struct {
+	Filter       *pagination.QueryOptions `json:"filter"`
+	EDIPartnerID pulid.ID                 `json:"ediPartnerId"`
+	Direction    edi.DocumentDirection    `json:"direction"`
+	Kind         edi.ControlNumberKind    `json:"kind"`
+	Anomaly      edi.ControlNumberAnomaly `json:"anomaly"`
+}
+
+// ControlNumberSequenceRequest identifies one sequence in a partner's ledger.
+type ControlNumberSequenceRequest struct {
+	TenantInfo    pagination.TenantInfo `json:"tenantInfo"`
+	EDIPartnerID  pulid.ID              `json:"ediPartnerId"`
+	Direction     edi.DocumentDirection `json:"direction"`
+	Kind          edi.ControlNumberKind `json:"kind"`
+	SequenceScope string                `json:"sequenceScope"`
+}
+
+type FindControlNumberEntryRequest struct {
+	Sequence      ControlNumberSequenceRequest `json:"sequence"`
+	ControlNumber string                       `json:"controlNumber"`
+}
+
+// FindResentTransactionRequest matches a transaction whose content, including
+// its ST02, was already received from the partner.
+type FindResentTransactionRequest struct {
+	TenantInfo     pagination.TenantInfo `json:"tenantInfo"`
+	EDIPartnerID   pulid.ID              `json:"ediPartnerId"`
+	TransactionSet edi.TransactionSet    `json:"transactionSet"`
+	ContentHash    string                `json:"contentHash"`
+}
+
+type UpdateTransactionOutcomeRequest struct {
+	TenantInfo pagination.TenantInfo    `json:"tenantInfo"`
+	EntryID    pulid.ID                 `json:"entryId"`
+	Outcome    edi.ControlNumberOutcome `json:"outcome"`
+}
+
+type SummarizeEDIControlNumberLedgerRequest struct {
+	TenantInfo   pagination.TenantInfo `json:"tenantInfo"`
+	EDIPartnerID pulid.ID              `json:"ediPartnerId"`
+}
+
+type EDIControlNumberLedgerRepository interface {
+	ListLedgerEntries(
+		ctx context.Context,
+		req *ListEDIControlNumberLedgerRequest,
+	) (*pagination.ListResult[*edi.EDIControlNumberLedgerEntry], error)
+	SummarizeLedger(
+		ctx context.Context,
+		req SummarizeEDIControlNumberLedgerRequest,
+	) ([]*edi.ControlNumberLedgerSummary, error)
+	// GetHighestSequence returns the largest numeric control number recorded
+	// in the sequence, or zero when the sequence is empty.
+	GetHighestSequence(ctx context.Context, req ControlNumberSequenceRequest) (int64, error)
+	// FindControlNumberEntry returns the earliest entry carrying the control
+	// number, or nil when the sequence has never seen it.
+	FindControlNumberEntry(
+		ctx context.Context,
+		req FindControlNumberEntryRequest,
+	) (*edi.EDIControlNumberLedgerEntry, error)
+	// FindResentTransaction returns the entry holding the transaction's
+	// content, or nil when it is new or every earlier copy was rejected or
+	// failed.
+	FindResentTransaction(
+		ctx context.Context,
+		req FindResentTransactionRequest,
+	) (*edi.EDIControlNumberLedgerEntry, error)
+	CreateLedgerEntry(
+		ctx context.Context,
+		entity *edi.EDIControlNumberLedgerEntry,
+	) (*edi.EDIControlNumberLedgerEntry, error)
+	// ClaimTransactionEntry inserts an inbound transaction entry unless another
+	// entry already holds its content, in which case it returns nil.
+	ClaimTransactionEntry(
+		ctx context.Context,
+		entity *edi.EDIControlNumberLedgerEntry,
+	) (*edi.EDIControlNumberLedgerEntry, error)
+	UpdateTransactionOutcome(ctx context.Context, req UpdateTransactionOutcomeRequest) error
+}
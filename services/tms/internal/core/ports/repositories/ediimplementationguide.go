@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+type ListEDIImplementationGuidesRequest struct {
+	Filter         *pagination.QueryOptions `json:"filter"`
+	EDIPartnerID   pulid.ID                 `json:"ediPartnerId"`
+	TransactionSet edi.TransactionSet       `json:"transactionSet"`
+}
+
+type GetEDIImplementationGuideByIDRequest struct {
+	ID         pulid.ID              `json:"id"`
+	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
+}
+
+type DeleteEDIImplementationGuideRequest struct {
+	ID         pulid.ID              `json:"id"`
+	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
+}
+
+// GetActiveEDIImplementationGuideRequest resolves the guide that applies to a
+// partner's document: the partner's own active guide first, then the tenant's
+// partner-less default.
+type GetActiveEDIImplementationGuideRequest struct {
+	TenantInfo     pagination.TenantInfo `json:"tenantInfo"`
+	PartnerID      pulid.ID              `json:"partnerId"`
+	TransactionSet edi.TransactionSet    `json:"transactionSet"`
+	X12Version     string                `json:"x12Version"`
+	Direction      edi.DocumentDirection `json:"direction"`
+}
+
+// DeactivateEDIImplementationGuidesRequest clears the active flag on every
+// other guide in the same partner, transaction set, version, and direction.
+type DeactivateEDIImplementationGuidesRequest struct {
+	TenantInfo     pagination.TenantInfo `json:"tenantInfo"`
+	PartnerID      pulid.ID              `json:"partnerId"`
+	TransactionSet edi.TransactionSet    `json:"transactionSet"`
+	X12Version     string                `json:"x12Version"`
+	Direction      edi.DocumentDirection `json:"direction"`
+	ExceptID       pulid.ID              `json:"exceptId"`
+}
+
+type EDIImplementationGuideRepository interface {
+	ListImplementationGuides(
+		ctx context.Context,
+		req *ListEDIImplementationGuidesRequest,
+	) (*pagination.ListResult[*edi.EDIImplementationGuide], error)
+	GetImplementationGuideByID(
+		ctx context.Context,
+		req GetEDIImplementationGuideByIDRequest,
+	) (*edi.EDIImplementationGuide, error)
+	GetActiveImplementationGuide(
+		ctx context.Context,
+		req GetActiveEDIImplementationGuideRequest,
+	) (*edi.EDIImplementationGuide, error)
+	CreateImplementationGuide(
+		ctx context.Context,
+		entity *edi.EDIImplementationGuide,
+	) (*edi.EDIImplementationGuide, error)
+	UpdateImplementationGuide(
+		ctx context.Context,
+		entity *edi.EDIImplementationGuide,
+	) (*edi.EDIImplementationGuide, error)
+	DeactivateImplementationGuides(
+		ctx context.Context,
+		req DeactivateEDIImplementationGuidesRequest,
+	) error
+	DeleteImplementationGuide(ctx context.Context, req DeleteEDIImplementationGuideRequest) error
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/emoss08/trenova/internal/core/domain/telematics"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+// TrackedTractor is a tractor with position history in a reporting window.
+type TrackedTractor struct {
+	TractorID pulid.ID `bun:"tractor_id"`
+	Code      string   `bun:"code"`
+}
+
+// ListTrackedTractorsRequest selects tractors that reported a position in the
+// half-open window [Start, End).
+type ListTrackedTractorsRequest struct {
+	TenantInfo pagination.TenantInfo
+	Start      int64
+	End        int64
+}
+
+// ListPositionHistoryRequest reads one tractor's fixes at a time. A quarter of
+// one-minute fixes is over a hundred thousand rows per truck, so the fleet is
+// never loaded at once.
+type ListPositionHistoryRequest struct {
+	TenantInfo pagination.TenantInfo
+	TractorID  pulid.ID
+	Start      int64
+	End        int64
+}
+
+type ListFuelPurchasesRequest struct {
+	TenantInfo pagination.TenantInfo
+	Start      int64
+	End        int64
+	TractorID  pulid.ID
+	FuelType   ifta.FuelType
+}
+
+type GetFuelPurchaseByIDRequest struct {
+	TenantInfo pagination.TenantInfo
+	PurchaseID pulid.ID
+}
+
+type ListIFTATaxRatesRequest struct {
+	TenantInfo pagination.TenantInfo
+	Period     ifta.Quarter
+	FuelType   ifta.FuelType
+}
+
+type ResolveTractorCodesRequest struct {
+	TenantInfo pagination.TenantInfo
+	Codes      []string
+}
+
+type IFTARepository interface {
+	ListTrackedTractors(
+		ctx context.Context,
+		req *ListTrackedTractorsRequest,
+	) ([]*TrackedTractor, error)
+	ListPositionHistory(
+		ctx context.Context,
+		req *ListPositionHistoryRequest,
+	) ([]*telematics.VehiclePositionHistory, error)
+	// ListJurisdictionBoundaries returns every state and province outline on
+	// file; the table is empty until an operator loads the boundary files.
+	ListJurisdictionBoundaries(ctx context.Context) ([]*ifta.JurisdictionBoundary, error)
+
+	ListFuelPurchases(
+		ctx context.Context,
+		req *ListFuelPurchasesRequest,
+	) ([]*ifta.FuelPurchase, error)
+	GetFuelPurchaseByID(
+		ctx context.Context,
+		req *GetFuelPurchaseByIDRequest,
+	) (*ifta.FuelPurchase, error)
+	CreateFuelPurchase(ctx context.Context, entity *ifta.FuelPurchase) (*ifta.FuelPurchase, error)
+	UpdateFuelPurchase(ctx context.Context, entity *ifta.FuelPurchase) (*ifta.FuelPurchase, error)
+	DeleteFuelPurchase(ctx context.Context, req *GetFuelPurchaseByIDRequest) error
+	// ImportFuelPurchases inserts the batch, skipping any receipt already on
+	// file for the same tractor, and returns how many rows were new.
+	ImportFuelPurchases(ctx context.Context, entities []*ifta.FuelPurchase) (int, error)
+
+	ListTaxRates(ctx context.Context, req *ListIFTATaxRatesRequest) ([]*ifta.TaxRate, error)
+	// UpsertTaxRates replaces the rate for each jurisdiction, quarter and fuel
+	// type in the batch, which is how a corrected rate matrix is re-entered.
+	UpsertTaxRates(ctx context.Context, entities []*ifta.TaxRate) ([]*ifta.TaxRate, error)
+
+	ResolveTractorCodes(
+		ctx context.Context,
+		req *ResolveTractorCodesRequest,
+	) (map[string]pulid.ID, error)
+}
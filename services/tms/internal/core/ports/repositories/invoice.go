@@ -28,6 +28,11 @@ type GetInvoiceByBillingQueueItemIDRequest struct {
 	TenantInfo         pagination.TenantInfo `json:"tenantInfo"`
 }
 
+type GetInvoiceByNumberRequest struct {
+	Number     string                `json:"number"`
+	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
+}
+
 type CountPostedInvoiceReconciliationDiscrepanciesRequest struct {
 	OrgID           pulid.ID        `json:"orgId"`
 	BuID            pulid.ID        `json:"buId"`
@@ -71,6 +76,10 @@ type InvoiceRepository interface {
 		ctx context.Context,
 		req GetInvoiceByBillingQueueItemIDRequest,
 	) (*invoice.Invoice, error)
+	GetByNumber(
+		ctx context.Context,
+		req GetInvoiceByNumberRequest,
+	) (*invoice.Invoice, error)
 	CountPostedReconciliationDiscrepancies(
 		ctx context.Context,
 		req CountPostedInvoiceReconciliationDiscrepanciesRequest,
@@ -250,6 +250,76 @@ type SplitMoveResponse struct {
 	NewMove      *shipment.ShipmentMove `json:"newMove,omitempty"`
 }
 
+// RelayMoveRequest splits a move at a relay point: the original move now ends at the
+// relay location and a new move carries the load from there to the original delivery.
+type RelayMoveRequest struct {
+	TenantInfo      pagination.TenantInfo `json:"-"`
+	MoveID          pulid.ID              `json:"moveId"`
+	RelayLocationID pulid.ID              `json:"relayLocationId"`
+	RelayArrival    SplitStopTimes        `json:"relayArrival"`
+	RelayDeparture  SplitStopTimes        `json:"relayDeparture"`
+	// LeadLegDistance and ReliefLegDistance replace the move's distance on each
+	// leg. A nil distance leaves the leg unmeasured rather than carrying the
+	// whole move's miles.
+	LeadLegDistance   *float64 `json:"leadLegDistance,omitempty"`
+	ReliefLegDistance *float64 `json:"reliefLegDistance,omitempty"`
+}
+
+func (r *RelayMoveRequest) Validate() *errortypes.MultiError {
+	multiErr := errortypes.NewMultiError()
+
+	multiErr.AddOzzoError(validation.ValidateStruct(
+		r,
+		validation.Field(&r.MoveID, validation.Required.Error("Move ID is required")),
+		validation.Field(
+			&r.RelayLocationID,
+			validation.Required.Error("Relay location ID is required"),
+		),
+		validation.Field(
+			&r.TenantInfo.OrgID,
+			validation.Required.Error("Organization ID is required"),
+		),
+		validation.Field(
+			&r.TenantInfo.BuID,
+			validation.Required.Error("Business unit ID is required"),
+		),
+		validation.Field(
+			&r.RelayArrival.ScheduledWindowStart,
+			validation.Required.Error("Relay arrival scheduled window start is required"),
+		),
+		validation.Field(
+			&r.RelayDeparture.ScheduledWindowStart,
+			validation.Required.Error("Relay departure scheduled window start is required"),
+		),
+		validation.Field(
+			&r.LeadLegDistance,
+			validation.Min(0.0).Error("Lead leg distance cannot be negative"),
+		),
+		validation.Field(
+			&r.ReliefLegDistance,
+			validation.Min(0.0).Error("Relief leg distance cannot be negative"),
+		),
+	))
+
+	if multiErr.HasErrors() {
+		return multiErr
+	}
+
+	if r.RelayDeparture.ScheduledWindowStart < r.RelayArrival.ScheduledWindowStart {
+		multiErr.Add(
+			"relayDeparture.scheduledWindowStart",
+			errortypes.ErrInvalid,
+			"Relay departure must be at or after the relay arrival",
+		)
+	}
+
+	if multiErr.HasErrors() {
+		return multiErr
+	}
+
+	return nil
+}
+
 type ShipmentMoveRepository interface {
 	SyncForShipment(
 		ctx context.Context,
@@ -281,4 +351,8 @@ type ShipmentMoveRepository interface {
 		ctx context.Context,
 		req *SplitMoveRequest,
 	) (*SplitMoveResponse, error)
+	RelayMove(
+		ctx context.Context,
+		req *RelayMoveRequest,
+	) (*SplitMoveResponse, error)
 }
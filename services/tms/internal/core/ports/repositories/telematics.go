@@ -61,9 +61,13 @@ type WorkerHOSViolationStats struct {
 	LastViolationAt int64    `bun:"last_violation_at" json:"lastViolationAt"`
 }
 
+// WorkerTelematicsMapping links a worker to a provider driver. Provider is the
+// telematics provider the worker is pinned to, empty for the tenant's primary one;
+// the same holds for tractor and trailer mappings.
 type WorkerTelematicsMapping struct {
 	WorkerID   pulid.ID
 	ExternalID string
+	Provider   string
 	FirstName  string
 	LastName   string
 }
@@ -71,6 +75,7 @@ type WorkerTelematicsMapping struct {
 type TractorTelematicsMapping struct {
 	TractorID  pulid.ID
 	ExternalID string
+	Provider   string
 	Vin        string
 	Code       string
 }
@@ -94,8 +99,11 @@ type TractorExternalIDAssignment struct {
 	ExternalID string
 }
 
+// AssignTractorExternalIDsRequest records matches made against Provider's vehicles,
+// pinning each tractor to that provider.
 type AssignTractorExternalIDsRequest struct {
 	TenantInfo  pagination.TenantInfo
+	Provider    string
 	Assignments []TractorExternalIDAssignment
 }
 
@@ -124,6 +132,7 @@ type ListVehicleInspectionsRequest struct {
 type TrailerTelematicsMapping struct {
 	TrailerID  pulid.ID
 	ExternalID string
+	Provider   string
 	Vin        string
 	Code       string
 }
@@ -135,6 +144,7 @@ type TrailerExternalIDAssignment struct {
 
 type AssignTrailerExternalIDsRequest struct {
 	TenantInfo  pagination.TenantInfo
+	Provider    string
 	Assignments []TrailerExternalIDAssignment
 }
 
@@ -262,6 +272,7 @@ type TelematicsRepository interface {
 		eventsOlderThan int64,
 		violationsOlderThan int64,
 		hosLogsOlderThan int64,
+		positionHistoryOlderThan int64,
 	) (int64, error)
 	GetWebhookConfigByToken(
 		ctx context.Context,
@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+// Every subscription lookup takes an APIKeyID. When it is set the lookup only
+// sees subscriptions owned by that key, which is how an integrator's key is
+// kept out of other integrators' subscriptions.
+
+type GetWebhookSubscriptionByIDRequest struct {
+	ID         pulid.ID
+	TenantInfo pagination.TenantInfo
+	APIKeyID   pulid.ID
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	Filter   *pagination.QueryOptions `json:"filter"`
+	APIKeyID pulid.ID                 `json:"-"`
+}
+
+type ListWebhookSubscriptionConnectionRequest struct {
+	Filter   *pagination.QueryOptions `json:"filter"`
+	Cursor   pagination.CursorInfo    `json:"-"`
+	APIKeyID pulid.ID                 `json:"-"`
+}
+
+type FindWebhookSubscriptionsForEventRequest struct {
+	TenantInfo pagination.TenantInfo
+	EventType  domainevent.Type
+}
+
+type RecordWebhookDeliveryFailureRequest struct {
+	ID         pulid.ID
+	TenantInfo pagination.TenantInfo
+	// DisableAfter disables the subscription once its consecutive failures
+	// reach this count.
+	DisableAfter int
+	FailedAt     int64
+}
+
+type RecordWebhookDeliverySuccessRequest struct {
+	ID          pulid.ID
+	TenantInfo  pagination.TenantInfo
+	DeliveredAt int64
+}
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, entity *webhook.Subscription) (*webhook.Subscription, error)
+	Update(ctx context.Context, entity *webhook.Subscription) (*webhook.Subscription, error)
+	GetByID(
+		ctx context.Context,
+		req GetWebhookSubscriptionByIDRequest,
+	) (*webhook.Subscription, error)
+	List(
+		ctx context.Context,
+		req *ListWebhookSubscriptionsRequest,
+	) (*pagination.ListResult[*webhook.Subscription], error)
+	ListConnection(
+		ctx context.Context,
+		req *ListWebhookSubscriptionConnectionRequest,
+	) (*pagination.CursorListResult[*webhook.Subscription], error)
+	Delete(ctx context.Context, req GetWebhookSubscriptionByIDRequest) error
+	// FindForEvent returns the tenant's active subscriptions to an event type.
+	// Customer scoping is left to the caller, which has the event payload.
+	FindForEvent(
+		ctx context.Context,
+		req FindWebhookSubscriptionsForEventRequest,
+	) ([]*webhook.Subscription, error)
+	// RecordDeliveryFailure and RecordDeliverySuccess update the failure count
+	// in place rather than through Update, because concurrent deliveries to
+	// one subscription would otherwise race on its version.
+	RecordDeliveryFailure(
+		ctx context.Context,
+		req RecordWebhookDeliveryFailureRequest,
+	) (*webhook.Subscription, error)
+	RecordDeliverySuccess(ctx context.Context, req RecordWebhookDeliverySuccessRequest) error
+}
+
+type GetWebhookDeliveryByIDRequest struct {
+	ID         pulid.ID
+	TenantInfo pagination.TenantInfo
+}
+
+type GetWebhookDeliveryByIdempotencyKeyRequest struct {
+	TenantInfo     pagination.TenantInfo
+	SubscriptionID pulid.ID
+	IdempotencyKey string
+}
+
+type ListWebhookDeliveriesRequest struct {
+	Filter         *pagination.QueryOptions `json:"filter"`
+	SubscriptionID pulid.ID                 `json:"subscriptionId"`
+}
+
+type ListWebhookDeliveryConnectionRequest struct {
+	Filter         *pagination.QueryOptions `json:"filter"`
+	Cursor         pagination.CursorInfo    `json:"-"`
+	SubscriptionID pulid.ID                 `json:"subscriptionId"`
+}
+
+type WebhookDeliveryRepository interface {
+	// CreateIfAbsent inserts the delivery unless the subscription already has
+	// one for the event's idempotency key, and reports whether it did.
+	CreateIfAbsent(ctx context.Context, entity *webhook.Delivery) (bool, error)
+	GetByID(ctx context.Context, req GetWebhookDeliveryByIDRequest) (*webhook.Delivery, error)
+	GetByIdempotencyKey(
+		ctx context.Context,
+		req GetWebhookDeliveryByIdempotencyKeyRequest,
+	) (*webhook.Delivery, error)
+	Update(ctx context.Context, entity *webhook.Delivery) (*webhook.Delivery, error)
+	List(
+		ctx context.Context,
+		req *ListWebhookDeliveriesRequest,
+	) (*pagination.ListResult[*webhook.Delivery], error)
+	ListConnection(
+		ctx context.Context,
+		req *ListWebhookDeliveryConnectionRequest,
+	) (*pagination.CursorListResult[*webhook.Delivery], error)
+	CreateAttempt(ctx context.Context, entity *webhook.DeliveryAttempt) error
+	ListAttempts(
+		ctx context.Context,
+		req GetWebhookDeliveryByIDRequest,
+	) ([]*webhook.DeliveryAttempt, error)
+}
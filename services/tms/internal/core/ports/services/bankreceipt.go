@@ -26,6 +26,9 @@ type ImportBankReceiptRequest struct {
 	BatchID         pulid.ID              `json:"batchId"`
 	SkipAudit       bool                  `json:"-"`
 	TenantInfo      pagination.TenantInfo `json:"tenantInfo"`
+	// ExceptionReason routes the receipt straight to the reconciliation
+	// exception queue instead of running the automatic matching policy.
+	ExceptionReason string `json:"-"`
 }
 
 type MatchBankReceiptRequest struct {
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/emoss08/trenova/internal/core/domain/agent"
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
 	"github.com/emoss08/trenova/internal/core/services/dispatchconsoleservice"
 	"github.com/emoss08/trenova/internal/core/services/dispatcheligibility"
@@ -41,6 +43,15 @@ type DispatchTour struct {
 	EndsAt             int64      `json:"endsAt"`
 	TotalScore         int        `json:"totalScore"`
 	TotalDeadheadMiles float64    `json:"totalDeadheadMiles"`
+	LoadedMiles        float64    `json:"loadedMiles"`
+	EmptyMiles         float64    `json:"emptyMiles"`
+	HomeMiles          float64    `json:"homeMiles"`
+	Revenue            float64    `json:"revenue"`
+	RevenuePerDay      float64    `json:"revenuePerDay"`
+	// ProjectedHomeArrival is zero when the driver has no home location on file.
+	ProjectedHomeArrival int64 `json:"projectedHomeArrival"`
+	// HomeBy is zero when the dispatch control sets no home-time window.
+	HomeBy int64 `json:"homeBy"`
 }
 
 type DispatchUncoveredMove struct {
@@ -71,8 +82,107 @@ type DispatchPlanRequest struct {
 	Apply        bool
 }
 
+type DispatchAcceptTourRequest struct {
+	TenantInfo pagination.TenantInfo
+	RunID      pulid.ID
+	TourID     pulid.ID
+}
+
+type DispatchTourAcceptance struct {
+	RunID       pulid.ID   `json:"runId"`
+	TourID      pulid.ID   `json:"tourId"`
+	MoveIDs     []pulid.ID `json:"moveIds"`
+	ProposalIDs []pulid.ID `json:"proposalIds"`
+}
+
 type DispatchAutoAssignService interface {
 	Plan(ctx context.Context, req *DispatchPlanRequest) (*DispatchPlan, error)
+	AcceptTour(ctx context.Context, req *DispatchAcceptTourRequest) (*DispatchTourAcceptance, error)
+}
+
+// DispatchRelayMode is how the load changes hands at a relay point.
+type DispatchRelayMode string
+
+const (
+	// DispatchRelayModeTractorSwap hooks the trailer to the relief driver's own tractor;
+	// the lead driver keeps theirs.
+	DispatchRelayModeTractorSwap = DispatchRelayMode("TractorSwap")
+	// DispatchRelayModeSlipSeat hands the lead driver's tractor, trailer attached, to a
+	// relief driver who has no tractor of their own.
+	DispatchRelayModeSlipSeat = DispatchRelayMode("SlipSeat")
+)
+
+type DispatchRelayPlanRequest struct {
+	TenantInfo   pagination.TenantInfo
+	MoveID       pulid.ID
+	LeadWorkerID pulid.ID
+	Limit        int
+}
+
+type DispatchRelayOption struct {
+	RelayPoint       *repositories.RelayPoint `json:"relayPoint"`
+	Mode             DispatchRelayMode        `json:"mode"`
+	LeadLegMiles     float64                  `json:"leadLegMiles"`
+	LeadArrival      int64                    `json:"leadArrival"`
+	LeadDriveMargin  int64                    `json:"leadDriveMarginMs"`
+	ReliefWorkerID   pulid.ID                 `json:"reliefWorkerId"`
+	ReliefWorkerName string                   `json:"reliefWorkerName"`
+	ReliefTractorID  pulid.ID                 `json:"reliefTractorId"`
+	TrailerID        pulid.ID                 `json:"trailerId"`
+	// ReliefDeadheadMiles is nil when the relief driver's position is unknown and they
+	// are assumed to report from their home terminal.
+	ReliefDeadheadMiles *float64                      `json:"reliefDeadheadMiles"`
+	ReliefArrival       int64                         `json:"reliefArrival"`
+	ReliefLegMiles      float64                       `json:"reliefLegMiles"`
+	ReliefResets        int                           `json:"reliefResets"`
+	HandoffAt           int64                         `json:"handoffAt"`
+	ProjectedDelivery   int64                         `json:"projectedDelivery"`
+	TransitSeconds      int64                         `json:"transitSeconds"`
+	SecondsSaved        int64                         `json:"secondsSaved"`
+	Findings            []dispatcheligibility.Finding `json:"findings"`
+}
+
+type DispatchRelayPlan struct {
+	MoveID         pulid.ID `json:"moveId"`
+	ProNumber      string   `json:"proNumber"`
+	LeadWorkerID   pulid.ID `json:"leadWorkerId"`
+	LeadWorkerName string   `json:"leadWorkerName"`
+	TotalMiles     float64  `json:"totalMiles"`
+	DepartsAt      int64    `json:"departsAt"`
+	// SingleDriverFeasible is false when the lead driver runs out of cycle before the
+	// delivery; the single-driver figures are then zero and nothing is "saved".
+	SingleDriverFeasible       bool                   `json:"singleDriverFeasible"`
+	SingleDriverTransitSeconds int64                  `json:"singleDriverTransitSeconds"`
+	SingleDriverDelivery       int64                  `json:"singleDriverDelivery"`
+	SingleDriverResets         int                    `json:"singleDriverResets"`
+	Options                    []*DispatchRelayOption `json:"options"`
+	GeneratedAt                int64                  `json:"generatedAt"`
+}
+
+type DispatchApplyRelayRequest struct {
+	TenantInfo      pagination.TenantInfo
+	MoveID          pulid.ID
+	RelayLocationID pulid.ID
+	LeadWorkerID    pulid.ID
+	ReliefWorkerID  pulid.ID
+	Mode            DispatchRelayMode
+	HandoffAt       int64
+	LeadArrival     int64
+}
+
+type DispatchRelayApplication struct {
+	OriginalMove     *shipment.ShipmentMove `json:"originalMove"`
+	RelayMove        *shipment.ShipmentMove `json:"relayMove"`
+	LeadAssignment   *shipment.Assignment   `json:"leadAssignment"`
+	ReliefAssignment *shipment.Assignment   `json:"reliefAssignment"`
+}
+
+// DispatchRelayService plans long-haul relays: where along the route the lead driver
+// can legally hand the load to a relief driver, and how much sooner it delivers than if
+// one driver took it all the way.
+type DispatchRelayService interface {
+	Plan(ctx context.Context, req *DispatchRelayPlanRequest) (*DispatchRelayPlan, error)
+	Apply(ctx context.Context, req *DispatchApplyRelayRequest) (*DispatchRelayApplication, error)
 }
 
 type DispatchConsoleService interface {
@@ -37,38 +37,42 @@ const (
 )
 
 type PreviewEDIDocumentRequest struct {
-	TenantInfo               pagination.TenantInfo `json:"-"`
-	PartnerDocumentProfileID pulid.ID              `json:"partnerDocumentProfileId"`
-	EDIPartnerID             pulid.ID              `json:"ediPartnerId"`
-	ShipmentID               pulid.ID              `json:"shipmentId"`
-	TransferID               pulid.ID              `json:"transferId"`
-	InvoiceID                pulid.ID              `json:"invoiceId"`
-	ShipmentEventID          pulid.ID              `json:"shipmentEventId"`
-	ServiceFailureID         pulid.ID              `json:"serviceFailureId"`
-	SourceMessageID          pulid.ID              `json:"sourceMessageId"`
-	TransactionSet           edi.TransactionSet    `json:"transactionSet"`
-	Direction                edi.DocumentDirection `json:"direction"`
-	Payload                  *edi.DocumentPayload  `json:"payload"`
-	CarrierSCAC              string                `json:"carrierScac"`
+	TenantInfo                     pagination.TenantInfo `json:"-"`
+	PartnerDocumentProfileID       pulid.ID              `json:"partnerDocumentProfileId"`
+	EDIPartnerID                   pulid.ID              `json:"ediPartnerId"`
+	ShipmentID                     pulid.ID              `json:"shipmentId"`
+	ShipmentMoveID                 pulid.ID              `json:"shipmentMoveId"`
+	TransferID                     pulid.ID              `json:"transferId"`
+	InvoiceID                      pulid.ID              `json:"invoiceId"`
+	ShipmentEventID                pulid.ID              `json:"shipmentEventId"`
+	ServiceFailureID               pulid.ID              `json:"serviceFailureId"`
+	SourceMessageID                pulid.ID              `json:"sourceMessageId"`
+	TransactionSet                 edi.TransactionSet    `json:"transactionSet"`
+	Direction                      edi.DocumentDirection `json:"direction"`
+	Payload                        *edi.DocumentPayload  `json:"payload"`
+	CarrierSCAC                    string                `json:"carrierScac"`
+	DeliveryCommunicationProfileID pulid.ID              `json:"-"`
 }
 
 type GenerateEDIDocumentRequest struct {
-	TenantInfo                    pagination.TenantInfo `json:"-"`
-	PartnerDocumentProfileID      pulid.ID              `json:"partnerDocumentProfileId"`
-	EDIPartnerID                  pulid.ID              `json:"ediPartnerId"`
-	ShipmentID                    pulid.ID              `json:"shipmentId"`
-	TransferID                    pulid.ID              `json:"transferId"`
-	InvoiceID                     pulid.ID              `json:"invoiceId"`
-	ShipmentEventID               pulid.ID              `json:"shipmentEventId"`
-	ServiceFailureID              pulid.ID              `json:"serviceFailureId"`
-	SourceMessageID               pulid.ID              `json:"sourceMessageId"`
-	TransactionSet                edi.TransactionSet    `json:"transactionSet"`
-	Direction                     edi.DocumentDirection `json:"direction"`
-	Payload                       *edi.DocumentPayload  `json:"payload"`
-	CarrierSCAC                   string                `json:"-"`
-	GeneratedByID                 pulid.ID              `json:"-"`
-	DisableDeliveryQueue          bool                  `json:"-"`
-	SuppressTenderRecipientUpsert bool                  `json:"-"`
+	TenantInfo                     pagination.TenantInfo `json:"-"`
+	PartnerDocumentProfileID       pulid.ID              `json:"partnerDocumentProfileId"`
+	EDIPartnerID                   pulid.ID              `json:"ediPartnerId"`
+	ShipmentID                     pulid.ID              `json:"shipmentId"`
+	ShipmentMoveID                 pulid.ID              `json:"shipmentMoveId"`
+	TransferID                     pulid.ID              `json:"transferId"`
+	InvoiceID                      pulid.ID              `json:"invoiceId"`
+	ShipmentEventID                pulid.ID              `json:"shipmentEventId"`
+	ServiceFailureID               pulid.ID              `json:"serviceFailureId"`
+	SourceMessageID                pulid.ID              `json:"sourceMessageId"`
+	TransactionSet                 edi.TransactionSet    `json:"transactionSet"`
+	Direction                      edi.DocumentDirection `json:"direction"`
+	Payload                        *edi.DocumentPayload  `json:"payload"`
+	CarrierSCAC                    string                `json:"-"`
+	DeliveryCommunicationProfileID pulid.ID              `json:"-"`
+	GeneratedByID                  pulid.ID              `json:"-"`
+	DisableDeliveryQueue           bool                  `json:"-"`
+	SuppressTenderRecipientUpsert  bool                  `json:"-"`
 }
 
 type ServiceFailure214LifecycleRequest struct {
@@ -11,6 +11,11 @@ type EDITransportRequest struct {
 	Secrets  map[string]string
 	FileName string
 	Contents string
+	Standard edi.EDIStandard
+	// DocumentID and Payload carry the message identity and canonical payload
+	// for transports that send structured documents instead of the rendered file.
+	DocumentID string
+	Payload    *edi.DocumentPayload
 }
 
 type EDITransportResult struct {
@@ -140,6 +140,36 @@ var CatalogDefinitions = []CatalogItem{
 		SortOrder:          10,
 		PrimaryActionLabel: catalogViewIntegrationLabel,
 	},
+	{
+		Type:          integration.TypeMotive,
+		Name:          "Motive",
+		Description:   "Connect your Motive (formerly KeepTruckin) ELD account to Trenova for live vehicle locations, hours-of-service clocks and logs, violations, and inspection reports. Runs alongside Samsara for mixed fleets.",
+		Category:      integration.CategoryTelematics,
+		CategoryLabel: "Telematics",
+		LogoURL:       "/integrations/logos/motive-light.svg",
+		LogoLightURL:  "/integrations/logos/motive-light.svg",
+		LogoDarkURL:   "/integrations/logos/motive-dark.svg",
+		DocsURL:       "https://developer.gomotive.com/",
+		WebsiteURL:    "https://gomotive.com/",
+		Color:         "#1f5bff",
+		GlowFrom:      "#1f5bff",
+		GlowTo:        "#0b1533",
+		Links: []CatalogLink{
+			{
+				Kind:  CatalogLinkKindDocs,
+				Label: catalogDocsLabel,
+				URL:   "https://developer.gomotive.com/",
+			},
+			{
+				Kind:  CatalogLinkKindWebsite,
+				Label: catalogWebsiteLabel,
+				URL:   "https://gomotive.com/",
+			},
+		},
+		Featured:           true,
+		SortOrder:          11,
+		PrimaryActionLabel: catalogViewIntegrationLabel,
+	},
 	{
 		Type:          integration.TypeGoogleMaps,
 		Name:          "Google Maps",
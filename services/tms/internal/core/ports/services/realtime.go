@@ -11,6 +11,9 @@ type RealtimeToken struct {
 	Token     string `json:"token"`
 	ClientID  string `json:"clientId"`
 	ExpiresAt int64  `json:"expiresAt"`
+	// Provider is "foony" or "builtin"; builtin tokens are only accepted by
+	// this API's /realtime/ws/ and /realtime/stream/ endpoints.
+	Provider string `json:"provider"`
 }
 
 type CreateRealtimeTokenRequest struct {
@@ -63,3 +66,13 @@ type RealtimeService interface {
 		req *PublishResourceInvalidationRequest,
 	) error
 }
+
+// ResourceEventSource streams a tenant's resource invalidation events, from
+// every replica and the worker, to consumers inside the API such as GraphQL
+// subscriptions. Entity arrives as the json.RawMessage it was published as.
+type ResourceEventSource interface {
+	SubscribeResourceEvents(
+		ctx context.Context,
+		orgID, buID pulid.ID,
+	) (<-chan *ResourceInvalidationEvent, error)
+}
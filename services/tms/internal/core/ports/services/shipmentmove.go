@@ -24,4 +24,8 @@ type ShipmentMoveService interface {
 		ctx context.Context,
 		req *repositories.SplitMoveRequest,
 	) (*repositories.SplitMoveResponse, error)
+	RelayMove(
+		ctx context.Context,
+		req *repositories.RelayMoveRequest,
+	) (*repositories.SplitMoveResponse, error)
 }
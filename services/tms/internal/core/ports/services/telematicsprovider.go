@@ -243,6 +243,12 @@ type TelematicsProviderFactory interface {
 		ctx context.Context,
 		tenantInfo pagination.TenantInfo,
 	) (TelematicsProvider, error)
+	// ProvidersFor returns every enabled telematics provider of a mixed fleet. The
+	// first is the primary provider, the one ProviderFor returns.
+	ProvidersFor(
+		ctx context.Context,
+		tenantInfo pagination.TenantInfo,
+	) ([]TelematicsProvider, error)
 	ProviderOfType(
 		ctx context.Context,
 		tenantInfo pagination.TenantInfo,
@@ -82,6 +82,13 @@ func (f fakeProposalRepo) ExpirePendingByRun(
 	return 0, nil
 }
 
+func (f fakeProposalRepo) ListPendingByTour(
+	context.Context,
+	repositories.ListAgentProposalsByTourRequest,
+) ([]*agent.AgentProposal, error) {
+	return nil, nil
+}
+
 func newService(shadow bool) serviceports.AgentProposalService {
 	return agentproposalservice.New(agentproposalservice.Params{
 		Logger:  zap.NewNop(),
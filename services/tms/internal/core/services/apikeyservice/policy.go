@@ -68,4 +68,10 @@ var runtimePolicy = map[string][]permission.Operation{
 		permission.OpCreate,
 		permission.OpUpdate,
 	},
+	permission.ResourceWebhookSubscription.String(): {
+		permission.OpRead,
+		permission.OpCreate,
+		permission.OpUpdate,
+		permission.OpDelete,
+	},
 }
@@ -155,7 +155,11 @@ func (s *Service) Import(
 	if err != nil {
 		return nil, err
 	}
-	created, err = s.applyReconciliationPolicy(ctx, created, actor)
+	if reason := strings.TrimSpace(req.ExceptionReason); reason != "" {
+		created, err = s.markException(ctx, created, actor, reason, req.SkipAudit)
+	} else {
+		created, err = s.applyReconciliationPolicy(ctx, created, actor)
+	}
 	if err != nil {
 		return nil, err
 	}
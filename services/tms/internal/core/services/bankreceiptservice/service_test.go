@@ -232,6 +232,51 @@ func TestImportMarksExceptionWhenNoUniqueCandidateExists(t *testing.T) {
 	assert.Contains(t, receipt.ExceptionReason, "No unique")
 }
 
+func TestImportWithExceptionReasonSkipsAutomaticMatching(t *testing.T) {
+	t.Parallel()
+
+	orgID := pulid.MustNew("org_")
+	buID := pulid.MustNew("bu_")
+	userID := pulid.MustNew("usr_")
+	receiptRepo := mocks.NewMockBankReceiptRepository(t)
+	receiptRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entity *bankreceipt.BankReceipt) (*bankreceipt.BankReceipt, error) {
+			copy := *entity
+			copy.ID = pulid.MustNew("brcpt_")
+			return &copy, nil
+		}).
+		Once()
+	receiptRepo.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entity *bankreceipt.BankReceipt) (*bankreceipt.BankReceipt, error) {
+			copy := *entity
+			return &copy, nil
+		}).
+		Once()
+	svc := &Service{
+		repo:         receiptRepo,
+		paymentRepo:  mocks.NewMockCustomerPaymentRepository(t),
+		auditService: &mocks.NoopAuditService{},
+	}
+
+	receipt, err := svc.Import(
+		t.Context(),
+		&serviceports.ImportBankReceiptRequest{
+			ReceiptDate:     100,
+			AmountMinor:     10000,
+			ReferenceNumber: "ACH-1",
+			ExceptionReason: "Invoice INV-9 was not found",
+			TenantInfo:      pagination.TenantInfo{OrgID: orgID, BuID: buID, UserID: userID},
+		},
+		testutil.NewSessionActor(userID, orgID, buID),
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, bankreceipt.StatusException, receipt.Status)
+	assert.Equal(t, "Invoice INV-9 was not found", receipt.ExceptionReason)
+}
+
 func TestImportCreatesWorkItemForException(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/emoss08/trenova/internal/core/domain/carriersettlement"
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
 	"github.com/emoss08/trenova/internal/core/domain/permission"
 	"github.com/emoss08/trenova/internal/core/domain/tenant"
 	"github.com/emoss08/trenova/internal/core/ports"
@@ -119,7 +120,11 @@ func (s *Service) approveInternal(
 		entity.ApprovedByID = actor.UserID
 		entity.ApprovedAt = &now
 		updated, txErr = s.settlementRepo.Update(txCtx, entity)
-		return txErr
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.outboxRepo.Append(txCtx, domainevent.NewCarrierSettlementApproved(updated))
 	})
 	if err != nil {
 		return nil, err
@@ -37,6 +37,7 @@ type Params struct {
 	AccountingRepo    repositories.AccountingControlRepository
 	JournalRepo       repositories.JournalPostingRepository
 	FiscalPeriodRepo  repositories.FiscalPeriodRepository
+	OutboxRepo        repositories.DomainEventOutboxRepository
 	Generator         seqgen.Generator
 	AuditService      serviceports.AuditService
 	Realtime          serviceports.RealtimeService
@@ -58,6 +59,7 @@ type Service struct {
 	accountingRepo    repositories.AccountingControlRepository
 	journalRepo       repositories.JournalPostingRepository
 	fiscalPeriodRepo  repositories.FiscalPeriodRepository
+	outboxRepo        repositories.DomainEventOutboxRepository
 	generator         seqgen.Generator
 	auditService      serviceports.AuditService
 	realtime          serviceports.RealtimeService
@@ -80,6 +82,7 @@ func New(p Params) *Service { //nolint:gocritic // stable API shape
 		accountingRepo:    p.AccountingRepo,
 		journalRepo:       p.JournalRepo,
 		fiscalPeriodRepo:  p.FiscalPeriodRepo,
+		outboxRepo:        p.OutboxRepo,
 		generator:         p.Generator,
 		auditService:      p.AuditService,
 		realtime:          p.Realtime,
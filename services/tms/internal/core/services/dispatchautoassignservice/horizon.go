@@ -13,7 +13,10 @@ import (
 	"github.com/emoss08/trenova/shared/pulid"
 )
 
-const horizonSearchSeed = int64(1)
+const (
+	horizonSearchSeed = int64(1)
+	secondsPerDay     = 86400.0
+)
 
 type horizonOracle struct {
 	candidates *dispatchcandidateservice.Service
@@ -24,6 +27,7 @@ type horizonOracle struct {
 	scores     [][]*dispatchcandidateservice.CandidateScore
 
 	baseCommitments map[pulid.ID][]*repositories.WorkerCommitment
+	tourStarts      map[int]int64
 }
 
 func newHorizonOracle(
@@ -52,6 +56,8 @@ func (o *horizonOracle) Rebuild(
 	assignments []dispatchplanner.Assignment,
 ) []dispatchplanner.Assignment {
 	o.snapshot.CommitmentsByWorker = cloneCommitments(o.baseCommitments)
+	o.snapshot.PlannedHOSByWorker = nil
+	o.tourStarts = nil
 
 	replayed := make([]dispatchplanner.Assignment, len(assignments))
 	for i, assignment := range assignments {
@@ -82,6 +88,10 @@ func (o *horizonOracle) Cost(task, resource int) float64 {
 	})
 	o.scores[task][resource] = score
 
+	if o.missesHomeTime(task, resource, score) {
+		return dispatchplanner.Forbidden
+	}
+
 	if cost := costFor(score, o.control); cost < assignmentsolver.Forbidden {
 		return cost
 	}
@@ -89,7 +99,55 @@ func (o *horizonOracle) Cost(task, resource int) float64 {
 	return dispatchplanner.Forbidden
 }
 
+// missesHomeTime reports whether ending the driver's tour with this move would get them
+// home later than their home-time window allows, measured from the start of the tour.
+// The window is the driver's own when set, else the control's default. Drivers without
+// a home location are never held to it.
+func (o *horizonOracle) missesHomeTime(
+	task, resource int,
+	score *dispatchcandidateservice.CandidateScore,
+) bool {
+	driver := o.drivers[resource]
+	window, ok := homeTimeWindow(o.control, driver)
+	if !ok || score == nil {
+		return false
+	}
+
+	move := o.moves[task]
+	_, homeSeconds, known := dispatchcandidateservice.HomeLeg(move, driver)
+	if !known {
+		return false
+	}
+
+	startsAt, started := o.tourStarts[resource]
+	if !started {
+		startsAt = score.ProjectedAvailable
+	}
+
+	return dispatchcandidateservice.PlannedCompletion(move, score)+homeSeconds > startsAt+window
+}
+
+func homeTimeWindow(
+	control *dispatchcontrol.DispatchControl,
+	driver *repositories.BoardDriver,
+) (int64, bool) {
+	if driver == nil {
+		return control.HomeTimeWindowSeconds(nil)
+	}
+
+	return control.HomeTimeWindowSeconds(driver.HomeTimeMaxDaysOut)
+}
+
 func (o *horizonOracle) Commit(task, resource int) {
+	if score := o.scores[task][resource]; score != nil {
+		if _, started := o.tourStarts[resource]; !started {
+			if o.tourStarts == nil {
+				o.tourStarts = make(map[int]int64, len(o.drivers))
+			}
+			o.tourStarts[resource] = score.ProjectedAvailable
+		}
+	}
+
 	dispatchcandidateservice.CommitPlannedMove(
 		&dispatchcandidateservice.CommitPlannedMoveRequest{
 			Snapshot: o.snapshot,
@@ -159,6 +217,7 @@ func buildHorizonPlan(p *buildHorizonPlanParams) *portservices.DispatchPlan {
 
 	tourByDriver := make(map[int]*portservices.DispatchTour, len(p.Oracle.drivers))
 	tourOrder := make([]int, 0, len(p.Oracle.drivers))
+	lastByDriver := make(map[int]dispatchplanner.Assignment, len(p.Oracle.drivers))
 
 	for _, assignment := range p.Result.Assignments {
 		move := p.Moves[assignment.Task]
@@ -203,6 +262,17 @@ func buildHorizonPlan(p *buildHorizonPlanParams) *portservices.DispatchPlan {
 		if score.DeadheadMiles != nil {
 			tour.TotalDeadheadMiles += *score.DeadheadMiles
 		}
+		if move.Distance != nil {
+			tour.LoadedMiles += *move.Distance
+		}
+		if move.Revenue != nil {
+			tour.Revenue += *move.Revenue
+		}
+
+		if last, seen := lastByDriver[assignment.Resource]; !seen ||
+			assignment.Sequence >= last.Sequence {
+			lastByDriver[assignment.Resource] = assignment
+		}
 	}
 
 	for _, index := range p.Result.Unassigned {
@@ -213,8 +283,35 @@ func buildHorizonPlan(p *buildHorizonPlanParams) *portservices.DispatchPlan {
 	}
 
 	for _, driver := range tourOrder {
-		plan.Tours = append(plan.Tours, tourByDriver[driver])
+		tour := tourByDriver[driver]
+		last := lastByDriver[driver]
+		finishTour(tour, p.Moves[last.Task], p.Oracle.drivers[driver], p.Control)
+		plan.Tours = append(plan.Tours, tour)
 	}
 
 	return plan
 }
+
+// finishTour closes the tour with the empty run home after its last move and rolls the
+// totals up into the economics dispatchers compare tours on.
+func finishTour(
+	tour *portservices.DispatchTour,
+	last *repositories.BoardMove,
+	driver *repositories.BoardDriver,
+	control *dispatchcontrol.DispatchControl,
+) {
+	endsAt := tour.EndsAt
+	if miles, driveSeconds, ok := dispatchcandidateservice.HomeLeg(last, driver); ok {
+		tour.HomeMiles = miles
+		tour.ProjectedHomeArrival = tour.EndsAt + driveSeconds
+		endsAt = tour.ProjectedHomeArrival
+	}
+	if window, ok := homeTimeWindow(control, driver); ok {
+		tour.HomeBy = tour.StartsAt + window
+	}
+
+	tour.EmptyMiles = tour.TotalDeadheadMiles + tour.HomeMiles
+
+	days := max(float64(endsAt-tour.StartsAt)/secondsPerDay, 1)
+	tour.RevenuePerDay = tour.Revenue / days
+}
@@ -403,3 +403,163 @@ func TestSolve_HorizonModeIsLabelledAsSuch(t *testing.T) {
 
 	assert.Equal(t, dispatchcontrol.PlanningModeHorizon.String(), plan.PlanningMode)
 }
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func TestBuildHorizonPlan_ReportsTourEconomicsAndTheTripHome(t *testing.T) {
+	t.Parallel()
+
+	worker := pulid.MustNew("wrk_")
+	moves := []*repositories.BoardMove{horizonMove("P1"), horizonMove("P2")}
+	moves[0].Distance, moves[0].Revenue = floatPtr(400), floatPtr(1200)
+	moves[1].Distance, moves[1].Revenue = floatPtr(300), floatPtr(900)
+	moves[1].DestinationLatitude = floatPtr(39.7392)
+	moves[1].DestinationLongitude = floatPtr(-104.9903)
+
+	fixture := newHorizonFixture(moves, [][]*dispatchcandidateservice.CandidateScore{
+		{horizonScore(worker, "Dana", 90, 10)},
+		{horizonScore(worker, "Dana", 80, 25)},
+	})
+	fixture.oracle.drivers[0].HomeLatitude = floatPtr(41.8781)
+	fixture.oracle.drivers[0].HomeLongitude = floatPtr(-87.6298)
+
+	control, agentControl := horizonControls()
+	daysOut := int16(5)
+	control.HorizonMaxDaysOut = &daysOut
+
+	plan := buildHorizonPlan(&buildHorizonPlanParams{
+		Moves: moves,
+		Result: dispatchplanner.Result{
+			Assignments: []dispatchplanner.Assignment{
+				{Task: 0, Resource: 0, Sequence: 0},
+				{Task: 1, Resource: 0, Sequence: 1},
+			},
+			Unassigned: []int{},
+		},
+		Oracle:       fixture.oracle,
+		Control:      control,
+		AgentControl: agentControl,
+		Now:          horizonNow,
+	})
+
+	require.Len(t, plan.Tours, 1)
+	tour := plan.Tours[0]
+
+	homeMiles, homeSeconds, ok := dispatchcandidateservice.HomeLeg(
+		moves[1],
+		fixture.oracle.drivers[0],
+	)
+	require.True(t, ok)
+
+	assert.InDelta(t, 700.0, tour.LoadedMiles, 0.001)
+	assert.InDelta(t, 2100.0, tour.Revenue, 0.001)
+	assert.InDelta(t, homeMiles, tour.HomeMiles, 0.001)
+	assert.InDelta(t, 35.0+homeMiles, tour.EmptyMiles, 0.001)
+	assert.Equal(t, tour.EndsAt+homeSeconds, tour.ProjectedHomeArrival)
+	assert.Equal(t, tour.StartsAt+5*86400, tour.HomeBy)
+	days := float64(tour.ProjectedHomeArrival-tour.StartsAt) / 86400
+	assert.InDelta(t, 2100.0/days, tour.RevenuePerDay, 0.001,
+		"revenue per day counts the days spent getting home")
+}
+
+func TestBuildHorizonPlan_UnknownHomeLeavesTheArrivalUnset(t *testing.T) {
+	t.Parallel()
+
+	worker := pulid.MustNew("wrk_")
+	moves := []*repositories.BoardMove{horizonMove("P1")}
+	moves[0].Revenue = floatPtr(800)
+	fixture := newHorizonFixture(moves, [][]*dispatchcandidateservice.CandidateScore{
+		{horizonScore(worker, "Dana", 90, 10)},
+	})
+	control, agentControl := horizonControls()
+
+	plan := buildHorizonPlan(&buildHorizonPlanParams{
+		Moves: moves,
+		Result: dispatchplanner.Result{
+			Assignments: []dispatchplanner.Assignment{{Task: 0, Resource: 0, Sequence: 0}},
+			Unassigned:  []int{},
+		},
+		Oracle:       fixture.oracle,
+		Control:      control,
+		AgentControl: agentControl,
+		Now:          horizonNow,
+	})
+
+	require.Len(t, plan.Tours, 1)
+	assert.Zero(t, plan.Tours[0].ProjectedHomeArrival)
+	assert.Zero(t, plan.Tours[0].HomeBy)
+	assert.InDelta(t, 10.0, plan.Tours[0].EmptyMiles, 0.001)
+	assert.InDelta(t, 800.0, plan.Tours[0].RevenuePerDay, 0.001,
+		"a tour shorter than a day is not credited with more than a day's revenue")
+}
+
+func TestHorizonOracle_MissesHomeTimeMeasuresFromTheTourStart(t *testing.T) {
+	t.Parallel()
+
+	worker := pulid.MustNew("wrk_")
+	move := horizonMove("P1")
+	move.DestinationLatitude = floatPtr(39.7392)
+	move.DestinationLongitude = floatPtr(-104.9903)
+
+	fixture := newHorizonFixture(
+		[]*repositories.BoardMove{move},
+		[][]*dispatchcandidateservice.CandidateScore{{nil}},
+	)
+	fixture.oracle.drivers[0].HomeLatitude = floatPtr(41.8781)
+	fixture.oracle.drivers[0].HomeLongitude = floatPtr(-87.6298)
+
+	control, _ := horizonControls()
+	daysOut := int16(2)
+	control.HorizonMaxDaysOut = &daysOut
+	fixture.oracle.control = control
+
+	score := horizonScore(worker, "Dana", 90, 10)
+	assert.False(t, fixture.oracle.missesHomeTime(0, 0, score),
+		"a fresh tour starting with this move gets home inside two days")
+
+	fixture.oracle.tourStarts = map[int]int64{0: horizonNow - 30*3600}
+	assert.True(t, fixture.oracle.missesHomeTime(0, 0, score),
+		"the same move late in a tour overruns the home-time window")
+
+	control.HorizonMaxDaysOut = nil
+	assert.False(t, fixture.oracle.missesHomeTime(0, 0, score),
+		"without a window there is nothing to miss")
+}
+
+func TestHorizonOracle_MissesHomeTimeUsesTheDriversOwnWindow(t *testing.T) {
+	t.Parallel()
+
+	worker := pulid.MustNew("wrk_")
+	move := horizonMove("P1")
+	move.DestinationLatitude = floatPtr(39.7392)
+	move.DestinationLongitude = floatPtr(-104.9903)
+
+	fixture := newHorizonFixture(
+		[]*repositories.BoardMove{move},
+		[][]*dispatchcandidateservice.CandidateScore{{nil}},
+	)
+	driver := fixture.oracle.drivers[0]
+	driver.HomeLatitude = floatPtr(41.8781)
+	driver.HomeLongitude = floatPtr(-87.6298)
+
+	control, _ := horizonControls()
+	orgDaysOut := int16(5)
+	control.HorizonMaxDaysOut = &orgDaysOut
+	fixture.oracle.control = control
+	fixture.oracle.tourStarts = map[int]int64{0: horizonNow - 30*3600}
+
+	score := horizonScore(worker, "Dana", 90, 10)
+	assert.False(t, fixture.oracle.missesHomeTime(0, 0, score),
+		"the organization's five days leave room for the run home")
+
+	driverDaysOut := int16(1)
+	driver.HomeTimeMaxDaysOut = &driverDaysOut
+	assert.True(t, fixture.oracle.missesHomeTime(0, 0, score),
+		"a driver who must be home within a day is held to their own window")
+
+	control.HorizonMaxDaysOut = nil
+	assert.True(t, fixture.oracle.missesHomeTime(0, 0, score),
+		"the driver's window applies without an organization default")
+}
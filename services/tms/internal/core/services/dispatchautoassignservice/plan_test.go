@@ -404,4 +404,12 @@ func TestToolParamsFor(t *testing.T) {
 	params, err = toolParamsFor(planned)
 	require.NoError(t, err)
 	assert.Equal(t, planned.TrailerID.String(), params["trailerId"])
+	assert.NotContains(t, params, "tourId")
+
+	planned.TourID = pulid.MustNew("tour_")
+	planned.SequenceIndex = 2
+	params, err = toolParamsFor(planned)
+	require.NoError(t, err)
+	assert.Equal(t, planned.TourID.String(), params["tourId"])
+	assert.InDelta(t, 2.0, params["sequenceIndex"], 0.001)
 }
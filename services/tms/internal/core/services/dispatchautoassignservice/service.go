@@ -8,6 +8,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/agent"
 	"github.com/emoss08/trenova/internal/core/domain/dispatchcontrol"
 	"github.com/emoss08/trenova/internal/core/domain/tenant"
+	"github.com/emoss08/trenova/internal/core/ports"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	portservices "github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
@@ -36,6 +37,7 @@ type Params struct {
 	fx.In
 
 	Logger              *zap.Logger
+	DB                  ports.DBConnection
 	ConsoleRepo         repositories.DispatchConsoleRepository
 	DispatchControlRepo repositories.DispatchControlRepository
 	AgentControlRepo    repositories.AgentControlRepository
@@ -47,6 +49,7 @@ type Params struct {
 
 type Service struct {
 	l                   *zap.Logger
+	db                  ports.DBConnection
 	consoleRepo         repositories.DispatchConsoleRepository
 	dispatchControlRepo repositories.DispatchControlRepository
 	agentControlRepo    repositories.AgentControlRepository
@@ -59,6 +62,7 @@ type Service struct {
 func New(p Params) *Service {
 	return &Service{
 		l:                   p.Logger.Named("service.dispatch-auto-assign"),
+		db:                  p.DB,
 		consoleRepo:         p.ConsoleRepo,
 		dispatchControlRepo: p.DispatchControlRepo,
 		agentControlRepo:    p.AgentControlRepo,
@@ -460,6 +464,8 @@ type assignMoveToolParams struct {
 	PrimaryWorkerID string `json:"primaryWorkerId"`
 	TractorID       string `json:"tractorId"`
 	TrailerID       string `json:"trailerId,omitempty"`
+	TourID          string `json:"tourId,omitempty"`
+	SequenceIndex   int    `json:"sequenceIndex,omitempty"`
 }
 
 func toolParamsFor(planned *portservices.DispatchPlannedAssignment) (map[string]any, error) {
@@ -471,13 +477,17 @@ func toolParamsFor(planned *portservices.DispatchPlannedAssignment) (map[string]
 	if !planned.TrailerID.IsNil() {
 		params.TrailerID = planned.TrailerID.String()
 	}
+	if !planned.TourID.IsNil() {
+		params.TourID = planned.TourID.String()
+		params.SequenceIndex = planned.SequenceIndex
+	}
 
 	raw, err := sonic.Marshal(params)
 	if err != nil {
 		return nil, fmt.Errorf("marshal assign move tool params: %w", err)
 	}
 
-	out := make(map[string]any, 4)
+	out := make(map[string]any, 6)
 	if err = sonic.Unmarshal(raw, &out); err != nil {
 		return nil, fmt.Errorf("unmarshal assign move tool params: %w", err)
 	}
@@ -0,0 +1,140 @@
+package dispatchautoassignservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/core/domain/agent"
+	"github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	portservices "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/uptrace/bun"
+)
+
+type tourStep struct {
+	proposalID pulid.ID
+	params     assignMoveToolParams
+}
+
+// AcceptTour executes a planned tour as a whole. Its moves are assigned in sequence and
+// its proposals marked accepted in one transaction, so the tour is either fully
+// dispatched or left exactly as proposed.
+func (s *Service) AcceptTour(
+	ctx context.Context,
+	req *portservices.DispatchAcceptTourRequest,
+) (*portservices.DispatchTourAcceptance, error) {
+	agentControl, err := s.agentControlRepo.GetOrCreate(ctx, req.TenantInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if agentControl.ShadowMode {
+		return nil, errortypes.NewBusinessError(
+			"Tours cannot be accepted while the dispatch agent is in shadow mode",
+		)
+	}
+
+	proposals, err := s.proposalRepo.ListPendingByTour(
+		ctx,
+		repositories.ListAgentProposalsByTourRequest{
+			RunID:      req.RunID,
+			TourID:     req.TourID,
+			TenantInfo: req.TenantInfo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposals) == 0 {
+		return nil, errortypes.NewNotFoundError("Tour has no pending proposals")
+	}
+
+	steps, err := tourStepsFor(proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := &portservices.DispatchTourAcceptance{
+		RunID:       req.RunID,
+		TourID:      req.TourID,
+		MoveIDs:     make([]pulid.ID, 0, len(steps)),
+		ProposalIDs: make([]pulid.ID, 0, len(steps)),
+	}
+
+	err = s.db.WithTx(ctx, ports.TxOptions{}, func(txCtx context.Context, _ bun.Tx) error {
+		for _, step := range steps {
+			if _, txErr := s.assignments.AssignToMove(
+				txCtx,
+				assignRequestFor(req, step.params),
+			); txErr != nil {
+				return txErr
+			}
+
+			if _, txErr := s.proposalRepo.UpdateStatus(
+				txCtx,
+				repositories.UpdateAgentProposalStatusRequest{
+					ID:         step.proposalID,
+					TenantInfo: req.TenantInfo,
+					Status:     agent.ProposalStatusAccepted,
+				},
+			); txErr != nil {
+				return txErr
+			}
+
+			accepted.MoveIDs = append(accepted.MoveIDs, pulid.ID(step.params.ShipmentMoveID))
+			accepted.ProposalIDs = append(accepted.ProposalIDs, step.proposalID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accepted, nil
+}
+
+func tourStepsFor(proposals []*agent.AgentProposal) ([]tourStep, error) {
+	steps := make([]tourStep, 0, len(proposals))
+	for _, proposal := range proposals {
+		raw, err := sonic.Marshal(proposal.ToolParams)
+		if err != nil {
+			return nil, fmt.Errorf("marshal tour proposal params: %w", err)
+		}
+
+		step := tourStep{proposalID: proposal.ID}
+		if err = sonic.Unmarshal(raw, &step.params); err != nil {
+			return nil, fmt.Errorf("unmarshal tour proposal params: %w", err)
+		}
+		steps = append(steps, step)
+	}
+
+	slices.SortStableFunc(steps, func(a, b tourStep) int {
+		return cmp.Compare(a.params.SequenceIndex, b.params.SequenceIndex)
+	})
+
+	return steps, nil
+}
+
+func assignRequestFor(
+	req *portservices.DispatchAcceptTourRequest,
+	params assignMoveToolParams,
+) *repositories.AssignShipmentMoveRequest {
+	assignReq := &repositories.AssignShipmentMoveRequest{
+		TenantInfo:      req.TenantInfo,
+		ShipmentMoveID:  pulid.ID(params.ShipmentMoveID),
+		PrimaryWorkerID: pulid.ID(params.PrimaryWorkerID),
+		TractorID:       pulid.ID(params.TractorID),
+	}
+	if params.TrailerID != "" {
+		trailerID := pulid.ID(params.TrailerID)
+		assignReq.TrailerID = &trailerID
+	}
+
+	return assignReq
+}
@@ -0,0 +1,261 @@
+package dispatchautoassignservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/agent"
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/internal/core/domain/tenant"
+	"github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	portservices "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/testutil/dbtest"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+type tourTxKey struct{}
+
+// tourTxConnection marks the context it hands to the transaction body and records
+// whether the body failed, which a real connection would roll back.
+type tourTxConnection struct {
+	dbtest.NopConnection
+	rolledBack *bool
+}
+
+func (c tourTxConnection) WithTx(
+	ctx context.Context,
+	_ ports.TxOptions,
+	fn func(context.Context, bun.Tx) error,
+) error {
+	err := fn(context.WithValue(ctx, tourTxKey{}, true), bun.Tx{})
+	*c.rolledBack = err != nil
+	return err
+}
+
+func inTourTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(tourTxKey{}).(bool)
+	return inTx
+}
+
+type stubAgentControlRepo struct {
+	control *tenant.AgentControl
+}
+
+func (s stubAgentControlRepo) GetOrCreate(
+	context.Context,
+	pagination.TenantInfo,
+) (*tenant.AgentControl, error) {
+	return s.control, nil
+}
+
+func (s stubAgentControlRepo) Update(
+	_ context.Context,
+	entity *tenant.AgentControl,
+) (*tenant.AgentControl, error) {
+	return entity, nil
+}
+
+type stubTourProposalRepo struct {
+	repositories.AgentProposalRepository
+	mock.Mock
+}
+
+func (m *stubTourProposalRepo) ListPendingByTour(
+	ctx context.Context,
+	req repositories.ListAgentProposalsByTourRequest,
+) ([]*agent.AgentProposal, error) {
+	args := m.Called(ctx, req)
+	proposals, _ := args.Get(0).([]*agent.AgentProposal)
+	return proposals, args.Error(1)
+}
+
+func (m *stubTourProposalRepo) UpdateStatus(
+	ctx context.Context,
+	req repositories.UpdateAgentProposalStatusRequest,
+) (*agent.AgentProposal, error) {
+	args := m.Called(ctx, req)
+	return nil, args.Error(1)
+}
+
+func tourProposal(tourID pulid.ID, sequence int) *agent.AgentProposal {
+	return &agent.AgentProposal{
+		ID: pulid.MustNew("apr_"),
+		ToolParams: map[string]any{
+			"shipmentMoveId":  pulid.MustNew("sm_").String(),
+			"primaryWorkerId": pulid.MustNew("wrk_").String(),
+			"tractorId":       pulid.MustNew("trac_").String(),
+			"tourId":          tourID.String(),
+			"sequenceIndex":   float64(sequence),
+		},
+	}
+}
+
+func moveOf(proposal *agent.AgentProposal) pulid.ID {
+	return pulid.ID(proposal.ToolParams["shipmentMoveId"].(string))
+}
+
+type tourFixture struct {
+	svc         *Service
+	proposals   *stubTourProposalRepo
+	assignments *mocks.MockAssignmentService
+	rolledBack  *bool
+	req         *portservices.DispatchAcceptTourRequest
+}
+
+func newTourFixture(t *testing.T, shadow bool) tourFixture {
+	t.Helper()
+
+	proposals := &stubTourProposalRepo{}
+	assignments := mocks.NewMockAssignmentService(t)
+	rolledBack := false
+
+	return tourFixture{
+		svc: &Service{
+			l:                zap.NewNop(),
+			db:               tourTxConnection{rolledBack: &rolledBack},
+			agentControlRepo: stubAgentControlRepo{control: &tenant.AgentControl{ShadowMode: shadow}},
+			proposalRepo:     proposals,
+			assignments:      assignments,
+		},
+		proposals:   proposals,
+		assignments: assignments,
+		rolledBack:  &rolledBack,
+		req: &portservices.DispatchAcceptTourRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: pulid.MustNew("org_"),
+				BuID:  pulid.MustNew("bu_"),
+			},
+			RunID:  pulid.MustNew("arun_"),
+			TourID: pulid.MustNew("tour_"),
+		},
+	}
+}
+
+func TestAcceptTour_AssignsEveryMoveInSequence(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTourFixture(t, false)
+	second := tourProposal(fixture.req.TourID, 1)
+	first := tourProposal(fixture.req.TourID, 0)
+	fixture.proposals.On("ListPendingByTour", mock.Anything, mock.Anything).
+		Return([]*agent.AgentProposal{second, first}, nil)
+	fixture.proposals.On("UpdateStatus", mock.MatchedBy(inTourTx), mock.MatchedBy(
+		func(req repositories.UpdateAgentProposalStatusRequest) bool {
+			return req.Status == agent.ProposalStatusAccepted
+		},
+	)).Return(nil, nil).Twice()
+
+	order := make([]pulid.ID, 0, 2)
+	fixture.assignments.EXPECT().
+		AssignToMove(mock.MatchedBy(inTourTx), mock.Anything).
+		RunAndReturn(func(
+			_ context.Context,
+			req *repositories.AssignShipmentMoveRequest,
+		) (*shipment.Assignment, error) {
+			order = append(order, req.ShipmentMoveID)
+			return &shipment.Assignment{}, nil
+		}).
+		Twice()
+
+	accepted, err := fixture.svc.AcceptTour(t.Context(), fixture.req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []pulid.ID{moveOf(first), moveOf(second)}, order)
+	assert.Equal(t, order, accepted.MoveIDs)
+	assert.Equal(t, []pulid.ID{first.ID, second.ID}, accepted.ProposalIDs)
+	assert.False(t, *fixture.rolledBack)
+	fixture.proposals.AssertExpectations(t)
+}
+
+func TestAcceptTour_RollsBackWhenAMoveCannotBeAssigned(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTourFixture(t, false)
+	first := tourProposal(fixture.req.TourID, 0)
+	second := tourProposal(fixture.req.TourID, 1)
+	fixture.proposals.On("ListPendingByTour", mock.Anything, mock.Anything).
+		Return([]*agent.AgentProposal{first, second}, nil)
+	fixture.proposals.On("UpdateStatus", mock.MatchedBy(inTourTx), mock.MatchedBy(
+		func(req repositories.UpdateAgentProposalStatusRequest) bool {
+			return req.ID == first.ID
+		},
+	)).Return(nil, nil).Once()
+
+	fixture.assignments.EXPECT().
+		AssignToMove(mock.MatchedBy(inTourTx), mock.MatchedBy(
+			func(req *repositories.AssignShipmentMoveRequest) bool {
+				return req.ShipmentMoveID == moveOf(first)
+			},
+		)).
+		Return(&shipment.Assignment{}, nil).
+		Once()
+	fixture.assignments.EXPECT().
+		AssignToMove(mock.Anything, mock.MatchedBy(
+			func(req *repositories.AssignShipmentMoveRequest) bool {
+				return req.ShipmentMoveID == moveOf(second)
+			},
+		)).
+		Return(nil, errors.New("worker is no longer available")).
+		Once()
+
+	accepted, err := fixture.svc.AcceptTour(t.Context(), fixture.req)
+	require.Error(t, err)
+	assert.Nil(t, accepted)
+	assert.True(t, *fixture.rolledBack, "the first assignment and its proposal must roll back")
+	fixture.proposals.AssertExpectations(t)
+}
+
+func TestAcceptTour_RollsBackWhenAProposalCannotBeAccepted(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTourFixture(t, false)
+	only := tourProposal(fixture.req.TourID, 0)
+	fixture.proposals.On("ListPendingByTour", mock.Anything, mock.Anything).
+		Return([]*agent.AgentProposal{only}, nil)
+	refused := errors.New("proposal was modified concurrently")
+	fixture.proposals.On("UpdateStatus", mock.MatchedBy(inTourTx), mock.Anything).
+		Return(nil, refused).
+		Once()
+	fixture.assignments.EXPECT().
+		AssignToMove(mock.MatchedBy(inTourTx), mock.Anything).
+		Return(&shipment.Assignment{}, nil).
+		Once()
+
+	accepted, err := fixture.svc.AcceptTour(t.Context(), fixture.req)
+	require.ErrorIs(t, err, refused)
+	assert.Nil(t, accepted)
+	assert.True(t, *fixture.rolledBack, "a tour whose proposals stay pending must not stay assigned")
+}
+
+func TestAcceptTour_ShadowModeIsRejected(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTourFixture(t, true)
+
+	_, err := fixture.svc.AcceptTour(t.Context(), fixture.req)
+	require.Error(t, err)
+	assert.True(t, errortypes.IsBusinessError(err))
+	fixture.proposals.AssertNotCalled(t, "ListPendingByTour", mock.Anything, mock.Anything)
+}
+
+func TestAcceptTour_NothingPendingIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	fixture := newTourFixture(t, false)
+	fixture.proposals.On("ListPendingByTour", mock.Anything, mock.Anything).
+		Return([]*agent.AgentProposal{}, nil)
+
+	_, err := fixture.svc.AcceptTour(t.Context(), fixture.req)
+	require.Error(t, err)
+	assert.True(t, errortypes.IsNotFoundError(err))
+}
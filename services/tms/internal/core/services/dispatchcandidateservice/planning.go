@@ -2,6 +2,8 @@ package dispatchcandidateservice
 
 import (
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/hosprojection"
+	"github.com/emoss08/trenova/shared/geoutils"
 	"github.com/emoss08/trenova/shared/pulid"
 )
 
@@ -28,6 +30,14 @@ func (s *Service) ScoreCandidate(req *ScoreCandidateRequest) *CandidateScore {
 	})
 }
 
+// PlannedHOS is where a driver's hours-of-service clocks stand at At, the end of the
+// last move planned for them in this run.
+type PlannedHOS struct {
+	Clocks hosprojection.Clocks
+	Limits hosprojection.Limits
+	At     int64
+}
+
 type CommitPlannedMoveRequest struct {
 	Snapshot *FleetSnapshot
 	Move     *repositories.BoardMove
@@ -51,6 +61,7 @@ func CommitPlannedMove(req *CommitPlannedMoveRequest) {
 
 	move := req.Move
 	workerID := req.Score.WorkerID
+	availableAt := commitPlannedHOS(req.Snapshot, workerID, req.Score)
 
 	req.Snapshot.CommitmentsByWorker[workerID] = append(
 		req.Snapshot.CommitmentsByWorker[workerID],
@@ -61,7 +72,7 @@ func CommitPlannedMove(req *CommitPlannedMoveRequest) {
 			ProNumber:      move.ProNumber,
 			MoveStatus:     move.MoveStatus,
 			WindowStart:    move.OriginWindowStart,
-			WindowEnd:      PlannedCompletion(move, req.Score),
+			WindowEnd:      max(PlannedCompletion(move, req.Score), availableAt),
 			DestinationID:  move.DestinationLocationID,
 			DestinationCty: move.DestinationCity,
 			DestinationSt:  move.DestinationState,
@@ -72,12 +83,71 @@ func CommitPlannedMove(req *CommitPlannedMoveRequest) {
 	)
 }
 
+// commitPlannedHOS records the clocks the driver is left with after the scored trip and
+// returns when they can next drive. A trip that runs the drive, shift or (where the
+// ruleset limits it) on-duty clock dry forces the ruleset's daily reset before anything
+// else, so the driver only becomes available once it completes.
+func commitPlannedHOS(snapshot *FleetSnapshot, workerID pulid.ID, score *CandidateScore) int64 {
+	if score.hosAfter == nil {
+		return 0
+	}
+
+	planned := *score.hosAfter
+	if planned.Clocks.DriveMs <= 0 || planned.Clocks.ShiftMs <= 0 ||
+		(planned.Limits.OnDutyMs > 0 && planned.Clocks.OnDutyMs <= 0) {
+		var restSeconds int64
+		planned.Clocks, restSeconds = hosprojection.Reset(planned.Clocks, planned.Limits)
+		planned.At += restSeconds
+	}
+
+	if snapshot.PlannedHOSByWorker == nil {
+		snapshot.PlannedHOSByWorker = make(map[pulid.ID]*PlannedHOS, 1)
+	}
+	snapshot.PlannedHOSByWorker[workerID] = &planned
+
+	return planned.At
+}
+
+// PlannedCompletion is when the driver finishes the move: the later of the delivery
+// window and the time it takes to drive there, including any breaks and resets the
+// hours-of-service projection inserted en route.
 func PlannedCompletion(move *repositories.BoardMove, score *CandidateScore) int64 {
 	if move == nil || score == nil {
 		return 0
 	}
 
-	drivenBy := score.ProjectedAvailable + score.EstimatedDriveMs/1000
+	drivenBy := score.ProjectedAvailable + max(score.EstimatedDriveMs, score.HOSTripMs)/1000
 
 	return max(MoveWindowEnd(move), drivenBy)
 }
+
+// HomeLeg estimates the empty run from the move's destination back to the driver's
+// home location on the same road-circuity and linehaul-speed assumptions as deadhead.
+// False means either end has no coordinates.
+func HomeLeg(
+	move *repositories.BoardMove,
+	driver *repositories.BoardDriver,
+) (miles float64, driveSeconds int64, ok bool) {
+	if move == nil || driver == nil ||
+		move.DestinationLatitude == nil || move.DestinationLongitude == nil ||
+		driver.HomeLatitude == nil || driver.HomeLongitude == nil {
+		return 0, 0, false
+	}
+
+	miles, driveSeconds = EstimateLeg(
+		*move.DestinationLatitude,
+		*move.DestinationLongitude,
+		*driver.HomeLatitude,
+		*driver.HomeLongitude,
+	)
+
+	return miles, driveSeconds, true
+}
+
+// EstimateLeg is the road distance and linehaul drive time between two points, using
+// the same circuity and speed assumptions the candidate scoring applies to deadhead.
+func EstimateLeg(fromLat, fromLon, toLat, toLon float64) (miles float64, driveSeconds int64) {
+	miles = geoutils.HaversineMiles(fromLat, fromLon, toLat, toLon) * roadCircuityFactor
+
+	return miles, int64(miles / averageLinehaulMph * 3600)
+}
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/emoss08/trenova/internal/core/domain/telematics"
+	"github.com/emoss08/trenova/internal/core/domain/worker"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/hosprojection"
 	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -205,6 +208,168 @@ func TestPlannedCompletion(t *testing.T) {
 	}
 }
 
+func TestCommitPlannedMove_ChainsHOSFromThePlannedMove(t *testing.T) {
+	t.Parallel()
+
+	svc := &Service{}
+	now := timeutils.NowUnix()
+	workerID := pulid.MustNew("wrk_")
+	driver := &repositories.BoardDriver{WorkerID: workerID}
+
+	snapshot := &FleetSnapshot{
+		Now:              now,
+		TelematicsActive: true,
+		WorkersByID:      map[pulid.ID]*worker.Worker{workerID: {ID: workerID}},
+		HOSByWorker: map[pulid.ID]*telematics.WorkerHOSState{
+			workerID: {
+				WorkerID:         workerID,
+				DriveRemainingMs: 11 * 3_600_000,
+				ShiftRemainingMs: 14 * 3_600_000,
+				CycleRemainingMs: 70 * 3_600_000,
+				BreakRemainingMs: 8 * 3_600_000,
+				RecordedAt:       now,
+			},
+		},
+	}
+	trip := tripEstimate{departure: now, driveMs: 6 * 3_600_000}
+
+	fresh := svc.projectHOS(driver, snapshot, trip, true)
+	require.NotNil(t, fresh)
+	require.True(t, fresh.Feasible)
+	assert.Zero(t, fresh.Trip.Resets, "six hours fits on full clocks")
+
+	CommitPlannedMove(&CommitPlannedMoveRequest{
+		Snapshot: snapshot,
+		Move:     &repositories.BoardMove{MoveID: pulid.MustNew("mov_")},
+		Score: &CandidateScore{
+			WorkerID:           workerID,
+			ProjectedAvailable: now,
+			EstimatedDriveMs:   9 * 3_600_000,
+			hosAfter: &PlannedHOS{
+				Clocks: hosprojection.Clocks{
+					DriveMs: 2 * 3_600_000,
+					ShiftMs: 4 * 3_600_000,
+					CycleMs: 61 * 3_600_000,
+					BreakMs: 7 * 3_600_000,
+				},
+				Limits: LimitsFor(snapshot.HOSByWorker[workerID]),
+				At:     now + 9*3600,
+			},
+		},
+	})
+
+	trip.departure = now + 9*3600
+	chained := svc.projectHOS(driver, snapshot, trip, true)
+	require.NotNil(t, chained)
+	require.True(t, chained.Feasible)
+	assert.Equal(t, 1, chained.Trip.Resets,
+		"two hours of drive left after the first move forces a reset partway through")
+	assert.Equal(t, int64(2*3_600_000), chained.DriveAvailableMs)
+}
+
+func TestCommitPlannedMove_ExhaustedClocksForceAReset(t *testing.T) {
+	t.Parallel()
+
+	now := int64(1_000_000)
+	workerID := pulid.MustNew("wrk_")
+	snapshot := &FleetSnapshot{Now: now}
+	limits := hosprojection.LimitsForRuleset("", "", "")
+
+	CommitPlannedMove(&CommitPlannedMoveRequest{
+		Snapshot: snapshot,
+		Move:     &repositories.BoardMove{MoveID: pulid.MustNew("mov_")},
+		Score: &CandidateScore{
+			WorkerID:           workerID,
+			ProjectedAvailable: now,
+			EstimatedDriveMs:   11 * 3_600_000,
+			hosAfter: &PlannedHOS{
+				Clocks: hosprojection.Clocks{ShiftMs: 3_600_000, CycleMs: 40 * 3_600_000},
+				Limits: limits,
+				At:     now + 11*3600,
+			},
+		},
+	})
+
+	planned := snapshot.PlannedHOSByWorker[workerID]
+	require.NotNil(t, planned)
+	assert.Equal(t, now+21*3600, planned.At)
+	assert.Equal(t, limits.DriveMs, planned.Clocks.DriveMs)
+	assert.Equal(t, int64(40*3_600_000), planned.Clocks.CycleMs, "a reset never restores the cycle")
+	assert.Equal(t, now+21*3600,
+		ProjectedTimeAvailable(snapshot.CommitmentsByWorker[workerID], now),
+		"the driver is not available again until the reset completes")
+}
+
+func TestCommitPlannedMove_ExhaustedOnDutyClockForcesAReset(t *testing.T) {
+	t.Parallel()
+
+	now := int64(1_000_000)
+	workerID := pulid.MustNew("wrk_")
+	snapshot := &FleetSnapshot{Now: now}
+	limits := hosprojection.LimitsForRuleset("Canada 70 hour / 7 day", "Canada South", "CS")
+	require.Positive(t, limits.OnDutyMs)
+
+	CommitPlannedMove(&CommitPlannedMoveRequest{
+		Snapshot: snapshot,
+		Move:     &repositories.BoardMove{MoveID: pulid.MustNew("mov_")},
+		Score: &CandidateScore{
+			WorkerID:           workerID,
+			ProjectedAvailable: now,
+			EstimatedDriveMs:   3 * 3_600_000,
+			hosAfter: &PlannedHOS{
+				Clocks: hosprojection.Clocks{
+					DriveMs:  5 * 3_600_000,
+					ShiftMs:  2 * 3_600_000,
+					CycleMs:  40 * 3_600_000,
+					BreakMs:  8 * 3_600_000,
+					OnDutyMs: 0,
+				},
+				Limits: limits,
+				At:     now + 3*3600,
+			},
+		},
+	})
+
+	planned := snapshot.PlannedHOSByWorker[workerID]
+	require.NotNil(t, planned)
+	assert.Greater(t, planned.At, now+3*3600,
+		"the driver rests before the next move once the on-duty limit is spent")
+	assert.Equal(t, limits.OnDutyMs, planned.Clocks.OnDutyMs)
+}
+
+func TestPlannedCompletion_IncludesRestsTakenEnRoute(t *testing.T) {
+	t.Parallel()
+
+	now := int64(1_000_000)
+	completion := PlannedCompletion(&repositories.BoardMove{}, &CandidateScore{
+		ProjectedAvailable: now,
+		EstimatedDriveMs:   12 * 3_600_000,
+		HOSTripMs:          22*3_600_000 + 30*60_000,
+	})
+
+	assert.Equal(t, now+22*3600+30*60, completion)
+}
+
+func TestHomeLeg(t *testing.T) {
+	t.Parallel()
+
+	move := &repositories.BoardMove{
+		DestinationLatitude:  ptr(chicagoLat),
+		DestinationLongitude: ptr(chicagoLon),
+	}
+
+	miles, driveSeconds, ok := HomeLeg(move, &repositories.BoardDriver{
+		HomeLatitude:  ptr(denverLat),
+		HomeLongitude: ptr(denverLon),
+	})
+	require.True(t, ok)
+	assert.InDelta(t, 1104, miles, 5, "about 920 great-circle miles at road circuity")
+	assert.Equal(t, int64(miles/averageLinehaulMph*3600), driveSeconds)
+
+	_, _, ok = HomeLeg(move, &repositories.BoardDriver{})
+	assert.False(t, ok, "a driver without a home location has no home leg")
+}
+
 func TestScoreCandidate_RejectsIncompleteRequests(t *testing.T) {
 	t.Parallel()
 
@@ -43,13 +43,20 @@ type CandidateScore struct {
 	ProjectedAvailable int64    `json:"projectedTimeAvailable"`
 
 	HOSStrategy          string `json:"hosStrategy"`
+	HOSRuleset           string `json:"hosRuleset"`
+	HOSExemption         string `json:"hosExemption"`
 	HOSRestStartDeadline int64  `json:"hosRestStartDeadline"`
 	HOSProjectedDriveMs  int64  `json:"hosProjectedDriveMs"`
 	HOSProjectedShiftMs  int64  `json:"hosProjectedShiftMs"`
 	HOSProjectedCycleMs  int64  `json:"hosProjectedCycleMs"`
+	HOSTripMs            int64  `json:"hosTripMs"`
 
 	Findings []dispatcheligibility.Finding `json:"findings"`
 	Factors  []ScoreFactor                 `json:"factors"`
+
+	// hosAfter is where the driver's clocks stand once this trip is driven; committing
+	// the move chains the next projection from here.
+	hosAfter *PlannedHOS
 }
 
 func (c *CandidateScore) Blocked() bool {
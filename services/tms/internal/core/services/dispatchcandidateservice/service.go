@@ -38,7 +38,9 @@ const (
 
 	safetyLookbackSeconds = int64(90 * 24 * 3600)
 
-	hosLogLookbackSeconds = int64(9 * 24 * 3600)
+	// hosLogLookbackSeconds reaches back past the Canadian 14-day window, the longest
+	// any ruleset looks at (cycle 2 and the 24-hour off-duty requirement).
+	hosLogLookbackSeconds = int64(15 * 24 * 3600)
 
 	ptoLookaheadSeconds = int64(14 * 24 * 3600)
 )
@@ -91,6 +93,7 @@ type FleetSnapshot struct {
 	HOSLogsByWorker     map[pulid.ID][]*telematics.WorkerHOSLog
 	PosByTractor        map[pulid.ID]*telematics.VehiclePosition
 	CommitmentsByWorker map[pulid.ID][]*repositories.WorkerCommitment
+	PlannedHOSByWorker  map[pulid.ID]*PlannedHOS
 	TimeOffByWorker     map[pulid.ID][]*repositories.WorkerTimeOff
 	WorkloadByWorker    map[pulid.ID]*repositories.WorkerWorkload
 	OnTimeByWorker      map[pulid.ID]*repositories.WorkerOnTimeStats
@@ -567,10 +570,20 @@ func (s *Service) scoreDriver(p *scoreDriverParams) *CandidateScore {
 	}
 	if projection != nil {
 		result.HOSStrategy = string(projection.Strategy)
+		result.HOSRuleset = string(projection.Ruleset)
+		result.HOSExemption = string(projection.Exemption)
 		result.HOSRestStartDeadline = projection.RestStartDeadline
 		result.HOSProjectedDriveMs = projection.DriveAvailableMs
 		result.HOSProjectedShiftMs = projection.ShiftAvailableMs
 		result.HOSProjectedCycleMs = projection.CycleAvailableMs
+		if projection.Feasible {
+			result.HOSTripMs = projection.Trip.TotalMs
+			result.hosAfter = &PlannedHOS{
+				Clocks: projection.Trip.End,
+				Limits: LimitsFor(hosState),
+				At:     projection.Trip.Arrival,
+			}
+		}
 	}
 
 	result.Verdict = verdictFor(&verdictInput{
@@ -598,7 +611,9 @@ func (s *Service) scoreDriver(p *scoreDriverParams) *CandidateScore {
 
 // projectHOS runs the forward hours-of-service projection for one candidate. It
 // returns nil when the projection cannot be trusted: no telematics integration, no or
-// stale clock data, or an ELD-exempt driver whose hours are not tracked.
+// stale clock data, or an ELD-exempt driver whose hours are not tracked. Short-haul
+// drivers claim the 150 air-mile exemption for trips measured from their home
+// location; one whose trip can't be measured is projected without it.
 func (s *Service) projectHOS(
 	driver *repositories.BoardDriver,
 	snapshot *FleetSnapshot,
@@ -612,39 +627,67 @@ func (s *Service) projectHOS(
 	if state == nil {
 		return nil
 	}
-	if w := snapshot.WorkersByID[driver.WorkerID]; w != nil && w.Profile != nil &&
-		(w.Profile.ELDExempt || w.Profile.ShortHaulExempt) {
-		return nil
+	var shortHaul *hosprojection.ShortHaulTrip
+	if w := snapshot.WorkersByID[driver.WorkerID]; w != nil && w.Profile != nil {
+		if w.Profile.ELDExempt {
+			return nil
+		}
+		if w.Profile.ShortHaulExempt {
+			shortHaul = trip.shortHaul
+		}
 	}
 
-	limits := hosprojection.LimitsForRuleset(
-		state.RulesetCycle,
-		state.RulesetShift,
-		state.RulesetJurisdiction,
+	limits := LimitsFor(state)
+	timeline := hosprojection.BuildTimeline(
+		snapshot.HOSLogsByWorker[driver.WorkerID],
+		snapshot.Now,
 	)
-	result := hosprojection.Project(hosprojection.Input{
+	onDutyMs := limits.OnDutyRemaining(timeline, snapshot.Now, state.DriveRemainingMs)
+	input := hosprojection.Input{
 		Now:         snapshot.Now,
 		Departure:   trip.departure,
 		TripDriveMs: trip.driveMs,
 		Clocks: hosprojection.Clocks{
-			DriveMs: state.DriveRemainingMs,
-			ShiftMs: state.ShiftRemainingMs,
-			CycleMs: state.CycleRemainingMs,
-			BreakMs: state.BreakRemainingMs,
+			DriveMs:  state.DriveRemainingMs,
+			OnDutyMs: onDutyMs,
+			ShiftMs:  state.ShiftRemainingMs,
+			CycleMs:  state.CycleRemainingMs,
+			BreakMs:  state.BreakRemainingMs,
 		},
 		ClocksAt:        state.RecordedAt,
 		CycleTomorrowMs: state.CycleTomorrowMs,
 		DutyStatus:      state.DutyStatus,
 		Limits:          limits,
 		Jurisdiction:    state.RulesetJurisdiction,
-		Timeline: hosprojection.BuildTimeline(
-			snapshot.HOSLogsByWorker[driver.WorkerID],
-			snapshot.Now,
-		),
-	})
+		Timeline:        timeline,
+		ShortHaul:       shortHaul,
+	}
+
+	// A driver with moves already planned ahead starts this trip from the clocks their
+	// last planned move leaves them with. The logged timeline and duty status describe
+	// the driver as they are now, not at the end of that chain, so neither applies.
+	if planned := snapshot.PlannedHOSByWorker[driver.WorkerID]; planned != nil {
+		input.Now = max(snapshot.Now, planned.At)
+		input.Clocks = planned.Clocks
+		input.ClocksAt = planned.At
+		input.CycleTomorrowMs = 0
+		input.DutyStatus = ""
+		input.Timeline = nil
+	}
+
+	result := hosprojection.Project(input)
 	return &result
 }
 
+// LimitsFor derives the clock ceilings for the ruleset the driver's ELD reports.
+func LimitsFor(state *telematics.WorkerHOSState) hosprojection.Limits {
+	return hosprojection.LimitsForRuleset(
+		state.RulesetCycle,
+		state.RulesetShift,
+		state.RulesetJurisdiction,
+	)
+}
+
 // appointmentFinding gives EnforceWorkerPTARestrictions its teeth: when a driver's
 // projected availability puts them past the pickup window, the flag decides whether
 // that hard-blocks the candidate or merely warns the dispatcher.
@@ -689,6 +732,7 @@ type tripEstimate struct {
 	projectedComplete int64
 	slackMinutes      float64
 	appointmentKnown  bool
+	shortHaul         *hosprojection.ShortHaulTrip
 }
 
 func (s *Service) computeTrip(
@@ -739,10 +783,44 @@ func (s *Service) computeTrip(
 		estimate.appointmentKnown = true
 		estimate.slackMinutes = float64(appointment-estimate.projectedArrival) / 60
 	}
+	estimate.shortHaul = shortHaulTrip(driver, move)
 
 	return estimate
 }
 
+// shortHaulTrip measures the move against the driver's home location, the reporting
+// location the 150 air-mile short-haul exemption is judged from: how far out the move
+// reaches in a straight line and how long the drive back takes. Nil when the home
+// location or either end of the move has no coordinates.
+func shortHaulTrip(
+	driver *repositories.BoardDriver,
+	move *repositories.BoardMove,
+) *hosprojection.ShortHaulTrip {
+	if move.OriginLatitude == nil || move.OriginLongitude == nil {
+		return nil
+	}
+	_, returnSeconds, ok := HomeLeg(move, driver)
+	if !ok {
+		return nil
+	}
+
+	homeLat, homeLon := *driver.HomeLatitude, *driver.HomeLongitude
+	radius := max(
+		geoutils.HaversineMiles(homeLat, homeLon, *move.OriginLatitude, *move.OriginLongitude),
+		geoutils.HaversineMiles(
+			homeLat,
+			homeLon,
+			*move.DestinationLatitude,
+			*move.DestinationLongitude,
+		),
+	)
+
+	return &hosprojection.ShortHaulTrip{
+		MaxRadiusMiles: radius,
+		ReturnDriveMs:  returnSeconds * 1000,
+	}
+}
+
 type geoPoint struct {
 	lat float64
 	lon float64
@@ -8,6 +8,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/worker"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/services/dispatcheligibility"
+	"github.com/emoss08/trenova/internal/core/services/hosprojection"
 	"github.com/emoss08/trenova/shared/pulid"
 	"github.com/emoss08/trenova/shared/timeutils"
 	"github.com/stretchr/testify/assert"
@@ -259,6 +260,61 @@ func TestComputeTrip_NoAppointmentLeavesSlackUnknown(t *testing.T) {
 	assert.Zero(t, trip.slackMinutes)
 }
 
+func TestProjectHOS_ShortHaulDriversClaimTheExemptionFromHome(t *testing.T) {
+	t.Parallel()
+
+	svc := &Service{}
+	now := timeutils.NowUnix()
+	workerID := pulid.MustNew("wrk_")
+	driver := &repositories.BoardDriver{
+		WorkerID:      workerID,
+		HomeLatitude:  ptr(41.8781),
+		HomeLongitude: ptr(-87.6298),
+	}
+	move := &repositories.BoardMove{
+		MoveID:               pulid.MustNew("smv_"),
+		Distance:             ptr(425),
+		OriginLatitude:       ptr(41.8781),
+		OriginLongitude:      ptr(-87.6298),
+		DestinationLatitude:  ptr(43.0389),
+		DestinationLongitude: ptr(-87.9065),
+	}
+
+	for _, shortHaul := range []bool{true, false} {
+		snapshot := &FleetSnapshot{
+			Now:              now,
+			TelematicsActive: true,
+			WorkersByID: map[pulid.ID]*worker.Worker{
+				workerID: {ID: workerID, Profile: &worker.WorkerProfile{ShortHaulExempt: shortHaul}},
+			},
+			HOSByWorker: map[pulid.ID]*telematics.WorkerHOSState{
+				workerID: {
+					WorkerID:         workerID,
+					DriveRemainingMs: 11 * 3_600_000,
+					ShiftRemainingMs: 14 * 3_600_000,
+					CycleRemainingMs: 70 * 3_600_000,
+					BreakRemainingMs: 8 * 3_600_000,
+					RecordedAt:       now,
+				},
+			},
+		}
+
+		trip := svc.computeTrip(driver, move, snapshot)
+		projection := svc.projectHOS(driver, snapshot, trip, true)
+
+		require.NotNil(t, projection)
+		require.True(t, projection.Feasible)
+		assert.Equal(t, hosprojection.RulesetUSProperty, projection.Ruleset)
+		if shortHaul {
+			assert.Equal(t, hosprojection.ExemptionShortHaul, projection.Exemption)
+			assert.Zero(t, projection.Trip.Breaks, "the exemption lifts the 30-minute break")
+		} else {
+			assert.Equal(t, hosprojection.ExemptionNone, projection.Exemption)
+			assert.Equal(t, 1, projection.Trip.Breaks)
+		}
+	}
+}
+
 func blockingFinding() []dispatcheligibility.Finding {
 	return []dispatcheligibility.Finding{{
 		Code:     dispatcheligibility.CodeHOSNoDriveTime,
@@ -9,6 +9,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/trailer"
 	"github.com/emoss08/trenova/internal/core/domain/worker"
 	"github.com/emoss08/trenova/internal/core/services/dispatcheligibility"
+	"github.com/emoss08/trenova/internal/core/services/hosprojection"
 	"github.com/emoss08/trenova/pkg/domaintypes"
 	"github.com/emoss08/trenova/pkg/errortypes"
 	"github.com/emoss08/trenova/shared/pulid"
@@ -225,6 +226,36 @@ func TestEvaluateHOSClocks_ExhaustedClocksReportEveryViolation(t *testing.T) {
 	assert.True(t, eval.Blocked())
 }
 
+func TestEvaluateHOSTrip_CitesTheProjectionRuleset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ruleset hosprojection.Ruleset
+		limiter hosprojection.Limiter
+		want    string
+	}{
+		{hosprojection.RulesetUSProperty, hosprojection.LimiterCycle, "49 CFR 395.3(b)"},
+		{hosprojection.RulesetAlaskaProperty, hosprojection.LimiterDrive, "49 CFR 395.1(h)"},
+		{hosprojection.RulesetCanadaCycle1, hosprojection.LimiterShift, "SOR/2005-313 s. 13"},
+		{hosprojection.RulesetCanadaCycle1, hosprojection.LimiterOnDuty, "SOR/2005-313 s. 13"},
+		{hosprojection.RulesetCanadaCycle2, hosprojection.LimiterCycle, "SOR/2005-313 s. 27"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.ruleset), func(t *testing.T) {
+			t.Parallel()
+
+			eval := dispatcheligibility.EvaluateHOSTrip(dispatcheligibility.HOSTripInput{
+				Projection: &hosprojection.Result{Ruleset: tt.ruleset, Limiter: tt.limiter},
+				Control:    blockingControl(),
+			})
+
+			require.Len(t, eval.Findings, 1)
+			assert.Equal(t, tt.want, eval.Findings[0].Regulation)
+		})
+	}
+}
+
 func TestEvaluateHOSClocks_BreakOnlyRequiredWhileDriving(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,15 @@ import (
 
 const hosTripTightMarginMs = int64(3_600_000)
 
+const (
+	regPassenger       = "49 CFR 395.5"
+	regAlaska          = "49 CFR 395.1(h)"
+	regTexasIntrastate = "37 TAC 4.12"
+	regCanadaShift     = "SOR/2005-313 s. 13"
+	regCanadaCycle1    = "SOR/2005-313 s. 26"
+	regCanadaCycle2    = "SOR/2005-313 s. 27"
+)
+
 type HOSTripInput struct {
 	Projection *hosprojection.Result
 	Control    *dispatchcontrol.DispatchControl
@@ -33,7 +42,7 @@ func EvaluateHOSTrip(in HOSTripInput) *Evaluation {
 			Severity:   enforcementSeverity(in.Control.ComplianceEnforcementLevel),
 			Field:      fieldHOS,
 			Message:    projection.Detail,
-			Regulation: regulationForLimiter(projection.Limiter),
+			Regulation: regulationFor(projection.Ruleset, projection.Limiter),
 		})
 		return eval
 	}
@@ -63,11 +72,41 @@ func EvaluateHOSTrip(in HOSTripInput) *Evaluation {
 	return eval
 }
 
+// regulationFor cites the rule the binding clock comes from under the projection's
+// ruleset; the US interstate property citations are the default.
+func regulationFor(ruleset hosprojection.Ruleset, limiter hosprojection.Limiter) string {
+	if limiter == hosprojection.LimiterNone {
+		return ""
+	}
+
+	switch ruleset {
+	case hosprojection.RulesetUSPassenger:
+		return regPassenger
+	case hosprojection.RulesetAlaskaProperty:
+		return regAlaska
+	case hosprojection.RulesetTexasIntrastate:
+		return regTexasIntrastate
+	case hosprojection.RulesetCanadaCycle1:
+		if limiter == hosprojection.LimiterCycle {
+			return regCanadaCycle1
+		}
+		return regCanadaShift
+	case hosprojection.RulesetCanadaCycle2:
+		if limiter == hosprojection.LimiterCycle {
+			return regCanadaCycle2
+		}
+		return regCanadaShift
+	case hosprojection.RulesetUSProperty:
+	}
+
+	return regulationForLimiter(limiter)
+}
+
 func regulationForLimiter(limiter hosprojection.Limiter) string {
 	switch limiter {
 	case hosprojection.LimiterDrive:
 		return regShiftDriving
-	case hosprojection.LimiterShift:
+	case hosprojection.LimiterOnDuty, hosprojection.LimiterShift:
 		return regShiftWindow
 	case hosprojection.LimiterCycle:
 		return regCycle
@@ -0,0 +1,373 @@
+package dispatchrelayservice
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	portservices "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
+	"github.com/emoss08/trenova/internal/core/services/dispatcheligibility"
+	"github.com/emoss08/trenova/internal/core/services/hosprojection"
+	"github.com/emoss08/trenova/shared/pulid"
+)
+
+const (
+	// maxRelayDetour is how much longer than the direct route the two relay legs may be
+	// before the relay point no longer counts as on the way.
+	maxRelayDetour = 1.15
+
+	// minRelayLegMiles keeps relay points away from either end of the route, where a
+	// hand-off costs more time than it could save.
+	minRelayLegMiles = 100.0
+
+	// swapDwellSeconds covers the hand-off itself: walk-around, trailer hook or seat
+	// change and paperwork.
+	swapDwellSeconds = int64(30 * 60)
+
+	// reliefDomicileMiles is how close to the relay point a relief driver with no known
+	// position must live to be assumed to report there from home.
+	reliefDomicileMiles = 75.0
+
+	defaultRelayOptions = 5
+)
+
+type scoreFunc func(
+	driver *repositories.BoardDriver,
+	leg *repositories.BoardMove,
+) *dispatchcandidateservice.CandidateScore
+
+type relayPlanner struct {
+	move      *repositories.BoardMove
+	lead      *repositories.BoardDriver
+	snapshot  *dispatchcandidateservice.FleetSnapshot
+	departure int64
+	limit     int
+	score     scoreFunc
+}
+
+// route describes the move as driven. Straight-line estimates are scaled to the move's
+// own distance when it has one, so relay legs add up to the miles actually dispatched.
+type route struct {
+	miles   float64
+	driveMs int64
+	scale   float64
+}
+
+func (p *relayPlanner) plan(points []*repositories.RelayPoint) *portservices.DispatchRelayPlan {
+	r := p.route()
+	leadClocks, leadLimits := p.clocksFor(p.lead.WorkerID)
+
+	plan := &portservices.DispatchRelayPlan{
+		MoveID:         p.move.MoveID,
+		ProNumber:      p.move.ProNumber,
+		LeadWorkerID:   p.lead.WorkerID,
+		LeadWorkerName: p.driverName(p.lead),
+		TotalMiles:     r.miles,
+		DepartsAt:      p.departure,
+		Options:        make([]*portservices.DispatchRelayOption, 0),
+	}
+
+	solo, limiter := hosprojection.PlanTransit(r.driveMs, leadClocks, leadLimits)
+	if limiter == hosprojection.LimiterNone {
+		plan.SingleDriverFeasible = true
+		plan.SingleDriverTransitSeconds = solo.TotalMs / 1000
+		plan.SingleDriverDelivery = p.departure + plan.SingleDriverTransitSeconds
+		plan.SingleDriverResets = solo.Resets
+	}
+
+	for _, point := range points {
+		option := p.optionAt(point, r, leadClocks, leadLimits)
+		if option == nil {
+			continue
+		}
+
+		option.TransitSeconds = option.ProjectedDelivery - p.departure
+		if plan.SingleDriverFeasible {
+			option.SecondsSaved = plan.SingleDriverTransitSeconds - option.TransitSeconds
+			if option.SecondsSaved <= 0 {
+				continue
+			}
+		}
+		plan.Options = append(plan.Options, option)
+	}
+
+	slices.SortStableFunc(plan.Options, func(a, b *portservices.DispatchRelayOption) int {
+		if c := cmp.Compare(a.ProjectedDelivery, b.ProjectedDelivery); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.LeadLegMiles, a.LeadLegMiles)
+	})
+
+	limit := p.limit
+	if limit <= 0 {
+		limit = defaultRelayOptions
+	}
+	if len(plan.Options) > limit {
+		plan.Options = plan.Options[:limit]
+	}
+
+	return plan
+}
+
+func (p *relayPlanner) route() route {
+	straightMiles, straightSeconds := dispatchcandidateservice.EstimateLeg(
+		*p.move.OriginLatitude,
+		*p.move.OriginLongitude,
+		*p.move.DestinationLatitude,
+		*p.move.DestinationLongitude,
+	)
+
+	r := route{miles: straightMiles, driveMs: straightSeconds * 1000, scale: 1}
+	if p.move.Distance != nil && *p.move.Distance > 0 && straightMiles > 0 {
+		r.scale = *p.move.Distance / straightMiles
+		r.miles = *p.move.Distance
+		r.driveMs = int64(float64(r.driveMs) * r.scale)
+	}
+
+	return r
+}
+
+// legMiles estimates the miles from the move's origin to the relay point and on
+// to its destination, scaled to the move's own distance like the planner's legs.
+func legMiles(move *repositories.BoardMove, point *repositories.RelayPoint) (lead, relief float64) {
+	r := (&relayPlanner{move: move}).route()
+	lead, _ = dispatchcandidateservice.EstimateLeg(
+		*move.OriginLatitude,
+		*move.OriginLongitude,
+		point.Latitude,
+		point.Longitude,
+	)
+	relief, _ = dispatchcandidateservice.EstimateLeg(
+		point.Latitude,
+		point.Longitude,
+		*move.DestinationLatitude,
+		*move.DestinationLongitude,
+	)
+
+	return lead * r.scale, relief * r.scale
+}
+
+// optionAt plans a relay at one point: the lead must reach it on the hours they have
+// without a 10-hour reset, and the best eligible relief driver takes it from there.
+func (p *relayPlanner) optionAt(
+	point *repositories.RelayPoint,
+	r route,
+	leadClocks hosprojection.Clocks,
+	leadLimits hosprojection.Limits,
+) *portservices.DispatchRelayOption {
+	leadMiles, leadSeconds := dispatchcandidateservice.EstimateLeg(
+		*p.move.OriginLatitude,
+		*p.move.OriginLongitude,
+		point.Latitude,
+		point.Longitude,
+	)
+	reliefMiles, reliefSeconds := dispatchcandidateservice.EstimateLeg(
+		point.Latitude,
+		point.Longitude,
+		*p.move.DestinationLatitude,
+		*p.move.DestinationLongitude,
+	)
+	leadMiles *= r.scale
+	reliefMiles *= r.scale
+
+	if leadMiles < minRelayLegMiles || reliefMiles < minRelayLegMiles ||
+		leadMiles+reliefMiles > r.miles*maxRelayDetour {
+		return nil
+	}
+
+	leadPlan, limiter := hosprojection.PlanTrip(
+		int64(float64(leadSeconds*1000)*r.scale),
+		leadClocks,
+		leadLimits,
+	)
+	if limiter != hosprojection.LimiterNone || leadPlan.Resets > 0 {
+		return nil
+	}
+
+	leadArrival := p.departure + leadPlan.TotalMs/1000
+	option := p.bestRelief(&reliefLeg{
+		point:       point,
+		miles:       reliefMiles,
+		driveMs:     int64(float64(reliefSeconds*1000) * r.scale),
+		leadArrival: leadArrival,
+	})
+	if option == nil {
+		return nil
+	}
+
+	option.RelayPoint = point
+	option.LeadLegMiles = leadMiles
+	option.LeadArrival = leadArrival
+	option.LeadDriveMargin = leadPlan.MarginMs
+
+	return option
+}
+
+type reliefLeg struct {
+	point       *repositories.RelayPoint
+	miles       float64
+	driveMs     int64
+	leadArrival int64
+}
+
+func (p *relayPlanner) bestRelief(leg *reliefLeg) *portservices.DispatchRelayOption {
+	board := p.boardLeg(leg)
+
+	var best *portservices.DispatchRelayOption
+	for _, driver := range p.snapshot.Drivers {
+		if driver.WorkerID == p.lead.WorkerID {
+			continue
+		}
+
+		score := p.score(driver, board)
+		if score == nil || score.Blocked() {
+			continue
+		}
+
+		option := p.reliefOption(driver, score, leg)
+		if option == nil {
+			continue
+		}
+		if best == nil || option.ProjectedDelivery < best.ProjectedDelivery {
+			best = option
+		}
+	}
+
+	return best
+}
+
+// boardLeg dresses the relay leg up as a board move so the candidate scoring can judge
+// relief drivers on it exactly as it would any other pickup.
+func (p *relayPlanner) boardLeg(leg *reliefLeg) *repositories.BoardMove {
+	board := *p.move
+	board.OriginLocationID = leg.point.LocationID
+	board.OriginName = leg.point.Name
+	board.OriginCity = leg.point.City
+	board.OriginState = leg.point.State
+	board.OriginLatitude = &leg.point.Latitude
+	board.OriginLongitude = &leg.point.Longitude
+	board.OriginWindowStart = leg.leadArrival
+	board.OriginWindowEnd = nil
+	board.OriginActualArrive = nil
+	board.Distance = &leg.miles
+
+	return &board
+}
+
+func (p *relayPlanner) reliefOption(
+	driver *repositories.BoardDriver,
+	score *dispatchcandidateservice.CandidateScore,
+	leg *reliefLeg,
+) *portservices.DispatchRelayOption {
+	option := &portservices.DispatchRelayOption{
+		Mode:             portservices.DispatchRelayModeTractorSwap,
+		ReliefWorkerID:   driver.WorkerID,
+		ReliefWorkerName: score.WorkerName,
+		ReliefTractorID:  driver.TractorID,
+		TrailerID:        score.TrailerID,
+		ReliefLegMiles:   leg.miles,
+		Findings:         score.Findings,
+	}
+	if option.Findings == nil {
+		option.Findings = []dispatcheligibility.Finding{}
+	}
+
+	if driver.TractorID.IsNil() {
+		leadTractorID := firstNonNil(p.move.AssignedTractorID, p.lead.TractorID)
+		if leadTractorID.IsNil() {
+			return nil
+		}
+		option.Mode = portservices.DispatchRelayModeSlipSeat
+		option.ReliefTractorID = leadTractorID
+	}
+
+	arrival, deadheadMs, ok := p.reliefReach(driver, score, leg.point)
+	if !ok {
+		return nil
+	}
+	option.ReliefDeadheadMiles = score.DeadheadMiles
+	option.ReliefArrival = arrival
+
+	clocks, limits := p.clocksFor(driver.WorkerID)
+	if deadheadMs > 0 {
+		deadhead, limiter := hosprojection.PlanTransit(deadheadMs, clocks, limits)
+		if limiter != hosprojection.LimiterNone {
+			return nil
+		}
+		clocks = deadhead.End
+	}
+
+	option.HandoffAt = max(leg.leadArrival, arrival) + swapDwellSeconds
+	rested, restSeconds := hosprojection.Reset(clocks, limits)
+	if option.HandoffAt-arrival >= restSeconds {
+		clocks = rested
+	}
+
+	relay, limiter := hosprojection.PlanTransit(leg.driveMs, clocks, limits)
+	if limiter != hosprojection.LimiterNone {
+		return nil
+	}
+	option.ReliefResets = relay.Resets
+	option.ProjectedDelivery = option.HandoffAt + relay.TotalMs/1000
+
+	return option
+}
+
+// reliefReach is when the relief driver gets to the relay point and how much of their
+// drive clock the empty run there burns. Drivers whose position is unknown are only
+// considered when they live close enough to report there from home.
+func (p *relayPlanner) reliefReach(
+	driver *repositories.BoardDriver,
+	score *dispatchcandidateservice.CandidateScore,
+	point *repositories.RelayPoint,
+) (arrival, deadheadMs int64, ok bool) {
+	available := max(p.snapshot.Now, score.ProjectedAvailable)
+	if score.DeadheadMiles != nil {
+		return score.ProjectedArrival, (score.ProjectedArrival - available) * 1000, true
+	}
+
+	if driver.HomeLatitude == nil || driver.HomeLongitude == nil {
+		return 0, 0, false
+	}
+	miles, seconds := dispatchcandidateservice.EstimateLeg(
+		*driver.HomeLatitude,
+		*driver.HomeLongitude,
+		point.Latitude,
+		point.Longitude,
+	)
+	if miles > reliefDomicileMiles {
+		return 0, 0, false
+	}
+
+	return available + seconds, 0, true
+}
+
+// clocksFor starts a driver from their reported hours when the ELD data is current.
+// Without it the driver is assumed rested, which is what a relief reporting for a
+// relay normally is.
+func (p *relayPlanner) clocksFor(workerID pulid.ID) (hosprojection.Clocks, hosprojection.Limits) {
+	state := p.snapshot.HOSByWorker[workerID]
+	if !p.snapshot.TelematicsActive || state == nil ||
+		p.snapshot.Now-state.RecordedAt > dispatcheligibility.HOSStateMaxAgeSeconds {
+		limits := hosprojection.LimitsForRuleset("", "", "")
+		return limits.Full(), limits
+	}
+
+	limits := dispatchcandidateservice.LimitsFor(state)
+	timeline := hosprojection.BuildTimeline(p.snapshot.HOSLogsByWorker[workerID], p.snapshot.Now)
+	return hosprojection.Clocks{
+		DriveMs:  state.DriveRemainingMs,
+		OnDutyMs: limits.OnDutyRemaining(timeline, p.snapshot.Now, state.DriveRemainingMs),
+		ShiftMs:  state.ShiftRemainingMs,
+		CycleMs:  state.CycleRemainingMs,
+		BreakMs:  state.BreakRemainingMs,
+	}, limits
+}
+
+func (p *relayPlanner) driverName(driver *repositories.BoardDriver) string {
+	if name, ok := p.snapshot.NamesByWorker[driver.WorkerID]; ok {
+		return name
+	}
+	return driver.FirstName + " " + driver.LastName
+}
@@ -0,0 +1,318 @@
+package dispatchrelayservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	portservices "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
+	"github.com/emoss08/trenova/internal/core/services/dispatcheligibility"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const relayNow = int64(1_700_000_000)
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+// chicagoToDallas is roughly 19 hours of driving: too far for one shift, comfortably
+// two shifts' worth when split near the middle.
+func chicagoToDallas() *repositories.BoardMove {
+	return &repositories.BoardMove{
+		MoveID:               pulid.MustNew("sm_"),
+		ProNumber:            "P100",
+		OriginLatitude:       floatPtr(41.8781),
+		OriginLongitude:      floatPtr(-87.6298),
+		DestinationLatitude:  floatPtr(32.7767),
+		DestinationLongitude: floatPtr(-96.7970),
+		OriginWindowStart:    relayNow,
+	}
+}
+
+func midwayTerminal() *repositories.RelayPoint {
+	return &repositories.RelayPoint{
+		LocationID: pulid.MustNew("loc_"),
+		Name:       "Midway Terminal",
+		Latitude:   37.4,
+		Longitude:  -92.5,
+	}
+}
+
+func relayDriver(name string) *repositories.BoardDriver {
+	return &repositories.BoardDriver{
+		WorkerID:  pulid.MustNew("wrk_"),
+		FirstName: name,
+		TractorID: pulid.MustNew("trac_"),
+	}
+}
+
+type scoredDriver struct {
+	driver *repositories.BoardDriver
+	score  *dispatchcandidateservice.CandidateScore
+}
+
+func onSiteScore(driver *repositories.BoardDriver) *dispatchcandidateservice.CandidateScore {
+	return &dispatchcandidateservice.CandidateScore{
+		WorkerID:           driver.WorkerID,
+		WorkerName:         driver.FirstName,
+		TractorID:          driver.TractorID,
+		DeadheadMiles:      floatPtr(0),
+		ProjectedAvailable: relayNow,
+		ProjectedArrival:   relayNow,
+	}
+}
+
+func newPlanner(
+	move *repositories.BoardMove,
+	lead *repositories.BoardDriver,
+	reliefs ...scoredDriver,
+) *relayPlanner {
+	drivers := []*repositories.BoardDriver{lead}
+	scores := make(map[pulid.ID]*dispatchcandidateservice.CandidateScore, len(reliefs))
+	for _, relief := range reliefs {
+		drivers = append(drivers, relief.driver)
+		scores[relief.driver.WorkerID] = relief.score
+	}
+
+	return &relayPlanner{
+		move:      move,
+		lead:      lead,
+		departure: relayNow,
+		snapshot: &dispatchcandidateservice.FleetSnapshot{
+			Drivers: drivers,
+			Now:     relayNow,
+		},
+		score: func(
+			driver *repositories.BoardDriver,
+			_ *repositories.BoardMove,
+		) *dispatchcandidateservice.CandidateScore {
+			return scores[driver.WorkerID]
+		},
+	}
+}
+
+func TestPlan_RelayBeatsSingleDriverTransit(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	planner := newPlanner(
+		chicagoToDallas(),
+		lead,
+		scoredDriver{driver: relief, score: onSiteScore(relief)},
+	)
+
+	plan := planner.plan([]*repositories.RelayPoint{midwayTerminal()})
+
+	require.True(t, plan.SingleDriverFeasible)
+	assert.Equal(t, 1, plan.SingleDriverResets, "one driver needs a 10-hour reset en route")
+	require.Len(t, plan.Options, 1)
+
+	option := plan.Options[0]
+	assert.Equal(t, portservices.DispatchRelayModeTractorSwap, option.Mode)
+	assert.Equal(t, relief.WorkerID, option.ReliefWorkerID)
+	assert.Equal(t, relief.TractorID, option.ReliefTractorID)
+	assert.Equal(t, option.LeadArrival+swapDwellSeconds, option.HandoffAt)
+	assert.Zero(t, option.ReliefResets)
+	assert.Equal(t, option.ProjectedDelivery-relayNow, option.TransitSeconds)
+	assert.Equal(
+		t,
+		plan.SingleDriverTransitSeconds-option.TransitSeconds,
+		option.SecondsSaved,
+	)
+	assert.Greater(t, option.SecondsSaved, int64(8*3600), "the relay skips the 10-hour reset")
+}
+
+func TestPlan_LeadMustReachTheRelayPointWithoutAReset(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	planner := newPlanner(
+		chicagoToDallas(),
+		lead,
+		scoredDriver{driver: relief, score: onSiteScore(relief)},
+	)
+	nearDallas := &repositories.RelayPoint{
+		LocationID: pulid.MustNew("loc_"),
+		Latitude:   34.5,
+		Longitude:  -95.5,
+	}
+
+	plan := planner.plan([]*repositories.RelayPoint{nearDallas})
+
+	assert.Empty(t, plan.Options)
+}
+
+func TestPlan_OffRoutePointsAreIgnored(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	planner := newPlanner(
+		chicagoToDallas(),
+		lead,
+		scoredDriver{driver: relief, score: onSiteScore(relief)},
+	)
+	louisville := &repositories.RelayPoint{
+		LocationID: pulid.MustNew("loc_"),
+		Latitude:   38.2527,
+		Longitude:  -85.7585,
+	}
+
+	plan := planner.plan([]*repositories.RelayPoint{louisville})
+
+	assert.Empty(t, plan.Options)
+}
+
+func TestPlan_BlockedReliefIsSkipped(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	score := onSiteScore(relief)
+	score.Findings = []dispatcheligibility.Finding{{
+		Code:     dispatcheligibility.CodeAppointmentMissed,
+		Severity: dispatcheligibility.SeverityBlock,
+	}}
+	planner := newPlanner(chicagoToDallas(), lead, scoredDriver{driver: relief, score: score})
+
+	plan := planner.plan([]*repositories.RelayPoint{midwayTerminal()})
+
+	assert.Empty(t, plan.Options)
+}
+
+func TestPlan_TractorlessReliefSlipSeatsIntoTheLeadTractor(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	point := midwayTerminal()
+	relief := &repositories.BoardDriver{
+		WorkerID:      pulid.MustNew("wrk_"),
+		FirstName:     "Relief",
+		HomeLatitude:  floatPtr(point.Latitude + 0.2),
+		HomeLongitude: floatPtr(point.Longitude),
+	}
+	score := onSiteScore(relief)
+	score.DeadheadMiles = nil
+	planner := newPlanner(chicagoToDallas(), lead, scoredDriver{driver: relief, score: score})
+
+	plan := planner.plan([]*repositories.RelayPoint{point})
+
+	require.Len(t, plan.Options, 1)
+	option := plan.Options[0]
+	assert.Equal(t, portservices.DispatchRelayModeSlipSeat, option.Mode)
+	assert.Equal(t, lead.TractorID, option.ReliefTractorID)
+	assert.Nil(t, option.ReliefDeadheadMiles, "the relief reports from home")
+}
+
+func TestPlan_UnlocatedReliefFarFromHomeIsSkipped(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	relief.HomeLatitude = floatPtr(45.0)
+	relief.HomeLongitude = floatPtr(-75.0)
+	score := onSiteScore(relief)
+	score.DeadheadMiles = nil
+	planner := newPlanner(chicagoToDallas(), lead, scoredDriver{driver: relief, score: score})
+
+	plan := planner.plan([]*repositories.RelayPoint{midwayTerminal()})
+
+	assert.Empty(t, plan.Options)
+}
+
+func TestPlan_EarliestDeliveryWinsAndLimitApplies(t *testing.T) {
+	t.Parallel()
+
+	lead := relayDriver("Lead")
+	ready := relayDriver("Ready")
+	late := relayDriver("Late")
+	lateScore := onSiteScore(late)
+	lateScore.ProjectedAvailable = relayNow + 14*3600
+	lateScore.ProjectedArrival = relayNow + 14*3600
+	planner := newPlanner(
+		chicagoToDallas(),
+		lead,
+		scoredDriver{driver: late, score: lateScore},
+		scoredDriver{driver: ready, score: onSiteScore(ready)},
+	)
+	planner.limit = 1
+
+	plan := planner.plan([]*repositories.RelayPoint{
+		midwayTerminal(),
+		{LocationID: pulid.MustNew("loc_"), Latitude: 37.0, Longitude: -92.9},
+	})
+
+	require.Len(t, plan.Options, 1)
+	assert.Equal(t, ready.WorkerID, plan.Options[0].ReliefWorkerID)
+}
+
+func TestReliefTractorFor(t *testing.T) {
+	t.Parallel()
+
+	leadTractor := pulid.MustNew("trac_")
+	withTractor := relayDriver("Relief")
+	withoutTractor := &repositories.BoardDriver{WorkerID: pulid.MustNew("wrk_")}
+
+	tests := []struct {
+		name    string
+		mode    portservices.DispatchRelayMode
+		relief  *repositories.BoardDriver
+		lead    pulid.ID
+		want    pulid.ID
+		wantErr bool
+	}{
+		{
+			name:   "tractor swap uses the relief tractor",
+			mode:   portservices.DispatchRelayModeTractorSwap,
+			relief: withTractor,
+			lead:   leadTractor,
+			want:   withTractor.TractorID,
+		},
+		{
+			name:   "slip seat takes over the lead tractor",
+			mode:   portservices.DispatchRelayModeSlipSeat,
+			relief: withoutTractor,
+			lead:   leadTractor,
+			want:   leadTractor,
+		},
+		{
+			name:    "tractor swap without a relief tractor",
+			mode:    portservices.DispatchRelayModeTractorSwap,
+			relief:  withoutTractor,
+			lead:    leadTractor,
+			wantErr: true,
+		},
+		{
+			name:    "slip seat without a lead tractor",
+			mode:    portservices.DispatchRelayModeSlipSeat,
+			relief:  withoutTractor,
+			wantErr: true,
+		},
+		{
+			name:    "unknown mode",
+			mode:    portservices.DispatchRelayMode("Teleport"),
+			relief:  withTractor,
+			lead:    leadTractor,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := reliefTractorFor(tt.mode, tt.relief, tt.lead)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package dispatchrelayservice
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/locationcategory"
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	portservices "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/dispatchcandidateservice"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	// relayCorridorPadDegrees widens the origin/destination bounding box so terminals
+	// just off the straight line between them are still considered.
+	relayCorridorPadDegrees = 1.0
+
+	maxRelayPoints = 200
+)
+
+var relayCategories = []locationcategory.Category{
+	locationcategory.CategoryTerminal,
+	locationcategory.CategoryTruckStop,
+}
+
+type Params struct {
+	fx.In
+
+	Logger              *zap.Logger
+	DB                  ports.DBConnection
+	ConsoleRepo         repositories.DispatchConsoleRepository
+	DispatchControlRepo repositories.DispatchControlRepository
+	CandidateService    *dispatchcandidateservice.Service
+	ShipmentMoveService portservices.ShipmentMoveService
+	AssignmentService   portservices.AssignmentService
+}
+
+type Service struct {
+	l                   *zap.Logger
+	db                  ports.DBConnection
+	consoleRepo         repositories.DispatchConsoleRepository
+	dispatchControlRepo repositories.DispatchControlRepository
+	candidates          *dispatchcandidateservice.Service
+	moves               portservices.ShipmentMoveService
+	assignments         portservices.AssignmentService
+}
+
+func New(p Params) *Service {
+	return &Service{
+		l:                   p.Logger.Named("service.dispatch-relay"),
+		db:                  p.DB,
+		consoleRepo:         p.ConsoleRepo,
+		dispatchControlRepo: p.DispatchControlRepo,
+		candidates:          p.CandidateService,
+		moves:               p.ShipmentMoveService,
+		assignments:         p.AssignmentService,
+	}
+}
+
+func (s *Service) Plan(
+	ctx context.Context,
+	req *portservices.DispatchRelayPlanRequest,
+) (*portservices.DispatchRelayPlan, error) {
+	control, err := s.dispatchControlRepo.GetOrCreate(
+		ctx,
+		req.TenantInfo.OrgID,
+		req.TenantInfo.BuID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	move, err := s.loadMove(ctx, req.TenantInfo, req.MoveID)
+	if err != nil {
+		return nil, err
+	}
+	if !routeKnown(move) {
+		return nil, errortypes.NewBusinessError(
+			"Relay planning needs geocoded pickup and delivery locations",
+		).WithParam("moveId", move.MoveID.String())
+	}
+
+	leadID, err := leadWorkerFor(req.LeadWorkerID, move)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timeutils.NowUnix()
+	moves := []*repositories.BoardMove{move}
+	snapshot, err := s.candidates.BuildSnapshot(ctx, &dispatchcandidateservice.SnapshotRequest{
+		TenantInfo: req.TenantInfo,
+		Filter: &repositories.DispatchBoardFilter{
+			TenantInfo:  req.TenantInfo,
+			WindowStart: min(now, move.OriginWindowStart),
+			WindowEnd:   max(now, dispatchcandidateservice.MoveWindowEnd(move)),
+		},
+		Control:     control,
+		CustomerIDs: dispatchcandidateservice.CustomerIDsOf(moves),
+		TrailerIDs:  dispatchcandidateservice.TrailerIDsOf(moves),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lead := driverByID(snapshot.Drivers, leadID)
+	if lead == nil {
+		return nil, errortypes.NewNotFoundError(
+			"Lead driver is not available in your organization's dispatch pool",
+		)
+	}
+
+	points, err := s.consoleRepo.ListRelayPoints(ctx, corridorFor(req.TenantInfo, move))
+	if err != nil {
+		return nil, err
+	}
+
+	planner := &relayPlanner{
+		move:      move,
+		lead:      lead,
+		snapshot:  snapshot,
+		departure: max(now, move.OriginWindowStart),
+		limit:     req.Limit,
+		score: func(
+			driver *repositories.BoardDriver,
+			leg *repositories.BoardMove,
+		) *dispatchcandidateservice.CandidateScore {
+			return s.candidates.ScoreCandidate(&dispatchcandidateservice.ScoreCandidateRequest{
+				Driver:   driver,
+				Move:     leg,
+				Snapshot: snapshot,
+			})
+		},
+	}
+
+	plan := planner.plan(points)
+	plan.GeneratedAt = now
+
+	return plan, nil
+}
+
+// Apply cuts the move at the chosen relay point and covers both legs in one
+// transaction, so a refused assignment leaves the move as it was instead of split
+// with an uncovered relay leg.
+func (s *Service) Apply(
+	ctx context.Context,
+	req *portservices.DispatchApplyRelayRequest,
+) (*portservices.DispatchRelayApplication, error) {
+	move, err := s.loadMove(ctx, req.TenantInfo, req.MoveID)
+	if err != nil {
+		return nil, err
+	}
+
+	leadID, err := leadWorkerFor(req.LeadWorkerID, move)
+	if err != nil {
+		return nil, err
+	}
+
+	drivers, err := s.consoleRepo.ListBoardDrivers(ctx, &repositories.DispatchBoardFilter{
+		TenantInfo: req.TenantInfo,
+		WorkerIDs:  []pulid.ID{leadID, req.ReliefWorkerID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lead := driverByID(drivers, leadID)
+	relief := driverByID(drivers, req.ReliefWorkerID)
+	if lead == nil || relief == nil {
+		return nil, errortypes.NewNotFoundError(
+			"Lead or relief driver is not available in your organization's dispatch pool",
+		)
+	}
+
+	leadTractorID := firstNonNil(move.AssignedTractorID, lead.TractorID)
+	reliefTractorID, err := reliefTractorFor(req.Mode, relief, leadTractorID)
+	if err != nil {
+		return nil, err
+	}
+
+	leadMiles, reliefMiles, err := s.relayLegMiles(ctx, req.TenantInfo, move, req.RelayLocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *portservices.DispatchRelayApplication
+	err = s.db.WithTx(ctx, ports.TxOptions{}, func(txCtx context.Context, _ bun.Tx) error {
+		relayed, txErr := s.moves.RelayMove(txCtx, &repositories.RelayMoveRequest{
+			TenantInfo:        req.TenantInfo,
+			MoveID:            move.MoveID,
+			RelayLocationID:   req.RelayLocationID,
+			RelayArrival:      repositories.SplitStopTimes{ScheduledWindowStart: req.LeadArrival},
+			RelayDeparture:    repositories.SplitStopTimes{ScheduledWindowStart: req.HandoffAt},
+			LeadLegDistance:   leadMiles,
+			ReliefLegDistance: reliefMiles,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		result = &portservices.DispatchRelayApplication{
+			OriginalMove: relayed.OriginalMove,
+			RelayMove:    relayed.NewMove,
+		}
+		trailerID := dispatchTrailer(move)
+
+		if move.AssignmentID.IsNil() {
+			result.LeadAssignment, txErr = s.assignments.AssignToMove(
+				txCtx,
+				assignRequest(
+					req.TenantInfo,
+					relayed.OriginalMove,
+					leadID,
+					leadTractorID,
+					trailerID,
+				),
+			)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		result.ReliefAssignment, txErr = s.assignments.AssignToMove(
+			txCtx,
+			assignRequest(
+				req.TenantInfo,
+				relayed.NewMove,
+				relief.WorkerID,
+				reliefTractorID,
+				trailerID,
+			),
+		)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Service) loadMove(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	moveID pulid.ID,
+) (*repositories.BoardMove, error) {
+	moves, err := s.consoleRepo.ListBoardMoves(ctx, &repositories.DispatchBoardFilter{
+		TenantInfo:     tenantInfo,
+		MoveIDs:        []pulid.ID{moveID},
+		IncludeCovered: true,
+		Limit:          1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(moves) == 0 {
+		return nil, errortypes.NewNotFoundError("Shipment move not found within your organization")
+	}
+	return moves[0], nil
+}
+
+// relayLegMiles measures both legs of the relay the way the planner did. A move
+// without a geocoded route, or a relay location outside its corridor, leaves
+// both legs unmeasured.
+func (s *Service) relayLegMiles(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	move *repositories.BoardMove,
+	relayLocationID pulid.ID,
+) (lead, relief *float64, err error) {
+	if !routeKnown(move) {
+		return nil, nil, nil
+	}
+
+	points, err := s.consoleRepo.ListRelayPoints(ctx, corridorFor(tenantInfo, move))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, point := range points {
+		if point.LocationID == relayLocationID {
+			leadMiles, reliefMiles := legMiles(move, point)
+			return &leadMiles, &reliefMiles, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// leadWorkerFor picks who drives the first leg. A move that is already assigned is led by
+// the driver on it; otherwise the dispatcher has to say who will take it.
+func leadWorkerFor(requested pulid.ID, move *repositories.BoardMove) (pulid.ID, error) {
+	if leadID := firstNonNil(move.AssignedWorkerID, requested); !leadID.IsNil() {
+		return leadID, nil
+	}
+
+	return pulid.Nil, errortypes.NewValidationError(
+		"leadWorkerId",
+		errortypes.ErrRequired,
+		"Choose the driver who takes the first leg, or assign the move first",
+	)
+}
+
+// reliefTractorFor decides which tractor pulls the relay leg. A slip-seat relief takes
+// over the lead's tractor; a tractor swap needs the relief driver to bring their own.
+func reliefTractorFor(
+	mode portservices.DispatchRelayMode,
+	relief *repositories.BoardDriver,
+	leadTractorID pulid.ID,
+) (pulid.ID, error) {
+	switch mode {
+	case portservices.DispatchRelayModeSlipSeat:
+		if leadTractorID.IsNil() {
+			return pulid.Nil, errortypes.NewBusinessError(
+				"Slip-seat relay needs the lead driver to have a tractor",
+			)
+		}
+		return leadTractorID, nil
+	case portservices.DispatchRelayModeTractorSwap:
+		if relief.TractorID.IsNil() {
+			return pulid.Nil, errortypes.NewBusinessError(
+				"Relief driver has no tractor; plan the relay as a slip seat instead",
+			)
+		}
+		return relief.TractorID, nil
+	default:
+		return pulid.Nil, errortypes.NewValidationError(
+			"mode",
+			errortypes.ErrInvalid,
+			"Relay mode must be TractorSwap or SlipSeat",
+		)
+	}
+}
+
+func assignRequest(
+	tenantInfo pagination.TenantInfo,
+	move *shipment.ShipmentMove,
+	workerID, tractorID, trailerID pulid.ID,
+) *repositories.AssignShipmentMoveRequest {
+	req := &repositories.AssignShipmentMoveRequest{
+		TenantInfo:      tenantInfo,
+		ShipmentMoveID:  move.ID,
+		PrimaryWorkerID: workerID,
+		TractorID:       tractorID,
+	}
+	if !trailerID.IsNil() {
+		req.TrailerID = &trailerID
+	}
+
+	return req
+}
+
+// dispatchTrailer is the trailer the load rides on: the one already assigned, or the
+// one the previous move on the shipment left it on.
+func dispatchTrailer(move *repositories.BoardMove) pulid.ID {
+	return firstNonNil(move.AssignedTrailerID, move.PreviousMoveTrailerID)
+}
+
+func corridorFor(
+	tenantInfo pagination.TenantInfo,
+	move *repositories.BoardMove,
+) *repositories.ListRelayPointsRequest {
+	originLat, originLon := *move.OriginLatitude, *move.OriginLongitude
+	destLat, destLon := *move.DestinationLatitude, *move.DestinationLongitude
+
+	return &repositories.ListRelayPointsRequest{
+		TenantInfo:   tenantInfo,
+		Categories:   relayCategories,
+		MinLatitude:  min(originLat, destLat) - relayCorridorPadDegrees,
+		MaxLatitude:  max(originLat, destLat) + relayCorridorPadDegrees,
+		MinLongitude: min(originLon, destLon) - relayCorridorPadDegrees,
+		MaxLongitude: max(originLon, destLon) + relayCorridorPadDegrees,
+		Limit:        maxRelayPoints,
+	}
+}
+
+func routeKnown(move *repositories.BoardMove) bool {
+	return move.OriginLatitude != nil && move.OriginLongitude != nil &&
+		move.DestinationLatitude != nil && move.DestinationLongitude != nil
+}
+
+func driverByID(drivers []*repositories.BoardDriver, workerID pulid.ID) *repositories.BoardDriver {
+	for _, driver := range drivers {
+		if driver.WorkerID == workerID {
+			return driver
+		}
+	}
+	return nil
+}
+
+func firstNonNil(preferred, fallback pulid.ID) pulid.ID {
+	if !preferred.IsNil() {
+		return preferred
+	}
+	return fallback
+}
@@ -0,0 +1,190 @@
+package dispatchrelayservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	portservices "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/testutil/dbtest"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+type relayTxKey struct{}
+
+// relayTxConnection marks the context it hands to the transaction body and
+// records whether the body failed, which a real connection would roll back.
+type relayTxConnection struct {
+	dbtest.NopConnection
+	rolledBack *bool
+}
+
+func (c relayTxConnection) WithTx(
+	ctx context.Context,
+	_ ports.TxOptions,
+	fn func(context.Context, bun.Tx) error,
+) error {
+	err := fn(context.WithValue(ctx, relayTxKey{}, true), bun.Tx{})
+	*c.rolledBack = err != nil
+	return err
+}
+
+func inRelayTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(relayTxKey{}).(bool)
+	return inTx
+}
+
+// relayConsoleRepo serves the move, drivers and relay points Apply looks up.
+type relayConsoleRepo struct {
+	repositories.DispatchConsoleRepository
+	move    *repositories.BoardMove
+	drivers []*repositories.BoardDriver
+	points  []*repositories.RelayPoint
+}
+
+func (r *relayConsoleRepo) ListBoardMoves(
+	context.Context,
+	*repositories.DispatchBoardFilter,
+) ([]*repositories.BoardMove, error) {
+	return []*repositories.BoardMove{r.move}, nil
+}
+
+func (r *relayConsoleRepo) ListBoardDrivers(
+	context.Context,
+	*repositories.DispatchBoardFilter,
+) ([]*repositories.BoardDriver, error) {
+	return r.drivers, nil
+}
+
+func (r *relayConsoleRepo) ListRelayPoints(
+	context.Context,
+	*repositories.ListRelayPointsRequest,
+) ([]*repositories.RelayPoint, error) {
+	return r.points, nil
+}
+
+func TestApply_RefusedReliefAssignmentRollsBackSplit(t *testing.T) {
+	t.Parallel()
+
+	move := chicagoToDallas()
+	point := midwayTerminal()
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	moves := mocks.NewMockShipmentMoveService(t)
+	assignments := mocks.NewMockAssignmentService(t)
+	rolledBack := false
+
+	service := &Service{
+		l:  zap.NewNop(),
+		db: relayTxConnection{rolledBack: &rolledBack},
+		consoleRepo: &relayConsoleRepo{
+			move:    move,
+			drivers: []*repositories.BoardDriver{lead, relief},
+			points:  []*repositories.RelayPoint{point},
+		},
+		moves:       moves,
+		assignments: assignments,
+	}
+
+	original := &shipment.ShipmentMove{ID: move.MoveID}
+	relayLeg := &shipment.ShipmentMove{ID: pulid.MustNew("sm_")}
+	moves.EXPECT().
+		RelayMove(mock.MatchedBy(inRelayTx), mock.Anything).
+		Return(&repositories.SplitMoveResponse{OriginalMove: original, NewMove: relayLeg}, nil)
+	assignments.EXPECT().
+		AssignToMove(mock.MatchedBy(inRelayTx), mock.MatchedBy(func(req *repositories.AssignShipmentMoveRequest) bool {
+			return req.ShipmentMoveID == original.ID
+		})).
+		Return(&shipment.Assignment{}, nil)
+	refused := errors.New("relief driver is already covering another move")
+	assignments.EXPECT().
+		AssignToMove(mock.MatchedBy(inRelayTx), mock.MatchedBy(func(req *repositories.AssignShipmentMoveRequest) bool {
+			return req.ShipmentMoveID == relayLeg.ID
+		})).
+		Return(nil, refused)
+
+	result, err := service.Apply(t.Context(), &portservices.DispatchApplyRelayRequest{
+		TenantInfo:      pagination.TenantInfo{OrgID: pulid.MustNew("org_"), BuID: pulid.MustNew("bu_")},
+		MoveID:          move.MoveID,
+		RelayLocationID: point.LocationID,
+		LeadWorkerID:    lead.WorkerID,
+		ReliefWorkerID:  relief.WorkerID,
+		Mode:            portservices.DispatchRelayModeTractorSwap,
+		HandoffAt:       relayNow + 10*3600,
+		LeadArrival:     relayNow + 9*3600,
+	})
+
+	require.ErrorIs(t, err, refused)
+	assert.Nil(t, result)
+	assert.True(t, rolledBack, "the split must be rolled back with the refused assignment")
+}
+
+func TestApply_SetsEachLegDistanceFromThePlan(t *testing.T) {
+	t.Parallel()
+
+	move := chicagoToDallas()
+	move.Distance = floatPtr(1_000)
+	point := midwayTerminal()
+	lead := relayDriver("Lead")
+	relief := relayDriver("Relief")
+	moves := mocks.NewMockShipmentMoveService(t)
+	assignments := mocks.NewMockAssignmentService(t)
+	rolledBack := false
+
+	service := &Service{
+		l:  zap.NewNop(),
+		db: relayTxConnection{rolledBack: &rolledBack},
+		consoleRepo: &relayConsoleRepo{
+			move:    move,
+			drivers: []*repositories.BoardDriver{lead, relief},
+			points:  []*repositories.RelayPoint{point},
+		},
+		moves:       moves,
+		assignments: assignments,
+	}
+
+	option := newPlanner(
+		move,
+		lead,
+		scoredDriver{driver: relief, score: onSiteScore(relief)},
+	).plan([]*repositories.RelayPoint{point}).Options[0]
+
+	moves.EXPECT().
+		RelayMove(mock.Anything, mock.MatchedBy(func(req *repositories.RelayMoveRequest) bool {
+			return req.LeadLegDistance != nil && req.ReliefLegDistance != nil &&
+				*req.LeadLegDistance == option.LeadLegMiles &&
+				*req.ReliefLegDistance == option.ReliefLegMiles
+		})).
+		Return(&repositories.SplitMoveResponse{
+			OriginalMove: &shipment.ShipmentMove{ID: move.MoveID},
+			NewMove:      &shipment.ShipmentMove{ID: pulid.MustNew("sm_")},
+		}, nil)
+	assignments.EXPECT().
+		AssignToMove(mock.Anything, mock.Anything).
+		Return(&shipment.Assignment{}, nil).
+		Times(2)
+
+	_, err := service.Apply(t.Context(), &portservices.DispatchApplyRelayRequest{
+		TenantInfo:      pagination.TenantInfo{OrgID: pulid.MustNew("org_"), BuID: pulid.MustNew("bu_")},
+		MoveID:          move.MoveID,
+		RelayLocationID: point.LocationID,
+		LeadWorkerID:    lead.WorkerID,
+		ReliefWorkerID:  relief.WorkerID,
+		Mode:            portservices.DispatchRelayModeTractorSwap,
+		HandoffAt:       option.HandoffAt,
+		LeadArrival:     option.LeadArrival,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, rolledBack)
+}
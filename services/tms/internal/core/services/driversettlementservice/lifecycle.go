@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/emoss08/trenova/internal/core/domain/documenttemplate"
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
 	"github.com/emoss08/trenova/internal/core/domain/driverpay"
 	"github.com/emoss08/trenova/internal/core/domain/driversettlement"
 	"github.com/emoss08/trenova/internal/core/domain/notification"
@@ -128,7 +129,11 @@ func (s *Service) approveInternal(
 		entity.ApprovedByID = actor.UserID
 		entity.ApprovedAt = &now
 		updated, txErr = s.settlementRepo.Update(txCtx, entity)
-		return txErr
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.outboxRepo.Append(txCtx, domainevent.NewDriverSettlementApproved(updated))
 	})
 	if err != nil {
 		return nil, err
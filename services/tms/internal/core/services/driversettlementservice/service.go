@@ -45,6 +45,7 @@ type Params struct {
 	AccountingRepo      repositories.AccountingControlRepository
 	JournalRepo         repositories.JournalPostingRepository
 	FiscalPeriodRepo    repositories.FiscalPeriodRepository
+	OutboxRepo          repositories.DomainEventOutboxRepository
 	Generator           seqgen.Generator
 	PayService          *driverpayservice.Service
 	AuditService        serviceports.AuditService
@@ -74,6 +75,7 @@ type Service struct {
 	accountingRepo      repositories.AccountingControlRepository
 	journalRepo         repositories.JournalPostingRepository
 	fiscalPeriodRepo    repositories.FiscalPeriodRepository
+	outboxRepo          repositories.DomainEventOutboxRepository
 	generator           seqgen.Generator
 	payService          *driverpayservice.Service
 	auditService        serviceports.AuditService
@@ -104,6 +106,7 @@ func New(p Params) *Service { //nolint:gocritic // stable API shape
 		accountingRepo:      p.AccountingRepo,
 		journalRepo:         p.JournalRepo,
 		fiscalPeriodRepo:    p.FiscalPeriodRepo,
+		outboxRepo:          p.OutboxRepo,
 		generator:           p.Generator,
 		payService:          p.PayService,
 		auditService:        p.AuditService,
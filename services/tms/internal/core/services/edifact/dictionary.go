@@ -0,0 +1,183 @@
+package edifact
+
+type segmentMetadata struct {
+	Name     string
+	Type     string
+	Elements map[int]string
+}
+
+var edifactDictionary = map[string]segmentMetadata{
+	"UNB": {
+		Name: "Interchange Header",
+		Type: "envelope",
+		Elements: map[int]string{
+			1:  "Syntax Identifier",
+			2:  "Interchange Sender",
+			3:  "Interchange Recipient",
+			4:  "Date and Time of Preparation",
+			5:  "Interchange Control Reference",
+			7:  "Application Reference",
+			11: "Test Indicator",
+		},
+	},
+	"UNH": {
+		Name: "Message Header",
+		Type: "envelope",
+		Elements: map[int]string{
+			1: "Message Reference Number",
+			2: "Message Identifier",
+		},
+	},
+	"UNT": {
+		Name: "Message Trailer",
+		Type: "envelope",
+		Elements: map[int]string{
+			1: "Number of Segments in Message",
+			2: "Message Reference Number",
+		},
+	},
+	"UNZ": {
+		Name: "Interchange Trailer",
+		Type: "envelope",
+		Elements: map[int]string{
+			1: "Interchange Control Count",
+			2: "Interchange Control Reference",
+		},
+	},
+	"BGM": {
+		Name: "Beginning of Message",
+		Type: "header",
+		Elements: map[int]string{
+			1: "Document/Message Name",
+			2: "Document/Message Number",
+			3: "Message Function Code",
+		},
+	},
+	"DTM": {
+		Name:     "Date/Time/Period",
+		Type:     "detail",
+		Elements: map[int]string{1: "Date/Time/Period"},
+	},
+	"FTX": {
+		Name: "Free Text",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Text Subject Qualifier",
+			4: "Text Literal",
+		},
+	},
+	"RFF": {
+		Name:     "Reference",
+		Type:     "detail",
+		Elements: map[int]string{1: "Reference"},
+	},
+	"NAD": {
+		Name: "Name and Address",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Party Function Code Qualifier",
+			2: "Party Identification Details",
+			4: "Party Name",
+			5: "Street",
+			6: "City Name",
+			7: "Country Sub-entity Identification",
+			8: "Postal Identification Code",
+			9: "Country Coded",
+		},
+	},
+	"TDT": {
+		Name: "Details of Transport",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Transport Stage Code Qualifier",
+			2: "Conveyance Reference Number",
+			5: "Carrier",
+		},
+	},
+	"LOC": {
+		Name: "Place/Location Identification",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Place/Location Qualifier",
+			2: "Location Identification",
+		},
+	},
+	"GID": {
+		Name: "Goods Item Details",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Goods Item Number",
+			2: "Number and Type of Packages",
+		},
+	},
+	"MEA": {
+		Name: "Measurements",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Measurement Purpose Qualifier",
+			2: "Measurement Details",
+			3: "Value/Range",
+		},
+	},
+	"EQD": {
+		Name: "Equipment Details",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Equipment Qualifier",
+			2: "Equipment Identification",
+		},
+	},
+	"STS": {
+		Name: "Status",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Status Category",
+			2: "Status Event",
+			3: "Status Reason",
+		},
+	},
+	"CNI": {
+		Name: "Consignment Information",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Consolidation Item Number",
+			2: "Document/Message Details",
+		},
+	},
+	"CUX": {
+		Name:     "Currencies",
+		Type:     "detail",
+		Elements: map[int]string{1: "Currency Details"},
+	},
+	"LIN": {
+		Name: "Line Item",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Line Item Number",
+			3: "Item Number Identification",
+		},
+	},
+	"IMD": {
+		Name: "Item Description",
+		Type: "detail",
+		Elements: map[int]string{
+			1: "Item Description Type",
+			3: "Item Description",
+		},
+	},
+	"MOA": {
+		Name:     "Monetary Amount",
+		Type:     "detail",
+		Elements: map[int]string{1: "Monetary Amount"},
+	},
+	"UNS": {
+		Name:     "Section Control",
+		Type:     "summary",
+		Elements: map[int]string{1: "Section Identification"},
+	},
+	"CNT": {
+		Name:     "Control Total",
+		Type:     "summary",
+		Elements: map[int]string{1: "Control"},
+	},
+}
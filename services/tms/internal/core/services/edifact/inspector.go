@@ -0,0 +1,267 @@
+package edifact
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
+	"github.com/emoss08/trenova/shared/stringutils"
+)
+
+// Inspect parses an EDIFACT interchange into the same inspection model the
+// X12 inspector produces, so previews, message inspection, and inbound
+// diagnostics render identically regardless of standard.
+func Inspect(req *edix12inspect.InspectX12Request) edix12inspect.InspectX12Result {
+	result := edix12inspect.InspectX12Result{
+		RawX12:         req.RawX12,
+		TransactionSet: req.TransactionSet,
+		X12Version:     req.X12Version,
+		Segments:       []edix12inspect.X12Segment{},
+		Groups:         []edix12inspect.X12FunctionalGroup{},
+		Transactions:   []edix12inspect.X12Transaction{},
+		Diagnostics:    []edix12inspect.NormalizedDiagnostic{},
+	}
+	interchange, err := Parse(req.RawX12)
+	if err != nil {
+		result.Separators = separatorsFor(DelimitersFromEnvelope(req.Envelope), false)
+		result.Diagnostics = append(result.Diagnostics, edix12inspect.NormalizedDiagnostic{
+			Severity:     edi.ValidationSeverityError,
+			Code:         "edifact.parse.failed",
+			Source:       edix12inspect.DiagnosticSourceInspection,
+			SegmentID:    SegmentUNB,
+			Message:      err.Error(),
+			SuggestedFix: "Provide an interchange that starts with UNA or UNB.",
+		})
+		edix12inspect.Finalize(&result, req.Diagnostics)
+		return result
+	}
+
+	result.Separators = separatorsFor(interchange.Delimiters, interchange.ServiceStringAdvice)
+	result.Segments = inspectionSegments(interchange)
+	result.Transactions = inspectionTransactions(interchange)
+	result.Envelope = edix12inspect.X12Envelope{
+		ISAControlNumber: interchange.ControlReference(),
+		IEAControlNumber: interchange.Trailer.Value(2, 1),
+		ExpectedGroups:   parseCount(interchange.Trailer.Value(1, 1)),
+		ActualGroups:     len(interchange.Messages),
+	}
+	result.Diagnostics = append(result.Diagnostics, validateInterchange(interchange)...)
+	edix12inspect.Finalize(&result, req.Diagnostics)
+	return result
+}
+
+func separatorsFor(delimiters Delimiters, advised bool) edix12inspect.X12Separators {
+	source := edix12inspect.SeparatorSourceFallback
+	if advised {
+		source = edix12inspect.SeparatorSourceISA
+	}
+	return edix12inspect.X12Separators{
+		Element:    delimiters.Element,
+		Segment:    delimiters.Segment,
+		Component:  delimiters.Component,
+		Repetition: delimiters.Repetition,
+		Source:     source,
+	}
+}
+
+func inspectionSegments(interchange *Interchange) []edix12inspect.X12Segment {
+	messageBySegment := make(map[int]int, len(interchange.Segments))
+	for index := range interchange.Messages {
+		message := &interchange.Messages[index]
+		for segmentIndex := message.StartSegment; segmentIndex <= message.EndSegment; segmentIndex++ {
+			messageBySegment[segmentIndex] = message.Index
+		}
+	}
+
+	segments := make([]edix12inspect.X12Segment, 0, len(interchange.Segments))
+	for index := range interchange.Segments {
+		segment := &interchange.Segments[index]
+		metadata, known := edifactDictionary[segment.Tag]
+		inspected := edix12inspect.X12Segment{
+			Index:             segment.Index,
+			TransactionIndex:  messageBySegment[segment.Index],
+			SegmentID:         segment.Tag,
+			Name:              stringutils.FirstNonEmpty(metadata.Name, segment.Tag),
+			Type:              stringutils.FirstNonEmpty(metadata.Type, "detail"),
+			Raw:               segment.Raw,
+			RawWithTerminator: segment.Raw + interchange.Delimiters.Segment,
+			StartOffset:       segment.StartOffset,
+			EndOffset:         segment.EndOffset,
+			Elements:          make([]edix12inspect.X12Element, 0, len(segment.Elements)),
+		}
+		for elementIndex, components := range segment.Elements {
+			position := elementIndex + 1
+			label := metadata.Elements[position]
+			element := edix12inspect.X12Element{
+				Position: position,
+				Label: stringutils.FirstNonEmpty(
+					label,
+					fmt.Sprintf("%s%02d", segment.Tag, position),
+				),
+				Value:      strings.Join(components, interchange.Delimiters.Component),
+				Known:      known && label != "",
+				Components: make([]edix12inspect.X12Component, 0, len(components)),
+			}
+			element.Empty = strings.Trim(element.Value, interchange.Delimiters.Component) == ""
+			for componentIndex, component := range components {
+				element.Components = append(element.Components, edix12inspect.X12Component{
+					Position: componentIndex + 1,
+					Value:    component,
+					Empty:    component == "",
+				})
+			}
+			inspected.Elements = append(inspected.Elements, element)
+		}
+		segments = append(segments, inspected)
+	}
+	return segments
+}
+
+func inspectionTransactions(interchange *Interchange) []edix12inspect.X12Transaction {
+	transactions := make([]edix12inspect.X12Transaction, 0, len(interchange.Messages))
+	for index := range interchange.Messages {
+		message := &interchange.Messages[index]
+		transaction := edix12inspect.X12Transaction{
+			Index:             message.Index,
+			TransactionSet:    message.Type,
+			STControlNumber:   message.Reference,
+			SEControlNumber:   message.TrailerRef,
+			ExpectedSegments:  parseCount(message.TrailerCount),
+			ActualSegments:    len(message.Segments),
+			StartSegmentIndex: message.StartSegment,
+		}
+		if !message.MissingTrailer {
+			transaction.EndSegmentIndex = message.EndSegment
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions
+}
+
+//nolint:funlen // Envelope checks are listed in interchange order for readability.
+func validateInterchange(interchange *Interchange) []edix12inspect.NormalizedDiagnostic {
+	diagnostics := make([]edix12inspect.NormalizedDiagnostic, 0)
+	add := func(code, segmentID string, segmentIndex int, message, fix string) {
+		diagnostics = append(diagnostics, edix12inspect.NormalizedDiagnostic{
+			Severity:     edi.ValidationSeverityError,
+			Code:         code,
+			Source:       edix12inspect.DiagnosticSourceValidation,
+			SegmentID:    segmentID,
+			SegmentIndex: segmentIndex,
+			Message:      message,
+			SuggestedFix: fix,
+		})
+	}
+
+	if interchange.Unterminated {
+		last := interchange.Segments[len(interchange.Segments)-1]
+		add(
+			"edifact.segment.unterminated",
+			last.Tag,
+			last.Index,
+			"The final segment is not followed by the segment terminator.",
+			"Terminate every segment, including UNZ, with the UNA segment terminator.",
+		)
+	}
+	if interchange.ControlReference() == "" {
+		add(
+			"edifact.unb.control_reference_missing",
+			SegmentUNB,
+			interchange.Header.Index,
+			"UNB does not carry an interchange control reference.",
+			"Populate UNB element 0020 with the interchange control reference.",
+		)
+	}
+	if len(interchange.Messages) == 0 {
+		add(
+			"edifact.message.missing",
+			SegmentUNB,
+			interchange.Header.Index,
+			"The interchange does not contain any UNH messages.",
+			"Add at least one UNH..UNT message to the interchange.",
+		)
+	}
+	for index := range interchange.Messages {
+		message := &interchange.Messages[index]
+		if message.MissingTrailer {
+			add(
+				"edifact.unt.missing",
+				SegmentUNH,
+				message.StartSegment,
+				fmt.Sprintf("Message %s is not closed by a UNT trailer.", message.Reference),
+				"Close every UNH message with a UNT trailer.",
+			)
+			continue
+		}
+		if parseCount(message.TrailerCount) != len(message.Segments) {
+			add(
+				"edifact.unt.count_mismatch",
+				SegmentUNT,
+				message.EndSegment,
+				fmt.Sprintf(
+					"UNT declares %s segments but message %s contains %d.",
+					stringutils.FirstNonEmpty(message.TrailerCount, "no"),
+					message.Reference,
+					len(message.Segments),
+				),
+				"Set UNT element 0074 to the segment count from UNH through UNT inclusive.",
+			)
+		}
+		if message.TrailerRef != message.Reference {
+			add(
+				"edifact.unt.reference_mismatch",
+				SegmentUNT,
+				message.EndSegment,
+				fmt.Sprintf(
+					"UNT reference %q does not match UNH reference %q.",
+					message.TrailerRef,
+					message.Reference,
+				),
+				"Repeat the UNH message reference number in UNT.",
+			)
+		}
+	}
+	if interchange.Trailer == nil {
+		add(
+			"edifact.unz.missing",
+			SegmentUNB,
+			interchange.Header.Index,
+			"The interchange is not closed by a UNZ trailer.",
+			"Close the interchange with UNZ.",
+		)
+		return diagnostics
+	}
+	if parseCount(interchange.Trailer.Value(1, 1)) != len(interchange.Messages) {
+		add(
+			"edifact.unz.count_mismatch",
+			SegmentUNZ,
+			interchange.Trailer.Index,
+			fmt.Sprintf(
+				"UNZ declares %s messages but the interchange contains %d.",
+				stringutils.FirstNonEmpty(interchange.Trailer.Value(1, 1), "no"),
+				len(interchange.Messages),
+			),
+			"Set UNZ element 0036 to the number of messages in the interchange.",
+		)
+	}
+	if interchange.Trailer.Value(2, 1) != interchange.ControlReference() {
+		add(
+			"edifact.unz.reference_mismatch",
+			SegmentUNZ,
+			interchange.Trailer.Index,
+			"UNZ control reference does not match UNB.",
+			"Repeat the UNB interchange control reference in UNZ.",
+		)
+	}
+	return diagnostics
+}
+
+func parseCount(value string) int {
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0
+	}
+	return parsed
+}
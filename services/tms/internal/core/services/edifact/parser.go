@@ -0,0 +1,337 @@
+package edifact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrEmptyInterchange = errors.New("edifact: interchange is empty")
+	ErrMissingUNB       = errors.New("edifact: interchange does not start with a UNB header")
+	ErrMissingMessages  = errors.New("edifact: interchange does not contain any UNH messages")
+)
+
+// Segment is one parsed EDIFACT segment. Elements exclude the tag and hold
+// the unescaped component values of each data element.
+type Segment struct {
+	Index       int        `json:"index"`
+	Tag         string     `json:"tag"`
+	Elements    [][]string `json:"elements"`
+	Raw         string     `json:"raw"`
+	StartOffset int        `json:"startOffset"`
+	EndOffset   int        `json:"endOffset"`
+}
+
+// Value returns the component of the 1-based data element, or "" when the
+// segment does not carry it.
+func (s *Segment) Value(element, component int) string {
+	if s == nil || element <= 0 || element > len(s.Elements) {
+		return ""
+	}
+	components := s.Elements[element-1]
+	if component <= 0 || component > len(components) {
+		return ""
+	}
+	return components[component-1]
+}
+
+// Message is a UNH..UNT envelope inside an interchange.
+type Message struct {
+	Index          int       `json:"index"`
+	Reference      string    `json:"reference"`
+	Type           string    `json:"type"`
+	Version        string    `json:"version"`
+	Release        string    `json:"release"`
+	Agency         string    `json:"agency"`
+	TrailerCount   string    `json:"trailerCount,omitempty"`
+	TrailerRef     string    `json:"trailerReference,omitempty"`
+	Segments       []Segment `json:"segments"`
+	StartSegment   int       `json:"startSegment"`
+	EndSegment     int       `json:"endSegment"`
+	MissingTrailer bool      `json:"missingTrailer"`
+}
+
+// DirectoryVersion joins the UNH version and release, e.g. "D96A".
+func (m *Message) DirectoryVersion() string {
+	return m.Version + m.Release
+}
+
+// FindSegment returns the first segment with tag after the UNH header.
+func (m *Message) FindSegment(tag string) *Segment {
+	for index := range m.Segments {
+		if m.Segments[index].Tag == tag {
+			return &m.Segments[index]
+		}
+	}
+	return nil
+}
+
+// FindSegments returns every segment with tag, in document order.
+func (m *Message) FindSegments(tag string) []Segment {
+	result := make([]Segment, 0, 4)
+	for index := range m.Segments {
+		if m.Segments[index].Tag == tag {
+			result = append(result, m.Segments[index])
+		}
+	}
+	return result
+}
+
+// Raw reassembles the message using the interchange delimiters.
+func (m *Message) Raw(delimiters Delimiters) string {
+	var builder strings.Builder
+	for index := range m.Segments {
+		builder.WriteString(m.Segments[index].Raw)
+		builder.WriteString(delimiters.Segment)
+	}
+	return builder.String()
+}
+
+type Interchange struct {
+	Delimiters          Delimiters `json:"delimiters"`
+	ServiceStringAdvice bool       `json:"serviceStringAdvice"`
+	Segments            []Segment  `json:"segments"`
+	Messages            []Message  `json:"messages"`
+	Header              *Segment   `json:"header,omitempty"`
+	Trailer             *Segment   `json:"trailer,omitempty"`
+	Unterminated        bool       `json:"unterminated"`
+}
+
+func (i *Interchange) SyntaxIdentifier() string {
+	return i.Header.Value(1, 1)
+}
+
+func (i *Interchange) SyntaxVersion() string {
+	return i.Header.Value(1, 2)
+}
+
+func (i *Interchange) SenderID() string {
+	return i.Header.Value(2, 1)
+}
+
+func (i *Interchange) SenderQualifier() string {
+	return i.Header.Value(2, 2)
+}
+
+func (i *Interchange) RecipientID() string {
+	return i.Header.Value(3, 1)
+}
+
+func (i *Interchange) RecipientQualifier() string {
+	return i.Header.Value(3, 2)
+}
+
+func (i *Interchange) ControlReference() string {
+	return i.Header.Value(5, 1)
+}
+
+// Parse tokenizes raw into segments and groups them into UNH..UNT messages.
+// Structural problems short of a missing UNB are left for Inspect to report.
+func Parse(raw string) (*Interchange, error) {
+	trimmed := strings.TrimLeft(raw, "\ufeff \t\r\n")
+	if strings.TrimSpace(trimmed) == "" {
+		return nil, ErrEmptyInterchange
+	}
+	offset := len(raw) - len(trimmed)
+	interchange := &Interchange{Delimiters: DefaultDelimiters()}
+	if strings.HasPrefix(trimmed, serviceStringAdviceTag) {
+		if len(trimmed) < serviceStringAdviceLength {
+			return nil, errors.New("edifact: UNA service string advice is truncated")
+		}
+		interchange.Delimiters = Delimiters{
+			Component:  trimmed[3:4],
+			Element:    trimmed[4:5],
+			Decimal:    trimmed[5:6],
+			Release:    trimmed[6:7],
+			Repetition: trimmed[7:8],
+			Segment:    trimmed[8:9],
+		}
+		interchange.ServiceStringAdvice = true
+		trimmed = trimmed[serviceStringAdviceLength:]
+		offset += serviceStringAdviceLength
+	}
+
+	interchange.Segments, interchange.Unterminated = tokenize(
+		trimmed,
+		offset,
+		interchange.Delimiters,
+	)
+	if len(interchange.Segments) == 0 || interchange.Segments[0].Tag != SegmentUNB {
+		return nil, ErrMissingUNB
+	}
+	interchange.Header = &interchange.Segments[0]
+	interchange.Messages = groupMessages(interchange.Segments)
+	for index := range interchange.Segments {
+		if interchange.Segments[index].Tag == SegmentUNZ {
+			interchange.Trailer = &interchange.Segments[index]
+		}
+	}
+	return interchange, nil
+}
+
+// ParseMessages parses raw and fails when it carries no messages, which is
+// the contract inbound processing needs.
+func ParseMessages(raw string) (*Interchange, error) {
+	interchange, err := Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(interchange.Messages) == 0 {
+		return nil, ErrMissingMessages
+	}
+	for index := range interchange.Messages {
+		message := &interchange.Messages[index]
+		if message.Type == "" {
+			return nil, fmt.Errorf(
+				"edifact: message %d does not declare a message type in UNH",
+				message.Index,
+			)
+		}
+	}
+	return interchange, nil
+}
+
+func tokenize(raw string, baseOffset int, delimiters Delimiters) ([]Segment, bool) {
+	segments := make([]Segment, 0, strings.Count(raw, delimiters.Segment)+1)
+	start := 0
+	for start < len(raw) {
+		end := indexUnescaped(raw, start, delimiters.Segment, delimiters.Release)
+		terminated := end >= 0
+		if !terminated {
+			end = len(raw)
+		}
+		body := raw[start:end]
+		trimmedBody := strings.TrimLeft(body, " \t\r\n")
+		bodyOffset := baseOffset + start + len(body) - len(trimmedBody)
+		hasBody := strings.TrimSpace(trimmedBody) != ""
+		if hasBody {
+			segments = append(segments, parseSegment(
+				len(segments),
+				trimmedBody,
+				bodyOffset,
+				delimiters,
+			))
+		}
+		if !terminated {
+			return segments, hasBody
+		}
+		start = end + len(delimiters.Segment)
+	}
+	return segments, false
+}
+
+func parseSegment(index int, body string, offset int, delimiters Delimiters) Segment {
+	elements := splitUnescaped(body, delimiters.Element, delimiters.Release)
+	segment := Segment{
+		Index:       index,
+		Tag:         strings.TrimSpace(elements[0]),
+		Raw:         body,
+		StartOffset: offset,
+		EndOffset:   offset + len(body),
+		Elements:    make([][]string, 0, len(elements)-1),
+	}
+	for _, element := range elements[1:] {
+		components := splitUnescaped(element, delimiters.Component, delimiters.Release)
+		for componentIndex, component := range components {
+			components[componentIndex] = unescape(component, delimiters.Release)
+		}
+		segment.Elements = append(segment.Elements, components)
+	}
+	return segment
+}
+
+func groupMessages(segments []Segment) []Message {
+	messages := make([]Message, 0, 1)
+	var current *Message
+	for index := range segments {
+		segment := &segments[index]
+		switch segment.Tag {
+		case SegmentUNH:
+			if current != nil {
+				current.MissingTrailer = true
+				current.EndSegment = index - 1
+				messages = append(messages, *current)
+			}
+			current = &Message{
+				Index:        len(messages) + 1,
+				Reference:    segment.Value(1, 1),
+				Type:         segment.Value(2, 1),
+				Version:      segment.Value(2, 2),
+				Release:      segment.Value(2, 3),
+				Agency:       segment.Value(2, 4),
+				StartSegment: index,
+				Segments:     []Segment{*segment},
+			}
+		case SegmentUNT:
+			if current == nil {
+				continue
+			}
+			current.Segments = append(current.Segments, *segment)
+			current.TrailerCount = segment.Value(1, 1)
+			current.TrailerRef = segment.Value(2, 1)
+			current.EndSegment = index
+			messages = append(messages, *current)
+			current = nil
+		case SegmentUNZ:
+			if current != nil {
+				current.MissingTrailer = true
+				current.EndSegment = index - 1
+				messages = append(messages, *current)
+				current = nil
+			}
+		default:
+			if current != nil {
+				current.Segments = append(current.Segments, *segment)
+			}
+		}
+	}
+	if current != nil {
+		current.MissingTrailer = true
+		current.EndSegment = len(segments) - 1
+		messages = append(messages, *current)
+	}
+	return messages
+}
+
+func indexUnescaped(value string, start int, separator, release string) int {
+	for index := start; index < len(value); index++ {
+		if release != "" && strings.HasPrefix(value[index:], release) {
+			index += len(release)
+			continue
+		}
+		if strings.HasPrefix(value[index:], separator) {
+			return index
+		}
+	}
+	return -1
+}
+
+func splitUnescaped(value, separator, release string) []string {
+	parts := make([]string, 0, 4)
+	start := 0
+	for {
+		index := indexUnescaped(value, start, separator, release)
+		if index < 0 {
+			parts = append(parts, value[start:])
+			return parts
+		}
+		parts = append(parts, value[start:index])
+		start = index + len(separator)
+	}
+}
+
+func unescape(value, release string) string {
+	if release == "" || !strings.Contains(value, release) {
+		return value
+	}
+	var builder strings.Builder
+	builder.Grow(len(value))
+	for index := 0; index < len(value); index++ {
+		if strings.HasPrefix(value[index:], release) && index+len(release) < len(value) {
+			index += len(release)
+		}
+		builder.WriteByte(value[index])
+	}
+	return builder.String()
+}
@@ -0,0 +1,142 @@
+package edifact
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleIFTSTA = "UNA:+.? '" +
+	"UNB+UNOC:3+SENDER:ZZ+RECEIVER:ZZ+261016:1200+000000042'" +
+	"UNH+0001+IFTSTA:D:96A:UN'" +
+	"BGM+77+SHIP?+1+9'" +
+	"STS+1+X1'" +
+	"UNT+4+0001'" +
+	"UNZ+1+000000042'"
+
+func TestParse_ReadsServiceStringAdviceAndEnvelope(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := Parse(sampleIFTSTA)
+
+	require.NoError(t, err)
+	assert.True(t, interchange.ServiceStringAdvice)
+	assert.Equal(t, "UNOC", interchange.SyntaxIdentifier())
+	assert.Equal(t, "3", interchange.SyntaxVersion())
+	assert.Equal(t, "SENDER", interchange.SenderID())
+	assert.Equal(t, "ZZ", interchange.RecipientQualifier())
+	assert.Equal(t, "000000042", interchange.ControlReference())
+	require.Len(t, interchange.Messages, 1)
+
+	message := interchange.Messages[0]
+	assert.Equal(t, "IFTSTA", message.Type)
+	assert.Equal(t, "D96A", message.DirectoryVersion())
+	assert.Equal(t, "4", message.TrailerCount)
+	assert.Len(t, message.Segments, 4)
+	assert.False(t, message.MissingTrailer)
+	assert.False(t, interchange.Unterminated)
+}
+
+func TestParse_UnescapesReleaseCharacter(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := Parse(sampleIFTSTA)
+
+	require.NoError(t, err)
+	bgm := interchange.Messages[0].FindSegment("BGM")
+	require.NotNil(t, bgm)
+	assert.Equal(t, "SHIP+1", bgm.Value(2, 1))
+	assert.Equal(t, "9", bgm.Value(3, 1))
+}
+
+func TestParse_UsesDefaultDelimitersWithoutUNA(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := Parse("UNB+UNOC:3+A+B+261016:1200+7'UNH+1+INVOIC:D:96A:UN'UNT+2+1'UNZ+1+7'")
+
+	require.NoError(t, err)
+	assert.False(t, interchange.ServiceStringAdvice)
+	assert.Equal(t, DefaultDelimiters(), interchange.Delimiters)
+	require.Len(t, interchange.Messages, 1)
+	assert.Equal(t, "INVOIC", interchange.Messages[0].Type)
+}
+
+func TestParse_RejectsMissingUNB(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse("UNH+1+IFTSTA:D:96A:UN'")
+
+	require.ErrorIs(t, err, ErrMissingUNB)
+}
+
+func TestParseMessages_RequiresMessages(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseMessages("UNB+UNOC:3+A+B+261016:1200+7'UNZ+0+7'")
+
+	require.ErrorIs(t, err, ErrMissingMessages)
+}
+
+func TestParse_FlagsMissingTrailers(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := Parse("UNB+UNOC:3+A+B+261016:1200+7'UNH+1+IFTSTA:D:96A:UN'BGM+77")
+
+	require.NoError(t, err)
+	assert.True(t, interchange.Unterminated)
+	assert.Nil(t, interchange.Trailer)
+	require.Len(t, interchange.Messages, 1)
+	assert.True(t, interchange.Messages[0].MissingTrailer)
+}
+
+func TestIsEDIFACT(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsEDIFACT(sampleIFTSTA))
+	assert.True(t, IsEDIFACT("\ufeff  UNB+UNOC:3'"))
+	assert.False(t, IsEDIFACT("ISA*00*"))
+}
+
+func TestDelimiters_EscapeReleasesServiceCharacters(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "A?+B?:C?'D??", DefaultDelimiters().Escape("A+B:C'D?"))
+	assert.Equal(t, "one two", DefaultDelimiters().Escape(" one\ntwo "))
+}
+
+func TestParse_ReadsRepetitionSeparatorFromUNA(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := Parse(
+		"UNA:+.?*'UNB+UNOC:4+A+B+261016:1200+7'UNH+1+INVOIC:D:96A:UN'UNT+2+1'UNZ+1+7'",
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "*", interchange.Delimiters.Repetition)
+	assert.Equal(t, "'", interchange.Delimiters.Segment)
+	assert.Equal(t, "4", interchange.Delimiters.SyntaxVersion())
+}
+
+func TestDelimitersFromEnvelope_ReadsRepetitionSeparator(t *testing.T) {
+	t.Parallel()
+
+	envelope := edi.DefaultEDIFACTEnvelopeSettings()
+	delimiters := DelimitersFromEnvelope(&envelope)
+	assert.Equal(t, "UNA:+.? '", delimiters.ServiceStringAdvice())
+	assert.Equal(t, "3", delimiters.SyntaxVersion())
+
+	envelope.RepetitionSeparator = "*"
+	delimiters = DelimitersFromEnvelope(&envelope)
+	assert.Equal(t, "*", delimiters.Repetition)
+	assert.Equal(t, "UNA:+.?*'", delimiters.ServiceStringAdvice())
+	assert.Equal(t, "A?*B", delimiters.Escape("A*B"))
+
+	envelope.RepetitionSeparator = " "
+	delimiters = DelimitersFromEnvelope(&envelope)
+	assert.Equal(t, "UNA:+.? '", delimiters.ServiceStringAdvice())
+	assert.Equal(t, "3", delimiters.SyntaxVersion())
+	assert.Equal(t, "A B", delimiters.Escape("A B"))
+}
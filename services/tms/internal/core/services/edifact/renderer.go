@@ -0,0 +1,143 @@
+package edifact
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edix12"
+	"github.com/emoss08/trenova/shared/stringutils"
+)
+
+const (
+	defaultSyntaxIdentifier = "UNOC"
+	defaultAgency           = "UN"
+	defaultPartyQualifier   = "ZZ"
+)
+
+// Render resolves the template through the shared renderer and emits an
+// EDIFACT interchange: a UNA advice, release-character escaping, composite
+// elements, and UNT/UNZ counts filled from the rendered segments.
+func Render(input *edix12.RenderInput) (*edix12.RenderResult, error) {
+	delimiters := DelimitersFromEnvelope(&input.Profile.Envelope)
+	return edix12.RenderWithSyntax(input, &edix12.Syntax{
+		ElementSeparator:   delimiters.Element,
+		ComponentSeparator: delimiters.Component,
+		SegmentTerminator:  delimiters.Segment,
+		Prefix:             delimiters.ServiceStringAdvice(),
+		EscapeElement: func(_ string, _ int, value string) string {
+			return delimiters.Escape(value)
+		},
+		ApplyTrailers: applyTrailerCounts,
+	})
+}
+
+// RuntimeValues mirrors edix12.RuntimeValues for the UNB/UNH envelope. The
+// directory version ("D96A") is split into the UNH version and release, and
+// the UNB date follows the syntax version: YYMMDD under 3, CCYYMMDD under 4.
+func RuntimeValues(profile *edi.EDIPartnerDocumentProfile, directory string) map[string]any {
+	now := time.Now().UTC()
+	envelope := profile.Envelope
+	version, release := SplitDirectory(directory)
+	syntaxVersion := DelimitersFromEnvelope(&envelope).SyntaxVersion()
+	dateLayout := "060102"
+	if syntaxVersion == "4" {
+		dateLayout = "20060102"
+	}
+	testIndicator := ""
+	if stringutils.FirstNonEmpty(envelope.InterchangeUsageIndicator, "T") == "T" {
+		testIndicator = "1"
+	}
+	return map[string]any{
+		"syntaxIdentifier": defaultSyntaxIdentifier,
+		"syntaxVersion":    syntaxVersion,
+		"interchangeSenderQualifier": stringutils.FirstNonEmpty(
+			envelope.InterchangeSenderQualifier,
+			defaultPartyQualifier,
+		),
+		"interchangeReceiverQualifier": stringutils.FirstNonEmpty(
+			envelope.InterchangeReceiverQualifier,
+			defaultPartyQualifier,
+		),
+		"interchangeSenderId":   envelope.InterchangeSenderID,
+		"interchangeReceiverId": envelope.InterchangeReceiverID,
+		"applicationReference": stringutils.FirstNonEmpty(
+			envelope.ApplicationReceiverCode,
+			string(profile.TransactionSet),
+		),
+		"testIndicator":     testIndicator,
+		"usageIndicator":    stringutils.FirstNonEmpty(envelope.InterchangeUsageIndicator, "T"),
+		"messageType":       string(profile.TransactionSet),
+		"messageVersion":    version,
+		"messageRelease":    release,
+		"controllingAgency": defaultAgency,
+		"x12Version":        directory,
+		"interchangeDate":   now.Format(dateLayout),
+		"interchangeTime":   now.Format("1504"),
+		"documentDateTime":  now.Format("200601021504"),
+	}
+}
+
+// SplitDirectory splits a directory such as "D96A" into the UNH message
+// version ("D") and release ("96A").
+func SplitDirectory(directory string) (version, release string) {
+	directory = stringutils.FirstNonEmpty(directory, edi.DefaultEDIFACTVersion)
+	if len(directory) < 2 {
+		return directory, ""
+	}
+	return directory[:1], directory[1:]
+}
+
+func applyTrailerCounts(segments [][]string) {
+	headerIndex := -1
+	messageReference := ""
+	interchangeReference := ""
+	messageCount := 0
+	for index, parts := range segments {
+		if len(parts) == 0 {
+			continue
+		}
+		switch parts[0] {
+		case SegmentUNB:
+			interchangeReference = elementAt(parts, 5)
+		case SegmentUNH:
+			headerIndex = index
+			messageCount++
+			messageReference = elementAt(parts, 1)
+		case SegmentUNT:
+			count := 0
+			if headerIndex >= 0 {
+				count = index - headerIndex + 1
+			}
+			parts = setElement(parts, 1, strconv.Itoa(count))
+			parts = setElementIfNotEmpty(parts, 2, messageReference)
+			segments[index] = parts
+		case SegmentUNZ:
+			parts = setElement(parts, 1, strconv.Itoa(messageCount))
+			parts = setElementIfNotEmpty(parts, 2, interchangeReference)
+			segments[index] = parts
+		}
+	}
+}
+
+func elementAt(parts []string, index int) string {
+	if index < len(parts) {
+		return parts[index]
+	}
+	return ""
+}
+
+func setElement(parts []string, index int, value string) []string {
+	for len(parts) <= index {
+		parts = append(parts, "")
+	}
+	parts[index] = value
+	return parts
+}
+
+func setElementIfNotEmpty(parts []string, index int, value string) []string {
+	if value == "" {
+		return parts
+	}
+	return setElement(parts, index, value)
+}
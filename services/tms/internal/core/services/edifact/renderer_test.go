@@ -0,0 +1,150 @@
+package edifact
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	editemplates "github.com/emoss08/trenova/internal/core/domain/edi/templates"
+	"github.com/emoss08/trenova/internal/core/services/edix12"
+	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func renderIFTSTA(t *testing.T, status *edi.ShipmentStatusPayload) string {
+	t.Helper()
+
+	profile := &edi.EDIPartnerDocumentProfile{
+		Standard:       edi.EDIStandardEDIFACT,
+		TransactionSet: edi.TransactionSetIFTSTA,
+		Direction:      edi.DocumentDirectionOutbound,
+		ValidationMode: edi.ValidationModeWarnOnly,
+		Envelope:       edi.DefaultEDIFACTEnvelopeSettings(),
+	}
+	profile.Envelope.InterchangeSenderID = "SENDER"
+	profile.Envelope.InterchangeReceiverID = "RECEIVER"
+	versionID := pulid.MustNew("editv_")
+	version := &edi.EDITemplateVersion{
+		ID:         versionID,
+		X12Version: edi.DefaultEDIFACTVersion,
+		Segments:   editemplates.IFTSTASegments(pagination.TenantInfo{}, versionID),
+	}
+	runtime := RuntimeValues(profile, edi.DefaultEDIFACTVersion)
+	runtime["isaControlNumber"] = "000000042"
+	runtime["transactionControlNumber"] = "0042"
+
+	result, err := Render(&edix12.RenderInput{
+		Context:         t.Context(),
+		Profile:         profile,
+		TemplateVersion: version,
+		DocumentPayload: edi.DocumentPayload{
+			TransactionSet: edi.TransactionSet214,
+			ShipmentStatus: status,
+		},
+		X12Version: edi.DefaultEDIFACTVersion,
+		Runtime:    runtime,
+	})
+	require.NoError(t, err)
+	return result.RawX12
+}
+
+func TestRender_IFTSTAEmitsEnvelopeCompositesAndCounts(t *testing.T) {
+	t.Parallel()
+
+	raw := renderIFTSTA(t, &edi.ShipmentStatusPayload{
+		ShipmentID: pulid.ID("SHIP-1"),
+		BOL:        "BOL+7",
+		StatusCode: "X1",
+		City:       "Chicago",
+		StateCode:  "IL",
+	})
+
+	assert.True(t, strings.HasPrefix(raw, "UNA:+.? '"))
+	assert.Contains(t, raw, "UNB+UNOC:3+SENDER:ZZ+RECEIVER:ZZ+")
+	assert.Contains(t, raw, "+000000042")
+	assert.Contains(t, raw, "UNH+0042+IFTSTA:D:96A:UN'")
+	assert.Contains(t, raw, "RFF+BM:BOL?+7'")
+	assert.Contains(t, raw, "STS+1+X1'")
+	assert.Contains(t, raw, "LOC+175+Chicago:::IL'")
+	assert.Contains(t, raw, "UNZ+1+000000042'")
+
+	interchange, err := ParseMessages(raw)
+	require.NoError(t, err)
+	require.Len(t, interchange.Messages, 1)
+	message := interchange.Messages[0]
+	assert.Equal(t, "0042", message.TrailerRef)
+	assert.Equal(t, len(message.Segments), parseCount(message.TrailerCount))
+	assert.Equal(t, "BOL+7", message.FindSegment("RFF").Value(1, 2))
+}
+
+func TestRuntimeValues_InterchangeDateFollowsSyntaxVersion(t *testing.T) {
+	t.Parallel()
+
+	profile := &edi.EDIPartnerDocumentProfile{
+		Standard:       edi.EDIStandardEDIFACT,
+		TransactionSet: edi.TransactionSetIFTSTA,
+		Envelope:       edi.DefaultEDIFACTEnvelopeSettings(),
+	}
+	runtime := RuntimeValues(profile, edi.DefaultEDIFACTVersion)
+	assert.Equal(t, "3", runtime["syntaxVersion"])
+	assert.Len(t, runtime["interchangeDate"], 6)
+
+	profile.Envelope.RepetitionSeparator = "*"
+	runtime = RuntimeValues(profile, edi.DefaultEDIFACTVersion)
+	assert.Equal(t, "4", runtime["syntaxVersion"])
+	assert.Len(t, runtime["interchangeDate"], 8)
+}
+
+func TestInspect_RenderedInterchangeHasNoDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	raw := renderIFTSTA(t, &edi.ShipmentStatusPayload{
+		ShipmentID: pulid.ID("SHIP-1"),
+		StatusCode: "X1",
+	})
+
+	result := Inspect(&edix12inspect.InspectX12Request{RawX12: raw})
+
+	assert.Empty(t, result.Diagnostics)
+	require.Len(t, result.Transactions, 1)
+	assert.Equal(t, "IFTSTA", result.Transactions[0].TransactionSet)
+	assert.Equal(t, "000000042", result.Envelope.ISAControlNumber)
+	assert.Equal(t, edix12inspect.SeparatorSourceISA, result.Separators.Source)
+	assert.NotEmpty(t, result.Formatted)
+}
+
+func TestInspect_ReportsTrailerMismatches(t *testing.T) {
+	t.Parallel()
+
+	result := Inspect(&edix12inspect.InspectX12Request{
+		RawX12: "UNB+UNOC:3+A+B+261016:1200+7'" +
+			"UNH+1+IFTSTA:D:96A:UN'BGM+77'UNT+9+2'" +
+			"UNZ+3+8'",
+	})
+
+	codes := make([]string, 0, len(result.Diagnostics))
+	for _, diagnostic := range result.Diagnostics {
+		codes = append(codes, diagnostic.Code)
+	}
+	assert.ElementsMatch(t, []string{
+		"edifact.unt.count_mismatch",
+		"edifact.unt.reference_mismatch",
+		"edifact.unz.count_mismatch",
+		"edifact.unz.reference_mismatch",
+	}, codes)
+}
+
+func TestSplitDirectory(t *testing.T) {
+	t.Parallel()
+
+	version, release := SplitDirectory("D01B")
+	assert.Equal(t, "D", version)
+	assert.Equal(t, "01B", release)
+
+	version, release = SplitDirectory("")
+	assert.Equal(t, "D", version)
+	assert.Equal(t, "96A", release)
+}
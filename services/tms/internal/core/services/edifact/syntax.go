@@ -0,0 +1,125 @@
+package edifact
+
+import (
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+)
+
+const (
+	serviceStringAdviceTag    = "UNA"
+	serviceStringAdviceLength = 9
+
+	// unusedRepetition fills UNA position 5 when no repetition separator is in
+	// use, as syntax versions before 4 require.
+	unusedRepetition = " "
+
+	SegmentUNB = "UNB"
+	SegmentUNH = "UNH"
+	SegmentUNT = "UNT"
+	SegmentUNZ = "UNZ"
+)
+
+// Delimiters are the six service characters announced by a UNA segment.
+// Repetition is UNA position 5: the repetition separator under syntax version
+// 4, and a reserved space under the versions before it.
+type Delimiters struct {
+	Component  string `json:"component"`
+	Element    string `json:"element"`
+	Decimal    string `json:"decimal"`
+	Release    string `json:"release"`
+	Repetition string `json:"repetition"`
+	Segment    string `json:"segment"`
+}
+
+func DefaultDelimiters() Delimiters {
+	return Delimiters{
+		Component:  ":",
+		Element:    "+",
+		Decimal:    ".",
+		Release:    "?",
+		Repetition: unusedRepetition,
+		Segment:    "'",
+	}
+}
+
+// DelimitersFromEnvelope reads the profile envelope, falling back to the
+// syntax defaults for any delimiter the envelope leaves blank.
+func DelimitersFromEnvelope(envelope *edi.X12EnvelopeSettings) Delimiters {
+	delimiters := DefaultDelimiters()
+	if envelope == nil {
+		return delimiters
+	}
+	delimiters.Component = firstCharacter(envelope.ComponentSeparator, delimiters.Component)
+	delimiters.Element = firstCharacter(envelope.ElementSeparator, delimiters.Element)
+	delimiters.Release = firstCharacter(envelope.ReleaseCharacter, delimiters.Release)
+	delimiters.Repetition = firstCharacter(envelope.RepetitionSeparator, delimiters.Repetition)
+	delimiters.Segment = firstCharacter(envelope.SegmentTerminator, delimiters.Segment)
+	return delimiters
+}
+
+// ServiceStringAdvice renders the UNA segment, including its own terminator.
+func (d Delimiters) ServiceStringAdvice() string {
+	return serviceStringAdviceTag +
+		d.Component +
+		d.Element +
+		d.Decimal +
+		d.Release +
+		d.Repetition +
+		d.Segment
+}
+
+// SyntaxVersion is the UNB syntax version the delimiters can be announced
+// under. A repetition separator only exists from version 4; without one the
+// interchange stays on version 3, which every partner reads.
+func (d Delimiters) SyntaxVersion() string {
+	if d.hasRepetition() {
+		return "4"
+	}
+	return "3"
+}
+
+func (d Delimiters) hasRepetition() bool {
+	return d.Repetition != "" && d.Repetition != unusedRepetition
+}
+
+// Escape prefixes every service character in value with the release
+// character so the value survives as a single data element.
+func (d Delimiters) Escape(value string) string {
+	value = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(value)
+	value = strings.TrimSpace(value)
+	if d.Release == "" {
+		return value
+	}
+	var builder strings.Builder
+	builder.Grow(len(value))
+	for _, char := range value {
+		if d.isServiceCharacter(string(char)) {
+			builder.WriteString(d.Release)
+		}
+		builder.WriteRune(char)
+	}
+	return builder.String()
+}
+
+func (d Delimiters) isServiceCharacter(char string) bool {
+	return char == d.Component ||
+		char == d.Element ||
+		char == d.Release ||
+		char == d.Segment ||
+		(d.hasRepetition() && char == d.Repetition)
+}
+
+func firstCharacter(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value[:1]
+}
+
+// IsEDIFACT reports whether raw starts with a UNA or UNB service segment.
+func IsEDIFACT(raw string) bool {
+	trimmed := strings.TrimLeft(raw, "\ufeff \t\r\n")
+	return strings.HasPrefix(trimmed, serviceStringAdviceTag) ||
+		strings.HasPrefix(trimmed, SegmentUNB)
+}
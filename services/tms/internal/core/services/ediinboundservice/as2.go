@@ -84,6 +84,8 @@ func (s *Service) receiveAS2Message(
 	}
 	if as2.IsMDNContentType(req.ContentType) {
 		if err := s.ediService.ApplyAS2MDN(ctx, &ediservice.ApplyAS2MDNRequest{
+			From:        req.From,
+			To:          req.To,
 			ContentType: req.ContentType,
 			Body:        req.Body,
 		}); err != nil {
@@ -182,7 +184,8 @@ func (s *Service) startInboundProcessing(
 				ID:        "edi-process-inbound-file-" + file.ID.String(),
 				TaskQueue: temporaltype.EDITaskQueue,
 				StaticSummary: fmt.Sprintf(
-					"Process inbound AS2 EDI file %s",
+					"Process inbound %s EDI file %s",
+					profile.Method,
 					file.ID.String(),
 				),
 			},
@@ -190,8 +193,9 @@ func (s *Service) startInboundProcessing(
 			request,
 		); err != nil {
 			s.l.Warn(
-				"failed to start inbound AS2 processing workflow; processing inline",
+				"failed to start inbound processing workflow; processing inline",
 				zap.String("fileId", file.ID.String()),
+				zap.String("method", string(profile.Method)),
 				zap.Error(err),
 			)
 		} else {
@@ -200,8 +204,9 @@ func (s *Service) startInboundProcessing(
 	}
 	if _, err := s.ProcessInboundFile(ctx, request); err != nil {
 		s.l.Error(
-			"failed to process inbound AS2 file",
+			"failed to process inbound file",
 			zap.String("fileId", file.ID.String()),
+			zap.String("method", string(profile.Method)),
 			zap.Error(err),
 		)
 	}
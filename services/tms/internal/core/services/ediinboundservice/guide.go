@@ -0,0 +1,113 @@
+package ediinboundservice
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+)
+
+// guideAcknowledgmentCodes maps implementation guide diagnostics to the
+// AK3/IK3 segment and AK4/IK4 element syntax error codes partners expect in a
+// rejecting 997 or 999.
+var guideAcknowledgmentCodes = map[string]string{
+	"x12.guide.segment_unexpected": "2",
+	"x12.guide.segment_missing":    "3",
+	"x12.guide.loop_missing":       "3",
+	"x12.guide.loop_max_repeat":    "4",
+	"x12.guide.segment_max_use":    "5",
+	"x12.guide.segment_not_used":   "I4",
+	"x12.guide.loop_not_used":      "I4",
+	"x12.guide.element_missing":    "1",
+	"x12.guide.syntax_rule":        "2",
+	"x12.guide.element_min_length": "4",
+	"x12.guide.element_max_length": "5",
+	"x12.guide.element_code":       "7",
+	"x12.guide.element_not_used":   "I10",
+}
+
+// validateImplementationGuide checks an inbound X12 transaction against the
+// partner's active inbound guide before it is routed. It returns nil when no
+// guide applies; otherwise the guide's diagnostics (possibly empty) for the
+// message, and guide errors are recorded as the transaction's rejections.
+func (s *Service) validateImplementationGuide(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	transaction *parsedTransaction,
+) ([]*edi.EDIMessageValidationError, error) {
+	if s.guideRepo == nil || transaction.jsonDocument ||
+		transaction.set.Standard() != edi.EDIStandardX12 {
+		return nil, nil
+	}
+	guide, err := s.guideRepo.GetActiveImplementationGuide(
+		ctx,
+		repositories.GetActiveEDIImplementationGuideRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: file.OrganizationID,
+				BuID:  file.BusinessUnitID,
+			},
+			PartnerID:      file.EDIPartnerID,
+			TransactionSet: transaction.set,
+			X12Version:     transaction.x12Version,
+			Direction:      edi.DocumentDirectionInbound,
+		},
+	)
+	if err != nil {
+		if errortypes.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	diagnostics := edix12inspect.ValidateImplementationGuide(
+		&guide.Definition,
+		transaction.segments,
+	)
+	messageDiagnostics := make([]*edi.EDIMessageValidationError, 0, len(diagnostics))
+	for index := range diagnostics {
+		diagnostic := &diagnostics[index]
+		messageDiagnostics = append(messageDiagnostics, &edi.EDIMessageValidationError{
+			Severity:        diagnostic.Severity,
+			Code:            diagnostic.Code,
+			SegmentID:       diagnostic.SegmentID,
+			ElementPosition: diagnostic.ElementPosition,
+			Path:            diagnostic.Path,
+			Message:         diagnostic.Message,
+			SuggestedFix:    diagnostic.SuggestedFix,
+		})
+		if diagnostic.Severity != edi.ValidationSeverityError {
+			continue
+		}
+		transaction.rejections = append(
+			transaction.rejections,
+			guideAcknowledgmentDiagnostic(transaction, diagnostic),
+		)
+	}
+	return messageDiagnostics, nil
+}
+
+// guideAcknowledgmentDiagnostic positions a guide error relative to the ST
+// segment, which X12 acknowledgments count as position 1.
+func guideAcknowledgmentDiagnostic(
+	transaction *parsedTransaction,
+	diagnostic *edix12inspect.NormalizedDiagnostic,
+) edi.AcknowledgmentDiagnostic {
+	position := int64(diagnostic.SegmentIndex)
+	if len(transaction.segments) > 0 {
+		position = int64(diagnostic.SegmentIndex-transaction.segments[0].Index) + 1
+	}
+	errorCode, ok := guideAcknowledgmentCodes[diagnostic.Code]
+	if !ok {
+		errorCode = "8"
+	}
+	return edi.AcknowledgmentDiagnostic{
+		SegmentID:       diagnostic.SegmentID,
+		SegmentPosition: position,
+		ElementPosition: int64(diagnostic.ElementPosition),
+		ErrorCode:       errorCode,
+		Message:         diagnostic.Message,
+	}
+}
@@ -0,0 +1,158 @@
+package ediinboundservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func remittanceGuide() *edi.EDIImplementationGuide {
+	return &edi.EDIImplementationGuide{
+		ID:             pulid.MustNew("ediig_"),
+		Direction:      edi.DocumentDirectionInbound,
+		TransactionSet: edi.TransactionSet820,
+		X12Version:     "004010",
+		IsActive:       true,
+		Definition: edi.ImplementationGuideDefinition{
+			Nodes: []edi.ImplementationGuideNode{
+				{
+					SegmentID: "BPR",
+					Usage:     edi.GuideUsageMandatory,
+					Elements: []edi.ImplementationGuideElement{
+						{Position: 1, Usage: edi.GuideUsageMandatory, Codes: []string{"I", "X"}},
+					},
+				},
+				{SegmentID: "TRN", Usage: edi.GuideUsageMandatory},
+				{SegmentID: "CUR", Usage: edi.GuideUsageOptional},
+				{
+					LoopID: "N1",
+					Usage:  edi.GuideUsageOptional,
+					MaxUse: 2,
+					Children: []edi.ImplementationGuideNode{
+						{SegmentID: "N1", Usage: edi.GuideUsageMandatory},
+					},
+				},
+				{
+					LoopID: "ENT",
+					Usage:  edi.GuideUsageMandatory,
+					Children: []edi.ImplementationGuideNode{
+						{SegmentID: "ENT", Usage: edi.GuideUsageMandatory},
+						{
+							LoopID: "RMR",
+							Usage:  edi.GuideUsageMandatory,
+							Children: []edi.ImplementationGuideNode{
+								{SegmentID: "RMR", Usage: edi.GuideUsageMandatory},
+								{SegmentID: "ADX", Usage: edi.GuideUsageOptional},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestProcessInboundFile_RejectsTransactionFailingImplementationGuide(t *testing.T) {
+	t.Parallel()
+
+	fixture := newInboundFixture(t, inbound820)
+	fixture.expectFileLoadAndUpdates(t)
+	fixture.expectPartnerLoad()
+	fixture.expectInboundDocumentType(edi.TransactionSet820)
+	fixture.expectNoInboundAckProfile(edi.TransactionSet820)
+
+	guideRepo := mocks.NewMockEDIImplementationGuideRepository(t)
+	guideRepo.EXPECT().
+		GetActiveImplementationGuide(mock.Anything, repositories.GetActiveEDIImplementationGuideRequest{
+			TenantInfo:     fixture.tenantInfo(),
+			PartnerID:      fixture.partner.ID,
+			TransactionSet: edi.TransactionSet820,
+			X12Version:     "004010",
+			Direction:      edi.DocumentDirectionInbound,
+		}).
+		Return(remittanceGuide(), nil).
+		Once()
+	fixture.service.guideRepo = guideRepo
+
+	var recorded repositories.CreateEDIMessageWithDiagnosticsRequest
+	fixture.messageRepo.EXPECT().
+		CreateMessageWithDiagnostics(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req repositories.CreateEDIMessageWithDiagnosticsRequest) (*edi.EDIMessage, error) {
+			recorded = req
+			message := *req.Message
+			message.ID = pulid.MustNew("edimsg_")
+			return &message, nil
+		}).
+		Once()
+
+	file, err := fixture.service.ProcessInboundFile(t.Context(), &ProcessInboundFileRequest{
+		FileID:     fixture.file.ID,
+		TenantInfo: fixture.tenantInfo(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, edi.InboundFileStatusQuarantined, file.Status)
+	assert.Contains(t, file.FailureReason, "failed implementation guide validation")
+	assert.Equal(t, "004010", recorded.Message.X12Version)
+	assert.Equal(t, edi.ValidationModeStrict, recorded.Message.ValidationMode)
+	guideErrors := make([]*edi.EDIMessageValidationError, 0, 1)
+	for _, diagnostic := range recorded.Diagnostics {
+		if diagnostic.Severity == edi.ValidationSeverityError {
+			guideErrors = append(guideErrors, diagnostic)
+		}
+	}
+	require.Len(t, guideErrors, 1)
+	assert.Equal(t, "x12.guide.element_code", guideErrors[0].Code)
+	assert.Equal(t, "ST[1]/BPR[1]/BPR01", guideErrors[0].Path)
+}
+
+func TestAcknowledgmentPayloadForTransaction_RejectsGuideErrors(t *testing.T) {
+	t.Parallel()
+
+	fixture := newInboundFixture(t, inbound820)
+	guideRepo := mocks.NewMockEDIImplementationGuideRepository(t)
+	guideRepo.EXPECT().
+		GetActiveImplementationGuide(mock.Anything, mock.Anything).
+		Return(remittanceGuide(), nil).
+		Once()
+	fixture.service.guideRepo = guideRepo
+
+	raw := "ISA*00*          *00*          *ZZ*PARTNER        *ZZ*TRENOVA        " +
+		"*261016*1200*^*00401*000000302*0*P*>~" +
+		"GS*RA*PARTNER*TRENOVA*20261016*1200*302*X*004010~" +
+		"ST*820*0002~" +
+		"BPR*I*100.00*C*ACH~" +
+		"CUR*PR*USD~" +
+		"ENT*1~" +
+		"RMR*IV*INV-1**100.00~" +
+		"SE*6*0002~" +
+		"GE*1*302~" +
+		"IEA*1*000000302~"
+	interchange, err := parseInterchange(raw)
+	require.NoError(t, err)
+	transaction := &interchange.transactions[0]
+
+	_, err = fixture.service.validateImplementationGuide(t.Context(), fixture.file, transaction)
+	require.NoError(t, err)
+	payload := acknowledgmentPayloadForTransaction(transaction, edi.TransactionSet999)
+
+	require.NotNil(t, payload.ImplementationAcknowledgment)
+	ack := payload.ImplementationAcknowledgment
+	assert.Equal(t, "R", ack.TransactionAcknowledgmentCode)
+	assert.Equal(t, "R", ack.GroupAcknowledgmentCode)
+	assert.Zero(t, ack.AcceptedTransactionSetCount)
+	require.Len(t, ack.Diagnostics, 1)
+	assert.Equal(t, edi.AcknowledgmentDiagnostic{
+		SegmentID:       "TRN",
+		SegmentPosition: 3,
+		ErrorCode:       "3",
+		Message:         ack.Diagnostics[0].Message,
+	}, ack.Diagnostics[0])
+}
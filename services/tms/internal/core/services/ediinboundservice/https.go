@@ -0,0 +1,136 @@
+package ediinboundservice
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/editransport"
+	"github.com/emoss08/trenova/internal/infrastructure/observability"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.uber.org/zap"
+)
+
+const httpsFileNameSuffix = ".json"
+
+var (
+	ErrHTTPSProfileNotFound = errortypes.NewAuthorizationError(
+		"No active HTTPS communication profile matches the request",
+	)
+	ErrHTTPSSignatureRejected = errortypes.NewAuthorizationError(
+		"HTTPS request signature is missing, expired, or invalid",
+	)
+	ErrHTTPSInboundNotConfigured = errortypes.NewAuthorizationError(
+		"HTTPS communication profile does not accept inbound documents without an HMAC secret",
+	)
+)
+
+type ReceiveHTTPSDocumentRequest struct {
+	ProfileID pulid.ID
+	Timestamp string
+	Signature string
+	Body      []byte
+}
+
+type ReceiveHTTPSDocumentResult struct {
+	FileID    pulid.ID `json:"fileId,omitempty"`
+	Duplicate bool     `json:"duplicate"`
+}
+
+func (s *Service) ReceiveHTTPSDocument(
+	ctx context.Context,
+	req *ReceiveHTTPSDocumentRequest,
+) (*ReceiveHTTPSDocumentResult, error) {
+	return observability.RunWithSpanReturn(
+		ctx,
+		"edi.receive_https_document",
+		func(ctx context.Context) (*ReceiveHTTPSDocumentResult, error) {
+			return s.receiveHTTPSDocument(ctx, req)
+		},
+	)
+}
+
+// receiveHTTPSDocument verifies and stages a partner's JSON document. Every
+// inbound request must be HMAC-signed with the profile's secret; OAuth2 only
+// covers the documents we send to the partner.
+func (s *Service) receiveHTTPSDocument(
+	ctx context.Context,
+	req *ReceiveHTTPSDocumentRequest,
+) (*ReceiveHTTPSDocumentResult, error) {
+	if req == nil || req.ProfileID.IsNil() {
+		return nil, ErrHTTPSProfileNotFound
+	}
+	profile, err := s.profileRepo.GetActiveHTTPSProfileByID(
+		ctx,
+		repositories.GetActiveHTTPSProfileByIDRequest{ID: req.ProfileID},
+	)
+	if err != nil {
+		if errortypes.IsNotFoundError(err) {
+			return nil, ErrHTTPSProfileNotFound
+		}
+		return nil, err
+	}
+	secrets, err := s.ediService.ProfileTransportSecrets(profile)
+	if err != nil {
+		return nil, err
+	}
+	secret := secrets[editransport.SecretKeyHTTPSHMACSecret]
+	if secret == "" {
+		return nil, ErrHTTPSInboundNotConfigured
+	}
+	if err = editransport.VerifyHTTPSSignature(
+		secret,
+		req.Timestamp,
+		req.Signature,
+		req.Body,
+		time.Now(),
+	); err != nil {
+		s.l.Warn(
+			"inbound HTTPS document signature rejected",
+			zap.String("profileId", profile.ID.String()),
+			zap.Error(err),
+		)
+		s.metrics.RecordInboundFile(
+			profile.EDIPartnerID.String(),
+			string(profile.Method),
+			"rejected",
+		)
+		return nil, ErrHTTPSSignatureRejected
+	}
+
+	var document editransport.HTTPSDocument
+	if err = json.Unmarshal(req.Body, &document); err != nil {
+		return nil, errortypes.NewValidationError(
+			"body",
+			errortypes.ErrInvalid,
+			"Request body must be a JSON document",
+		)
+	}
+	documentID := strings.TrimSpace(document.DocumentID)
+	if documentID == "" {
+		return nil, errortypes.NewValidationError(
+			"documentId",
+			errortypes.ErrRequired,
+			"Document ID is required",
+		)
+	}
+
+	staged, skipped, err := s.stageInboundFile(ctx, profile, &services.EDIInboundRemoteFile{
+		Path:     "https:" + documentID,
+		Name:     sanitizeAS2FileName(documentID) + httpsFileNameSuffix,
+		Contents: string(req.Body),
+		Size:     int64(len(req.Body)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if skipped {
+		return &ReceiveHTTPSDocumentResult{Duplicate: true}, nil
+	}
+	s.startInboundProcessing(ctx, profile, staged)
+	return &ReceiveHTTPSDocumentResult{FileID: staged.ID}, nil
+}
@@ -0,0 +1,149 @@
+package ediinboundservice
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/ediservice"
+	"github.com/emoss08/trenova/internal/core/services/editransport"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func httpsInboundProfile(secrets map[string]string) *edi.EDICommunicationProfile {
+	return &edi.EDICommunicationProfile{
+		ID:             pulid.MustNew("edicp_"),
+		OrganizationID: pulid.MustNew("org_"),
+		BusinessUnitID: pulid.MustNew("bu_"),
+		EDIPartnerID:   pulid.MustNew("edip_"),
+		Method:         edi.ConnectionMethodHTTPS,
+		Config: map[string]any{
+			editransport.ConfigKeyEndpointURL: "https://partner.example/edi",
+		},
+		EncryptedSecrets: secrets,
+	}
+}
+
+func TestReceiveHTTPSDocumentStagesSignedDocument(t *testing.T) {
+	t.Parallel()
+
+	profile := httpsInboundProfile(map[string]string{
+		editransport.SecretKeyHTTPSHMACSecret: "partner-secret",
+	})
+	profileRepo := mocks.NewMockEDICommunicationProfileRepository(t)
+	profileRepo.EXPECT().
+		GetActiveHTTPSProfileByID(
+			mock.Anything,
+			repositories.GetActiveHTTPSProfileByIDRequest{ID: profile.ID},
+		).
+		Return(profile, nil)
+	inboundFileRepo := mocks.NewMockEDIInboundFileRepository(t)
+	inboundFileRepo.EXPECT().
+		ExistsByChecksum(mock.Anything, mock.Anything).
+		Return(false, nil).
+		Once()
+	inboundFileRepo.EXPECT().
+		CreateInboundFile(mock.Anything, mock.MatchedBy(func(file *edi.EDIInboundFile) bool {
+			return file.Method == edi.ConnectionMethodHTTPS &&
+				file.RemotePath == "https:TMS-LOAD-77" &&
+				file.FileName == "TMS-LOAD-77.json" &&
+				file.RawContent == inboundHTTPSLoadTender
+		})).
+		RunAndReturn(func(_ context.Context, file *edi.EDIInboundFile) (*edi.EDIInboundFile, error) {
+			file.ID = pulid.MustNew("ediinf_")
+			return file, nil
+		}).
+		Once()
+	workflowStarter := mocks.NewMockWorkflowStarter(t)
+	workflowStarter.EXPECT().Enabled().Return(true)
+	workflowStarter.EXPECT().
+		StartWorkflow(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Once()
+	service := New(Params{
+		Logger:          zap.NewNop(),
+		InboundFileRepo: inboundFileRepo,
+		ProfileRepo:     profileRepo,
+		EDIService:      ediservice.New(ediservice.Params{Logger: zap.NewNop()}),
+		WorkflowStarter: workflowStarter,
+	})
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	result, err := service.ReceiveHTTPSDocument(t.Context(), &ReceiveHTTPSDocumentRequest{
+		ProfileID: profile.ID,
+		Timestamp: timestamp,
+		Signature: editransport.SignHTTPSBody(
+			"partner-secret",
+			timestamp,
+			[]byte(inboundHTTPSLoadTender),
+		),
+		Body: []byte(inboundHTTPSLoadTender),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Duplicate)
+	assert.True(t, result.FileID.IsNotNil())
+}
+
+func TestReceiveHTTPSDocumentRejectsUnverifiedRequests(t *testing.T) {
+	t.Parallel()
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	tests := []struct {
+		name      string
+		secrets   map[string]string
+		signature string
+		expected  error
+	}{
+		{
+			name:    "wrong secret",
+			secrets: map[string]string{editransport.SecretKeyHTTPSHMACSecret: "partner-secret"},
+			signature: editransport.SignHTTPSBody(
+				"guess",
+				timestamp,
+				[]byte(inboundHTTPSLoadTender),
+			),
+			expected: ErrHTTPSSignatureRejected,
+		},
+		{
+			name:      "no inbound secret",
+			secrets:   map[string]string{},
+			signature: editransport.SignHTTPSBody("", timestamp, []byte(inboundHTTPSLoadTender)),
+			expected:  ErrHTTPSInboundNotConfigured,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := httpsInboundProfile(tt.secrets)
+			profileRepo := mocks.NewMockEDICommunicationProfileRepository(t)
+			profileRepo.EXPECT().
+				GetActiveHTTPSProfileByID(mock.Anything, mock.Anything).
+				Return(profile, nil)
+			service := New(Params{
+				Logger:          zap.NewNop(),
+				InboundFileRepo: mocks.NewMockEDIInboundFileRepository(t),
+				ProfileRepo:     profileRepo,
+				EDIService:      ediservice.New(ediservice.Params{Logger: zap.NewNop()}),
+			})
+
+			_, err := service.ReceiveHTTPSDocument(t.Context(), &ReceiveHTTPSDocumentRequest{
+				ProfileID: profile.ID,
+				Timestamp: timestamp,
+				Signature: tt.signature,
+				Body:      []byte(inboundHTTPSLoadTender),
+			})
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
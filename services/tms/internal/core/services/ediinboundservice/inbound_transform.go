@@ -0,0 +1,186 @@
+package ediinboundservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/edistarlark"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/jsonutils"
+)
+
+// applyInboundTransform runs the partner's inbound transform over the parsed
+// document and pins the result on the transaction, so the stored payload
+// snapshot and every route read the transformed document.
+func applyInboundTransform(
+	ctx context.Context,
+	partner *edi.EDIPartner,
+	transaction *parsedTransaction,
+) error {
+	script := strings.TrimSpace(partner.InboundTransformScript)
+	if script == "" || !transformsTransactionSet(transaction.set) {
+		return nil
+	}
+	preview := runInboundTransform(ctx, script, transaction)
+	if len(preview.Diagnostics) > 0 {
+		return fmt.Errorf(
+			"inbound transform failed: %s",
+			preview.Diagnostics[0].Message,
+		)
+	}
+	transaction.payload = preview.After
+	return nil
+}
+
+// DryRunInboundTransform parses a sample interchange and shows each
+// transaction's canonical payload before and after the transform, without
+// recording or routing anything. The partner's saved script is used unless
+// the request supplies one.
+func (s *Service) DryRunInboundTransform(
+	ctx context.Context,
+	req *DryRunInboundTransformRequest,
+) (*InboundTransformDryRun, error) {
+	if req == nil || strings.TrimSpace(req.RawContent) == "" {
+		return nil, errortypes.NewValidationError(
+			"rawContent",
+			errortypes.ErrRequired,
+			"Sample inbound document is required",
+		)
+	}
+	partner, err := s.partnerRepo.GetByID(ctx, repositories.GetEDIPartnerByIDRequest{
+		ID:         req.PartnerID,
+		TenantInfo: req.TenantInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	script := strings.TrimSpace(req.Script)
+	if script == "" {
+		script = strings.TrimSpace(partner.InboundTransformScript)
+	}
+	if script == "" {
+		return nil, errortypes.NewValidationError(
+			"script",
+			errortypes.ErrRequired,
+			"The partner has no inbound transform script to run",
+		)
+	}
+	interchange, err := parseInterchange(req.RawContent)
+	if err != nil {
+		return nil, errortypes.NewValidationError(
+			"rawContent",
+			errortypes.ErrInvalid,
+			err.Error(),
+		)
+	}
+
+	result := &InboundTransformDryRun{
+		Transactions: make([]*InboundTransformPreview, 0, len(interchange.transactions)),
+	}
+	for index := range interchange.transactions {
+		transaction := &interchange.transactions[index]
+		if !transformsTransactionSet(transaction.set) {
+			continue
+		}
+		result.Transactions = append(
+			result.Transactions,
+			runInboundTransform(ctx, script, transaction),
+		)
+	}
+	return result, nil
+}
+
+func runInboundTransform(
+	ctx context.Context,
+	script string,
+	transaction *parsedTransaction,
+) *InboundTransformPreview {
+	before := transaction.documentPayload()
+	preview := &InboundTransformPreview{
+		TransactionSet: transaction.set,
+		ControlNumber:  transaction.controlNumber,
+		Before:         before,
+		Diagnostics:    []edistarlark.Diagnostic{},
+	}
+
+	// The load tender is also exposed as "shipment" for outbound templates;
+	// handing the script a single branch keeps its edits from being
+	// shadowed by the untouched copy.
+	if before.LoadTender != nil {
+		before.Shipment = nil
+	}
+	document, err := jsonutils.ToJSON(before)
+	if err != nil {
+		preview.Diagnostics = append(preview.Diagnostics, transformDiagnostic(err))
+		return preview
+	}
+	result := edistarlark.Transform(ctx, edistarlark.TransformRequest{
+		Script:   script,
+		Document: document,
+	})
+	preview.ExecutionSteps = result.ExecutionSteps
+	if len(result.Diagnostics) > 0 {
+		preview.Diagnostics = result.Diagnostics
+		return preview
+	}
+
+	after, err := decodeTransformedPayload(result.Document, before.TransactionSet)
+	if err != nil {
+		preview.Diagnostics = append(preview.Diagnostics, transformDiagnostic(err))
+		return preview
+	}
+	preview.After = after
+	return preview
+}
+
+func decodeTransformedPayload(
+	document map[string]any,
+	set edi.TransactionSet,
+) (*edi.DocumentPayload, error) {
+	encoded, err := sonic.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("encode transformed document: %w", err)
+	}
+	payload := new(edi.DocumentPayload)
+	if err = sonic.Unmarshal(encoded, payload); err != nil {
+		return nil, fmt.Errorf("decode transformed document: %w", err)
+	}
+	// Routing is chosen from the envelope, so a transform cannot turn a
+	// status message into a tender.
+	if payload.TransactionSet != set {
+		return nil, fmt.Errorf(
+			"transform changed the transaction set from %s to %s",
+			set,
+			payload.TransactionSet,
+		)
+	}
+	return payload, nil
+}
+
+// transformsTransactionSet reports whether the transaction carries a
+// business document. Acknowledgments are reconciled against what was sent
+// and are never rewritten.
+func transformsTransactionSet(set edi.TransactionSet) bool {
+	switch set.X12Equivalent() {
+	case edi.TransactionSet204,
+		edi.TransactionSet210,
+		edi.TransactionSet214,
+		edi.TransactionSet820,
+		edi.TransactionSet990:
+		return true
+	default:
+		return false
+	}
+}
+
+func transformDiagnostic(err error) edistarlark.Diagnostic {
+	return edistarlark.Diagnostic{
+		Severity: edistarlark.DiagnosticSeverityError,
+		Code:     edistarlark.DiagnosticCodeInvalidTransformResult,
+		Message:  err.Error(),
+	}
+}
@@ -0,0 +1,125 @@
+package ediinboundservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const remapStatusTransform = `CODES = {"X1": "AF"}
+
+def transform(document):
+    status = document["shipmentStatus"]
+    status["statusCode"] = CODES.get(status["statusCode"], status["statusCode"])
+    status["statusReasonCode"] = "AG"`
+
+func TestApplyInboundTransform_RoutesReadTransformedDocument(t *testing.T) {
+	t.Parallel()
+
+	transaction := parse214Transaction(t, rawShipmentStatus214("", "X1"))
+	partner := &edi.EDIPartner{InboundTransformScript: remapStatusTransform}
+
+	require.NoError(t, applyInboundTransform(t.Context(), partner, transaction))
+
+	details := parseShipmentStatus(transaction)
+	require.Equal(t, "AF", details.statusCode)
+	require.Equal(t, "AG", details.reasonCode)
+	require.Equal(t, "SHIP-1001", details.shipmentRef)
+	require.NotZero(t, details.eventAt)
+	payload := transaction.documentPayload()
+	require.Equal(t, "AF", payload.ShipmentStatus.StatusCode)
+}
+
+func TestApplyInboundTransform_KeepsX12TenderResponseReferences(t *testing.T) {
+	t.Parallel()
+
+	transaction := parse990Transaction(t, rawTenderResponse990("tof_offer", "A", ""))
+	partner := &edi.EDIPartner{InboundTransformScript: `def transform(document):
+    document["tenderResponse"]["responseCode"] = "D"`}
+
+	require.NoError(t, applyInboundTransform(t.Context(), partner, transaction))
+
+	details := parseTenderResponse(transaction)
+	require.Equal(t, "D", details.reservationCode)
+	require.Equal(t, "SCAC", details.scac)
+	require.Equal(t, "SHIP-1001", details.shipmentRef)
+	require.Equal(t, []string{"SHIP-1001", "tof_offer"}, details.references)
+}
+
+func TestApplyInboundTransform_KeepsX12ShipmentStatusReferences(t *testing.T) {
+	t.Parallel()
+
+	transaction := parse214Transaction(t, rawShipmentStatus214("tof_offer", "X1"))
+	partner := &edi.EDIPartner{InboundTransformScript: remapStatusTransform}
+
+	require.NoError(t, applyInboundTransform(t.Context(), partner, transaction))
+
+	details := parseShipmentStatus(transaction)
+	require.Equal(t, "AF", details.statusCode)
+	require.Equal(t, "PRO-77", details.referenceID)
+	require.Equal(t, "SHIP-1001", details.shipmentRef)
+	require.Equal(t, []string{"SHIP-1001", "PRO-77", "tof_offer"}, details.references)
+}
+
+func TestApplyInboundTransform_FailureStopsTransaction(t *testing.T) {
+	t.Parallel()
+
+	transaction := parse214Transaction(t, rawShipmentStatus214("", "X1"))
+	partner := &edi.EDIPartner{InboundTransformScript: `def transform(document):
+    return document["missing"]`}
+
+	err := applyInboundTransform(t.Context(), partner, transaction)
+
+	require.ErrorContains(t, err, "inbound transform failed")
+	require.Nil(t, transaction.payload)
+}
+
+func TestApplyInboundTransform_RejectsTransactionSetChange(t *testing.T) {
+	t.Parallel()
+
+	transaction := parse214Transaction(t, rawShipmentStatus214("", "AF"))
+	partner := &edi.EDIPartner{InboundTransformScript: `def transform(document):
+    document["transactionSet"] = "204"`}
+
+	err := applyInboundTransform(t.Context(), partner, transaction)
+
+	require.ErrorContains(t, err, "transform changed the transaction set from 214 to 204")
+}
+
+func TestDryRunInboundTransform_ShowsBeforeAndAfterPayload(t *testing.T) {
+	t.Parallel()
+
+	partnerRepo := mocks.NewMockEDIPartnerRepository(t)
+	service := &Service{l: zap.NewNop(), partnerRepo: partnerRepo}
+	partnerID := pulid.MustNew("edip_")
+	partnerRepo.EXPECT().
+		GetByID(mock.Anything, mock.Anything).
+		Return(&edi.EDIPartner{ID: partnerID}, nil).
+		Once()
+
+	result, err := service.DryRunInboundTransform(t.Context(), &DryRunInboundTransformRequest{
+		PartnerID: partnerID,
+		TenantInfo: pagination.TenantInfo{
+			OrgID: pulid.MustNew("org_"),
+			BuID:  pulid.MustNew("bu_"),
+		},
+		RawContent: rawShipmentStatus214("", "X1"),
+		Script:     remapStatusTransform,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Transactions, 1)
+	preview := result.Transactions[0]
+	require.Empty(t, preview.Diagnostics)
+	require.Equal(t, "X1", preview.Before.ShipmentStatus.StatusCode)
+	require.Equal(t, "NS", preview.Before.ShipmentStatus.StatusReasonCode)
+	require.NotNil(t, preview.After)
+	require.Equal(t, "AF", preview.After.ShipmentStatus.StatusCode)
+	require.Equal(t, "AG", preview.After.ShipmentStatus.StatusReasonCode)
+}
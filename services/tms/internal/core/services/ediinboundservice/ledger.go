@@ -0,0 +1,141 @@
+package ediinboundservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/ediservice"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"go.uber.org/zap"
+)
+
+// tracksControlNumbers reports whether the interchange carries partner
+// assigned envelope numbers. JSON documents received over HTTPS are keyed by
+// a hash of their document ID instead.
+func (i *parsedInterchange) tracksControlNumbers() bool {
+	for index := range i.transactions {
+		if i.transactions[index].jsonDocument {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) recordInterchangeControlNumber(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	interchange *parsedInterchange,
+	rejected bool,
+) {
+	if !interchange.tracksControlNumbers() {
+		return
+	}
+	s.recordControlNumber(ctx, &ediservice.ControlNumberObservation{
+		TenantInfo:    inboundFileTenantInfo(file),
+		EDIPartnerID:  file.EDIPartnerID,
+		Direction:     edi.DocumentDirectionInbound,
+		Kind:          edi.ControlNumberKindInterchange,
+		ControlNumber: interchange.controlNumber,
+		InboundFileID: file.ID,
+		Rejected:      rejected,
+	})
+}
+
+func (s *Service) recordGroupControlNumber(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	transaction *parsedTransaction,
+) {
+	if transaction.jsonDocument {
+		return
+	}
+	s.recordControlNumber(ctx, &ediservice.ControlNumberObservation{
+		TenantInfo:    inboundFileTenantInfo(file),
+		EDIPartnerID:  file.EDIPartnerID,
+		Direction:     edi.DocumentDirectionInbound,
+		Kind:          edi.ControlNumberKindGroup,
+		ControlNumber: transaction.groupControlNumber,
+		InboundFileID: file.ID,
+	})
+}
+
+// claimTransaction ledgers the transaction's ST02 as received and returns an
+// error when the partner resent a transaction that another file holds, so the
+// resend never reaches routing and cannot create a second tender. The claim
+// is settled by settleTransaction once processing finishes.
+func (s *Service) claimTransaction(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	transaction *parsedTransaction,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	if transaction.jsonDocument {
+		return nil, nil //nolint:nilnil // JSON documents carry no ST02
+	}
+	claim, original, err := s.ediService.ClaimInboundTransaction(
+		ctx,
+		&ediservice.ControlNumberObservation{
+			TenantInfo:     inboundFileTenantInfo(file),
+			EDIPartnerID:   file.EDIPartnerID,
+			Direction:      edi.DocumentDirectionInbound,
+			Kind:           edi.ControlNumberKindTransaction,
+			SequenceScope:  file.InterchangeControlNumber + "/" + transaction.groupControlNumber,
+			ControlNumber:  transaction.controlNumber,
+			TransactionSet: transaction.set,
+			InboundFileID:  file.ID,
+			ContentHash:    ediservice.ControlNumberContentHash(transaction.raw),
+		},
+	)
+	if err != nil {
+		s.l.Warn(
+			"failed to claim transaction in the EDI ledger",
+			zap.String("fileId", file.ID.String()),
+			zap.String("transactionControlNumber", transaction.controlNumber),
+			zap.Error(err),
+		)
+	}
+	if original == nil {
+		return claim, nil
+	}
+	return nil, fmt.Errorf(
+		"transaction %s/%s was already received in inbound file %s and was not processed again",
+		transaction.set,
+		transaction.controlNumber,
+		original.InboundFileID,
+	)
+}
+
+func (s *Service) settleTransaction(
+	ctx context.Context,
+	claim *edi.EDIControlNumberLedgerEntry,
+	processErr error,
+) {
+	if err := s.ediService.SettleInboundTransaction(ctx, claim, processErr); err != nil {
+		s.l.Warn(
+			"failed to record EDI transaction outcome",
+			zap.String("ledgerEntryId", claim.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *Service) recordControlNumber(
+	ctx context.Context,
+	obs *ediservice.ControlNumberObservation,
+) {
+	if _, err := s.ediService.RecordControlNumber(ctx, obs); err != nil {
+		s.l.Warn(
+			"failed to record EDI control number",
+			zap.String("fileId", obs.InboundFileID.String()),
+			zap.String("kind", string(obs.Kind)),
+			zap.Error(err),
+		)
+	}
+}
+
+func inboundFileTenantInfo(file *edi.EDIInboundFile) pagination.TenantInfo {
+	return pagination.TenantInfo{
+		OrgID: file.OrganizationID,
+		BuID:  file.BusinessUnitID,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edifact"
 	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
 	"github.com/emoss08/trenova/shared/pulid"
 	"github.com/emoss08/trenova/shared/stringutils"
@@ -19,6 +20,12 @@ func parseInterchange(rawX12 string) (*parsedInterchange, error) {
 	if strings.TrimSpace(rawX12) == "" {
 		return nil, errors.New("inbound file is empty")
 	}
+	if edifact.IsEDIFACT(rawX12) {
+		return parseEDIFACTInterchange(rawX12)
+	}
+	if isHTTPSDocument(rawX12) {
+		return parseHTTPSInterchange(rawX12)
+	}
 	inspection := edix12inspect.InspectX12(&edix12inspect.InspectX12Request{RawX12: rawX12})
 	if len(inspection.Segments) == 0 {
 		return nil, errors.New("inbound file does not contain any X12 segments")
@@ -41,6 +48,7 @@ func parseInterchange(rawX12 string) (*parsedInterchange, error) {
 		interchange.applicationSender = strings.TrimSpace(elementValue(gs, 2))
 		interchange.applicationReceiver = strings.TrimSpace(elementValue(gs, 3))
 		interchange.groupControlNumber = strings.TrimSpace(elementValue(gs, 6))
+		interchange.x12Version = strings.TrimSpace(elementValue(gs, 8))
 	}
 	if len(inspection.Transactions) == 0 {
 		return nil, errors.New("inbound file does not contain any X12 transactions")
@@ -65,6 +73,7 @@ func parseInterchange(rawX12 string) (*parsedInterchange, error) {
 			controlNumber:      strings.TrimSpace(transaction.STControlNumber),
 			groupControlNumber: interchange.groupControlNumber,
 			functionalGroupID:  interchange.functionalGroupID,
+			x12Version:         interchange.x12Version,
 			segments:           segments,
 			raw:                raw.String(),
 		})
@@ -125,6 +134,9 @@ func elementValue(segment *edix12inspect.X12Segment, position int) string {
 }
 
 func (t *parsedTransaction) documentPayload() edi.DocumentPayload {
+	if t.payload != nil {
+		return *t.payload
+	}
 	switch t.set {
 	case edi.TransactionSet204:
 		payload := parseLoadTender(t)
@@ -139,6 +151,12 @@ func (t *parsedTransaction) documentPayload() edi.DocumentPayload {
 			TransactionSet: edi.TransactionSet210,
 			FreightInvoice: &payload,
 		}
+	case edi.TransactionSet820:
+		payload := parseRemittanceAdvice(t)
+		return edi.DocumentPayload{
+			TransactionSet:   edi.TransactionSet820,
+			RemittanceAdvice: &payload,
+		}
 	case edi.TransactionSet990:
 		details := parseTenderResponse(t)
 		return edi.DocumentPayload{
@@ -257,18 +275,52 @@ func parseAcknowledgments(t *parsedTransaction) []acknowledgmentEntry {
 	return entries
 }
 
+// parseTenderResponse reads the 990 from its segments and lays any payload
+// pinned by a transform or a non-X12 parse over them. The B1 SCAC and L11
+// references only exist in the segments, so they are kept alongside the
+// payload's references rather than replaced by them.
 func parseTenderResponse(t *parsedTransaction) tenderResponseDetails {
+	details := tenderResponseFromSegments(t.segments)
+	if t.payload == nil || t.payload.TenderResponse == nil {
+		return details
+	}
+	response := t.payload.TenderResponse
+	details.shipmentRef = stringutils.FirstNonEmpty(
+		strings.TrimSpace(response.BOL),
+		details.shipmentRef,
+	)
+	details.reservationCode = stringutils.FirstNonEmpty(
+		strings.ToUpper(strings.TrimSpace(response.ResponseCode)),
+		details.reservationCode,
+	)
+	details.remarks = stringutils.FirstNonEmpty(
+		strings.TrimSpace(response.RejectionReason),
+		strings.TrimSpace(response.ReasonCode),
+		details.remarks,
+	)
+	details.references = mergeReferences(
+		stringutils.NonEmptyStrings(
+			response.BOL,
+			response.TransferID.String(),
+			response.ShipmentID.String(),
+		),
+		details.references,
+	)
+	return details
+}
+
+func tenderResponseFromSegments(segments []edix12inspect.X12Segment) tenderResponseDetails {
 	details := tenderResponseDetails{}
-	if b1 := findSegment(t.segments, "B1"); b1 != nil {
+	if b1 := findSegment(segments, "B1"); b1 != nil {
 		details.scac = strings.TrimSpace(elementValue(b1, 1))
 		details.shipmentRef = strings.TrimSpace(elementValue(b1, 2))
 		details.reservationCode = strings.ToUpper(strings.TrimSpace(elementValue(b1, 4)))
 	}
 	if details.shipmentRef == "" {
-		details.shipmentRef = referenceFromL11(t.segments)
+		details.shipmentRef = referenceFromL11(segments)
 	}
-	details.references = referenceValues(t.segments)
-	if k1 := findSegment(t.segments, "K1"); k1 != nil {
+	details.references = referenceValues(segments)
+	if k1 := findSegment(segments, "K1"); k1 != nil {
 		details.remarks = strings.TrimSpace(strings.Join(stringutils.NonEmptyStrings(
 			elementValue(k1, 1),
 			elementValue(k1, 2),
@@ -277,17 +329,49 @@ func parseTenderResponse(t *parsedTransaction) tenderResponseDetails {
 	return details
 }
 
+// parseShipmentStatus reads the 214 from its segments and lays any pinned
+// payload over them, keeping the L11 references the payload does not carry.
 func parseShipmentStatus(t *parsedTransaction) shipmentStatusDetails {
+	details := shipmentStatusFromSegments(t.segments)
+	if t.payload == nil || t.payload.ShipmentStatus == nil {
+		return details
+	}
+	status := t.payload.ShipmentStatus
+	details.referenceID = stringutils.FirstNonEmpty(
+		strings.TrimSpace(status.ProNumber),
+		details.referenceID,
+	)
+	details.shipmentRef = stringutils.FirstNonEmpty(
+		strings.TrimSpace(status.BOL),
+		details.shipmentRef,
+	)
+	details.statusCode = stringutils.FirstNonEmpty(status.StatusCode, details.statusCode)
+	details.reasonCode = stringutils.FirstNonEmpty(status.StatusReasonCode, details.reasonCode)
+	if status.EventDate != 0 {
+		details.eventAt = status.EventDate
+	}
+	details.references = mergeReferences(
+		stringutils.NonEmptyStrings(
+			status.BOL,
+			status.ProNumber,
+			status.References["shipmentId"],
+		),
+		details.references,
+	)
+	return details
+}
+
+func shipmentStatusFromSegments(segments []edix12inspect.X12Segment) shipmentStatusDetails {
 	details := shipmentStatusDetails{}
-	if b10 := findSegment(t.segments, "B10"); b10 != nil {
+	if b10 := findSegment(segments, "B10"); b10 != nil {
 		details.referenceID = strings.TrimSpace(elementValue(b10, 1))
 		details.shipmentRef = strings.TrimSpace(elementValue(b10, 2))
 	}
 	if details.shipmentRef == "" {
-		details.shipmentRef = referenceFromL11(t.segments)
+		details.shipmentRef = referenceFromL11(segments)
 	}
-	details.references = referenceValues(t.segments)
-	if at7 := findSegment(t.segments, "AT7"); at7 != nil {
+	details.references = referenceValues(segments)
+	if at7 := findSegment(segments, "AT7"); at7 != nil {
 		details.statusCode = strings.ToUpper(strings.TrimSpace(elementValue(at7, 1)))
 		details.reasonCode = strings.ToUpper(strings.TrimSpace(elementValue(at7, 2)))
 		details.eventAt = parseX12Timestamp(elementValue(at7, 5), elementValue(at7, 6))
@@ -295,6 +379,21 @@ func parseShipmentStatus(t *parsedTransaction) shipmentStatusDetails {
 	return details
 }
 
+// mergeReferences appends the references in extra that primary does not
+// already hold, keeping primary's order first.
+func mergeReferences(primary, extra []string) []string {
+	merged := make([]string, 0, len(primary)+len(extra))
+	seen := make(map[string]struct{}, len(primary)+len(extra))
+	for _, reference := range append(primary, extra...) {
+		if _, ok := seen[reference]; ok {
+			continue
+		}
+		seen[reference] = struct{}{}
+		merged = append(merged, reference)
+	}
+	return merged
+}
+
 func parseFreightInvoice(t *parsedTransaction) edi.FreightInvoicePayload {
 	payload := edi.FreightInvoicePayload{ReferenceNumbers: map[string]string{}}
 	applyFreightInvoiceHeader(&payload, t.segments)
@@ -837,6 +936,12 @@ func acknowledgmentPayloadForTransaction(
 		ReceivedTransactionSetCount:      1,
 		IncludedTransactionSetCount:      1,
 	}
+	if len(transaction.rejections) > 0 {
+		ack.GroupAcknowledgmentCode = "R"
+		ack.TransactionAcknowledgmentCode = "R"
+		ack.AcceptedTransactionSetCount = 0
+		ack.Diagnostics = transaction.rejections
+	}
 	if ackSet == edi.TransactionSet999 {
 		implementation := edi.ImplementationAckPayload(ack)
 		return edi.DocumentPayload{
@@ -859,6 +964,8 @@ func transactionSetFromCode(code string) edi.TransactionSet {
 		return edi.TransactionSet210
 	case "QM":
 		return edi.TransactionSet214
+	case "RA":
+		return edi.TransactionSet820
 	case "GF":
 		return edi.TransactionSet990
 	default:
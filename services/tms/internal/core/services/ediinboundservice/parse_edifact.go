@@ -0,0 +1,370 @@
+package ediinboundservice
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edifact"
+	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/stringutils"
+	"github.com/shopspring/decimal"
+)
+
+// parseEDIFACTInterchange maps a UNB..UNZ interchange onto the same parsed
+// shape as X12. UNB carries the party identifiers that ISA would; there is no
+// functional group, and each message payload is built up front because the
+// X12 segment readers cannot interpret composite EDIFACT elements.
+func parseEDIFACTInterchange(raw string) (*parsedInterchange, error) {
+	parsed, err := edifact.ParseMessages(raw)
+	if err != nil {
+		if errors.Is(err, edifact.ErrMissingUNB) {
+			return nil, errors.New("inbound file does not start with a UNB interchange header")
+		}
+		return nil, err
+	}
+	inspection := edifact.Inspect(&edix12inspect.InspectX12Request{RawX12: raw})
+	interchange := &parsedInterchange{
+		inspection:        inspection,
+		controlNumber:     strings.TrimSpace(parsed.ControlReference()),
+		senderQualifier:   strings.TrimSpace(parsed.SenderQualifier()),
+		senderID:          strings.TrimSpace(parsed.SenderID()),
+		receiverQualifier: strings.TrimSpace(parsed.RecipientQualifier()),
+		receiverID:        strings.TrimSpace(parsed.RecipientID()),
+		transactions:      make([]parsedTransaction, 0, len(parsed.Messages)),
+	}
+	for index := range parsed.Messages {
+		message := &parsed.Messages[index]
+		set := edi.TransactionSet(strings.ToUpper(strings.TrimSpace(message.Type)))
+		payload := edifactDocumentPayload(set, message, parsed.Delimiters.Decimal)
+		segments := make([]edix12inspect.X12Segment, 0, len(message.Segments))
+		for segmentIndex := range inspection.Segments {
+			position := inspection.Segments[segmentIndex].Index
+			if position >= message.StartSegment && position <= message.EndSegment {
+				segments = append(segments, inspection.Segments[segmentIndex])
+			}
+		}
+		interchange.transactions = append(interchange.transactions, parsedTransaction{
+			set:           set,
+			controlNumber: strings.TrimSpace(message.Reference),
+			segments:      segments,
+			raw:           message.Raw(parsed.Delimiters),
+			payload:       payload,
+		})
+	}
+	return interchange, nil
+}
+
+func edifactDocumentPayload(
+	set edi.TransactionSet,
+	message *edifact.Message,
+	decimalMark string,
+) *edi.DocumentPayload {
+	//nolint:exhaustive // Only EDIFACT messages carry a prebuilt payload.
+	switch set {
+	case edi.TransactionSetIFTMIN:
+		payload := parseIFTMIN(message)
+		return &edi.DocumentPayload{
+			TransactionSet: edi.TransactionSet204,
+			PurposeCode:    payload.PurposeCode,
+			LoadTender:     &payload,
+		}
+	case edi.TransactionSetIFTSTA:
+		payload := parseIFTSTA(message)
+		return &edi.DocumentPayload{
+			TransactionSet: edi.TransactionSet214,
+			ShipmentStatus: &payload,
+		}
+	case edi.TransactionSetINVOIC:
+		payload := parseINVOIC(message, decimalMark)
+		return &edi.DocumentPayload{
+			TransactionSet: edi.TransactionSet210,
+			FreightInvoice: &payload,
+		}
+	default:
+		return nil
+	}
+}
+
+func parseIFTMIN(message *edifact.Message) edi.LoadTenderPayload {
+	payload := edi.LoadTenderPayload{
+		PurposeCode:              edi.LoadTenderPurposeOriginal,
+		CustomerID:               pulid.ID(inboundDefaultMappingKey),
+		CustomerLabel:            "Default customer for inbound tenders",
+		ServiceTypeID:            pulid.ID(inboundDefaultMappingKey),
+		ServiceTypeLabel:         "Default service type for inbound tenders",
+		FormulaTemplateID:        pulid.ID(inboundDefaultMappingKey),
+		FormulaTemplateLabel:     "Default rating formula for inbound tenders",
+		RatingDetail:             map[string]any{},
+		RequiredMappingEntityIDs: map[edi.MappingEntityType][]pulid.ID{},
+	}
+	if bgm := message.FindSegment("BGM"); bgm != nil {
+		if ref := strings.TrimSpace(bgm.Value(2, 1)); ref != "" {
+			payload.RatingDetail["externalShipmentId"] = ref
+		}
+		// Message function 5 (replace) and 4 (change) amend an earlier tender.
+		switch strings.TrimSpace(bgm.Value(3, 1)) {
+		case "4", "5":
+			payload.PurposeCode = edi.LoadTenderPurposeChange
+		}
+	}
+	payload.BOL = edifactReference(message, "BM")
+
+	stops := make([]edi.LoadTenderStop, 0, 4)
+	var current *edi.LoadTenderStop
+	for index := range message.Segments {
+		segment := &message.Segments[index]
+		switch segment.Tag {
+		case "NAD":
+			stopType, ok := edifactStopType(segment.Value(1, 1))
+			if !ok {
+				current = nil
+				continue
+			}
+			stops = append(stops, edifactTenderStop(segment, stopType, int64(len(stops)+1)))
+			current = &stops[len(stops)-1]
+		case "DTM":
+			if current != nil && current.ScheduledWindowStart == 0 {
+				current.ScheduledWindowStart = edifactTimestamp(segment)
+			}
+		case "GID":
+			current = nil
+		case "FTX":
+			description := strings.TrimSpace(segment.Value(4, 1))
+			if segment.Value(1, 1) != "AAA" || description == "" {
+				continue
+			}
+			commodityID := edi.MappingSourceID(description)
+			payload.Commodities = append(payload.Commodities, edi.LoadTenderCommodity{
+				CommodityID:          commodityID,
+				CommodityLabel:       description,
+				CommodityDescription: description,
+			})
+			addRequiredMappingID(&payload, edi.MappingEntityTypeCommodity, commodityID)
+		case "MEA":
+			if segment.Value(2, 1) == "G" || segment.Value(2, 1) == "AAB" {
+				if weight := parseInt64(segment.Value(3, 2)); weight > 0 && payload.Weight == nil {
+					payload.Weight = &weight
+				}
+			}
+		}
+	}
+	if len(stops) > 0 {
+		payload.Moves = []edi.LoadTenderMove{{Loaded: true, Sequence: 0, Stops: stops}}
+	}
+	for index := range stops {
+		addRequiredMappingID(&payload, edi.MappingEntityTypeLocation, stops[index].LocationID)
+	}
+
+	addRequiredMappingID(&payload, edi.MappingEntityTypeCustomer, payload.CustomerID)
+	addRequiredMappingID(&payload, edi.MappingEntityTypeServiceType, payload.ServiceTypeID)
+	addRequiredMappingID(
+		&payload,
+		edi.MappingEntityTypeFormulaTemplate,
+		payload.FormulaTemplateID,
+	)
+	return payload
+}
+
+// edifactStopType maps NAD party qualifiers to stop types. Parties that are
+// not a pickup or delivery location (carrier, bill-to) do not open a stop.
+func edifactStopType(qualifier string) (string, bool) {
+	switch strings.ToUpper(strings.TrimSpace(qualifier)) {
+	case "CZ", "SF", "SH", "PW":
+		return "Pickup", true
+	case "CN", "ST", "DP":
+		return "Delivery", true
+	case "PICKUP", "DELIVERY", "SPLITPICKUP", "SPLITDELIVERY":
+		return stopTypeFromCode(qualifier), true
+	default:
+		return "", false
+	}
+}
+
+func edifactTenderStop(
+	segment *edifact.Segment,
+	stopType string,
+	sequence int64,
+) edi.LoadTenderStop {
+	stop := edi.LoadTenderStop{
+		Type:                 stopType,
+		ScheduleType:         inboundStopScheduleType(),
+		Sequence:             sequence,
+		LocationCode:         strings.TrimSpace(segment.Value(2, 1)),
+		LocationName:         strings.TrimSpace(segment.Value(4, 1)),
+		LocationAddressLine1: strings.TrimSpace(segment.Value(5, 1)),
+		LocationAddressLine2: strings.TrimSpace(segment.Value(5, 2)),
+		LocationCity:         strings.TrimSpace(segment.Value(6, 1)),
+		LocationStateCode:    strings.TrimSpace(segment.Value(7, 1)),
+		LocationPostalCode:   strings.TrimSpace(segment.Value(8, 1)),
+	}
+	stop.AddressLine = strings.Join(stringutils.NonEmptyStrings(
+		stop.LocationAddressLine1,
+		stop.LocationAddressLine2,
+		strings.Join(stringutils.NonEmptyStrings(
+			stop.LocationCity,
+			stop.LocationStateCode,
+			stop.LocationPostalCode,
+		), ", "),
+	), ", ")
+	stop.LocationID = edi.MappingSourceID(stringutils.FirstNonEmpty(
+		stop.LocationCode,
+		stop.LocationName,
+		fmt.Sprintf("STOP-%d", stop.Sequence),
+	))
+	stop.LocationLabel = stringutils.FirstNonEmpty(
+		strings.TrimSpace(strings.Join(
+			stringutils.NonEmptyStrings(stop.LocationName, stop.AddressLine),
+			" - ",
+		)),
+		string(stop.LocationID),
+	)
+	return stop
+}
+
+func parseIFTSTA(message *edifact.Message) edi.ShipmentStatusPayload {
+	payload := edi.ShipmentStatusPayload{
+		BOL:        edifactReference(message, "BM"),
+		ProNumber:  edifactReference(message, "CN"),
+		References: map[string]string{},
+	}
+	if bgm := message.FindSegment("BGM"); bgm != nil {
+		payload.References["shipmentId"] = strings.TrimSpace(bgm.Value(2, 1))
+	}
+	if payload.BOL == "" {
+		payload.BOL = payload.References["shipmentId"]
+	}
+	payload.References["referenceId"] = payload.ProNumber
+	if sts := message.FindSegment("STS"); sts != nil {
+		payload.StatusCode = strings.ToUpper(strings.TrimSpace(sts.Value(2, 1)))
+		payload.StatusReasonCode = strings.ToUpper(strings.TrimSpace(sts.Value(3, 1)))
+	}
+	for _, dtm := range message.FindSegments("DTM") {
+		if timestamp := edifactTimestamp(&dtm); timestamp > 0 {
+			payload.EventDate = timestamp
+			payload.EventTime = timestamp
+			break
+		}
+	}
+	if loc := message.FindSegment("LOC"); loc != nil {
+		payload.City = strings.TrimSpace(loc.Value(2, 1))
+		payload.StateCode = strings.TrimSpace(loc.Value(2, 4))
+	}
+	if eqd := message.FindSegment("EQD"); eqd != nil {
+		payload.EquipmentNumber = strings.TrimSpace(eqd.Value(2, 1))
+	}
+	return payload
+}
+
+func parseINVOIC(message *edifact.Message, decimalMark string) edi.FreightInvoicePayload {
+	payload := edi.FreightInvoicePayload{ReferenceNumbers: map[string]string{}}
+	if bgm := message.FindSegment("BGM"); bgm != nil {
+		payload.InvoiceNumber = strings.TrimSpace(bgm.Value(2, 1))
+	}
+	for _, rff := range message.FindSegments("RFF") {
+		assignFreightInvoiceReference(&payload, rff.Value(1, 1), rff.Value(1, 2))
+	}
+	if cux := message.FindSegment("CUX"); cux != nil {
+		payload.CurrencyCode = strings.ToUpper(strings.TrimSpace(cux.Value(1, 2)))
+	}
+
+	var charge *edi.FreightInvoiceCharge
+	summary := false
+	for index := range message.Segments {
+		segment := &message.Segments[index]
+		switch segment.Tag {
+		case "DTM":
+			timestamp := edifactTimestamp(segment)
+			switch segment.Value(1, 1) {
+			case "3", "137":
+				if payload.InvoiceDate == 0 {
+					payload.InvoiceDate = timestamp
+				}
+			case "35":
+				payload.DeliveryDate = timestamp
+			}
+		case "NAD":
+			code := strings.ToUpper(strings.TrimSpace(segment.Value(1, 1)))
+			if code != "BT" && code != "IV" && code != "BI" {
+				continue
+			}
+			payload.BillToName = strings.TrimSpace(segment.Value(4, 1))
+			payload.BillToAddressLine1 = strings.TrimSpace(segment.Value(5, 1))
+			payload.BillToAddressLine2 = strings.TrimSpace(segment.Value(5, 2))
+			payload.BillToCity = strings.TrimSpace(segment.Value(6, 1))
+			payload.BillToStateCode = strings.TrimSpace(segment.Value(7, 1))
+			payload.BillToPostalCode = strings.TrimSpace(segment.Value(8, 1))
+			payload.BillToCountry = strings.TrimSpace(segment.Value(9, 1))
+		case "LIN":
+			sequence := parseInt64(segment.Value(1, 1))
+			if sequence == 0 {
+				sequence = int64(len(payload.LineCharges) + 1)
+			}
+			payload.LineCharges = append(
+				payload.LineCharges,
+				edi.FreightInvoiceCharge{Sequence: sequence},
+			)
+			charge = &payload.LineCharges[len(payload.LineCharges)-1]
+		case "IMD":
+			if charge != nil {
+				charge.Code = strings.ToUpper(strings.TrimSpace(segment.Value(3, 1)))
+				charge.Description = strings.TrimSpace(segment.Value(3, 4))
+			}
+		case "UNS":
+			summary = true
+			charge = nil
+		case "MOA":
+			amount, err := edifactDecimal(segment.Value(1, 2), decimalMark)
+			if err != nil {
+				continue
+			}
+			switch {
+			case charge != nil:
+				charge.Amount = amount.Decimal
+			case summary && (segment.Value(1, 1) == "77" || segment.Value(1, 1) == "86"):
+				payload.TotalAmount = amount
+			}
+		}
+	}
+	return payload
+}
+
+func edifactReference(message *edifact.Message, qualifier string) string {
+	for _, rff := range message.FindSegments("RFF") {
+		if strings.EqualFold(strings.TrimSpace(rff.Value(1, 1)), qualifier) {
+			return strings.TrimSpace(rff.Value(1, 2))
+		}
+	}
+	return ""
+}
+
+// edifactTimestamp reads a DTM C507 composite using its format qualifier:
+// 102 (CCYYMMDD), 203 (CCYYMMDDHHMM), or 204 (CCYYMMDDHHMMSS).
+func edifactTimestamp(segment *edifact.Segment) int64 {
+	value := strings.TrimSpace(segment.Value(1, 2))
+	if value == "" {
+		return 0
+	}
+	layout := "20060102"
+	switch segment.Value(1, 3) {
+	case "203":
+		layout = "200601021504"
+	case "204":
+		layout = "20060102150405"
+	}
+	parsed, err := time.ParseInLocation(layout, value, time.UTC)
+	if err != nil {
+		return parseX12Timestamp(value, "")
+	}
+	return parsed.Unix()
+}
+
+func edifactDecimal(value, decimalMark string) (decimal.NullDecimal, error) {
+	if decimalMark != "" && decimalMark != "." {
+		value = strings.ReplaceAll(value, decimalMark, ".")
+	}
+	return decimalFromX12(value)
+}
@@ -0,0 +1,118 @@
+package ediinboundservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const inboundIFTMIN = "UNA:+.? '" +
+	"UNB+UNOC:3+PARTNER:ZZ+TRENOVA:ZZ+261016:1200+000000101'" +
+	"UNH+1+IFTMIN:D:96A:UN'" +
+	"BGM+610+EXT-55+9'" +
+	"RFF+BM:BOL-1'" +
+	"NAD+CZ+DOCK1++Chicago Dock+100 Main+Chicago+IL+60601'" +
+	"DTM+200:202610171430:203'" +
+	"NAD+CN+++Dallas DC+9 Elm+Dallas+TX+75201'" +
+	"GID+1'" +
+	"FTX+AAA+++Palletized freight'" +
+	"MEA+WT+G+LBR:42000'" +
+	"UNT+10+1'" +
+	"UNZ+1+000000101'"
+
+func TestParseInterchange_EDIFACTLoadTender(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := parseInterchange(inboundIFTMIN)
+
+	require.NoError(t, err)
+	assert.Equal(t, "000000101", interchange.controlNumber)
+	assert.Equal(t, "PARTNER", interchange.senderID)
+	assert.Equal(t, "ZZ", interchange.senderQualifier)
+	require.Len(t, interchange.transactions, 1)
+
+	transaction := &interchange.transactions[0]
+	assert.Equal(t, edi.TransactionSetIFTMIN, transaction.set)
+	assert.Len(t, transaction.segments, 10)
+
+	payload := transaction.documentPayload()
+	require.NotNil(t, payload.LoadTender)
+	tender := payload.LoadTender
+	assert.Equal(t, "BOL-1", tender.BOL)
+	assert.Equal(t, "EXT-55", tender.RatingDetail["externalShipmentId"])
+	require.NotNil(t, tender.Weight)
+	assert.Equal(t, int64(42000), *tender.Weight)
+	require.Len(t, tender.Moves, 1)
+	stops := tender.Moves[0].Stops
+	require.Len(t, stops, 2)
+	assert.Equal(t, "Pickup", stops[0].Type)
+	assert.Equal(t, "DOCK1", stops[0].LocationCode)
+	assert.Equal(
+		t,
+		time.Date(2026, 10, 17, 14, 30, 0, 0, time.UTC).Unix(),
+		stops[0].ScheduledWindowStart,
+	)
+	assert.Equal(t, "Delivery", stops[1].Type)
+	assert.Equal(t, "Dallas", stops[1].LocationCity)
+	require.Len(t, tender.Commodities, 1)
+	assert.Equal(t, "Palletized freight", tender.Commodities[0].CommodityDescription)
+}
+
+func TestParseInterchange_EDIFACTShipmentStatus(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := parseInterchange("UNB+UNOC:3+PARTNER:ZZ+TRENOVA:ZZ+261016:1200+9'" +
+		"UNH+1+IFTSTA:D:96A:UN'" +
+		"BGM+77+SHIP-9+9'" +
+		"RFF+BM:BOL-9'" +
+		"STS+1+x1+ns'" +
+		"DTM+334:20261017:102'" +
+		"UNT+6+1'" +
+		"UNZ+1+9'")
+
+	require.NoError(t, err)
+	require.Len(t, interchange.transactions, 1)
+	details := parseShipmentStatus(&interchange.transactions[0])
+	assert.Equal(t, "BOL-9", details.shipmentRef)
+	assert.Equal(t, "X1", details.statusCode)
+	assert.Equal(t, "NS", details.reasonCode)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC).Unix(), details.eventAt)
+	assert.Contains(t, details.references, "SHIP-9")
+}
+
+func TestParseInterchange_EDIFACTInvoice(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := parseInterchange("UNA:+,? '" +
+		"UNB+UNOC:3+PARTNER:ZZ+TRENOVA:ZZ+261016:1200+12'" +
+		"UNH+1+INVOIC:D:96A:UN'" +
+		"BGM+380+INV-1+9'" +
+		"DTM+3:20261016:102'" +
+		"RFF+BM:BOL-3'" +
+		"NAD+BT+++Acme Shipping+1 Road+Austin+TX+73301+US'" +
+		"CUX+2:EUR:4'" +
+		"LIN+1'" +
+		"IMD+F++FSC:::Fuel surcharge'" +
+		"MOA+203:125,50'" +
+		"UNS+S'" +
+		"MOA+77:125,50'" +
+		"UNT+12+1'" +
+		"UNZ+1+12'")
+
+	require.NoError(t, err)
+	payload := interchange.transactions[0].documentPayload()
+	require.NotNil(t, payload.FreightInvoice)
+	invoice := payload.FreightInvoice
+	assert.Equal(t, "INV-1", invoice.InvoiceNumber)
+	assert.Equal(t, "BOL-3", invoice.BOL)
+	assert.Equal(t, "EUR", invoice.CurrencyCode)
+	assert.Equal(t, "Acme Shipping", invoice.BillToName)
+	require.Len(t, invoice.LineCharges, 1)
+	assert.Equal(t, "FSC", invoice.LineCharges[0].Code)
+	assert.Equal(t, "125.5", invoice.LineCharges[0].Amount.String())
+	require.True(t, invoice.TotalAmount.Valid)
+	assert.Equal(t, "125.5", invoice.TotalAmount.Decimal.String())
+}
@@ -0,0 +1,185 @@
+package ediinboundservice
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/editransport"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/stringutils"
+)
+
+// httpsControlNumberLength matches the control number columns, which were
+// sized for X12 and EDIFACT references.
+const httpsControlNumberLength = 20
+
+func isHTTPSDocument(raw string) bool {
+	return strings.HasPrefix(strings.TrimSpace(raw), "{")
+}
+
+// parseHTTPSInterchange reads one JSON document received over HTTPS. The
+// document ID stands in for the interchange control number so a resent
+// document is caught by the same duplicate check as a resent interchange.
+func parseHTTPSInterchange(raw string) (*parsedInterchange, error) {
+	var document editransport.HTTPSDocument
+	if err := json.Unmarshal([]byte(raw), &document); err != nil {
+		return nil, fmt.Errorf("inbound JSON document could not be parsed: %w", err)
+	}
+	documentID := strings.TrimSpace(document.DocumentID)
+	if documentID == "" {
+		return nil, errors.New("inbound JSON document does not carry a documentId")
+	}
+	set := edi.TransactionSet(stringutils.FirstNonEmpty(
+		strings.TrimSpace(string(document.TransactionSet)),
+		strings.TrimSpace(string(document.Payload.TransactionSet)),
+	))
+	payload, err := httpsDocumentPayload(set, &document.Payload)
+	if err != nil {
+		return nil, err
+	}
+	controlNumber := httpsControlNumber(documentID)
+	return &parsedInterchange{
+		controlNumber: controlNumber,
+		transactions: []parsedTransaction{{
+			set:           set,
+			controlNumber: controlNumber,
+			raw:           raw,
+			payload:       payload,
+			jsonDocument:  true,
+		}},
+	}, nil
+}
+
+func httpsDocumentPayload(
+	set edi.TransactionSet,
+	payload *edi.DocumentPayload,
+) (*edi.DocumentPayload, error) {
+	payload.TransactionSet = set
+	//nolint:exhaustive // HTTPS partners exchange the lifecycle documents only.
+	switch set {
+	case edi.TransactionSet204:
+		if payload.LoadTender == nil {
+			return nil, errors.New("inbound JSON load tender does not carry a loadTender payload")
+		}
+		normalizeHTTPSLoadTender(payload.LoadTender)
+		if payload.LoadTender.PurposeCode == "" {
+			payload.LoadTender.PurposeCode = payload.PurposeCode
+		}
+		if payload.LoadTender.PurposeCode == "" {
+			payload.LoadTender.PurposeCode = edi.LoadTenderPurposeOriginal
+		}
+		payload.PurposeCode = payload.LoadTender.PurposeCode
+	case edi.TransactionSet210:
+		if payload.FreightInvoice == nil {
+			return nil, errors.New("inbound JSON freight invoice does not carry an invoice payload")
+		}
+	case edi.TransactionSet214:
+		if payload.ShipmentStatus == nil {
+			return nil, errors.New(
+				"inbound JSON shipment status does not carry a shipmentStatus payload",
+			)
+		}
+	case edi.TransactionSet820:
+		if payload.RemittanceAdvice == nil {
+			return nil, errors.New(
+				"inbound JSON remittance advice does not carry a remittanceAdvice payload",
+			)
+		}
+	case edi.TransactionSet990:
+		if payload.TenderResponse == nil {
+			return nil, errors.New(
+				"inbound JSON tender response does not carry a tenderResponse payload",
+			)
+		}
+	default:
+		return nil, fmt.Errorf("transaction set %q is not accepted over HTTPS", set)
+	}
+	return payload, nil
+}
+
+// normalizeHTTPSLoadTender gives a JSON tender the same mapping placeholders
+// an X12 204 gets, so unmapped partner references surface in the mapping
+// review instead of failing the transfer.
+func normalizeHTTPSLoadTender(payload *edi.LoadTenderPayload) {
+	if payload.CustomerID.IsNil() {
+		payload.CustomerID = pulid.ID(inboundDefaultMappingKey)
+		payload.CustomerLabel = "Default customer for inbound tenders"
+	}
+	if payload.ServiceTypeID.IsNil() {
+		payload.ServiceTypeID = pulid.ID(inboundDefaultMappingKey)
+		payload.ServiceTypeLabel = "Default service type for inbound tenders"
+	}
+	if payload.FormulaTemplateID.IsNil() {
+		payload.FormulaTemplateID = pulid.ID(inboundDefaultMappingKey)
+		payload.FormulaTemplateLabel = "Default rating formula for inbound tenders"
+	}
+	if payload.RatingDetail == nil {
+		payload.RatingDetail = map[string]any{}
+	}
+	payload.RequiredMappingEntityIDs = map[edi.MappingEntityType][]pulid.ID{}
+
+	for moveIndex := range payload.Moves {
+		stops := payload.Moves[moveIndex].Stops
+		for stopIndex := range stops {
+			stop := &stops[stopIndex]
+			if stop.ScheduleType == "" {
+				stop.ScheduleType = inboundStopScheduleType()
+			}
+			if stop.LocationID.IsNil() {
+				stop.LocationID = edi.MappingSourceID(stringutils.FirstNonEmpty(
+					stop.LocationCode,
+					stop.LocationName,
+					fmt.Sprintf("STOP-%d", stop.Sequence),
+				))
+			}
+			if stop.LocationLabel == "" {
+				stop.LocationLabel = stringutils.FirstNonEmpty(
+					stop.LocationName,
+					string(stop.LocationID),
+				)
+			}
+			addRequiredMappingID(payload, edi.MappingEntityTypeLocation, stop.LocationID)
+		}
+	}
+	for index := range payload.Commodities {
+		commodity := &payload.Commodities[index]
+		if commodity.CommodityID.IsNil() {
+			commodity.CommodityID = edi.MappingSourceID(stringutils.FirstNonEmpty(
+				commodity.CommodityName,
+				commodity.CommodityDescription,
+				commodity.CommodityLabel,
+			))
+		}
+		if commodity.CommodityLabel == "" {
+			commodity.CommodityLabel = stringutils.FirstNonEmpty(
+				commodity.CommodityName,
+				commodity.CommodityDescription,
+				string(commodity.CommodityID),
+			)
+		}
+		addRequiredMappingID(payload, edi.MappingEntityTypeCommodity, commodity.CommodityID)
+	}
+
+	addRequiredMappingID(payload, edi.MappingEntityTypeCustomer, payload.CustomerID)
+	addRequiredMappingID(payload, edi.MappingEntityTypeServiceType, payload.ServiceTypeID)
+	addRequiredMappingID(
+		payload,
+		edi.MappingEntityTypeFormulaTemplate,
+		payload.FormulaTemplateID,
+	)
+}
+
+// httpsControlNumber keeps short document IDs readable and hashes longer ones
+// down to the column width; the full ID is kept on the inbound file's path.
+func httpsControlNumber(documentID string) string {
+	if len(documentID) <= httpsControlNumberLength {
+		return documentID
+	}
+	sum := sha256.Sum256([]byte(documentID))
+	return hex.EncodeToString(sum[:])[:httpsControlNumberLength]
+}
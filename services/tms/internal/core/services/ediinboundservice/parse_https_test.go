@@ -0,0 +1,119 @@
+package ediinboundservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const inboundHTTPSLoadTender = `{
+	"documentId": "TMS-LOAD-77",
+	"transactionSet": "204",
+	"payload": {
+		"loadTender": {
+			"bol": "BOL-77",
+			"moves": [{
+				"loaded": true,
+				"stops": [
+					{"type": "Pickup", "sequence": 1, "locationCode": "DC-01", "locationName": "Dallas DC"},
+					{"type": "Delivery", "sequence": 2, "locationName": "Houston Store"}
+				]
+			}],
+			"commodities": [{"commodityDescription": "Paper goods"}]
+		}
+	}
+}`
+
+func TestParseInterchangeHTTPSLoadTender(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := parseInterchange(inboundHTTPSLoadTender)
+
+	require.NoError(t, err)
+	assert.Equal(t, "TMS-LOAD-77", interchange.controlNumber)
+	require.Len(t, interchange.transactions, 1)
+	transaction := interchange.transactions[0]
+	assert.True(t, transaction.jsonDocument)
+	assert.Equal(t, edi.TransactionSet204, transaction.set)
+
+	payload := transaction.documentPayload()
+	require.NotNil(t, payload.LoadTender)
+	tender := payload.LoadTender
+	assert.Equal(t, edi.LoadTenderPurposeOriginal, tender.PurposeCode)
+	assert.Equal(t, "BOL-77", tender.BOL)
+	assert.Equal(t, pulid.ID(inboundDefaultMappingKey), tender.CustomerID)
+	require.Len(t, tender.Moves[0].Stops, 2)
+	assert.Equal(t, edi.MappingSourceID("DC-01"), tender.Moves[0].Stops[0].LocationID)
+	assert.Equal(t, edi.MappingSourceID("Houston Store"), tender.Moves[0].Stops[1].LocationID)
+	assert.ElementsMatch(t, []pulid.ID{
+		edi.MappingSourceID("DC-01"),
+		edi.MappingSourceID("Houston Store"),
+	}, tender.RequiredMappingEntityIDs[edi.MappingEntityTypeLocation])
+	assert.Equal(
+		t,
+		[]pulid.ID{edi.MappingSourceID("Paper goods")},
+		tender.RequiredMappingEntityIDs[edi.MappingEntityTypeCommodity],
+	)
+	assert.Contains(
+		t,
+		tender.RequiredMappingEntityIDs[edi.MappingEntityTypeCustomer],
+		pulid.ID(inboundDefaultMappingKey),
+	)
+}
+
+func TestParseInterchangeHTTPSTenderResponse(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := parseInterchange(`{
+		"documentId": "8f4c7d1e-7f39-4a8e-9e51-3f8c2b6d9a10",
+		"transactionSet": "990",
+		"payload": {"tenderResponse": {"bol": "SHIP-9", "responseCode": "d", "rejectionReason": "No capacity"}}
+	}`)
+
+	require.NoError(t, err)
+	assert.Len(t, interchange.controlNumber, httpsControlNumberLength)
+	details := parseTenderResponse(&interchange.transactions[0])
+	assert.Equal(t, "SHIP-9", details.shipmentRef)
+	assert.Equal(t, "D", details.reservationCode)
+	assert.Equal(t, "No capacity", details.remarks)
+}
+
+func TestParseInterchangeHTTPSRejectsIncompleteDocuments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		raw     string
+		message string
+	}{
+		{
+			name:    "missing document id",
+			raw:     `{"transactionSet": "214", "payload": {"shipmentStatus": {"bol": "B"}}}`,
+			message: "documentId",
+		},
+		{
+			name:    "missing payload",
+			raw:     `{"documentId": "D-1", "transactionSet": "214", "payload": {}}`,
+			message: "shipmentStatus",
+		},
+		{
+			name:    "unsupported set",
+			raw:     `{"documentId": "D-1", "transactionSet": "997", "payload": {}}`,
+			message: "not accepted over HTTPS",
+		},
+		{name: "malformed", raw: `{"documentId": `, message: "could not be parsed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseInterchange(tt.raw)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
@@ -0,0 +1,401 @@
+package ediinboundservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/customerpayment"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/invoice"
+	"github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/money"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/stringutils"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// remittancePlan is an 820 resolved against open customer invoices: the
+// applications that can be posted and the lines AR has to work by hand.
+type remittancePlan struct {
+	customerID   pulid.ID
+	applications []*services.CustomerPaymentApplicationInput
+	exceptions   []string
+}
+
+func parseRemittanceAdvice(t *parsedTransaction) edi.RemittanceAdvicePayload {
+	payload := edi.RemittanceAdvicePayload{}
+	if bpr := findSegment(t.segments, "BPR"); bpr != nil {
+		if amount, err := decimalFromX12(elementValue(bpr, 2)); err == nil {
+			payload.TotalAmount = amount
+		}
+		payload.PaymentMethod = strings.ToUpper(strings.TrimSpace(elementValue(bpr, 4)))
+		payload.PaymentDate = parseX12Timestamp(elementValue(bpr, 16), "")
+	}
+	if trn := findSegment(t.segments, "TRN"); trn != nil {
+		payload.TraceNumber = strings.TrimSpace(elementValue(trn, 2))
+	}
+	if cur := findSegment(t.segments, "CUR"); cur != nil {
+		payload.CurrencyCode = currencyCodeFromX12(elementValue(cur, 2))
+	}
+	for index := range t.segments {
+		segment := &t.segments[index]
+		switch segment.SegmentID {
+		case "N1":
+			if strings.EqualFold(strings.TrimSpace(elementValue(segment, 1)), "PR") {
+				payload.PayerName = strings.TrimSpace(elementValue(segment, 2))
+				payload.PayerID = strings.TrimSpace(elementValue(segment, 4))
+			}
+		case "DTM":
+			if payload.PaymentDate == 0 &&
+				strings.TrimSpace(elementValue(segment, 1)) == "097" {
+				payload.PaymentDate = parseX12Timestamp(
+					elementValue(segment, 2),
+					elementValue(segment, 3),
+				)
+			}
+		case "RMR":
+			payload.Lines = append(payload.Lines, parseRemittanceLine(
+				segment,
+				int64(len(payload.Lines)+1),
+			))
+		case "ADX":
+			if len(payload.Lines) == 0 {
+				continue
+			}
+			line := &payload.Lines[len(payload.Lines)-1]
+			if amount, err := decimalFromX12(elementValue(segment, 1)); err == nil {
+				line.AdjustmentAmount = amount
+			}
+			line.AdjustmentReasonCode = strings.TrimSpace(elementValue(segment, 2))
+		}
+	}
+	return payload
+}
+
+func parseRemittanceLine(rmr *edix12inspect.X12Segment, sequence int64) edi.RemittanceLine {
+	line := edi.RemittanceLine{
+		Sequence:           sequence,
+		ReferenceQualifier: strings.ToUpper(strings.TrimSpace(elementValue(rmr, 1))),
+		InvoiceNumber:      strings.TrimSpace(elementValue(rmr, 2)),
+	}
+	if amount, err := decimalFromX12(elementValue(rmr, 4)); err == nil {
+		line.PaidAmount = amount.Decimal
+	}
+	if amount, err := decimalFromX12(elementValue(rmr, 5)); err == nil {
+		line.InvoiceAmount = amount
+	}
+	if amount, err := decimalFromX12(elementValue(rmr, 6)); err == nil {
+		line.DiscountAmount = amount
+	}
+	return line
+}
+
+func remittancePaymentMethod(code string) customerpayment.Method {
+	switch strings.ToUpper(strings.TrimSpace(code)) {
+	case "ACH":
+		return customerpayment.MethodACH
+	case "CHK":
+		return customerpayment.MethodCheck
+	case "FWT", "FEW":
+		return customerpayment.MethodWire
+	default:
+		return customerpayment.MethodOther
+	}
+}
+
+// routeRemittanceAdvice posts an inbound 820 as a customer payment applied
+// across the invoices it names, then imports the matching bank receipt.
+// Short-pays and lines that cannot be applied send the receipt to the
+// reconciliation exception queue instead of being written off.
+func (s *Service) routeRemittanceAdvice(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	partner *edi.EDIPartner,
+	message *edi.EDIMessage,
+	transaction *parsedTransaction,
+) ([]string, error) {
+	payload := message.PayloadSnapshot.RemittanceAdvice
+	if payload == nil {
+		return nil, fmt.Errorf(
+			"remittance advice %s/%s could not be parsed into a remittance payload",
+			transaction.set,
+			transaction.controlNumber,
+		)
+	}
+	if !partner.EnabledForInbound {
+		return []string{fmt.Sprintf(
+			"remittance advice %s/%s recorded without processing: partner %s is disabled for inbound",
+			transaction.set,
+			transaction.controlNumber,
+			partner.Code,
+		)}, nil
+	}
+	if s.db == nil || s.customerPayments == nil || s.bankReceipts == nil ||
+		s.invoiceRepo == nil || s.userRepo == nil {
+		return []string{fmt.Sprintf(
+			"remittance advice %s/%s recorded without processing: customer payment posting is not configured",
+			transaction.set,
+			transaction.controlNumber,
+		)}, nil
+	}
+	if !payload.TotalAmount.Valid || !payload.TotalAmount.Decimal.IsPositive() {
+		return []string{fmt.Sprintf(
+			"remittance advice %s/%s does not carry a BPR payment amount",
+			transaction.set,
+			transaction.controlNumber,
+		)}, nil
+	}
+
+	systemUser, err := s.userRepo.GetSystemUser(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	tenantInfo := pagination.TenantInfo{
+		OrgID:  file.OrganizationID,
+		BuID:   file.BusinessUnitID,
+		UserID: systemUser.ID,
+	}
+	actor := &services.RequestActor{
+		PrincipalType:  services.PrincipalTypeUser,
+		PrincipalID:    systemUser.ID,
+		UserID:         systemUser.ID,
+		BusinessUnitID: file.BusinessUnitID,
+		OrganizationID: file.OrganizationID,
+	}
+
+	amountMinor := money.MinorUnits(payload.TotalAmount.Decimal)
+	plan, err := s.planRemittance(ctx, tenantInfo, partner, payload, amountMinor)
+	if err != nil {
+		return nil, err
+	}
+	paymentDate := payload.PaymentDate
+	if paymentDate == 0 {
+		paymentDate = timeutils.NowUnix()
+	}
+	memo := fmt.Sprintf(
+		"EDI 820 remittance %s from %s",
+		transaction.controlNumber,
+		stringutils.FirstNonEmpty(payload.PayerName, partner.Name),
+	)
+
+	// The payment and the bank receipt commit together. A retried 820 after a
+	// failed import would otherwise post and apply the payment a second time.
+	err = s.db.WithTx(ctx, ports.TxOptions{}, func(txCtx context.Context, _ bun.Tx) error {
+		return s.postRemittance(txCtx, file, payload, plan, remittancePosting{
+			tenantInfo:  tenantInfo,
+			actor:       actor,
+			amountMinor: amountMinor,
+			paymentDate: paymentDate,
+			memo:        memo,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// remittancePosting carries what routeRemittanceAdvice resolved for the
+// payment and bank receipt it writes.
+type remittancePosting struct {
+	tenantInfo  pagination.TenantInfo
+	actor       *services.RequestActor
+	amountMinor int64
+	paymentDate int64
+	memo        string
+}
+
+func (s *Service) postRemittance(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	payload *edi.RemittanceAdvicePayload,
+	plan *remittancePlan,
+	posting remittancePosting,
+) error {
+	if plan.customerID.IsNil() {
+		plan.exceptions = append(
+			plan.exceptions,
+			"No customer could be resolved for the remittance",
+		)
+	} else {
+		_, err := s.customerPayments.PostAndApply(ctx, &services.PostCustomerPaymentRequest{
+			CustomerID:      plan.customerID,
+			PaymentDate:     posting.paymentDate,
+			AccountingDate:  posting.paymentDate,
+			AmountMinor:     posting.amountMinor,
+			PaymentMethod:   remittancePaymentMethod(payload.PaymentMethod),
+			ReferenceNumber: payload.TraceNumber,
+			Memo:            posting.memo,
+			CurrencyCode:    payload.CurrencyCode,
+			Applications:    plan.applications,
+			TenantInfo:      posting.tenantInfo,
+		}, posting.actor)
+		if err != nil {
+			if !errortypes.IsError(err) && !errortypes.IsBusinessError(err) {
+				return err
+			}
+			s.l.Info(
+				"EDI remittance could not be posted as a customer payment",
+				zap.String("fileId", file.ID.String()),
+				zap.String("traceNumber", payload.TraceNumber),
+				zap.Error(err),
+			)
+			plan.exceptions = append(
+				plan.exceptions,
+				"Customer payment could not be posted: "+err.Error(),
+			)
+		}
+	}
+
+	_, err := s.bankReceipts.Import(ctx, &services.ImportBankReceiptRequest{
+		ReceiptDate:     posting.paymentDate,
+		AmountMinor:     posting.amountMinor,
+		ReferenceNumber: payload.TraceNumber,
+		Memo:            posting.memo,
+		TenantInfo:      posting.tenantInfo,
+		ExceptionReason: strings.Join(plan.exceptions, "; "),
+	}, posting.actor)
+	return err
+}
+
+func (s *Service) planRemittance(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	partner *edi.EDIPartner,
+	payload *edi.RemittanceAdvicePayload,
+	amountMinor int64,
+) (*remittancePlan, error) {
+	plan := &remittancePlan{
+		customerID:   partner.CustomerID,
+		applications: make([]*services.CustomerPaymentApplicationInput, 0, len(payload.Lines)),
+	}
+	seen := make(map[pulid.ID]struct{}, len(payload.Lines))
+	remainingMinor := amountMinor
+	for index := range payload.Lines {
+		line := &payload.Lines[index]
+		if line.InvoiceNumber == "" {
+			plan.exceptions = append(plan.exceptions, fmt.Sprintf(
+				"Remittance line %d does not reference an invoice number",
+				line.Sequence,
+			))
+			continue
+		}
+		inv, err := s.invoiceRepo.GetByNumber(ctx, repositories.GetInvoiceByNumberRequest{
+			Number:     line.InvoiceNumber,
+			TenantInfo: tenantInfo,
+		})
+		if err != nil {
+			if errortypes.IsNotFoundError(err) {
+				plan.exceptions = append(plan.exceptions, fmt.Sprintf(
+					"Invoice %s was not found",
+					line.InvoiceNumber,
+				))
+				continue
+			}
+			return nil, err
+		}
+		if reason := remittanceLineException(plan, inv, seen); reason != "" {
+			plan.exceptions = append(plan.exceptions, reason)
+			continue
+		}
+		seen[inv.ID] = struct{}{}
+		if plan.customerID.IsNil() {
+			plan.customerID = inv.CustomerID
+		}
+
+		paidMinor := money.MinorUnits(line.PaidAmount)
+		openMinor := inv.OpenBalanceMinor()
+		appliedMinor := min(paidMinor, openMinor, remainingMinor)
+		settled, unexplained := remittanceSettledMinor(line, inv.CurrencyCode)
+		for _, reason := range unexplained {
+			plan.exceptions = append(plan.exceptions, fmt.Sprintf(
+				"Invoice %s %s",
+				inv.Number,
+				reason,
+			))
+		}
+		if settled < openMinor {
+			plan.exceptions = append(plan.exceptions, fmt.Sprintf(
+				"Invoice %s was short-paid by %s",
+				inv.Number,
+				money.FormatMinor(openMinor-settled, inv.CurrencyCode),
+			))
+		}
+		if appliedMinor < min(paidMinor, openMinor) {
+			plan.exceptions = append(plan.exceptions, fmt.Sprintf(
+				"Invoice %s could not be fully applied because the remittance lines exceed the payment amount",
+				inv.Number,
+			))
+		}
+		if appliedMinor <= 0 {
+			continue
+		}
+		remainingMinor -= appliedMinor
+		plan.applications = append(plan.applications, &services.CustomerPaymentApplicationInput{
+			InvoiceID:          inv.ID,
+			AppliedAmountMinor: appliedMinor,
+		})
+	}
+	return plan, nil
+}
+
+// remittanceSettledMinor is how much of an invoice a remittance line accounts
+// for: the amount paid plus the discount taken, less the signed ADX
+// adjustment. A negative adjustment is a deduction the payer took and a
+// positive one is an amount added on top of the invoice, so neither is counted
+// by its size. Only the paid amount is applied as cash; an explained discount
+// or deduction stays on the invoice for AR to write off. Discounts and
+// deductions the payer does not explain are not counted as settled and are
+// returned as exception reasons instead.
+func remittanceSettledMinor(line *edi.RemittanceLine, currencyCode string) (int64, []string) {
+	settled := money.MinorUnits(line.PaidAmount)
+	var unexplained []string
+	if line.DiscountAmount.Valid && !line.DiscountAmount.Decimal.IsZero() {
+		if line.DiscountAmount.Decimal.IsPositive() {
+			settled += money.MinorUnits(line.DiscountAmount.Decimal)
+		} else {
+			unexplained = append(unexplained, fmt.Sprintf(
+				"carries a negative discount of %s",
+				money.FormatMinor(-money.MinorUnits(line.DiscountAmount.Decimal), currencyCode),
+			))
+		}
+	}
+	if line.AdjustmentAmount.Valid && !line.AdjustmentAmount.Decimal.IsZero() {
+		if line.AdjustmentAmount.Decimal.IsNegative() && line.AdjustmentReasonCode == "" {
+			unexplained = append(unexplained, fmt.Sprintf(
+				"carries a deduction of %s without an adjustment reason",
+				money.FormatMinor(-money.MinorUnits(line.AdjustmentAmount.Decimal), currencyCode),
+			))
+		} else {
+			settled -= money.MinorUnits(line.AdjustmentAmount.Decimal)
+		}
+	}
+	return settled, unexplained
+}
+
+func remittanceLineException(
+	plan *remittancePlan,
+	inv *invoice.Invoice,
+	seen map[pulid.ID]struct{},
+) string {
+	switch {
+	case !plan.customerID.IsNil() && inv.CustomerID != plan.customerID:
+		return fmt.Sprintf("Invoice %s belongs to a different customer", inv.Number)
+	case inv.Status != invoice.StatusPosted:
+		return fmt.Sprintf("Invoice %s is not posted", inv.Number)
+	case inv.OpenBalanceMinor() <= 0:
+		return fmt.Sprintf("Invoice %s has no open balance", inv.Number)
+	}
+	if _, ok := seen[inv.ID]; ok {
+		return fmt.Sprintf("Invoice %s appears on more than one remittance line", inv.Number)
+	}
+	return ""
+}
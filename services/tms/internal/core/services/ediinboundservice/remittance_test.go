@@ -0,0 +1,439 @@
+package ediinboundservice
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/bankreceipt"
+	"github.com/emoss08/trenova/internal/core/domain/billingqueue"
+	"github.com/emoss08/trenova/internal/core/domain/customerpayment"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/invoice"
+	"github.com/emoss08/trenova/internal/core/domain/tenant"
+	"github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/infrastructure/observability/metrics"
+	"github.com/emoss08/trenova/internal/testutil/dbtest"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+const inbound820 = "ISA*00*          *00*          *ZZ*PARTNER        *ZZ*TRENOVA        " +
+	"*261016*1200*^*00401*000000301*0*P*>~" +
+	"GS*RA*PARTNER*TRENOVA*20261016*1200*301*X*004010~" +
+	"ST*820*0001~" +
+	"BPR*C*1550.00*C*ACH*CTX*01*021000021*DA*123456789*1234567890**01*031000053*DA*987654321*20261016~" +
+	"TRN*1*ACH-7781*1234567890~" +
+	"CUR*PR*USD~" +
+	"N1*PR*Acme Shipping*91*ACME01~" +
+	"N1*PE*Trenova~" +
+	"ENT*1~" +
+	"RMR*IV*INV-1**1000.00*1000.00~" +
+	"RMR*IV*INV-2**450.00*500.00~" +
+	"ADX*-50.00*CS~" +
+	"RMR*IV*INV-9**100.00*100.00~" +
+	"SE*12*0001~" +
+	"GE*1*301~" +
+	"IEA*1*000000301~"
+
+type remittanceTxKey struct{}
+
+// remittanceTxConnection marks the context it hands to the transaction body so
+// tests can tell which calls ran inside it.
+type remittanceTxConnection struct {
+	dbtest.NopConnection
+}
+
+func (remittanceTxConnection) WithTx(
+	ctx context.Context,
+	_ ports.TxOptions,
+	fn func(context.Context, bun.Tx) error,
+) error {
+	return fn(context.WithValue(ctx, remittanceTxKey{}, true), bun.Tx{})
+}
+
+func inRemittanceTx(ctx context.Context) bool {
+	inTx, _ := ctx.Value(remittanceTxKey{}).(bool)
+	return inTx
+}
+
+type remittanceRouteFixture struct {
+	service     *Service
+	file        *edi.EDIInboundFile
+	partner     *edi.EDIPartner
+	customerID  pulid.ID
+	invoices    map[string]*invoice.Invoice
+	payments    *mocks.MockCustomerPaymentService
+	receipts    *mocks.MockBankReceiptService
+	transaction *parsedTransaction
+	message     *edi.EDIMessage
+}
+
+func newRemittanceRouteFixture(t *testing.T, raw string) *remittanceRouteFixture {
+	t.Helper()
+
+	orgID := pulid.MustNew("org_")
+	buID := pulid.MustNew("bu_")
+	customerID := pulid.MustNew("cus_")
+	fixture := &remittanceRouteFixture{
+		file: &edi.EDIInboundFile{
+			ID:             pulid.MustNew("ediinf_"),
+			OrganizationID: orgID,
+			BusinessUnitID: buID,
+		},
+		partner: &edi.EDIPartner{
+			ID:                pulid.MustNew("edip_"),
+			OrganizationID:    orgID,
+			BusinessUnitID:    buID,
+			Code:              "ACME",
+			Name:              "Acme Shipping",
+			CustomerID:        customerID,
+			EnabledForInbound: true,
+		},
+		customerID: customerID,
+		invoices:   map[string]*invoice.Invoice{},
+		payments:   mocks.NewMockCustomerPaymentService(t),
+		receipts:   mocks.NewMockBankReceiptService(t),
+	}
+
+	interchange, err := parseInterchange(raw)
+	require.NoError(t, err)
+	require.Len(t, interchange.transactions, 1)
+	fixture.transaction = &interchange.transactions[0]
+	fixture.message = &edi.EDIMessage{PayloadSnapshot: fixture.transaction.documentPayload()}
+
+	invoiceRepo := mocks.NewMockInvoiceRepository(t)
+	invoiceRepo.EXPECT().
+		GetByNumber(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req repositories.GetInvoiceByNumberRequest) (*invoice.Invoice, error) {
+			if inv, ok := fixture.invoices[req.Number]; ok {
+				return inv, nil
+			}
+			return nil, errortypes.NewNotFoundError("Invoice")
+		}).
+		Maybe()
+	userRepo := mocks.NewMockUserRepository(t)
+	userRepo.EXPECT().
+		GetSystemUser(mock.Anything, []string{"id"}).
+		Return(&tenant.User{ID: pulid.MustNew("usr_")}, nil).
+		Maybe()
+
+	fixture.service = &Service{
+		l:                zap.NewNop(),
+		metrics:          metrics.NewEDI(nil, zap.NewNop(), false),
+		db:               dbtest.NopConnection{},
+		invoiceRepo:      invoiceRepo,
+		userRepo:         userRepo,
+		customerPayments: fixture.payments,
+		bankReceipts:     fixture.receipts,
+	}
+	return fixture
+}
+
+func (f *remittanceRouteFixture) addInvoice(number string, totalMinor int64) {
+	f.invoices[number] = &invoice.Invoice{
+		ID:               pulid.MustNew("inv_"),
+		Number:           number,
+		CustomerID:       f.customerID,
+		Status:           invoice.StatusPosted,
+		BillType:         billingqueue.BillTypeInvoice,
+		CurrencyCode:     "USD",
+		TotalAmountMinor: totalMinor,
+	}
+}
+
+func (f *remittanceRouteFixture) route(t *testing.T) []string {
+	t.Helper()
+
+	warnings, err := f.service.routeRemittanceAdvice(
+		t.Context(),
+		f.file,
+		f.partner,
+		f.message,
+		f.transaction,
+	)
+	require.NoError(t, err)
+	return warnings
+}
+
+func TestParseInterchange_RemittanceAdvice(t *testing.T) {
+	t.Parallel()
+
+	interchange, err := parseInterchange(inbound820)
+
+	require.NoError(t, err)
+	require.Len(t, interchange.transactions, 1)
+	transaction := &interchange.transactions[0]
+	assert.Equal(t, edi.TransactionSet820, transaction.set)
+	assert.Equal(t, "RA", transaction.functionalGroupID)
+
+	payload := transaction.documentPayload()
+	require.NotNil(t, payload.RemittanceAdvice)
+	remittance := payload.RemittanceAdvice
+	assert.Equal(t, "ACH-7781", remittance.TraceNumber)
+	assert.Equal(t, "ACH", remittance.PaymentMethod)
+	assert.Equal(t, "USD", remittance.CurrencyCode)
+	assert.Equal(t, "Acme Shipping", remittance.PayerName)
+	assert.Equal(t, "ACME01", remittance.PayerID)
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC).Unix(), remittance.PaymentDate)
+	require.True(t, remittance.TotalAmount.Valid)
+	assert.Equal(t, "1550", remittance.TotalAmount.Decimal.String())
+	require.Len(t, remittance.Lines, 3)
+	assert.Equal(t, "IV", remittance.Lines[0].ReferenceQualifier)
+	assert.Equal(t, "INV-1", remittance.Lines[0].InvoiceNumber)
+	assert.Equal(t, "450", remittance.Lines[1].PaidAmount.String())
+	assert.Equal(t, "500", remittance.Lines[1].InvoiceAmount.Decimal.String())
+	assert.Equal(t, "-50", remittance.Lines[1].AdjustmentAmount.Decimal.String())
+	assert.Equal(t, "CS", remittance.Lines[1].AdjustmentReasonCode)
+	assert.Equal(t, int64(3), remittance.Lines[2].Sequence)
+}
+
+func TestRouteRemittanceAdvice_AppliesPaymentAndFlagsExceptions(t *testing.T) {
+	t.Parallel()
+
+	fixture := newRemittanceRouteFixture(t, inbound820)
+	fixture.addInvoice("INV-1", 100000)
+	fixture.addInvoice("INV-2", 52000)
+
+	var posted *services.PostCustomerPaymentRequest
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.PostCustomerPaymentRequest, actor *services.RequestActor) (*customerpayment.Payment, error) {
+			require.False(t, actor.UserID.IsNil())
+			posted = req
+			return &customerpayment.Payment{ID: pulid.MustNew("cpay_")}, nil
+		}).
+		Once()
+	var imported *services.ImportBankReceiptRequest
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			imported = req
+			return nil, nil
+		}).
+		Once()
+
+	warnings := fixture.route(t)
+
+	assert.Empty(t, warnings)
+	require.NotNil(t, posted)
+	assert.Equal(t, fixture.customerID, posted.CustomerID)
+	assert.Equal(t, int64(155000), posted.AmountMinor)
+	assert.Equal(t, customerpayment.MethodACH, posted.PaymentMethod)
+	assert.Equal(t, "ACH-7781", posted.ReferenceNumber)
+	require.Len(t, posted.Applications, 2)
+	assert.Equal(t, fixture.invoices["INV-1"].ID, posted.Applications[0].InvoiceID)
+	assert.Equal(t, int64(100000), posted.Applications[0].AppliedAmountMinor)
+	assert.Equal(t, fixture.invoices["INV-2"].ID, posted.Applications[1].InvoiceID)
+	assert.Equal(t, int64(45000), posted.Applications[1].AppliedAmountMinor)
+	assert.Zero(t, posted.Applications[1].ShortPayAmountMinor)
+
+	require.NotNil(t, imported)
+	assert.Equal(t, int64(155000), imported.AmountMinor)
+	assert.Equal(t, "ACH-7781", imported.ReferenceNumber)
+	assert.Contains(t, imported.ExceptionReason, "Invoice INV-2 was short-paid by 20.00 USD")
+	assert.Contains(t, imported.ExceptionReason, "Invoice INV-9 was not found")
+}
+
+func TestRouteRemittanceAdvice_CleanRemittanceLeavesMatchingToReconciliation(t *testing.T) {
+	t.Parallel()
+
+	fixture := newRemittanceRouteFixture(t, inbound820)
+	fixture.addInvoice("INV-1", 100000)
+	fixture.addInvoice("INV-2", 45000)
+	fixture.addInvoice("INV-9", 10000)
+
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		Return(&customerpayment.Payment{}, nil).
+		Once()
+	var imported *services.ImportBankReceiptRequest
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			imported = req
+			return nil, nil
+		}).
+		Once()
+
+	fixture.route(t)
+
+	require.NotNil(t, imported)
+	assert.Empty(t, imported.ExceptionReason)
+}
+
+func TestRouteRemittanceAdvice_DiscountTakenIsNotAShortPayment(t *testing.T) {
+	t.Parallel()
+
+	raw := strings.Replace(inbound820, "RMR*IV*INV-1**1000.00*1000.00~",
+		"RMR*IV*INV-1**980.00*1000.00*20.00~", 1)
+	fixture := newRemittanceRouteFixture(t, raw)
+	fixture.addInvoice("INV-1", 100000)
+	fixture.addInvoice("INV-2", 50000)
+	fixture.addInvoice("INV-9", 10000)
+
+	var posted *services.PostCustomerPaymentRequest
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.PostCustomerPaymentRequest, _ *services.RequestActor) (*customerpayment.Payment, error) {
+			posted = req
+			return &customerpayment.Payment{}, nil
+		}).
+		Once()
+	var imported *services.ImportBankReceiptRequest
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			imported = req
+			return nil, nil
+		}).
+		Once()
+
+	fixture.route(t)
+
+	require.NotNil(t, posted)
+	require.Len(t, posted.Applications, 3)
+	assert.Equal(t, int64(98000), posted.Applications[0].AppliedAmountMinor,
+		"only the cash is applied; the discount stays on the invoice")
+	require.NotNil(t, imported)
+	assert.Empty(t, imported.ExceptionReason,
+		"the discount on INV-1 and the deduction on INV-2 account for both shortfalls")
+}
+
+func TestRouteRemittanceAdvice_PostingFailureBecomesReceiptException(t *testing.T) {
+	t.Parallel()
+
+	fixture := newRemittanceRouteFixture(t, inbound820)
+	fixture.addInvoice("INV-1", 100000)
+	fixture.invoices["INV-2"] = &invoice.Invoice{
+		ID:         pulid.MustNew("inv_"),
+		Number:     "INV-2",
+		CustomerID: pulid.MustNew("cus_"),
+		Status:     invoice.StatusPosted,
+	}
+
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errortypes.NewValidationError(
+			"accountingDate",
+			errortypes.ErrInvalid,
+			"Accounting date must fall within a fiscal period",
+		)).
+		Once()
+	var imported *services.ImportBankReceiptRequest
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			imported = req
+			return nil, nil
+		}).
+		Once()
+
+	fixture.route(t)
+
+	require.NotNil(t, imported)
+	assert.Contains(t, imported.ExceptionReason, "Invoice INV-2 belongs to a different customer")
+	assert.Contains(t, imported.ExceptionReason, "Customer payment could not be posted")
+}
+
+func TestRouteRemittanceAdvice_PostsPaymentAndReceiptInOneTransaction(t *testing.T) {
+	t.Parallel()
+
+	fixture := newRemittanceRouteFixture(t, inbound820)
+	fixture.service.db = remittanceTxConnection{}
+	fixture.addInvoice("INV-1", 100000)
+
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ *services.PostCustomerPaymentRequest, _ *services.RequestActor) (*customerpayment.Payment, error) {
+			assert.True(t, inRemittanceTx(ctx), "the payment must post inside the remittance transaction")
+			return &customerpayment.Payment{}, nil
+		}).
+		Once()
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			assert.True(t, inRemittanceTx(ctx), "the receipt must import inside the remittance transaction")
+			return nil, errors.New("bank receipt insert failed")
+		}).
+		Once()
+
+	_, err := fixture.service.routeRemittanceAdvice(
+		t.Context(),
+		fixture.file,
+		fixture.partner,
+		fixture.message,
+		fixture.transaction,
+	)
+
+	require.Error(t, err, "a failed import rolls the posted payment back and leaves the 820 to be retried")
+}
+
+func TestRouteRemittanceAdvice_UnexplainedDeductionIsAnException(t *testing.T) {
+	t.Parallel()
+
+	raw := strings.Replace(inbound820, "ADX*-50.00*CS~", "ADX*-50.00~", 1)
+	fixture := newRemittanceRouteFixture(t, raw)
+	fixture.addInvoice("INV-1", 100000)
+	fixture.addInvoice("INV-2", 50000)
+	fixture.addInvoice("INV-9", 10000)
+
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		Return(&customerpayment.Payment{}, nil).
+		Once()
+	var imported *services.ImportBankReceiptRequest
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			imported = req
+			return nil, nil
+		}).
+		Once()
+
+	fixture.route(t)
+
+	require.NotNil(t, imported)
+	assert.Contains(t, imported.ExceptionReason,
+		"Invoice INV-2 carries a deduction of 50.00 USD without an adjustment reason")
+	assert.Contains(t, imported.ExceptionReason, "Invoice INV-2 was short-paid by 50.00 USD")
+}
+
+func TestRouteRemittanceAdvice_AdditiveAdjustmentDoesNotSettleTheInvoice(t *testing.T) {
+	t.Parallel()
+
+	raw := strings.Replace(inbound820, "ADX*-50.00*CS~", "ADX*50.00*CS~", 1)
+	fixture := newRemittanceRouteFixture(t, raw)
+	fixture.addInvoice("INV-1", 100000)
+	fixture.addInvoice("INV-2", 50000)
+	fixture.addInvoice("INV-9", 10000)
+
+	fixture.payments.EXPECT().
+		PostAndApply(mock.Anything, mock.Anything, mock.Anything).
+		Return(&customerpayment.Payment{}, nil).
+		Once()
+	var imported *services.ImportBankReceiptRequest
+	fixture.receipts.EXPECT().
+		Import(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, req *services.ImportBankReceiptRequest, _ *services.RequestActor) (*bankreceipt.BankReceipt, error) {
+			imported = req
+			return nil, nil
+		}).
+		Once()
+
+	fixture.route(t)
+
+	require.NotNil(t, imported)
+	assert.Contains(t, imported.ExceptionReason, "Invoice INV-2 was short-paid by 100.00 USD",
+		"an added amount is not a deduction and does not count toward the invoice")
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/internal/core/services/ediservice"
@@ -30,6 +31,7 @@ type Params struct {
 	fx.In
 
 	Logger              *zap.Logger
+	DB                  ports.DBConnection
 	InboundFileRepo     repositories.EDIInboundFileRepository
 	ProfileRepo         repositories.EDICommunicationProfileRepository
 	PartnerRepo         repositories.EDIPartnerRepository
@@ -38,9 +40,14 @@ type Params struct {
 	DocumentProfileRepo repositories.EDIPartnerDocumentProfileRepository
 	TransferRepo        repositories.EDILoadTenderTransferRepository
 	TenderRecipientRepo repositories.EDITenderRecipientRepository
-	TenderRepo          repositories.TenderRepository      `optional:"true"`
-	TenderResponses     services.TenderResponseRecorder    `optional:"true"`
-	InvoiceMatcher      services.CarrierInvoiceAutoMatcher `optional:"true"`
+	TenderRepo          repositories.TenderRepository                 `optional:"true"`
+	TenderResponses     services.TenderResponseRecorder               `optional:"true"`
+	InvoiceMatcher      services.CarrierInvoiceAutoMatcher            `optional:"true"`
+	InvoiceRepo         repositories.InvoiceRepository                `optional:"true"`
+	UserRepo            repositories.UserRepository                   `optional:"true"`
+	CustomerPayments    services.CustomerPaymentService               `optional:"true"`
+	BankReceipts        services.BankReceiptService                   `optional:"true"`
+	GuideRepo           repositories.EDIImplementationGuideRepository `optional:"true"`
 	EDIService          *ediservice.Service
 	Transport           services.EDITransportDispatcher
 	WorkflowStarter     services.WorkflowStarter
@@ -49,6 +56,7 @@ type Params struct {
 
 type Service struct {
 	l                   *zap.Logger
+	db                  ports.DBConnection
 	inboundFileRepo     repositories.EDIInboundFileRepository
 	profileRepo         repositories.EDICommunicationProfileRepository
 	partnerRepo         repositories.EDIPartnerRepository
@@ -60,6 +68,11 @@ type Service struct {
 	tenderRepo          repositories.TenderRepository
 	tenderResponses     services.TenderResponseRecorder
 	invoiceMatcher      services.CarrierInvoiceAutoMatcher
+	invoiceRepo         repositories.InvoiceRepository
+	userRepo            repositories.UserRepository
+	customerPayments    services.CustomerPaymentService
+	bankReceipts        services.BankReceiptService
+	guideRepo           repositories.EDIImplementationGuideRepository
 	ediService          *ediservice.Service
 	transport           services.EDITransportDispatcher
 	workflowStarter     services.WorkflowStarter
@@ -74,6 +87,7 @@ func New(p Params) *Service {
 	return &Service{
 		l:                   p.Logger.Named("service.edi-inbound"),
 		metrics:             ediMetrics,
+		db:                  p.DB,
 		inboundFileRepo:     p.InboundFileRepo,
 		profileRepo:         p.ProfileRepo,
 		partnerRepo:         p.PartnerRepo,
@@ -85,6 +99,11 @@ func New(p Params) *Service {
 		tenderRepo:          p.TenderRepo,
 		tenderResponses:     p.TenderResponses,
 		invoiceMatcher:      p.InvoiceMatcher,
+		invoiceRepo:         p.InvoiceRepo,
+		userRepo:            p.UserRepo,
+		customerPayments:    p.CustomerPayments,
+		bankReceipts:        p.BankReceipts,
+		guideRepo:           p.GuideRepo,
 		ediService:          p.EDIService,
 		transport:           p.Transport,
 		workflowStarter:     p.WorkflowStarter,
@@ -345,18 +364,21 @@ func (s *Service) processInboundFile(
 	file.TransactionCount = len(interchange.transactions)
 	file.Status = edi.InboundFileStatusParsed
 	file.FailureReason = ""
-	file, err = s.inboundFileRepo.UpdateInboundFile(ctx, file)
+	parsed := file
+	file, err = s.inboundFileRepo.UpdateInboundFile(ctx, parsed)
 	if err != nil {
 		if dberror.IsUniqueConstraintViolation(err) {
-			file.Status = edi.InboundFileStatusDuplicate
-			file.FailureReason = fmt.Sprintf(
+			parsed.Status = edi.InboundFileStatusDuplicate
+			parsed.FailureReason = fmt.Sprintf(
 				"interchange control number %s was already processed for this partner",
 				interchange.controlNumber,
 			)
-			return s.inboundFileRepo.UpdateInboundFile(ctx, file)
+			s.recordInterchangeControlNumber(ctx, parsed, interchange, true)
+			return s.inboundFileRepo.UpdateInboundFile(ctx, parsed)
 		}
 		return nil, err
 	}
+	s.recordInterchangeControlNumber(ctx, file, interchange, false)
 
 	partner, err := s.partnerRepo.GetByID(ctx, repositories.GetEDIPartnerByIDRequest{
 		ID:         file.EDIPartnerID,
@@ -373,8 +395,13 @@ func (s *Service) processInboundFile(
 	warnings := make([]string, 0)
 	failures := 0
 	processed := 0
+	ledgeredGroups := make(map[string]struct{})
 	for index := range interchange.transactions {
 		transaction := &interchange.transactions[index]
+		if _, ok := ledgeredGroups[transaction.groupControlNumber]; !ok {
+			ledgeredGroups[transaction.groupControlNumber] = struct{}{}
+			s.recordGroupControlNumber(ctx, file, transaction)
+		}
 		outcome := s.processTransaction(ctx, file, partner, transaction)
 		warnings = append(warnings, outcome.warnings...)
 		if outcome.err != nil {
@@ -462,12 +489,45 @@ func (s *Service) processTransaction(
 	partner *edi.EDIPartner,
 	transaction *parsedTransaction,
 ) transactionOutcome {
-	message, err := s.recordInboundMessage(ctx, file, transaction)
+	claim, err := s.claimTransaction(ctx, file, transaction)
+	if err != nil {
+		return transactionOutcome{err: err}
+	}
+	outcome := s.routeTransaction(ctx, file, partner, transaction)
+	s.settleTransaction(ctx, claim, outcome.err)
+	return outcome
+}
+
+func (s *Service) routeTransaction(
+	ctx context.Context,
+	file *edi.EDIInboundFile,
+	partner *edi.EDIPartner,
+	transaction *parsedTransaction,
+) transactionOutcome {
+	guideDiagnostics, err := s.validateImplementationGuide(ctx, file, transaction)
+	if err != nil {
+		return transactionOutcome{err: err}
+	}
+	// A failed transform still records the message with the document as
+	// parsed so the partner's raw values are visible when fixing the script.
+	transformErr := applyInboundTransform(ctx, partner, transaction)
+	message, err := s.recordInboundMessage(ctx, file, transaction, guideDiagnostics)
 	if err != nil {
 		return transactionOutcome{err: err}
 	}
 	outcome := transactionOutcome{message: message}
-	switch transaction.set {
+	if len(transaction.rejections) > 0 {
+		outcome.err = fmt.Errorf(
+			"transaction failed implementation guide validation with %d error(s)",
+			len(transaction.rejections),
+		)
+		return outcome
+	}
+	if transformErr != nil {
+		outcome.err = transformErr
+		return outcome
+	}
+	switch transaction.set.X12Equivalent() {
 	case edi.TransactionSet997, edi.TransactionSet999:
 		outcome.warnings = s.routeAcknowledgment(ctx, file, partner, message, transaction)
 	case edi.TransactionSet990:
@@ -484,6 +544,14 @@ func (s *Service) processTransaction(
 			message,
 			transaction,
 		)
+	case edi.TransactionSet820:
+		outcome.warnings, outcome.err = s.routeRemittanceAdvice(
+			ctx,
+			file,
+			partner,
+			message,
+			transaction,
+		)
 	default:
 		outcome.warnings = []string{fmt.Sprintf(
 			"transaction %s/%s recorded without processing: unsupported transaction set",
@@ -498,6 +566,7 @@ func (s *Service) recordInboundMessage(
 	ctx context.Context,
 	file *edi.EDIInboundFile,
 	transaction *parsedTransaction,
+	guideDiagnostics []*edi.EDIMessageValidationError,
 ) (*edi.EDIMessage, error) {
 	documentType, err := s.inboundDocumentType(ctx, transaction.set)
 	if err != nil {
@@ -505,15 +574,18 @@ func (s *Service) recordInboundMessage(
 	}
 	payload := transaction.documentPayload()
 	message := &edi.EDIMessage{
-		BusinessUnitID:           file.BusinessUnitID,
-		OrganizationID:           file.OrganizationID,
-		EDIPartnerID:             file.EDIPartnerID,
-		DocumentTypeID:           documentType.ID,
-		InboundFileID:            file.ID,
-		Direction:                edi.DocumentDirectionInbound,
-		Standard:                 edi.EDIStandardX12,
-		TransactionSet:           transaction.set,
-		X12Version:               documentType.DefaultVersion,
+		BusinessUnitID: file.BusinessUnitID,
+		OrganizationID: file.OrganizationID,
+		EDIPartnerID:   file.EDIPartnerID,
+		DocumentTypeID: documentType.ID,
+		InboundFileID:  file.ID,
+		Direction:      edi.DocumentDirectionInbound,
+		Standard:       transaction.set.Standard(),
+		TransactionSet: transaction.set,
+		X12Version: stringutils.FirstNonEmpty(
+			transaction.x12Version,
+			documentType.DefaultVersion,
+		),
 		Status:                   edi.MessageStatusGenerated,
 		ValidationMode:           edi.ValidationModeDisabled,
 		InterchangeControlNumber: file.InterchangeControlNumber,
@@ -524,9 +596,15 @@ func (s *Service) recordInboundMessage(
 		PayloadSnapshot:          payload,
 		AckStatus:                edi.MessageAcknowledgmentStatusNotExpected,
 	}
+	if guideDiagnostics != nil {
+		message.ValidationMode = edi.ValidationModeStrict
+	}
 	return s.messageRepo.CreateMessageWithDiagnostics(
 		ctx,
-		repositories.CreateEDIMessageWithDiagnosticsRequest{Message: message},
+		repositories.CreateEDIMessageWithDiagnosticsRequest{
+			Message:     message,
+			Diagnostics: guideDiagnostics,
+		},
 	)
 }
 
@@ -537,7 +615,7 @@ func (s *Service) inboundDocumentType(
 	documentTypes, err := s.documentTypeRepo.ListDocumentTypes(
 		ctx,
 		repositories.ListEDIDocumentTypesRequest{
-			Standard:       edi.EDIStandardX12,
+			Standard:       transactionSet.Standard(),
 			TransactionSet: transactionSet,
 			Direction:      edi.DocumentDirectionInbound,
 		},
@@ -549,7 +627,7 @@ func (s *Service) inboundDocumentType(
 		documentTypes, err = s.documentTypeRepo.ListDocumentTypes(
 			ctx,
 			repositories.ListEDIDocumentTypesRequest{
-				Standard:       edi.EDIStandardX12,
+				Standard:       transactionSet.Standard(),
 				TransactionSet: transactionSet,
 			},
 		)
@@ -559,7 +637,8 @@ func (s *Service) inboundDocumentType(
 	}
 	if len(documentTypes) == 0 {
 		return nil, fmt.Errorf(
-			"x12 %s document type is not seeded",
+			"%s %s document type is not seeded",
+			transactionSet.Standard(),
 			transactionSet,
 		)
 	}
@@ -576,8 +655,13 @@ func (s *Service) generateInboundAcknowledgments(
 	ackSets := map[edi.TransactionSet][]*parsedTransaction{}
 	for index := range interchange.transactions {
 		transaction := &interchange.transactions[index]
+		// EDIFACT CONTRL acknowledgments are not generated; partners that
+		// require them exchange them out of band. HTTPS documents are
+		// acknowledged by the response to the request that carried them.
 		if transaction.set == edi.TransactionSet997 ||
-			transaction.set == edi.TransactionSet999 {
+			transaction.set == edi.TransactionSet999 ||
+			transaction.jsonDocument ||
+			transaction.set.Standard() == edi.EDIStandardEDIFACT {
 			continue
 		}
 		ackSets[transaction.set] = append(ackSets[transaction.set], transaction)
@@ -278,3 +278,66 @@ func TestProcessInboundFile_QuarantinesUnparseableContent(t *testing.T) {
 	require.Equal(t, edi.InboundFileStatusQuarantined, file.Status)
 	require.NotEmpty(t, file.FailureReason)
 }
+
+func TestProcessInboundFile_QuarantinesResent204WithoutCreatingTransfer(t *testing.T) {
+	t.Parallel()
+
+	raw := renderBase204(t, sampleTenderPayload())
+	fixture := newInboundFixture(t, raw)
+	ledger := mocks.NewMockEDIControlNumberLedgerRepository(t)
+	fixture.service.ediService = ediservice.New(ediservice.Params{
+		Logger:              zap.NewNop(),
+		MappingProfileRepo:  fixture.mappingProfileRepo,
+		TenderRecipientRepo: fixture.recipientRepo,
+		LedgerRepo:          ledger,
+	})
+	lastUpdate := fixture.expectFileLoadAndUpdates(t)
+	fixture.expectPartnerLoad()
+	fixture.expectNoInboundAckProfile(edi.TransactionSet204)
+
+	original := &edi.EDIControlNumberLedgerEntry{
+		ID:            pulid.MustNew("edicnl_"),
+		InboundFileID: pulid.MustNew("ediinf_"),
+	}
+	ledger.EXPECT().
+		FindResentTransaction(mock.Anything, mock.MatchedBy(
+			func(req repositories.FindResentTransactionRequest) bool {
+				return req.EDIPartnerID == fixture.partner.ID &&
+					req.TransactionSet == edi.TransactionSet204 &&
+					req.ContentHash != ""
+			},
+		)).
+		Return(original, nil).
+		Once()
+	ledger.EXPECT().FindControlNumberEntry(mock.Anything, mock.Anything).Return(nil, nil)
+	ledger.EXPECT().GetHighestSequence(mock.Anything, mock.Anything).Return(int64(0), nil)
+	var recorded []*edi.EDIControlNumberLedgerEntry
+	ledger.EXPECT().
+		CreateLedgerEntry(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entry *edi.EDIControlNumberLedgerEntry) (*edi.EDIControlNumberLedgerEntry, error) {
+			recorded = append(recorded, entry)
+			return entry, nil
+		})
+
+	file, err := fixture.service.ProcessInboundFile(t.Context(), &ProcessInboundFileRequest{
+		FileID:     fixture.file.ID,
+		TenantInfo: fixture.tenantInfo(),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, edi.InboundFileStatusQuarantined, file.Status)
+	require.Contains(t, lastUpdate.FailureReason, "was not processed again")
+	require.Len(t, recorded, 3)
+	transaction := recorded[2]
+	require.Equal(t, edi.ControlNumberKindTransaction, transaction.Kind)
+	require.Equal(t, edi.ControlNumberAnomalyDuplicate, transaction.Anomaly)
+	require.Equal(t, original.ID, transaction.DuplicateOfID)
+	require.True(t, transaction.Rejected)
+	fixture.messageRepo.AssertNotCalled(
+		t,
+		"CreateMessageWithDiagnostics",
+		mock.Anything,
+		mock.Anything,
+	)
+	fixture.transferRepo.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything)
+}
@@ -2,6 +2,7 @@ package ediinboundservice
 
 import (
 	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edistarlark"
 	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
 	"github.com/emoss08/trenova/pkg/pagination"
 	"github.com/emoss08/trenova/shared/pulid"
@@ -24,6 +25,30 @@ type ProcessInboundFileRequest struct {
 	Reprocess  bool                  `json:"reprocess"`
 }
 
+type DryRunInboundTransformRequest struct {
+	PartnerID  pulid.ID              `json:"partnerId"`
+	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
+	RawContent string                `json:"rawContent"`
+	// Script previews an unsaved transform; the partner's saved script runs
+	// when it is empty.
+	Script string `json:"script"`
+}
+
+type InboundTransformDryRun struct {
+	Transactions []*InboundTransformPreview `json:"transactions"`
+}
+
+// InboundTransformPreview is one transaction's canonical payload before and
+// after the partner's transform. After is nil when the transform failed.
+type InboundTransformPreview struct {
+	TransactionSet edi.TransactionSet       `json:"transactionSet"`
+	ControlNumber  string                   `json:"controlNumber"`
+	Before         edi.DocumentPayload      `json:"before"`
+	After          *edi.DocumentPayload     `json:"after"`
+	Diagnostics    []edistarlark.Diagnostic `json:"diagnostics"`
+	ExecutionSteps uint64                   `json:"executionSteps"`
+}
+
 type PollableProfile struct {
 	ProfileID  pulid.ID              `json:"profileId"`
 	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
@@ -38,6 +63,7 @@ type parsedInterchange struct {
 	receiverID          string
 	functionalGroupID   string
 	groupControlNumber  string
+	x12Version          string
 	applicationSender   string
 	applicationReceiver string
 	transactions        []parsedTransaction
@@ -48,8 +74,19 @@ type parsedTransaction struct {
 	controlNumber      string
 	groupControlNumber string
 	functionalGroupID  string
+	x12Version         string
 	segments           []edix12inspect.X12Segment
 	raw                string
+	// rejections are the implementation guide errors reported back to the
+	// partner in the transaction's 997/999.
+	rejections []edi.AcknowledgmentDiagnostic
+	// payload is set for EDIFACT messages, whose composite elements are
+	// parsed when the interchange is read rather than from segments, and for
+	// JSON documents received over HTTPS.
+	payload *edi.DocumentPayload
+	// jsonDocument marks HTTPS documents, which have no segments to check
+	// against a guide and no envelope to acknowledge.
+	jsonDocument bool
 }
 
 type transactionOutcome struct {
@@ -11,8 +11,11 @@ import (
 )
 
 const (
-	EDIAlertEventMessageDeadLettered    = "edi.message.dead_lettered"
-	EDIAlertEventInboundFileQuarantined = "edi.inbound_file.quarantined"
+	EDIAlertEventMessageDeadLettered     = "edi.message.dead_lettered"
+	EDIAlertEventInboundFileQuarantined  = "edi.inbound_file.quarantined"
+	EDIAlertEventControlNumberGap        = "edi.control_number.gap"
+	EDIAlertEventControlNumberDuplicate  = "edi.control_number.duplicate"
+	EDIAlertEventControlNumberOutOfOrder = "edi.control_number.out_of_order"
 
 	ediAlertThrottleWindowSeconds = int64(15 * 60)
 	ediAlertSource                = "ediservice"
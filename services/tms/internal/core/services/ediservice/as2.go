@@ -4,21 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/emoss08/trenova/internal/core/domain/edi"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/services/editransport"
 	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/temporaltype"
 	"github.com/emoss08/trenova/shared/as2"
 	"github.com/emoss08/trenova/shared/timeutils"
+	"go.temporal.io/api/serviceerror"
 	"go.uber.org/zap"
 )
 
+const EDIAlertEventAS2MDNOverdue = "edi.as2.mdn_overdue"
+
 type ApplyAS2MDNRequest struct {
+	From        string
+	To          string
 	ContentType string
 	Body        []byte
 }
 
+// ApplyAS2MDN resolves the outbound delivery an asynchronous MDN refers to. The
+// MDN must come from the partner the message was sent to, carry a valid
+// signature whenever the profile has a partner signing certificate, and
+// acknowledge the MIC computed when the message was transmitted.
+//
+//nolint:funlen // Verification and resolution read best as one sequence.
 func (s *Service) ApplyAS2MDN(ctx context.Context, req *ApplyAS2MDNRequest) error {
 	if req == nil || len(req.Body) == 0 {
 		return errortypes.NewValidationError(
@@ -43,10 +57,15 @@ func (s *Service) ApplyAS2MDN(ctx context.Context, req *ApplyAS2MDNRequest) erro
 		return err
 	}
 
-	if mdn.Signed {
-		if err = s.verifyAS2MDNSignature(ctx, message, req); err != nil {
-			return err
-		}
+	cfg, err := s.as2ConfigForMessage(ctx, message)
+	if err != nil {
+		return err
+	}
+	if err = verifyAS2MDNOrigin(req, cfg); err != nil {
+		return err
+	}
+	if err = verifyAS2MDNSignature(req, mdn, cfg); err != nil {
+		return err
 	}
 
 	if message.DeliveryStatus == edi.MessageDeliveryStatusSent {
@@ -59,11 +78,11 @@ func (s *Service) ApplyAS2MDN(ctx context.Context, req *ApplyAS2MDNRequest) erro
 			"AS2 partner reported a processing failure: %s",
 			mdn.Disposition,
 		))
+		s.signalAS2MDNReceived(ctx, message)
 		return nil
 	}
 
-	if message.AS2MIC != "" && mdn.ReceivedContentMIC != "" &&
-		!as2.MICMatches(message.AS2MIC, mdn.ReceivedContentMIC) {
+	if !as2MDNMICAccepted(message, mdn, cfg) {
 		s.recordDeliveryFailure(
 			ctx,
 			message,
@@ -71,6 +90,7 @@ func (s *Service) ApplyAS2MDN(ctx context.Context, req *ApplyAS2MDNRequest) erro
 			&now,
 			errAS2MICMismatch,
 		)
+		s.signalAS2MDNReceived(ctx, message)
 		return nil
 	}
 
@@ -96,39 +116,160 @@ func (s *Service) ApplyAS2MDN(ctx context.Context, req *ApplyAS2MDNRequest) erro
 		"AS2 async MDN resolved outbound message delivery",
 		zap.String("messageId", message.ID.String()),
 	)
+	s.signalAS2MDNReceived(ctx, message)
 
 	return s.completeTenderChangeDelivery(ctx, message)
 }
 
-var errAS2MICMismatch = errors.New("AS2 MDN MIC does not match the transmitted content")
+// ExpireAS2MDN fails a delivery whose asynchronous MDN never arrived. It is a
+// no-op when the MDN resolved the delivery in the meantime or the message has
+// since been retransmitted under a new AS2 Message-ID.
+func (s *Service) ExpireAS2MDN(ctx context.Context, payload *ExpireAS2MDNPayload) error {
+	if payload == nil || payload.MessageID.IsNil() {
+		return errors.New("EDI message ID is required for MDN expiry")
+	}
+	message, err := s.messageRepo.GetMessageByID(ctx, repositories.GetEDIMessageByIDRequest{
+		ID:         payload.MessageID,
+		TenantInfo: payload.TenantInfo,
+	})
+	if err != nil {
+		return err
+	}
+	if message.DeliveryStatus != edi.MessageDeliveryStatusSending ||
+		message.AS2MessageID != payload.AS2MessageID {
+		return nil
+	}
 
-func (s *Service) verifyAS2MDNSignature(
+	timeout := time.Duration(payload.MDNTimeoutSeconds) * time.Second
+	reason := fmt.Sprintf("AS2 partner did not return an MDN within %s", timeout)
+	now := timeutils.NowUnix()
+	s.recordDeliveryFailure(ctx, message, message.DeliveryRemotePath, &now, errors.New(reason))
+	s.NotifyOperationalFailure(ctx, &EDIOperationalAlert{
+		OrganizationID: message.OrganizationID,
+		BusinessUnitID: message.BusinessUnitID,
+		EventType:      EDIAlertEventAS2MDNOverdue,
+		PartnerID:      message.EDIPartnerID,
+		Title:          "AS2 MDN overdue",
+		Message: fmt.Sprintf(
+			"Outbound %s message %s was sent but the partner did not return an MDN within %s",
+			message.TransactionSet,
+			message.ID,
+			timeout,
+		),
+		RelatedEntities: map[string]any{
+			"messageId": message.ID,
+			"partnerId": message.EDIPartnerID,
+		},
+		Data: map[string]any{
+			"transactionSet": message.TransactionSet,
+			"as2MessageId":   message.AS2MessageID,
+			"error":          reason,
+			"link":           "/edi/messages?panelType=edit&panelEntityId=" + message.ID.String(),
+		},
+	})
+	return nil
+}
+
+var (
+	errAS2MICMismatch = errors.New("AS2 MDN MIC does not match the transmitted content")
+
+	ErrAS2MDNOriginMismatch = errortypes.NewAuthorizationError(
+		"AS2 MDN identifiers do not match the partner the message was sent to",
+	)
+	ErrAS2MDNUnsigned = errortypes.NewAuthorizationError(
+		"AS2 MDN must be signed by the partner",
+	)
+)
+
+func (s *Service) as2ConfigForMessage(
 	ctx context.Context,
 	message *edi.EDIMessage,
-	req *ApplyAS2MDNRequest,
-) error {
+) (*editransport.AS2Config, error) {
 	profile, err := s.deliveryProfileForMessage(ctx, message)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	secrets, err := s.ProfileTransportSecrets(profile)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	cfg, err := editransport.AS2ConfigFromProfile(profile, secrets)
-	if err != nil {
-		return err
+	return editransport.AS2ConfigFromProfile(profile, secrets)
+}
+
+// verifyAS2MDNOrigin checks that the MDN travels in the reverse direction of the
+// original message: from the partner's AS2 ID back to ours.
+func verifyAS2MDNOrigin(req *ApplyAS2MDNRequest, cfg *editransport.AS2Config) error {
+	if !strings.EqualFold(strings.TrimSpace(req.From), cfg.PartnerAS2ID) ||
+		!strings.EqualFold(strings.TrimSpace(req.To), cfg.LocalAS2ID) {
+		return ErrAS2MDNOriginMismatch
 	}
+	return nil
+}
+
+func verifyAS2MDNSignature(
+	req *ApplyAS2MDNRequest,
+	mdn *as2.ParsedMDN,
+	cfg *editransport.AS2Config,
+) error {
 	if cfg.PartnerSigningCertificate == nil {
 		return nil
 	}
-	if _, err = as2.ParseMDN(
+	if !mdn.Signed {
+		return ErrAS2MDNUnsigned
+	}
+	if _, err := as2.ParseMDN(
 		req.ContentType,
 		req.Body,
 		cfg.PartnerSigningCertificate,
 	); err != nil {
-		return fmt.Errorf("AS2 MDN signature verification failed: %w", err)
+		return errortypes.NewAuthorizationError("AS2 MDN signature verification failed").
+			WithInternal(err)
 	}
-
 	return nil
 }
+
+// as2MDNMICAccepted compares the MDN's Received-Content-MIC with the MIC stored
+// at send time. A signed receipt was requested whenever the partner has a
+// signing certificate, and RFC 4130 requires the MIC in signed receipts, so it
+// may only be omitted for unsigned ones.
+func as2MDNMICAccepted(
+	message *edi.EDIMessage,
+	mdn *as2.ParsedMDN,
+	cfg *editransport.AS2Config,
+) bool {
+	if message.AS2MIC == "" {
+		return true
+	}
+	if mdn.ReceivedContentMIC == "" {
+		return cfg.PartnerSigningCertificate == nil
+	}
+	return as2.MICMatches(message.AS2MIC, mdn.ReceivedContentMIC)
+}
+
+// signalAS2MDNReceived releases the delivery workflow's overdue timer. The
+// timer re-checks the message before alerting, so a lost signal only delays
+// the workflow's completion.
+func (s *Service) signalAS2MDNReceived(ctx context.Context, message *edi.EDIMessage) {
+	if s.workflowStarter == nil || !s.workflowStarter.Enabled() {
+		return
+	}
+	err := s.workflowStarter.SignalWorkflow(
+		ctx,
+		buildDeliverMessageWorkflowID(message.ID),
+		"",
+		temporaltype.AS2MDNReceivedSignalName,
+		message.AS2MessageID,
+	)
+	if err == nil {
+		return
+	}
+	var notFoundErr *serviceerror.NotFound
+	if errors.As(err, &notFoundErr) {
+		return
+	}
+	s.l.Warn(
+		"failed to signal EDI delivery workflow about AS2 MDN",
+		zap.String("messageId", message.ID.String()),
+		zap.Error(err),
+	)
+}
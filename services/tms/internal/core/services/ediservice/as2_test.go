@@ -28,6 +28,40 @@ func pendingAS2Message(messageID string) *edi.EDIMessage {
 	}
 }
 
+func as2DeliveryProfile() *edi.EDICommunicationProfile {
+	return &edi.EDICommunicationProfile{
+		ID:     pulid.MustNew("edicp_"),
+		Method: edi.ConnectionMethodAS2,
+		Config: map[string]any{
+			"localAS2Id":   "TRENOVA-AS2",
+			"partnerAS2Id": "PARTNER-AS2",
+			"endpointUrl":  "https://partner.example.com/as2",
+			"mdnMode":      "async",
+			"mdnUrl":       "https://tms.example.com/edi/as2/mdn/",
+		},
+	}
+}
+
+// expectAS2DeliveryProfile resolves the message's delivery profile the way
+// ApplyAS2MDN does: no tender change pins a recipient, so the partner's active
+// profile is used.
+func expectAS2DeliveryProfile(
+	t *testing.T,
+) (*mocks.MockEDITenderChangeRepository, *mocks.MockEDICommunicationProfileRepository) {
+	t.Helper()
+
+	tenderChangeRepo := mocks.NewMockEDITenderChangeRepository(t)
+	tenderChangeRepo.EXPECT().
+		GetTenderChangeByOutboundMessageID(mock.Anything, mock.Anything).
+		Return(nil, errortypes.NewNotFoundError("tender change not found"))
+	profileRepo := mocks.NewMockEDICommunicationProfileRepository(t)
+	profileRepo.EXPECT().
+		GetActiveProfileByPartner(mock.Anything, mock.Anything).
+		Return(as2DeliveryProfile(), nil).
+		Once()
+	return tenderChangeRepo, profileRepo
+}
+
 func TestApplyAS2MDNResolvesPendingDelivery(t *testing.T) {
 	t.Parallel()
 
@@ -53,16 +87,13 @@ func TestApplyAS2MDNResolvesPendingDelivery(t *testing.T) {
 			return message, nil
 		}).
 		Once()
-	tenderChangeRepo := mocks.NewMockEDITenderChangeRepository(t)
-	tenderChangeRepo.EXPECT().
-		GetTenderChangeByOutboundMessageID(mock.Anything, mock.Anything).
-		Return(nil, errortypes.NewNotFoundError("tender change not found")).
-		Once()
+	tenderChangeRepo, profileRepo := expectAS2DeliveryProfile(t)
 
 	service := New(Params{
 		Logger:           zap.NewNop(),
 		MessageRepo:      messageRepo,
 		TenderChangeRepo: tenderChangeRepo,
+		ProfileRepo:      profileRepo,
 	})
 
 	mdn, err := as2.BuildMDN(&as2.BuildMDNOptions{
@@ -74,6 +105,8 @@ func TestApplyAS2MDNResolvesPendingDelivery(t *testing.T) {
 	require.NoError(t, err)
 
 	err = service.ApplyAS2MDN(t.Context(), &ApplyAS2MDNRequest{
+		From:        "PARTNER-AS2",
+		To:          "TRENOVA-AS2",
 		ContentType: mdn.ContentType,
 		Body:        mdn.Body,
 	})
@@ -103,7 +136,14 @@ func TestApplyAS2MDNFailsDeliveryOnRejectedDisposition(t *testing.T) {
 		Return(message, nil).
 		Once()
 
-	service := New(Params{Logger: zap.NewNop(), MessageRepo: messageRepo})
+	tenderChangeRepo, profileRepo := expectAS2DeliveryProfile(t)
+
+	service := New(Params{
+		Logger:           zap.NewNop(),
+		MessageRepo:      messageRepo,
+		TenderChangeRepo: tenderChangeRepo,
+		ProfileRepo:      profileRepo,
+	})
 
 	mdn, err := as2.BuildMDN(&as2.BuildMDNOptions{
 		From:              "PARTNER-AS2",
@@ -114,6 +154,8 @@ func TestApplyAS2MDNFailsDeliveryOnRejectedDisposition(t *testing.T) {
 	require.NoError(t, err)
 
 	err = service.ApplyAS2MDN(t.Context(), &ApplyAS2MDNRequest{
+		From:        "PARTNER-AS2",
+		To:          "TRENOVA-AS2",
 		ContentType: mdn.ContentType,
 		Body:        mdn.Body,
 	})
@@ -141,7 +183,14 @@ func TestApplyAS2MDNFailsDeliveryOnMICMismatch(t *testing.T) {
 		Return(message, nil).
 		Once()
 
-	service := New(Params{Logger: zap.NewNop(), MessageRepo: messageRepo})
+	tenderChangeRepo, profileRepo := expectAS2DeliveryProfile(t)
+
+	service := New(Params{
+		Logger:           zap.NewNop(),
+		MessageRepo:      messageRepo,
+		TenderChangeRepo: tenderChangeRepo,
+		ProfileRepo:      profileRepo,
+	})
 
 	mdn, err := as2.BuildMDN(&as2.BuildMDNOptions{
 		From:               "PARTNER-AS2",
@@ -152,8 +201,101 @@ func TestApplyAS2MDNFailsDeliveryOnMICMismatch(t *testing.T) {
 	require.NoError(t, err)
 
 	err = service.ApplyAS2MDN(t.Context(), &ApplyAS2MDNRequest{
+		From:        "PARTNER-AS2",
+		To:          "TRENOVA-AS2",
 		ContentType: mdn.ContentType,
 		Body:        mdn.Body,
 	})
 	require.NoError(t, err)
 }
+
+func TestApplyAS2MDNRejectsMDNFromAnotherPartner(t *testing.T) {
+	t.Parallel()
+
+	messageID := "<pending-321@trenova.as2>"
+	message := pendingAS2Message(messageID)
+
+	messageRepo := mocks.NewMockEDIMessageRepository(t)
+	messageRepo.EXPECT().
+		GetOutboundMessageByAS2MessageID(mock.Anything, messageID).
+		Return(message, nil).
+		Once()
+	tenderChangeRepo, profileRepo := expectAS2DeliveryProfile(t)
+
+	service := New(Params{
+		Logger:           zap.NewNop(),
+		MessageRepo:      messageRepo,
+		TenderChangeRepo: tenderChangeRepo,
+		ProfileRepo:      profileRepo,
+	})
+
+	mdn, err := as2.BuildMDN(&as2.BuildMDNOptions{
+		From:               "SPOOFED-AS2",
+		To:                 "TRENOVA-AS2",
+		OriginalMessageID:  messageID,
+		ReceivedContentMIC: "q1w2e3r4, sha256",
+	})
+	require.NoError(t, err)
+
+	err = service.ApplyAS2MDN(t.Context(), &ApplyAS2MDNRequest{
+		From:        "SPOOFED-AS2",
+		To:          "TRENOVA-AS2",
+		ContentType: mdn.ContentType,
+		Body:        mdn.Body,
+	})
+	require.ErrorIs(t, err, ErrAS2MDNOriginMismatch)
+	require.Equal(t, edi.MessageDeliveryStatusSending, message.DeliveryStatus)
+}
+
+func TestExpireAS2MDN(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fails a delivery still awaiting its MDN", func(t *testing.T) {
+		t.Parallel()
+
+		message := pendingAS2Message("<overdue-1@trenova.as2>")
+		messageRepo := mocks.NewMockEDIMessageRepository(t)
+		messageRepo.EXPECT().
+			GetMessageByID(mock.Anything, mock.Anything).
+			Return(message, nil).
+			Once()
+		messageRepo.EXPECT().
+			UpdateMessageDelivery(
+				mock.Anything,
+				mock.MatchedBy(func(req *repositories.UpdateEDIMessageDeliveryRequest) bool {
+					return req.ID == message.ID &&
+						req.DeliveryStatus == edi.MessageDeliveryStatusFailed &&
+						req.DeliveryLastError == "AS2 partner did not return an MDN within 4h0m0s"
+				}),
+			).
+			Return(message, nil).
+			Once()
+		service := New(Params{Logger: zap.NewNop(), MessageRepo: messageRepo})
+
+		err := service.ExpireAS2MDN(t.Context(), &ExpireAS2MDNPayload{
+			MessageID:         message.ID,
+			AS2MessageID:      message.AS2MessageID,
+			MDNTimeoutSeconds: 4 * 60 * 60,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("ignores a retransmitted message", func(t *testing.T) {
+		t.Parallel()
+
+		message := pendingAS2Message("<overdue-2-retry@trenova.as2>")
+		messageRepo := mocks.NewMockEDIMessageRepository(t)
+		messageRepo.EXPECT().
+			GetMessageByID(mock.Anything, mock.Anything).
+			Return(message, nil).
+			Once()
+		service := New(Params{Logger: zap.NewNop(), MessageRepo: messageRepo})
+
+		err := service.ExpireAS2MDN(t.Context(), &ExpireAS2MDNPayload{
+			MessageID:         message.ID,
+			AS2MessageID:      "<overdue-2@trenova.as2>",
+			MDNTimeoutSeconds: 60,
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,746 @@
+package ediservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/pkg/temporaltype"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/stringutils"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+const defaultCertificationResponseTimeoutMinutes = int64(15)
+
+type StartEDICertificationRunRequest struct {
+	TenantInfo             pagination.TenantInfo `json:"-"`
+	EDIPartnerID           pulid.ID              `json:"ediPartnerId"`
+	CommunicationProfileID pulid.ID              `json:"communicationProfileId"`
+	ShipmentID             pulid.ID              `json:"shipmentId"`
+	InvoiceID              pulid.ID              `json:"invoiceId"`
+	ResponseTimeoutMinutes int64                 `json:"responseTimeoutMinutes"`
+	ExpectedStatusCount    int64                 `json:"expectedStatusCount"`
+}
+
+type AdvanceEDICertificationRunPayload struct {
+	RunID      pulid.ID              `json:"runId"`
+	TenantInfo pagination.TenantInfo `json:"tenantInfo"`
+}
+
+type AdvanceEDICertificationRunResult struct {
+	RunID     pulid.ID                   `json:"runId"`
+	Status    edi.CertificationRunStatus `json:"status"`
+	Completed bool                       `json:"completed"`
+}
+
+// certificationVerdict is the judgement of one step against the messages
+// exchanged so far. A step that is neither passed nor failed keeps waiting
+// until the run's response timeout elapses.
+type certificationVerdict struct {
+	passed     bool
+	failed     bool
+	detail     string
+	messageIDs []pulid.ID
+}
+
+func (s *Service) ListCertificationRuns(
+	ctx context.Context,
+	req *repositories.ListEDICertificationRunsRequest,
+) (*pagination.ListResult[*edi.EDICertificationRun], error) {
+	if err := s.requireCertificationRepo(); err != nil {
+		return nil, err
+	}
+	return s.certificationRepo.ListCertificationRuns(ctx, req)
+}
+
+func (s *Service) GetCertificationRun(
+	ctx context.Context,
+	req repositories.GetEDICertificationRunByIDRequest,
+) (*edi.EDICertificationRun, error) {
+	if err := s.requireCertificationRepo(); err != nil {
+		return nil, err
+	}
+	return s.certificationRepo.GetCertificationRunByID(ctx, req)
+}
+
+// StartCertificationRun records a new run for an external partner and starts
+// the workflow that drives its scripted exchange over the chosen
+// communication profile.
+func (s *Service) StartCertificationRun(
+	ctx context.Context,
+	req *StartEDICertificationRunRequest,
+	actor *services.RequestActor,
+) (*edi.EDICertificationRun, error) {
+	if err := s.requireCertificationRepo(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errortypes.NewValidationError(
+			"",
+			errortypes.ErrRequired,
+			"Certification run request is required",
+		)
+	}
+
+	partner, err := s.partnerRepo.GetByID(ctx, repositories.GetEDIPartnerByIDRequest{
+		ID:         req.EDIPartnerID,
+		TenantInfo: req.TenantInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if partner.Kind != edi.PartnerKindExternal {
+		return nil, errortypes.NewValidationError(
+			"ediPartnerId",
+			errortypes.ErrInvalidOperation,
+			"Only external EDI partners are certified",
+		)
+	}
+	profile, err := s.profileRepo.GetProfileByID(
+		ctx,
+		repositories.GetEDICommunicationProfileByIDRequest{
+			ID:         req.CommunicationProfileID,
+			TenantInfo: req.TenantInfo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !certificationProfileServesPartner(profile, partner) {
+		return nil, errortypes.NewValidationError(
+			"communicationProfileId",
+			errortypes.ErrInvalid,
+			"Communication profile does not belong to the EDI partner",
+		)
+	}
+	if profile.Method == edi.ConnectionMethodInternal {
+		return nil, errortypes.NewValidationError(
+			"communicationProfileId",
+			errortypes.ErrInvalidOperation,
+			"Internal communication profiles do not require certification",
+		)
+	}
+
+	latest, err := s.certificationRepo.GetLatestCertificationRun(
+		ctx,
+		repositories.GetLatestEDICertificationRunRequest{
+			TenantInfo:   req.TenantInfo,
+			EDIPartnerID: partner.ID,
+		},
+	)
+	if err != nil && !errortypes.IsNotFoundError(err) {
+		return nil, err
+	}
+	if latest != nil && latest.Status == edi.CertificationRunStatusRunning {
+		return nil, errortypes.NewBusinessError(
+			"A certification run is already in progress for this EDI partner",
+		)
+	}
+
+	timeoutMinutes := req.ResponseTimeoutMinutes
+	if timeoutMinutes <= 0 {
+		timeoutMinutes = defaultCertificationResponseTimeoutMinutes
+	}
+	expectedStatusCount := req.ExpectedStatusCount
+	if expectedStatusCount <= 0 {
+		expectedStatusCount = edi.DefaultCertificationStatusCount
+	}
+	connectionID := profile.EDIConnectionID
+	if connectionID.IsNil() {
+		connectionID = partner.EDIConnectionID
+	}
+	entity := &edi.EDICertificationRun{
+		BusinessUnitID:         req.TenantInfo.BuID,
+		OrganizationID:         req.TenantInfo.OrgID,
+		EDIPartnerID:           partner.ID,
+		CommunicationProfileID: profile.ID,
+		EDIConnectionID:        connectionID,
+		ShipmentID:             req.ShipmentID,
+		InvoiceID:              req.InvoiceID,
+		Status:                 edi.CertificationRunStatusRunning,
+		Steps:                  edi.NewCertificationSteps(),
+		ResponseTimeoutSeconds: timeoutMinutes * 60,
+		ExpectedStatusCount:    expectedStatusCount,
+		StartedByID:            req.TenantInfo.UserID,
+	}
+	multiErr := errortypes.NewMultiError()
+	entity.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	created, err := s.certificationRepo.CreateCertificationRun(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.startCertificationWorkflow(ctx, created); err != nil {
+		now := timeutils.NowUnix()
+		created.Fail(&created.Steps[0], "Certification workflow could not be started", now)
+		if _, updateErr := s.certificationRepo.UpdateCertificationRun(ctx, created); updateErr != nil {
+			return nil, errors.Join(err, updateErr)
+		}
+		return nil, err
+	}
+
+	s.logAction(created, actor, permission.OpCreate, nil, created, "EDI certification run started")
+	return created, nil
+}
+
+// AdvanceCertificationRun evaluates the run's current step and moves through
+// as many steps as the exchanged messages allow. It is called repeatedly by
+// the certification workflow until the run passes or fails.
+func (s *Service) AdvanceCertificationRun(
+	ctx context.Context,
+	payload *AdvanceEDICertificationRunPayload,
+) (*AdvanceEDICertificationRunResult, error) {
+	if err := s.requireCertificationRepo(); err != nil {
+		return nil, err
+	}
+	run, err := s.certificationRepo.GetCertificationRunByID(
+		ctx,
+		repositories.GetEDICertificationRunByIDRequest{
+			ID:         payload.RunID,
+			TenantInfo: payload.TenantInfo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for run.Status == edi.CertificationRunStatusRunning {
+		step := run.CurrentStep()
+		if step == nil {
+			break
+		}
+		now := timeutils.NowUnix()
+		if step.StartedAt == nil {
+			step.Status = edi.CertificationStepStatusRunning
+			step.StartedAt = &now
+			// Record the start before evaluating: a send step retried after a
+			// lost update finds the document it already sent by this time
+			// instead of sending the same 204 or 210 again.
+			if run, err = s.certificationRepo.UpdateCertificationRun(ctx, run); err != nil {
+				return nil, err
+			}
+			step = run.CurrentStep()
+		}
+		finished, stepErr := s.evaluateCertificationStep(ctx, run, step, now)
+		if stepErr != nil {
+			return nil, stepErr
+		}
+		if run, err = s.certificationRepo.UpdateCertificationRun(ctx, run); err != nil {
+			return nil, err
+		}
+		if !finished {
+			break
+		}
+	}
+
+	return &AdvanceEDICertificationRunResult{
+		RunID:     run.ID,
+		Status:    run.Status,
+		Completed: run.Status != edi.CertificationRunStatusRunning,
+	}, nil
+}
+
+func (s *Service) evaluateCertificationStep(
+	ctx context.Context,
+	run *edi.EDICertificationRun,
+	step *edi.CertificationStep,
+	now int64,
+) (bool, error) {
+	var (
+		verdict certificationVerdict
+		err     error
+	)
+	switch step.Key {
+	case edi.CertificationStepSendLoadTender:
+		verdict, err = s.sendCertificationDocument(ctx, run, step, &GenerateEDIDocumentRequest{
+			ShipmentID:     run.ShipmentID,
+			TransactionSet: edi.TransactionSet204,
+		})
+	case edi.CertificationStepSendFreightInvoice:
+		verdict, err = s.sendCertificationDocument(ctx, run, step, &GenerateEDIDocumentRequest{
+			InvoiceID:      run.InvoiceID,
+			TransactionSet: edi.TransactionSet210,
+		})
+	case edi.CertificationStepAwaitTenderResponse:
+		verdict, err = s.awaitCertificationMessages(ctx, run, edi.TransactionSet990)
+	case edi.CertificationStepAwaitShipmentStatus:
+		verdict, err = s.awaitCertificationMessages(ctx, run, edi.TransactionSet214)
+	case edi.CertificationStepVerifyAcknowledgments:
+		var messages []*edi.EDIMessage
+		messages, err = s.certificationRepo.ListCertificationMessages(
+			ctx,
+			repositories.ListEDICertificationMessagesRequest{
+				TenantInfo:   certificationTenantInfo(run),
+				EDIPartnerID: run.EDIPartnerID,
+				MessageIDs:   certificationSentMessageIDs(run),
+			},
+		)
+		if err == nil {
+			verdict = judgeCertificationAcknowledgments(messages)
+		}
+	default:
+		verdict = certificationVerdict{failed: true, detail: "Unknown certification step"}
+	}
+	if err != nil {
+		return false, err
+	}
+
+	step.MessageIDs = mergeCertificationMessageIDs(step.MessageIDs, verdict.messageIDs)
+	switch {
+	case verdict.failed:
+		run.Fail(step, verdict.detail, now)
+		return true, nil
+	case verdict.passed:
+		run.Pass(step, verdict.detail, now)
+		return true, nil
+	case now-*step.StartedAt >= run.ResponseTimeoutSeconds:
+		run.Fail(step, certificationTimeoutDetail(step.Key, verdict.detail, run), now)
+		return true, nil
+	default:
+		step.Detail = verdict.detail
+		return false, nil
+	}
+}
+
+func (s *Service) sendCertificationDocument(
+	ctx context.Context,
+	run *edi.EDICertificationRun,
+	step *edi.CertificationStep,
+	req *GenerateEDIDocumentRequest,
+) (certificationVerdict, error) {
+	req.TenantInfo = certificationTenantInfo(run)
+	req.EDIPartnerID = run.EDIPartnerID
+	req.Direction = edi.DocumentDirectionOutbound
+	req.GeneratedByID = run.StartedByID
+	req.DeliveryCommunicationProfileID = run.CommunicationProfileID
+
+	message, err := s.previousCertificationSend(ctx, run, step, req)
+	if err != nil {
+		return certificationVerdict{}, err
+	}
+	if message == nil {
+		message, err = s.GenerateDocument(ctx, req)
+	}
+	if err != nil {
+		if errortypes.IsError(err) ||
+			errortypes.IsBusinessError(err) ||
+			errortypes.IsNotFoundError(err) {
+			return certificationVerdict{failed: true, detail: err.Error()}, nil
+		}
+		return certificationVerdict{}, err
+	}
+	return certificationVerdict{
+		passed: true,
+		detail: fmt.Sprintf(
+			"Sent %s with control number %s",
+			req.TransactionSet,
+			message.TransactionControlNumber,
+		),
+		messageIDs: []pulid.ID{message.ID},
+	}, nil
+}
+
+// previousCertificationSend returns the document an earlier attempt at the
+// step already sent for the run's shipment or invoice, or nil when the step
+// has not sent one yet.
+func (s *Service) previousCertificationSend(
+	ctx context.Context,
+	run *edi.EDICertificationRun,
+	step *edi.CertificationStep,
+	req *GenerateEDIDocumentRequest,
+) (*edi.EDIMessage, error) {
+	messages, err := s.certificationRepo.ListCertificationMessages(
+		ctx,
+		repositories.ListEDICertificationMessagesRequest{
+			TenantInfo:     req.TenantInfo,
+			EDIPartnerID:   run.EDIPartnerID,
+			Direction:      edi.DocumentDirectionOutbound,
+			TransactionSet: req.TransactionSet,
+			Since:          *step.StartedAt,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, message := range messages {
+		if req.InvoiceID.IsNotNil() {
+			invoice := message.PayloadSnapshot.FreightInvoice
+			if invoice != nil && invoice.InvoiceID == req.InvoiceID {
+				return message, nil
+			}
+			continue
+		}
+		if message.ShipmentID == req.ShipmentID {
+			return message, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Service) awaitCertificationMessages(
+	ctx context.Context,
+	run *edi.EDICertificationRun,
+	transactionSet edi.TransactionSet,
+) (certificationVerdict, error) {
+	since := run.StartedAt
+	if tender := run.Step(edi.CertificationStepSendLoadTender); tender != nil &&
+		tender.CompletedAt != nil {
+		since = *tender.CompletedAt
+	}
+	messages, err := s.certificationRepo.ListCertificationMessages(
+		ctx,
+		repositories.ListEDICertificationMessagesRequest{
+			TenantInfo:     certificationTenantInfo(run),
+			EDIPartnerID:   run.EDIPartnerID,
+			Direction:      edi.DocumentDirectionInbound,
+			TransactionSet: transactionSet,
+			Since:          since,
+		},
+	)
+	if err != nil {
+		return certificationVerdict{}, err
+	}
+	references, err := s.certificationShipmentReferences(ctx, run)
+	if err != nil {
+		return certificationVerdict{}, err
+	}
+	messages = certificationMessagesForShipment(messages, references)
+	if transactionSet == edi.TransactionSet990 {
+		return judgeCertificationTenderResponses(messages), nil
+	}
+	return judgeCertificationStatusUpdates(messages, run.ExpectedStatusCount), nil
+}
+
+// certificationShipmentReferences collects the identifiers the partner can
+// echo back for the run's test shipment: the shipment ID sent in B2 and the
+// BOL carried by the 204 the run sent.
+func (s *Service) certificationShipmentReferences(
+	ctx context.Context,
+	run *edi.EDICertificationRun,
+) ([]string, error) {
+	references := make([]string, 0, 3)
+	if run.ShipmentID.IsNotNil() {
+		references = append(references, run.ShipmentID.String())
+	}
+	tender := run.Step(edi.CertificationStepSendLoadTender)
+	if tender == nil || len(tender.MessageIDs) == 0 {
+		return references, nil
+	}
+	sent, err := s.certificationRepo.ListCertificationMessages(
+		ctx,
+		repositories.ListEDICertificationMessagesRequest{
+			TenantInfo:   certificationTenantInfo(run),
+			EDIPartnerID: run.EDIPartnerID,
+			MessageIDs:   tender.MessageIDs,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, message := range sent {
+		if message.ShipmentID.IsNotNil() {
+			references = append(references, message.ShipmentID.String())
+		}
+		for _, payload := range []*edi.LoadTenderPayload{
+			message.PayloadSnapshot.LoadTender,
+			message.PayloadSnapshot.Shipment,
+		} {
+			if payload != nil {
+				references = append(references, payload.BOL)
+			}
+		}
+	}
+	return stringutils.NonEmptyStrings(references...), nil
+}
+
+// certificationMessagesForShipment keeps the inbound messages that name the
+// run's test shipment, so unrelated traffic from the partner cannot pass a
+// step.
+func certificationMessagesForShipment(
+	messages []*edi.EDIMessage,
+	references []string,
+) []*edi.EDIMessage {
+	matched := make([]*edi.EDIMessage, 0, len(messages))
+	for _, message := range messages {
+		if certificationMessageNamesShipment(message, references) {
+			matched = append(matched, message)
+		}
+	}
+	return matched
+}
+
+func certificationMessageNamesShipment(message *edi.EDIMessage, references []string) bool {
+	candidates := make([]string, 0, 6)
+	if message.ShipmentID.IsNotNil() {
+		candidates = append(candidates, message.ShipmentID.String())
+	}
+	if response := message.PayloadSnapshot.TenderResponse; response != nil {
+		candidates = append(candidates, response.BOL, response.ShipmentID.String())
+	}
+	if status := message.PayloadSnapshot.ShipmentStatus; status != nil {
+		candidates = append(candidates, status.BOL, status.ShipmentID.String())
+		for _, value := range status.References {
+			candidates = append(candidates, value)
+		}
+	}
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" {
+			continue
+		}
+		for _, reference := range references {
+			if strings.EqualFold(candidate, strings.TrimSpace(reference)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Service) startCertificationWorkflow(
+	ctx context.Context,
+	run *edi.EDICertificationRun,
+) error {
+	if s.workflowStarter == nil || !s.workflowStarter.Enabled() {
+		return errortypes.NewBusinessError("EDI certification workflow is not configured")
+	}
+	_, err := s.workflowStarter.StartWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       "edi-certification-" + run.ID.String(),
+			TaskQueue:                                temporaltype.EDITaskQueue,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+			StaticSummary: fmt.Sprintf(
+				"Certify EDI partner %s",
+				run.EDIPartnerID.String(),
+			),
+		},
+		temporaltype.RunEDICertificationWorkflowName,
+		&AdvanceEDICertificationRunPayload{
+			RunID:      run.ID,
+			TenantInfo: certificationTenantInfo(run),
+		},
+	)
+	if err != nil {
+		var alreadyStartedErr *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStartedErr) {
+			return nil
+		}
+		return errortypes.NewBusinessError("failed to start EDI certification workflow").
+			WithInternal(err)
+	}
+	return nil
+}
+
+// requirePassingCertification gates activation of an external connection on
+// the most recent certification run recorded against it.
+func (s *Service) requirePassingCertification(
+	ctx context.Context,
+	connection *edi.EDIConnection,
+	tenantInfo pagination.TenantInfo,
+) error {
+	if err := s.requireCertificationRepo(); err != nil {
+		return err
+	}
+	latest, err := s.certificationRepo.GetLatestCertificationRun(
+		ctx,
+		repositories.GetLatestEDICertificationRunRequest{
+			TenantInfo:      tenantInfo,
+			EDIConnectionID: connection.ID,
+		},
+	)
+	if err != nil && !errortypes.IsNotFoundError(err) {
+		return err
+	}
+	if latest == nil || latest.Status != edi.CertificationRunStatusPassed {
+		return errortypes.NewBusinessError(
+			"External EDI connections are activated only after a passing certification run",
+		)
+	}
+	return nil
+}
+
+func (s *Service) requireCertificationRepo() error {
+	if s.certificationRepo == nil {
+		return errortypes.NewBusinessError("EDI partner certification is not configured")
+	}
+	return nil
+}
+
+func certificationProfileServesPartner(
+	profile *edi.EDICommunicationProfile,
+	partner *edi.EDIPartner,
+) bool {
+	if profile.EDIPartnerID == partner.ID {
+		return true
+	}
+	return profile.EDIPartnerID.IsNil() &&
+		profile.EDIConnectionID.IsNotNil() &&
+		profile.EDIConnectionID == partner.EDIConnectionID
+}
+
+func certificationTenantInfo(run *edi.EDICertificationRun) pagination.TenantInfo {
+	return pagination.TenantInfo{
+		OrgID:  run.OrganizationID,
+		BuID:   run.BusinessUnitID,
+		UserID: run.StartedByID,
+	}
+}
+
+func certificationSentMessageIDs(run *edi.EDICertificationRun) []pulid.ID {
+	ids := make([]pulid.ID, 0, 2)
+	for _, key := range []edi.CertificationStepKey{
+		edi.CertificationStepSendLoadTender,
+		edi.CertificationStepSendFreightInvoice,
+	} {
+		if step := run.Step(key); step != nil {
+			ids = append(ids, step.MessageIDs...)
+		}
+	}
+	return ids
+}
+
+func mergeCertificationMessageIDs(existing, found []pulid.ID) []pulid.ID {
+	for _, id := range found {
+		seen := false
+		for _, current := range existing {
+			if current == id {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			existing = append(existing, id)
+		}
+	}
+	return existing
+}
+
+// judgeCertificationTenderResponses passes on the first accepting 990 and
+// fails on a decline. Messages are expected oldest first.
+func judgeCertificationTenderResponses(messages []*edi.EDIMessage) certificationVerdict {
+	verdict := certificationVerdict{detail: "Waiting for a 990 tender response"}
+	for _, message := range messages {
+		verdict.messageIDs = append(verdict.messageIDs, message.ID)
+		response := message.PayloadSnapshot.TenderResponse
+		if response == nil {
+			continue
+		}
+		switch strings.ToUpper(strings.TrimSpace(response.ResponseCode)) {
+		case "A":
+			verdict.passed = true
+			verdict.detail = "Partner accepted the load tender"
+			verdict.messageIDs = []pulid.ID{message.ID}
+			return verdict
+		case "D", "R":
+			verdict.failed = true
+			verdict.detail = fmt.Sprintf(
+				"Partner declined the load tender with response code %s",
+				response.ResponseCode,
+			)
+			verdict.messageIDs = []pulid.ID{message.ID}
+			return verdict
+		default:
+			verdict.detail = fmt.Sprintf(
+				"Received a 990 with unsupported response code %q",
+				response.ResponseCode,
+			)
+		}
+	}
+	return verdict
+}
+
+func judgeCertificationStatusUpdates(
+	messages []*edi.EDIMessage,
+	expected int64,
+) certificationVerdict {
+	verdict := certificationVerdict{messageIDs: make([]pulid.ID, 0, len(messages))}
+	for _, message := range messages {
+		verdict.messageIDs = append(verdict.messageIDs, message.ID)
+	}
+	received := int64(len(messages))
+	verdict.detail = fmt.Sprintf("Received %d of %d 214 status updates", received, expected)
+	verdict.passed = received >= expected
+	return verdict
+}
+
+// judgeCertificationAcknowledgments requires an accepting 997 for every
+// document the run sent. Pending acknowledgments keep the step waiting.
+func judgeCertificationAcknowledgments(messages []*edi.EDIMessage) certificationVerdict {
+	verdict := certificationVerdict{}
+	if len(messages) == 0 {
+		verdict.failed = true
+		verdict.detail = "No documents were sent during the certification run"
+		return verdict
+	}
+	pending := 0
+	for _, message := range messages {
+		label := fmt.Sprintf("%s %s", message.TransactionSet, message.TransactionControlNumber)
+		switch {
+		case message.DeliveryStatus == edi.MessageDeliveryStatusFailed ||
+			message.DeliveryStatus == edi.MessageDeliveryStatusDeadLettered:
+			verdict.failed = true
+			verdict.detail = fmt.Sprintf("%s could not be delivered to the partner", label)
+			return verdict
+		case message.AckStatus == edi.MessageAcknowledgmentStatusAccepted:
+		case message.AckStatus == edi.MessageAcknowledgmentStatusNotExpected:
+			verdict.failed = true
+			verdict.detail = fmt.Sprintf(
+				"%s does not request a functional acknowledgment",
+				label,
+			)
+			return verdict
+		case message.AckStatus == edi.MessageAcknowledgmentStatusRejected ||
+			message.AckStatus == edi.MessageAcknowledgmentStatusFailed:
+			verdict.failed = true
+			verdict.detail = fmt.Sprintf("%s was %s by the partner's 997", label, message.AckStatus)
+			if message.AckLastError != "" {
+				verdict.detail += ": " + message.AckLastError
+			}
+			return verdict
+		default:
+			pending++
+		}
+	}
+	if pending > 0 {
+		verdict.detail = fmt.Sprintf(
+			"Waiting for %d of %d 997 acknowledgments",
+			pending,
+			len(messages),
+		)
+		return verdict
+	}
+	verdict.passed = true
+	verdict.detail = fmt.Sprintf("Partner accepted all %d documents", len(messages))
+	return verdict
+}
+
+func certificationTimeoutDetail(
+	key edi.CertificationStepKey,
+	progress string,
+	run *edi.EDICertificationRun,
+) string {
+	minutes := run.ResponseTimeoutSeconds / 60
+	switch key {
+	case edi.CertificationStepAwaitTenderResponse:
+		return fmt.Sprintf("No accepting 990 received within %d minutes", minutes)
+	case edi.CertificationStepAwaitShipmentStatus,
+		edi.CertificationStepVerifyAcknowledgments:
+		return fmt.Sprintf("%s after %d minutes", progress, minutes)
+	case edi.CertificationStepSendLoadTender, edi.CertificationStepSendFreightInvoice:
+	}
+	return fmt.Sprintf("Step did not complete within %d minutes", minutes)
+}
@@ -0,0 +1,519 @@
+package ediservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func certificationMessage(
+	transactionSet edi.TransactionSet,
+	payload edi.DocumentPayload,
+) *edi.EDIMessage {
+	return &edi.EDIMessage{
+		ID:                       pulid.MustNew("edimsg_"),
+		TransactionSet:           transactionSet,
+		TransactionControlNumber: "0001",
+		PayloadSnapshot:          payload,
+	}
+}
+
+func tenderResponseMessage(code string) *edi.EDIMessage {
+	return certificationMessage(edi.TransactionSet990, edi.DocumentPayload{
+		TenderResponse: &edi.TenderResponsePayload{ResponseCode: code},
+	})
+}
+
+func certificationRunAt(step edi.CertificationStepKey, startedAt int64) *edi.EDICertificationRun {
+	run := &edi.EDICertificationRun{
+		ID:                     pulid.MustNew("edicr_"),
+		OrganizationID:         pulid.MustNew("org_"),
+		BusinessUnitID:         pulid.MustNew("bu_"),
+		EDIPartnerID:           pulid.MustNew("edip_"),
+		Status:                 edi.CertificationRunStatusRunning,
+		Steps:                  edi.NewCertificationSteps(),
+		ResponseTimeoutSeconds: 900,
+		ExpectedStatusCount:    2,
+		StartedAt:              startedAt,
+	}
+	for i := range run.Steps {
+		if run.Steps[i].Key == step {
+			run.Steps[i].Status = edi.CertificationStepStatusRunning
+			run.Steps[i].StartedAt = &startedAt
+			break
+		}
+		run.Steps[i].Status = edi.CertificationStepStatusPassed
+		run.Steps[i].StartedAt = &startedAt
+		run.Steps[i].CompletedAt = &startedAt
+	}
+	return run
+}
+
+func TestJudgeCertificationTenderResponses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		messages []*edi.EDIMessage
+		passed   bool
+		failed   bool
+	}{
+		{name: "no response yet"},
+		{
+			name:     "accepted",
+			messages: []*edi.EDIMessage{tenderResponseMessage("A")},
+			passed:   true,
+		},
+		{
+			name:     "declined",
+			messages: []*edi.EDIMessage{tenderResponseMessage("D")},
+			failed:   true,
+		},
+		{
+			name:     "unsupported code keeps waiting",
+			messages: []*edi.EDIMessage{tenderResponseMessage("X")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verdict := judgeCertificationTenderResponses(tt.messages)
+			assert.Equal(t, tt.passed, verdict.passed)
+			assert.Equal(t, tt.failed, verdict.failed)
+		})
+	}
+}
+
+func TestJudgeCertificationAcknowledgments(t *testing.T) {
+	t.Parallel()
+
+	withAck := func(
+		status edi.MessageAcknowledgmentStatus,
+		delivery edi.MessageDeliveryStatus,
+	) *edi.EDIMessage {
+		message := certificationMessage(edi.TransactionSet204, edi.DocumentPayload{})
+		message.AckStatus = status
+		message.DeliveryStatus = delivery
+		return message
+	}
+	accepted := withAck(edi.MessageAcknowledgmentStatusAccepted, edi.MessageDeliveryStatusSent)
+
+	tests := []struct {
+		name     string
+		messages []*edi.EDIMessage
+		passed   bool
+		failed   bool
+		detail   string
+	}{
+		{
+			name:   "nothing sent",
+			failed: true,
+			detail: "No documents were sent during the certification run",
+		},
+		{
+			name:     "all accepted",
+			messages: []*edi.EDIMessage{accepted, accepted},
+			passed:   true,
+			detail:   "Partner accepted all 2 documents",
+		},
+		{
+			name: "pending keeps waiting",
+			messages: []*edi.EDIMessage{
+				accepted,
+				withAck(edi.MessageAcknowledgmentStatusPending, edi.MessageDeliveryStatusSent),
+			},
+			detail: "Waiting for 1 of 2 997 acknowledgments",
+		},
+		{
+			name: "rejected",
+			messages: []*edi.EDIMessage{
+				withAck(edi.MessageAcknowledgmentStatusRejected, edi.MessageDeliveryStatusSent),
+			},
+			failed: true,
+			detail: "204 0001 was Rejected by the partner's 997",
+		},
+		{
+			name: "not expected",
+			messages: []*edi.EDIMessage{
+				withAck(edi.MessageAcknowledgmentStatusNotExpected, edi.MessageDeliveryStatusSent),
+			},
+			failed: true,
+			detail: "204 0001 does not request a functional acknowledgment",
+		},
+		{
+			name: "dead lettered",
+			messages: []*edi.EDIMessage{
+				withAck(
+					edi.MessageAcknowledgmentStatusPending,
+					edi.MessageDeliveryStatusDeadLettered,
+				),
+			},
+			failed: true,
+			detail: "204 0001 could not be delivered to the partner",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verdict := judgeCertificationAcknowledgments(tt.messages)
+			assert.Equal(t, tt.passed, verdict.passed)
+			assert.Equal(t, tt.failed, verdict.failed)
+			assert.Equal(t, tt.detail, verdict.detail)
+		})
+	}
+}
+
+// certificationRunWithTender returns a run waiting at step whose 204 step
+// sent a load tender for a test shipment carrying bol.
+func certificationRunWithTender(
+	step edi.CertificationStepKey,
+	startedAt int64,
+	bol string,
+) (*edi.EDICertificationRun, *edi.EDIMessage) {
+	run := certificationRunAt(step, startedAt)
+	run.ShipmentID = pulid.MustNew("shp_")
+	sent := certificationMessage(edi.TransactionSet204, edi.DocumentPayload{
+		LoadTender: &edi.LoadTenderPayload{ShipmentID: run.ShipmentID, BOL: bol},
+	})
+	run.Step(edi.CertificationStepSendLoadTender).MessageIDs = []pulid.ID{sent.ID}
+	return run, sent
+}
+
+func expectCertificationSentMessages(
+	certRepo *mocks.MockEDICertificationRunRepository,
+	sent *edi.EDIMessage,
+) {
+	certRepo.EXPECT().
+		ListCertificationMessages(
+			mock.Anything,
+			mock.MatchedBy(func(req repositories.ListEDICertificationMessagesRequest) bool {
+				return len(req.MessageIDs) == 1 && req.MessageIDs[0] == sent.ID
+			}),
+		).
+		Return([]*edi.EDIMessage{sent}, nil)
+}
+
+func TestAdvanceCertificationRunWaitsForStatusUpdates(t *testing.T) {
+	t.Parallel()
+
+	now := timeutils.NowUnix()
+	run, sent := certificationRunWithTender(edi.CertificationStepAwaitTenderResponse, now, "BOL-1")
+	tender := tenderResponseMessage("A")
+	tender.PayloadSnapshot.TenderResponse.BOL = "BOL-1"
+	status := certificationMessage(edi.TransactionSet214, edi.DocumentPayload{
+		ShipmentStatus: &edi.ShipmentStatusPayload{BOL: run.ShipmentID.String()},
+	})
+
+	certRepo := mocks.NewMockEDICertificationRunRepository(t)
+	certRepo.EXPECT().
+		GetCertificationRunByID(mock.Anything, mock.Anything).
+		Return(run, nil).
+		Once()
+	expectCertificationSentMessages(certRepo, sent)
+	certRepo.EXPECT().
+		ListCertificationMessages(
+			mock.Anything,
+			mock.MatchedBy(func(req repositories.ListEDICertificationMessagesRequest) bool {
+				return req.TransactionSet == edi.TransactionSet990 &&
+					req.Direction == edi.DocumentDirectionInbound
+			}),
+		).
+		Return([]*edi.EDIMessage{tender}, nil).
+		Once()
+	certRepo.EXPECT().
+		ListCertificationMessages(
+			mock.Anything,
+			mock.MatchedBy(func(req repositories.ListEDICertificationMessagesRequest) bool {
+				return req.TransactionSet == edi.TransactionSet214
+			}),
+		).
+		Return([]*edi.EDIMessage{status}, nil).
+		Once()
+	certRepo.EXPECT().
+		UpdateCertificationRun(mock.Anything, run).
+		Return(run, nil).
+		Times(3)
+
+	svc := New(Params{Logger: zap.NewNop(), CertificationRepo: certRepo})
+	result, err := svc.AdvanceCertificationRun(t.Context(), &AdvanceEDICertificationRunPayload{
+		RunID:      run.ID,
+		TenantInfo: certificationTenantInfo(run),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Completed)
+	assert.Equal(t, edi.CertificationRunStatusRunning, result.Status)
+	response := run.Step(edi.CertificationStepAwaitTenderResponse)
+	assert.Equal(t, edi.CertificationStepStatusPassed, response.Status)
+	assert.Equal(t, []pulid.ID{tender.ID}, response.MessageIDs)
+	statuses := run.Step(edi.CertificationStepAwaitShipmentStatus)
+	assert.Equal(t, edi.CertificationStepStatusRunning, statuses.Status)
+	assert.Equal(t, "Received 1 of 2 214 status updates", statuses.Detail)
+}
+
+func TestAdvanceCertificationRunIgnoresMessagesForOtherShipments(t *testing.T) {
+	t.Parallel()
+
+	now := timeutils.NowUnix()
+	run, sent := certificationRunWithTender(edi.CertificationStepAwaitTenderResponse, now, "BOL-1")
+	unrelated := tenderResponseMessage("A")
+	unrelated.PayloadSnapshot.TenderResponse.BOL = "BOL-PRODUCTION"
+	unreferenced := tenderResponseMessage("A")
+
+	certRepo := mocks.NewMockEDICertificationRunRepository(t)
+	certRepo.EXPECT().
+		GetCertificationRunByID(mock.Anything, mock.Anything).
+		Return(run, nil).
+		Once()
+	expectCertificationSentMessages(certRepo, sent)
+	certRepo.EXPECT().
+		ListCertificationMessages(
+			mock.Anything,
+			mock.MatchedBy(func(req repositories.ListEDICertificationMessagesRequest) bool {
+				return req.TransactionSet == edi.TransactionSet990
+			}),
+		).
+		Return([]*edi.EDIMessage{unrelated, unreferenced}, nil).
+		Once()
+	certRepo.EXPECT().
+		UpdateCertificationRun(mock.Anything, run).
+		Return(run, nil).
+		Once()
+
+	svc := New(Params{Logger: zap.NewNop(), CertificationRepo: certRepo})
+	result, err := svc.AdvanceCertificationRun(t.Context(), &AdvanceEDICertificationRunPayload{
+		RunID:      run.ID,
+		TenantInfo: certificationTenantInfo(run),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Completed)
+	response := run.Step(edi.CertificationStepAwaitTenderResponse)
+	assert.Equal(t, edi.CertificationStepStatusRunning, response.Status)
+	assert.Equal(t, "Waiting for a 990 tender response", response.Detail)
+	assert.Empty(t, response.MessageIDs)
+}
+
+func TestAdvanceCertificationRunFailsStepAfterTimeout(t *testing.T) {
+	t.Parallel()
+
+	startedAt := timeutils.NowUnix() - 901
+	run := certificationRunAt(edi.CertificationStepAwaitShipmentStatus, startedAt)
+
+	certRepo := mocks.NewMockEDICertificationRunRepository(t)
+	certRepo.EXPECT().
+		GetCertificationRunByID(mock.Anything, mock.Anything).
+		Return(run, nil).
+		Once()
+	certRepo.EXPECT().
+		ListCertificationMessages(mock.Anything, mock.Anything).
+		Return([]*edi.EDIMessage{}, nil).
+		Once()
+	certRepo.EXPECT().
+		UpdateCertificationRun(mock.Anything, run).
+		Return(run, nil).
+		Once()
+
+	svc := New(Params{Logger: zap.NewNop(), CertificationRepo: certRepo})
+	result, err := svc.AdvanceCertificationRun(t.Context(), &AdvanceEDICertificationRunPayload{
+		RunID:      run.ID,
+		TenantInfo: certificationTenantInfo(run),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Completed)
+	assert.Equal(t, edi.CertificationRunStatusFailed, run.Status)
+	assert.Equal(
+		t,
+		"Receive 214 shipment status sequence: Received 0 of 2 214 status updates after 15 minutes",
+		run.FailureReason,
+	)
+	assert.Equal(
+		t,
+		edi.CertificationStepStatusSkipped,
+		run.Step(edi.CertificationStepSendFreightInvoice).Status,
+	)
+	assert.Equal(
+		t,
+		edi.CertificationStepStatusSkipped,
+		run.Step(edi.CertificationStepVerifyAcknowledgments).Status,
+	)
+	require.NotNil(t, run.CompletedAt)
+}
+
+func TestAdvanceCertificationRunRecordsStepStartBeforeSending(t *testing.T) {
+	t.Parallel()
+
+	run := certificationRunAt(edi.CertificationStepSendLoadTender, timeutils.NowUnix())
+	step := run.Step(edi.CertificationStepSendLoadTender)
+	step.Status = edi.CertificationStepStatusPending
+	step.StartedAt = nil
+
+	certRepo := mocks.NewMockEDICertificationRunRepository(t)
+	certRepo.EXPECT().
+		GetCertificationRunByID(mock.Anything, mock.Anything).
+		Return(run, nil).
+		Once()
+	certRepo.EXPECT().
+		UpdateCertificationRun(mock.Anything, run).
+		Return(nil, errortypes.NewBusinessError("version mismatch")).
+		Once()
+
+	svc := New(Params{Logger: zap.NewNop(), CertificationRepo: certRepo})
+	_, err := svc.AdvanceCertificationRun(t.Context(), &AdvanceEDICertificationRunPayload{
+		RunID:      run.ID,
+		TenantInfo: certificationTenantInfo(run),
+	})
+
+	require.Error(t, err)
+	assert.Empty(t, step.MessageIDs)
+}
+
+func TestAdvanceCertificationRunAdoptsTenderSentByEarlierAttempt(t *testing.T) {
+	t.Parallel()
+
+	run := certificationRunAt(edi.CertificationStepSendLoadTender, timeutils.NowUnix())
+	run.ShipmentID = pulid.MustNew("shp_")
+	other := certificationMessage(edi.TransactionSet204, edi.DocumentPayload{})
+	other.ShipmentID = pulid.MustNew("shp_")
+	sent := certificationMessage(edi.TransactionSet204, edi.DocumentPayload{})
+	sent.ShipmentID = run.ShipmentID
+
+	certRepo := mocks.NewMockEDICertificationRunRepository(t)
+	certRepo.EXPECT().
+		GetCertificationRunByID(mock.Anything, mock.Anything).
+		Return(run, nil).
+		Once()
+	certRepo.EXPECT().
+		ListCertificationMessages(
+			mock.Anything,
+			mock.MatchedBy(func(req repositories.ListEDICertificationMessagesRequest) bool {
+				return req.TransactionSet == edi.TransactionSet204 &&
+					req.Direction == edi.DocumentDirectionOutbound
+			}),
+		).
+		Return([]*edi.EDIMessage{other, sent}, nil).
+		Once()
+	certRepo.EXPECT().
+		ListCertificationMessages(mock.Anything, mock.Anything).
+		Return([]*edi.EDIMessage{}, nil).
+		Maybe()
+	certRepo.EXPECT().
+		UpdateCertificationRun(mock.Anything, run).
+		Return(run, nil)
+
+	svc := New(Params{Logger: zap.NewNop(), CertificationRepo: certRepo})
+	_, err := svc.AdvanceCertificationRun(t.Context(), &AdvanceEDICertificationRunPayload{
+		RunID:      run.ID,
+		TenantInfo: certificationTenantInfo(run),
+	})
+
+	require.NoError(t, err)
+	tender := run.Step(edi.CertificationStepSendLoadTender)
+	assert.Equal(t, edi.CertificationStepStatusPassed, tender.Status)
+	assert.Equal(t, []pulid.ID{sent.ID}, tender.MessageIDs)
+}
+
+func TestAcceptExternalConnectionRequiresPassingCertification(t *testing.T) {
+	t.Parallel()
+
+	tenantInfo := pagination.TenantInfo{
+		OrgID:  pulid.MustNew("org_"),
+		BuID:   pulid.MustNew("bu_"),
+		UserID: pulid.MustNew("usr_"),
+	}
+	pendingConnection := func() *edi.EDIConnection {
+		return &edi.EDIConnection{
+			ID:                   pulid.MustNew("edic_"),
+			BusinessUnitID:       tenantInfo.BuID,
+			SourceOrganizationID: pulid.MustNew("org_"),
+			TargetOrganizationID: tenantInfo.OrgID,
+			Method:               edi.ConnectionMethodAS2,
+			Status:               edi.ConnectionStatusPendingAcceptance,
+		}
+	}
+	actor := &services.RequestActor{UserID: tenantInfo.UserID}
+
+	tests := []struct {
+		name   string
+		latest *edi.EDICertificationRun
+		err    error
+	}{
+		{name: "never certified", err: errortypes.NewNotFoundError("not found")},
+		{
+			name:   "latest run failed",
+			latest: &edi.EDICertificationRun{Status: edi.CertificationRunStatusFailed},
+		},
+		{
+			name:   "latest run passed",
+			latest: &edi.EDICertificationRun{Status: edi.CertificationRunStatusPassed},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := pendingConnection()
+			connectionRepo := mocks.NewMockEDIConnectionRepository(t)
+			certRepo := mocks.NewMockEDICertificationRunRepository(t)
+			connectionRepo.EXPECT().
+				GetConnectionForUpdate(mock.Anything, mock.Anything).
+				Return(connection, nil).
+				Once()
+			certRepo.EXPECT().
+				GetLatestCertificationRun(
+					mock.Anything,
+					repositories.GetLatestEDICertificationRunRequest{
+						TenantInfo:      tenantInfo,
+						EDIConnectionID: connection.ID,
+					},
+				).
+				Return(tt.latest, tt.err).
+				Once()
+			passed := tt.latest != nil && tt.latest.Status == edi.CertificationRunStatusPassed
+			if passed {
+				connectionRepo.EXPECT().
+					UpdateConnection(mock.Anything, connection).
+					Return(connection, nil).
+					Once()
+			}
+
+			svc := New(Params{
+				Logger:            zap.NewNop(),
+				ConnectionRepo:    connectionRepo,
+				CertificationRepo: certRepo,
+			})
+			accepted, err := svc.AcceptConnection(
+				t.Context(),
+				&EDIConnectionActionRequest{
+					ConnectionID: connection.ID,
+					TenantInfo:   tenantInfo,
+				},
+				actor,
+			)
+
+			if !passed {
+				require.Error(t, err)
+				assert.True(t, errortypes.IsBusinessError(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, edi.ConnectionStatusActive, accepted.Status)
+			assert.Equal(t, tenantInfo.UserID, accepted.AcceptedByID)
+			require.NotNil(t, accepted.AcceptedAt)
+		})
+	}
+}
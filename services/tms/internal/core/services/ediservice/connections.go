@@ -101,11 +101,6 @@ func (s *Service) AcceptConnection(
 			"Only pending EDI connections can be accepted",
 		)
 	}
-	if connection.Method != edi.ConnectionMethodInternal {
-		return nil, errortypes.NewBusinessError(
-			"external EDI connections can be configured but are not accepted through invite flow",
-		)
-	}
 
 	now := timeutils.NowUnix()
 	original := *connection
@@ -113,6 +108,25 @@ func (s *Service) AcceptConnection(
 	connection.AcceptedByID = actor.UserID
 	connection.AcceptedAt = &now
 
+	if connection.Method != edi.ConnectionMethodInternal {
+		if err = s.requirePassingCertification(ctx, connection, req.TenantInfo); err != nil {
+			return nil, err
+		}
+		accepted, updateErr := s.connectionRepo.UpdateConnection(ctx, connection)
+		if updateErr != nil {
+			return nil, mapEDIConnectionConstraint(updateErr)
+		}
+		s.logAction(
+			accepted,
+			actor,
+			permission.OpUpdate,
+			&original,
+			accepted,
+			"EDI connection accepted after certification",
+		)
+		return accepted, nil
+	}
+
 	sourcePartner := buildConnectionPartner(
 		connection,
 		connection.SourceOrganizationID,
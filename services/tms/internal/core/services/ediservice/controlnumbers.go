@@ -0,0 +1,420 @@
+package ediservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.uber.org/zap"
+)
+
+// ControlNumberObservation is one ISA13, GS06 or ST02 seen on an inbound file
+// or an outbound message.
+type ControlNumberObservation struct {
+	TenantInfo     pagination.TenantInfo
+	EDIPartnerID   pulid.ID
+	Direction      edi.DocumentDirection
+	Kind           edi.ControlNumberKind
+	SequenceScope  string
+	ControlNumber  string
+	TransactionSet edi.TransactionSet
+	InboundFileID  pulid.ID
+	MessageID      pulid.ID
+	ContentHash    string
+	// DuplicateOfID marks the observation as a resend of an earlier entry
+	// found by content rather than by control number.
+	DuplicateOfID pulid.ID
+	Rejected      bool
+}
+
+// ControlNumberContentHash fingerprints a transaction so a resend under a new
+// envelope can be matched to the original.
+func ControlNumberContentHash(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Service) ListControlNumberLedger(
+	ctx context.Context,
+	req *repositories.ListEDIControlNumberLedgerRequest,
+) (*pagination.ListResult[*edi.EDIControlNumberLedgerEntry], error) {
+	if err := s.requireControlNumberLedgerRepo(); err != nil {
+		return nil, err
+	}
+	return s.ledgerRepo.ListLedgerEntries(ctx, req)
+}
+
+func (s *Service) SummarizeControlNumberLedger(
+	ctx context.Context,
+	req repositories.SummarizeEDIControlNumberLedgerRequest,
+) ([]*edi.ControlNumberLedgerSummary, error) {
+	if err := s.requireControlNumberLedgerRepo(); err != nil {
+		return nil, err
+	}
+	return s.ledgerRepo.SummarizeLedger(ctx, req)
+}
+
+// ClaimInboundTransaction ledgers an inbound ST02 as received and holds its
+// content against resends until SettleInboundTransaction records the outcome.
+// When another file already holds the content, the transaction is ledgered as
+// a rejected duplicate and the original is returned instead of a claim. A file
+// that is processed again reuses its own claim.
+func (s *Service) ClaimInboundTransaction(
+	ctx context.Context,
+	obs *ControlNumberObservation,
+) (claim, original *edi.EDIControlNumberLedgerEntry, err error) {
+	if s.ledgerRepo == nil || obs == nil || obs.ContentHash == "" {
+		return nil, nil, nil
+	}
+	find := repositories.FindResentTransactionRequest{
+		TenantInfo:     obs.TenantInfo,
+		EDIPartnerID:   obs.EDIPartnerID,
+		TransactionSet: obs.TransactionSet,
+		ContentHash:    obs.ContentHash,
+	}
+	// A claim is only lost to a concurrent insert, after which the lookup
+	// finds the winner, so one retry settles every race.
+	for range 2 {
+		original, err = s.ledgerRepo.FindResentTransaction(ctx, find)
+		if err != nil {
+			return nil, nil, err
+		}
+		if original != nil {
+			if original.InboundFileID == obs.InboundFileID {
+				return original, nil, nil
+			}
+			resend := *obs
+			resend.DuplicateOfID = original.ID
+			resend.Rejected = true
+			if _, err = s.RecordControlNumber(ctx, &resend); err != nil {
+				return nil, original, err
+			}
+			return nil, original, nil
+		}
+		claim, err = s.claimTransactionEntry(ctx, obs)
+		if err != nil || claim != nil {
+			return claim, nil, err
+		}
+	}
+	return nil, nil, fmt.Errorf(
+		"transaction %s/%s could not be claimed in the control number ledger",
+		obs.TransactionSet,
+		obs.ControlNumber,
+	)
+}
+
+// SettleInboundTransaction records how processing a claimed transaction
+// ended. A failed transaction releases its content so a resend is processed.
+func (s *Service) SettleInboundTransaction(
+	ctx context.Context,
+	claim *edi.EDIControlNumberLedgerEntry,
+	processErr error,
+) error {
+	if s.ledgerRepo == nil || claim == nil {
+		return nil
+	}
+	outcome := edi.ControlNumberOutcomeProcessed
+	if processErr != nil {
+		outcome = edi.ControlNumberOutcomeFailed
+	}
+	return s.ledgerRepo.UpdateTransactionOutcome(
+		ctx,
+		repositories.UpdateTransactionOutcomeRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: claim.OrganizationID,
+				BuID:  claim.BusinessUnitID,
+			},
+			EntryID: claim.ID,
+			Outcome: outcome,
+		},
+	)
+}
+
+// claimTransactionEntry inserts the observation as a received entry, or
+// returns nil when a concurrent file claimed the content first. An earlier
+// failed entry from the same file is claimed again rather than duplicated.
+func (s *Service) claimTransactionEntry(
+	ctx context.Context,
+	obs *ControlNumberObservation,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	entry, recorded, err := s.newLedgerEntry(ctx, obs)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case recorded != nil && recorded.Rejected:
+		// The file was turned away as a resend of an original that has
+		// since failed, so this attempt is ledgered as a new entry.
+		entry = &edi.EDIControlNumberLedgerEntry{
+			OrganizationID: recorded.OrganizationID,
+			BusinessUnitID: recorded.BusinessUnitID,
+			EDIPartnerID:   recorded.EDIPartnerID,
+			Direction:      recorded.Direction,
+			Kind:           recorded.Kind,
+			SequenceScope:  recorded.SequenceScope,
+			ControlNumber:  recorded.ControlNumber,
+			Sequence:       recorded.Sequence,
+			TransactionSet: recorded.TransactionSet,
+			InboundFileID:  recorded.InboundFileID,
+			ContentHash:    recorded.ContentHash,
+			Anomaly:        edi.ControlNumberAnomalyDuplicate,
+			DuplicateOfID:  recorded.ID,
+		}
+	case recorded != nil:
+		err = s.ledgerRepo.UpdateTransactionOutcome(
+			ctx,
+			repositories.UpdateTransactionOutcomeRequest{
+				TenantInfo: obs.TenantInfo,
+				EntryID:    recorded.ID,
+				Outcome:    edi.ControlNumberOutcomeReceived,
+			},
+		)
+		if dberror.IsUniqueConstraintViolation(err) {
+			return nil, nil //nolint:nilnil // another file holds the transaction
+		}
+		if err != nil {
+			return nil, err
+		}
+		recorded.Outcome = edi.ControlNumberOutcomeReceived
+		return recorded, nil
+	}
+	entry.Outcome = edi.ControlNumberOutcomeReceived
+	created, err := s.ledgerRepo.ClaimTransactionEntry(ctx, entry)
+	if err != nil || created == nil {
+		return nil, err
+	}
+	s.notifyControlNumberAnomaly(ctx, created)
+	return created, nil
+}
+
+// RecordControlNumber appends the observation to the partner's ledger,
+// classifies it against the earlier entries in its sequence, and alerts on
+// gaps, duplicates and out-of-order numbers. Recording the same file or
+// message twice returns the original entry.
+func (s *Service) RecordControlNumber(
+	ctx context.Context,
+	obs *ControlNumberObservation,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	if s.ledgerRepo == nil || obs == nil ||
+		strings.TrimSpace(obs.ControlNumber) == "" {
+		return nil, nil //nolint:nilnil // the ledger is optional
+	}
+	entry, recorded, err := s.newLedgerEntry(ctx, obs)
+	if err != nil {
+		return nil, err
+	}
+	if recorded != nil {
+		return recorded, nil
+	}
+	created, err := s.ledgerRepo.CreateLedgerEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyControlNumberAnomaly(ctx, created)
+	return created, nil
+}
+
+// newLedgerEntry builds and classifies the entry for an observation. When the
+// same file or message already recorded the control number, that entry is
+// returned as recorded instead.
+func (s *Service) newLedgerEntry(
+	ctx context.Context,
+	obs *ControlNumberObservation,
+) (entry, recorded *edi.EDIControlNumberLedgerEntry, err error) {
+	sequence := repositories.ControlNumberSequenceRequest{
+		TenantInfo:    obs.TenantInfo,
+		EDIPartnerID:  obs.EDIPartnerID,
+		Direction:     obs.Direction,
+		Kind:          obs.Kind,
+		SequenceScope: obs.SequenceScope,
+	}
+	prior, err := s.ledgerRepo.FindControlNumberEntry(
+		ctx,
+		repositories.FindControlNumberEntryRequest{
+			Sequence:      sequence,
+			ControlNumber: obs.ControlNumber,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if prior != nil && sameControlNumberSource(prior, obs) {
+		return nil, prior, nil
+	}
+	highest, err := s.ledgerRepo.GetHighestSequence(ctx, sequence)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry = &edi.EDIControlNumberLedgerEntry{
+		OrganizationID: obs.TenantInfo.OrgID,
+		BusinessUnitID: obs.TenantInfo.BuID,
+		EDIPartnerID:   obs.EDIPartnerID,
+		Direction:      obs.Direction,
+		Kind:           obs.Kind,
+		SequenceScope:  obs.SequenceScope,
+		ControlNumber:  obs.ControlNumber,
+		Sequence:       controlNumberSequence(obs.ControlNumber),
+		TransactionSet: obs.TransactionSet,
+		InboundFileID:  obs.InboundFileID,
+		MessageID:      obs.MessageID,
+		ContentHash:    obs.ContentHash,
+		Rejected:       obs.Rejected,
+		Outcome:        edi.ControlNumberOutcomeProcessed,
+	}
+	classifyControlNumber(entry, highest, prior, obs.DuplicateOfID)
+	return entry, nil, nil
+}
+
+// recordOutboundControlNumbers ledgers a generated message. Outbound numbers
+// are allocated per document type, so each document type is its own sequence.
+func (s *Service) recordOutboundControlNumbers(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	message *edi.EDIMessage,
+) {
+	numbers := []struct {
+		kind  edi.ControlNumberKind
+		value string
+	}{
+		{kind: edi.ControlNumberKindInterchange, value: message.InterchangeControlNumber},
+		{kind: edi.ControlNumberKindGroup, value: message.GroupControlNumber},
+		{kind: edi.ControlNumberKindTransaction, value: message.TransactionControlNumber},
+	}
+	for _, number := range numbers {
+		obs := &ControlNumberObservation{
+			TenantInfo:    tenantInfo,
+			EDIPartnerID:  message.EDIPartnerID,
+			Direction:     message.Direction,
+			Kind:          number.kind,
+			SequenceScope: message.DocumentTypeID.String(),
+			ControlNumber: number.value,
+			MessageID:     message.ID,
+		}
+		if number.kind == edi.ControlNumberKindTransaction {
+			obs.TransactionSet = message.TransactionSet
+		}
+		if _, err := s.RecordControlNumber(ctx, obs); err != nil {
+			s.l.Warn(
+				"failed to record EDI control number",
+				zap.String("messageId", message.ID.String()),
+				zap.String("kind", string(number.kind)),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func classifyControlNumber(
+	entry *edi.EDIControlNumberLedgerEntry,
+	highest int64,
+	prior *edi.EDIControlNumberLedgerEntry,
+	duplicateOfID pulid.ID,
+) {
+	entry.Anomaly = edi.ControlNumberAnomalyNone
+	switch {
+	case duplicateOfID.IsNotNil():
+		entry.Anomaly = edi.ControlNumberAnomalyDuplicate
+		entry.DuplicateOfID = duplicateOfID
+	case prior != nil:
+		entry.Anomaly = edi.ControlNumberAnomalyDuplicate
+		entry.DuplicateOfID = prior.ID
+	case entry.Sequence == 0 || highest == 0 || entry.Sequence == highest+1:
+	case entry.Sequence > highest+1:
+		entry.Anomaly = edi.ControlNumberAnomalyGap
+		entry.ExpectedControlNumber = fmt.Sprintf(
+			"%0*d",
+			len(entry.ControlNumber),
+			highest+1,
+		)
+	default:
+		entry.Anomaly = edi.ControlNumberAnomalyOutOfOrder
+	}
+}
+
+// controlNumberSequence parses the numeric value of a control number. Zero
+// means the number is not numeric and is only checked for duplicates.
+func controlNumberSequence(controlNumber string) int64 {
+	value, err := strconv.ParseInt(strings.TrimSpace(controlNumber), 10, 64)
+	if err != nil || value < 0 {
+		return 0
+	}
+	return value
+}
+
+func sameControlNumberSource(
+	entry *edi.EDIControlNumberLedgerEntry,
+	obs *ControlNumberObservation,
+) bool {
+	if obs.InboundFileID.IsNotNil() && entry.InboundFileID == obs.InboundFileID {
+		return true
+	}
+	return obs.MessageID.IsNotNil() && entry.MessageID == obs.MessageID
+}
+
+func (s *Service) notifyControlNumberAnomaly(
+	ctx context.Context,
+	entry *edi.EDIControlNumberLedgerEntry,
+) {
+	alert := &EDIOperationalAlert{
+		OrganizationID: entry.OrganizationID,
+		BusinessUnitID: entry.BusinessUnitID,
+		PartnerID:      entry.EDIPartnerID,
+		RelatedEntities: map[string]any{
+			"ledgerEntryId": entry.ID,
+			"partnerId":     entry.EDIPartnerID,
+		},
+		Data: map[string]any{
+			"direction":     entry.Direction,
+			"kind":          entry.Kind,
+			"controlNumber": entry.ControlNumber,
+			"link": "/edi/partners?panelType=edit&panelEntityId=" +
+				entry.EDIPartnerID.String(),
+		},
+	}
+	label := fmt.Sprintf(
+		"%s %s control number %s",
+		entry.Direction,
+		strings.ToLower(string(entry.Kind)),
+		entry.ControlNumber,
+	)
+	switch entry.Anomaly {
+	case edi.ControlNumberAnomalyGap:
+		alert.EventType = EDIAlertEventControlNumberGap
+		alert.Title = "EDI control number gap"
+		alert.Message = fmt.Sprintf(
+			"%s arrived when %s was expected",
+			label,
+			entry.ExpectedControlNumber,
+		)
+	case edi.ControlNumberAnomalyDuplicate:
+		alert.EventType = EDIAlertEventControlNumberDuplicate
+		alert.Title = "EDI control number duplicate"
+		alert.Message = label + " was already recorded"
+		if entry.Rejected {
+			alert.Message += " and was not processed again"
+		}
+	case edi.ControlNumberAnomalyOutOfOrder:
+		alert.EventType = EDIAlertEventControlNumberOutOfOrder
+		alert.Title = "EDI control number out of order"
+		alert.Message = label + " arrived after a higher control number"
+	case edi.ControlNumberAnomalyNone:
+		return
+	}
+	s.NotifyOperationalFailure(ctx, alert)
+}
+
+func (s *Service) requireControlNumberLedgerRepo() error {
+	if s.ledgerRepo == nil {
+		return errortypes.NewBusinessError("EDI control number ledger is not configured")
+	}
+	return nil
+}
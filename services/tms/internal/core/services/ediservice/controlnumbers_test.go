@@ -0,0 +1,304 @@
+package ediservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/notification"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/notificationservice"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestClassifyControlNumber(t *testing.T) {
+	t.Parallel()
+
+	prior := &edi.EDIControlNumberLedgerEntry{ID: pulid.MustNew("edicnl_")}
+	resentOf := pulid.MustNew("edicnl_")
+	tests := []struct {
+		name          string
+		controlNumber string
+		highest       int64
+		prior         *edi.EDIControlNumberLedgerEntry
+		duplicateOfID pulid.ID
+		anomaly       edi.ControlNumberAnomaly
+		expected      string
+		duplicateOf   pulid.ID
+	}{
+		{
+			name:          "first number in the sequence",
+			controlNumber: "000000042",
+			anomaly:       edi.ControlNumberAnomalyNone,
+		},
+		{
+			name:          "next number",
+			controlNumber: "000000043",
+			highest:       42,
+			anomaly:       edi.ControlNumberAnomalyNone,
+		},
+		{
+			name:          "skipped numbers",
+			controlNumber: "000000046",
+			highest:       42,
+			anomaly:       edi.ControlNumberAnomalyGap,
+			expected:      "000000043",
+		},
+		{
+			name:          "lower number not seen before",
+			controlNumber: "000000040",
+			highest:       42,
+			anomaly:       edi.ControlNumberAnomalyOutOfOrder,
+		},
+		{
+			name:          "number already recorded",
+			controlNumber: "000000040",
+			highest:       42,
+			prior:         prior,
+			anomaly:       edi.ControlNumberAnomalyDuplicate,
+			duplicateOf:   prior.ID,
+		},
+		{
+			name:          "resent content under a new number",
+			controlNumber: "0007",
+			highest:       6,
+			duplicateOfID: resentOf,
+			anomaly:       edi.ControlNumberAnomalyDuplicate,
+			duplicateOf:   resentOf,
+		},
+		{
+			name:          "non-numeric numbers are only checked for duplicates",
+			controlNumber: "MSG-9",
+			highest:       42,
+			anomaly:       edi.ControlNumberAnomalyNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			entry := &edi.EDIControlNumberLedgerEntry{
+				ControlNumber: tt.controlNumber,
+				Sequence:      controlNumberSequence(tt.controlNumber),
+			}
+			classifyControlNumber(entry, tt.highest, tt.prior, tt.duplicateOfID)
+
+			require.Equal(t, tt.anomaly, entry.Anomaly)
+			require.Equal(t, tt.expected, entry.ExpectedControlNumber)
+			require.Equal(t, tt.duplicateOf, entry.DuplicateOfID)
+		})
+	}
+}
+
+func TestRecordControlNumberAlertsOnGap(t *testing.T) {
+	t.Parallel()
+
+	ledger := mocks.NewMockEDIControlNumberLedgerRepository(t)
+	notificationRepo := mocks.NewMockNotificationRepository(t)
+	realtime := mocks.NewMockRealtimeService(t)
+	service := &Service{
+		l:          zap.NewNop(),
+		ledgerRepo: ledger,
+		notifications: notificationservice.New(notificationservice.Params{
+			Logger:   zap.NewNop(),
+			Repo:     notificationRepo,
+			Realtime: realtime,
+		}),
+	}
+	obs := &ControlNumberObservation{
+		TenantInfo: pagination.TenantInfo{
+			OrgID: pulid.MustNew("org_"),
+			BuID:  pulid.MustNew("bu_"),
+		},
+		EDIPartnerID:  pulid.MustNew("edip_"),
+		Direction:     edi.DocumentDirectionInbound,
+		Kind:          edi.ControlNumberKindInterchange,
+		ControlNumber: "000000105",
+		InboundFileID: pulid.MustNew("ediinf_"),
+	}
+
+	ledger.EXPECT().FindControlNumberEntry(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	ledger.EXPECT().GetHighestSequence(mock.Anything, mock.Anything).Return(int64(101), nil).Once()
+	ledger.EXPECT().
+		CreateLedgerEntry(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entry *edi.EDIControlNumberLedgerEntry) (*edi.EDIControlNumberLedgerEntry, error) {
+			entry.ID = pulid.MustNew("edicnl_")
+			return entry, nil
+		}).
+		Once()
+	notificationRepo.EXPECT().ExistsRecent(mock.Anything, mock.Anything).Return(false, nil).Once()
+	notificationRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(entity *notification.Notification) bool {
+			return entity.EventType == EDIAlertEventControlNumberGap &&
+				entity.Message == "Inbound interchange control number 000000105 arrived when 000000102 was expected"
+		})).
+		RunAndReturn(func(_ context.Context, entity *notification.Notification) (*notification.Notification, error) {
+			return entity, nil
+		}).
+		Once()
+	realtime.EXPECT().PublishResourceInvalidation(mock.Anything, mock.Anything).Return(nil).Once()
+
+	entry, err := service.RecordControlNumber(t.Context(), obs)
+
+	require.NoError(t, err)
+	require.Equal(t, edi.ControlNumberAnomalyGap, entry.Anomaly)
+	require.Equal(t, "000000102", entry.ExpectedControlNumber)
+	require.Equal(t, int64(105), entry.Sequence)
+}
+
+func TestRecordControlNumberIgnoresReprocessedFile(t *testing.T) {
+	t.Parallel()
+
+	ledger := mocks.NewMockEDIControlNumberLedgerRepository(t)
+	service := &Service{l: zap.NewNop(), ledgerRepo: ledger}
+	fileID := pulid.MustNew("ediinf_")
+	original := &edi.EDIControlNumberLedgerEntry{
+		ID:            pulid.MustNew("edicnl_"),
+		ControlNumber: "000000101",
+		InboundFileID: fileID,
+		Anomaly:       edi.ControlNumberAnomalyNone,
+	}
+	ledger.EXPECT().
+		FindControlNumberEntry(mock.Anything, mock.Anything).
+		Return(original, nil).
+		Once()
+
+	entry, err := service.RecordControlNumber(t.Context(), &ControlNumberObservation{
+		EDIPartnerID:  pulid.MustNew("edip_"),
+		Direction:     edi.DocumentDirectionInbound,
+		Kind:          edi.ControlNumberKindInterchange,
+		ControlNumber: "000000101",
+		InboundFileID: fileID,
+	})
+
+	require.NoError(t, err)
+	require.Same(t, original, entry)
+}
+
+func TestClaimInboundTransactionRejectsResendThatLostTheRace(t *testing.T) {
+	t.Parallel()
+
+	ledger := mocks.NewMockEDIControlNumberLedgerRepository(t)
+	service := &Service{l: zap.NewNop(), ledgerRepo: ledger}
+	winner := &edi.EDIControlNumberLedgerEntry{
+		ID:            pulid.MustNew("edicnl_"),
+		InboundFileID: pulid.MustNew("ediinf_"),
+		Outcome:       edi.ControlNumberOutcomeReceived,
+	}
+	obs := &ControlNumberObservation{
+		EDIPartnerID:   pulid.MustNew("edip_"),
+		Direction:      edi.DocumentDirectionInbound,
+		Kind:           edi.ControlNumberKindTransaction,
+		SequenceScope:  "000000201/1",
+		ControlNumber:  "0001",
+		TransactionSet: edi.TransactionSet204,
+		InboundFileID:  pulid.MustNew("ediinf_"),
+		ContentHash:    ControlNumberContentHash("ST*204*0001~"),
+	}
+
+	ledger.EXPECT().FindResentTransaction(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	ledger.EXPECT().FindControlNumberEntry(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	ledger.EXPECT().GetHighestSequence(mock.Anything, mock.Anything).Return(int64(0), nil).Twice()
+	ledger.EXPECT().
+		ClaimTransactionEntry(mock.Anything, mock.MatchedBy(
+			func(entry *edi.EDIControlNumberLedgerEntry) bool {
+				return entry.Outcome == edi.ControlNumberOutcomeReceived && !entry.Rejected
+			},
+		)).
+		Return(nil, nil).
+		Once()
+	ledger.EXPECT().FindResentTransaction(mock.Anything, mock.Anything).Return(winner, nil).Once()
+	var resend *edi.EDIControlNumberLedgerEntry
+	ledger.EXPECT().
+		CreateLedgerEntry(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entry *edi.EDIControlNumberLedgerEntry) (*edi.EDIControlNumberLedgerEntry, error) {
+			resend = entry
+			return entry, nil
+		}).
+		Once()
+
+	claim, original, err := service.ClaimInboundTransaction(t.Context(), obs)
+
+	require.NoError(t, err)
+	require.Nil(t, claim)
+	require.Same(t, winner, original)
+	require.NotNil(t, resend)
+	require.True(t, resend.Rejected)
+	require.Equal(t, winner.ID, resend.DuplicateOfID)
+	require.Equal(t, edi.ControlNumberAnomalyDuplicate, resend.Anomaly)
+}
+
+func TestClaimInboundTransactionReclaimsFailedEntryFromSameFile(t *testing.T) {
+	t.Parallel()
+
+	ledger := mocks.NewMockEDIControlNumberLedgerRepository(t)
+	service := &Service{l: zap.NewNop(), ledgerRepo: ledger}
+	fileID := pulid.MustNew("ediinf_")
+	failed := &edi.EDIControlNumberLedgerEntry{
+		ID:            pulid.MustNew("edicnl_"),
+		ControlNumber: "0001",
+		InboundFileID: fileID,
+		Outcome:       edi.ControlNumberOutcomeFailed,
+	}
+
+	ledger.EXPECT().FindResentTransaction(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	ledger.EXPECT().FindControlNumberEntry(mock.Anything, mock.Anything).Return(failed, nil).Once()
+	ledger.EXPECT().
+		UpdateTransactionOutcome(mock.Anything, mock.MatchedBy(
+			func(req repositories.UpdateTransactionOutcomeRequest) bool {
+				return req.EntryID == failed.ID &&
+					req.Outcome == edi.ControlNumberOutcomeReceived
+			},
+		)).
+		Return(nil).
+		Once()
+
+	claim, original, err := service.ClaimInboundTransaction(t.Context(), &ControlNumberObservation{
+		EDIPartnerID:   pulid.MustNew("edip_"),
+		Direction:      edi.DocumentDirectionInbound,
+		Kind:           edi.ControlNumberKindTransaction,
+		ControlNumber:  "0001",
+		TransactionSet: edi.TransactionSet204,
+		InboundFileID:  fileID,
+		ContentHash:    ControlNumberContentHash("ST*204*0001~"),
+	})
+
+	require.NoError(t, err)
+	require.Nil(t, original)
+	require.Same(t, failed, claim)
+	require.Equal(t, edi.ControlNumberOutcomeReceived, claim.Outcome)
+}
+
+func TestSettleInboundTransactionReleasesFailedClaim(t *testing.T) {
+	t.Parallel()
+
+	ledger := mocks.NewMockEDIControlNumberLedgerRepository(t)
+	service := &Service{l: zap.NewNop(), ledgerRepo: ledger}
+	claim := &edi.EDIControlNumberLedgerEntry{
+		ID:             pulid.MustNew("edicnl_"),
+		OrganizationID: pulid.MustNew("org_"),
+		BusinessUnitID: pulid.MustNew("bu_"),
+	}
+
+	ledger.EXPECT().
+		UpdateTransactionOutcome(mock.Anything, repositories.UpdateTransactionOutcomeRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: claim.OrganizationID,
+				BuID:  claim.BusinessUnitID,
+			},
+			EntryID: claim.ID,
+			Outcome: edi.ControlNumberOutcomeFailed,
+		}).
+		Return(nil).
+		Once()
+
+	err := service.SettleInboundTransaction(t.Context(), claim, errors.New("tender rejected"))
+
+	require.NoError(t, err)
+}
@@ -27,6 +27,9 @@ var deliverableMethods = []edi.ConnectionMethod{
 	edi.ConnectionMethodSFTP,
 	edi.ConnectionMethodVAN,
 	edi.ConnectionMethodAS2,
+	edi.ConnectionMethodHTTPS,
+	edi.ConnectionMethodFTPS,
+	edi.ConnectionMethodS3,
 }
 
 func (s *Service) DeliverMessage(
@@ -87,6 +90,11 @@ func (s *Service) deliverMessage(
 			Secrets:  secrets,
 			FileName: editransport.OutboundFileName(profile, message),
 			Contents: message.RawX12,
+			Standard: message.Standard,
+			// The message ID is stable across retries, so HTTPS partners can
+			// use it to discard duplicate deliveries.
+			DocumentID: message.ID.String(),
+			Payload:    &message.PayloadSnapshot,
 		},
 	)
 	transportSeconds := time.Since(transportStartedAt).Seconds()
@@ -133,9 +141,11 @@ func (s *Service) deliverMessage(
 			return nil, err
 		}
 		return &DeliverEDIMessageWorkflowResult{
-			MessageID:      message.ID,
-			DeliveryStatus: message.DeliveryStatus,
-			RemotePath:     remotePath,
+			MessageID:         message.ID,
+			DeliveryStatus:    message.DeliveryStatus,
+			RemotePath:        remotePath,
+			AS2MessageID:      as2MessageID,
+			MDNTimeoutSeconds: int64(editransport.AsyncMDNTimeout(profile).Seconds()),
 		}, nil
 	}
 
@@ -616,8 +626,15 @@ func (s *Service) deliveryProfileForMessage(
 func (s *Service) ProfileTransportSecrets(
 	profile *edi.EDICommunicationProfile,
 ) (map[string]string, error) {
-	secrets := make(map[string]string, 3)
-	for _, key := range []string{"password", "privateKey", "basicAuthPassword"} {
+	secrets := make(map[string]string, 6)
+	for _, key := range []string{
+		"password",
+		"privateKey",
+		"basicAuthPassword",
+		editransport.SecretKeyHTTPSClientSecret,
+		editransport.SecretKeyHTTPSHMACSecret,
+		editransport.SecretKeyS3SecretAccessKey,
+	} {
 		value, err := s.decryptProfileSecret(profile, key)
 		if err != nil {
 			return nil, err
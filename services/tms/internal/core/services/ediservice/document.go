@@ -11,6 +11,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/domain/permission"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/edifact"
 	"github.com/emoss08/trenova/internal/core/services/edix12"
 	"github.com/emoss08/trenova/internal/core/services/edix12inspect"
 	"github.com/emoss08/trenova/pkg/errortypes"
@@ -31,6 +32,7 @@ type resolvedDocumentContext struct {
 	runtime            map[string]any
 	partnerDiagnostics []edix12.Diagnostic
 	carrierSCAC        string
+	guide              *edi.ImplementationGuideDefinition
 }
 
 func (s *Service) ListDocumentTypes(
@@ -91,7 +93,8 @@ func (s *Service) UpsertPartnerDocumentProfile(
 	if req == nil {
 		return nil, s.validator.ValidatePartnerDocumentProfileRequest(req)
 	}
-	if req.Envelope.ElementSeparator == "" {
+	envelopeDefaulted := req.Envelope.ElementSeparator == ""
+	if envelopeDefaulted {
 		req.Envelope = edi.DefaultX12EnvelopeSettings()
 	}
 	if multiErr := s.validator.ValidatePartnerDocumentProfileRequest(req); multiErr != nil {
@@ -182,6 +185,15 @@ func (s *Service) UpsertPartnerDocumentProfile(
 	if profile.Envelope.ElementSeparator == "" {
 		profile.Envelope = edi.DefaultX12EnvelopeSettings()
 	}
+	if envelopeDefaulted {
+		profile.Envelope = profile.Envelope.WithDefaultDelimiters(profile.Standard)
+	}
+	if multiErr := s.validator.ValidatePartnerDocumentProfileEnvelope(
+		profile.Standard,
+		&profile.Envelope,
+	); multiErr != nil {
+		return nil, multiErr
+	}
 	if profile.TemplateVersionID.IsNil() {
 		profile.TemplateVersionID = templateVersion.ID
 	}
@@ -356,7 +368,7 @@ func (s *Service) PreviewDocument(
 		return nil, err
 	}
 	edix12.SetProvisionalControlNumbers(resolved.runtime)
-	result, err := edix12.RenderX12(resolved.renderInput())
+	result, err := resolved.render()
 	if err != nil {
 		return nil, err
 	}
@@ -387,19 +399,21 @@ func (s *Service) GenerateDocument(
 		)
 	}
 	previewReq := &PreviewEDIDocumentRequest{
-		TenantInfo:               req.TenantInfo,
-		PartnerDocumentProfileID: req.PartnerDocumentProfileID,
-		EDIPartnerID:             req.EDIPartnerID,
-		ShipmentID:               req.ShipmentID,
-		TransferID:               req.TransferID,
-		InvoiceID:                req.InvoiceID,
-		ShipmentEventID:          req.ShipmentEventID,
-		ServiceFailureID:         req.ServiceFailureID,
-		SourceMessageID:          req.SourceMessageID,
-		TransactionSet:           req.TransactionSet,
-		Direction:                req.Direction,
-		Payload:                  req.Payload,
-		CarrierSCAC:              req.CarrierSCAC,
+		TenantInfo:                     req.TenantInfo,
+		PartnerDocumentProfileID:       req.PartnerDocumentProfileID,
+		EDIPartnerID:                   req.EDIPartnerID,
+		ShipmentID:                     req.ShipmentID,
+		ShipmentMoveID:                 req.ShipmentMoveID,
+		TransferID:                     req.TransferID,
+		InvoiceID:                      req.InvoiceID,
+		ShipmentEventID:                req.ShipmentEventID,
+		ServiceFailureID:               req.ServiceFailureID,
+		SourceMessageID:                req.SourceMessageID,
+		TransactionSet:                 req.TransactionSet,
+		Direction:                      req.Direction,
+		Payload:                        req.Payload,
+		CarrierSCAC:                    req.CarrierSCAC,
+		DeliveryCommunicationProfileID: req.DeliveryCommunicationProfileID,
 	}
 	resolved, err := s.resolveDocumentContext(ctx, previewReq)
 	if err != nil {
@@ -411,7 +425,7 @@ func (s *Service) GenerateDocument(
 	provisional := *resolved
 	provisional.runtime = maputils.CloneShallow(resolved.runtime)
 	edix12.SetProvisionalControlNumbers(provisional.runtime)
-	provisionalResult, err := edix12.RenderX12(provisional.renderInput())
+	provisionalResult, err := provisional.render()
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +468,7 @@ func (s *Service) GenerateDocument(
 		"%04d",
 		controlNumbers[edi.ControlNumberKindTransaction],
 	)
-	result, err := edix12.RenderX12(resolved.renderInput())
+	result, err := resolved.render()
 	if err != nil {
 		return nil, err
 	}
@@ -509,6 +523,7 @@ func (s *Service) GenerateDocument(
 	if err != nil {
 		return nil, err
 	}
+	s.recordOutboundControlNumbers(ctx, req.TenantInfo, created)
 	if !req.SuppressTenderRecipientUpsert {
 		if err = s.upsertExternalTenderRecipient(ctx, created, resolved.profile); err != nil {
 			return nil, err
@@ -553,7 +568,7 @@ func (s *Service) GetMessage(
 }
 
 func (s *Service) InspectX12(
-	_ context.Context,
+	ctx context.Context,
 	req *InspectX12Request,
 ) (*edix12inspect.InspectX12Result, error) {
 	if req == nil {
@@ -563,16 +578,39 @@ func (s *Service) InspectX12(
 			"Inspection request is required",
 		)
 	}
-	result := edix12inspect.InspectX12(&edix12inspect.InspectX12Request{
+	inspectReq := &edix12inspect.InspectX12Request{
 		RawX12:         req.RawX12,
 		TransactionSet: req.TransactionSet,
 		X12Version:     req.X12Version,
 		Envelope:       req.Envelope,
 		Diagnostics:    req.Diagnostics,
-	})
+	}
+	if !req.ImplementationGuideID.IsNil() {
+		guide, err := s.GetImplementationGuide(
+			ctx,
+			repositories.GetEDIImplementationGuideByIDRequest{
+				ID:         req.ImplementationGuideID,
+				TenantInfo: req.TenantInfo,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		inspectReq.Guide = &guide.Definition
+	}
+	result := inspectRaw(inspectReq)
 	return &result, nil
 }
 
+// inspectRaw picks the inspector from the interchange itself so stored
+// EDIFACT messages and pasted payloads inspect without a standard hint.
+func inspectRaw(req *edix12inspect.InspectX12Request) edix12inspect.InspectX12Result {
+	if edifact.IsEDIFACT(req.RawX12) {
+		return edifact.Inspect(req)
+	}
+	return edix12inspect.InspectX12(req)
+}
+
 func (s *Service) InspectMessage(
 	ctx context.Context,
 	req repositories.GetEDIMessageByIDRequest,
@@ -593,7 +631,7 @@ func (s *Service) InspectMessage(
 			SuggestedFix:    diagnostic.SuggestedFix,
 		})
 	}
-	inspection := edix12inspect.InspectX12(&edix12inspect.InspectX12Request{
+	inspection := inspectRaw(&edix12inspect.InspectX12Request{
 		RawX12:         message.RawX12,
 		TransactionSet: message.TransactionSet,
 		X12Version:     message.X12Version,
@@ -712,12 +750,15 @@ func (s *Service) resolveDocumentContext(
 	if err != nil {
 		return nil, err
 	}
+	if req.DeliveryCommunicationProfileID.IsNotNil() {
+		payload.DeliveryCommunicationProfileID = req.DeliveryCommunicationProfileID
+	}
 	x12Version := stringutils.FirstNonEmpty(
 		profile.X12VersionOverride,
 		templateVersion.X12Version,
 		defaultX12Version(profile.TransactionSet),
 	)
-	runtime := edix12.RuntimeValues(profile, x12Version)
+	runtime := runtimeValues(profile, x12Version)
 	partnerDiagnostics, err := s.validateProfilePartnerSettings(
 		ctx,
 		profile,
@@ -727,6 +768,10 @@ func (s *Service) resolveDocumentContext(
 	if err != nil {
 		return nil, err
 	}
+	guide, err := s.resolveImplementationGuide(ctx, req.TenantInfo, profile, x12Version)
+	if err != nil {
+		return nil, err
+	}
 	return &resolvedDocumentContext{
 		ctx:                ctx,
 		profile:            profile,
@@ -736,6 +781,7 @@ func (s *Service) resolveDocumentContext(
 		runtime:            runtime,
 		partnerDiagnostics: partnerDiagnostics,
 		carrierSCAC:        req.CarrierSCAC,
+		guide:              guide,
 	}, nil
 }
 
@@ -796,6 +842,7 @@ func (s *Service) resolvePayload(
 	if transactionSet == "" {
 		transactionSet = edi.TransactionSet204
 	}
+	transactionSet = transactionSet.X12Equivalent()
 	if !req.TransferID.IsNil() {
 		if transactionSet != edi.TransactionSet204 && transactionSet != edi.TransactionSet990 {
 			return edi.DocumentPayload{}, sourceTransactionSetError(
@@ -908,15 +955,31 @@ func (s *Service) resolvePayload(
 	if req.ShipmentID.IsNil() {
 		return edi.DocumentPayload{}, missingSourceError(transactionSet)
 	}
-	if transactionSet != edi.TransactionSet204 && transactionSet != edi.TransactionSet214 {
+	//nolint:exhaustive // Only shipment-sourced transaction sets are accepted here.
+	switch transactionSet {
+	case edi.TransactionSet204,
+		edi.TransactionSet211,
+		edi.TransactionSet212,
+		edi.TransactionSet214:
+	default:
 		return edi.DocumentPayload{}, sourceTransactionSetError(
 			"shipmentId",
 			"shipment",
 			transactionSet,
 			edi.TransactionSet204,
+			edi.TransactionSet211,
+			edi.TransactionSet212,
 			edi.TransactionSet214,
 		)
 	}
+	if req.ShipmentMoveID.IsNotNil() && transactionSet != edi.TransactionSet212 {
+		return edi.DocumentPayload{}, sourceTransactionSetError(
+			"shipmentMoveId",
+			"shipment move",
+			transactionSet,
+			edi.TransactionSet212,
+		)
+	}
 	source, err := s.shipmentSvc.Get(ctx, &repositories.GetShipmentByIDRequest{
 		ID:         req.ShipmentID,
 		TenantInfo: req.TenantInfo,
@@ -927,10 +990,25 @@ func (s *Service) resolvePayload(
 	if err != nil {
 		return edi.DocumentPayload{}, err
 	}
-	if transactionSet == edi.TransactionSet214 {
+	//nolint:exhaustive // Transaction sets were gated to shipment sources above.
+	switch transactionSet {
+	case edi.TransactionSet211:
+		return buildBillOfLadingPayload(source), nil
+	case edi.TransactionSet212:
+		if req.ShipmentMoveID.IsNotNil() &&
+			serviceFailureMove(source, req.ShipmentMoveID) == nil {
+			return edi.DocumentPayload{}, errortypes.NewValidationError(
+				"shipmentMoveId",
+				errortypes.ErrInvalidReference,
+				"Shipment move must belong to the shipment",
+			)
+		}
+		return buildDeliveryManifestPayload(source, req.ShipmentMoveID), nil
+	case edi.TransactionSet214:
 		return buildShipmentStatusPayload(source), nil
+	default:
+		return edi.NewLoadTenderDocumentPayload(buildTenderPayload(source)), nil
 	}
-	return edi.NewLoadTenderDocumentPayload(buildTenderPayload(source)), nil
 }
 
 func sourceTransactionSetError(
@@ -964,6 +1042,12 @@ func missingSourceError(transactionSet edi.TransactionSet) error {
 			errortypes.ErrRequired,
 			"Invoice ID or payload is required for 210 documents",
 		)
+	case edi.TransactionSet211, edi.TransactionSet212:
+		return errortypes.NewValidationError(
+			"shipmentId",
+			errortypes.ErrRequired,
+			fmt.Sprintf("Shipment ID or payload is required for %s documents", transactionSet),
+		)
 	case edi.TransactionSet214:
 		return errortypes.NewValidationError(
 			"shipmentEventId",
@@ -1003,15 +1087,43 @@ func (c *resolvedDocumentContext) renderInput() *edix12.RenderInput {
 	}
 }
 
+// render emits the document in the profile's standard. EDIFACT shares the
+// template resolution and diagnostics of the X12 renderer. Rendered X12 is
+// checked against the partner's implementation guide when one is active.
+func (c *resolvedDocumentContext) render() (*edix12.RenderResult, error) {
+	if c.profile.Standard == edi.EDIStandardEDIFACT {
+		return edifact.Render(c.renderInput())
+	}
+	result, err := edix12.RenderX12(c.renderInput())
+	if err != nil || c.guide == nil {
+		return result, err
+	}
+	result.Diagnostics = append(result.Diagnostics, edix12.FilterDiagnostics(
+		edix12inspect.ImplementationGuideDiagnostics(result.RawX12, &c.profile.Envelope, c.guide),
+		c.profile.ValidationMode,
+	)...)
+	return result, nil
+}
+
+func runtimeValues(profile *edi.EDIPartnerDocumentProfile, version string) map[string]any {
+	if profile.Standard == edi.EDIStandardEDIFACT {
+		return edifact.RuntimeValues(profile, version)
+	}
+	return edix12.RuntimeValues(profile, version)
+}
+
 func defaultX12Version(transactionSet edi.TransactionSet) string {
 	if transactionSet == edi.TransactionSet999 {
 		return "005010"
 	}
+	if transactionSet.Standard() == edi.EDIStandardEDIFACT {
+		return edi.DefaultEDIFACTVersion
+	}
 	return edi.DefaultX12204Version
 }
 
 func defaultProfileName(transactionSet edi.TransactionSet, direction edi.DocumentDirection) string {
-	parts := []string{"X12"}
+	parts := []string{string(transactionSet.Standard())}
 	if transactionSet != "" {
 		parts = append(parts, string(transactionSet))
 	}
@@ -1029,6 +1141,12 @@ func documentShipmentID(payload edi.DocumentPayload) pulid.ID {
 	if payload.ShipmentStatus != nil {
 		return payload.ShipmentStatus.ShipmentID
 	}
+	if payload.BillOfLading != nil {
+		return payload.BillOfLading.ShipmentID
+	}
+	if payload.DeliveryManifest != nil {
+		return payload.DeliveryManifest.ShipmentID
+	}
 	if payload.TenderResponse != nil {
 		return payload.TenderResponse.ShipmentID
 	}
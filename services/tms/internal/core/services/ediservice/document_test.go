@@ -274,3 +274,68 @@ func TestUpsertPartnerDocumentProfile_ActiveProfileRequiresActiveTemplateVersion
 
 	requireValidationError(t, err, "templateVersionId", errortypes.ErrInvalidOperation)
 }
+
+func TestValidatePartnerDocumentProfileEnvelope(t *testing.T) {
+	t.Parallel()
+
+	edifactEnvelope := edi.DefaultEDIFACTEnvelopeSettings()
+	edifactEnvelope.InterchangeSenderID = "5412345000013-LONG-GLN-BASED-PARTY"
+	edifactEnvelope.InterchangeSenderQualifier = "14"
+	edifactEnvelope.InterchangeReceiverQualifier = "ZZZ"
+
+	x12WithoutRepetition := edi.DefaultX12EnvelopeSettings()
+	x12WithoutRepetition.RepetitionSeparator = ""
+
+	x12WithLongID := edi.DefaultX12EnvelopeSettings()
+	x12WithLongID.InterchangeSenderID = edifactEnvelope.InterchangeSenderID
+
+	edifactWithLongQualifier := edi.DefaultEDIFACTEnvelopeSettings()
+	edifactWithLongQualifier.InterchangeReceiverQualifier = "ZZZZZ"
+
+	tests := []struct {
+		name     string
+		standard edi.EDIStandard
+		envelope edi.X12EnvelopeSettings
+		field    string
+	}{
+		{
+			name:     "EDIFACT party ID and qualifier",
+			standard: edi.EDIStandardEDIFACT,
+			envelope: edifactEnvelope,
+		},
+		{
+			name:     "EDIFACT qualifier over 4 characters",
+			standard: edi.EDIStandardEDIFACT,
+			envelope: edifactWithLongQualifier,
+			field:    "envelope.interchangeReceiverQualifier",
+		},
+		{
+			name:     "X12 ID over 15 characters",
+			standard: edi.EDIStandardX12,
+			envelope: x12WithLongID,
+			field:    "envelope.interchangeSenderId",
+		},
+		{
+			name:     "X12 without a repetition separator",
+			standard: edi.EDIStandardX12,
+			envelope: x12WithoutRepetition,
+			field:    "envelope.repetitionSeparator",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			multiErr := NewValidator().
+				ValidatePartnerDocumentProfileEnvelope(tt.standard, &tt.envelope)
+			if tt.field == "" {
+				require.Nil(t, multiErr)
+				return
+			}
+			require.NotNil(t, multiErr)
+			require.Len(t, multiErr.Errors, 1)
+			require.Equal(t, tt.field, multiErr.Errors[0].Field)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package ediservice
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	coreports "github.com/emoss08/trenova/internal/core/ports"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/uptrace/bun"
+)
+
+type SaveEDIImplementationGuideRequest struct {
+	TenantInfo     pagination.TenantInfo
+	ID             pulid.ID
+	EDIPartnerID   pulid.ID
+	Name           string
+	Description    string
+	Direction      edi.DocumentDirection
+	TransactionSet edi.TransactionSet
+	X12Version     string
+	GuideRevision  string
+	IsActive       bool
+	Definition     edi.ImplementationGuideDefinition
+	Version        int64
+}
+
+func (s *Service) ListImplementationGuides(
+	ctx context.Context,
+	req *repositories.ListEDIImplementationGuidesRequest,
+) (*pagination.ListResult[*edi.EDIImplementationGuide], error) {
+	if err := s.requireGuideRepo(); err != nil {
+		return nil, err
+	}
+	return s.guideRepo.ListImplementationGuides(ctx, req)
+}
+
+func (s *Service) GetImplementationGuide(
+	ctx context.Context,
+	req repositories.GetEDIImplementationGuideByIDRequest,
+) (*edi.EDIImplementationGuide, error) {
+	if err := s.requireGuideRepo(); err != nil {
+		return nil, err
+	}
+	return s.guideRepo.GetImplementationGuideByID(ctx, req)
+}
+
+func (s *Service) CreateImplementationGuide(
+	ctx context.Context,
+	req *SaveEDIImplementationGuideRequest,
+) (*edi.EDIImplementationGuide, error) {
+	if err := s.requireGuideRepo(); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errortypes.NewValidationError(
+			"definition",
+			errortypes.ErrRequired,
+			"Implementation guide request is required",
+		)
+	}
+	entity := &edi.EDIImplementationGuide{
+		BusinessUnitID: req.TenantInfo.BuID,
+		OrganizationID: req.TenantInfo.OrgID,
+	}
+	applyImplementationGuideRequest(entity, req)
+	return s.saveImplementationGuide(
+		ctx,
+		req.TenantInfo,
+		entity,
+		s.guideRepo.CreateImplementationGuide,
+	)
+}
+
+func (s *Service) UpdateImplementationGuide(
+	ctx context.Context,
+	req *SaveEDIImplementationGuideRequest,
+) (*edi.EDIImplementationGuide, error) {
+	if err := s.requireGuideRepo(); err != nil {
+		return nil, err
+	}
+	if req == nil || req.ID.IsNil() {
+		return nil, errortypes.NewValidationError(
+			"id",
+			errortypes.ErrRequired,
+			"EDI implementation guide ID is required",
+		)
+	}
+	entity, err := s.guideRepo.GetImplementationGuideByID(
+		ctx,
+		repositories.GetEDIImplementationGuideByIDRequest{
+			ID:         req.ID,
+			TenantInfo: req.TenantInfo,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	applyImplementationGuideRequest(entity, req)
+	entity.Version = req.Version
+	return s.saveImplementationGuide(
+		ctx,
+		req.TenantInfo,
+		entity,
+		s.guideRepo.UpdateImplementationGuide,
+	)
+}
+
+func (s *Service) DeleteImplementationGuide(
+	ctx context.Context,
+	req repositories.DeleteEDIImplementationGuideRequest,
+) error {
+	if err := s.requireGuideRepo(); err != nil {
+		return err
+	}
+	return s.guideRepo.DeleteImplementationGuide(ctx, req)
+}
+
+func applyImplementationGuideRequest(
+	entity *edi.EDIImplementationGuide,
+	req *SaveEDIImplementationGuideRequest,
+) {
+	entity.EDIPartnerID = req.EDIPartnerID
+	entity.Name = req.Name
+	entity.Description = req.Description
+	entity.Direction = req.Direction
+	entity.TransactionSet = req.TransactionSet
+	entity.X12Version = req.X12Version
+	entity.GuideRevision = req.GuideRevision
+	entity.IsActive = req.IsActive
+	entity.Definition = req.Definition
+}
+
+// saveImplementationGuide persists a guide and, when it is activated, retires
+// the previously active guide for the same partner, set, version, and
+// direction in the same transaction.
+func (s *Service) saveImplementationGuide(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	entity *edi.EDIImplementationGuide,
+	persist func(context.Context, *edi.EDIImplementationGuide) (*edi.EDIImplementationGuide, error),
+) (*edi.EDIImplementationGuide, error) {
+	multiErr := errortypes.NewMultiError()
+	entity.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+	if !entity.EDIPartnerID.IsNil() {
+		if _, err := s.partnerRepo.GetByID(ctx, repositories.GetEDIPartnerByIDRequest{
+			ID:         entity.EDIPartnerID,
+			TenantInfo: tenantInfo,
+		}); err != nil {
+			if errortypes.IsNotFoundError(err) {
+				return nil, errortypes.NewValidationError(
+					"ediPartnerId",
+					errortypes.ErrInvalid,
+					"EDI partner does not exist",
+				)
+			}
+			return nil, err
+		}
+	}
+
+	var saved *edi.EDIImplementationGuide
+	err := s.db.WithTx(ctx, coreports.TxOptions{}, func(txCtx context.Context, _ bun.Tx) error {
+		var err error
+		saved, err = persist(txCtx, entity)
+		if err != nil {
+			return err
+		}
+		if !saved.IsActive {
+			return nil
+		}
+		return s.guideRepo.DeactivateImplementationGuides(
+			txCtx,
+			repositories.DeactivateEDIImplementationGuidesRequest{
+				TenantInfo:     tenantInfo,
+				PartnerID:      saved.EDIPartnerID,
+				TransactionSet: saved.TransactionSet,
+				X12Version:     saved.X12Version,
+				Direction:      saved.Direction,
+				ExceptID:       saved.ID,
+			},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// resolveImplementationGuide returns the active guide for an X12 profile, or
+// nil when none is configured so rendering falls back to dictionary checks.
+func (s *Service) resolveImplementationGuide(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	profile *edi.EDIPartnerDocumentProfile,
+	x12Version string,
+) (*edi.ImplementationGuideDefinition, error) {
+	if s.guideRepo == nil ||
+		profile.Standard == edi.EDIStandardEDIFACT ||
+		profile.ValidationMode == edi.ValidationModeDisabled {
+		return nil, nil //nolint:nilnil // No guide is a valid outcome.
+	}
+	guide, err := s.guideRepo.GetActiveImplementationGuide(
+		ctx,
+		repositories.GetActiveEDIImplementationGuideRequest{
+			TenantInfo:     tenantInfo,
+			PartnerID:      profile.EDIPartnerID,
+			TransactionSet: profile.TransactionSet,
+			X12Version:     x12Version,
+			Direction:      profile.Direction,
+		},
+	)
+	if err != nil {
+		if errortypes.IsNotFoundError(err) {
+			return nil, nil //nolint:nilnil // No guide is a valid outcome.
+		}
+		return nil, err
+	}
+	return &guide.Definition, nil
+}
+
+func (s *Service) requireGuideRepo() error {
+	if s.guideRepo == nil {
+		return errortypes.NewBusinessError("EDI implementation guides are not configured")
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package ediservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/edix12"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func guideRenderContext(
+	t *testing.T,
+	mode edi.ValidationMode,
+	guide *edi.ImplementationGuideDefinition,
+) *resolvedDocumentContext {
+	t.Helper()
+
+	tenantInfo := pagination.TenantInfo{
+		OrgID: pulid.MustNew("org_"),
+		BuID:  pulid.MustNew("bu_"),
+	}
+	profile := &edi.EDIPartnerDocumentProfile{
+		Standard:       edi.EDIStandardX12,
+		TransactionSet: edi.TransactionSet204,
+		Direction:      edi.DocumentDirectionOutbound,
+		ValidationMode: mode,
+		Envelope: edi.X12EnvelopeSettings{
+			InterchangeSenderID:   "SENDERID",
+			InterchangeReceiverID: "RECEIVERID",
+			ElementSeparator:      "*",
+			SegmentTerminator:     "~",
+			ComponentSeparator:    ">",
+			RepetitionSeparator:   "^",
+		},
+	}
+	runtime := runtimeValues(profile, edi.DefaultX12204Version)
+	edix12.SetProvisionalControlNumbers(runtime)
+	return &resolvedDocumentContext{
+		ctx:             t.Context(),
+		profile:         profile,
+		templateVersion: validTemplateVersion(tenantInfo),
+		payload: edi.NewLoadTenderDocumentPayload(edi.LoadTenderPayload{
+			PurposeCode: edi.LoadTenderPurposeOriginal,
+			BOL:         "BOL-1",
+		}),
+		x12Version: edi.DefaultX12204Version,
+		runtime:    runtime,
+		guide:      guide,
+	}
+}
+
+func guideDiagnostics(diagnostics []edix12.Diagnostic) []edix12.Diagnostic {
+	filtered := make([]edix12.Diagnostic, 0)
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Code == "x12.guide.segment_missing" {
+			filtered = append(filtered, diagnostic)
+		}
+	}
+	return filtered
+}
+
+func TestRenderAppliesImplementationGuideByValidationMode(t *testing.T) {
+	t.Parallel()
+
+	guide := &edi.ImplementationGuideDefinition{
+		Nodes: []edi.ImplementationGuideNode{
+			{SegmentID: "ZZZ", Usage: edi.GuideUsageMandatory},
+		},
+	}
+	tests := []struct {
+		name     string
+		mode     edi.ValidationMode
+		severity edi.ValidationSeverity
+		count    int
+	}{
+		{
+			name:     "strict",
+			mode:     edi.ValidationModeStrict,
+			severity: edi.ValidationSeverityError,
+			count:    1,
+		},
+		{
+			name:     "warn only",
+			mode:     edi.ValidationModeWarnOnly,
+			severity: edi.ValidationSeverityWarning,
+			count:    1,
+		},
+		{name: "disabled", mode: edi.ValidationModeDisabled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := guideRenderContext(t, tt.mode, guide).render()
+
+			require.NoError(t, err)
+			missing := guideDiagnostics(result.Diagnostics)
+			require.Len(t, missing, tt.count)
+			if tt.count > 0 {
+				assert.Equal(t, tt.severity, missing[0].Severity)
+				assert.Equal(t, "ZZZ", missing[0].SegmentID)
+				assert.Equal(t, "ST[1]/ZZZ", missing[0].Path)
+			}
+		})
+	}
+}
+
+func TestResolveImplementationGuide(t *testing.T) {
+	t.Parallel()
+
+	tenantInfo := pagination.TenantInfo{
+		OrgID: pulid.MustNew("org_"),
+		BuID:  pulid.MustNew("bu_"),
+	}
+	profile := &edi.EDIPartnerDocumentProfile{
+		EDIPartnerID:   pulid.MustNew("edip_"),
+		Standard:       edi.EDIStandardX12,
+		TransactionSet: edi.TransactionSet204,
+		Direction:      edi.DocumentDirectionOutbound,
+		ValidationMode: edi.ValidationModeStrict,
+	}
+
+	t.Run("returns the active partner guide", func(t *testing.T) {
+		t.Parallel()
+
+		guideRepo := mocks.NewMockEDIImplementationGuideRepository(t)
+		guideRepo.EXPECT().
+			GetActiveImplementationGuide(mock.Anything, repositories.GetActiveEDIImplementationGuideRequest{
+				TenantInfo:     tenantInfo,
+				PartnerID:      profile.EDIPartnerID,
+				TransactionSet: edi.TransactionSet204,
+				X12Version:     "004010",
+				Direction:      edi.DocumentDirectionOutbound,
+			}).
+			Return(&edi.EDIImplementationGuide{
+				Definition: edi.ImplementationGuideDefinition{
+					Nodes: []edi.ImplementationGuideNode{{SegmentID: "B2"}},
+				},
+			}, nil).
+			Once()
+		svc := &Service{guideRepo: guideRepo}
+
+		guide, err := svc.resolveImplementationGuide(t.Context(), tenantInfo, profile, "004010")
+
+		require.NoError(t, err)
+		require.NotNil(t, guide)
+		assert.Equal(t, "B2", guide.Nodes[0].SegmentID)
+	})
+
+	t.Run("no guide configured", func(t *testing.T) {
+		t.Parallel()
+
+		guideRepo := mocks.NewMockEDIImplementationGuideRepository(t)
+		guideRepo.EXPECT().
+			GetActiveImplementationGuide(mock.Anything, mock.Anything).
+			Return(nil, errortypes.NewNotFoundError("EDIImplementationGuide")).
+			Once()
+		svc := &Service{guideRepo: guideRepo}
+
+		guide, err := svc.resolveImplementationGuide(t.Context(), tenantInfo, profile, "004010")
+
+		require.NoError(t, err)
+		assert.Nil(t, guide)
+	})
+}
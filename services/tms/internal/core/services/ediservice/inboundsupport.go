@@ -321,6 +321,11 @@ func (s *Service) EnsureOutboundDocumentProfile(
 	if err != nil {
 		return nil, err
 	}
+	if transactionSet.Standard() == edi.EDIStandardEDIFACT {
+		// Partner envelopes are copied from X12 profiles; EDIFACT needs its
+		// own service characters while keeping the party identifiers.
+		envelope = envelope.WithDefaultDelimiters(edi.EDIStandardEDIFACT)
+	}
 	template, _, err := s.templateRepo.EnsureBaseTemplate(ctx, tenantInfo, transactionSet)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,231 @@
+package ediservice
+
+import (
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/hazardousmaterial"
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/stringutils"
+)
+
+func buildBillOfLadingPayload(source *shipment.Shipment) edi.DocumentPayload {
+	payload := edi.BillOfLadingPayload{
+		ShipmentID:        source.ID,
+		BOL:               source.BOL,
+		ProNumber:         source.ProNumber,
+		Pieces:            source.Pieces,
+		Weight:            source.Weight,
+		TotalChargeAmount: source.TotalChargeAmount,
+		ReferenceNumbers: map[string]string{
+			"shipmentId": source.ID.String(),
+			"bol":        source.BOL,
+			"pro":        source.ProNumber,
+		},
+	}
+
+	stops := shipmentStops(source, pulid.Nil)
+	for _, stop := range stops {
+		if stop.IsOriginStop() {
+			payload.ShipDate = stop.ScheduledWindowStart
+			payload.Shipper = ladingParty(stop)
+			break
+		}
+	}
+	for i := len(stops) - 1; i >= 0; i-- {
+		if stops[i].IsDestinationStop() {
+			payload.DeliveryDate = stops[i].ScheduledWindowStart
+			payload.Consignee = ladingParty(stops[i])
+			break
+		}
+	}
+
+	payload.LineItems = make([]edi.LadingLineItem, 0, len(source.Commodities))
+	for _, commodity := range source.Commodities {
+		if commodity == nil {
+			continue
+		}
+		material := commodityHazardousMaterial(commodity)
+		payload.LineItems = append(payload.LineItems, edi.LadingLineItem{
+			Sequence:    int64(len(payload.LineItems) + 1),
+			CommodityID: commodity.CommodityID,
+			Description: stringutils.FirstNonEmpty(
+				commodityDescription(commodity),
+				commodityName(commodity),
+			),
+			Pieces:    commodity.Pieces,
+			Weight:    commodity.Weight,
+			Hazardous: material != nil,
+		})
+	}
+	payload.HazardousMaterials = hazardousMaterialLines(source.Commodities)
+
+	return edi.DocumentPayload{
+		TransactionSet: edi.TransactionSet211,
+		BillOfLading:   &payload,
+	}
+}
+
+// buildDeliveryManifestPayload lists the delivery stops of one move, or of the
+// whole shipment when moveID is nil. Hazardous lading is reported per shipment
+// because commodities are not tracked per stop.
+func buildDeliveryManifestPayload(
+	source *shipment.Shipment,
+	moveID pulid.ID,
+) edi.DocumentPayload {
+	payload := edi.DeliveryManifestPayload{
+		ShipmentID:         source.ID,
+		ShipmentMoveID:     moveID,
+		BOL:                source.BOL,
+		ProNumber:          source.ProNumber,
+		Stops:              []edi.ManifestStop{},
+		HazardousMaterials: hazardousMaterialLines(source.Commodities),
+	}
+	if move := serviceFailureMove(source, moveID); move != nil &&
+		move.Assignment != nil && move.Assignment.Trailer != nil {
+		payload.EquipmentNumber = move.Assignment.Trailer.Code
+	}
+
+	for _, stop := range shipmentStops(source, moveID) {
+		if !stop.IsDestinationStop() {
+			continue
+		}
+		manifestStop := edi.ManifestStop{
+			Sequence:             int64(len(payload.Stops) + 1),
+			StopID:               stop.ID,
+			Type:                 string(stop.Type),
+			LocationID:           stop.LocationID,
+			AddressLine1:         stop.AddressLine,
+			ScheduledWindowStart: stop.ScheduledWindowStart,
+			ActualArrival:        stop.ActualArrival,
+			Pieces:               stop.Pieces,
+			Weight:               stop.Weight,
+		}
+		if stop.Location != nil {
+			manifestStop.LocationName = stop.Location.Name
+			manifestStop.LocationCode = stop.Location.Code
+			manifestStop.AddressLine1 = stringutils.FirstNonEmpty(
+				stop.Location.AddressLine1,
+				stop.AddressLine,
+			)
+			manifestStop.City = stop.Location.City
+			manifestStop.PostalCode = stop.Location.PostalCode
+			if stop.Location.State != nil {
+				manifestStop.StateCode = stop.Location.State.Abbreviation
+			}
+		}
+		if payload.ManifestDate == 0 || (stop.ScheduledWindowStart > 0 &&
+			stop.ScheduledWindowStart < payload.ManifestDate) {
+			payload.ManifestDate = stop.ScheduledWindowStart
+		}
+		payload.Stops = append(payload.Stops, manifestStop)
+	}
+	payload.StopCount = int64(len(payload.Stops))
+
+	return edi.DocumentPayload{
+		TransactionSet:   edi.TransactionSet212,
+		DeliveryManifest: &payload,
+	}
+}
+
+// shipmentStops returns the stops of every move in move order, limited to one
+// move when moveID is set.
+func shipmentStops(source *shipment.Shipment, moveID pulid.ID) []*shipment.Stop {
+	stops := make([]*shipment.Stop, 0)
+	for _, move := range source.Moves {
+		if move == nil || (moveID.IsNotNil() && move.ID != moveID) {
+			continue
+		}
+		for _, stop := range move.Stops {
+			if stop != nil {
+				stops = append(stops, stop)
+			}
+		}
+	}
+	return stops
+}
+
+func ladingParty(stop *shipment.Stop) edi.LadingParty {
+	party := edi.LadingParty{
+		LocationID:   stop.LocationID,
+		AddressLine1: stop.AddressLine,
+	}
+	if stop.Location == nil {
+		return party
+	}
+	party.Name = stop.Location.Name
+	party.Code = stop.Location.Code
+	party.AddressLine1 = stringutils.FirstNonEmpty(stop.Location.AddressLine1, stop.AddressLine)
+	party.AddressLine2 = stop.Location.AddressLine2
+	party.City = stop.Location.City
+	party.PostalCode = stop.Location.PostalCode
+	if stop.Location.State != nil {
+		party.StateCode = stop.Location.State.Abbreviation
+	}
+	return party
+}
+
+func commodityHazardousMaterial(
+	commodity *shipment.ShipmentCommodity,
+) *hazardousmaterial.HazardousMaterial {
+	if commodity == nil || commodity.Commodity == nil {
+		return nil
+	}
+	return commodity.Commodity.HazardousMaterial
+}
+
+func hazardousMaterialLines(
+	commodities []*shipment.ShipmentCommodity,
+) []edi.HazardousMaterialLine {
+	lines := make([]edi.HazardousMaterialLine, 0)
+	for _, commodity := range commodities {
+		material := commodityHazardousMaterial(commodity)
+		if material == nil {
+			continue
+		}
+		line := edi.HazardousMaterialLine{
+			Sequence:              int64(len(lines) + 1),
+			CommodityID:           commodity.CommodityID,
+			UNNumber:              x12UNNumber(material.UNNumber),
+			HazardClass:           x12HazardClass(material.Class),
+			SubsidiaryHazardClass: material.SubsidiaryHazardClass,
+			PackingGroup:          string(material.PackingGroup),
+			ProperShippingName: stringutils.FirstNonEmpty(
+				material.ProperShippingName,
+				material.Name,
+			),
+			Pieces:                      commodity.Pieces,
+			Weight:                      commodity.Weight,
+			ErgGuideNumber:              material.ErgGuideNumber,
+			EmergencyContact:            material.EmergencyContact,
+			EmergencyContactPhoneNumber: material.EmergencyContactPhoneNumber,
+		}
+		if material.IsReportableQuantity {
+			line.ReportableQuantity = "RQ"
+		}
+		if material.MarinePollutant {
+			line.MarinePollutant = "MARINE POLLUTANT"
+		}
+		if material.InhalationHazard {
+			line.InhalationHazard = "INHALATION HAZARD"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// x12HazardClass converts the stored class enum ("HazardClass2And1") to the
+// class/division code carried on LH2 ("2.1").
+func x12HazardClass(class hazardousmaterial.HazardousClass) string {
+	code := strings.TrimPrefix(string(class), "HazardClass")
+	return strings.Replace(code, "And", ".", 1)
+}
+
+func x12UNNumber(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" || strings.HasPrefix(value, "UN") || strings.HasPrefix(value, "NA") {
+		return value
+	}
+	return "UN" + value
+}
@@ -0,0 +1,166 @@
+package ediservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/commodity"
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/domain/hazardousmaterial"
+	"github.com/emoss08/trenova/internal/core/domain/location"
+	"github.com/emoss08/trenova/internal/core/domain/shipment"
+	"github.com/emoss08/trenova/internal/core/domain/trailer"
+	"github.com/emoss08/trenova/internal/core/domain/usstate"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBillOfLadingPayload(t *testing.T) {
+	t.Parallel()
+
+	source := ladingTestShipment()
+
+	payload := buildBillOfLadingPayload(source)
+
+	require.Equal(t, edi.TransactionSet211, payload.TransactionSet)
+	bol := payload.BillOfLading
+	require.NotNil(t, bol)
+	require.Equal(t, source.ID, bol.ShipmentID)
+	require.Equal(t, "BOL-211", bol.BOL)
+	require.Equal(t, int64(100), bol.ShipDate)
+	require.Equal(t, int64(300), bol.DeliveryDate)
+	require.Equal(t, "Houston Plant", bol.Shipper.Name)
+	require.Equal(t, "TX", bol.Shipper.StateCode)
+	require.Equal(t, "Tulsa Yard", bol.Consignee.Name)
+	require.Len(t, bol.LineItems, 2)
+	require.Equal(t, "Flammable paint", bol.LineItems[0].Description)
+	require.True(t, bol.LineItems[0].Hazardous)
+	require.False(t, bol.LineItems[1].Hazardous)
+	require.Equal(t, []edi.HazardousMaterialLine{
+		{
+			Sequence:                    1,
+			CommodityID:                 source.Commodities[0].CommodityID,
+			UNNumber:                    "UN1263",
+			HazardClass:                 "3",
+			PackingGroup:                "II",
+			ProperShippingName:          "Paint",
+			Pieces:                      10,
+			Weight:                      1200,
+			ReportableQuantity:          "RQ",
+			InhalationHazard:            "INHALATION HAZARD",
+			EmergencyContact:            "CHEMTREC",
+			EmergencyContactPhoneNumber: "8004249300",
+		},
+	}, bol.HazardousMaterials)
+}
+
+func TestBuildDeliveryManifestPayload(t *testing.T) {
+	t.Parallel()
+
+	source := ladingTestShipment()
+
+	whole := buildDeliveryManifestPayload(source, pulid.Nil).DeliveryManifest
+	require.NotNil(t, whole)
+	require.Equal(t, int64(2), whole.StopCount)
+	require.Equal(t, "Dallas Store", whole.Stops[0].LocationName)
+	require.Equal(t, int64(1), whole.Stops[0].Sequence)
+	require.Equal(t, "Tulsa Yard", whole.Stops[1].LocationName)
+	require.Equal(t, int64(2), whole.Stops[1].Sequence)
+	require.Equal(t, int64(200), whole.ManifestDate)
+	require.Empty(t, whole.EquipmentNumber)
+	require.Len(t, whole.HazardousMaterials, 1)
+
+	secondMove := source.Moves[1]
+	scoped := buildDeliveryManifestPayload(source, secondMove.ID).DeliveryManifest
+	require.NotNil(t, scoped)
+	require.Equal(t, secondMove.ID, scoped.ShipmentMoveID)
+	require.Len(t, scoped.Stops, 1)
+	require.Equal(t, "Tulsa Yard", scoped.Stops[0].LocationName)
+	require.Equal(t, int64(300), scoped.ManifestDate)
+	require.Equal(t, "TRL-42", scoped.EquipmentNumber)
+}
+
+func TestX12HazardClass(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "3", x12HazardClass(hazardousmaterial.HazardousClass3))
+	require.Equal(t, "2.1", x12HazardClass(hazardousmaterial.HazardousClass2And1))
+	require.Equal(t, "1.4", x12HazardClass(hazardousmaterial.HazardousClass1And4))
+	require.Equal(t, "UN1263", x12UNNumber(" 1263 "))
+	require.Equal(t, "NA1993", x12UNNumber("na1993"))
+}
+
+func ladingTestShipment() *shipment.Shipment {
+	texas := &usstate.UsState{Abbreviation: "TX"}
+	return &shipment.Shipment{
+		ID:        pulid.MustNew("sp_"),
+		BOL:       "BOL-211",
+		ProNumber: "PRO-211",
+		Moves: []*shipment.ShipmentMove{
+			{
+				ID: pulid.MustNew("smv_"),
+				Stops: []*shipment.Stop{
+					{
+						Type:                 shipment.StopTypePickup,
+						ScheduledWindowStart: 100,
+						Location: &location.Location{
+							Name:         "Houston Plant",
+							AddressLine1: "1 Plant Rd",
+							City:         "Houston",
+							State:        texas,
+						},
+					},
+					{
+						ID:                   pulid.MustNew("stp_"),
+						Type:                 shipment.StopTypeSplitDelivery,
+						ScheduledWindowStart: 200,
+						Location: &location.Location{
+							Name:  "Dallas Store",
+							State: texas,
+						},
+					},
+				},
+			},
+			{
+				ID: pulid.MustNew("smv_"),
+				Assignment: &shipment.Assignment{
+					Trailer: &trailer.Trailer{Code: "TRL-42"},
+				},
+				Stops: []*shipment.Stop{
+					{
+						ID:                   pulid.MustNew("stp_"),
+						Type:                 shipment.StopTypeDelivery,
+						ScheduledWindowStart: 300,
+						Location:             &location.Location{Name: "Tulsa Yard"},
+					},
+				},
+			},
+		},
+		Commodities: []*shipment.ShipmentCommodity{
+			{
+				CommodityID: pulid.MustNew("com_"),
+				Pieces:      10,
+				Weight:      1200,
+				Commodity: &commodity.Commodity{
+					Name:        "Paint",
+					Description: "Flammable paint",
+					HazardousMaterial: &hazardousmaterial.HazardousMaterial{
+						Name:                        "Paint",
+						Class:                       hazardousmaterial.HazardousClass3,
+						UNNumber:                    "1263",
+						PackingGroup:                hazardousmaterial.PackingGroupII,
+						EmergencyContact:            "CHEMTREC",
+						EmergencyContactPhoneNumber: "8004249300",
+						IsReportableQuantity:        true,
+						InhalationHazard:            true,
+					},
+				},
+			},
+			{
+				CommodityID: pulid.MustNew("com_"),
+				Pieces:      4,
+				Weight:      80,
+				Commodity:   &commodity.Commodity{Name: "Brushes"},
+			},
+		},
+	}
+}
@@ -68,10 +68,13 @@ type Params struct {
 	ShipmentCommentRepo repositories.ShipmentCommentRepository
 	UserRepo            repositories.UserRepository
 	ShipmentRepo        repositories.ShipmentRepository
-	TenderRepo          repositories.TenderRepository       `optional:"true"`
-	CarrierRepo         repositories.CarrierRepository      `optional:"true"`
-	ShipmentMoveRepo    repositories.ShipmentMoveRepository `optional:"true"`
-	ShipmentMoves       services.ShipmentMoveService        `optional:"true"`
+	TenderRepo          repositories.TenderRepository                 `optional:"true"`
+	CarrierRepo         repositories.CarrierRepository                `optional:"true"`
+	ShipmentMoveRepo    repositories.ShipmentMoveRepository           `optional:"true"`
+	GuideRepo           repositories.EDIImplementationGuideRepository `optional:"true"`
+	CertificationRepo   repositories.EDICertificationRunRepository    `optional:"true"`
+	LedgerRepo          repositories.EDIControlNumberLedgerRepository `optional:"true"`
+	ShipmentMoves       services.ShipmentMoveService                  `optional:"true"`
 	ShipmentSvc         services.ShipmentService
 	WorkflowStarter     services.WorkflowStarter
 	AuditService        services.AuditService
@@ -115,6 +118,9 @@ type Service struct {
 	tenderRepo          repositories.TenderRepository
 	carrierRepo         repositories.CarrierRepository
 	shipmentMoveRepo    repositories.ShipmentMoveRepository
+	guideRepo           repositories.EDIImplementationGuideRepository
+	certificationRepo   repositories.EDICertificationRunRepository
+	ledgerRepo          repositories.EDIControlNumberLedgerRepository
 	shipmentMoves       services.ShipmentMoveService
 	shipmentSvc         services.ShipmentService
 	workflowStarter     services.WorkflowStarter
@@ -166,6 +172,9 @@ func New(p Params) *Service {
 		tenderRepo:          p.TenderRepo,
 		carrierRepo:         p.CarrierRepo,
 		shipmentMoveRepo:    p.ShipmentMoveRepo,
+		guideRepo:           p.GuideRepo,
+		certificationRepo:   p.CertificationRepo,
+		ledgerRepo:          p.LedgerRepo,
 		shipmentMoves:       p.ShipmentMoves,
 		shipmentSvc:         p.ShipmentSvc,
 		workflowStarter:     p.WorkflowStarter,
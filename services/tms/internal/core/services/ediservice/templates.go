@@ -777,15 +777,15 @@ func (s *Service) validateTemplateCreateRequest(
 	if strings.TrimSpace(req.Name) == "" {
 		multiErr.Add("name", errortypes.ErrRequired, "Template name is required")
 	}
+	if req.TransactionSet == "" {
+		req.TransactionSet = edi.TransactionSet204
+	}
 	if req.Standard == "" {
-		req.Standard = edi.EDIStandardX12
+		req.Standard = req.TransactionSet.Standard()
 	}
 	if req.Direction == "" {
 		req.Direction = edi.DocumentDirectionOutbound
 	}
-	if req.TransactionSet == "" {
-		req.TransactionSet = edi.TransactionSet204
-	}
 	if multiErr.HasErrors() {
 		return multiErr
 	}
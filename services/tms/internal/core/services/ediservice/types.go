@@ -107,12 +107,13 @@ type PreviewEDIDocumentRequest = services.PreviewEDIDocumentRequest
 type GenerateEDIDocumentRequest = services.GenerateEDIDocumentRequest
 
 type InspectX12Request struct {
-	TenantInfo     pagination.TenantInfo    `json:"-"`
-	RawX12         string                   `json:"rawX12"`
-	TransactionSet edi.TransactionSet       `json:"transactionSet"`
-	X12Version     string                   `json:"x12Version"`
-	Envelope       *edi.X12EnvelopeSettings `json:"envelope"`
-	Diagnostics    []edix12.Diagnostic      `json:"diagnostics"`
+	TenantInfo            pagination.TenantInfo    `json:"-"`
+	RawX12                string                   `json:"rawX12"`
+	TransactionSet        edi.TransactionSet       `json:"transactionSet"`
+	X12Version            string                   `json:"x12Version"`
+	Envelope              *edi.X12EnvelopeSettings `json:"envelope"`
+	Diagnostics           []edix12.Diagnostic      `json:"diagnostics"`
+	ImplementationGuideID pulid.ID                 `json:"implementationGuideId"`
 }
 
 type EDIMessageInspection struct {
@@ -253,9 +254,24 @@ type DeliverEDIMessageWorkflowPayload struct {
 }
 
 type DeliverEDIMessageWorkflowResult struct {
-	MessageID      pulid.ID                  `json:"messageId"`
-	DeliveryStatus edi.MessageDeliveryStatus `json:"deliveryStatus"`
-	RemotePath     string                    `json:"remotePath"`
+	MessageID         pulid.ID                  `json:"messageId"`
+	DeliveryStatus    edi.MessageDeliveryStatus `json:"deliveryStatus"`
+	RemotePath        string                    `json:"remotePath"`
+	AS2MessageID      string                    `json:"as2MessageId,omitempty"`
+	MDNTimeoutSeconds int64                     `json:"mdnTimeoutSeconds,omitempty"`
+}
+
+// AwaitingMDN reports whether the partner still owes an asynchronous MDN for
+// the delivery.
+func (r *DeliverEDIMessageWorkflowResult) AwaitingMDN() bool {
+	return r != nil && r.AS2MessageID != "" && r.MDNTimeoutSeconds > 0
+}
+
+type ExpireAS2MDNPayload struct {
+	MessageID         pulid.ID              `json:"messageId"`
+	TenantInfo        pagination.TenantInfo `json:"tenantInfo"`
+	AS2MessageID      string                `json:"as2MessageId"`
+	MDNTimeoutSeconds int64                 `json:"mdnTimeoutSeconds"`
 }
 
 type MarkEDIMessageDeadLetteredPayload struct {
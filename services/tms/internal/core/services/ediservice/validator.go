@@ -5,8 +5,10 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edistarlark"
 	"github.com/emoss08/trenova/internal/core/services/editransport"
 	"github.com/emoss08/trenova/pkg/errortypes"
 	"github.com/emoss08/trenova/shared/as2"
@@ -28,6 +30,15 @@ func (v *Validator) ValidatePartner(entity *edi.EDIPartner) *errortypes.MultiErr
 	}
 
 	entity.Validate(multiErr)
+	// A transform that cannot load would fail every inbound transaction from
+	// the partner, so it is rejected when saved rather than when files arrive.
+	if strings.TrimSpace(entity.InboundTransformScript) != "" {
+		for _, diagnostic := range edistarlark.ValidateTransformScript(
+			entity.InboundTransformScript,
+		) {
+			multiErr.Add("inboundTransformScript", errortypes.ErrInvalid, diagnostic.Message)
+		}
+	}
 	if multiErr.HasErrors() {
 		return multiErr
 	}
@@ -64,7 +75,10 @@ func (v *Validator) ValidateConnection(entity *edi.EDIConnection) *errortypes.Mu
 				edi.ConnectionMethodAS2,
 				edi.ConnectionMethodSFTP,
 				edi.ConnectionMethodVAN,
-			).Error("Method must be Internal, AS2, SFTP, or VAN"),
+				edi.ConnectionMethodHTTPS,
+				edi.ConnectionMethodFTPS,
+				edi.ConnectionMethodS3,
+			).Error("Method must be Internal, AS2, SFTP, VAN, HTTPS, FTPS, or S3"),
 		),
 		validation.Field(
 			&entity.Status,
@@ -139,7 +153,10 @@ func (v *Validator) ValidateCommunicationProfile(
 				edi.ConnectionMethodAS2,
 				edi.ConnectionMethodSFTP,
 				edi.ConnectionMethodVAN,
-			).Error("Method must be Internal, AS2, SFTP, or VAN"),
+				edi.ConnectionMethodHTTPS,
+				edi.ConnectionMethodFTPS,
+				edi.ConnectionMethodS3,
+			).Error("Method must be Internal, AS2, SFTP, VAN, HTTPS, FTPS, or S3"),
 		),
 		validation.Field(
 			&entity.Name,
@@ -169,7 +186,22 @@ func (v *Validator) ValidatePartnerDocumentProfileRequest(
 	validateDocumentStatus(multiErr, req.Status)
 	validateValidationMode(multiErr, req.ValidationMode)
 	validateAcknowledgmentType(multiErr, req.Acknowledgment.Type)
-	validateEnvelope(multiErr, &req.Envelope)
+
+	if multiErr.HasErrors() {
+		return multiErr
+	}
+	return nil
+}
+
+// ValidatePartnerDocumentProfileEnvelope checks the envelope against the
+// standard of the template the profile renders, which is only known once the
+// template has been resolved.
+func (v *Validator) ValidatePartnerDocumentProfileEnvelope(
+	standard edi.EDIStandard,
+	envelope *edi.X12EnvelopeSettings,
+) *errortypes.MultiError {
+	multiErr := errortypes.NewMultiError()
+	validateEnvelope(multiErr, standard, envelope)
 
 	if multiErr.HasErrors() {
 		return multiErr
@@ -246,7 +278,40 @@ func validateAcknowledgmentType(multiErr *errortypes.MultiError, ackType edi.Ack
 	}
 }
 
-func validateEnvelope(multiErr *errortypes.MultiError, envelope *edi.X12EnvelopeSettings) {
+func validateEnvelope(
+	multiErr *errortypes.MultiError,
+	standard edi.EDIStandard,
+	envelope *edi.X12EnvelopeSettings,
+) {
+	if standard == edi.EDIStandardEDIFACT {
+		validateUNBParties(multiErr, envelope)
+	} else {
+		validateISAParties(multiErr, envelope)
+	}
+	requireSeparator(multiErr, "envelope.elementSeparator", envelope.ElementSeparator)
+	requireSeparator(multiErr, "envelope.segmentTerminator", envelope.SegmentTerminator)
+	requireSeparator(multiErr, "envelope.componentSeparator", envelope.ComponentSeparator)
+	if standard != edi.EDIStandardEDIFACT || envelope.RepetitionSeparator != "" {
+		// EDIFACT profiles may leave the repetition separator unset to stay on
+		// syntax version 3; X12 5010 envelopes always carry one in ISA11.
+		requireSeparator(multiErr, "envelope.repetitionSeparator", envelope.RepetitionSeparator)
+	}
+
+	if envelope.InterchangeUsageIndicator == "" {
+		return
+	}
+	switch envelope.InterchangeUsageIndicator {
+	case "P", "T":
+	default:
+		multiErr.Add(
+			"envelope.interchangeUsageIndicator",
+			errortypes.ErrInvalid,
+			"Usage indicator must be P or T",
+		)
+	}
+}
+
+func validateISAParties(multiErr *errortypes.MultiError, envelope *edi.X12EnvelopeSettings) {
 	requireX12ID(
 		multiErr,
 		"envelope.interchangeSenderId",
@@ -269,23 +334,34 @@ func validateEnvelope(multiErr *errortypes.MultiError, envelope *edi.X12Envelope
 		"envelope.interchangeReceiverQualifier",
 		envelope.InterchangeReceiverQualifier,
 	)
-	requireSeparator(multiErr, "envelope.elementSeparator", envelope.ElementSeparator)
-	requireSeparator(multiErr, "envelope.segmentTerminator", envelope.SegmentTerminator)
-	requireSeparator(multiErr, "envelope.componentSeparator", envelope.ComponentSeparator)
-	requireSeparator(multiErr, "envelope.repetitionSeparator", envelope.RepetitionSeparator)
+}
 
-	if envelope.InterchangeUsageIndicator == "" {
-		return
-	}
-	switch envelope.InterchangeUsageIndicator {
-	case "P", "T":
-	default:
-		multiErr.Add(
-			"envelope.interchangeUsageIndicator",
-			errortypes.ErrInvalid,
-			"Usage indicator must be P or T",
-		)
-	}
+// validateUNBParties checks the UNB sender and receiver identifications
+// (data elements 0004/0010, an..35) and their code qualifiers (0007/0008,
+// an..4).
+func validateUNBParties(multiErr *errortypes.MultiError, envelope *edi.X12EnvelopeSettings) {
+	requireEDIFACTPartyID(
+		multiErr,
+		"envelope.interchangeSenderId",
+		envelope.InterchangeSenderID,
+		"UNB sender ID is required",
+	)
+	requireEDIFACTPartyID(
+		multiErr,
+		"envelope.interchangeReceiverId",
+		envelope.InterchangeReceiverID,
+		"UNB receiver ID is required",
+	)
+	validateUNBQualifier(
+		multiErr,
+		"envelope.interchangeSenderQualifier",
+		envelope.InterchangeSenderQualifier,
+	)
+	validateUNBQualifier(
+		multiErr,
+		"envelope.interchangeReceiverQualifier",
+		envelope.InterchangeReceiverQualifier,
+	)
 }
 
 func requireX12ID(multiErr *errortypes.MultiError, field, value, message string) {
@@ -299,6 +375,17 @@ func requireX12ID(multiErr *errortypes.MultiError, field, value, message string)
 	}
 }
 
+func requireEDIFACTPartyID(multiErr *errortypes.MultiError, field, value, message string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		multiErr.Add(field, errortypes.ErrRequired, message)
+		return
+	}
+	if len(value) > 35 {
+		multiErr.Add(field, errortypes.ErrInvalid, "UNB party ID must be 35 characters or fewer")
+	}
+}
+
 func requireSeparator(multiErr *errortypes.MultiError, field, value string) {
 	if value == "" {
 		multiErr.Add(field, errortypes.ErrRequired, "Separator is required")
@@ -348,6 +435,35 @@ func (v *Validator) validateProfileConfig(
 			[]string{"password", "privateKey"},
 			"VAN password or private key secret is required",
 		)
+	case edi.ConnectionMethodFTPS:
+		requireConfigString(multiErr, entity.Config, "host", "Host is required")
+		requireConfigString(multiErr, entity.Config, "port", "Port is required")
+		requireConfigString(multiErr, entity.Config, "username", "Username is required")
+		requireAnySecret(
+			multiErr,
+			entity.EncryptedSecrets,
+			[]string{"password"},
+			"FTPS password secret is required",
+		)
+		validateTLSCertificateConfig(multiErr, entity.Config)
+	case edi.ConnectionMethodS3:
+		requireConfigString(multiErr, entity.Config, "endpoint", "Endpoint is required")
+		requireConfigString(multiErr, entity.Config, "bucket", "Bucket is required")
+		requireConfigString(multiErr, entity.Config, "accessKeyId", "Access key ID is required")
+		requireAnySecret(
+			multiErr,
+			entity.EncryptedSecrets,
+			[]string{editransport.SecretKeyS3SecretAccessKey},
+			"S3 secret access key secret is required",
+		)
+		validateS3EndpointConfig(multiErr, entity.Config)
+	case edi.ConnectionMethodHTTPS:
+		requireConfigString(multiErr, entity.Config, "endpointUrl", "Endpoint URL is required")
+		v.validateHTTPSProfileConfig(entity, multiErr)
+		// HTTPS partners exchange JSON documents, so there is no X12 envelope
+		// to configure.
+		validateDeliveryRetryConfig(multiErr, entity.Config)
+		return
 	}
 	if entity.Method != edi.ConnectionMethodInternal {
 		requireConfigString(
@@ -445,6 +561,12 @@ func validateISAQualifier(multiErr *errortypes.MultiError, field, value string)
 	}
 }
 
+func validateUNBQualifier(multiErr *errortypes.MultiError, field, value string) {
+	if len(value) > 4 {
+		multiErr.Add(field, errortypes.ErrInvalid, "UNB qualifier must be 4 characters or fewer")
+	}
+}
+
 func requireConfigString(
 	multiErr *errortypes.MultiError,
 	config map[string]any,
@@ -522,19 +644,22 @@ func (v *Validator) validateAS2ProfileConfig(
 			"Async MDN return URL is required when MDN mode is async",
 		)
 	}
-	if endpointURL := maputils.StringValue(
+	if minutes, ok := maputils.IntValue(
 		entity.Config,
-		editransport.ConfigKeyEndpointURL,
-	); endpointURL != "" {
-		if parsed, err := url.Parse(endpointURL); err != nil || parsed.Scheme == "" ||
-			parsed.Host == "" {
-			multiErr.Add(
-				"config.endpointUrl",
-				errortypes.ErrInvalid,
-				"Endpoint URL must be a valid absolute URL",
-			)
-		}
+		editransport.ConfigKeyMDNTimeoutMinutes,
+	); ok && (minutes <= 0 || minutes > int64(editransport.MaxAsyncMDNTimeout/time.Minute)) {
+		multiErr.Add(
+			"config.mdnTimeoutMinutes",
+			errortypes.ErrInvalid,
+			"MDN timeout must be between 1 and 4320 minutes",
+		)
 	}
+	validateAbsoluteURL(
+		multiErr,
+		entity.Config,
+		editransport.ConfigKeyEndpointURL,
+		"Endpoint URL must be a valid absolute URL",
+	)
 	validateAS2Algorithm(
 		multiErr,
 		entity.Config,
@@ -609,6 +734,135 @@ func (v *Validator) validateAS2ProfileConfig(
 	}
 }
 
+func (v *Validator) validateHTTPSProfileConfig(
+	entity *edi.EDICommunicationProfile,
+	multiErr *errortypes.MultiError,
+) {
+	validateHTTPSURL(
+		multiErr,
+		entity.Config,
+		editransport.ConfigKeyEndpointURL,
+		"Endpoint URL must be a valid absolute https URL",
+	)
+	validateHTTPSAuthScheme(multiErr, entity.Config)
+	switch strings.ToLower(maputils.StringValue(entity.Config, editransport.ConfigKeyHTTPSAuthScheme)) {
+	case editransport.HTTPSAuthSchemeOAuth2:
+		requireConfigString(
+			multiErr,
+			entity.Config,
+			editransport.ConfigKeyHTTPSTokenURL,
+			"Token URL is required for OAuth2",
+		)
+		requireConfigString(
+			multiErr,
+			entity.Config,
+			editransport.ConfigKeyHTTPSClientID,
+			"Client ID is required for OAuth2",
+		)
+		validateHTTPSURL(
+			multiErr,
+			entity.Config,
+			editransport.ConfigKeyHTTPSTokenURL,
+			"Token URL must be a valid absolute https URL",
+		)
+		requireAnySecret(
+			multiErr,
+			entity.EncryptedSecrets,
+			[]string{editransport.SecretKeyHTTPSClientSecret},
+			"OAuth2 client secret is required",
+		)
+	case editransport.HTTPSAuthSchemeHMAC:
+		requireAnySecret(
+			multiErr,
+			entity.EncryptedSecrets,
+			[]string{editransport.SecretKeyHTTPSHMACSecret},
+			"HMAC secret is required",
+		)
+	}
+	if _, err := editransport.HTTPSPayloadMappingFromConfig(entity.Config); err != nil {
+		multiErr.Add(
+			"config."+editransport.ConfigKeyHTTPSPayloadMapping,
+			errortypes.ErrInvalid,
+			"Payload mapping must be a JSON object of target paths to source paths",
+		)
+	}
+}
+
+func validateAbsoluteURL(
+	multiErr *errortypes.MultiError,
+	config map[string]any,
+	key string,
+	message string,
+) {
+	value := maputils.StringValue(config, key)
+	if value == "" {
+		return
+	}
+	if parsed, err := url.Parse(value); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		multiErr.Add("config."+key, errortypes.ErrInvalid, message)
+	}
+}
+
+// validateHTTPSURL is validateAbsoluteURL restricted to https, for URLs that
+// carry documents, client secrets, or bearer tokens.
+func validateHTTPSURL(
+	multiErr *errortypes.MultiError,
+	config map[string]any,
+	key string,
+	message string,
+) {
+	value := maputils.StringValue(config, key)
+	if value == "" {
+		return
+	}
+	if parsed, err := url.Parse(value); err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		multiErr.Add("config."+key, errortypes.ErrInvalid, message)
+	}
+}
+
+func validateHTTPSAuthScheme(multiErr *errortypes.MultiError, config map[string]any) {
+	switch strings.ToLower(maputils.StringValue(config, editransport.ConfigKeyHTTPSAuthScheme)) {
+	case "",
+		editransport.HTTPSAuthSchemeNone,
+		editransport.HTTPSAuthSchemeOAuth2,
+		editransport.HTTPSAuthSchemeHMAC:
+	default:
+		multiErr.Add(
+			"config."+editransport.ConfigKeyHTTPSAuthScheme,
+			errortypes.ErrInvalid,
+			"Authentication scheme must be none, oauth2, or hmac",
+		)
+	}
+}
+
+func validateTLSCertificateConfig(multiErr *errortypes.MultiError, config map[string]any) {
+	value := maputils.StringValue(config, editransport.ConfigKeyTLSCertificate)
+	if value == "" {
+		return
+	}
+	if err := editransport.ValidateFTPSCertificate(value); err != nil {
+		multiErr.Add(
+			"config."+editransport.ConfigKeyTLSCertificate,
+			errortypes.ErrInvalid,
+			"TLS certificate must be a PEM-encoded X.509 certificate",
+		)
+	}
+}
+
+func validateS3EndpointConfig(multiErr *errortypes.MultiError, config map[string]any) {
+	value := maputils.StringValue(config, editransport.ConfigKeyS3Endpoint)
+	if value == "" {
+		return
+	}
+	if err := editransport.ValidateS3Endpoint(value); err != nil {
+		multiErr.Add(
+			"config."+editransport.ConfigKeyS3Endpoint,
+			errortypes.ErrInvalid,
+			"Endpoint must be a host or an http(s) URL without a path",
+		)
+	}
+}
+
 func validateAS2Algorithm(
 	multiErr *errortypes.MultiError,
 	config map[string]any,
@@ -0,0 +1,79 @@
+package ediservice
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/editransport"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCommunicationProfile_HTTPSRequiresTLSAndKnownAuthScheme(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config map[string]any
+		field  string
+	}{
+		{
+			name: "https endpoint and token URL",
+			config: map[string]any{
+				editransport.ConfigKeyEndpointURL:     "https://partner.example.com/tenders",
+				editransport.ConfigKeyHTTPSAuthScheme: editransport.HTTPSAuthSchemeOAuth2,
+				editransport.ConfigKeyHTTPSTokenURL:   "https://auth.example.com/token",
+				editransport.ConfigKeyHTTPSClientID:   "trenova",
+			},
+		},
+		{
+			name: "cleartext endpoint",
+			config: map[string]any{
+				editransport.ConfigKeyEndpointURL: "http://partner.example.com/tenders",
+			},
+			field: "config." + editransport.ConfigKeyEndpointURL,
+		},
+		{
+			name: "cleartext token URL",
+			config: map[string]any{
+				editransport.ConfigKeyEndpointURL:     "https://partner.example.com/tenders",
+				editransport.ConfigKeyHTTPSAuthScheme: editransport.HTTPSAuthSchemeOAuth2,
+				editransport.ConfigKeyHTTPSTokenURL:   "http://auth.example.com/token",
+				editransport.ConfigKeyHTTPSClientID:   "trenova",
+			},
+			field: "config." + editransport.ConfigKeyHTTPSTokenURL,
+		},
+		{
+			name: "unknown auth scheme",
+			config: map[string]any{
+				editransport.ConfigKeyEndpointURL:     "https://partner.example.com/tenders",
+				editransport.ConfigKeyHTTPSAuthScheme: "basic",
+			},
+			field: "config." + editransport.ConfigKeyHTTPSAuthScheme,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			multiErr := NewValidator().ValidateCommunicationProfile(&edi.EDICommunicationProfile{
+				BusinessUnitID: pulid.MustNew("bu_"),
+				OrganizationID: pulid.MustNew("org_"),
+				Method:         edi.ConnectionMethodHTTPS,
+				Name:           "Partner API",
+				Config:         tt.config,
+				EncryptedSecrets: map[string]string{
+					editransport.SecretKeyHTTPSClientSecret: "client-secret",
+				},
+			})
+			if tt.field == "" {
+				require.Nil(t, multiErr)
+				return
+			}
+			require.NotNil(t, multiErr)
+			require.Len(t, multiErr.Errors, 1)
+			require.Equal(t, tt.field, multiErr.Errors[0].Field)
+		})
+	}
+}
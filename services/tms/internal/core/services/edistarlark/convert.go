@@ -182,3 +182,63 @@ func trimFloat(value float64) string {
 	}
 	return strconv.FormatFloat(value, 'f', -1, 64)
 }
+
+// fromStarlarkValue converts a script result back into the JSON shapes the
+// EDI payloads decode from.
+//
+//nolint:cyclop // Starlark conversion must enumerate supported scalar and collection types.
+func fromStarlarkValue(value starlark.Value) (any, error) {
+	switch typed := value.(type) {
+	case nil, starlark.NoneType:
+		return nil, nil //nolint:nilnil // None is a JSON null, not an error
+	case starlark.String:
+		return string(typed), nil
+	case starlark.Bool:
+		return bool(typed), nil
+	case starlark.Int:
+		if intValue, ok := typed.Int64(); ok {
+			return intValue, nil
+		}
+		return typed.BigInt().String(), nil
+	case starlark.Float:
+		floatValue := float64(typed)
+		if math.IsNaN(floatValue) || math.IsInf(floatValue, 0) {
+			return nil, fmt.Errorf("float %s cannot be represented in a document", typed)
+		}
+		return floatValue, nil
+	case *starlark.Dict:
+		values := make(map[string]any, typed.Len())
+		for _, item := range typed.Items() {
+			key, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("document keys must be strings, got %s", item[0].Type())
+			}
+			converted, err := fromStarlarkValue(item[1])
+			if err != nil {
+				return nil, fmt.Errorf("convert key %q: %w", string(key), err)
+			}
+			values[string(key)] = converted
+		}
+		return values, nil
+	case *starlark.List:
+		return iterableFromStarlark(typed, typed.Len())
+	case starlark.Tuple:
+		return iterableFromStarlark(typed, typed.Len())
+	}
+	return nil, fmt.Errorf("unsupported %s value in document", value.Type())
+}
+
+func iterableFromStarlark(values starlark.Iterable, size int) ([]any, error) {
+	items := make([]any, 0, size)
+	iter := values.Iterate()
+	defer iter.Done()
+	var item starlark.Value
+	for index := 0; iter.Next(&item); index++ {
+		converted, err := fromStarlarkValue(item)
+		if err != nil {
+			return nil, fmt.Errorf("convert index %d: %w", index, err)
+		}
+		items = append(items, converted)
+	}
+	return items, nil
+}
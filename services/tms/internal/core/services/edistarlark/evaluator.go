@@ -50,6 +50,11 @@ func Evaluate(ctx context.Context, req EvalRequest) EvalResult {
 	return NewEvaluator(Options{}).Evaluate(ctx, req)
 }
 
+// Transform runs an inbound transform with the default sandbox limits.
+func Transform(ctx context.Context, req TransformRequest) TransformResult {
+	return NewEvaluator(Options{}).Transform(ctx, req)
+}
+
 func ValidateScriptFunction(req EvalRequest) []Diagnostic {
 	evaluator := NewEvaluator(Options{})
 	thread := evaluator.newThread()
@@ -172,6 +177,18 @@ func BuildContextFromPayload(
 }
 
 func (e *Evaluator) Evaluate(ctx context.Context, req EvalRequest) EvalResult {
+	return e.run(ctx, req, func(thread *starlark.Thread) EvalResult {
+		return e.evaluateOnThread(thread, req)
+	})
+}
+
+// run executes the script on a fresh thread, cancelling it once the step
+// limit or the timeout is reached.
+func (e *Evaluator) run(
+	ctx context.Context,
+	req EvalRequest,
+	execute func(*starlark.Thread) EvalResult,
+) EvalResult {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -184,7 +201,7 @@ func (e *Evaluator) Evaluate(ctx context.Context, req EvalRequest) EvalResult {
 
 	done := make(chan executionResult, 1)
 	go func() {
-		done <- executionResult{result: e.evaluateOnThread(thread, req)}
+		done <- executionResult{result: execute(thread)}
 	}()
 
 	select {
@@ -242,7 +259,7 @@ func (e *Evaluator) evaluateOnThread(thread *starlark.Thread, req EvalRequest) (
 		}
 	}
 
-	globals, diagnostics := e.evalLibraries(thread, req)
+	fn, diagnostics := e.resolveFunction(thread, req)
 	if len(diagnostics) > 0 {
 		return EvalResult{
 			Diagnostics:    diagnostics,
@@ -250,44 +267,6 @@ func (e *Evaluator) evaluateOnThread(thread *starlark.Thread, req EvalRequest) (
 		}
 	}
 
-	if strings.TrimSpace(req.Script) != "" {
-		globals, err = e.evalInlineScript(thread, req, globals)
-		if err != nil {
-			return resultWithDiagnostic(req, thread, classifyError(err), err)
-		}
-	}
-
-	functionName := strings.TrimSpace(req.FunctionName)
-	if functionName == "" {
-		if strings.TrimSpace(req.Script) == "" {
-			return resultWithDiagnostic(
-				req,
-				thread,
-				DiagnosticCodeFunctionNotFound,
-				errors.New("starlark function name is required when no inline script is provided"),
-			)
-		}
-		functionName = defaultFunctionName
-	}
-
-	fn, ok := globals[functionName]
-	if !ok {
-		return resultWithDiagnostic(
-			req,
-			thread,
-			DiagnosticCodeFunctionNotFound,
-			fmt.Errorf("required Starlark function %q is not defined", functionName),
-		)
-	}
-	if _, ok = fn.(starlark.Callable); !ok {
-		return resultWithDiagnostic(
-			req,
-			thread,
-			DiagnosticCodeFunctionNotCallable,
-			fmt.Errorf("%q is not callable", functionName),
-		)
-	}
-
 	args := starlark.Tuple{ctxValue}
 	if req.Item != nil && callableAcceptsItem(fn) {
 		args = starlark.Tuple{ctxValue, itemValue}
@@ -318,6 +297,61 @@ func (e *Evaluator) evaluateOnThread(thread *starlark.Thread, req EvalRequest) (
 	return result
 }
 
+// resolveFunction executes the request's libraries and inline script and
+// returns the callable the request names.
+func (e *Evaluator) resolveFunction(
+	thread *starlark.Thread,
+	req EvalRequest,
+) (starlark.Value, []Diagnostic) {
+	globals, diagnostics := e.evalLibraries(thread, req)
+	if len(diagnostics) > 0 {
+		return nil, diagnostics
+	}
+
+	if strings.TrimSpace(req.Script) != "" {
+		var err error
+		globals, err = e.evalInlineScript(thread, req, globals)
+		if err != nil {
+			return nil, []Diagnostic{diagnostic(req, classifyError(err), err.Error())}
+		}
+	}
+
+	functionName := strings.TrimSpace(req.FunctionName)
+	if functionName == "" {
+		if strings.TrimSpace(req.Script) == "" {
+			return nil, []Diagnostic{
+				diagnostic(
+					req,
+					DiagnosticCodeFunctionNotFound,
+					"starlark function name is required when no inline script is provided",
+				),
+			}
+		}
+		functionName = defaultFunctionName
+	}
+
+	fn, ok := globals[functionName]
+	if !ok {
+		return nil, []Diagnostic{
+			diagnostic(
+				req,
+				DiagnosticCodeFunctionNotFound,
+				fmt.Sprintf("required Starlark function %q is not defined", functionName),
+			),
+		}
+	}
+	if _, ok = fn.(starlark.Callable); !ok {
+		return nil, []Diagnostic{
+			diagnostic(
+				req,
+				DiagnosticCodeFunctionNotCallable,
+				fmt.Sprintf("%q is not callable", functionName),
+			),
+		}
+	}
+	return fn, nil
+}
+
 func (e *Evaluator) newThread() *starlark.Thread {
 	return &starlark.Thread{
 		Name:  "edi-starlark",
@@ -550,6 +584,8 @@ func suggestedFix(code string) string {
 		return "Reduce script execution time or simplify expensive loops."
 	case diagnosticCodeInvalidResult:
 		return "Return a string, number, boolean, or None from the Starlark function."
+	case DiagnosticCodeInvalidTransformResult:
+		return "Return the document dict, or None after editing the document in place."
 	case diagnosticCodePanic:
 		return "Review the approved helper implementation used by this script."
 	default:
@@ -0,0 +1,117 @@
+package edistarlark
+
+import (
+	"context"
+	"fmt"
+
+	"go.starlark.net/starlark"
+)
+
+// Transform runs an inbound document through the script's transform function
+// under the same step and time limits as element evaluation. The document is
+// handed to the script unfrozen, so the function may edit it in place and
+// return None, or return a replacement dict.
+func (e *Evaluator) Transform(ctx context.Context, req TransformRequest) TransformResult {
+	evalReq := req.evalRequest()
+	result := e.run(ctx, evalReq, func(thread *starlark.Thread) EvalResult {
+		return e.transformOnThread(thread, evalReq, req.Document)
+	})
+	if len(result.Diagnostics) > 0 {
+		return TransformResult{
+			Diagnostics:    result.Diagnostics,
+			ExecutionSteps: result.ExecutionSteps,
+		}
+	}
+
+	document, err := fromStarlarkValue(result.Raw)
+	if err == nil {
+		if converted, ok := document.(map[string]any); ok {
+			return TransformResult{
+				Document:       converted,
+				Diagnostics:    []Diagnostic{},
+				ExecutionSteps: result.ExecutionSteps,
+			}
+		}
+		err = fmt.Errorf("transform produced %T instead of a document", document)
+	}
+	return TransformResult{
+		Diagnostics: []Diagnostic{
+			diagnostic(evalReq, DiagnosticCodeInvalidTransformResult, err.Error()),
+		},
+		ExecutionSteps: result.ExecutionSteps,
+	}
+}
+
+// ValidateTransformScript reports the diagnostics that would stop the script
+// from running as an inbound transform, without a document to run it on.
+func ValidateTransformScript(script string) []Diagnostic {
+	req := TransformRequest{Script: script}
+	return ValidateScriptFunction(req.evalRequest())
+}
+
+func (r TransformRequest) evalRequest() EvalRequest {
+	path := r.Path
+	if path == "" {
+		path = defaultTransformFilename
+	}
+	return EvalRequest{
+		Script:       r.Script,
+		FunctionName: defaultTransformFunctionName,
+		Path:         path,
+	}
+}
+
+func (e *Evaluator) transformOnThread(
+	thread *starlark.Thread,
+	req EvalRequest,
+	document map[string]any,
+) (result EvalResult) {
+	result.Diagnostics = []Diagnostic{}
+	defer func() {
+		result.ExecutionSteps = thread.ExecutionSteps()
+		if recovered := recover(); recovered != nil {
+			result.Raw = nil
+			result.Diagnostics = []Diagnostic{
+				diagnostic(
+					req,
+					diagnosticCodePanic,
+					fmt.Sprintf("Starlark runtime panicked: %v", recovered),
+				),
+			}
+		}
+	}()
+
+	documentValue, err := toStarlarkValue(ensureMap(document))
+	if err != nil {
+		return resultWithDiagnostic(req, thread, diagnosticCodeRuntimeError, err)
+	}
+
+	fn, diagnostics := e.resolveFunction(thread, req)
+	if len(diagnostics) > 0 {
+		return EvalResult{
+			Diagnostics:    diagnostics,
+			ExecutionSteps: thread.ExecutionSteps(),
+		}
+	}
+
+	raw, err := starlark.Call(thread, fn, starlark.Tuple{documentValue}, nil)
+	if err != nil {
+		return resultWithDiagnostic(req, thread, classifyError(err), err)
+	}
+
+	switch raw.(type) {
+	case starlark.NoneType:
+		result.Raw = documentValue
+	case *starlark.Dict:
+		result.Raw = raw
+	default:
+		result.Diagnostics = []Diagnostic{
+			diagnostic(
+				req,
+				DiagnosticCodeInvalidTransformResult,
+				fmt.Sprintf("Starlark transform returned unsupported %s result", raw.Type()),
+			),
+		}
+	}
+	return result
+}
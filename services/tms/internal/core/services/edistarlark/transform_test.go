@@ -0,0 +1,106 @@
+package edistarlark
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransform_EditsDocumentInPlace(t *testing.T) {
+	t.Parallel()
+
+	result := Transform(t.Context(), TransformRequest{
+		Script: `REASONS = {"X1": "NS", "X2": "AG"}
+
+def transform(document):
+    status = document["shipmentStatus"]
+    status["statusReasonCode"] = REASONS.get(status["statusReasonCode"], "NS")
+    ref, _, pro = status["bol"].partition("/")
+    status["bol"] = ref
+    status["proNumber"] = pro`,
+		Document: map[string]any{
+			"transactionSet": "214",
+			"shipmentStatus": map[string]any{
+				"bol":              "BOL-1/PRO-9",
+				"statusReasonCode": "X2",
+				"eventDate":        float64(1_700_000_000),
+			},
+		},
+	})
+
+	require.Empty(t, result.Diagnostics)
+	status, ok := result.Document["shipmentStatus"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "AG", status["statusReasonCode"])
+	assert.Equal(t, "BOL-1", status["bol"])
+	assert.Equal(t, "PRO-9", status["proNumber"])
+	assert.InDelta(t, float64(1_700_000_000), status["eventDate"], 0)
+	assert.NotZero(t, result.ExecutionSteps)
+}
+
+func TestTransform_ReturnsReplacementDocument(t *testing.T) {
+	t.Parallel()
+
+	result := Transform(t.Context(), TransformRequest{
+		Script: `def transform(document):
+    tender = document["loadTender"]
+    length = tender.get("equipmentLength", 0)
+    return {
+        "transactionSet": document["transactionSet"],
+        "loadTender": dict(tender, equipmentType = "V53" if length >= 53 else "V48"),
+    }`,
+		Document: map[string]any{
+			"transactionSet": "204",
+			"loadTender":     map[string]any{"equipmentLength": 53},
+		},
+	})
+
+	require.Empty(t, result.Diagnostics)
+	tender, ok := result.Document["loadTender"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "V53", tender["equipmentType"])
+	assert.Equal(t, int64(53), tender["equipmentLength"])
+}
+
+func TestTransform_UnsupportedResultReturnsDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	result := Transform(t.Context(), TransformRequest{
+		Script: `def transform(document):
+    return "214"`,
+		Document: map[string]any{"transactionSet": "214"},
+	})
+
+	require.Len(t, result.Diagnostics, 1)
+	assert.Equal(t, DiagnosticCodeInvalidTransformResult, result.Diagnostics[0].Code)
+	assert.Nil(t, result.Document)
+}
+
+func TestTransform_StepLimitStopsRunawayScript(t *testing.T) {
+	t.Parallel()
+
+	result := NewEvaluator(Options{MaxExecutionSteps: 1_000}).Transform(
+		t.Context(),
+		TransformRequest{
+			Script: `def transform(document):
+    while True:
+        document["n"] = 1`,
+		},
+	)
+
+	require.Len(t, result.Diagnostics, 1)
+	assert.Equal(t, diagnosticCodeStepLimit, result.Diagnostics[0].Code)
+}
+
+func TestValidateTransformScript_RequiresTransformFunction(t *testing.T) {
+	t.Parallel()
+
+	diagnostics := ValidateTransformScript(`def value(ctx):
+    return ""`)
+
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, DiagnosticCodeFunctionNotFound, diagnostics[0].Code)
+	assert.Empty(t, ValidateTransformScript(`def transform(document):
+    return None`))
+}
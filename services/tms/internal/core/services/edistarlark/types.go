@@ -13,6 +13,9 @@ const (
 
 	defaultFunctionName = "value"
 	defaultFilename     = "edi_element.star"
+
+	defaultTransformFunctionName = "transform"
+	defaultTransformFilename     = "edi_inbound_transform.star"
 )
 
 const (
@@ -21,6 +24,7 @@ const (
 	DiagnosticCodeLibrarySyntaxError       = "script_library_syntax_error"
 	DiagnosticCodeFunctionNotFound         = "script_function_not_found"
 	DiagnosticCodeFunctionNotCallable      = "script_function_not_callable"
+	DiagnosticCodeInvalidTransformResult   = "script_invalid_transform_result"
 )
 
 type Options struct {
@@ -56,6 +60,20 @@ type EvalResult struct {
 	ExecutionSteps uint64
 }
 
+// TransformRequest carries a partner's inbound transform script and the
+// canonical document, as JSON values, that it rewrites.
+type TransformRequest struct {
+	Script   string
+	Document map[string]any
+	Path     string
+}
+
+type TransformResult struct {
+	Document       map[string]any
+	Diagnostics    []Diagnostic
+	ExecutionSteps uint64
+}
+
 type Diagnostic struct {
 	Severity        DiagnosticSeverity `json:"severity"`
 	Code            string             `json:"code"`
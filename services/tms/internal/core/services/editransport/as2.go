@@ -24,6 +24,7 @@ const (
 	ConfigKeyEndpointURL                  = "endpointUrl"
 	ConfigKeyMDNMode                      = "mdnMode"
 	ConfigKeyMDNURL                       = "mdnUrl"
+	ConfigKeyMDNTimeoutMinutes            = "mdnTimeoutMinutes"
 	ConfigKeySigningAlgorithm             = "signingAlgorithm"
 	ConfigKeyEncryptionAlgorithm          = "encryptionAlgorithm"
 	ConfigKeyCompressionAlgorithm         = "compressionAlgorithm"
@@ -42,6 +43,9 @@ const (
 
 	AS2InboundRequirementAuto = "auto"
 
+	DefaultAsyncMDNTimeout = 4 * time.Hour
+	MaxAsyncMDNTimeout     = 72 * time.Hour
+
 	CompressionZlib = "zlib"
 
 	as2RequestTimeout = 60 * time.Second
@@ -169,6 +173,7 @@ func (t *AS2Transport) Deliver(
 		To:                    cfg.PartnerAS2ID,
 		Subject:               "Trenova EDI Document",
 		FileName:              req.FileName,
+		ContentType:           as2ContentType(req.Standard),
 		Payload:               []byte(req.Contents),
 		SigningCertificate:    cfg.LocalCertificate,
 		SigningKey:            cfg.PrivateKey,
@@ -297,6 +302,30 @@ func validateAS2DeliveryConfig(cfg *AS2Config) error {
 	}
 }
 
+// as2ContentType leaves X12 on the library default and only overrides the
+// MIME type for standards that need their own.
+func as2ContentType(standard edi.EDIStandard) string {
+	if standard == edi.EDIStandardEDIFACT {
+		return as2.ContentTypeEDIFACT
+	}
+	return ""
+}
+
+// AsyncMDNTimeout returns how long an async AS2 profile waits for its MDN
+// before the delivery is treated as overdue, or zero for sync profiles.
+func AsyncMDNTimeout(profile *edi.EDICommunicationProfile) time.Duration {
+	if profile == nil || profile.Method != edi.ConnectionMethodAS2 ||
+		!strings.EqualFold(maputils.StringValue(profile.Config, ConfigKeyMDNMode), MDNModeAsync) {
+		return 0
+	}
+	minutes, ok := maputils.IntValue(profile.Config, ConfigKeyMDNTimeoutMinutes)
+	if !ok || minutes <= 0 {
+		return DefaultAsyncMDNTimeout
+	}
+	minutes = min(minutes, int64(MaxAsyncMDNTimeout/time.Minute))
+	return time.Duration(minutes) * time.Minute
+}
+
 func asyncMDNURL(cfg *AS2Config) string {
 	if cfg.Async() {
 		return cfg.MDNURL
@@ -242,3 +242,57 @@ func TestAS2ConfigFromProfileValidation(t *testing.T) {
 	cfg.MDNURL = ""
 	require.Error(t, validateAS2DeliveryConfig(cfg))
 }
+
+func TestAsyncMDNTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		method  edi.ConnectionMethod
+		config  map[string]any
+		timeout time.Duration
+	}{
+		{
+			name:   "sync profile",
+			method: edi.ConnectionMethodAS2,
+			config: map[string]any{ConfigKeyMDNMode: MDNModeSync},
+		},
+		{
+			name:   "non-AS2 profile",
+			method: edi.ConnectionMethodSFTP,
+			config: map[string]any{ConfigKeyMDNMode: MDNModeAsync},
+		},
+		{
+			name:    "async default",
+			method:  edi.ConnectionMethodAS2,
+			config:  map[string]any{ConfigKeyMDNMode: MDNModeAsync},
+			timeout: DefaultAsyncMDNTimeout,
+		},
+		{
+			name:   "async configured",
+			method: edi.ConnectionMethodAS2,
+			config: map[string]any{
+				ConfigKeyMDNMode:           MDNModeAsync,
+				ConfigKeyMDNTimeoutMinutes: 90,
+			},
+			timeout: 90 * time.Minute,
+		},
+		{
+			name:   "async clamped",
+			method: edi.ConnectionMethodAS2,
+			config: map[string]any{
+				ConfigKeyMDNMode:           MDNModeAsync,
+				ConfigKeyMDNTimeoutMinutes: 100000,
+			},
+			timeout: MaxAsyncMDNTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := &edi.EDICommunicationProfile{Method: tt.method, Config: tt.config}
+			require.Equal(t, tt.timeout, AsyncMDNTimeout(profile))
+		})
+	}
+}
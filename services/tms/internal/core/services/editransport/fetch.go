@@ -7,6 +7,7 @@ import (
 	"io"
 	"path"
 
+	"github.com/emoss08/trenova/internal/core/domain/edi"
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/shared/maputils"
 	"github.com/pkg/sftp"
@@ -102,10 +103,7 @@ func archiveInboundOverSFTP(
 	defer sshClient.Close()
 	defer client.Close()
 
-	archiveDirectory := stringOrDefault(
-		maputils.StringValue(req.Profile.Config, configKeyArchiveDir),
-		path.Join(inboundDirectory, "processed"),
-	)
+	archiveDirectory := archiveDirectoryFor(req.Profile, inboundDirectory)
 	if err = client.MkdirAll(archiveDirectory); err != nil {
 		return fmt.Errorf("create archive directory: %w", err)
 	}
@@ -137,6 +135,15 @@ func inboundEndpointConfig(
 	return &cfg, inboundDirectory, nil
 }
 
+// archiveDirectoryFor returns where picked-up files are moved, defaulting to a
+// processed/ directory under the inbound directory.
+func archiveDirectoryFor(profile *edi.EDICommunicationProfile, inboundDirectory string) string {
+	return stringOrDefault(
+		maputils.StringValue(profile.Config, configKeyArchiveDir),
+		path.Join(inboundDirectory, "processed"),
+	)
+}
+
 func readRemoteFile(client *sftp.Client, remotePath string) (string, error) {
 	file, err := client.Open(remotePath)
 	if err != nil {
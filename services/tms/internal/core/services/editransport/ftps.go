@@ -0,0 +1,355 @@
+package editransport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/shared/maputils"
+	"github.com/jlaffaye/ftp"
+)
+
+const (
+	ConfigKeyTLSCertificate = "tlsCertificate"
+
+	defaultFTPSPort    = "21"
+	ftpsCommandTimeout = 30 * time.Second
+	ftpsDataTimeout    = 2 * time.Minute
+)
+
+type ftpsConfig struct {
+	host              string
+	port              string
+	username          string
+	password          string
+	tlsCertificate    string
+	outboundDirectory string
+}
+
+// FTPSTransport speaks explicit-TLS FTP (AUTH TLS, PROT P, passive data
+// channels) through jlaffaye/ftp. Implicit FTPS on port 990 and active mode
+// are not supported.
+type FTPSTransport struct{}
+
+func NewFTPSTransport() *FTPSTransport {
+	return &FTPSTransport{}
+}
+
+func (t *FTPSTransport) Method() edi.ConnectionMethod {
+	return edi.ConnectionMethodFTPS
+}
+
+func (t *FTPSTransport) Deliver(
+	ctx context.Context,
+	req *services.EDITransportRequest,
+) (*services.EDITransportResult, error) {
+	if req == nil || req.Profile == nil {
+		return nil, ErrEDICommunicationProfileRequired
+	}
+	if strings.TrimSpace(req.FileName) == "" {
+		return nil, errors.New("EDI delivery file name is required")
+	}
+	cfg := ftpsConfigFromProfile(req.Profile, req.Secrets)
+	if err := validateFTPSConfig(&cfg); err != nil {
+		return nil, err
+	}
+	directory := stringOrDefault(cfg.outboundDirectory, defaultOutboundDirectory)
+	remotePath := path.Join(directory, req.FileName)
+	client, err := dialFTPS(ctx, &cfg)
+	if err != nil {
+		return &services.EDITransportResult{RemotePath: remotePath}, err
+	}
+	defer client.Close()
+
+	if err = client.MkdirAll(directory); err != nil {
+		return &services.EDITransportResult{RemotePath: remotePath}, err
+	}
+	if err = client.Store(remotePath, req.Contents); err != nil {
+		return &services.EDITransportResult{RemotePath: remotePath}, err
+	}
+	return &services.EDITransportResult{RemotePath: remotePath}, nil
+}
+
+func (t *FTPSTransport) FetchInboundFiles(
+	ctx context.Context,
+	req *services.EDIInboundFetchRequest,
+) ([]*services.EDIInboundRemoteFile, error) {
+	cfg, inboundDirectory, err := ftpsInboundConfig(req)
+	if err != nil {
+		return nil, err
+	}
+	client, err := dialFTPS(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	entries, err := client.List(inboundDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("list inbound directory: %w", err)
+	}
+	files := make([]*services.EDIInboundRemoteFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.dir {
+			continue
+		}
+		remotePath := path.Join(inboundDirectory, entry.name)
+		contents, readErr := client.Retrieve(remotePath)
+		if readErr != nil {
+			return nil, fmt.Errorf("read inbound file %s: %w", remotePath, readErr)
+		}
+		files = append(files, &services.EDIInboundRemoteFile{
+			Path:     remotePath,
+			Name:     entry.name,
+			Contents: contents,
+			Size:     int64(len(contents)),
+		})
+	}
+	return files, nil
+}
+
+func (t *FTPSTransport) ArchiveInboundFile(
+	ctx context.Context,
+	req *services.EDIInboundFetchRequest,
+	remotePath string,
+) error {
+	cfg, inboundDirectory, err := ftpsInboundConfig(req)
+	if err != nil {
+		return err
+	}
+	client, err := dialFTPS(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	archiveDirectory := archiveDirectoryFor(req.Profile, inboundDirectory)
+	if err = client.MkdirAll(archiveDirectory); err != nil {
+		return fmt.Errorf("create archive directory: %w", err)
+	}
+	if err = client.Rename(remotePath, path.Join(archiveDirectory, path.Base(remotePath))); err != nil {
+		return fmt.Errorf("archive inbound file: %w", err)
+	}
+	return nil
+}
+
+func ftpsConfigFromProfile(
+	profile *edi.EDICommunicationProfile,
+	secrets map[string]string,
+) ftpsConfig {
+	return ftpsConfig{
+		host:              maputils.StringValue(profile.Config, configKeyHost),
+		port:              maputils.StringValue(profile.Config, configKeyPort),
+		username:          maputils.StringValue(profile.Config, configKeyUsername),
+		tlsCertificate:    maputils.StringValue(profile.Config, ConfigKeyTLSCertificate),
+		outboundDirectory: maputils.StringValue(profile.Config, configKeyOutboundDir),
+		password:          strings.TrimSpace(secrets[secretKeyPassword]),
+	}
+}
+
+func validateFTPSConfig(cfg *ftpsConfig) error {
+	switch {
+	case cfg.host == "":
+		return errors.New("FTPS host is required for EDI delivery")
+	case cfg.username == "":
+		return errors.New("FTPS username is required for EDI delivery")
+	case cfg.password == "":
+		return errors.New("FTPS password secret is required for EDI delivery")
+	}
+	if cfg.tlsCertificate != "" {
+		if _, err := pinnedCertificateDER(cfg.tlsCertificate); err != nil {
+			return err
+		}
+	}
+	if cfg.port == "" {
+		return nil
+	}
+	if _, err := strconv.Atoi(cfg.port); err != nil {
+		return fmt.Errorf("FTPS port must be numeric: %w", err)
+	}
+	return nil
+}
+
+func ftpsInboundConfig(req *services.EDIInboundFetchRequest) (*ftpsConfig, string, error) {
+	if req == nil || req.Profile == nil {
+		return nil, "", errors.New("EDI communication profile is required for inbound polling")
+	}
+	inboundDirectory := maputils.StringValue(req.Profile.Config, configKeyInboundDir)
+	if inboundDirectory == "" {
+		return nil, "", errors.New("inbound directory is required for EDI inbound polling")
+	}
+	cfg := ftpsConfigFromProfile(req.Profile, req.Secrets)
+	if err := validateFTPSConfig(&cfg); err != nil {
+		return nil, "", err
+	}
+	return &cfg, inboundDirectory, nil
+}
+
+// ValidateFTPSCertificate reports whether value is usable as an FTPS
+// tlsCertificate pin.
+func ValidateFTPSCertificate(value string) error {
+	_, err := pinnedCertificateDER(value)
+	return err
+}
+
+func pinnedCertificateDER(certificatePEM string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certificatePEM)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("FTPS TLS certificate must be a PEM-encoded certificate")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("parse FTPS TLS certificate: %w", err)
+	}
+	return block.Bytes, nil
+}
+
+// ftpsTLSConfig verifies the server against the system roots unless the profile
+// pins the server certificate, in which case only that exact certificate is
+// accepted, the same way knownHostKey pins an SFTP host.
+func ftpsTLSConfig(cfg *ftpsConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.host,
+		// Servers commonly require data connections to resume the control
+		// connection's TLS session.
+		ClientSessionCache: tls.NewLRUClientSessionCache(4),
+	}
+	if cfg.tlsCertificate == "" {
+		return tlsConfig, nil
+	}
+	pinned, err := pinnedCertificateDER(cfg.tlsCertificate)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.InsecureSkipVerify = true //nolint:gosec // Replaced by the certificate pin below.
+	tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], pinned) {
+			return errors.New("FTPS server certificate does not match configured TLS certificate")
+		}
+		return nil
+	}
+	return tlsConfig, nil
+}
+
+// ftpsClient wraps the FTP connection with the few operations the transport
+// needs, so callers see one error vocabulary for dial, login and transfer.
+type ftpsClient struct {
+	conn *ftp.ServerConn
+}
+
+type ftpsEntry struct {
+	name string
+	dir  bool
+}
+
+// dialFTPS connects with explicit TLS (AUTH TLS, then PBSZ 0 and PROT P after
+// login) and passive data channels. The library falls back from EPSV to PASV
+// and replaces an unroutable PASV address with the control connection's host.
+func dialFTPS(ctx context.Context, cfg *ftpsConfig) (*ftpsClient, error) {
+	tlsConfig, err := ftpsTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	address := net.JoinHostPort(cfg.host, stringOrDefault(cfg.port, defaultFTPSPort))
+	conn, err := ftp.Dial(
+		address,
+		ftp.DialWithContext(ctx),
+		ftp.DialWithTimeout(ftpsCommandTimeout),
+		ftp.DialWithShutTimeout(ftpsDataTimeout),
+		ftp.DialWithExplicitTLS(tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect FTPS server: %w", err)
+	}
+	if err = conn.Login(cfg.username, cfg.password); err != nil {
+		_ = conn.Quit()
+		return nil, fmt.Errorf("authenticate FTPS user: %w", err)
+	}
+	return &ftpsClient{conn: conn}, nil
+}
+
+func (c *ftpsClient) Close() {
+	_ = c.conn.Quit()
+}
+
+func (c *ftpsClient) ChangeDir(dir string) error {
+	return c.conn.ChangeDir(dir)
+}
+
+// MkdirAll creates each missing segment of dir. MKD failures are ignored
+// because servers report an existing directory the same way as a refusal; a
+// directory that really could not be created fails the transfer that follows.
+func (c *ftpsClient) MkdirAll(dir string) error {
+	cleaned := path.Clean("/" + dir)
+	current := ""
+	for segment := range strings.SplitSeq(strings.Trim(cleaned, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		current += "/" + segment
+		if err := c.conn.MakeDir(current); err != nil {
+			var protocolErr *textproto.Error
+			if !errors.As(err, &protocolErr) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *ftpsClient) Rename(from, to string) error {
+	return c.conn.Rename(from, to)
+}
+
+func (c *ftpsClient) Store(remotePath, contents string) error {
+	return c.conn.Stor(remotePath, strings.NewReader(contents))
+}
+
+func (c *ftpsClient) Retrieve(remotePath string) (string, error) {
+	response, err := c.conn.Retr(remotePath)
+	if err != nil {
+		return "", err
+	}
+	contents, readErr := io.ReadAll(response)
+	if closeErr := response.Close(); readErr == nil {
+		readErr = closeErr
+	}
+	return string(contents), readErr
+}
+
+// List returns the regular files and directories in dir. Links and the
+// "." and ".." entries some servers include are dropped.
+func (c *ftpsClient) List(dir string) ([]ftpsEntry, error) {
+	listing, err := c.conn.List(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]ftpsEntry, 0, len(listing))
+	for _, entry := range listing {
+		name := path.Base(entry.Name)
+		if name == "." || name == ".." {
+			continue
+		}
+		switch entry.Type {
+		case ftp.EntryTypeFile:
+			entries = append(entries, ftpsEntry{name: name})
+		case ftp.EntryTypeFolder:
+			entries = append(entries, ftpsEntry{name: name, dir: true})
+		case ftp.EntryTypeLink:
+		}
+	}
+	return entries, nil
+}
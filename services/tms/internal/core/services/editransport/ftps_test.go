@@ -0,0 +1,122 @@
+package editransport
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFTPSConfigFromProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := &edi.EDICommunicationProfile{
+		Config: map[string]any{
+			"host":              " ftps.example.com ",
+			"port":              990,
+			"username":          "trenova",
+			"outboundDirectory": "/mailbox/out",
+		},
+	}
+	cfg := ftpsConfigFromProfile(profile, map[string]string{"password": "secret "})
+
+	require.Equal(t, "ftps.example.com", cfg.host)
+	require.Equal(t, "990", cfg.port)
+	require.Equal(t, "trenova", cfg.username)
+	require.Equal(t, "/mailbox/out", cfg.outboundDirectory)
+	require.Equal(t, "secret", cfg.password)
+	require.Empty(t, cfg.tlsCertificate)
+}
+
+func TestValidateFTPSConfig(t *testing.T) {
+	t.Parallel()
+
+	identity := newAS2TestIdentity(t, "ftps.example.com")
+	valid := ftpsConfig{
+		host:     "ftps.example.com",
+		username: "trenova",
+		password: "secret",
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(cfg *ftpsConfig)
+		wantErr string
+	}{
+		{name: "valid system roots", mutate: func(*ftpsConfig) {}},
+		{
+			name:   "valid pinned certificate",
+			mutate: func(cfg *ftpsConfig) { cfg.tlsCertificate = identity.certificatePEM },
+		},
+		{
+			name:    "missing host",
+			mutate:  func(cfg *ftpsConfig) { cfg.host = "" },
+			wantErr: "FTPS host is required",
+		},
+		{
+			name:    "missing password",
+			mutate:  func(cfg *ftpsConfig) { cfg.password = "" },
+			wantErr: "FTPS password secret is required",
+		},
+		{
+			name:    "malformed certificate",
+			mutate:  func(cfg *ftpsConfig) { cfg.tlsCertificate = "not a certificate" },
+			wantErr: "must be a PEM-encoded certificate",
+		},
+		{
+			name:    "non-numeric port",
+			mutate:  func(cfg *ftpsConfig) { cfg.port = "ftp" },
+			wantErr: "FTPS port must be numeric",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := valid
+			tt.mutate(&cfg)
+			err := validateFTPSConfig(&cfg)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestFTPSTLSConfigPinsCertificate(t *testing.T) {
+	t.Parallel()
+
+	pinned := newAS2TestIdentity(t, "ftps.example.com")
+	other := newAS2TestIdentity(t, "other.example.com")
+
+	tlsConfig, err := ftpsTLSConfig(&ftpsConfig{
+		host:           "ftps.example.com",
+		tlsCertificate: pinned.certificatePEM,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig.VerifyPeerCertificate)
+	require.NoError(t, tlsConfig.VerifyPeerCertificate([][]byte{pinned.certificate.Raw}, nil))
+	require.Error(t, tlsConfig.VerifyPeerCertificate([][]byte{other.certificate.Raw}, nil))
+
+	unpinned, err := ftpsTLSConfig(&ftpsConfig{host: "ftps.example.com"})
+	require.NoError(t, err)
+	require.False(t, unpinned.InsecureSkipVerify)
+	require.Nil(t, unpinned.VerifyPeerCertificate)
+}
+
+func TestFTPSInboundConfigRequiresDirectory(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := ftpsInboundConfig(&services.EDIInboundFetchRequest{
+		Profile: &edi.EDICommunicationProfile{
+			Method: edi.ConnectionMethodFTPS,
+			Config: map[string]any{"host": "ftps.example.com", "username": "trenova"},
+		},
+		Secrets: map[string]string{"password": "secret"},
+	})
+	require.ErrorContains(t, err, "inbound directory is required")
+}
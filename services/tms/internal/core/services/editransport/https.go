@@ -0,0 +1,448 @@
+package editransport
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/shared/maputils"
+	"go.temporal.io/sdk/temporal"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	ConfigKeyHTTPSAuthScheme     = "authScheme"
+	ConfigKeyHTTPSTokenURL       = "tokenUrl"
+	ConfigKeyHTTPSClientID       = "clientId"
+	ConfigKeyHTTPSScopes         = "scopes"
+	ConfigKeyHTTPSPayloadMapping = "payloadMapping"
+
+	SecretKeyHTTPSClientSecret = "clientSecret"
+	SecretKeyHTTPSHMACSecret   = "hmacSecret"
+
+	HTTPSAuthSchemeNone   = "none"
+	HTTPSAuthSchemeOAuth2 = "oauth2"
+	HTTPSAuthSchemeHMAC   = "hmac"
+
+	HTTPSHeaderTimestamp      = "X-Trenova-Timestamp"
+	HTTPSHeaderSignature      = "X-Trenova-Signature"
+	HTTPSHeaderIdempotencyKey = "Idempotency-Key"
+
+	// HTTPSSignatureTolerance bounds the clock skew accepted on signed
+	// requests, which also limits how long a captured request can be replayed.
+	HTTPSSignatureTolerance = 5 * time.Minute
+
+	httpsRequestTimeout  = 60 * time.Second
+	httpsMaxResponse     = 1 << 20
+	httpsSignaturePrefix = "sha256="
+)
+
+var (
+	ErrHTTPSSignatureMissing = errors.New("HTTPS request signature headers are required")
+	ErrHTTPSSignatureExpired = errors.New("HTTPS request timestamp is outside the allowed window")
+	ErrHTTPSSignatureInvalid = errors.New("HTTPS request signature does not match")
+)
+
+// HTTPSDocument is the JSON body exchanged with partners that take documents
+// over HTTPS instead of X12. The payload is the canonical document payload the
+// X12 and EDIFACT renderers work from, so both directions share one shape.
+type HTTPSDocument struct {
+	DocumentID     string              `json:"documentId"`
+	TransactionSet edi.TransactionSet  `json:"transactionSet"`
+	SentAt         int64               `json:"sentAt,omitempty"`
+	Payload        edi.DocumentPayload `json:"payload"`
+}
+
+type HTTPSConfig struct {
+	EndpointURL  string
+	AuthScheme   string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	HMACSecret   string
+	// PayloadMapping maps dotted target paths in the outbound body to dotted
+	// source paths in the HTTPSDocument. When empty the document is sent as is.
+	PayloadMapping map[string]string
+}
+
+func HTTPSConfigFromProfile(
+	profile *edi.EDICommunicationProfile,
+	secrets map[string]string,
+) (*HTTPSConfig, error) {
+	cfg := &HTTPSConfig{
+		EndpointURL: maputils.StringValue(profile.Config, ConfigKeyEndpointURL),
+		AuthScheme: strings.ToLower(
+			maputils.StringValue(profile.Config, ConfigKeyHTTPSAuthScheme),
+		),
+		TokenURL:     maputils.StringValue(profile.Config, ConfigKeyHTTPSTokenURL),
+		ClientID:     maputils.StringValue(profile.Config, ConfigKeyHTTPSClientID),
+		ClientSecret: strings.TrimSpace(secrets[SecretKeyHTTPSClientSecret]),
+		HMACSecret:   strings.TrimSpace(secrets[SecretKeyHTTPSHMACSecret]),
+	}
+	if cfg.AuthScheme == "" {
+		cfg.AuthScheme = HTTPSAuthSchemeNone
+	}
+	for scope := range strings.FieldsSeq(
+		strings.ReplaceAll(maputils.StringValue(profile.Config, ConfigKeyHTTPSScopes), ",", " "),
+	) {
+		cfg.Scopes = append(cfg.Scopes, scope)
+	}
+	mapping, err := HTTPSPayloadMappingFromConfig(profile.Config)
+	if err != nil {
+		return nil, err
+	}
+	cfg.PayloadMapping = mapping
+	return cfg, nil
+}
+
+// HTTPSPayloadMappingFromConfig reads the payloadMapping object, which may be
+// stored either as a JSON object or as its string encoding.
+func HTTPSPayloadMappingFromConfig(config map[string]any) (map[string]string, error) {
+	raw, ok := config[ConfigKeyHTTPSPayloadMapping]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	var entries map[string]any
+	switch typed := raw.(type) {
+	case map[string]any:
+		entries = typed
+	case string:
+		if strings.TrimSpace(typed) == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(typed), &entries); err != nil {
+			return nil, fmt.Errorf("payload mapping must be a JSON object: %w", err)
+		}
+	default:
+		return nil, errors.New("payload mapping must be a JSON object")
+	}
+	mapping := make(map[string]string, len(entries))
+	for target, source := range entries {
+		sourcePath, isString := source.(string)
+		if strings.TrimSpace(target) == "" || !isString || strings.TrimSpace(sourcePath) == "" {
+			return nil, fmt.Errorf("payload mapping entry %q must map to a source path", target)
+		}
+		mapping[strings.TrimSpace(target)] = strings.TrimSpace(sourcePath)
+	}
+	return mapping, nil
+}
+
+// MapHTTPSPayload builds the partner's JSON body from the document. Source
+// paths that resolve to nothing are omitted rather than sent as null.
+func MapHTTPSPayload(document *HTTPSDocument, mapping map[string]string) (any, error) {
+	if len(mapping) == 0 {
+		return document, nil
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var source map[string]any
+	if err = json.Unmarshal(encoded, &source); err != nil {
+		return nil, err
+	}
+	target := make(map[string]any, len(mapping))
+	for targetPath, sourcePath := range mapping {
+		value := maputils.Path(source, sourcePath)
+		if value == nil {
+			continue
+		}
+		if err = setJSONPath(target, targetPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return target, nil
+}
+
+func setJSONPath(target map[string]any, path string, value any) error {
+	parts := strings.Split(path, ".")
+	current := target
+	for index, part := range parts {
+		if part == "" {
+			return fmt.Errorf("payload mapping target %q is not a valid path", path)
+		}
+		if index == len(parts)-1 {
+			current[part] = value
+			return nil
+		}
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			if current[part] != nil {
+				return fmt.Errorf("payload mapping target %q overlaps another target", path)
+			}
+			next = map[string]any{}
+			current[part] = next
+		}
+		current = next
+	}
+	return nil
+}
+
+// SignHTTPSBody returns the signature header value for a body sent at the
+// given Unix timestamp: hex HMAC-SHA256 over "<timestamp>.<body>".
+func SignHTTPSBody(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = io.WriteString(mac, timestamp)
+	_, _ = io.WriteString(mac, ".")
+	_, _ = mac.Write(body)
+	return httpsSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func VerifyHTTPSSignature(secret, timestamp, signature string, body []byte, now time.Time) error {
+	timestamp = strings.TrimSpace(timestamp)
+	signature = strings.TrimSpace(signature)
+	if timestamp == "" || signature == "" {
+		return ErrHTTPSSignatureMissing
+	}
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrHTTPSSignatureExpired
+	}
+	age := now.Sub(time.Unix(sentAt, 0))
+	if age < -HTTPSSignatureTolerance || age > HTTPSSignatureTolerance {
+		return ErrHTTPSSignatureExpired
+	}
+	expected := SignHTTPSBody(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrHTTPSSignatureInvalid
+	}
+	return nil
+}
+
+type HTTPSTransport struct {
+	client *http.Client
+	now    func() time.Time
+
+	tokenMu sync.Mutex
+	tokens  map[string]oauth2.TokenSource
+}
+
+func NewHTTPSTransport() *HTTPSTransport {
+	return &HTTPSTransport{
+		client: &http.Client{Timeout: httpsRequestTimeout},
+		now:    time.Now,
+		tokens: make(map[string]oauth2.TokenSource),
+	}
+}
+
+func (t *HTTPSTransport) Method() edi.ConnectionMethod {
+	return edi.ConnectionMethodHTTPS
+}
+
+func (t *HTTPSTransport) Deliver(
+	ctx context.Context,
+	req *services.EDITransportRequest,
+) (*services.EDITransportResult, error) {
+	if req == nil || req.Profile == nil {
+		return nil, ErrEDICommunicationProfileRequired
+	}
+	if req.Payload == nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			"HTTPS delivery requires the canonical document payload",
+			"HTTPSPayloadMissing",
+			nil,
+		)
+	}
+	cfg, err := HTTPSConfigFromProfile(req.Profile, req.Secrets)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			err.Error(),
+			"HTTPSConfigInvalid",
+			err,
+		)
+	}
+	if err = validateHTTPSDeliveryConfig(cfg); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			err.Error(),
+			"HTTPSConfigInvalid",
+			err,
+		)
+	}
+
+	document := &HTTPSDocument{
+		DocumentID:     req.DocumentID,
+		TransactionSet: req.Payload.TransactionSet,
+		SentAt:         t.now().Unix(),
+		Payload:        *req.Payload,
+	}
+	body, err := MapHTTPSPayload(document, cfg.PayloadMapping)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("HTTPS document could not be encoded: %w", err)
+	}
+
+	response, err := t.post(ctx, cfg, req.DocumentID, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode == http.StatusUnauthorized && cfg.AuthScheme == HTTPSAuthSchemeOAuth2 {
+		// The cached token expired or was revoked before its advertised
+		// expiry. Only a rejection of a freshly issued token is final.
+		_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, httpsMaxResponse))
+		_ = response.Body.Close()
+		t.forgetToken(cfg)
+		response, err = t.post(ctx, cfg, req.DocumentID, encoded)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer response.Body.Close()
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, httpsMaxResponse))
+	if response.StatusCode == http.StatusUnauthorized && cfg.AuthScheme == HTTPSAuthSchemeOAuth2 {
+		t.forgetToken(cfg)
+	}
+	if err = httpsStatusError(response.StatusCode, responseBody); err != nil {
+		return nil, err
+	}
+	return &services.EDITransportResult{RemotePath: cfg.EndpointURL}, nil
+}
+
+func (t *HTTPSTransport) post(
+	ctx context.Context,
+	cfg *HTTPSConfig,
+	documentID string,
+	body []byte,
+) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		cfg.EndpointURL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("HTTPS request could not be created: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	if documentID != "" {
+		request.Header.Set(HTTPSHeaderIdempotencyKey, documentID)
+	}
+	if err = t.authorize(ctx, request, cfg, body); err != nil {
+		return nil, err
+	}
+	response, err := t.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("HTTPS delivery to %s failed: %w", cfg.EndpointURL, err)
+	}
+	return response, nil
+}
+
+func (t *HTTPSTransport) authorize(
+	ctx context.Context,
+	request *http.Request,
+	cfg *HTTPSConfig,
+	body []byte,
+) error {
+	switch cfg.AuthScheme {
+	case HTTPSAuthSchemeHMAC:
+		timestamp := strconv.FormatInt(t.now().Unix(), 10)
+		request.Header.Set(HTTPSHeaderTimestamp, timestamp)
+		request.Header.Set(HTTPSHeaderSignature, SignHTTPSBody(cfg.HMACSecret, timestamp, body))
+	case HTTPSAuthSchemeOAuth2:
+		token, err := t.tokenSource(ctx, cfg).Token()
+		if err != nil {
+			t.forgetToken(cfg)
+			return fmt.Errorf("OAuth2 token request to %s failed: %w", cfg.TokenURL, err)
+		}
+		token.SetAuthHeader(request)
+	}
+	return nil
+}
+
+// tokenSource caches one client-credentials source per credential set so
+// tokens are reused across deliveries until they expire.
+func (t *HTTPSTransport) tokenSource(ctx context.Context, cfg *HTTPSConfig) oauth2.TokenSource {
+	key := httpsTokenKey(cfg)
+	t.tokenMu.Lock()
+	defer t.tokenMu.Unlock()
+	if source, ok := t.tokens[key]; ok {
+		return source
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		TokenURL:     cfg.TokenURL,
+		Scopes:       cfg.Scopes,
+	}
+	// The source outlives this delivery, so it must not hold the activity's
+	// cancellable context.
+	tokenCtx := context.WithValue(context.WithoutCancel(ctx), oauth2.HTTPClient, t.client)
+	source := credentials.TokenSource(tokenCtx)
+	t.tokens[key] = source
+	return source
+}
+
+func (t *HTTPSTransport) forgetToken(cfg *HTTPSConfig) {
+	t.tokenMu.Lock()
+	defer t.tokenMu.Unlock()
+	delete(t.tokens, httpsTokenKey(cfg))
+}
+
+func httpsTokenKey(cfg *HTTPSConfig) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		cfg.TokenURL,
+		cfg.ClientID,
+		cfg.ClientSecret,
+		strings.Join(cfg.Scopes, " "),
+	}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// httpsStatusError classifies a partner response. Timeouts, throttling, and
+// server errors are retried under the profile's retry policy; any other
+// rejection means the request itself is wrong and retrying cannot help.
+func httpsStatusError(statusCode int, body []byte) error {
+	if statusCode >= 200 && statusCode <= 299 {
+		return nil
+	}
+	message := fmt.Sprintf(
+		"HTTPS partner rejected the document with status %d: %s",
+		statusCode,
+		truncateForError(body),
+	)
+	if statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= 500 {
+		return errors.New(message)
+	}
+	return temporal.NewNonRetryableApplicationError(message, "HTTPSRequestRejected", nil)
+}
+
+func validateHTTPSDeliveryConfig(cfg *HTTPSConfig) error {
+	switch {
+	case cfg.EndpointURL == "":
+		return errors.New("endpoint URL is required for HTTPS delivery")
+	case cfg.AuthScheme == HTTPSAuthSchemeOAuth2 && cfg.TokenURL == "":
+		return errors.New("OAuth2 token URL is required for HTTPS delivery")
+	case cfg.AuthScheme == HTTPSAuthSchemeOAuth2 && cfg.ClientID == "":
+		return errors.New("OAuth2 client ID is required for HTTPS delivery")
+	case cfg.AuthScheme == HTTPSAuthSchemeOAuth2 && cfg.ClientSecret == "":
+		return errors.New("OAuth2 client secret is required for HTTPS delivery")
+	case cfg.AuthScheme == HTTPSAuthSchemeHMAC && cfg.HMACSecret == "":
+		return errors.New("HMAC secret is required for HTTPS delivery")
+	case cfg.AuthScheme != HTTPSAuthSchemeNone &&
+		cfg.AuthScheme != HTTPSAuthSchemeOAuth2 &&
+		cfg.AuthScheme != HTTPSAuthSchemeHMAC:
+		return fmt.Errorf("HTTPS auth scheme %q is not supported", cfg.AuthScheme)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,257 @@
+package editransport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+)
+
+func httpsTestRequest(endpoint string, config map[string]any) *services.EDITransportRequest {
+	config[ConfigKeyEndpointURL] = endpoint
+	return &services.EDITransportRequest{
+		Profile: &edi.EDICommunicationProfile{
+			Method: edi.ConnectionMethodHTTPS,
+			Config: config,
+		},
+		Secrets: map[string]string{
+			SecretKeyHTTPSHMACSecret:   "hmac-secret",
+			SecretKeyHTTPSClientSecret: "client-secret",
+		},
+		DocumentID: "edim_01",
+		Payload: &edi.DocumentPayload{
+			TransactionSet: edi.TransactionSet214,
+			ShipmentStatus: &edi.ShipmentStatusPayload{
+				BOL:        "BOL-1",
+				StatusCode: "X1",
+			},
+		},
+	}
+}
+
+func TestHTTPSTransportDeliverSignsDocument(t *testing.T) {
+	t.Parallel()
+
+	var received HTTPSDocument
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "edim_01", r.Header.Get(HTTPSHeaderIdempotencyKey))
+		assert.NoError(t, VerifyHTTPSSignature(
+			"hmac-secret",
+			r.Header.Get(HTTPSHeaderTimestamp),
+			r.Header.Get(HTTPSHeaderSignature),
+			body,
+			time.Now(),
+		))
+		assert.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(server.Close)
+
+	result, err := NewHTTPSTransport().Deliver(t.Context(), httpsTestRequest(
+		server.URL,
+		map[string]any{ConfigKeyHTTPSAuthScheme: HTTPSAuthSchemeHMAC},
+	))
+
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, result.RemotePath)
+	assert.False(t, result.Pending)
+	assert.Equal(t, "edim_01", received.DocumentID)
+	assert.Equal(t, edi.TransactionSet214, received.TransactionSet)
+	require.NotNil(t, received.Payload.ShipmentStatus)
+	assert.Equal(t, "X1", received.Payload.ShipmentStatus.StatusCode)
+}
+
+func TestHTTPSTransportDeliverAppliesPayloadMapping(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewHTTPSTransport().Deliver(t.Context(), httpsTestRequest(
+		server.URL,
+		map[string]any{
+			ConfigKeyHTTPSPayloadMapping: `{
+				"reference": "documentId",
+				"shipment.bol": "payload.shipmentStatus.bol",
+				"shipment.status": "payload.shipmentStatus.statusCode",
+				"shipment.city": "payload.shipmentStatus.city"
+			}`,
+		},
+	))
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"reference": "edim_01",
+		"shipment": map[string]any{
+			"bol":    "BOL-1",
+			"status": "X1",
+		},
+	}, received)
+}
+
+func TestHTTPSTransportDeliverUsesOAuth2ClientCredentials(t *testing.T) {
+	t.Parallel()
+
+	var tokenRequests atomic.Int32
+	tokenServer := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenRequests.Add(1)
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "tenders.write", r.PostForm.Get("scope"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(
+				w,
+				`{"access_token":"token-1","token_type":"Bearer","expires_in":3600}`,
+			)
+		}),
+	)
+	t.Cleanup(tokenServer.Close)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := NewHTTPSTransport()
+	for range 2 {
+		_, err := transport.Deliver(t.Context(), httpsTestRequest(
+			server.URL,
+			map[string]any{
+				ConfigKeyHTTPSAuthScheme: HTTPSAuthSchemeOAuth2,
+				ConfigKeyHTTPSTokenURL:   tokenServer.URL,
+				ConfigKeyHTTPSClientID:   "trenova",
+				ConfigKeyHTTPSScopes:     "tenders.write",
+			},
+		))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), tokenRequests.Load())
+}
+
+func TestHTTPSTransportDeliverRetriesOAuth2RejectionWithAFreshToken(t *testing.T) {
+	t.Parallel()
+
+	var tokenRequests atomic.Int32
+	tokenServer := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			issued := tokenRequests.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(
+				w,
+				`{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`,
+				issued,
+			)
+		}),
+	)
+	t.Cleanup(tokenServer.Close)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewHTTPSTransport().Deliver(t.Context(), httpsTestRequest(
+		server.URL,
+		map[string]any{
+			ConfigKeyHTTPSAuthScheme: HTTPSAuthSchemeOAuth2,
+			ConfigKeyHTTPSTokenURL:   tokenServer.URL,
+			ConfigKeyHTTPSClientID:   "trenova",
+		},
+	))
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), tokenRequests.Load())
+}
+
+func TestHTTPSTransportDeliverClassifiesRejections(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		status    int
+		retryable bool
+	}{
+		{name: "bad request", status: http.StatusBadRequest},
+		{name: "unprocessable", status: http.StatusUnprocessableEntity},
+		{name: "throttled", status: http.StatusTooManyRequests, retryable: true},
+		{name: "server error", status: http.StatusBadGateway, retryable: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(tt.status)
+				}),
+			)
+			t.Cleanup(server.Close)
+
+			_, err := NewHTTPSTransport().Deliver(
+				t.Context(),
+				httpsTestRequest(server.URL, map[string]any{}),
+			)
+
+			require.Error(t, err)
+			var applicationErr *temporal.ApplicationError
+			if tt.retryable {
+				assert.NotErrorAs(t, err, &applicationErr)
+				return
+			}
+			require.ErrorAs(t, err, &applicationErr)
+			assert.True(t, applicationErr.NonRetryable())
+		})
+	}
+}
+
+func TestVerifyHTTPSSignature(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_800_000_000, 0)
+	body := []byte(`{"documentId":"DOC-1"}`)
+	timestamp := "1800000000"
+	signature := SignHTTPSBody("secret", timestamp, body)
+
+	require.NoError(t, VerifyHTTPSSignature("secret", timestamp, signature, body, now))
+	require.ErrorIs(
+		t,
+		VerifyHTTPSSignature("secret", timestamp, signature, []byte(`{}`), now),
+		ErrHTTPSSignatureInvalid,
+	)
+	require.ErrorIs(
+		t,
+		VerifyHTTPSSignature("other", timestamp, signature, body, now),
+		ErrHTTPSSignatureInvalid,
+	)
+	require.ErrorIs(
+		t,
+		VerifyHTTPSSignature("secret", timestamp, signature, body, now.Add(10*time.Minute)),
+		ErrHTTPSSignatureExpired,
+	)
+	require.ErrorIs(
+		t,
+		VerifyHTTPSSignature("secret", "", signature, body, now),
+		ErrHTTPSSignatureMissing,
+	)
+}
@@ -22,6 +22,21 @@ var Module = fx.Module("edi-transport",
 			fx.As(new(services.EDITransport)),
 			fx.ResultTags(`group:"edi_transports"`),
 		),
+		fx.Annotate(
+			NewHTTPSTransport,
+			fx.As(new(services.EDITransport)),
+			fx.ResultTags(`group:"edi_transports"`),
+		),
+		fx.Annotate(
+			NewFTPSTransport,
+			fx.As(new(services.EDITransport)),
+			fx.ResultTags(`group:"edi_transports"`),
+		),
+		fx.Annotate(
+			NewS3Transport,
+			fx.As(new(services.EDITransport)),
+			fx.ResultTags(`group:"edi_transports"`),
+		),
 		fx.Annotate(
 			NewDispatcher,
 			fx.As(new(services.EDITransportDispatcher)),
@@ -0,0 +1,289 @@
+package editransport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/shared/maputils"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	ConfigKeyS3Endpoint        = "endpoint"
+	ConfigKeyS3Bucket          = "bucket"
+	ConfigKeyS3Region          = "region"
+	ConfigKeyS3AccessKeyID     = "accessKeyId"
+	SecretKeyS3SecretAccessKey = "secretAccessKey"
+
+	defaultS3Region         = "us-east-1"
+	defaultS3OutboundPrefix = "outbound/"
+	s3ContentTypeX12        = "application/edi-x12"
+	s3ContentTypeEDIFACT    = "application/edifact"
+)
+
+type s3Config struct {
+	endpoint        string
+	secure          bool
+	bucket          string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	outboundPrefix  string
+}
+
+// S3Transport treats a bucket on any S3-compatible store as the partner's
+// mailbox. The inboundDirectory, outboundDirectory and archiveDirectory keys
+// shared with SFTP and FTPS hold key prefixes here, so polling selects S3
+// profiles the same way.
+type S3Transport struct{}
+
+func NewS3Transport() *S3Transport {
+	return &S3Transport{}
+}
+
+func (t *S3Transport) Method() edi.ConnectionMethod {
+	return edi.ConnectionMethodS3
+}
+
+func (t *S3Transport) Deliver(
+	ctx context.Context,
+	req *services.EDITransportRequest,
+) (*services.EDITransportResult, error) {
+	if req == nil || req.Profile == nil {
+		return nil, ErrEDICommunicationProfileRequired
+	}
+	if strings.TrimSpace(req.FileName) == "" {
+		return nil, errors.New("EDI delivery file name is required")
+	}
+	cfg, err := s3ConfigFromProfile(req.Profile, req.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	key := cfg.outboundPrefix + req.FileName
+	remotePath := s3RemotePath(cfg.bucket, key)
+	client, err := newS3Client(cfg)
+	if err != nil {
+		return nil, err
+	}
+	contentType := s3ContentTypeX12
+	if req.Standard == edi.EDIStandardEDIFACT {
+		contentType = s3ContentTypeEDIFACT
+	}
+	if _, err = client.PutObject(
+		ctx,
+		cfg.bucket,
+		key,
+		strings.NewReader(req.Contents),
+		int64(len(req.Contents)),
+		minio.PutObjectOptions{ContentType: contentType},
+	); err != nil {
+		return &services.EDITransportResult{RemotePath: remotePath}, fmt.Errorf(
+			"upload object: %w",
+			err,
+		)
+	}
+	return &services.EDITransportResult{RemotePath: remotePath}, nil
+}
+
+func (t *S3Transport) FetchInboundFiles(
+	ctx context.Context,
+	req *services.EDIInboundFetchRequest,
+) ([]*services.EDIInboundRemoteFile, error) {
+	cfg, inboundPrefix, err := s3InboundConfig(req)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newS3Client(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*services.EDIInboundRemoteFile, 0)
+	for object := range client.ListObjects(ctx, cfg.bucket, minio.ListObjectsOptions{
+		Prefix: inboundPrefix,
+	}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("list inbound prefix: %w", object.Err)
+		}
+		// Non-recursive listings report nested prefixes, such as the default
+		// archive, as keys ending in a slash.
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		contents, readErr := readS3Object(ctx, client, cfg.bucket, object.Key)
+		if readErr != nil {
+			return nil, fmt.Errorf("read inbound object %s: %w", object.Key, readErr)
+		}
+		files = append(files, &services.EDIInboundRemoteFile{
+			Path:     object.Key,
+			Name:     path.Base(object.Key),
+			Contents: contents,
+			Size:     object.Size,
+		})
+	}
+	return files, nil
+}
+
+// ArchiveInboundFile moves the object under the archive prefix. S3 has no
+// rename, so the object is copied and the original deleted.
+func (t *S3Transport) ArchiveInboundFile(
+	ctx context.Context,
+	req *services.EDIInboundFetchRequest,
+	remotePath string,
+) error {
+	cfg, inboundPrefix, err := s3InboundConfig(req)
+	if err != nil {
+		return err
+	}
+	client, err := newS3Client(cfg)
+	if err != nil {
+		return err
+	}
+	archivePrefix := s3Prefix(
+		maputils.StringValue(req.Profile.Config, configKeyArchiveDir),
+		inboundPrefix+"processed/",
+	)
+	if _, err = client.CopyObject(
+		ctx,
+		minio.CopyDestOptions{Bucket: cfg.bucket, Object: archivePrefix + path.Base(remotePath)},
+		minio.CopySrcOptions{Bucket: cfg.bucket, Object: remotePath},
+	); err != nil {
+		return fmt.Errorf("archive inbound object: %w", err)
+	}
+	if err = client.RemoveObject(
+		ctx,
+		cfg.bucket,
+		remotePath,
+		minio.RemoveObjectOptions{},
+	); err != nil {
+		return fmt.Errorf("remove archived inbound object: %w", err)
+	}
+	return nil
+}
+
+func s3ConfigFromProfile(
+	profile *edi.EDICommunicationProfile,
+	secrets map[string]string,
+) (*s3Config, error) {
+	endpoint, secure, err := parseS3Endpoint(
+		maputils.StringValue(profile.Config, ConfigKeyS3Endpoint),
+	)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &s3Config{
+		endpoint: endpoint,
+		secure:   secure,
+		bucket:   maputils.StringValue(profile.Config, ConfigKeyS3Bucket),
+		region: stringOrDefault(
+			maputils.StringValue(profile.Config, ConfigKeyS3Region),
+			defaultS3Region,
+		),
+		accessKeyID:     maputils.StringValue(profile.Config, ConfigKeyS3AccessKeyID),
+		secretAccessKey: strings.TrimSpace(secrets[SecretKeyS3SecretAccessKey]),
+		outboundPrefix: s3Prefix(
+			maputils.StringValue(profile.Config, configKeyOutboundDir),
+			defaultS3OutboundPrefix,
+		),
+	}
+	switch {
+	case cfg.bucket == "":
+		return nil, errors.New("S3 bucket is required for EDI delivery")
+	case cfg.accessKeyID == "":
+		return nil, errors.New("S3 access key ID is required for EDI delivery")
+	case cfg.secretAccessKey == "":
+		return nil, errors.New("S3 secret access key secret is required for EDI delivery")
+	}
+	return cfg, nil
+}
+
+func s3InboundConfig(req *services.EDIInboundFetchRequest) (*s3Config, string, error) {
+	if req == nil || req.Profile == nil {
+		return nil, "", errors.New("EDI communication profile is required for inbound polling")
+	}
+	inboundPrefix := s3Prefix(maputils.StringValue(req.Profile.Config, configKeyInboundDir), "")
+	if inboundPrefix == "" {
+		return nil, "", errors.New("inbound prefix is required for EDI inbound polling")
+	}
+	cfg, err := s3ConfigFromProfile(req.Profile, req.Secrets)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, inboundPrefix, nil
+}
+
+// ValidateS3Endpoint reports whether value is usable as an S3 endpoint.
+func ValidateS3Endpoint(value string) error {
+	_, _, err := parseS3Endpoint(value)
+	return err
+}
+
+// parseS3Endpoint accepts either a bare host[:port], which is reached over
+// TLS, or an http(s) URL whose scheme decides.
+func parseS3Endpoint(raw string) (endpoint string, secure bool, err error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", false, errors.New("S3 endpoint is required for EDI delivery")
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", false, fmt.Errorf("S3 endpoint is invalid: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", false, fmt.Errorf("S3 endpoint scheme %q is not supported", parsed.Scheme)
+	}
+	if parsed.Host == "" || strings.Trim(parsed.Path, "/") != "" {
+		return "", false, errors.New("S3 endpoint must be a host without a path")
+	}
+	return parsed.Host, parsed.Scheme == "https", nil
+}
+
+// s3Prefix normalizes a directory-style setting into an object key prefix:
+// no leading slash and exactly one trailing slash.
+func s3Prefix(value, fallback string) string {
+	prefix := strings.Trim(strings.TrimSpace(value), "/")
+	if prefix == "" {
+		return fallback
+	}
+	return prefix + "/"
+}
+
+func s3RemotePath(bucket, key string) string {
+	return "s3://" + bucket + "/" + key
+}
+
+func newS3Client(cfg *s3Config) (*minio.Client, error) {
+	client, err := minio.New(cfg.endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.accessKeyID, cfg.secretAccessKey, ""),
+		Secure: cfg.secure,
+		Region: cfg.region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create S3 client: %w", err)
+	}
+	return client, nil
+}
+
+func readS3Object(ctx context.Context, client *minio.Client, bucket, key string) (string, error) {
+	object, err := client.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,105 @@
+package editransport
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseS3Endpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		raw          string
+		wantEndpoint string
+		wantSecure   bool
+		wantErr      string
+	}{
+		{
+			name:         "bare host defaults to TLS",
+			raw:          "s3.us-east-1.amazonaws.com",
+			wantEndpoint: "s3.us-east-1.amazonaws.com",
+			wantSecure:   true,
+		},
+		{
+			name:         "http URL with port",
+			raw:          " http://localhost:9223/ ",
+			wantEndpoint: "localhost:9223",
+		},
+		{
+			name:         "https URL",
+			raw:          "https://minio.partner.example",
+			wantEndpoint: "minio.partner.example",
+			wantSecure:   true,
+		},
+		{name: "empty", raw: "", wantErr: "S3 endpoint is required"},
+		{name: "unsupported scheme", raw: "ftp://bucket.example", wantErr: "not supported"},
+		{
+			name:    "path not allowed",
+			raw:     "https://s3.example.com/bucket",
+			wantErr: "must be a host without a path",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint, secure, err := parseS3Endpoint(tt.raw)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEndpoint, endpoint)
+			require.Equal(t, tt.wantSecure, secure)
+		})
+	}
+}
+
+func TestS3Prefix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "inbound/", s3Prefix("/inbound", ""))
+	require.Equal(t, "partners/acme/in/", s3Prefix(" partners/acme/in/ ", ""))
+	require.Equal(t, "outbound/", s3Prefix("", defaultS3OutboundPrefix))
+	require.Empty(t, s3Prefix("/", ""))
+}
+
+func TestS3ConfigFromProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := &edi.EDICommunicationProfile{
+		Method: edi.ConnectionMethodS3,
+		Config: map[string]any{
+			"endpoint":          "http://localhost:9223",
+			"bucket":            "edi-mailbox",
+			"accessKeyId":       "trenova",
+			"outboundDirectory": "/to-partner",
+		},
+	}
+
+	cfg, err := s3ConfigFromProfile(profile, map[string]string{"secretAccessKey": "secret"})
+	require.NoError(t, err)
+	require.Equal(t, "localhost:9223", cfg.endpoint)
+	require.False(t, cfg.secure)
+	require.Equal(t, defaultS3Region, cfg.region)
+	require.Equal(t, "to-partner/", cfg.outboundPrefix)
+	require.Equal(
+		t,
+		"s3://edi-mailbox/to-partner/204.edi",
+		s3RemotePath(cfg.bucket, "to-partner/204.edi"),
+	)
+
+	_, err = s3ConfigFromProfile(profile, map[string]string{})
+	require.ErrorContains(t, err, "S3 secret access key secret is required")
+
+	_, _, err = s3InboundConfig(&services.EDIInboundFetchRequest{
+		Profile: profile,
+		Secrets: map[string]string{"secretAccessKey": "secret"},
+	})
+	require.ErrorContains(t, err, "inbound prefix is required")
+}
@@ -27,6 +27,7 @@ const (
 	defaultOutboundDirectory = "/outbound"
 	defaultFileNamingPattern = "{partnerId}-{transactionSet}-{messageId}.x12"
 	defaultOutboundExtension = ".x12"
+	edifactOutboundExtension = ".edi"
 	secretKeyPassword        = "password"
 	secretKeyPrivateKey      = "privateKey"
 	configKeyHost            = "host"
@@ -223,11 +224,17 @@ func hostKeyCallbackFor(knownHostKey string) (ssh.HostKeyCallback, error) {
 }
 
 func OutboundFileName(profile *edi.EDICommunicationProfile, message *edi.EDIMessage) string {
-	pattern := defaultFileNamingPattern
+	extension := defaultOutboundExtension
+	if message != nil && message.Standard == edi.EDIStandardEDIFACT {
+		extension = edifactOutboundExtension
+	}
+	fallbackPattern := strings.TrimSuffix(defaultFileNamingPattern, defaultOutboundExtension) +
+		extension
+	pattern := fallbackPattern
 	if profile != nil {
 		pattern = stringOrDefault(
 			maputils.StringValue(profile.Config, configKeyFileNamePattern),
-			defaultFileNamingPattern,
+			fallbackPattern,
 		)
 	}
 	partnerID := ""
@@ -250,10 +257,10 @@ func OutboundFileName(profile *edi.EDICommunicationProfile, message *edi.EDIMess
 	name := replacer.Replace(pattern)
 	name = strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(name)
 	if strings.TrimSpace(name) == "" {
-		return messageID + defaultOutboundExtension
+		return messageID + extension
 	}
 	if path.Ext(name) == "" {
-		name += defaultOutboundExtension
+		name += extension
 	}
 	return name
 }
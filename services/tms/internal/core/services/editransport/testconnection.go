@@ -11,6 +11,7 @@ import (
 
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/shared/maputils"
+	"github.com/minio/minio-go/v7"
 )
 
 const (
@@ -119,6 +120,95 @@ func testSFTPEndpoint(
 	return checks
 }
 
+func (t *FTPSTransport) TestConnection(
+	ctx context.Context,
+	req *services.EDITransportRequest,
+) []services.EDIConnectionCheck {
+	checks := make([]services.EDIConnectionCheck, 0, 4)
+	if req == nil || req.Profile == nil {
+		return append(checks, failedCheck("configuration", "EDI communication profile is required"))
+	}
+	cfg := ftpsConfigFromProfile(req.Profile, req.Secrets)
+	if err := validateFTPSConfig(&cfg); err != nil {
+		return append(checks, failedCheck("configuration", err.Error()))
+	}
+	checks = append(checks, passedCheck("configuration", "Endpoint configuration is complete"))
+
+	client, err := dialFTPS(ctx, &cfg)
+	if err != nil {
+		return append(checks, failedCheck("connection", err.Error()))
+	}
+	defer client.Close()
+	checks = append(checks, passedCheck("connection", "Connected over TLS and authenticated"))
+
+	directories := []struct{ name, path string }{
+		{"outbound directory", stringOrDefault(cfg.outboundDirectory, defaultOutboundDirectory)},
+		{"inbound directory", maputils.StringValue(req.Profile.Config, configKeyInboundDir)},
+	}
+	for _, directory := range directories {
+		if directory.path == "" {
+			continue
+		}
+		if cwdErr := client.ChangeDir(directory.path); cwdErr != nil {
+			checks = append(checks, warningCheck(
+				directory.name,
+				fmt.Sprintf("%s is not accessible: %s", directory.path, cwdErr.Error()),
+			))
+		} else {
+			checks = append(checks, passedCheck(directory.name, directory.path+" is accessible"))
+		}
+	}
+	return checks
+}
+
+func (t *S3Transport) TestConnection(
+	ctx context.Context,
+	req *services.EDITransportRequest,
+) []services.EDIConnectionCheck {
+	checks := make([]services.EDIConnectionCheck, 0, 3)
+	if req == nil || req.Profile == nil {
+		return append(checks, failedCheck("configuration", "EDI communication profile is required"))
+	}
+	cfg, err := s3ConfigFromProfile(req.Profile, req.Secrets)
+	if err != nil {
+		return append(checks, failedCheck("configuration", err.Error()))
+	}
+	checks = append(checks, passedCheck("configuration", "Bucket configuration is complete"))
+
+	client, err := newS3Client(cfg)
+	if err != nil {
+		return append(checks, failedCheck("connection", err.Error()))
+	}
+	exists, err := client.BucketExists(ctx, cfg.bucket)
+	switch {
+	case err != nil:
+		return append(checks, failedCheck("connection", err.Error()))
+	case !exists:
+		return append(checks, failedCheck("connection", "Bucket "+cfg.bucket+" does not exist"))
+	}
+	checks = append(checks, passedCheck("connection", "Authenticated and bucket is accessible"))
+
+	inboundPrefix := s3Prefix(maputils.StringValue(req.Profile.Config, configKeyInboundDir), "")
+	if inboundPrefix == "" {
+		return checks
+	}
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for object := range client.ListObjects(listCtx, cfg.bucket, minio.ListObjectsOptions{
+		Prefix:  inboundPrefix,
+		MaxKeys: 1,
+	}) {
+		if object.Err != nil {
+			return append(checks, warningCheck(
+				"inbound prefix",
+				fmt.Sprintf("%s cannot be listed: %s", inboundPrefix, object.Err.Error()),
+			))
+		}
+		break
+	}
+	return append(checks, passedCheck("inbound prefix", inboundPrefix+" can be listed"))
+}
+
 func (t *AS2Transport) TestConnection(
 	ctx context.Context,
 	req *services.EDITransportRequest,
@@ -238,3 +328,49 @@ func testAS2Reachability(
 		response.StatusCode,
 	))
 }
+
+func (t *HTTPSTransport) TestConnection(
+	ctx context.Context,
+	req *services.EDITransportRequest,
+) []services.EDIConnectionCheck {
+	checks := make([]services.EDIConnectionCheck, 0, 3)
+	if req == nil || req.Profile == nil {
+		return append(checks, failedCheck("configuration", "EDI communication profile is required"))
+	}
+	cfg, err := HTTPSConfigFromProfile(req.Profile, req.Secrets)
+	if err != nil {
+		return append(checks, failedCheck("configuration", err.Error()))
+	}
+	if validationErr := validateHTTPSDeliveryConfig(cfg); validationErr != nil {
+		return append(checks, failedCheck("configuration", validationErr.Error()))
+	}
+	checks = append(checks, passedCheck("configuration", "HTTPS configuration is complete"))
+
+	requestCtx, cancel := context.WithTimeout(ctx, as2ReachabilityTimeout)
+	defer cancel()
+	if cfg.AuthScheme == HTTPSAuthSchemeOAuth2 {
+		if _, tokenErr := t.tokenSource(requestCtx, cfg).Token(); tokenErr != nil {
+			t.forgetToken(cfg)
+			checks = append(checks, failedCheck(
+				"authentication",
+				"OAuth2 token request failed: "+tokenErr.Error(),
+			))
+		} else {
+			checks = append(checks, passedCheck("authentication", "OAuth2 token issued"))
+		}
+	}
+
+	request, err := http.NewRequestWithContext(requestCtx, http.MethodHead, cfg.EndpointURL, nil)
+	if err != nil {
+		return append(checks, failedCheck("endpoint", "Endpoint URL is invalid: "+err.Error()))
+	}
+	response, err := t.client.Do(request)
+	if err != nil {
+		return append(checks, failedCheck("endpoint", "Endpoint is not reachable: "+err.Error()))
+	}
+	defer response.Body.Close()
+	return append(checks, passedCheck("endpoint", fmt.Sprintf(
+		"Endpoint responded with HTTP %d",
+		response.StatusCode,
+	)))
+}
@@ -23,6 +23,8 @@ var validConditionRoots = map[string]struct{}{
 	"loadTender":        {},
 	"invoice":           {},
 	"shipmentStatus":    {},
+	"billOfLading":      {},
+	"deliveryManifest":  {},
 	"tenderResponse":    {},
 	"functionalAck":     {},
 	"implementationAck": {},
@@ -57,12 +57,39 @@ const (
 	x12SegmentIEA = "IEA"
 )
 
+// Syntax carries the delimiter and envelope rules applied on top of template
+// resolution. RenderX12 supplies the X12 rules; other standards render through
+// RenderWithSyntax with their own element escaping and trailer counts.
+type Syntax struct {
+	ElementSeparator   string
+	ComponentSeparator string
+	SegmentTerminator  string
+	// Prefix is written ahead of the first segment, e.g. an EDIFACT UNA
+	// service string advice.
+	Prefix        string
+	EscapeElement func(segmentID string, position int, value string) string
+	ApplyTrailers func(segments [][]string)
+}
+
 func RenderX12(input *RenderInput) (*RenderResult, error) {
+	normalizeEnvelope(&input.Profile.Envelope)
+	envelope := &input.Profile.Envelope
+	return RenderWithSyntax(input, &Syntax{
+		ElementSeparator:   envelope.ElementSeparator,
+		ComponentSeparator: envelope.ComponentSeparator,
+		SegmentTerminator:  envelope.SegmentTerminator,
+		EscapeElement: func(segmentID string, position int, value string) string {
+			return sanitizeX12Element(segmentID, position, value, envelope)
+		},
+		ApplyTrailers: applyTrailerCounts,
+	})
+}
+
+func RenderWithSyntax(input *RenderInput, syntax *Syntax) (*RenderResult, error) {
 	renderCtx := input.Context
 	if renderCtx == nil {
 		renderCtx = context.Background()
 	}
-	normalizeEnvelope(&input.Profile.Envelope)
 
 	payload := input.DocumentPayload
 	if !payload.HasBranch() && input.Payload.ShipmentID.IsNotNil() {
@@ -83,7 +110,7 @@ func RenderX12(input *RenderInput) (*RenderResult, error) {
 		input.CarrierSCAC,
 	)
 
-	rendered := make([]string, 0, len(segments)+8)
+	rendered := make([][]string, 0, len(segments)+8)
 	diagnostics := make([]Diagnostic, 0)
 	for _, segment := range segments {
 		repeats := repeatValues(payloadMap, segment.RepeatPath)
@@ -112,6 +139,7 @@ func RenderX12(input *RenderInput) (*RenderResult, error) {
 				continue
 			}
 			elements := make([]string, maxElementPosition(segment.Elements))
+			composites := make(map[int][]string)
 			segmentHasValue := segment.Required
 			for i := range segment.Elements {
 				element := &segment.Elements[i]
@@ -129,36 +157,60 @@ func RenderX12(input *RenderInput) (*RenderResult, error) {
 				if element.Position <= 0 {
 					continue
 				}
-				elements[element.Position-1] = sanitizeX12Element(
-					segment.SegmentID,
-					element.Position,
-					value,
-					&input.Profile.Envelope,
-				)
+				escaped := syntax.EscapeElement(segment.SegmentID, element.Position, value)
+				if element.Component > 0 {
+					composites[element.Position] = setComponent(
+						composites[element.Position],
+						element.Component,
+						escaped,
+					)
+					continue
+				}
+				elements[element.Position-1] = escaped
 			}
 			if !segmentHasValue && !segment.Required {
 				continue
 			}
+			for position, components := range composites {
+				elements[position-1] = strings.Join(
+					trimTrailingEmpty(components),
+					syntax.ComponentSeparator,
+				)
+			}
 			diagnostics = append(diagnostics, validateRenderedSegment(segment, elements)...)
-			rendered = append(rendered, strings.Join(
+			rendered = append(
+				rendered,
 				append([]string{segment.SegmentID}, trimTrailingEmpty(elements)...),
-				input.Profile.Envelope.ElementSeparator,
-			))
+			)
 		}
 	}
 
-	applyTrailerCounts(rendered, input.Profile.Envelope.ElementSeparator)
-	raw := strings.Join(
-		rendered,
-		input.Profile.Envelope.SegmentTerminator,
-	) + input.Profile.Envelope.SegmentTerminator
+	if syntax.ApplyTrailers != nil {
+		syntax.ApplyTrailers(rendered)
+	}
+	lines := make([]string, 0, len(rendered))
+	for _, parts := range rendered {
+		lines = append(lines, strings.Join(parts, syntax.ElementSeparator))
+	}
+	raw := syntax.Prefix + strings.Join(
+		lines,
+		syntax.SegmentTerminator,
+	) + syntax.SegmentTerminator
 	return &RenderResult{
 		RawX12:       raw,
 		SegmentCount: int64(len(rendered)),
-		Diagnostics:  filterDiagnostics(diagnostics, input.Profile.ValidationMode),
+		Diagnostics:  FilterDiagnostics(diagnostics, input.Profile.ValidationMode),
 	}, nil
 }
 
+func setComponent(components []string, component int, value string) []string {
+	for len(components) < component {
+		components = append(components, "")
+	}
+	components[component-1] = value
+	return components
+}
+
 func Render204(input *RenderInput) (*RenderResult, error) {
 	return RenderX12(input)
 }
@@ -404,6 +456,8 @@ func isQualifiedFieldRoot(path string) bool {
 		"loadTender.",
 		"invoice.",
 		"shipmentStatus.",
+		"billOfLading.",
+		"deliveryManifest.",
 		"tenderResponse.",
 		"functionalAck.",
 		"implementationAck.",
@@ -425,6 +479,11 @@ func formatElementValue(
 	element *edi.TemplateElement,
 	value any,
 ) string {
+	// BOL04 (211) and ATA02 (212) carry the document date.
+	if (segment.SegmentID == "BOL" && element.Position == 4) ||
+		(segment.SegmentID == "ATA" && element.Position == 2) {
+		return formatX12Date(value)
+	}
 	if segment.SegmentID == "G62" || segment.SegmentID == x12SegmentAT7 {
 		switch element.Position {
 		case 2:
@@ -524,10 +583,9 @@ type trailerState struct {
 	functionalGroupCount     int
 }
 
-func applyTrailerCounts(rendered []string, separator string) {
+func applyTrailerCounts(rendered [][]string) {
 	state := trailerState{stIndex: -1}
-	for i, segment := range rendered {
-		parts := strings.Split(segment, separator)
+	for i, parts := range rendered {
 		if len(parts) == 0 {
 			continue
 		}
@@ -540,13 +598,10 @@ func applyTrailerCounts(rendered []string, separator string) {
 			state.captureTransaction(parts, i)
 		case x12SegmentSE:
 			state.applyTransactionTrailer(parts, i)
-			rendered[i] = strings.Join(parts, separator)
 		case x12SegmentGE:
 			state.applyGroupTrailer(parts)
-			rendered[i] = strings.Join(parts, separator)
 		case x12SegmentIEA:
 			state.applyInterchangeTrailer(parts)
-			rendered[i] = strings.Join(parts, separator)
 		}
 	}
 }
@@ -655,6 +710,8 @@ var documentRootKeys = [...]string{
 	"loadTender",
 	"invoice",
 	"shipmentStatus",
+	"billOfLading",
+	"deliveryManifest",
 	"tenderResponse",
 	"functionalAck",
 	"implementationAck",
@@ -826,7 +883,9 @@ func trimTrailingEmpty(values []string) []string {
 	return values[:last]
 }
 
-func filterDiagnostics(diagnostics []Diagnostic, mode edi.ValidationMode) []Diagnostic {
+// FilterDiagnostics applies a profile's validation mode: disabled keeps only
+// render and script failures, and warn-only downgrades errors to warnings.
+func FilterDiagnostics(diagnostics []Diagnostic, mode edi.ValidationMode) []Diagnostic {
 	if mode == edi.ValidationModeDisabled {
 		filtered := make([]Diagnostic, 0, len(diagnostics))
 		for _, diagnostic := range diagnostics {
@@ -782,6 +782,30 @@ func TestRenderX12_StarterTemplatesRenderTransactionSets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:           "211 bill of lading",
+			transactionSet: edi.TransactionSet211,
+			payload: edi.DocumentPayload{
+				TransactionSet: edi.TransactionSet211,
+				BillOfLading: &edi.BillOfLadingPayload{
+					ShipmentID: pulid.MustNew("shp_"),
+					BOL:        "BOL-211",
+					ShipDate:   time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC).Unix(),
+				},
+			},
+		},
+		{
+			name:           "212 delivery manifest",
+			transactionSet: edi.TransactionSet212,
+			payload: edi.DocumentPayload{
+				TransactionSet: edi.TransactionSet212,
+				DeliveryManifest: &edi.DeliveryManifestPayload{
+					ShipmentID:   pulid.MustNew("shp_"),
+					BOL:          "BOL-212",
+					ManifestDate: time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC).Unix(),
+				},
+			},
+		},
 		{
 			name:           "214 shipment status",
 			transactionSet: edi.TransactionSet214,
@@ -922,6 +946,111 @@ func TestRenderX12_214ServiceFailureStatusRequiresReasonCode(t *testing.T) {
 	assert.Equal(t, "shipmentStatus.statusReasonCode", diagnostic.Path)
 }
 
+func TestRenderX12_211RendersLadingAndHazardousMaterialSegments(t *testing.T) {
+	t.Parallel()
+
+	shipDate := time.Date(2026, 5, 16, 9, 0, 0, 0, time.UTC).Unix()
+	result := renderStarterDocument(t, edi.DocumentPayload{
+		TransactionSet: edi.TransactionSet211,
+		BillOfLading: &edi.BillOfLadingPayload{
+			ShipmentID: pulid.MustNew("shp_"),
+			BOL:        "BOL-HM",
+			ProNumber:  "PRO-1",
+			ShipDate:   shipDate,
+			Shipper: edi.LadingParty{
+				Name:         "Acme Chemical",
+				AddressLine1: "1 Plant Rd",
+				City:         "Houston",
+				StateCode:    "TX",
+				PostalCode:   "77001",
+			},
+			Consignee: edi.LadingParty{Name: "Beta Paints", City: "Dallas", StateCode: "TX"},
+			LineItems: []edi.LadingLineItem{
+				{Sequence: 1, Description: "Paint", Pieces: 10, Weight: 1200, Hazardous: true},
+				{Sequence: 2, Description: "Brushes", Pieces: 4, Weight: 80},
+			},
+			HazardousMaterials: []edi.HazardousMaterialLine{
+				{
+					Sequence:                    1,
+					UNNumber:                    "UN1263",
+					HazardClass:                 "3",
+					PackingGroup:                "II",
+					ProperShippingName:          "Paint",
+					Pieces:                      10,
+					ReportableQuantity:          "RQ",
+					EmergencyContact:            "CHEMTREC",
+					EmergencyContactPhoneNumber: "8004249300",
+				},
+			},
+		},
+	})
+
+	require.Empty(t, result.Diagnostics)
+	assert.Contains(t, result.RawX12, "ST*211*")
+	assert.Contains(t, result.RawX12, "BOL*TEST*PP*BOL-HM*20260516~")
+	assert.Contains(t, result.RawX12, "L11*PRO-1*CN~")
+	assert.Contains(t, result.RawX12, "N1*SH*Acme Chemical~N3*1 Plant Rd~N4*Houston*TX*77001~")
+	assert.Contains(t, result.RawX12, "N1*CN*Beta Paints~")
+	assert.Contains(t, result.RawX12, "AT2*10*PCS*G*L*1200~AT2*4*PCS*G*L*80~")
+	assert.Contains(t, result.RawX12, "LH1*PC*10*UN1263*****RQ*II~")
+	assert.Contains(t, result.RawX12, "LH2*3*P~")
+	assert.Contains(t, result.RawX12, "LH3*Paint*D~")
+	assert.Contains(t, result.RawX12, "PER*HM*CHEMTREC*TE*8004249300~")
+}
+
+func TestRenderX12_211SkipsHazardousMaterialSegmentsWithoutHazmat(t *testing.T) {
+	t.Parallel()
+
+	result := renderStarterDocument(t, edi.DocumentPayload{
+		TransactionSet: edi.TransactionSet211,
+		BillOfLading: &edi.BillOfLadingPayload{
+			BOL:       "BOL-DRY",
+			ShipDate:  time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC).Unix(),
+			LineItems: []edi.LadingLineItem{{Sequence: 1, Description: "Paper", Pieces: 2}},
+		},
+	})
+
+	require.Empty(t, result.Diagnostics)
+	for _, segment := range []string{"~LH1*", "~LH2*", "~LH3*", "~PER*", "~L11*", "~N1*"} {
+		assert.NotContains(t, result.RawX12, segment)
+	}
+}
+
+func TestRenderX12_212RendersManifestStops(t *testing.T) {
+	t.Parallel()
+
+	firstDelivery := time.Date(2026, 5, 17, 8, 0, 0, 0, time.UTC).Unix()
+	weight := int64(900)
+	result := renderStarterDocument(t, edi.DocumentPayload{
+		TransactionSet: edi.TransactionSet212,
+		DeliveryManifest: &edi.DeliveryManifestPayload{
+			BOL:             "BOL-212",
+			ManifestDate:    firstDelivery,
+			EquipmentNumber: "TRL-9",
+			Stops: []edi.ManifestStop{
+				{
+					Sequence:             1,
+					LocationName:         "Store 1",
+					LocationCode:         "S1",
+					City:                 "Austin",
+					StateCode:            "TX",
+					ScheduledWindowStart: firstDelivery,
+					Weight:               &weight,
+				},
+				{Sequence: 2, LocationName: "Store 2", City: "Waco", StateCode: "TX"},
+			},
+		},
+	})
+
+	require.Empty(t, result.Diagnostics)
+	assert.Contains(t, result.RawX12, "ATA*TEST*20260517***TRL-9~")
+	assert.Contains(t, result.RawX12, "L11*BOL-212*BM~")
+	assert.Contains(t, result.RawX12, "LX*1~LX*2~")
+	assert.Contains(t, result.RawX12, "N1*CN*Store 1*93*S1~N1*CN*Store 2*93~")
+	assert.Contains(t, result.RawX12, "N4*Austin*TX~N4*Waco*TX~")
+	assert.Contains(t, result.RawX12, "G62*68*20260517~AT8*G*L*900~")
+}
+
 func TestRenderX12_StarlarkReadsDocumentRoots(t *testing.T) {
 	t.Parallel()
 
@@ -1430,3 +1559,38 @@ func findElement(
 	require.Less(t, index, len(segment.Elements))
 	return &segment.Elements[index]
 }
+
+func renderStarterDocument(t *testing.T, payload edi.DocumentPayload) *RenderResult {
+	t.Helper()
+
+	segments, err := editemplates.StarterSegments(
+		pagination.TenantInfo{},
+		pulid.MustNew("editv_"),
+		payload.TransactionSet,
+	)
+	require.NoError(t, err)
+
+	profile := &edi.EDIPartnerDocumentProfile{
+		TransactionSet:    payload.TransactionSet,
+		FunctionalGroupID: edi.FunctionalGroupDefault(payload.TransactionSet),
+		Envelope:          edi.DefaultX12EnvelopeSettings(),
+		ValidationMode:    edi.ValidationModeStrict,
+		PartnerSettings: map[string]any{
+			"carrier": map[string]any{"scac": "TEST"},
+		},
+	}
+	runtime := RuntimeValues(profile, "004010")
+	SetProvisionalControlNumbers(runtime)
+
+	result, err := RenderX12(&RenderInput{
+		Profile: profile,
+		TemplateVersion: &edi.EDITemplateVersion{
+			Segments: segments,
+		},
+		DocumentPayload: payload,
+		Runtime:         runtime,
+	})
+	require.NoError(t, err)
+
+	return result
+}
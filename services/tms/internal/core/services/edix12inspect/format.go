@@ -74,9 +74,9 @@ func diagnosticIndexesForSegment(
 
 func indentationForSegment(segmentID string) string {
 	switch segmentID {
-	case "ISA", "IEA":
+	case "ISA", "IEA", "UNB", "UNZ":
 		return ""
-	case "GS", "GE":
+	case "GS", "GE", "UNH", "UNT":
 		return "  "
 	default:
 		return "    "
@@ -0,0 +1,568 @@
+package edix12inspect
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/services/edix12"
+)
+
+// guideMatch records the guide node a segment matched so the inspector can
+// label it from the partner's guide instead of the static dictionary.
+type guideMatch struct {
+	node *edi.ImplementationGuideNode
+	loop string
+}
+
+type guideValidator struct {
+	segments    []X12Segment
+	trailer     *X12Segment
+	pos         int
+	diagnostics []NormalizedDiagnostic
+	matches     map[int]guideMatch
+}
+
+type guideTransaction struct {
+	path     string
+	segments []X12Segment
+	trailer  *X12Segment
+}
+
+// ValidateImplementationGuide checks every ST/SE transaction in segments
+// against a partner implementation guide and reports loop, segment, and
+// element violations with their guide paths.
+func ValidateImplementationGuide(
+	guide *edi.ImplementationGuideDefinition,
+	segments []X12Segment,
+) []NormalizedDiagnostic {
+	diagnostics, _ := validateGuide(guide, segments)
+	return diagnostics
+}
+
+// ImplementationGuideDiagnostics parses rendered X12 with the profile's
+// separators and returns guide violations in the renderer's diagnostic shape.
+func ImplementationGuideDiagnostics(
+	rawX12 string,
+	envelope *edi.X12EnvelopeSettings,
+	guide *edi.ImplementationGuideDefinition,
+) []edix12.Diagnostic {
+	separators, _ := DetectSeparators(rawX12, envelope)
+	segments, _ := parseSegments(rawX12, &separators)
+	normalized := ValidateImplementationGuide(guide, segments)
+	diagnostics := make([]edix12.Diagnostic, 0, len(normalized))
+	for index := range normalized {
+		diagnostic := &normalized[index]
+		diagnostics = append(diagnostics, edix12.Diagnostic{
+			Severity:        diagnostic.Severity,
+			Code:            diagnostic.Code,
+			SegmentID:       diagnostic.SegmentID,
+			ElementPosition: diagnostic.ElementPosition,
+			Path:            diagnostic.Path,
+			Message:         diagnostic.Message,
+			SuggestedFix:    diagnostic.SuggestedFix,
+		})
+	}
+	return diagnostics
+}
+
+func validateGuide(
+	guide *edi.ImplementationGuideDefinition,
+	segments []X12Segment,
+) ([]NormalizedDiagnostic, map[int]guideMatch) {
+	if guide == nil || len(guide.Nodes) == 0 {
+		return nil, nil
+	}
+	diagnostics := make([]NormalizedDiagnostic, 0)
+	matches := make(map[int]guideMatch)
+	for _, transaction := range guideTransactions(segments) {
+		validator := &guideValidator{
+			segments: transaction.segments,
+			trailer:  transaction.trailer,
+			matches:  matches,
+		}
+		validator.matchNodes(guide.Nodes, transaction.path, "", map[string]bool{})
+		validator.skipUnexpected(nil, transaction.path, map[string]bool{})
+		diagnostics = append(diagnostics, validator.diagnostics...)
+	}
+	return diagnostics, matches
+}
+
+// guideTransactions splits segments into transaction bodies, excluding the
+// ST header and SE trailer that the envelope validation already covers.
+func guideTransactions(segments []X12Segment) []guideTransaction {
+	transactions := make([]guideTransaction, 0, 1)
+	var current *guideTransaction
+	for index := range segments {
+		segment := &segments[index]
+		switch segment.SegmentID {
+		case "ST":
+			ordinal := segment.TransactionIndex
+			if ordinal == 0 {
+				ordinal = len(transactions) + 1
+			}
+			transactions = append(transactions, guideTransaction{
+				path: fmt.Sprintf("ST[%d]", ordinal),
+				// Fall back to ST when SE is missing so missing-segment
+				// diagnostics still point inside the transaction.
+				trailer: segment,
+			})
+			current = &transactions[len(transactions)-1]
+		case "SE":
+			if current != nil {
+				current.trailer = segment
+			}
+			current = nil
+		case "GE", "IEA":
+			current = nil
+		default:
+			if current != nil {
+				current.segments = append(current.segments, *segment)
+			}
+		}
+	}
+	return transactions
+}
+
+// matchNodes consumes segments for one level of the guide. stop holds the
+// segments that belong to an enclosing level, which end this level rather
+// than being reported as unexpected.
+func (v *guideValidator) matchNodes(
+	nodes []edi.ImplementationGuideNode,
+	path string,
+	loop string,
+	stop map[string]bool,
+) {
+	for index := range nodes {
+		node := &nodes[index]
+		v.skipUnexpected(nodes[index:], path, stop)
+		if node.IsLoop() {
+			v.matchLoop(node, nodes[index+1:], path, stop)
+			continue
+		}
+		v.matchSegment(node, path, loop)
+	}
+}
+
+func (v *guideValidator) matchSegment(node *edi.ImplementationGuideNode, path, loop string) {
+	count := 0
+	for v.pos < len(v.segments) && v.segments[v.pos].SegmentID == node.SegmentID {
+		segment := &v.segments[v.pos]
+		count++
+		v.acceptSegment(node, segment, fmt.Sprintf("%s/%s[%d]", path, node.SegmentID, count), loop)
+		if node.Usage != edi.GuideUsageNotUsed && count > node.MaxOccurs() {
+			v.addSegmentDiagnostic(
+				segment,
+				"x12.guide.segment_max_use",
+				fmt.Sprintf("%s/%s[%d]", path, node.SegmentID, count),
+				fmt.Sprintf(
+					"%s appears %d times; the guide allows at most %d.",
+					node.SegmentID,
+					count,
+					node.MaxOccurs(),
+				),
+				"Remove the extra segment or split the data across guide-defined loops.",
+			)
+		}
+		v.pos++
+	}
+	if count < node.MinOccurs() {
+		v.addSegmentDiagnostic(
+			v.anchor(),
+			"x12.guide.segment_missing",
+			path+"/"+node.SegmentID,
+			fmt.Sprintf(
+				"Required segment %s%s is missing; the guide requires at least %d.",
+				node.SegmentID,
+				guideNameSuffix(node.Name),
+				node.MinOccurs(),
+			),
+			"Map a value for the segment or confirm the partner's guide marks it optional.",
+		).SegmentID = node.SegmentID
+	}
+}
+
+func (v *guideValidator) matchLoop(
+	node *edi.ImplementationGuideNode,
+	following []edi.ImplementationGuideNode,
+	path string,
+	stop map[string]bool,
+) {
+	trigger := node.TriggerSegmentID()
+	childStop := make(map[string]bool, len(stop)+len(following)+1)
+	for segmentID := range stop {
+		childStop[segmentID] = true
+	}
+	for index := range following {
+		childStop[following[index].TriggerSegmentID()] = true
+	}
+	childStop[trigger] = true
+
+	repeats := 0
+	for v.pos < len(v.segments) && v.segments[v.pos].SegmentID == trigger {
+		segment := &v.segments[v.pos]
+		repeats++
+		loopPath := fmt.Sprintf("%s/%s[%d]", path, node.LoopID, repeats)
+		switch {
+		case node.Usage == edi.GuideUsageNotUsed:
+			v.addSegmentDiagnostic(
+				segment,
+				"x12.guide.loop_not_used",
+				loopPath,
+				fmt.Sprintf("Loop %s is marked not used by the guide.", node.LoopID),
+				"Remove the loop from the document.",
+			)
+		case node.MaxOccurs() > 0 && repeats > node.MaxOccurs():
+			v.addSegmentDiagnostic(
+				segment,
+				"x12.guide.loop_max_repeat",
+				loopPath,
+				fmt.Sprintf(
+					"Loop %s repeats %d times; the guide allows at most %d.",
+					node.LoopID,
+					repeats,
+					node.MaxOccurs(),
+				),
+				"Reduce the number of loop repetitions sent to this partner.",
+			)
+		}
+		triggerNode := &node.Children[0]
+		v.acceptSegment(
+			triggerNode,
+			segment,
+			fmt.Sprintf("%s/%s[1]", loopPath, triggerNode.SegmentID),
+			node.LoopID,
+		)
+		v.pos++
+		v.matchNodes(node.Children[1:], loopPath, node.LoopID, childStop)
+	}
+	if repeats < node.MinOccurs() {
+		v.addSegmentDiagnostic(
+			v.anchor(),
+			"x12.guide.loop_missing",
+			path+"/"+node.LoopID,
+			fmt.Sprintf(
+				"Loop %s%s occurs %d times; the guide requires at least %d.",
+				node.LoopID,
+				guideNameSuffix(node.Name),
+				repeats,
+				node.MinOccurs(),
+			),
+			fmt.Sprintf("Send the loop starting with %s.", trigger),
+		).SegmentID = trigger
+	}
+}
+
+// skipUnexpected reports and skips segments that neither start one of the
+// remaining nodes at this level nor belong to an enclosing level.
+func (v *guideValidator) skipUnexpected(
+	remaining []edi.ImplementationGuideNode,
+	path string,
+	stop map[string]bool,
+) {
+	for v.pos < len(v.segments) {
+		segment := &v.segments[v.pos]
+		if stop[segment.SegmentID] ||
+			slices.ContainsFunc(remaining, func(node edi.ImplementationGuideNode) bool {
+				return node.TriggerSegmentID() == segment.SegmentID
+			}) {
+			return
+		}
+		v.addSegmentDiagnostic(
+			segment,
+			"x12.guide.segment_unexpected",
+			path+"/"+segment.SegmentID,
+			fmt.Sprintf(
+				"Segment %s is not defined by the guide at this position.",
+				segment.SegmentID,
+			),
+			"Move the segment to its guide position or remove it.",
+		)
+		v.pos++
+	}
+}
+
+func (v *guideValidator) acceptSegment(
+	node *edi.ImplementationGuideNode,
+	segment *X12Segment,
+	path string,
+	loop string,
+) {
+	v.matches[segment.Index] = guideMatch{node: node, loop: loop}
+	if node.Usage == edi.GuideUsageNotUsed {
+		v.addSegmentDiagnostic(
+			segment,
+			"x12.guide.segment_not_used",
+			path,
+			fmt.Sprintf("Segment %s is marked not used by the guide.", node.SegmentID),
+			"Remove the segment from the document.",
+		)
+		return
+	}
+	v.validateElements(node, segment, path)
+}
+
+func (v *guideValidator) validateElements(
+	node *edi.ImplementationGuideNode,
+	segment *X12Segment,
+	path string,
+) {
+	defined := make(map[int]bool, len(node.Elements))
+	for index := range node.Elements {
+		element := &node.Elements[index]
+		defined[element.Position] = true
+		value := elementValue(segment, element.Position)
+		elementPath := fmt.Sprintf("%s/%s%02d", path, segment.SegmentID, element.Position)
+		switch {
+		case value == "":
+			if guideElementRequired(element, segment) {
+				v.addElementDiagnostic(
+					segment,
+					element.Position,
+					"x12.guide.element_missing",
+					elementPath,
+					fmt.Sprintf(
+						"%s%02d%s is required by the guide.",
+						segment.SegmentID,
+						element.Position,
+						guideNameSuffix(element.Name),
+					),
+					"Map a value for the element.",
+				)
+			}
+		case element.Usage == edi.GuideUsageNotUsed:
+			v.addElementDiagnostic(
+				segment,
+				element.Position,
+				"x12.guide.element_not_used",
+				elementPath,
+				fmt.Sprintf(
+					"%s%02d is marked not used by the guide.",
+					segment.SegmentID,
+					element.Position,
+				),
+				"Leave the element empty for this partner.",
+			)
+		case element.MinLength > 0 && len(value) < element.MinLength,
+			element.MaxLength > 0 && len(value) > element.MaxLength:
+			code := "x12.guide.element_max_length"
+			if len(value) < element.MinLength {
+				code = "x12.guide.element_min_length"
+			}
+			v.addElementDiagnostic(
+				segment,
+				element.Position,
+				code,
+				elementPath,
+				fmt.Sprintf(
+					"%s%02d is %d characters; the guide allows %s.",
+					segment.SegmentID,
+					element.Position,
+					len(value),
+					guideLengthRange(element.MinLength, element.MaxLength),
+				),
+				"Pad or truncate the mapped value to the guide length.",
+			)
+		case len(element.Codes) > 0 && !slices.Contains(element.Codes, value):
+			v.addElementDiagnostic(
+				segment,
+				element.Position,
+				"x12.guide.element_code",
+				elementPath,
+				fmt.Sprintf(
+					"%s%02d value %q is not in the guide code list.",
+					segment.SegmentID,
+					element.Position,
+					value,
+				),
+				"Use one of: "+strings.Join(element.Codes, ", ")+".",
+			)
+		}
+	}
+	if len(node.Elements) > 0 {
+		for index := range segment.Elements {
+			element := &segment.Elements[index]
+			if element.Value == "" || defined[element.Position] {
+				continue
+			}
+			v.addElementDiagnostic(
+				segment,
+				element.Position,
+				"x12.guide.element_undefined",
+				fmt.Sprintf("%s/%s%02d", path, segment.SegmentID, element.Position),
+				fmt.Sprintf(
+					"%s%02d is not defined by the guide.",
+					segment.SegmentID,
+					element.Position,
+				),
+				"Confirm the partner accepts the element or leave it empty.",
+			).Severity = edi.ValidationSeverityWarning
+		}
+	}
+	for index := range node.SyntaxRules {
+		v.validateSyntaxRule(&node.SyntaxRules[index], segment, path)
+	}
+}
+
+func (v *guideValidator) validateSyntaxRule(
+	rule *edi.GuideSyntaxRule,
+	segment *X12Segment,
+	path string,
+) {
+	present := make([]bool, len(rule.Positions))
+	presentCount := 0
+	for index, position := range rule.Positions {
+		if elementValue(segment, position) != "" {
+			present[index] = true
+			presentCount++
+		}
+	}
+	violated := false
+	switch rule.Type {
+	case edi.GuideSyntaxRulePaired:
+		violated = presentCount > 0 && presentCount < len(rule.Positions)
+	case edi.GuideSyntaxRuleRequired:
+		violated = presentCount == 0
+	case edi.GuideSyntaxRuleExclusion:
+		violated = presentCount > 1
+	case edi.GuideSyntaxRuleConditional:
+		violated = present[0] && presentCount < len(rule.Positions)
+	case edi.GuideSyntaxRuleListConditional:
+		violated = present[0] && presentCount == 1
+	}
+	if !violated {
+		return
+	}
+	labels := make([]string, 0, len(rule.Positions))
+	for _, position := range rule.Positions {
+		labels = append(labels, fmt.Sprintf("%s%02d", segment.SegmentID, position))
+	}
+	v.addElementDiagnostic(
+		segment,
+		rule.Positions[0],
+		"x12.guide.syntax_rule",
+		path,
+		fmt.Sprintf(
+			"%s violates the %s syntax rule for %s.",
+			segment.SegmentID,
+			strings.ToLower(string(rule.Type)),
+			strings.Join(labels, ", "),
+		),
+		"Send the related elements together as the guide's syntax notes require.",
+	)
+}
+
+// anchor is the segment a missing-segment diagnostic points at: the next
+// unconsumed segment, or the transaction trailer.
+func (v *guideValidator) anchor() *X12Segment {
+	if v.pos < len(v.segments) {
+		return &v.segments[v.pos]
+	}
+	return v.trailer
+}
+
+func (v *guideValidator) addSegmentDiagnostic(
+	segment *X12Segment,
+	code string,
+	path string,
+	message string,
+	suggestedFix string,
+) *NormalizedDiagnostic {
+	return v.addElementDiagnostic(segment, 0, code, path, message, suggestedFix)
+}
+
+func (v *guideValidator) addElementDiagnostic(
+	segment *X12Segment,
+	position int,
+	code string,
+	path string,
+	message string,
+	suggestedFix string,
+) *NormalizedDiagnostic {
+	diagnostic := NormalizedDiagnostic{
+		Severity:        edi.ValidationSeverityError,
+		Code:            code,
+		Source:          DiagnosticSourceImplementationGuide,
+		ElementPosition: position,
+		Path:            path,
+		Message:         message,
+		SuggestedFix:    suggestedFix,
+	}
+	if segment != nil {
+		diagnostic.SegmentID = segment.SegmentID
+		diagnostic.SegmentIndex = segment.Index
+	}
+	v.diagnostics = append(v.diagnostics, diagnostic)
+	return &v.diagnostics[len(v.diagnostics)-1]
+}
+
+func guideElementRequired(element *edi.ImplementationGuideElement, segment *X12Segment) bool {
+	switch element.Usage {
+	case edi.GuideUsageMandatory:
+		return true
+	case edi.GuideUsageSituational:
+		if element.RequiredWhen == nil {
+			return false
+		}
+		value := elementValue(segment, element.RequiredWhen.Position)
+		if value == "" {
+			return false
+		}
+		return len(element.RequiredWhen.Values) == 0 ||
+			slices.Contains(element.RequiredWhen.Values, value)
+	case edi.GuideUsageOptional, edi.GuideUsageNotUsed:
+		return false
+	}
+	return false
+}
+
+func guideLengthRange(minLength, maxLength int) string {
+	switch {
+	case minLength > 0 && maxLength > 0:
+		return fmt.Sprintf("%d to %d", minLength, maxLength)
+	case maxLength > 0:
+		return fmt.Sprintf("at most %d", maxLength)
+	default:
+		return fmt.Sprintf("at least %d", minLength)
+	}
+}
+
+func guideNameSuffix(name string) string {
+	if name == "" {
+		return ""
+	}
+	return " (" + name + ")"
+}
+
+// applyGuideLabels replaces dictionary names and required flags with the
+// partner guide's labels for every segment the guide matched.
+func applyGuideLabels(segments []X12Segment, matches map[int]guideMatch) {
+	for index := range segments {
+		segment := &segments[index]
+		match, ok := matches[segment.Index]
+		if !ok {
+			continue
+		}
+		if match.node.Name != "" {
+			segment.Name = match.node.Name
+		}
+		if match.loop != "" {
+			segment.Loop = match.loop
+		}
+		for elementIndex := range segment.Elements {
+			element := &segment.Elements[elementIndex]
+			for defIndex := range match.node.Elements {
+				definition := &match.node.Elements[defIndex]
+				if definition.Position != element.Position {
+					continue
+				}
+				if definition.Name != "" {
+					element.Label = definition.Name
+				}
+				element.Required = definition.Usage == edi.GuideUsageMandatory
+				element.Known = true
+			}
+		}
+	}
+}
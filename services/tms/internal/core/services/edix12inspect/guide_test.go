@@ -0,0 +1,184 @@
+package edix12inspect
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTenderGuide() *edi.ImplementationGuideDefinition {
+	return &edi.ImplementationGuideDefinition{
+		Nodes: []edi.ImplementationGuideNode{
+			{
+				SegmentID: "B2",
+				Name:      "Beginning Segment for Shipment Information",
+				Usage:     edi.GuideUsageMandatory,
+				Elements: []edi.ImplementationGuideElement{
+					{Position: 2, Name: "SCAC", Usage: edi.GuideUsageMandatory, MaxLength: 4},
+					{Position: 4, Name: "Shipment ID", Usage: edi.GuideUsageMandatory},
+					{
+						Position: 6,
+						Name:     "Payment Method",
+						Usage:    edi.GuideUsageMandatory,
+						Codes:    []string{"PP", "CC", "TP"},
+					},
+				},
+			},
+			{SegmentID: "B2A", Usage: edi.GuideUsageMandatory},
+			{
+				SegmentID: "L11",
+				Usage:     edi.GuideUsageOptional,
+				MaxUse:    5,
+				SyntaxRules: []edi.GuideSyntaxRule{
+					{Type: edi.GuideSyntaxRulePaired, Positions: []int{1, 2}},
+				},
+			},
+			{
+				LoopID: "0300",
+				Name:   "Stop Off Details",
+				Usage:  edi.GuideUsageMandatory,
+				MaxUse: 2,
+				Children: []edi.ImplementationGuideNode{
+					{
+						SegmentID: "S5",
+						Usage:     edi.GuideUsageMandatory,
+						Elements: []edi.ImplementationGuideElement{
+							{Position: 1, Usage: edi.GuideUsageMandatory},
+							{
+								Position: 2,
+								Usage:    edi.GuideUsageMandatory,
+								Codes:    []string{"CL", "UL"},
+							},
+						},
+					},
+					{SegmentID: "G62", Usage: edi.GuideUsageOptional, MaxUse: 2},
+					{
+						LoopID: "0310",
+						Usage:  edi.GuideUsageOptional,
+						Children: []edi.ImplementationGuideNode{
+							{
+								SegmentID: "N1",
+								Usage:     edi.GuideUsageMandatory,
+								Elements: []edi.ImplementationGuideElement{
+									{Position: 1, Usage: edi.GuideUsageMandatory},
+									{Position: 2, Usage: edi.GuideUsageOptional},
+									{
+										Position:     3,
+										Usage:        edi.GuideUsageSituational,
+										RequiredWhen: &edi.GuideElementCondition{Position: 4},
+									},
+									{Position: 4, Usage: edi.GuideUsageSituational},
+								},
+							},
+							{SegmentID: "N3", Usage: edi.GuideUsageOptional},
+						},
+					},
+				},
+			},
+			{SegmentID: "L3", Usage: edi.GuideUsageOptional},
+		},
+	}
+}
+
+func diagnosticPaths(diagnostics []NormalizedDiagnostic) map[string]string {
+	paths := make(map[string]string, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		paths[diagnostic.Path] = diagnostic.Code
+	}
+	return paths
+}
+
+func TestValidateImplementationGuide_ConformingDocument(t *testing.T) {
+	t.Parallel()
+
+	result := InspectX12(&InspectX12Request{
+		RawX12: "ST*204*0001~" +
+			"B2**ABCD**SH-1**PP~" +
+			"B2A*00~" +
+			"L11*BOL-1*BM~" +
+			"S5*1*CL~G62*10*20261017~N1*SH*Acme*93*DOCK1~N3*1 Main~" +
+			"S5*2*UL~N1*CN*Dallas DC~" +
+			"L3*42000*G~" +
+			"SE*12*0001~",
+		Guide: loadTenderGuide(),
+	})
+
+	for _, diagnostic := range result.Diagnostics {
+		assert.NotEqual(
+			t,
+			DiagnosticSourceImplementationGuide,
+			diagnostic.Source,
+			diagnostic.Message,
+		)
+	}
+	require.Len(t, result.Segments, 12)
+	assert.Equal(t, "Beginning Segment for Shipment Information", result.Segments[1].Name)
+	assert.Equal(t, "SCAC", result.Segments[1].Elements[1].Label)
+	assert.True(t, result.Segments[1].Elements[1].Required)
+	assert.Equal(t, "0300", result.Segments[4].Loop)
+	assert.Equal(t, "0310", result.Segments[6].Loop)
+}
+
+func TestValidateImplementationGuide_ReportsViolationsWithPaths(t *testing.T) {
+	t.Parallel()
+
+	result := InspectX12(&InspectX12Request{
+		RawX12: "ST*204*0001~" +
+			"B2**TOOLONG**SH-1**XX~" +
+			"L11*BOL-1~" +
+			"S5*1*CL~N1*SH*Acme**DOCK1~ZZZ*1~" +
+			"S5*2*UL~" +
+			"S5*3*XX~" +
+			"SE*9*0001~",
+		Guide: loadTenderGuide(),
+	})
+
+	guideDiagnostics := make([]NormalizedDiagnostic, 0)
+	for _, diagnostic := range result.Diagnostics {
+		if diagnostic.Source == DiagnosticSourceImplementationGuide {
+			guideDiagnostics = append(guideDiagnostics, diagnostic)
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"ST[1]/B2[1]/B202":                 "x12.guide.element_max_length",
+		"ST[1]/B2[1]/B206":                 "x12.guide.element_code",
+		"ST[1]/B2A":                        "x12.guide.segment_missing",
+		"ST[1]/L11[1]":                     "x12.guide.syntax_rule",
+		"ST[1]/0300[1]/0310[1]/N1[1]/N103": "x12.guide.element_missing",
+		"ST[1]/0300[1]/0310[1]/ZZZ":        "x12.guide.segment_unexpected",
+		"ST[1]/0300[3]":                    "x12.guide.loop_max_repeat",
+		"ST[1]/0300[3]/S5[1]/S502":         "x12.guide.element_code",
+	}, diagnosticPaths(guideDiagnostics))
+
+	for _, diagnostic := range guideDiagnostics {
+		assert.Equal(t, edi.ValidationSeverityError, diagnostic.Severity)
+		if diagnostic.Code == "x12.guide.segment_missing" {
+			assert.Equal(t, "B2A", diagnostic.SegmentID)
+			assert.Equal(t, 3, diagnostic.SegmentIndex)
+		}
+		if diagnostic.Code == "x12.guide.element_max_length" {
+			assert.Equal(t, 2, diagnostic.ElementPosition)
+		}
+	}
+}
+
+func TestValidateImplementationGuide_MissingMandatoryLoopAndUndefinedElement(t *testing.T) {
+	t.Parallel()
+
+	segments := InspectX12(&InspectX12Request{
+		RawX12: "ST*204*0001~B2**ABCD**SH-1**PP*EXTRA~B2A*00~SE*4*0001~",
+	}).Segments
+
+	diagnostics := ValidateImplementationGuide(loadTenderGuide(), segments)
+
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, "x12.guide.element_undefined", diagnostics[0].Code)
+	assert.Equal(t, edi.ValidationSeverityWarning, diagnostics[0].Severity)
+	assert.Equal(t, "ST[1]/B2[1]/B207", diagnostics[0].Path)
+	assert.Equal(t, "x12.guide.loop_missing", diagnostics[1].Code)
+	assert.Equal(t, "S5", diagnostics[1].SegmentID)
+	assert.Equal(t, "ST[1]/0300", diagnostics[1].Path)
+	assert.Equal(t, 4, diagnostics[1].SegmentIndex)
+}
@@ -33,6 +33,11 @@ func InspectX12(req *InspectX12Request) InspectX12Result {
 	diagnostics = append(diagnostics, parseDiagnostics...)
 	diagnostics = append(diagnostics, validateStructure(req, segments)...)
 	diagnostics = append(diagnostics, normalizeRenderDiagnostics(req.Diagnostics, segments)...)
+	if req.Guide != nil {
+		guideDiagnostics, matches := validateGuide(req.Guide, segments)
+		diagnostics = append(diagnostics, guideDiagnostics...)
+		applyGuideLabels(segments, matches)
+	}
 
 	result := InspectX12Result{
 		RawX12:         req.RawX12,
@@ -50,6 +55,18 @@ func InspectX12(req *InspectX12Request) InspectX12Result {
 	return result
 }
 
+// Finalize appends normalized render diagnostics and fills the formatted view
+// and summary. Inspectors for other standards build segments, transactions,
+// and the envelope themselves and share this last step.
+func Finalize(result *InspectX12Result, renderDiagnostics []edix12.Diagnostic) {
+	result.Diagnostics = append(
+		result.Diagnostics,
+		normalizeRenderDiagnostics(renderDiagnostics, result.Segments)...,
+	)
+	result.Formatted = formatSegments(result.Segments, result.Diagnostics)
+	result.Summary = buildSummary(result)
+}
+
 func DetectSeparators(
 	rawX12 string,
 	envelope *edi.X12EnvelopeSettings,
@@ -24,6 +24,9 @@ const (
 	DiagnosticSourceCondition      = DiagnosticSource("condition")
 	DiagnosticSourceSourceContext  = DiagnosticSource("source_context")
 	DiagnosticSourcePartnerSetting = DiagnosticSource("partner_setting")
+	// DiagnosticSourceImplementationGuide marks violations of a partner's
+	// implementation guide rather than of the base X12 standard.
+	DiagnosticSourceImplementationGuide = DiagnosticSource("implementation_guide")
 )
 
 type InspectX12Request struct {
@@ -32,6 +35,9 @@ type InspectX12Request struct {
 	X12Version     string                   `json:"x12Version"`
 	Envelope       *edi.X12EnvelopeSettings `json:"envelope,omitempty"`
 	Diagnostics    []edix12.Diagnostic      `json:"diagnostics,omitempty"`
+	// Guide, when set, validates each transaction against the partner's
+	// implementation guide and labels matched segments from it.
+	Guide *edi.ImplementationGuideDefinition `json:"-"`
 }
 
 type InspectX12Result struct {
@@ -7,13 +7,15 @@ import (
 )
 
 // Project determines the best legal way for a driver to take a trip departing at
-// in.Departure, evaluating rest strategies ordered from least to most disruptive:
-// drive on current clocks, complete a qualifying split sleeper pairing, take a full
-// 10-hour reset, or take a 34-hour cycle restart. Every applicable strategy is
-// simulated and the one delivering the earliest arrival wins, so a split pairing that
-// avoids an en-route reset beats limping along on depleted clocks. When none works,
-// the result carries the binding clock from the most-rested strategy that was
-// actually available before departure.
+// in.Departure under the ruleset carried in in.Limits, evaluating rest strategies
+// ordered from least to most disruptive: drive on current clocks, complete a
+// qualifying split sleeper pairing, take the ruleset's daily reset, take a Canadian
+// 24-hour off-duty period, or restart the cycle. Every applicable strategy is simulated
+// and the one delivering the earliest arrival wins, so a split pairing that avoids an
+// en-route reset beats limping along on depleted clocks. When a short-haul trip is
+// claimed, each strategy is planned under the exemption wherever the driver can stay
+// within its limits. When none works, the result carries the binding clock from the
+// most-rested strategy that was actually available before departure.
 //
 //nolint:gocritic // a value input keeps the projection API pure; call frequency is low
 func Project(in Input) Result {
@@ -22,22 +24,9 @@ func Project(in Input) Result {
 	}
 
 	e := newEngine(&in)
-	candidates := make([]strategyCandidate, 0, 4)
-	if candidate, ok := e.currentClocks(in.Departure); ok {
-		candidates = append(candidates, candidate)
-	}
-	if candidate, ok := e.splitSleeper(in.Departure); ok {
-		candidates = append(candidates, candidate)
-	}
-	if candidate, ok := e.tenHourReset(in.Departure); ok {
-		candidates = append(candidates, candidate)
-	}
-	if candidate, ok := e.restart34(in.Departure); ok {
-		candidates = append(candidates, candidate)
-	}
-
+	candidates := e.candidates(in.Departure)
 	if len(candidates) == 0 {
-		return infeasibleResult(LimiterShift,
+		return e.infeasibleResult(LimiterShift,
 			"No rest plan can complete before the departure time")
 	}
 
@@ -48,7 +37,13 @@ func Project(in Input) Result {
 	var lastLimiter Limiter
 	var lastCandidate strategyCandidate
 	for _, candidate := range candidates {
-		plan, limiter := PlanTrip(in.TripDriveMs, candidate.clocks, in.Limits)
+		r := e.rules
+		if e.shortHaulApplies(candidate) {
+			r.breakRequired = false
+			candidate.exemption = ExemptionShortHaul
+		}
+
+		plan, limiter := planTrip(in.TripDriveMs, candidate.clocks, in.Limits, &r, 0)
 		if limiter != LimiterNone {
 			lastPlan = plan
 			lastLimiter = limiter
@@ -67,7 +62,8 @@ func Project(in Input) Result {
 		return e.feasibleResult(bestCandidate, bestPlan)
 	}
 
-	result := infeasibleResult(lastLimiter, infeasibleDetail(lastCandidate, lastLimiter))
+	result := e.infeasibleResult(lastLimiter, e.infeasibleDetail(lastCandidate, lastLimiter))
+	result.Exemption = lastCandidate.exemption
 	result.DriveAvailableMs = lastCandidate.clocks.DriveMs
 	result.ShiftAvailableMs = lastCandidate.clocks.ShiftMs
 	result.CycleAvailableMs = lastCandidate.clocks.CycleMs
@@ -75,9 +71,59 @@ func Project(in Input) Result {
 	return result
 }
 
+func (e *engine) candidates(departure int64) []strategyCandidate {
+	strategies := []func(int64) (strategyCandidate, bool){
+		e.currentClocks,
+		e.splitSleeper,
+		e.offDutyReset,
+		e.offDuty24,
+		e.cycleRestart,
+	}
+
+	candidates := make([]strategyCandidate, 0, len(strategies))
+	for _, strategy := range strategies {
+		if candidate, ok := strategy(departure); ok {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+// shortHaulClaimed reports whether the trip may run under the 150 air-mile short-haul
+// exemption at all: it was claimed, the ruleset recognises it, and the trip stays
+// inside the radius. Canada has its own radius rules, which are not modelled.
+func (e *engine) shortHaulClaimed() bool {
+	return e.in.ShortHaul != nil && e.rules.shortHaul &&
+		e.in.ShortHaul.MaxRadiusMiles/statuteMilesPerAirMile <= shortHaulRadiusAirMiles
+}
+
+// shortHaulApplies reports whether the driver, starting from the candidate's clocks,
+// can drive the trip and back to the reporting location inside the exemption's
+// 14-hour window with no en-route reset. The shift clock is already counting down
+// that window; rulesets with a longer shift give up the difference.
+func (e *engine) shortHaulApplies(candidate strategyCandidate) bool {
+	if !e.shortHaulClaimed() {
+		return false
+	}
+
+	r := e.rules
+	r.breakRequired = false
+	plan, limiter := planTrip(
+		e.in.TripDriveMs+e.in.ShortHaul.ReturnDriveMs,
+		candidate.clocks,
+		e.in.Limits,
+		&r,
+		0,
+	)
+	window := candidate.clocks.ShiftMs - max(e.in.Limits.ShiftMs-shortHaulWindowMs, 0)
+	return limiter == LimiterNone && plan.Resets == 0 && plan.TotalMs <= window
+}
+
 func (e *engine) feasibleResult(candidate strategyCandidate, plan TripPlan) Result {
 	return Result{
 		Feasible:          true,
+		Ruleset:           e.ruleset(),
+		Exemption:         candidate.exemption,
 		Strategy:          candidate.strategy,
 		Limiter:           LimiterNone,
 		DriveAvailableMs:  candidate.clocks.DriveMs,
@@ -89,12 +135,21 @@ func (e *engine) feasibleResult(candidate strategyCandidate, plan TripPlan) Resu
 	}
 }
 
-func infeasibleResult(limiter Limiter, detail string) Result {
+// ruleset is the ruleset the projection ran under, with the zero value spelled out.
+func (e *engine) ruleset() Ruleset {
+	if _, ok := rulesets[e.in.Limits.Ruleset]; ok {
+		return e.in.Limits.Ruleset
+	}
+	return RulesetUSProperty
+}
+
+func (e *engine) infeasibleResult(limiter Limiter, detail string) Result {
 	return Result{
 		Feasible: false,
+		Ruleset:  e.ruleset(),
 		Strategy: "",
 		Limiter:  limiter,
-		Detail:   detail,
+		Detail:   detail + e.rulesetNote(),
 	}
 }
 
@@ -107,13 +162,17 @@ func (e *engine) feasibleDetail(candidate strategyCandidate, plan TripPlan) stri
 		lead = "Feasible on current clocks"
 	case StrategySplitSleeper:
 		lead = "Feasible with a split sleeper berth pairing"
+	case StrategyEightHourReset:
+		lead = "Feasible after an 8-hour reset"
 	case StrategyTenHourReset:
 		lead = "Feasible after a 10-hour reset"
-	case StrategyRestart34:
-		lead = "Feasible after a 34-hour cycle restart"
+	case StrategyOffDuty24:
+		lead = "Feasible after a 24-hour off-duty period"
+	case StrategyRestart24, StrategyRestart34, StrategyRestart36, StrategyRestart72:
+		lead = fmt.Sprintf("Feasible after a %s cycle restart", hoursLabel(e.rules.restartMs))
 	}
 
-	detail := fmt.Sprintf("%s; %s of clock left after the trip", lead, margin)
+	detail := fmt.Sprintf("%s%s; %s of clock left after the trip", lead, e.rulesetNote(), margin)
 	if candidate.restStartDeadline > e.in.Now {
 		detail += fmt.Sprintf(", rest must start within %s",
 			timeutils.FormatDurationMs((candidate.restStartDeadline-e.in.Now)*1000))
@@ -121,12 +180,43 @@ func (e *engine) feasibleDetail(candidate strategyCandidate, plan TripPlan) stri
 	if plan.Breaks > 0 || plan.Resets > 0 {
 		detail += fmt.Sprintf(" (%s en route)", enRouteRests(plan))
 	}
+	if plan.DeferredMs > 0 {
+		detail += fmt.Sprintf("; %s of daily off-duty time deferred to the next day",
+			timeutils.FormatDurationMs(plan.DeferredMs))
+	}
+	detail += e.shortHaulNote(candidate)
 	if !e.hasTimeline() && candidate.strategy != StrategyCurrentClocks {
 		detail += "; no duty log history, projected from clocks only"
 	}
 	return detail
 }
 
+// rulesetNote names the ruleset in details for anything but US interstate property,
+// which dispatchers take as read.
+func (e *engine) rulesetNote() string {
+	if e.ruleset() == RulesetUSProperty {
+		return ""
+	}
+	return " under " + e.rules.label + " rules"
+}
+
+func (e *engine) shortHaulNote(candidate strategyCandidate) string {
+	switch {
+	case e.in.ShortHaul == nil || !e.rules.shortHaul:
+		return ""
+	case candidate.exemption == ExemptionShortHaul:
+		return "; 150 air-mile short-haul exemption, no 30-minute break required"
+	case !e.shortHaulClaimed():
+		return "; short-haul exemption lost, the trip leaves the 150 air-mile radius"
+	default:
+		return "; short-haul exemption lost, the driver cannot be back within 14 hours"
+	}
+}
+
+func hoursLabel(ms int64) string {
+	return fmt.Sprintf("%d-hour", ms/hourMs)
+}
+
 func enRouteRests(plan TripPlan) string {
 	switch {
 	case plan.Breaks > 0 && plan.Resets > 0:
@@ -142,18 +232,30 @@ func enRouteRests(plan TripPlan) string {
 	}
 }
 
-func infeasibleDetail(candidate strategyCandidate, limiter Limiter) string {
+func (e *engine) infeasibleDetail(candidate strategyCandidate, limiter Limiter) string {
 	switch limiter {
 	case LimiterCycle:
-		if candidate.strategy == StrategyRestart34 {
-			return "60/70-hour cycle exhausted for this trip even after a 34-hour restart"
+		cycle := hoursLabel(e.in.Limits.CycleMs)
+		restart := hoursLabel(e.rules.restartMs)
+		switch {
+		case e.rules.restartMs == 0:
+			return cycle + " cycle exhausted; this ruleset has no restart, so hours only" +
+				" return as old duty days roll off"
+		case candidate.strategy == e.rules.restartStrategy:
+			return fmt.Sprintf("%s cycle exhausted for this trip even after a %s restart",
+				cycle, restart)
+		default:
+			return fmt.Sprintf("%s cycle exhausted; a %s restart cannot complete before departure",
+				cycle, restart)
 		}
-		return "60/70-hour cycle exhausted; a 34-hour restart cannot complete before departure"
 	case LimiterDrive:
 		return "Not enough drive hours for this trip under any rest plan that fits before departure"
+	case LimiterOnDuty:
+		return "The " + hoursLabel(e.in.Limits.OnDutyMs) + " on-duty limit cannot cover this" +
+			" trip under any rest plan that fits before departure"
 	case LimiterShift:
-		return "The 14-hour window cannot cover this trip under any rest plan" +
-			" that fits before departure"
+		return "The " + hoursLabel(e.in.Limits.ShiftMs) + " window cannot cover this trip" +
+			" under any rest plan that fits before departure"
 	case LimiterBreak:
 		return "Required 30-minute break cannot be satisfied for this trip"
 	case LimiterNone:
@@ -285,16 +285,10 @@ func TestProject_CycleRecoversAsOldDutyDaysAgeOut(t *testing.T) {
 	assert.Equal(t, hoursMs(70), result.CycleAvailableMs)
 }
 
-func TestProject_CanadianJurisdictionSkipsSplitAndRestart(t *testing.T) {
+func TestProject_CanadianCycle1ResetsAfter36HoursOff(t *testing.T) {
 	t.Parallel()
 
-	limits := Limits{
-		DriveMs: 13 * hourMs,
-		ShiftMs: 16 * hourMs,
-		CycleMs: 70 * hourMs,
-		BreakMs: 8 * hourMs,
-	}
-	result := Project(Input{
+	input := Input{
 		Now:         baseTime,
 		Departure:   baseTime + hoursSec(40),
 		TripDriveMs: hoursMs(8),
@@ -306,12 +300,21 @@ func TestProject_CanadianJurisdictionSkipsSplitAndRestart(t *testing.T) {
 		},
 		ClocksAt:     baseTime,
 		DutyStatus:   telematics.DutyStatusOffDuty,
-		Limits:       limits,
+		Limits:       LimitsForRuleset("Canada 70 hour / 7 day", "Canada South", "CS"),
 		Jurisdiction: "CS",
-	})
+	}
 
-	require.False(t, result.Feasible)
+	result := Project(input)
+	require.True(t, result.Feasible)
+	assert.Equal(t, RulesetCanadaCycle1, result.Ruleset)
+	assert.Equal(t, StrategyRestart36, result.Strategy)
+	assert.Contains(t, result.Detail, "36-hour cycle restart under Canada cycle 1 rules")
+
+	input.Departure = baseTime + hoursSec(34)
+	result = Project(input)
+	require.False(t, result.Feasible, "a US 34-hour restart does not reset a Canadian cycle")
 	assert.Equal(t, LimiterCycle, result.Limiter)
+	assert.Contains(t, result.Detail, "36-hour restart cannot complete")
 }
 
 func TestProject_PastDepartureIsClampedToNow(t *testing.T) {
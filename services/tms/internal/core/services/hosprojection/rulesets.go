@@ -3,40 +3,249 @@ package hosprojection
 import (
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 var cycleHoursPattern = regexp.MustCompile(`(\d+)\s*hour`)
 
-// LimitsForRuleset derives clock ceilings from the provider's free-text ruleset labels
-// (e.g. "70 hour/8 day", "US Interstate Property", jurisdiction "CS"/"CN"), falling
-// back to US interstate property limits when the labels don't say otherwise.
+// Ruleset names the hours-of-service regulation a projection runs under. The zero
+// value is US interstate property, which is what the engine assumed before rulesets
+// were first-class.
+type Ruleset string
+
+const (
+	RulesetUSProperty      = Ruleset("usInterstateProperty")
+	RulesetUSPassenger     = Ruleset("usInterstatePassenger")
+	RulesetTexasIntrastate = Ruleset("texasIntrastate")
+	RulesetAlaskaProperty  = Ruleset("alaskaProperty")
+	RulesetCanadaCycle1    = Ruleset("canadaCycle1")
+	RulesetCanadaCycle2    = Ruleset("canadaCycle2")
+)
+
+// Exemption names a regulatory exemption the projection relied on.
+type Exemption string
+
+const (
+	ExemptionNone      = Exemption("")
+	ExemptionShortHaul = Exemption("shortHaul150")
+)
+
+// rules is everything about a ruleset beyond its clock ceilings: how the driver
+// regains hours, whether the 30-minute break applies, and the Canadian extras.
+type rules struct {
+	label string
+
+	// resetMs is the consecutive off-duty time that restores the drive and shift clocks
+	// before departure. Off-duty time owed per day beyond it (dailyOffDutyMs) is taken
+	// with every en-route rest, less up to deferralMs pushed onto the next one.
+	resetMs        int64
+	resetStrategy  Strategy
+	dailyOffDutyMs int64
+	deferralMs     int64
+
+	// restartMs is the off-duty time that restarts the cycle; zero means the ruleset has
+	// no restart and the cycle is only regained as old duty days roll out of the window.
+	restartMs       int64
+	restartStrategy Strategy
+
+	// The cycle is measured over shortWindowSec when its ceiling is at most shortCycleMs
+	// and over longWindowSec otherwise.
+	shortCycleMs   int64
+	shortWindowSec int64
+	longWindowSec  int64
+
+	// longRestMs must have been taken within longRestWithinSec of driving; under cycle 2
+	// no more than onDutyBeforeLongRestMs may be worked without one either.
+	longRestMs             int64
+	longRestWithinSec      int64
+	onDutyBeforeLongRestMs int64
+
+	breakRequired bool
+	splitSleeper  bool
+	shortHaul     bool
+}
+
+var rulesets = map[Ruleset]rules{
+	RulesetUSProperty: {
+		label:           "US interstate property",
+		resetMs:         resetRestMs,
+		resetStrategy:   StrategyTenHourReset,
+		restartMs:       restartMs,
+		restartStrategy: StrategyRestart34,
+		shortCycleMs:    sixtyHourCycleMs,
+		shortWindowSec:  sevenDayWindowSec,
+		longWindowSec:   eightDayWindowSec,
+		breakRequired:   true,
+		splitSleeper:    true,
+		shortHaul:       true,
+	},
+	// 49 CFR 395.5: 10 hours driving and 15 on duty after 8 consecutive hours off. The
+	// 34-hour restart and the 30-minute break are property-carrier provisions.
+	RulesetUSPassenger: {
+		label:          "US interstate passenger",
+		resetMs:        8 * hourMs,
+		resetStrategy:  StrategyEightHourReset,
+		shortCycleMs:   sixtyHourCycleMs,
+		shortWindowSec: sevenDayWindowSec,
+		longWindowSec:  eightDayWindowSec,
+		shortHaul:      true,
+	},
+	// Texas Administrative Code 4.12: 12 hours driving, 15 on duty after 8 consecutive
+	// hours off, 70 hours in 7 days with a 34-hour restart.
+	RulesetTexasIntrastate: {
+		label:           "Texas intrastate",
+		resetMs:         8 * hourMs,
+		resetStrategy:   StrategyEightHourReset,
+		restartMs:       restartMs,
+		restartStrategy: StrategyRestart34,
+		shortCycleMs:    70 * hourMs,
+		shortWindowSec:  sevenDayWindowSec,
+		longWindowSec:   sevenDayWindowSec,
+		shortHaul:       true,
+	},
+	// 49 CFR 395.1(h): 15 hours driving and 20 on duty after 10 consecutive hours off,
+	// 70/7 or 80/8, restarted by 24 consecutive hours off, in lieu of 395.3 so no break.
+	RulesetAlaskaProperty: {
+		label:           "Alaska property",
+		resetMs:         resetRestMs,
+		resetStrategy:   StrategyTenHourReset,
+		restartMs:       24 * hourMs,
+		restartStrategy: StrategyRestart24,
+		shortCycleMs:    70 * hourMs,
+		shortWindowSec:  sevenDayWindowSec,
+		longWindowSec:   eightDayWindowSec,
+		shortHaul:       true,
+	},
+	// SOR/2005-313 ss. 12-16, 25-29: a work shift starts after 8 consecutive hours off,
+	// 10 hours off are owed per day of which 2 may be deferred to the next, a 24-hour
+	// off-duty period is required every 14 days, and cycle 1 resets after 36 hours off.
+	RulesetCanadaCycle1: {
+		label:             "Canada cycle 1",
+		resetMs:           8 * hourMs,
+		resetStrategy:     StrategyEightHourReset,
+		dailyOffDutyMs:    canadaDailyOffDutyMs,
+		deferralMs:        canadaDeferralMs,
+		restartMs:         36 * hourMs,
+		restartStrategy:   StrategyRestart36,
+		shortCycleMs:      80 * hourMs,
+		shortWindowSec:    sevenDayWindowSec,
+		longWindowSec:     sevenDayWindowSec,
+		longRestMs:        24 * hourMs,
+		longRestWithinSec: fourteenDayWindowSec,
+	},
+	// Cycle 2 is 120 hours in 14 days, with 24 consecutive hours off required before
+	// 70 hours on duty accumulate, and resets after 72 hours off.
+	RulesetCanadaCycle2: {
+		label:                  "Canada cycle 2",
+		resetMs:                8 * hourMs,
+		resetStrategy:          StrategyEightHourReset,
+		dailyOffDutyMs:         canadaDailyOffDutyMs,
+		deferralMs:             canadaDeferralMs,
+		restartMs:              72 * hourMs,
+		restartStrategy:        StrategyRestart72,
+		shortCycleMs:           120 * hourMs,
+		shortWindowSec:         fourteenDayWindowSec,
+		longWindowSec:          fourteenDayWindowSec,
+		longRestMs:             24 * hourMs,
+		longRestWithinSec:      fourteenDayWindowSec,
+		onDutyBeforeLongRestMs: 70 * hourMs,
+	},
+}
+
+func rulesFor(ruleset Ruleset) rules {
+	if r, ok := rulesets[ruleset]; ok {
+		return r
+	}
+	return rulesets[RulesetUSProperty]
+}
+
+// Label is the ruleset's dispatcher-readable name.
+func (r Ruleset) Label() string {
+	return rulesFor(r).label
+}
+
+// cycleWindowSec is the rolling window a cycle with the given ceiling is measured over.
+func (r *rules) cycleWindowSec(cycleMs int64) int64 {
+	if cycleMs <= r.shortCycleMs {
+		return r.shortWindowSec
+	}
+	return r.longWindowSec
+}
+
+// enRouteRestMs is the off-duty time taken at each en-route rest when nothing is
+// deferred: the consecutive reset, topped up to the daily off-duty requirement.
+func (r *rules) enRouteRestMs() int64 {
+	return max(r.resetMs, r.dailyOffDutyMs)
+}
+
+// LimitsForRuleset derives the ruleset and its clock ceilings from the provider's
+// free-text labels (e.g. cycle "70 hour/8 day", shift "US Interstate Property",
+// jurisdiction "CS"/"CN"), falling back to US interstate property when the labels
+// don't say otherwise. The cycle ceiling follows the hours in the cycle label.
 func LimitsForRuleset(cycle, shift, jurisdiction string) Limits {
+	ruleset := rulesetFor(cycle, shift, jurisdiction)
 	limits := Limits{
+		Ruleset: ruleset,
 		DriveMs: standardDriveMs,
 		ShiftMs: 14 * hourMs,
 		CycleMs: 70 * hourMs,
 		BreakMs: 8 * hourMs,
 	}
 
-	if match := cycleHoursPattern.FindStringSubmatch(cycle); len(match) == 2 {
-		if hours, err := strconv.ParseInt(match[1], 10, 64); err == nil && hours > 0 {
-			limits.CycleMs = hours * hourMs
-		}
-	}
-
-	switch shift {
-	case "US Interstate Passenger":
+	switch ruleset {
+	case RulesetUSPassenger:
 		limits.DriveMs = 10 * hourMs
 		limits.ShiftMs = 15 * hourMs
-	case "Texas Intrastate":
+	case RulesetTexasIntrastate:
 		limits.DriveMs = 12 * hourMs
 		limits.ShiftMs = 15 * hourMs
-	}
-
-	if jurisdiction == canadaSouthJurisCS || jurisdiction == canadaNorthJurisCN {
+	case RulesetAlaskaProperty:
+		limits.DriveMs = 15 * hourMs
+		limits.ShiftMs = 20 * hourMs
+	case RulesetCanadaCycle1, RulesetCanadaCycle2:
+		// A work shift allows 13 hours of driving and 14 on duty inside a 16-hour
+		// elapsed window; north of 60 it is 15, 18 and 20.
 		limits.DriveMs = 13 * hourMs
+		limits.OnDutyMs = 14 * hourMs
 		limits.ShiftMs = 16 * hourMs
+		if jurisdiction == canadaNorthJurisCN {
+			limits.DriveMs = 15 * hourMs
+			limits.OnDutyMs = 18 * hourMs
+			limits.ShiftMs = 20 * hourMs
+		}
+		if ruleset == RulesetCanadaCycle2 {
+			limits.CycleMs = 120 * hourMs
+		}
+	case RulesetUSProperty:
+	}
+
+	if match := cycleHoursPattern.FindStringSubmatch(cycle); len(match) == 2 {
+		if hours, err := strconv.ParseInt(match[1], 10, 64); err == nil && hours > 0 {
+			limits.CycleMs = hours * hourMs
+		}
 	}
 
 	return limits
 }
+
+func rulesetFor(cycle, shift, jurisdiction string) Ruleset {
+	cycleLabel := strings.ToLower(cycle)
+	shiftLabel := strings.ToLower(shift)
+
+	switch {
+	case jurisdiction == canadaSouthJurisCS || jurisdiction == canadaNorthJurisCN ||
+		strings.Contains(cycleLabel, "canada") || strings.Contains(shiftLabel, "canada"):
+		if strings.Contains(cycleLabel, "cycle 2") || strings.Contains(cycleLabel, "14 day") {
+			return RulesetCanadaCycle2
+		}
+		return RulesetCanadaCycle1
+	case jurisdiction == alaskaJurisAK || strings.Contains(shiftLabel, "alaska"):
+		return RulesetAlaskaProperty
+	case strings.Contains(shiftLabel, "texas"):
+		return RulesetTexasIntrastate
+	case strings.Contains(shiftLabel, "passenger"):
+		return RulesetUSPassenger
+	default:
+		return RulesetUSProperty
+	}
+}
@@ -0,0 +1,451 @@
+package hosprojection
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/telematics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitsForRuleset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cycle        string
+		shift        string
+		jurisdiction string
+		want         Limits
+	}{
+		{
+			name: "unlabelled falls back to US property",
+			want: rulesetLimits(RulesetUSProperty, 11, 14, 70),
+		},
+		{
+			name:  "US property 60/7",
+			cycle: "USA 60 hour / 7 day",
+			shift: "US Interstate Property",
+			want:  rulesetLimits(RulesetUSProperty, 11, 14, 60),
+		},
+		{
+			name:  "US passenger",
+			cycle: "USA 70 hour / 8 day",
+			shift: "US Interstate Passenger",
+			want:  rulesetLimits(RulesetUSPassenger, 10, 15, 70),
+		},
+		{
+			name:  "Texas intrastate",
+			cycle: "Texas 70 hour / 7 day",
+			shift: "Texas Intrastate",
+			want:  rulesetLimits(RulesetTexasIntrastate, 12, 15, 70),
+		},
+		{
+			name:  "Alaska 80/8",
+			cycle: "Alaska 80 hour / 8 day",
+			shift: "Alaska Property",
+			want:  rulesetLimits(RulesetAlaskaProperty, 15, 20, 80),
+		},
+		{
+			name:         "Canada south cycle 1",
+			cycle:        "Canada 70 hour / 7 day",
+			shift:        "Canada South",
+			jurisdiction: "CS",
+			want:         canadaLimits(RulesetCanadaCycle1, 13, 14, 16, 70),
+		},
+		{
+			name:         "Canada south cycle 2",
+			cycle:        "Canada 120 hour / 14 day",
+			shift:        "Canada South",
+			jurisdiction: "CS",
+			want:         canadaLimits(RulesetCanadaCycle2, 13, 14, 16, 120),
+		},
+		{
+			name:         "Canada north cycle 1",
+			cycle:        "Canada North Cycle 1 (80 hour / 7 day)",
+			jurisdiction: "CN",
+			want:         canadaLimits(RulesetCanadaCycle1, 15, 18, 20, 80),
+		},
+		{
+			name:         "Canada cycle 2 without hours in the label",
+			cycle:        "Canada Cycle 2",
+			jurisdiction: "CS",
+			want:         canadaLimits(RulesetCanadaCycle2, 13, 14, 16, 120),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, LimitsForRuleset(tt.cycle, tt.shift, tt.jurisdiction))
+		})
+	}
+}
+
+func rulesetLimits(ruleset Ruleset, driveHours, shiftHours, cycleHours int64) Limits {
+	return Limits{
+		Ruleset: ruleset,
+		DriveMs: driveHours * hourMs,
+		ShiftMs: shiftHours * hourMs,
+		CycleMs: cycleHours * hourMs,
+		BreakMs: 8 * hourMs,
+	}
+}
+
+func canadaLimits(ruleset Ruleset, driveHours, onDutyHours, shiftHours, cycleHours int64) Limits {
+	limits := rulesetLimits(ruleset, driveHours, shiftHours, cycleHours)
+	limits.OnDutyMs = onDutyHours * hourMs
+	return limits
+}
+
+func canadaSouthLimits(cycle string) Limits {
+	return LimitsForRuleset(cycle, "Canada South", canadaSouthJurisCS)
+}
+
+func TestPlanTrip_CanadaDefersTwoHoursOfDailyOffDuty(t *testing.T) {
+	t.Parallel()
+
+	limits := canadaSouthLimits("Canada 70 hour / 7 day")
+
+	plan, limiter := PlanTrip(hoursMs(20), limits.Full(), limits)
+
+	require.Equal(t, LimiterNone, limiter)
+	assert.Zero(t, plan.Breaks, "Canada has no 30-minute break rule")
+	assert.Equal(t, 1, plan.Resets)
+	assert.Equal(t, hoursMs(28), plan.TotalMs, "13h, an 8-hour rest, then 7h")
+	assert.Equal(t, hoursMs(2), plan.DeferredMs)
+}
+
+func TestPlanTrip_CanadaOnDutyLimitBindsBeforeTheDriveClock(t *testing.T) {
+	t.Parallel()
+
+	limits := canadaSouthLimits("Canada 70 hour / 7 day")
+	start := limits.Full()
+	start.OnDutyMs = hoursMs(3)
+
+	plan, limiter := PlanTrip(hoursMs(5), start, limits)
+
+	require.Equal(t, LimiterNone, limiter)
+	assert.Equal(t, 1, plan.Resets)
+	assert.Equal(t, hoursMs(13), plan.TotalMs, "3h to the 14-hour limit, an 8-hour rest, then 2h")
+	assert.Equal(t, hoursMs(12), plan.End.OnDutyMs)
+}
+
+func TestProject_CanadaCountsNonDrivingDutyAgainstTheOnDutyLimit(t *testing.T) {
+	t.Parallel()
+
+	limits := canadaSouthLimits("Canada 70 hour / 7 day")
+	input := Input{
+		Now:         baseTime,
+		Departure:   baseTime,
+		TripDriveMs: hoursMs(4),
+		Clocks: Clocks{
+			DriveMs:  hoursMs(13),
+			OnDutyMs: limits.OnDutyRemaining(nil, baseTime, hoursMs(13)),
+			ShiftMs:  hoursMs(5),
+			CycleMs:  hoursMs(50),
+			BreakMs:  hoursMs(8),
+		},
+		ClocksAt:     baseTime,
+		DutyStatus:   telematics.DutyStatusOnDuty,
+		Limits:       limits,
+		Jurisdiction: canadaSouthJurisCS,
+		Timeline: []DutyInterval{
+			interval(telematics.DutyStatusOffDuty, 22, 11),
+			interval(telematics.DutyStatusOnDuty, 11, 0),
+		},
+	}
+
+	result := Project(input)
+
+	require.True(t, result.Feasible)
+	assert.Equal(t, StrategyCurrentClocks, result.Strategy)
+	assert.Equal(t, 1, result.Trip.Resets, "11h on duty leaves 3h before the 14-hour limit")
+	assert.Equal(t, hoursMs(12), result.Trip.TotalMs, "3h, an 8-hour rest, then 1h")
+}
+
+func TestLimits_OnDutyRemainingSumsTheLogsSinceTheReset(t *testing.T) {
+	t.Parallel()
+
+	limits := canadaSouthLimits("Canada 70 hour / 7 day")
+
+	t.Run("from the reset that started the shift", func(t *testing.T) {
+		t.Parallel()
+
+		timeline := []DutyInterval{
+			interval(telematics.DutyStatusOffDuty, 20, 10),
+			interval(telematics.DutyStatusOnDuty, 10, 6),
+			interval(telematics.DutyStatusDriving, 6, 5),
+			interval(telematics.DutyStatusOffDuty, 5, 4),
+			interval(telematics.DutyStatusOnDuty, 4, 0),
+		}
+
+		assert.Equal(t, hoursMs(5), limits.OnDutyRemaining(timeline, baseTime, hoursMs(12)),
+			"9h on duty since the rest, though only 1h of it was driving")
+	})
+
+	t.Run("at least what the logs hold without a reset in them", func(t *testing.T) {
+		t.Parallel()
+
+		timeline := []DutyInterval{
+			interval(telematics.DutyStatusOnDuty, 6, 0),
+		}
+
+		assert.Equal(t, hoursMs(8), limits.OnDutyRemaining(timeline, baseTime, hoursMs(12)))
+		assert.Equal(t, hoursMs(4), limits.OnDutyRemaining(timeline, baseTime, hoursMs(3)),
+			"the drive hours used bound it when they exceed the logs")
+	})
+
+	t.Run("from the drive hours without logs", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, hoursMs(13), limits.OnDutyRemaining(nil, baseTime, hoursMs(12)))
+	})
+
+	t.Run("zero without a separate on-duty limit", func(t *testing.T) {
+		t.Parallel()
+
+		us := LimitsForRuleset("", "", "")
+		assert.Zero(t, us.OnDutyRemaining(nil, baseTime, hoursMs(5)))
+	})
+}
+
+func TestPlanTransit_CanadaRepaysDeferredOffDutyAtTheNextRest(t *testing.T) {
+	t.Parallel()
+
+	limits := canadaSouthLimits("Canada 120 hour / 14 day")
+
+	plan, limiter := PlanTransit(hoursMs(35), limits.Full(), limits)
+
+	require.Equal(t, LimiterNone, limiter)
+	assert.Equal(t, 2, plan.Resets)
+	assert.Equal(t, hoursMs(13+8+13+12+9), plan.TotalMs)
+	assert.Zero(t, plan.DeferredMs)
+}
+
+func TestReset_UsesTheRulesetDailyRest(t *testing.T) {
+	t.Parallel()
+
+	_, usRest := Reset(Clocks{}, LimitsForRuleset("", "", ""))
+	_, passengerRest := Reset(Clocks{}, LimitsForRuleset("", "US Interstate Passenger", ""))
+	_, canadaRest := Reset(Clocks{}, canadaSouthLimits("Canada 70 hour / 7 day"))
+
+	assert.Equal(t, hoursSec(10), usRest)
+	assert.Equal(t, hoursSec(8), passengerRest)
+	assert.Equal(t, hoursSec(10), canadaRest, "8 consecutive hours plus the daily top-up")
+}
+
+// dailyRuns builds days of drivingHours on duty followed by rest up to the next day,
+// ending at baseTime, so no rest ever reaches 24 hours.
+func dailyRuns(days int, drivingHours float64) []DutyInterval {
+	timeline := make([]DutyInterval, 0, days*2)
+	for day := days; day > 0; day-- {
+		start := float64(day * 24)
+		timeline = append(timeline,
+			interval(telematics.DutyStatusDriving, start, start-drivingHours),
+			interval(telematics.DutyStatusOffDuty, start-drivingHours, start-24),
+		)
+	}
+	return timeline
+}
+
+func TestProject_CanadaRequires24HoursOffEvery14Days(t *testing.T) {
+	t.Parallel()
+
+	input := Input{
+		Now:         baseTime,
+		Departure:   baseTime + hoursSec(6),
+		TripDriveMs: hoursMs(4),
+		Clocks: Clocks{
+			DriveMs: hoursMs(13),
+			ShiftMs: hoursMs(16),
+			CycleMs: hoursMs(28),
+			BreakMs: hoursMs(8),
+		},
+		ClocksAt:     baseTime,
+		DutyStatus:   telematics.DutyStatusOffDuty,
+		Limits:       canadaSouthLimits("Canada 70 hour / 7 day"),
+		Jurisdiction: canadaSouthJurisCS,
+		Timeline:     dailyRuns(15, 4),
+	}
+
+	result := Project(input)
+	require.True(t, result.Feasible)
+	assert.Equal(t, StrategyOffDuty24, result.Strategy)
+	assert.Contains(t, result.Detail, "24-hour off-duty period")
+
+	input.Departure = baseTime + hoursSec(2)
+	result = Project(input)
+	assert.False(t, result.Feasible, "the 20-hour rest cannot become 24 hours in time")
+}
+
+func TestProject_CanadaCycle2CapsOnDutyBetween24HourRests(t *testing.T) {
+	t.Parallel()
+
+	timeline := append(
+		[]DutyInterval{interval(telematics.DutyStatusOffDuty, 8*24+30, 8*24)},
+		dailyRuns(8, 10)...,
+	)
+	input := Input{
+		Now:         baseTime,
+		Departure:   baseTime,
+		TripDriveMs: hoursMs(4),
+		Clocks: Clocks{
+			DriveMs: hoursMs(13),
+			ShiftMs: hoursMs(16),
+			CycleMs: hoursMs(100),
+			BreakMs: hoursMs(8),
+		},
+		ClocksAt:     baseTime,
+		DutyStatus:   telematics.DutyStatusOffDuty,
+		Limits:       canadaSouthLimits("Canada 120 hour / 14 day"),
+		Jurisdiction: canadaSouthJurisCS,
+		Timeline:     timeline,
+	}
+
+	result := Project(input)
+	require.False(t, result.Feasible, "80 hours worked since the last 24 hours off")
+	assert.Equal(t, LimiterCycle, result.Limiter)
+
+	input.Departure = baseTime + hoursSec(10)
+	result = Project(input)
+	require.True(t, result.Feasible)
+	assert.Equal(t, StrategyOffDuty24, result.Strategy)
+	assert.Equal(t, hoursMs(70), result.CycleAvailableMs)
+}
+
+func TestProject_AlaskaHasNoBreakAndRestartsAfter24Hours(t *testing.T) {
+	t.Parallel()
+
+	limits := LimitsForRuleset("Alaska 80 hour / 8 day", "Alaska Property", "")
+	input := Input{
+		Now:         baseTime,
+		Departure:   baseTime,
+		TripDriveMs: hoursMs(14),
+		Clocks:      limits.Full(),
+		ClocksAt:    baseTime,
+		DutyStatus:  telematics.DutyStatusOffDuty,
+		Limits:      limits,
+	}
+
+	result := Project(input)
+	require.True(t, result.Feasible)
+	assert.Equal(t, RulesetAlaskaProperty, result.Ruleset)
+	assert.Zero(t, result.Trip.Breaks)
+	assert.Equal(t, hoursMs(14), result.Trip.TotalMs)
+
+	input.Clocks.CycleMs = hoursMs(2)
+	input.Departure = baseTime + hoursSec(24)
+	result = Project(input)
+	require.True(t, result.Feasible)
+	assert.Equal(t, StrategyRestart24, result.Strategy)
+}
+
+func TestProject_PassengerCarriersCannotRestart(t *testing.T) {
+	t.Parallel()
+
+	limits := LimitsForRuleset("USA 70 hour / 8 day", "US Interstate Passenger", "")
+	clocks := limits.Full()
+	clocks.CycleMs = hoursMs(1)
+
+	result := Project(Input{
+		Now:         baseTime,
+		Departure:   baseTime + hoursSec(48),
+		TripDriveMs: hoursMs(4),
+		Clocks:      clocks,
+		ClocksAt:    baseTime,
+		DutyStatus:  telematics.DutyStatusOffDuty,
+		Limits:      limits,
+	})
+
+	require.False(t, result.Feasible)
+	assert.Equal(t, RulesetUSPassenger, result.Ruleset)
+	assert.Contains(t, result.Detail, "no restart")
+}
+
+func TestProject_ShortHaulExemption(t *testing.T) {
+	t.Parallel()
+
+	limits := LimitsForRuleset("", "", "")
+	input := Input{
+		Now:         baseTime,
+		Departure:   baseTime,
+		TripDriveMs: hoursMs(9),
+		Clocks:      limits.Full(),
+		ClocksAt:    baseTime,
+		DutyStatus:  telematics.DutyStatusOffDuty,
+		Limits:      limits,
+	}
+
+	tests := []struct {
+		name          string
+		shortHaul     *ShortHaulTrip
+		wantExemption Exemption
+		wantBreaks    int
+		wantDetail    string
+	}{
+		{
+			name:       "not claimed",
+			wantBreaks: 1,
+		},
+		{
+			name:          "inside the radius and back within 14 hours",
+			shortHaul:     &ShortHaulTrip{MaxRadiusMiles: 120, ReturnDriveMs: hoursMs(2)},
+			wantExemption: ExemptionShortHaul,
+			wantDetail:    "no 30-minute break required",
+		},
+		{
+			name:       "beyond 150 air miles",
+			shortHaul:  &ShortHaulTrip{MaxRadiusMiles: 200, ReturnDriveMs: hoursMs(2)},
+			wantBreaks: 1,
+			wantDetail: "leaves the 150 air-mile radius",
+		},
+		{
+			name:       "too long a day to get back",
+			shortHaul:  &ShortHaulTrip{MaxRadiusMiles: 120, ReturnDriveMs: hoursMs(6)},
+			wantBreaks: 1,
+			wantDetail: "cannot be back within 14 hours",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			in := input
+			in.ShortHaul = tt.shortHaul
+
+			result := Project(in)
+
+			require.True(t, result.Feasible)
+			assert.Equal(t, RulesetUSProperty, result.Ruleset)
+			assert.Equal(t, tt.wantExemption, result.Exemption)
+			assert.Equal(t, tt.wantBreaks, result.Trip.Breaks)
+			if tt.wantDetail != "" {
+				assert.Contains(t, result.Detail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestProject_CanadaIgnoresTheUSShortHaulExemption(t *testing.T) {
+	t.Parallel()
+
+	limits := canadaSouthLimits("Canada 70 hour / 7 day")
+	result := Project(Input{
+		Now:          baseTime,
+		Departure:    baseTime,
+		TripDriveMs:  hoursMs(3),
+		Clocks:       limits.Full(),
+		ClocksAt:     baseTime,
+		DutyStatus:   telematics.DutyStatusOffDuty,
+		Limits:       limits,
+		Jurisdiction: canadaSouthJurisCS,
+		ShortHaul:    &ShortHaulTrip{MaxRadiusMiles: 50},
+	})
+
+	require.True(t, result.Feasible)
+	assert.Equal(t, ExemptionNone, result.Exemption)
+	assert.NotContains(t, result.Detail, "short-haul")
+}
@@ -1,46 +1,69 @@
 package hosprojection
 
-// strategyCandidate is one rest plan with the clocks it yields at departure.
+import "slices"
+
+// strategyCandidate is one rest plan with the clocks it yields at departure, and the
+// exemption the trip was planned under once the candidate has been evaluated.
 type strategyCandidate struct {
 	strategy          Strategy
 	clocks            Clocks
 	restStartDeadline int64
+	exemption         Exemption
 }
 
 type engine struct {
-	in   Input
-	runs []restRun
+	in    Input
+	rules rules
+	runs  []restRun
 }
 
 func newEngine(in *Input) *engine {
-	return &engine{in: *in, runs: restRuns(in.Timeline, in.Now)}
+	return &engine{
+		in:    *in,
+		rules: rulesFor(in.Limits.Ruleset),
+		runs:  restRuns(in.Timeline, in.Now),
+	}
 }
 
 func (e *engine) hasTimeline() bool { return len(e.in.Timeline) > 0 }
 
 // cycleWindowSec is the rolling window the driver's cycle is measured over.
 func (e *engine) cycleWindowSec() int64 {
-	if e.in.Limits.CycleMs == sixtyHourCycleMs {
-		return sevenDayWindowSec
+	return e.rules.cycleWindowSec(e.in.Limits.CycleMs)
+}
+
+// timelineCovers reports whether persisted logs reach back windowSec before departure.
+func (e *engine) timelineCovers(windowSec, departure int64) bool {
+	if !e.hasTimeline() {
+		return false
 	}
-	return eightDayWindowSec
+	return e.in.Timeline[0].StartAt <= departure-windowSec
 }
 
 // timelineCoversCycleWindow reports whether persisted logs reach back far enough to
 // recompute the cycle from scratch at the departure time.
 func (e *engine) timelineCoversCycleWindow(departure int64) bool {
-	if !e.hasTimeline() {
-		return false
+	return e.timelineCovers(e.cycleWindowSec(), departure)
+}
+
+// cycleAvailableAt projects the cycle clock forward to the departure. Under Canadian
+// cycle 2 it is further capped by the on-duty hours left before a 24-hour off-duty
+// period is due.
+func (e *engine) cycleAvailableAt(departure int64) int64 {
+	available := e.windowCycleAvailableAt(departure)
+	if limit := e.rules.onDutyBeforeLongRestMs; limit > 0 && e.hasTimeline() {
+		since := max(e.lastLongRestEnd(), e.in.Timeline[0].StartAt)
+		available = min(available, limit-onDutyMsBetween(e.in.Timeline, since, e.in.Now))
 	}
-	return e.in.Timeline[0].StartAt <= departure-e.cycleWindowSec()
+	return available
 }
 
-// cycleAvailableAt projects the 60/70-hour clock forward to the departure, assuming no
-// further duty before then. With full timeline coverage the rolling window is summed
+// windowCycleAvailableAt projects the rolling cycle forward to the departure, assuming
+// no further duty before then. With full timeline coverage the window is summed
 // exactly, so hours regained as old duty days age out are credited. Without coverage
 // the snapshot clock is used, topped up with the provider's cycleTomorrow figure once
 // the departure falls on a later calendar day.
-func (e *engine) cycleAvailableAt(departure int64) int64 {
+func (e *engine) windowCycleAvailableAt(departure int64) int64 {
 	if e.timelineCoversCycleWindow(departure) {
 		used := onDutyMsBetween(e.in.Timeline, departure-e.cycleWindowSec(), e.in.Now)
 		return e.in.Limits.CycleMs - used
@@ -75,24 +98,75 @@ func (e *engine) restStartFloor() int64 {
 	return e.in.Now
 }
 
+// lastLongRestEnd is when the driver's most recent off-duty period of the ruleset's
+// long-rest length ended, or 0 when the timeline holds none. A rest still running at
+// now counts as ending now.
+func (e *engine) lastLongRestEnd() int64 {
+	if e.rules.longRestMs == 0 {
+		return 0
+	}
+	for _, run := range slices.Backward(e.runs) {
+		end := min(run.endAt, e.in.Now)
+		if (end-run.startAt)*1000 >= e.rules.longRestMs {
+			return end
+		}
+	}
+	return 0
+}
+
+// longRestDue reports whether the Canadian 24-hour off-duty period has to be taken
+// before departure: the logs reach back far enough to tell and show none in the
+// preceding 14 days. Without that coverage the requirement is assumed met, the same
+// way the cycle falls back to the provider's clock.
+func (e *engine) longRestDue(departure int64) bool {
+	if e.rules.longRestMs == 0 || e.lastLongRestEnd() >= departure-e.rules.longRestWithinSec {
+		return false
+	}
+	return e.timelineCovers(e.rules.longRestWithinSec, departure)
+}
+
 // currentClocks projects the snapshot clocks to departure with no additional rest. The
-// 14-hour window keeps burning wall-clock time; the drive clock does not.
+// 14-hour window keeps burning wall-clock time; the drive and on-duty clocks do not.
 func (e *engine) currentClocks(departure int64) (strategyCandidate, bool) {
+	if e.longRestDue(departure) {
+		return strategyCandidate{}, false
+	}
 	elapsedMs := max((departure-e.in.ClocksAt)*1000, 0)
 
 	clocks := Clocks{
-		DriveMs: e.in.Clocks.DriveMs,
-		ShiftMs: e.in.Clocks.ShiftMs - elapsedMs,
-		CycleMs: e.cycleAvailableAt(departure),
-		BreakMs: e.projectedBreakMs(departure),
+		DriveMs:  e.in.Clocks.DriveMs,
+		OnDutyMs: e.onDutyAvailableAt(),
+		ShiftMs:  e.in.Clocks.ShiftMs - elapsedMs,
+		CycleMs:  e.cycleAvailableAt(departure),
+		BreakMs:  e.projectedBreakMs(departure),
 	}
 
-	if clocks.DriveMs <= 0 || clocks.ShiftMs <= 0 {
+	if clocks.DriveMs <= 0 || clocks.ShiftMs <= 0 ||
+		(e.in.Limits.OnDutyMs > 0 && clocks.OnDutyMs <= 0) {
 		return strategyCandidate{}, false
 	}
 	return strategyCandidate{strategy: StrategyCurrentClocks, clocks: clocks}, true
 }
 
+// onDutyAvailableAt is the on-duty clock left for the current shift, assuming no
+// further duty before departure. When the logs hold the rest that started the shift it
+// is summed from them, since the provider's clock only bounds it from the drive hours.
+func (e *engine) onDutyAvailableAt() int64 {
+	if e.in.Limits.OnDutyMs == 0 {
+		return 0
+	}
+	if shiftStart := e.lastResetEnd(); shiftStart > 0 {
+		return e.in.Limits.OnDutyMs - onDutyMsBetween(e.in.Timeline, shiftStart, e.in.Now)
+	}
+	return e.in.Clocks.OnDutyMs
+}
+
+// lastResetEnd is when the driver's most recent consecutive off-duty reset ended, or 0
+// when the timeline holds none.
+func (e *engine) lastResetEnd() int64 {
+	return lastResetEnd(e.runs, e.in.Now, e.rules.resetMs)
+}
+
 // projectedBreakMs projects the 8-hour break clock to departure: a driver resting for
 // 30 minutes or more before departing restarts it in full.
 func (e *engine) projectedBreakMs(departure int64) int64 {
@@ -109,51 +183,69 @@ func (e *engine) projectedBreakMs(departure int64) int64 {
 	return min(e.breakAvailableAt(departure), e.in.Limits.BreakMs)
 }
 
-// tenHourReset models 10 consecutive off-duty hours completing before departure,
-// restoring the drive and shift clocks in full. The cycle is untouched.
-func (e *engine) tenHourReset(departure int64) (strategyCandidate, bool) {
-	restStart := e.restStartFloor()
-	if restStart+resetRestMs/1000 > departure {
+// offDutyReset models the ruleset's consecutive off-duty reset completing before
+// departure: 10 hours for US property and Alaska, 8 hours for US passenger, Texas
+// intrastate and the Canadian work shift. It restores the drive and shift clocks in
+// full; the cycle is untouched.
+func (e *engine) offDutyReset(departure int64) (strategyCandidate, bool) {
+	if e.longRestDue(departure) {
 		return strategyCandidate{}, false
 	}
-	return strategyCandidate{
-		strategy: StrategyTenHourReset,
-		clocks: Clocks{
-			DriveMs: e.in.Limits.DriveMs,
-			ShiftMs: e.in.Limits.ShiftMs,
-			CycleMs: e.cycleAvailableAt(departure),
-			BreakMs: e.in.Limits.BreakMs,
-		},
-		restStartDeadline: departure - resetRestMs/1000,
-	}, true
+	return e.restFor(departure, e.rules.resetStrategy, e.rules.resetMs,
+		e.cycleAvailableAt(departure))
+}
+
+// offDuty24 models the Canadian 24 consecutive off-duty hours completing before
+// departure. It satisfies the 14-day requirement and, under cycle 2, restores the 70
+// on-duty hours allowed between such periods; the 7- or 14-day cycle is untouched.
+func (e *engine) offDuty24(departure int64) (strategyCandidate, bool) {
+	if e.rules.longRestMs == 0 {
+		return strategyCandidate{}, false
+	}
+	cycle := e.windowCycleAvailableAt(departure)
+	if limit := e.rules.onDutyBeforeLongRestMs; limit > 0 {
+		cycle = min(cycle, limit)
+	}
+	return e.restFor(departure, StrategyOffDuty24, e.rules.longRestMs, cycle)
 }
 
-// restart34 models 34 consecutive off-duty hours completing before departure, which
-// restarts the 60/70-hour cycle on top of everything a 10-hour reset restores.
-func (e *engine) restart34(departure int64) (strategyCandidate, bool) {
-	if e.splitDisallowedJurisdiction() {
+// cycleRestart models the ruleset's cycle restart completing before departure: 34
+// hours for US property and Texas, 24 for Alaska, 36 and 72 for Canadian cycles 1 and
+// 2. It restarts the cycle on top of everything a reset restores. US passenger
+// carriers have no restart.
+func (e *engine) cycleRestart(departure int64) (strategyCandidate, bool) {
+	if e.rules.restartMs == 0 {
 		return strategyCandidate{}, false
 	}
+	return e.restFor(departure, e.rules.restartStrategy, e.rules.restartMs,
+		e.in.Limits.CycleMs)
+}
+
+// restFor is a rest of restMs completing before departure that restores the drive,
+// on-duty, shift and break clocks and leaves the given cycle.
+func (e *engine) restFor(
+	departure int64,
+	strategy Strategy,
+	restMs int64,
+	cycleMs int64,
+) (strategyCandidate, bool) {
 	restStart := e.restStartFloor()
-	if restStart+restartMs/1000 > departure {
+	if restStart+restMs/1000 > departure {
 		return strategyCandidate{}, false
 	}
 	return strategyCandidate{
-		strategy: StrategyRestart34,
+		strategy: strategy,
 		clocks: Clocks{
-			DriveMs: e.in.Limits.DriveMs,
-			ShiftMs: e.in.Limits.ShiftMs,
-			CycleMs: e.in.Limits.CycleMs,
-			BreakMs: e.in.Limits.BreakMs,
+			DriveMs:  e.in.Limits.DriveMs,
+			OnDutyMs: e.in.Limits.OnDutyMs,
+			ShiftMs:  e.in.Limits.ShiftMs,
+			CycleMs:  cycleMs,
+			BreakMs:  e.in.Limits.BreakMs,
 		},
-		restStartDeadline: departure - restartMs/1000,
+		restStartDeadline: departure - restMs/1000,
 	}, true
 }
 
-func (e *engine) splitDisallowedJurisdiction() bool {
-	return e.in.Jurisdiction == canadaSouthJurisCS || e.in.Jurisdiction == canadaNorthJurisCN
-}
-
 // splitPair is one qualifying sleeper-berth pairing candidate.
 type splitPair struct {
 	recalcPoint       int64
@@ -169,7 +261,7 @@ type splitPair struct {
 // window and clocks recalculate from the end of the first period; the 11-hour drive
 // clock and the cycle are never extended (49 CFR 395.1(g)).
 func (e *engine) splitSleeper(departure int64) (strategyCandidate, bool) {
-	if e.splitDisallowedJurisdiction() || e.in.Limits.DriveMs != standardDriveMs {
+	if !e.rules.splitSleeper || e.in.Limits.DriveMs != standardDriveMs {
 		return strategyCandidate{}, false
 	}
 	if !e.hasTimeline() {
@@ -193,6 +193,18 @@ func onDutyMsBetween(timeline []DutyInterval, from, to int64) int64 {
 	return statusMsBetween(timeline, from, to, isOnDutyStatus)
 }
 
+// lastResetEnd is when the most recent rest run of at least resetMs ended, or 0 when
+// there is none. A rest still running at now counts as ending now.
+func lastResetEnd(runs []restRun, now, resetMs int64) int64 {
+	for _, run := range slices.Backward(runs) {
+		end := min(run.endAt, now)
+		if (end-run.startAt)*1000 >= resetMs {
+			return end
+		}
+	}
+	return 0
+}
+
 // trailingRestStart returns the start of the open trailing rest run, or 0 when the
 // driver is not currently resting according to the timeline.
 func trailingRestStart(timeline []DutyInterval, now int64) int64 {
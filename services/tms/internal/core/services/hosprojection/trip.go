@@ -1,14 +1,29 @@
 package hosprojection
 
-// PlanTrip simulates driving tripDriveMs from the given starting clocks, inserting the
-// mandated 30-minute break whenever the 8-hour driving clock runs out and a full
-// 10-hour reset whenever the drive or shift clock exhausts mid-trip. The cycle is
-// deliberately never regained en route: rolling recovery depends on day-by-day duty
-// history that cannot be guaranteed at planning time, so exhausting it fails the plan.
-// A LimiterNone return means the trip completes.
+// PlanTrip simulates driving tripDriveMs from the given starting clocks under the
+// limits' ruleset, inserting the mandated 30-minute break whenever the 8-hour driving
+// clock runs out (where the ruleset requires one) and a full reset whenever the drive,
+// on-duty or shift clock exhausts mid-trip. The cycle is deliberately never regained en route:
+// rolling recovery depends on day-by-day duty history that cannot be guaranteed at
+// planning time, so exhausting it fails the plan. A LimiterNone return means the trip
+// completes.
 func PlanTrip(tripDriveMs int64, start Clocks, limits Limits) (TripPlan, Limiter) {
-	plan := TripPlan{}
+	r := rulesFor(limits.Ruleset)
+	return planTrip(tripDriveMs, start, limits, &r, 0)
+}
+
+// planTrip is PlanTrip under explicit rules, starting with owedMs of deferred off-duty
+// time still to be added to the first rest.
+func planTrip(
+	tripDriveMs int64,
+	start Clocks,
+	limits Limits,
+	r *rules,
+	owedMs int64,
+) (TripPlan, Limiter) {
+	plan := TripPlan{DeferredMs: owedMs}
 	drive := start.DriveMs
+	onDuty := start.OnDutyMs
 	shift := start.ShiftMs
 	cycle := start.CycleMs
 	breakClock := start.BreakMs
@@ -19,9 +34,9 @@ func PlanTrip(tripDriveMs int64, start Clocks, limits Limits) (TripPlan, Limiter
 			return plan, LimiterCycle
 		}
 
-		if breakClock <= 0 {
+		if r.breakRequired && breakClock <= 0 {
 			if shift < breakRestMs {
-				if !enRouteReset(&plan, &drive, &shift, &breakClock, limits) {
+				if !enRouteReset(&plan, &drive, &onDuty, &shift, &breakClock, limits, r) {
 					return plan, LimiterShift
 				}
 				continue
@@ -33,13 +48,22 @@ func PlanTrip(tripDriveMs int64, start Clocks, limits Limits) (TripPlan, Limiter
 			continue
 		}
 
-		leg := min(remaining, drive, shift, cycle, breakClock)
+		leg := min(remaining, drive, shift, cycle)
+		if limits.OnDutyMs > 0 {
+			leg = min(leg, onDuty)
+		}
+		if r.breakRequired {
+			leg = min(leg, breakClock)
+		}
 		if leg <= 0 {
 			limiter := LimiterShift
-			if drive <= 0 {
+			switch {
+			case drive <= 0:
 				limiter = LimiterDrive
+			case limits.OnDutyMs > 0 && onDuty <= 0:
+				limiter = LimiterOnDuty
 			}
-			if !enRouteReset(&plan, &drive, &shift, &breakClock, limits) {
+			if !enRouteReset(&plan, &drive, &onDuty, &shift, &breakClock, limits, r) {
 				return plan, limiter
 			}
 			continue
@@ -51,20 +75,130 @@ func PlanTrip(tripDriveMs int64, start Clocks, limits Limits) (TripPlan, Limiter
 		shift -= leg
 		cycle -= leg
 		breakClock -= leg
+		if limits.OnDutyMs > 0 {
+			onDuty -= leg
+		}
 	}
 
 	plan.MarginMs = min(drive, shift, cycle)
+	if limits.OnDutyMs > 0 {
+		plan.MarginMs = min(plan.MarginMs, onDuty)
+	}
+	plan.End = Clocks{
+		DriveMs:  drive,
+		OnDutyMs: onDuty,
+		ShiftMs:  shift,
+		CycleMs:  cycle,
+		BreakMs:  breakClock,
+	}
 	return plan, LimiterNone
 }
 
-func enRouteReset(plan *TripPlan, drive, shift, breakClock *int64, limits Limits) bool {
+// PlanTransit is PlanTrip without the cap on en-route resets: it chains the drive a day
+// at a time so a single driver's end-to-end transit can be measured however many rests
+// it takes. It answers "how long would one driver need", not "can this driver take it
+// now", so only running out of cycle fails it.
+func PlanTransit(tripDriveMs int64, start Clocks, limits Limits) (TripPlan, Limiter) {
+	if tripDriveMs <= 0 || limits.DriveMs <= 0 {
+		return PlanTrip(tripDriveMs, start, limits)
+	}
+
+	r := rulesFor(limits.Ruleset)
+	transit := TripPlan{}
+	clocks := start
+	for remaining := tripDriveMs; remaining > 0; {
+		leg := min(remaining, limits.DriveMs)
+		plan, limiter := planTrip(leg, clocks, limits, &r, transit.DeferredMs)
+		transit.TotalMs += plan.TotalMs
+		transit.Breaks += plan.Breaks
+		transit.Resets += plan.Resets
+		transit.DeferredMs = plan.DeferredMs
+		if limiter != LimiterNone {
+			return transit, limiter
+		}
+
+		remaining -= leg
+		clocks = plan.End
+		transit.MarginMs = plan.MarginMs
+	}
+
+	transit.End = clocks
+	return transit, LimiterNone
+}
+
+// enRouteReset takes the ruleset's daily rest. Under the Canadian rules a rest with
+// nothing already owed may be cut short by the deferrable off-duty time, which is then
+// added to the next rest, or carried past arrival when there is none.
+func enRouteReset(
+	plan *TripPlan,
+	drive, onDuty, shift, breakClock *int64,
+	limits Limits,
+	r *rules,
+) bool {
 	if plan.Resets >= maxEnRouteResets {
 		return false
 	}
-	plan.TotalMs += resetRestMs
+	rest := r.enRouteRestMs()
+	if plan.DeferredMs > 0 {
+		rest += plan.DeferredMs
+		plan.DeferredMs = 0
+	} else if r.deferralMs > 0 {
+		rest -= r.deferralMs
+		plan.DeferredMs = r.deferralMs
+	}
+	plan.TotalMs += rest
 	*drive = limits.DriveMs
+	*onDuty = limits.OnDutyMs
 	*shift = limits.ShiftMs
 	*breakClock = limits.BreakMs
 	plan.Resets++
 	return true
 }
+
+// Reset returns the clocks after the ruleset's full daily off-duty rest taken from
+// start, and how long that rest lasts in seconds. Drive, on-duty, shift and break are
+// restored in full; the cycle is untouched for the same reason PlanTrip never regains
+// it.
+func Reset(start Clocks, limits Limits) (Clocks, int64) {
+	r := rulesFor(limits.Ruleset)
+	return Clocks{
+		DriveMs:  limits.DriveMs,
+		OnDutyMs: limits.OnDutyMs,
+		ShiftMs:  limits.ShiftMs,
+		CycleMs:  start.CycleMs,
+		BreakMs:  limits.BreakMs,
+	}, r.enRouteRestMs() / 1000
+}
+
+// Full returns the clocks of a fully rested driver with a fresh cycle.
+func (l Limits) Full() Clocks {
+	return Clocks{
+		DriveMs:  l.DriveMs,
+		OnDutyMs: l.OnDutyMs,
+		ShiftMs:  l.ShiftMs,
+		CycleMs:  l.CycleMs,
+		BreakMs:  l.BreakMs,
+	}
+}
+
+// OnDutyRemaining is the on-duty clock an ELD that does not report one would show:
+// the limit less the on-duty time logged since the reset that started the shift. When
+// the logs reach back to no such reset, the shift's on-duty time is at least what they
+// hold and at least the drive hours already used. It is zero when the ruleset has no
+// separate on-duty limit.
+func (l Limits) OnDutyRemaining(timeline []DutyInterval, now, driveRemainingMs int64) int64 {
+	if l.OnDutyMs == 0 {
+		return 0
+	}
+
+	resetMs := rulesFor(l.Ruleset).resetMs
+	if shiftStart := lastResetEnd(restRuns(timeline, now), now, resetMs); shiftStart > 0 {
+		return l.OnDutyMs - onDutyMsBetween(timeline, shiftStart, now)
+	}
+
+	usedMs := l.DriveMs - driveRemainingMs
+	if len(timeline) > 0 {
+		usedMs = max(usedMs, onDutyMsBetween(timeline, timeline[0].StartAt, now))
+	}
+	return l.OnDutyMs - usedMs
+}
@@ -39,6 +39,12 @@ func TestPlanTrip_TenHourDriveInsertsOneBreak(t *testing.T) {
 	assert.Zero(t, plan.Resets)
 	assert.Equal(t, hoursMs(10.5), plan.TotalMs)
 	assert.Equal(t, hoursMs(1), plan.MarginMs)
+	assert.Equal(t, Clocks{
+		DriveMs: hoursMs(1),
+		ShiftMs: hoursMs(3.5),
+		CycleMs: hoursMs(60),
+		BreakMs: hoursMs(6),
+	}, plan.End, "the break restarts the 8-hour clock, which then burns the last 2 hours")
 }
 
 func TestPlanTrip_ResetCapMakesExtremeTripsInfeasible(t *testing.T) {
@@ -85,6 +91,29 @@ func TestPlanTrip_ExhaustedShiftWithNoBreakRoomForcesReset(t *testing.T) {
 	assert.Equal(t, hoursMs(12), plan.TotalMs)
 }
 
+func TestPlanTransit_ChainsAsManyResetsAsTheDriveNeeds(t *testing.T) {
+	t.Parallel()
+
+	plan, limiter := PlanTransit(hoursMs(35), fullClocks(), usPropertyLimits())
+
+	require.Equal(t, LimiterNone, limiter, "PlanTrip caps resets, a transit estimate must not")
+	assert.Equal(t, 3, plan.Resets)
+	assert.Equal(t, 3, plan.Breaks)
+	assert.Equal(t, hoursMs(66.5), plan.TotalMs)
+	assert.Equal(t, hoursMs(35), plan.End.CycleMs)
+}
+
+func TestPlanTransit_StillStopsAtTheCycle(t *testing.T) {
+	t.Parallel()
+
+	clocks := fullClocks()
+	clocks.CycleMs = hoursMs(20)
+
+	_, limiter := PlanTransit(hoursMs(35), clocks, usPropertyLimits())
+
+	assert.Equal(t, LimiterCycle, limiter)
+}
+
 func TestBuildTimeline_CapsOpenEntriesAndClipsOverlaps(t *testing.T) {
 	t.Parallel()
 
@@ -153,3 +182,17 @@ func TestRestRuns_MergesAdjacentRestStatusesAndTracksSleeper(t *testing.T) {
 	assert.False(t, runs[0].open)
 	assert.True(t, runs[1].open)
 }
+
+func TestReset_RestoresDailyClocksButNotTheCycle(t *testing.T) {
+	t.Parallel()
+
+	rested, restSeconds := Reset(Clocks{CycleMs: hoursMs(20)}, usPropertyLimits())
+
+	assert.Equal(t, int64(10*3600), restSeconds)
+	assert.Equal(t, Clocks{
+		DriveMs: hoursMs(11),
+		ShiftMs: hoursMs(14),
+		CycleMs: hoursMs(20),
+		BreakMs: hoursMs(8),
+	}, rested)
+}
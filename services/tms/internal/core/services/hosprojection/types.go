@@ -12,13 +12,24 @@ const (
 	splitShortRestMs   = 2 * hourMs
 	splitPairTotalMs   = 10 * hourMs
 
-	sixtyHourCycleMs   = 60 * hourMs
-	sevenDayWindowSec  = int64(7 * 86400)
-	eightDayWindowSec  = int64(8 * 86400)
-	standardDriveMs    = 11 * hourMs
-	maxEnRouteResets   = 2
-	canadaSouthJurisCS = "CS"
-	canadaNorthJurisCN = "CN"
+	sixtyHourCycleMs     = 60 * hourMs
+	sevenDayWindowSec    = int64(7 * 86400)
+	eightDayWindowSec    = int64(8 * 86400)
+	fourteenDayWindowSec = int64(14 * 86400)
+	standardDriveMs      = 11 * hourMs
+	maxEnRouteResets     = 2
+	canadaSouthJurisCS   = "CS"
+	canadaNorthJurisCN   = "CN"
+	alaskaJurisAK        = "AK"
+
+	canadaDailyOffDutyMs = 10 * hourMs
+	canadaDeferralMs     = 2 * hourMs
+
+	// shortHaulRadiusAirMiles and shortHaulWindowMs bound the 49 CFR 395.1(e)(1)
+	// exemption. An air mile is a nautical mile, statuteMilesPerAirMile converts.
+	shortHaulRadiusAirMiles = 150.0
+	statuteMilesPerAirMile  = 1.15078
+	shortHaulWindowMs       = 14 * hourMs
 )
 
 // Strategy names the rest plan that makes a trip legal, ordered from least to most
@@ -26,37 +37,50 @@ const (
 type Strategy string
 
 const (
-	StrategyCurrentClocks = Strategy("currentClocks")
-	StrategySplitSleeper  = Strategy("splitSleeper")
-	StrategyTenHourReset  = Strategy("tenHourReset")
-	StrategyRestart34     = Strategy("restart34")
+	StrategyCurrentClocks  = Strategy("currentClocks")
+	StrategySplitSleeper   = Strategy("splitSleeper")
+	StrategyEightHourReset = Strategy("eightHourReset")
+	StrategyTenHourReset   = Strategy("tenHourReset")
+	StrategyOffDuty24      = Strategy("offDuty24")
+	StrategyRestart24      = Strategy("restart24")
+	StrategyRestart34      = Strategy("restart34")
+	StrategyRestart36      = Strategy("restart36")
+	StrategyRestart72      = Strategy("restart72")
 )
 
 // Limiter identifies the clock that makes a trip infeasible.
 type Limiter string
 
 const (
-	LimiterNone  = Limiter("")
-	LimiterDrive = Limiter("drive")
-	LimiterShift = Limiter("shift")
-	LimiterCycle = Limiter("cycle")
-	LimiterBreak = Limiter("break")
+	LimiterNone   = Limiter("")
+	LimiterDrive  = Limiter("drive")
+	LimiterOnDuty = Limiter("onDuty")
+	LimiterShift  = Limiter("shift")
+	LimiterCycle  = Limiter("cycle")
+	LimiterBreak  = Limiter("break")
 )
 
-// Limits are the ruleset ceilings for each clock in milliseconds.
+// Limits are the ruleset ceilings for each clock in milliseconds, tagged with the
+// ruleset whose reset, restart and break rules apply to them. OnDutyMs caps on-duty
+// time within the shift separately from its elapsed window; it is zero for rulesets
+// whose shift window is the only on-duty ceiling.
 type Limits struct {
-	DriveMs int64
-	ShiftMs int64
-	CycleMs int64
-	BreakMs int64
+	Ruleset  Ruleset
+	DriveMs  int64
+	OnDutyMs int64
+	ShiftMs  int64
+	CycleMs  int64
+	BreakMs  int64
 }
 
-// Clocks are the remaining milliseconds on each clock at a point in time.
+// Clocks are the remaining milliseconds on each clock at a point in time. Unlike the
+// shift clock, the on-duty clock only burns while the driver is on duty.
 type Clocks struct {
-	DriveMs int64
-	ShiftMs int64
-	CycleMs int64
-	BreakMs int64
+	DriveMs  int64
+	OnDutyMs int64
+	ShiftMs  int64
+	CycleMs  int64
+	BreakMs  int64
 }
 
 // DutyInterval is one closed span of the driver's duty timeline in unix seconds.
@@ -79,21 +103,39 @@ type Input struct {
 	Limits          Limits
 	Jurisdiction    string
 	Timeline        []DutyInterval
+	ShortHaul       *ShortHaulTrip
+}
+
+// ShortHaulTrip claims the 150 air-mile short-haul exemption for a driver who works
+// from a fixed reporting location. MaxRadiusMiles is the farthest the trip takes the
+// driver from it in statute miles, and ReturnDriveMs the drive back once the trip
+// ends. The exemption only applies when the trip stays inside the radius and the
+// driver is back within 14 hours.
+type ShortHaulTrip struct {
+	MaxRadiusMiles float64
+	ReturnDriveMs  int64
 }
 
 // TripPlan is the simulated execution of the trip's drive time, including mandated
-// 30-minute breaks and any en-route 10-hour resets.
+// 30-minute breaks and any en-route resets. DeferredMs is Canadian daily off-duty time
+// pushed past arrival onto the driver's next rest. End holds the clocks left when the
+// trip completes, which is where a chained follow-on trip starts from.
 type TripPlan struct {
-	TotalMs  int64
-	Breaks   int
-	Resets   int
-	Arrival  int64
-	MarginMs int64
+	TotalMs    int64
+	Breaks     int
+	Resets     int
+	DeferredMs int64
+	Arrival    int64
+	MarginMs   int64
+	End        Clocks
 }
 
-// Result is the best legal option for taking the trip at its departure time.
+// Result is the best legal option for taking the trip at its departure time, with the
+// ruleset it was judged under and any exemption it relied on.
 type Result struct {
 	Feasible          bool
+	Ruleset           Ruleset
+	Exemption         Exemption
 	Strategy          Strategy
 	Limiter           Limiter
 	DriveAvailableMs  int64
@@ -0,0 +1,163 @@
+package iftaservice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/shopspring/decimal"
+)
+
+// scheduleHeader is the jurisdiction schedule in the column order of the
+// IFTA-100 return, so the rows can be keyed into a base jurisdiction's portal
+// or pasted onto the paper schedule as they stand.
+var scheduleHeader = []string{
+	"Jurisdiction",
+	"Rate Type",
+	"Tax Rate",
+	"Total Miles",
+	"Taxable Miles",
+	"Taxable Gallons",
+	"Tax-Paid Gallons",
+	"Net Taxable Gallons",
+	"Tax Due",
+	"Interest",
+	"Total Due",
+}
+
+var gapHeader = []string{
+	"Unit",
+	"Kind",
+	"Started At",
+	"Ended At",
+	"From",
+	"To",
+	"Miles",
+}
+
+func exportFileName(result *ifta.TaxReturn) string {
+	return "ifta-" + result.Period.String() + "-" +
+		strings.ToLower(result.FuelType.String()) + ".csv"
+}
+
+func whole(value decimal.Decimal) string { return value.StringFixed(0) }
+
+func money(value decimal.Decimal) string { return value.StringFixed(2) }
+
+func rate(value decimal.Decimal) string { return value.StringFixed(4) }
+
+// writeReturn renders the return as a summary block, the jurisdiction
+// schedule and the coverage gaps behind its estimated miles. A jurisdiction
+// with a surcharge gets a second row for it, as on the form, since the
+// surcharge is levied on taxable gallons with no credit for fuel bought there.
+// Interest is left at zero: it depends on when the return is filed, which the
+// export cannot know.
+func writeReturn(result *ifta.TaxReturn) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		{"IFTA Quarterly Fuel Tax Return"},
+		{"Period", result.Period.String()},
+		{"Fuel Type", result.FuelType.String()},
+		{"Total Miles", whole(result.TotalMiles)},
+		{"Taxable Miles", whole(result.TaxableMiles)},
+		{"Total Gallons", whole(result.TotalGallons)},
+		{"Fleet MPG", money(result.FleetMPG)},
+		{"Unattributed Miles", whole(result.UnattributedMiles)},
+		{},
+		scheduleHeader,
+	}
+
+	totalMiles := decimal.Zero
+	taxableMiles := decimal.Zero
+	taxableGallons := decimal.Zero
+	taxPaidGallons := decimal.Zero
+	netGallons := decimal.Zero
+	taxDue := decimal.Zero
+
+	for _, line := range result.Lines {
+		rows = append(rows, []string{
+			line.Jurisdiction,
+			"Fuel",
+			rate(line.TaxRate),
+			whole(line.TotalMiles),
+			whole(line.TaxableMiles),
+			whole(line.TaxableGallons),
+			whole(line.TaxPaidGallons),
+			whole(line.NetTaxableGallons),
+			money(line.TaxDue),
+			money(decimal.Zero),
+			money(line.TaxDue),
+		})
+		if line.SurchargeRate.IsPositive() {
+			rows = append(rows, []string{
+				line.Jurisdiction,
+				"Surcharge",
+				rate(line.SurchargeRate),
+				"",
+				"",
+				whole(line.TaxableGallons),
+				"",
+				whole(line.TaxableGallons),
+				money(line.SurchargeDue),
+				money(decimal.Zero),
+				money(line.SurchargeDue),
+			})
+		}
+
+		totalMiles = totalMiles.Add(line.TotalMiles)
+		taxableMiles = taxableMiles.Add(line.TaxableMiles)
+		taxableGallons = taxableGallons.Add(line.TaxableGallons)
+		taxPaidGallons = taxPaidGallons.Add(line.TaxPaidGallons)
+		netGallons = netGallons.Add(line.NetTaxableGallons)
+		taxDue = taxDue.Add(line.NetDue)
+	}
+
+	rows = append(rows, []string{
+		"Total",
+		"",
+		"",
+		whole(totalMiles),
+		whole(taxableMiles),
+		whole(taxableGallons),
+		whole(taxPaidGallons),
+		whole(netGallons),
+		money(taxDue),
+		money(decimal.Zero),
+		money(result.TotalDue),
+	})
+
+	if len(result.Warnings) > 0 {
+		rows = append(rows, []string{}, []string{"Warnings"})
+		for _, warning := range result.Warnings {
+			rows = append(rows, []string{warning})
+		}
+	}
+
+	rows = append(rows, []string{}, []string{"Coverage Gaps"}, gapHeader)
+	for _, gap := range result.Gaps {
+		rows = append(rows, []string{
+			gap.TractorCode,
+			gap.Kind.String(),
+			timestamp(gap.StartedAt),
+			timestamp(gap.EndedAt),
+			gap.FromJurisdiction,
+			gap.ToJurisdiction,
+			strconv.FormatFloat(gap.Miles, 'f', 1, 64),
+		})
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func timestamp(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
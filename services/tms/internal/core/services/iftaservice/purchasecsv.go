@@ -0,0 +1,398 @@
+package iftaservice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/shopspring/decimal"
+)
+
+// maxImportBytes bounds one fuel card export. A quarter of fill-ups for a
+// large fleet is well under a megabyte; past this it is the wrong file.
+const maxImportBytes = 8 << 20
+
+// litresPerGallon converts Canadian card exports, which report litres, into the
+// US gallons the return is filed in.
+var litresPerGallon = decimal.RequireFromString("3.785411784")
+
+type purchaseColumn int
+
+const (
+	columnDate purchaseColumn = iota
+	columnUnit
+	columnJurisdiction
+	columnGallons
+	columnFuelType
+	columnTotalCost
+	columnVendor
+	columnReceipt
+	columnTaxPaid
+	columnVolumeUnit
+)
+
+type volumeUnit int
+
+const (
+	volumeUnknown volumeUnit = iota
+	volumeGallons
+	volumeLitres
+)
+
+// purchaseHeaders maps the headers fuel card exports use onto the columns the
+// import reads. Headers are matched case-insensitively with spaces, dashes and
+// underscores ignored, so "Unit #" reads as "unit" and a byte order mark in
+// front of the first header is dropped with the rest of the punctuation. "Unit"
+// is the tractor; the unit of measure has headers of its own.
+var purchaseHeaders = map[string]purchaseColumn{
+	"date":            columnDate,
+	"purchasedate":    columnDate,
+	"transactiondate": columnDate,
+	"unit":            columnUnit,
+	"unitnumber":      columnUnit,
+	"tractor":         columnUnit,
+	"truck":           columnUnit,
+	"jurisdiction":    columnJurisdiction,
+	"state":           columnJurisdiction,
+	"province":        columnJurisdiction,
+	"stateprovince":   columnJurisdiction,
+	"gallons":         columnGallons,
+	"usgallons":       columnGallons,
+	"litres":          columnGallons,
+	"liters":          columnGallons,
+	"quantity":        columnGallons,
+	"volume":          columnGallons,
+	"fueltype":        columnFuelType,
+	"fuel":            columnFuelType,
+	"product":         columnFuelType,
+	"totalcost":       columnTotalCost,
+	"amount":          columnTotalCost,
+	"total":           columnTotalCost,
+	"vendor":          columnVendor,
+	"merchant":        columnVendor,
+	"receipt":         columnReceipt,
+	"receiptnumber":   columnReceipt,
+	"invoice":         columnReceipt,
+	"transactionid":   columnReceipt,
+	"taxpaid":         columnTaxPaid,
+	"uom":             columnVolumeUnit,
+	"unitofmeasure":   columnVolumeUnit,
+	"measure":         columnVolumeUnit,
+	"volumeunit":      columnVolumeUnit,
+	"quantityunit":    columnVolumeUnit,
+}
+
+// quantityHeaderUnits are the quantity headers that name their unit. A bare
+// "Quantity" does not, and a file using it needs a unit of measure column.
+var quantityHeaderUnits = map[string]volumeUnit{
+	"gallons":   volumeGallons,
+	"usgallons": volumeGallons,
+	"litres":    volumeLitres,
+	"liters":    volumeLitres,
+}
+
+var requiredColumns = []struct {
+	column purchaseColumn
+	name   string
+}{
+	{columnDate, "date"},
+	{columnUnit, "unit"},
+	{columnJurisdiction, "jurisdiction"},
+	{columnGallons, "gallons"},
+}
+
+var purchaseDateLayouts = []string{
+	time.DateOnly,
+	"01/02/2006",
+	"1/2/2006",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"01/02/2006 15:04",
+	time.RFC3339,
+}
+
+// purchaseRow is one line of the file before tractors are resolved. The
+// volume unit is the one the quantity header names, if any.
+type purchaseRow struct {
+	line       int
+	unit       string
+	volumeUnit volumeUnit
+	cells      map[purchaseColumn]string
+}
+
+func normalizeHeader(header string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(header))
+}
+
+// readPurchases reads the file's header and rows. Blank lines are skipped;
+// row line numbers count from the top of the file so they match what the
+// person fixing it sees in their spreadsheet.
+func readPurchases(content []byte) ([]*purchaseRow, error) {
+	if len(content) == 0 {
+		return nil, errortypes.NewValidationError(
+			"file", errortypes.ErrRequired, "A file is required",
+		)
+	}
+	if len(content) > maxImportBytes {
+		return nil, errortypes.NewValidationError(
+			"file", errortypes.ErrInvalid, "This file is too large to import",
+		)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errortypes.NewValidationError(
+			"file", errortypes.ErrInvalid, "This file could not be read as a CSV",
+		)
+	}
+	if len(records) == 0 {
+		return nil, errortypes.NewValidationError(
+			"file", errortypes.ErrInvalid, "This file is empty",
+		)
+	}
+
+	columns := make(map[int]purchaseColumn, len(records[0]))
+	found := make(map[purchaseColumn]bool, len(records[0]))
+	headerUnit := volumeUnknown
+	for index, header := range records[0] {
+		normalized := normalizeHeader(header)
+		column, ok := purchaseHeaders[normalized]
+		if !ok || found[column] {
+			continue
+		}
+		columns[index] = column
+		found[column] = true
+		if column == columnGallons {
+			headerUnit = quantityHeaderUnits[normalized]
+		}
+	}
+
+	multiErr := errortypes.NewMultiError()
+	for _, required := range requiredColumns {
+		if !found[required.column] {
+			multiErr.Add("file", errortypes.ErrRequired,
+				"The file has no "+required.name+" column")
+		}
+	}
+	if found[columnGallons] && headerUnit == volumeUnknown && !found[columnVolumeUnit] {
+		multiErr.Add("file", errortypes.ErrRequired,
+			"The quantity column does not say whether it is gallons or litres; "+
+				"name it Gallons or Litres or add a unit of measure column")
+	}
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	rows := make([]*purchaseRow, 0, len(records)-1)
+	for index, record := range records[1:] {
+		row := &purchaseRow{
+			line:       index + 2,
+			volumeUnit: headerUnit,
+			cells:      make(map[purchaseColumn]string, len(columns)),
+		}
+		blank := true
+		for cellIndex, cell := range record {
+			column, ok := columns[cellIndex]
+			if !ok {
+				continue
+			}
+			cell = strings.TrimSpace(cell)
+			if cell != "" {
+				blank = false
+			}
+			row.cells[column] = cell
+		}
+		if blank {
+			continue
+		}
+		row.unit = row.cells[columnUnit]
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, errortypes.NewValidationError(
+			"file", errortypes.ErrInvalid, "This file has no purchase rows in it",
+		)
+	}
+
+	return rows, nil
+}
+
+// buildPurchases turns the rows into purchases, collecting every problem in
+// the file rather than stopping at the first.
+func buildPurchases(
+	tenantInfo pagination.TenantInfo,
+	rows []*purchaseRow,
+	tractorIDs map[string]pulid.ID,
+) ([]*ifta.FuelPurchase, error) {
+	multiErr := errortypes.NewMultiError()
+	purchases := make([]*ifta.FuelPurchase, 0, len(rows))
+
+	for _, row := range rows {
+		rowErr := multiErr.WithIndex("rows", row.line)
+		problems := len(multiErr.Errors)
+		purchase := &ifta.FuelPurchase{
+			OrganizationID: tenantInfo.OrgID,
+			BusinessUnitID: tenantInfo.BuID,
+			Jurisdiction:   ifta.NormalizeJurisdiction(row.cells[columnJurisdiction]),
+			Vendor:         row.cells[columnVendor],
+			ReceiptNumber:  row.cells[columnReceipt],
+			Source:         ifta.PurchaseSourceImport,
+			TaxPaid:        true,
+		}
+
+		tractorID, ok := tractorIDs[row.unit]
+		switch {
+		case row.unit == "":
+			rowErr.Add("unit", errortypes.ErrRequired, "Unit is required")
+		case !ok:
+			rowErr.Add("unit", errortypes.ErrInvalid, "No tractor has the code "+row.unit)
+		default:
+			purchase.TractorID = tractorID
+		}
+
+		if row.cells[columnJurisdiction] != "" && purchase.Jurisdiction == "" {
+			rowErr.Add("jurisdiction", errortypes.ErrInvalid,
+				row.cells[columnJurisdiction]+" is not a state or province")
+		}
+
+		if at, err := parsePurchaseDate(row.cells[columnDate]); err != nil {
+			rowErr.Add("date", errortypes.ErrInvalid,
+				"Date must look like 2026-07-14 or 07/14/2026")
+		} else {
+			purchase.PurchasedAt = at
+		}
+
+		unit, unitValid := parseVolumeUnit(row.cells[columnVolumeUnit], row.volumeUnit)
+		if !unitValid {
+			rowErr.Add("unitOfMeasure", errortypes.ErrInvalid,
+				"Unit of measure must be gallons or litres")
+		}
+		if quantity, err := parseAmount(row.cells[columnGallons]); err != nil {
+			rowErr.Add("gallons", errortypes.ErrInvalid, "Gallons must be a number")
+		} else if unitValid {
+			purchase.Gallons = toGallons(quantity, unit)
+		}
+
+		if raw := row.cells[columnTotalCost]; raw != "" {
+			if cost, err := parseAmount(raw); err != nil {
+				rowErr.Add("totalCost", errortypes.ErrInvalid, "Total cost must be a number")
+			} else {
+				purchase.TotalCost = decimal.NewNullDecimal(cost)
+			}
+		}
+
+		if fuelType, valid := parseFuelType(row.cells[columnFuelType]); valid {
+			purchase.FuelType = fuelType
+		} else {
+			rowErr.Add("fuelType", errortypes.ErrInvalid,
+				row.cells[columnFuelType]+" is not diesel or gasoline")
+		}
+
+		if taxPaid, valid := parseYesNo(row.cells[columnTaxPaid]); valid {
+			purchase.TaxPaid = taxPaid
+		} else {
+			rowErr.Add("taxPaid", errortypes.ErrInvalid, "Tax paid must be yes or no")
+		}
+
+		// Errors land on the root, so a row is clean when it added none.
+		if len(multiErr.Errors) == problems {
+			purchase.Validate(rowErr)
+		}
+		purchases = append(purchases, purchase)
+	}
+
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	return purchases, nil
+}
+
+// parsePurchaseDate reads a receipt date. A date without a time is taken as
+// midnight UTC so it lands in the quarter printed on the receipt.
+func parsePurchaseDate(raw string) (int64, error) {
+	var lastErr error
+	for _, layout := range purchaseDateLayouts {
+		parsed, err := time.Parse(layout, raw)
+		if err == nil {
+			return parsed.Unix(), nil
+		}
+		lastErr = err
+	}
+
+	return 0, lastErr
+}
+
+func parseAmount(raw string) (decimal.Decimal, error) {
+	raw = strings.NewReplacer("$", "", ",", "").Replace(raw)
+
+	return decimal.NewFromString(strings.TrimSpace(raw))
+}
+
+// parseVolumeUnit reads the unit of measure column, falling back to the unit
+// the quantity header names when the cell is blank. A quantity in no known
+// unit is refused rather than taken as gallons: litres read as gallons would
+// overstate the fuel bought nearly fourfold.
+func parseVolumeUnit(raw string, headerUnit volumeUnit) (volumeUnit, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "":
+		return headerUnit, headerUnit != volumeUnknown
+	case "gal", "gals", "gallon", "gallons", "usg", "us gal", "us gallons":
+		return volumeGallons, true
+	case "l", "lt", "ltr", "ltrs", "litre", "litres", "liter", "liters":
+		return volumeLitres, true
+	default:
+		return volumeUnknown, false
+	}
+}
+
+// toGallons converts a quantity to US gallons at the three decimals the
+// purchase stores.
+func toGallons(quantity decimal.Decimal, unit volumeUnit) decimal.Decimal {
+	if unit == volumeLitres {
+		return quantity.Div(litresPerGallon).Round(3)
+	}
+
+	return quantity
+}
+
+// parseFuelType reads the product column. A fuel card export without one is
+// almost always a diesel card, so blank reads as diesel.
+func parseFuelType(raw string) (ifta.FuelType, bool) {
+	switch strings.ToLower(raw) {
+	case "", "diesel", "d", "dsl", "ulsd", "#2 diesel":
+		return ifta.FuelTypeDiesel, true
+	case "gasoline", "gas", "g", "unleaded", "unl":
+		return ifta.FuelTypeGasoline, true
+	default:
+		return "", false
+	}
+}
+
+// parseYesNo reads the tax paid column. Retail fuel is tax-paid, so blank
+// reads as yes; only bulk or exempt purchases are marked otherwise.
+func parseYesNo(raw string) (value, valid bool) {
+	switch strings.ToLower(raw) {
+	case "", "y", "yes", "true", "1":
+		return true, true
+	case "n", "no", "false", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
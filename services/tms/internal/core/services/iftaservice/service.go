@@ -0,0 +1,543 @@
+// Package iftaservice prepares the quarterly IFTA fuel tax return.
+//
+// Miles come from the telematics position history rather than trip sheets:
+// every fix is placed in a jurisdiction and the distance between fixes is
+// attributed to where it was driven. Fuel comes from purchase receipts keyed
+// in or imported from a fuel card export. The return itself is arithmetic on
+// those two, done in the ifta domain package so it can be read on its own.
+package iftaservice
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/emoss08/trenova/internal/core/domain/permission"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/auditservice"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/jsonutils"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	Logger       *zap.Logger
+	Repo         repositories.IFTARepository
+	AuditService services.AuditService
+}
+
+type Service struct {
+	l            *zap.Logger
+	repo         repositories.IFTARepository
+	auditService services.AuditService
+}
+
+func New(p Params) *Service {
+	return &Service{
+		l:            p.Logger.Named("service.ifta"),
+		repo:         p.Repo,
+		auditService: p.AuditService,
+	}
+}
+
+// QuarterRequest names one tenant's reporting period.
+type QuarterRequest struct {
+	TenantInfo pagination.TenantInfo
+	Period     ifta.Quarter
+}
+
+func (r *QuarterRequest) validate() error {
+	multiErr := errortypes.NewMultiError()
+	r.Period.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return multiErr
+	}
+
+	return nil
+}
+
+type ReturnRequest struct {
+	TenantInfo pagination.TenantInfo
+	Period     ifta.Quarter
+	FuelType   ifta.FuelType
+}
+
+func (r *ReturnRequest) validate() error {
+	multiErr := errortypes.NewMultiError()
+	r.Period.Validate(multiErr)
+	if !r.FuelType.IsValid() {
+		multiErr.Add("fuelType", errortypes.ErrInvalid, "Fuel type is invalid")
+	}
+	if multiErr.HasErrors() {
+		return multiErr
+	}
+
+	return nil
+}
+
+type ListPurchasesRequest struct {
+	TenantInfo pagination.TenantInfo
+	Period     ifta.Quarter
+	TractorID  pulid.ID
+}
+
+func (s *Service) ListPurchases(
+	ctx context.Context,
+	req *ListPurchasesRequest,
+) ([]*ifta.FuelPurchase, error) {
+	if err := (&QuarterRequest{Period: req.Period}).validate(); err != nil {
+		return nil, err
+	}
+
+	start, end := req.Period.Bounds()
+
+	return s.repo.ListFuelPurchases(ctx, &repositories.ListFuelPurchasesRequest{
+		TenantInfo: req.TenantInfo,
+		Start:      start,
+		End:        end,
+		TractorID:  req.TractorID,
+	})
+}
+
+func (s *Service) GetPurchase(
+	ctx context.Context,
+	req *repositories.GetFuelPurchaseByIDRequest,
+) (*ifta.FuelPurchase, error) {
+	return s.repo.GetFuelPurchaseByID(ctx, req)
+}
+
+func (s *Service) CreatePurchase(
+	ctx context.Context,
+	entity *ifta.FuelPurchase,
+	userID pulid.ID,
+) (*ifta.FuelPurchase, error) {
+	log := s.l.With(zap.String("operation", "CreatePurchase"))
+
+	entity.Jurisdiction = ifta.NormalizeJurisdiction(entity.Jurisdiction)
+	entity.Source = ifta.PurchaseSourceManual
+
+	multiErr := errortypes.NewMultiError()
+	entity.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	created, err := s.repo.CreateFuelPurchase(ctx, entity)
+	if err != nil {
+		log.Error("failed to create fuel purchase", zap.Error(err))
+		return nil, err
+	}
+
+	s.logAction(&services.LogActionParams{
+		ResourceID:     created.ID.String(),
+		Operation:      permission.OpCreate,
+		UserID:         userID,
+		CurrentState:   jsonutils.MustToJSON(created),
+		OrganizationID: created.OrganizationID,
+		BusinessUnitID: created.BusinessUnitID,
+	}, auditservice.WithComment("Fuel purchase created"))
+
+	return created, nil
+}
+
+func (s *Service) UpdatePurchase(
+	ctx context.Context,
+	entity *ifta.FuelPurchase,
+	userID pulid.ID,
+) (*ifta.FuelPurchase, error) {
+	log := s.l.With(zap.String("operation", "UpdatePurchase"))
+
+	entity.Jurisdiction = ifta.NormalizeJurisdiction(entity.Jurisdiction)
+
+	multiErr := errortypes.NewMultiError()
+	entity.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	original, err := s.repo.GetFuelPurchaseByID(ctx, &repositories.GetFuelPurchaseByIDRequest{
+		TenantInfo: pagination.TenantInfo{
+			OrgID: entity.OrganizationID,
+			BuID:  entity.BusinessUnitID,
+		},
+		PurchaseID: entity.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Where a purchase came from is history, not something an edit changes.
+	entity.Source = original.Source
+
+	updated, err := s.repo.UpdateFuelPurchase(ctx, entity)
+	if err != nil {
+		log.Error("failed to update fuel purchase", zap.Error(err))
+		return nil, err
+	}
+
+	s.logAction(&services.LogActionParams{
+		ResourceID:     updated.ID.String(),
+		Operation:      permission.OpUpdate,
+		UserID:         userID,
+		CurrentState:   jsonutils.MustToJSON(updated),
+		PreviousState:  jsonutils.MustToJSON(original),
+		OrganizationID: updated.OrganizationID,
+		BusinessUnitID: updated.BusinessUnitID,
+	},
+		auditservice.WithComment("Fuel purchase updated"),
+		auditservice.WithDiff(original, updated),
+	)
+
+	return updated, nil
+}
+
+func (s *Service) DeletePurchase(
+	ctx context.Context,
+	req *repositories.GetFuelPurchaseByIDRequest,
+	userID pulid.ID,
+) error {
+	original, err := s.repo.GetFuelPurchaseByID(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.DeleteFuelPurchase(ctx, req); err != nil {
+		s.l.Error("failed to delete fuel purchase", zap.Error(err))
+		return err
+	}
+
+	s.logAction(&services.LogActionParams{
+		ResourceID:     original.ID.String(),
+		Operation:      permission.OpDelete,
+		UserID:         userID,
+		PreviousState:  jsonutils.MustToJSON(original),
+		OrganizationID: original.OrganizationID,
+		BusinessUnitID: original.BusinessUnitID,
+	}, auditservice.WithComment("Fuel purchase deleted"))
+
+	return nil
+}
+
+type ImportPurchasesRequest struct {
+	TenantInfo pagination.TenantInfo
+	Content    []byte
+}
+
+type ImportPurchasesResult struct {
+	// Read is how many purchase rows the file held.
+	Read int `json:"read"`
+	// Imported is how many of them were new. The rest carried a receipt
+	// number already on file for the same tractor, which is what re-importing
+	// an overlapping fuel card export produces.
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+}
+
+// ImportPurchases reads a fuel card export and records every purchase in it.
+// The file is taken whole or not at all: one bad row fails the import with
+// every problem reported against its line, so a corrected file can simply be
+// uploaded again.
+func (s *Service) ImportPurchases(
+	ctx context.Context,
+	req *ImportPurchasesRequest,
+	userID pulid.ID,
+) (*ImportPurchasesResult, error) {
+	rows, err := readPurchases(req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(rows))
+	for _, row := range rows {
+		codes = append(codes, row.unit)
+	}
+
+	tractorIDs, err := s.repo.ResolveTractorCodes(ctx, &repositories.ResolveTractorCodesRequest{
+		TenantInfo: req.TenantInfo,
+		Codes:      codes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	purchases, err := buildPurchases(req.TenantInfo, rows, tractorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	imported, err := s.repo.ImportFuelPurchases(ctx, purchases)
+	if err != nil {
+		s.l.Error("failed to import fuel purchases", zap.Error(err))
+		return nil, err
+	}
+
+	result := &ImportPurchasesResult{
+		Read:     len(purchases),
+		Imported: imported,
+		Skipped:  len(purchases) - imported,
+	}
+
+	s.logAction(&services.LogActionParams{
+		ResourceID:     req.TenantInfo.OrgID.String(),
+		Operation:      permission.OpImport,
+		UserID:         userID,
+		CurrentState:   jsonutils.MustToJSON(result),
+		OrganizationID: req.TenantInfo.OrgID,
+		BusinessUnitID: req.TenantInfo.BuID,
+	}, auditservice.WithComment("Fuel purchases imported"))
+
+	return result, nil
+}
+
+func (s *Service) ListTaxRates(
+	ctx context.Context,
+	req *QuarterRequest,
+) ([]*ifta.TaxRate, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListTaxRates(ctx, &repositories.ListIFTATaxRatesRequest{
+		TenantInfo: req.TenantInfo,
+		Period:     req.Period,
+	})
+}
+
+type UpsertTaxRatesRequest struct {
+	TenantInfo pagination.TenantInfo
+	Period     ifta.Quarter
+	Rates      []*ifta.TaxRate
+}
+
+// UpsertTaxRates records the quarter's rate matrix. Rates are published per
+// quarter, so every row is stamped with the requested period whatever it said.
+func (s *Service) UpsertTaxRates(
+	ctx context.Context,
+	req *UpsertTaxRatesRequest,
+	userID pulid.ID,
+) ([]*ifta.TaxRate, error) {
+	multiErr := errortypes.NewMultiError()
+	req.Period.Validate(multiErr)
+
+	seen := make(map[string]struct{}, len(req.Rates))
+	for index, rate := range req.Rates {
+		rate.OrganizationID = req.TenantInfo.OrgID
+		rate.BusinessUnitID = req.TenantInfo.BuID
+		rate.Year = req.Period.Year
+		rate.Quarter = req.Period.Quarter
+		rate.Jurisdiction = ifta.NormalizeJurisdiction(rate.Jurisdiction)
+
+		rowErr := multiErr.WithIndex("rates", index)
+		rate.Validate(rowErr)
+
+		key := rate.Jurisdiction + "/" + rate.FuelType.String()
+		if _, duplicate := seen[key]; duplicate {
+			rowErr.Add("jurisdiction", errortypes.ErrDuplicate,
+				"Jurisdiction appears more than once for this fuel type")
+		}
+		seen[key] = struct{}{}
+	}
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	rates, err := s.repo.UpsertTaxRates(ctx, req.Rates)
+	if err != nil {
+		s.l.Error("failed to upsert ifta tax rates", zap.Error(err))
+		return nil, err
+	}
+
+	s.logAction(&services.LogActionParams{
+		ResourceID:     req.Period.String(),
+		Operation:      permission.OpUpdate,
+		UserID:         userID,
+		CurrentState:   jsonutils.MustToJSON(rates),
+		OrganizationID: req.TenantInfo.OrgID,
+		BusinessUnitID: req.TenantInfo.BuID,
+	}, auditservice.WithComment("IFTA tax rates updated"))
+
+	return rates, nil
+}
+
+// Mileage attributes every tracked tractor's quarter to jurisdictions. Each
+// tractor's history is read and reduced on its own so the fleet's fixes are
+// never held at once; the boundary outlines are read once and shared.
+func (s *Service) Mileage(
+	ctx context.Context,
+	req *QuarterRequest,
+) ([]*ifta.VehicleMileage, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	start, end := req.Period.Bounds()
+
+	tractors, err := s.repo.ListTrackedTractors(ctx, &repositories.ListTrackedTractorsRequest{
+		TenantInfo: req.TenantInfo,
+		Start:      start,
+		End:        end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outlines, err := s.repo.ListJurisdictionBoundaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	boundaries := ifta.NewBoundaryMap(outlines)
+
+	vehicles := make([]*ifta.VehicleMileage, 0, len(tractors))
+	for _, tracked := range tractors {
+		history, hErr := s.repo.ListPositionHistory(
+			ctx,
+			&repositories.ListPositionHistoryRequest{
+				TenantInfo: req.TenantInfo,
+				TractorID:  tracked.TractorID,
+				Start:      start,
+				End:        end,
+			},
+		)
+		if hErr != nil {
+			return nil, hErr
+		}
+
+		points := make([]ifta.TrackPoint, 0, len(history))
+		for _, fix := range history {
+			points = append(points, ifta.TrackPoint{
+				RecordedAt:     fix.RecordedAt,
+				Latitude:       fix.Latitude,
+				Longitude:      fix.Longitude,
+				OdometerMeters: fix.OdometerMeters,
+				Jurisdiction:   ifta.JurisdictionFromLocation(fix.FormattedLocation),
+			})
+		}
+
+		vehicle := ifta.AttributeMileage(tracked.TractorID, points, boundaries)
+		vehicle.TractorCode = tracked.Code
+		for _, gap := range vehicle.Gaps {
+			gap.TractorCode = tracked.Code
+		}
+		vehicles = append(vehicles, vehicle)
+	}
+
+	return vehicles, nil
+}
+
+// Return computes the quarter's return for one fuel type. A tractor is
+// reported under the fuel it mostly bought that quarter, since the position
+// history does not say what it burns; one with no purchases is taken to be
+// diesel, which is what nearly every qualified motor vehicle runs on.
+func (s *Service) Return(
+	ctx context.Context,
+	req *ReturnRequest,
+) (*ifta.TaxReturn, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	quarter := &QuarterRequest{TenantInfo: req.TenantInfo, Period: req.Period}
+
+	vehicles, err := s.Mileage(ctx, quarter)
+	if err != nil {
+		return nil, err
+	}
+
+	purchases, err := s.ListPurchases(ctx, &ListPurchasesRequest{
+		TenantInfo: req.TenantInfo,
+		Period:     req.Period,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.repo.ListTaxRates(ctx, &repositories.ListIFTATaxRatesRequest{
+		TenantInfo: req.TenantInfo,
+		Period:     req.Period,
+		FuelType:   req.FuelType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fuelTypes := predominantFuelTypes(purchases)
+	fuelTypeOf := func(tractorID pulid.ID) ifta.FuelType {
+		if fuelType, ok := fuelTypes[tractorID]; ok {
+			return fuelType
+		}
+		return ifta.FuelTypeDiesel
+	}
+
+	input := &ifta.ReturnInput{
+		Period:    req.Period,
+		FuelType:  req.FuelType,
+		Vehicles:  make([]*ifta.VehicleMileage, 0, len(vehicles)),
+		Purchases: make([]*ifta.FuelPurchase, 0, len(purchases)),
+		Rates:     rates,
+	}
+	for _, vehicle := range vehicles {
+		if fuelTypeOf(vehicle.TractorID) == req.FuelType {
+			input.Vehicles = append(input.Vehicles, vehicle)
+		}
+	}
+	for _, purchase := range purchases {
+		if purchase.FuelType == req.FuelType {
+			input.Purchases = append(input.Purchases, purchase)
+		}
+	}
+
+	return ifta.ComputeReturn(input), nil
+}
+
+// ExportReturn renders the return as a CSV in the schedule layout.
+func (s *Service) ExportReturn(
+	ctx context.Context,
+	req *ReturnRequest,
+) (fileName string, content []byte, err error) {
+	result, err := s.Return(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	content, err = writeReturn(result)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return exportFileName(result), content, nil
+}
+
+func predominantFuelTypes(purchases []*ifta.FuelPurchase) map[pulid.ID]ifta.FuelType {
+	gallons := make(map[pulid.ID]map[ifta.FuelType]float64)
+	for _, purchase := range purchases {
+		byType, ok := gallons[purchase.TractorID]
+		if !ok {
+			byType = make(map[ifta.FuelType]float64, 2)
+			gallons[purchase.TractorID] = byType
+		}
+		byType[purchase.FuelType] += purchase.Gallons.InexactFloat64()
+	}
+
+	fuelTypes := make(map[pulid.ID]ifta.FuelType, len(gallons))
+	for tractorID, byType := range gallons {
+		// Diesel wins a tie, matching the default for a tractor with no fuel.
+		fuelType := ifta.FuelTypeDiesel
+		if byType[ifta.FuelTypeGasoline] > byType[ifta.FuelTypeDiesel] {
+			fuelType = ifta.FuelTypeGasoline
+		}
+		fuelTypes[tractorID] = fuelType
+	}
+
+	return fuelTypes
+}
+
+func (s *Service) logAction(params *services.LogActionParams, opts ...services.LogOption) {
+	params.Resource = permission.ResourceIFTA
+	if err := s.auditService.LogAction(params, opts...); err != nil {
+		s.l.Error("failed to log audit action", zap.Error(err))
+	}
+}
@@ -0,0 +1,240 @@
+package iftaservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fieldsOf(t *testing.T, err error) []string {
+	t.Helper()
+
+	multiErr, ok := err.(*errortypes.MultiError)
+	require.True(t, ok, "expected a MultiError, got %T", err)
+
+	fields := make([]string, 0, len(multiErr.Errors))
+	for _, problem := range multiErr.Errors {
+		fields = append(fields, problem.Field)
+	}
+	return fields
+}
+
+func TestReadPurchases_MatchesFuelCardHeaders(t *testing.T) {
+	t.Parallel()
+
+	content := "\ufeffTransaction Date,Unit #,State,Quantity,UOM,Product,Amount,Merchant,Invoice\n" +
+		"2026-07-14,T100,il,120.5,GAL,ULSD,\"$512.13\",Pilot 1234,R-1\n" +
+		",,,,,,,,\n" +
+		"07/15/2026,T200,Indiana,80,GAL,Diesel,340,Love's 55,R-2\n"
+
+	rows, err := readPurchases([]byte(content))
+	require.NoError(t, err)
+	require.Len(t, rows, 2, "the blank line is skipped")
+	assert.Equal(t, 2, rows[0].line)
+	assert.Equal(t, 4, rows[1].line, "line numbers count the skipped blank")
+	assert.Equal(t, "T100", rows[0].unit)
+	assert.Equal(t, "Pilot 1234", rows[0].cells[columnVendor])
+}
+
+func TestReadPurchases_RefusesAFileMissingRequiredColumns(t *testing.T) {
+	t.Parallel()
+
+	_, err := readPurchases([]byte("Date,Unit,Vendor\n2026-07-14,T100,Pilot\n"))
+
+	require.Error(t, err)
+	assert.ElementsMatch(t, []string{"file", "file"}, fieldsOf(t, err))
+	assert.Contains(t, err.Error(), "jurisdiction")
+	assert.Contains(t, err.Error(), "gallons")
+}
+
+func TestReadPurchases_RefusesAQuantityWithoutAUnit(t *testing.T) {
+	t.Parallel()
+
+	_, err := readPurchases([]byte("Date,Unit,State,Quantity\n2026-07-14,T100,IL,50\n"))
+
+	require.Error(t, err)
+	assert.Equal(t, []string{"file"}, fieldsOf(t, err))
+	assert.Contains(t, err.Error(), "gallons or litres")
+}
+
+func TestBuildPurchases(t *testing.T) {
+	t.Parallel()
+
+	tenant := pagination.TenantInfo{OrgID: pulid.MustNew("org_"), BuID: pulid.MustNew("bu_")}
+	tractorID := pulid.MustNew("tr_")
+	tractors := map[string]pulid.ID{"T100": tractorID}
+
+	t.Run("reads every column", func(t *testing.T) {
+		t.Parallel()
+
+		rows, err := readPurchases([]byte(
+			"Date,Unit,Jurisdiction,Gallons,Fuel Type,Total Cost,Receipt,Tax Paid\n" +
+				"2026-09-30,T100,Ontario,\"1,200.25\",gas,\"$4,010.00\",R-9,no\n",
+		))
+		require.NoError(t, err)
+
+		purchases, err := buildPurchases(tenant, rows, tractors)
+		require.NoError(t, err)
+		require.Len(t, purchases, 1)
+
+		purchase := purchases[0]
+		assert.Equal(t, tractorID, purchase.TractorID)
+		assert.Equal(t, tenant.OrgID, purchase.OrganizationID)
+		assert.Equal(t, "ON", purchase.Jurisdiction)
+		assert.Equal(t, "1200.25", purchase.Gallons.String())
+		assert.Equal(t, "4010", purchase.TotalCost.Decimal.String())
+		assert.Equal(t, ifta.FuelTypeGasoline, purchase.FuelType)
+		assert.False(t, purchase.TaxPaid)
+		assert.Equal(t, ifta.PurchaseSourceImport, purchase.Source)
+		assert.Equal(t, ifta.Quarter{Year: 2026, Quarter: 3}, ifta.QuarterOf(purchase.PurchasedAt))
+	})
+
+	t.Run("converts litres to US gallons", func(t *testing.T) {
+		t.Parallel()
+
+		rows, err := readPurchases([]byte(
+			"Date,Unit,Province,Litres,UOM\n" +
+				"2026-07-14,T100,ON,378.5411784,\n" +
+				"2026-07-15,T100,ON,25,gal\n",
+		))
+		require.NoError(t, err)
+
+		purchases, err := buildPurchases(tenant, rows, tractors)
+		require.NoError(t, err)
+		require.Len(t, purchases, 2)
+		assert.Equal(t, "100", purchases[0].Gallons.String(),
+			"a blank unit cell takes the unit the header names")
+		assert.Equal(t, "25", purchases[1].Gallons.String(),
+			"the row's own unit wins over the header")
+	})
+
+	t.Run("refuses a unit it does not know", func(t *testing.T) {
+		t.Parallel()
+
+		rows, err := readPurchases([]byte(
+			"Date,Unit,Jurisdiction,Quantity,Unit of Measure\n" +
+				"2026-07-14,T100,IL,50,gal\n" +
+				"2026-07-15,T100,IL,50,\n" +
+				"2026-07-16,T100,IL,50,kg\n",
+		))
+		require.NoError(t, err)
+
+		_, err = buildPurchases(tenant, rows, tractors)
+
+		assert.ElementsMatch(t, []string{
+			"rows[3].unitOfMeasure",
+			"rows[4].unitOfMeasure",
+		}, fieldsOf(t, err))
+	})
+
+	t.Run("reports every bad row against its line", func(t *testing.T) {
+		t.Parallel()
+
+		rows, err := readPurchases([]byte(
+			"Date,Unit,Jurisdiction,Gallons\n" +
+				"2026-07-14,T100,IL,50\n" +
+				"yesterday,T999,ZZ,lots\n" +
+				"2026-07-16,T100,IL,-4\n",
+		))
+		require.NoError(t, err)
+
+		purchases, err := buildPurchases(tenant, rows, tractors)
+
+		assert.Nil(t, purchases, "one bad row fails the whole file")
+		assert.ElementsMatch(t, []string{
+			"rows[3].unit",
+			"rows[3].jurisdiction",
+			"rows[3].date",
+			"rows[3].gallons",
+			"rows[4].gallons",
+		}, fieldsOf(t, err))
+	})
+}
+
+func TestWriteReturn_LaysOutTheSchedule(t *testing.T) {
+	t.Parallel()
+
+	tractorID := pulid.MustNew("tr_")
+	result := ifta.ComputeReturn(&ifta.ReturnInput{
+		Period:   ifta.Quarter{Year: 2026, Quarter: 3},
+		FuelType: ifta.FuelTypeDiesel,
+		Vehicles: []*ifta.VehicleMileage{{
+			TractorID:   tractorID,
+			TractorCode: "T100",
+			Jurisdictions: []*ifta.JurisdictionMiles{
+				{Jurisdiction: "IL", Miles: 600},
+				{Jurisdiction: "IN", Miles: 400, EstimatedMiles: 30},
+			},
+			Gaps: []*ifta.Gap{{
+				TractorID:        tractorID,
+				TractorCode:      "T100",
+				Kind:             ifta.GapKindCoverage,
+				StartedAt:        1_783_000_000,
+				EndedAt:          1_783_003_600,
+				FromJurisdiction: "IL",
+				ToJurisdiction:   "IN",
+				Miles:            61.2,
+			}},
+		}},
+		Purchases: []*ifta.FuelPurchase{{
+			TractorID:    tractorID,
+			Jurisdiction: "IN",
+			FuelType:     ifta.FuelTypeDiesel,
+			Gallons:      decimal.NewFromInt(200),
+			TaxPaid:      true,
+		}},
+		Rates: []*ifta.TaxRate{
+			{Jurisdiction: "IL", FuelType: ifta.FuelTypeDiesel, Rate: decimal.RequireFromString("0.7")},
+			{
+				Jurisdiction:  "IN",
+				FuelType:      ifta.FuelTypeDiesel,
+				Rate:          decimal.RequireFromString("0.6"),
+				SurchargeRate: decimal.RequireFromString("0.1"),
+			},
+		},
+	})
+
+	content, err := writeReturn(result)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Contains(t, lines, "Fleet MPG,5.00")
+	assert.Contains(t, lines, strings.Join(scheduleHeader, ","))
+	assert.Contains(t, lines, "IL,Fuel,0.7000,600,600,120,0,120,84.00,0.00,84.00")
+	assert.Contains(t, lines, "IN,Fuel,0.6000,400,400,80,200,-120,-72.00,0.00,-72.00")
+	assert.Contains(t, lines, "IN,Surcharge,0.1000,,,80,,80,8.00,0.00,8.00")
+	assert.Contains(t, lines, "Total,,,1000,1000,200,200,0,20.00,0.00,20.00")
+	assert.Contains(t, lines, "T100,CoverageGap,2026-07-02T13:46:40Z,2026-07-02T14:46:40Z,IL,IN,61.2")
+	assert.Equal(t, "ifta-2026Q3-diesel.csv", exportFileName(result))
+}
+
+func TestPredominantFuelTypes(t *testing.T) {
+	t.Parallel()
+
+	pickup := pulid.MustNew("tr_")
+	even := pulid.MustNew("tr_")
+	buy := func(tractorID pulid.ID, fuelType ifta.FuelType, gallons int64) *ifta.FuelPurchase {
+		return &ifta.FuelPurchase{
+			TractorID: tractorID,
+			FuelType:  fuelType,
+			Gallons:   decimal.NewFromInt(gallons),
+		}
+	}
+
+	fuelTypes := predominantFuelTypes([]*ifta.FuelPurchase{
+		buy(pickup, ifta.FuelTypeGasoline, 90),
+		buy(pickup, ifta.FuelTypeDiesel, 40),
+		buy(even, ifta.FuelTypeGasoline, 50),
+		buy(even, ifta.FuelTypeDiesel, 50),
+	})
+
+	assert.Equal(t, ifta.FuelTypeGasoline, fuelTypes[pickup])
+	assert.Equal(t, ifta.FuelTypeDiesel, fuelTypes[even], "diesel wins a tie")
+}
@@ -0,0 +1,37 @@
+package integrationservice
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/integration"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	sharedmotive "github.com/emoss08/trenova/shared/motive"
+)
+
+func (s *Service) MotiveClient(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+) (*sharedmotive.Client, error) {
+	runtimeCfg, err := s.GetRuntimeConfig(ctx, tenantInfo, integration.TypeMotive)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey := runtimeCfg.Config["apiKey"]
+	if apiKey == "" {
+		return nil, errortypes.NewBusinessError("Motive integration is not configured")
+	}
+
+	client, err := sharedmotive.New(
+		apiKey,
+		sharedmotive.WithBaseURL(runtimeCfg.Config["baseUrl"]),
+	)
+	if err != nil {
+		return nil, errortypes.NewBusinessError(
+			"failed to initialize Motive client",
+		).WithInternal(err)
+	}
+
+	return client, nil
+}
@@ -139,6 +139,8 @@ func webhookTokenPrefix(typ integration.Type) string {
 		return "postmark_"
 	case integration.TypeSamsara:
 		return "samsara_"
+	case integration.TypeMotive:
+		return "motive_"
 	default:
 		return ""
 	}
@@ -19,7 +19,7 @@ import (
 	"go.uber.org/zap"
 )
 
-const expectedCatalogItems = 12
+const expectedCatalogItems = 13
 
 type stubIntegrationRepo struct {
 	listByTenantResult []*integration.Integration
@@ -185,15 +185,16 @@ func TestListCatalogSortedBySortOrderThenName(t *testing.T) {
 	require.Equal(t, integration.TypeResend, resp.Items[0].Type)
 	require.Equal(t, integration.TypePostmark, resp.Items[1].Type)
 	require.Equal(t, integration.TypeSamsara, resp.Items[2].Type)
-	require.Equal(t, integration.TypeGoogleMaps, resp.Items[3].Type)
-	require.Equal(t, integration.TypePCMiler, resp.Items[4].Type)
-	require.Equal(t, integration.TypeOpenWeatherMap, resp.Items[5].Type)
-	require.Equal(t, integration.TypeOpenAI, resp.Items[6].Type)
-	require.Equal(t, integration.TypeOANDAExchangeRates, resp.Items[7].Type)
-	require.Equal(t, integration.TypeEIAFuelPrices, resp.Items[8].Type)
-	require.Equal(t, integration.TypeAmazonSES, resp.Items[9].Type)
-	require.Equal(t, integration.TypeSendGrid, resp.Items[10].Type)
-	require.Equal(t, integration.TypeMailgun, resp.Items[11].Type)
+	require.Equal(t, integration.TypeMotive, resp.Items[3].Type)
+	require.Equal(t, integration.TypeGoogleMaps, resp.Items[4].Type)
+	require.Equal(t, integration.TypePCMiler, resp.Items[5].Type)
+	require.Equal(t, integration.TypeOpenWeatherMap, resp.Items[6].Type)
+	require.Equal(t, integration.TypeOpenAI, resp.Items[7].Type)
+	require.Equal(t, integration.TypeOANDAExchangeRates, resp.Items[8].Type)
+	require.Equal(t, integration.TypeEIAFuelPrices, resp.Items[9].Type)
+	require.Equal(t, integration.TypeAmazonSES, resp.Items[10].Type)
+	require.Equal(t, integration.TypeSendGrid, resp.Items[11].Type)
+	require.Equal(t, integration.TypeMailgun, resp.Items[12].Type)
 }
 
 func TestListCatalogIncludesPlannedEmailProviderLogos(t *testing.T) {
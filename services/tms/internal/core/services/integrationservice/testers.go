@@ -11,6 +11,8 @@ import (
 
 	"github.com/bytedance/sonic"
 	"github.com/emoss08/trenova/internal/core/domain/integration"
+	sharedmotive "github.com/emoss08/trenova/shared/motive"
+	"github.com/emoss08/trenova/shared/motive/users"
 	"github.com/emoss08/trenova/shared/pcmiler"
 	sharedsamsara "github.com/emoss08/trenova/shared/samsara"
 	"github.com/emoss08/trenova/shared/samsara/drivers"
@@ -22,6 +24,7 @@ type connectionTester interface {
 
 var connectionTesters = map[integration.Type]connectionTester{
 	integration.TypeSamsara:            &samsaraConnectionTester{},
+	integration.TypeMotive:             &motiveConnectionTester{},
 	integration.TypeOANDAExchangeRates: &oandaExchangeRatesConnectionTester{},
 	integration.TypeEIAFuelPrices:      &eiaFuelPricesConnectionTester{},
 	integration.TypePCMiler:            &pcmilerConnectionTester{},
@@ -259,6 +262,22 @@ func (t *samsaraConnectionTester) Test(ctx context.Context, cfg map[string]strin
 	return err
 }
 
+type motiveConnectionTester struct{}
+
+func (t *motiveConnectionTester) Test(ctx context.Context, cfg map[string]string) error {
+	client, err := sharedmotive.New(
+		cfg["apiKey"],
+		sharedmotive.WithBaseURL(cfg["baseUrl"]),
+		sharedmotive.WithTimeout(15*time.Second),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Users.List(ctx, users.ListParams{Role: users.RoleDriver, PerPage: 1})
+	return err
+}
+
 type pcmilerConnectionTester struct{}
 
 func (t *pcmilerConnectionTester) Test(ctx context.Context, cfg map[string]string) error {
@@ -25,6 +25,7 @@ import (
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/accountingcontrolrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/billingcontrolrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/billingqueuerepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/domaineventrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/fiscalperiodrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/fiscalyearrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/invoicerepository"
@@ -256,6 +257,9 @@ func TestGroupedInvoiceFromOrderEndToEnd(t *testing.T) {
 		billingRepo:      billingRepo,
 		accountingRepo:   accountingRepo,
 		journalRepo:      journalRepo,
+		outboxRepo: domaineventrepository.New(
+			domaineventrepository.Params{DB: conn, Logger: logger},
+		),
 		validator: NewValidator(ValidatorParams{
 			DB:               conn,
 			Logger:           logger,
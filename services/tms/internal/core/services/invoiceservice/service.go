@@ -9,6 +9,7 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/emoss08/trenova/internal/core/domain/billingqueue"
 	"github.com/emoss08/trenova/internal/core/domain/customer"
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
 	"github.com/emoss08/trenova/internal/core/domain/invoice"
 	"github.com/emoss08/trenova/internal/core/domain/notification"
 	"github.com/emoss08/trenova/internal/core/domain/order"
@@ -56,6 +57,7 @@ type Params struct {
 	AccountingRepo      repositories.AccountingControlRepository
 	JournalRepo         repositories.JournalPostingRepository
 	AdjustmentRepo      repositories.InvoiceAdjustmentRepository
+	OutboxRepo          repositories.DomainEventOutboxRepository
 	NotificationService *notificationservice.Service
 	EmailRepo           repositories.EmailRepository
 	Validator           *Validator
@@ -87,6 +89,7 @@ type Service struct {
 	accountingRepo      repositories.AccountingControlRepository
 	journalRepo         repositories.JournalPostingRepository
 	adjustmentRepo      repositories.InvoiceAdjustmentRepository
+	outboxRepo          repositories.DomainEventOutboxRepository
 	notificationService *notificationservice.Service
 	emailRepo           repositories.EmailRepository
 	validator           *Validator
@@ -138,6 +141,7 @@ func New(p Params) servicesports.InvoiceService { //nolint:gocritic // stable AP
 		accountingRepo:      p.AccountingRepo,
 		journalRepo:         p.JournalRepo,
 		adjustmentRepo:      p.AdjustmentRepo,
+		outboxRepo:          p.OutboxRepo,
 		notificationService: p.NotificationService,
 		emailRepo:           p.EmailRepo,
 		validator:           p.Validator,
@@ -401,6 +405,13 @@ func (s *Service) Post( //nolint:funlen // legacy workflow
 			return postErr
 		}
 
+		if eventErr := s.outboxRepo.Append(
+			txCtx,
+			domainevent.NewInvoicePosted(updated),
+		); eventErr != nil {
+			return eventErr
+		}
+
 		posted = updated
 
 		s.logAction(updated, auditActor, permission.OpUpdate, &previous, updated, "Invoice posted")
@@ -23,6 +23,7 @@ import (
 	"github.com/emoss08/trenova/internal/infrastructure/postgres"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/accountingcontrolrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/billingqueuerepository"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/domaineventrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/fiscalperiodrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/fiscalyearrepository"
 	"github.com/emoss08/trenova/internal/infrastructure/postgres/repositories/invoicerepository"
@@ -252,6 +253,9 @@ func TestPostCreatesInvoiceJournalSourceAndBalances(t *testing.T) {
 		shipmentRepo:     shipmentRepo,
 		accountingRepo:   accountingRepo,
 		journalRepo:      journalRepo,
+		outboxRepo: domaineventrepository.New(
+			domaineventrepository.Params{DB: conn, Logger: logger},
+		),
 		validator: NewValidator(
 			ValidatorParams{
 				DB:               conn,
@@ -302,6 +306,21 @@ func TestPostCreatesInvoiceJournalSourceAndBalances(t *testing.T) {
 	assert.Equal(t, int64(10000), entry.TotalDebit)
 	assert.Equal(t, int64(10000), entry.TotalCredit)
 
+	var event struct {
+		EventType      string `bun:"event_type"`
+		IdempotencyKey string `bun:"idempotency_key"`
+	}
+	require.NoError(
+		t,
+		db.NewSelect().
+			Table("domain_event_outbox").
+			Column("event_type", "idempotency_key").
+			Where("aggregate_id = ?", entity.ID.String()).
+			Scan(ctx, &event),
+	)
+	assert.Equal(t, "invoice.posted", event.EventType)
+	assert.Equal(t, "invoice.posted:"+entity.ID.String(), event.IdempotencyKey)
+
 	var source struct {
 		SourceEventType string `bun:"source_event_type"`
 		Status          string `bun:"status"`
@@ -544,6 +563,9 @@ func TestPostSkipsInvoiceLedgerWhenRevenueRecognitionIsCashReceipt(t *testing.T)
 		shipmentRepo:     shipmentRepo,
 		accountingRepo:   accountingRepo,
 		journalRepo:      journalRepo,
+		outboxRepo: domaineventrepository.New(
+			domaineventrepository.Params{DB: conn, Logger: logger},
+		),
 		validator: NewValidator(
 			ValidatorParams{
 				DB:               conn,
@@ -0,0 +1,94 @@
+package realtimeservice
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/infrastructure/realtimehub"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// frameSubscriber is the part of the hub the event source reads from.
+type frameSubscriber interface {
+	Subscribe(ctx context.Context, channel string) (<-chan *realtimehub.ServerFrame, error)
+}
+
+type EventSourceParams struct {
+	fx.In
+
+	Logger *zap.Logger
+	Hub    *realtimehub.Hub
+}
+
+type EventSource struct {
+	l   *zap.Logger
+	hub frameSubscriber
+}
+
+func NewEventSource(p EventSourceParams) services.ResourceEventSource {
+	return &EventSource{
+		l:   p.Logger.Named("service.realtime.events"),
+		hub: p.Hub,
+	}
+}
+
+// resourceEventEnvelope decodes a published event while keeping the entity as
+// raw JSON; only the consumer knows what type it should become.
+type resourceEventEnvelope struct {
+	services.ResourceInvalidationEvent
+
+	Entity json.RawMessage `json:"entity,omitempty"`
+}
+
+func (s *EventSource) SubscribeResourceEvents(
+	ctx context.Context,
+	orgID, buID pulid.ID,
+) (<-chan *services.ResourceInvalidationEvent, error) {
+	if orgID.IsNil() || buID.IsNil() {
+		return nil, errortypes.NewBusinessError("invalid realtime tenant context")
+	}
+
+	frames, err := s.hub.Subscribe(
+		ctx,
+		tenantDataEventsChannelName(orgID.String(), buID.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *services.ResourceInvalidationEvent)
+	go func() {
+		defer close(events)
+
+		for frame := range frames {
+			if frame.Action != realtimehub.ActionMessage ||
+				frame.Name != resourceInvalidationEventName {
+				continue
+			}
+
+			envelope := new(resourceEventEnvelope)
+			if decodeErr := sonic.Unmarshal(frame.Data, envelope); decodeErr != nil {
+				s.l.Warn("dropping undecodable resource event", zap.Error(decodeErr))
+				continue
+			}
+
+			event := envelope.ResourceInvalidationEvent
+			if len(envelope.Entity) > 0 {
+				event.Entity = envelope.Entity
+			}
+
+			select {
+			case events <- &event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,31 @@
+package realtimeservice
+
+import (
+	"context"
+	"time"
+
+	realtime "github.com/Foony-Limited/realtime-go"
+	"github.com/emoss08/trenova/internal/infrastructure/realtimehub"
+)
+
+type foonyProvider struct {
+	apiKey string
+	client *realtime.Rest
+}
+
+func (p *foonyProvider) CreateToken(
+	clientID string,
+	capability realtimehub.Capability,
+	ttl time.Duration,
+) (string, error) {
+	return realtime.CreateJWT(p.apiKey, realtime.CreateJWTParams{
+		ClientID:   clientID,
+		TTL:        ttl,
+		Capability: realtime.Capability(capability),
+	})
+}
+
+func (p *foonyProvider) Publish(ctx context.Context, channel, name string, data any) error {
+	_, err := p.client.Channels.Get(channel).Publish(ctx, name, data)
+	return err
+}
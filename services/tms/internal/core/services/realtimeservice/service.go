@@ -9,35 +9,65 @@ import (
 	realtime "github.com/Foony-Limited/realtime-go"
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/emoss08/trenova/internal/infrastructure/realtimehub"
 	"github.com/emoss08/trenova/pkg/errortypes"
 	"github.com/emoss08/trenova/shared/pulid"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
-const defaultTokenTTL = time.Hour
 const resourceInvalidationEventName = "resource.invalidation"
 
+// provider is the realtime backend tokens are minted for and events are
+// published through. Channel names and capabilities are the same for every
+// provider, so clients only differ in how they connect.
+type provider interface {
+	CreateToken(clientID string, capability realtimehub.Capability, ttl time.Duration) (string, error)
+	Publish(ctx context.Context, channel, name string, data any) error
+}
+
 type Params struct {
 	fx.In
 
 	Logger *zap.Logger
 	Config *config.Config
 	Client *realtime.Rest
+	Hub    *realtimehub.Hub
 }
 
 type Service struct {
-	l      *zap.Logger
-	apiKey string
-	client *realtime.Rest
+	l            *zap.Logger
+	provider     provider
+	providerName string
+	tokenTTL     time.Duration
+	// fanout mirrors events onto the hub when clients use another provider, so
+	// in-process consumers see them either way.
+	fanout provider
 }
 
 func New(p Params) services.RealtimeService {
-	return &Service{
-		l:      p.Logger.Named("service.realtime"),
-		apiKey: p.Config.GetFoonyConfig().APIKey,
-		client: p.Client,
+	realtimeCfg := p.Config.GetRealtimeConfig()
+
+	svc := &Service{
+		l:            p.Logger.Named("service.realtime"),
+		providerName: realtimeCfg.GetProvider(),
+		tokenTTL:     realtimeCfg.GetTokenTTL(),
+	}
+
+	switch {
+	case realtimeCfg.IsBuiltin() && p.Hub != nil:
+		svc.provider = p.Hub
+	case p.Client != nil:
+		svc.provider = &foonyProvider{
+			apiKey: p.Config.GetFoonyConfig().APIKey,
+			client: p.Client,
+		}
+		if p.Hub != nil {
+			svc.fanout = p.Hub
+		}
 	}
+
+	return svc
 }
 
 func (s *Service) CreateToken(
@@ -51,30 +81,32 @@ func (s *Service) CreateToken(
 		return nil, errortypes.NewBusinessError("invalid realtime auth context")
 	}
 
-	if s.client == nil {
+	if s.provider == nil {
 		return nil, errortypes.NewBusinessError("realtime service is not configured")
 	}
 
 	clientID := req.UserID.String()
-	token, err := realtime.CreateJWT(s.apiKey, realtime.CreateJWTParams{
-		ClientID:   clientID,
-		TTL:        defaultTokenTTL,
-		Capability: tenantCapability(req.OrganizationID.String(), req.BusinessUnitID.String()),
-	})
+	token, err := s.provider.CreateToken(
+		clientID,
+		tenantCapability(req.OrganizationID.String(), req.BusinessUnitID.String()),
+		s.tokenTTL,
+	)
 	if err != nil {
-		s.l.Error("failed to mint Foony token", zap.Error(err))
+		s.l.Error("failed to mint realtime token",
+			zap.String("provider", s.providerName), zap.Error(err))
 		return nil, fmt.Errorf("mint realtime token: %w", err)
 	}
 
 	return &services.RealtimeToken{
 		Token:     token,
 		ClientID:  clientID,
-		ExpiresAt: time.Now().Add(defaultTokenTTL).UnixMilli(),
+		ExpiresAt: time.Now().Add(s.tokenTTL).UnixMilli(),
+		Provider:  s.providerName,
 	}, nil
 }
 
-func tenantCapability(orgID, buID string) realtime.Capability {
-	return realtime.Capability{
+func tenantCapability(orgID, buID string) realtimehub.Capability {
+	return realtimehub.Capability{
 		fmt.Sprintf("tenant:%s:%s:*", orgID, buID):        {"subscribe", "presence", "history"},
 		fmt.Sprintf("tenant:%s:%s:typing:*", orgID, buID): {"subscribe", "publish", "presence"},
 	}
@@ -100,7 +132,7 @@ func (s *Service) PublishResourceInvalidation(
 		return errortypes.NewBusinessError("invalid realtime invalidation payload")
 	}
 
-	if s.client == nil {
+	if s.provider == nil {
 		return errortypes.NewBusinessError("realtime service is not configured")
 	}
 
@@ -147,8 +179,9 @@ func (s *Service) PublishResourceInvalidation(
 		event.ActorAPIKeyID = req.ActorAPIKeyID.String()
 	}
 
-	if _, err := s.client.Channels.Get(channelName).Publish(
+	if err := s.provider.Publish(
 		ctx,
+		channelName,
 		resourceInvalidationEventName,
 		event,
 	); err != nil {
@@ -161,6 +194,21 @@ func (s *Service) PublishResourceInvalidation(
 		return fmt.Errorf("publish realtime invalidation event: %w", err)
 	}
 
+	if s.fanout != nil {
+		if err := s.fanout.Publish(
+			ctx,
+			channelName,
+			resourceInvalidationEventName,
+			event,
+		); err != nil {
+			s.l.Warn(
+				"failed to fan out realtime invalidation event",
+				zap.Error(err),
+				zap.String("channel", channelName),
+			)
+		}
+	}
+
 	return nil
 }
 
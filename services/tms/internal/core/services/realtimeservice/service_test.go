@@ -1,13 +1,17 @@
 package realtimeservice
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
 
 	realtime "github.com/Foony-Limited/realtime-go"
 	servicesport "github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/emoss08/trenova/internal/infrastructure/realtimehub"
 	"github.com/emoss08/trenova/shared/pulid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -23,9 +27,10 @@ func TestCreateToken(t *testing.T) {
 	require.NoError(t, err)
 
 	svc := &Service{
-		l:      zap.NewNop(),
-		apiKey: testAPIKey,
-		client: client,
+		l:            zap.NewNop(),
+		provider:     &foonyProvider{apiKey: testAPIKey, client: client},
+		providerName: config.RealtimeProviderFoony,
+		tokenTTL:     time.Hour,
 	}
 
 	req := &servicesport.CreateRealtimeTokenRequest{
@@ -39,6 +44,7 @@ func TestCreateToken(t *testing.T) {
 	require.NotNil(t, result)
 
 	assert.Equal(t, req.UserID.String(), result.ClientID)
+	assert.Equal(t, config.RealtimeProviderFoony, result.Provider)
 	assert.NotEmpty(t, result.Token)
 	assert.Greater(t, result.ExpiresAt, time.Now().UnixMilli())
 
@@ -68,3 +74,177 @@ func TestCreateToken_ValidationErrors(t *testing.T) {
 	_, err = svc.CreateToken(&servicesport.CreateRealtimeTokenRequest{})
 	require.Error(t, err)
 }
+
+type recordingProvider struct {
+	channel    string
+	name       string
+	data       any
+	capability realtimehub.Capability
+}
+
+func (p *recordingProvider) CreateToken(
+	_ string,
+	capability realtimehub.Capability,
+	_ time.Duration,
+) (string, error) {
+	p.capability = capability
+	return "token", nil
+}
+
+func (p *recordingProvider) Publish(_ context.Context, channel, name string, data any) error {
+	p.channel = channel
+	p.name = name
+	p.data = data
+	return nil
+}
+
+func TestCreateToken_BuiltinGrantsTenantCapability(t *testing.T) {
+	t.Parallel()
+
+	provider := &recordingProvider{}
+	svc := &Service{
+		l:            zap.NewNop(),
+		provider:     provider,
+		providerName: config.RealtimeProviderBuiltin,
+		tokenTTL:     time.Hour,
+	}
+
+	orgID := pulid.MustNew("org_")
+	buID := pulid.MustNew("bu_")
+	result, err := svc.CreateToken(&servicesport.CreateRealtimeTokenRequest{
+		UserID:         pulid.MustNew("usr_"),
+		OrganizationID: orgID,
+		BusinessUnitID: buID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, config.RealtimeProviderBuiltin, result.Provider)
+
+	tenant := "tenant:" + orgID.String() + ":" + buID.String()
+	assert.True(
+		t,
+		provider.capability.Allows(tenant+":data-events", realtimehub.OperationSubscribe),
+	)
+	assert.False(t, provider.capability.Allows(tenant+":data-events", realtimehub.OperationPublish))
+	assert.True(
+		t,
+		provider.capability.Allows(tenant+":typing:shipment:shp_1", realtimehub.OperationPublish),
+	)
+	assert.False(
+		t,
+		provider.capability.Allows(
+			"tenant:org_other:bu_other:data-events",
+			realtimehub.OperationSubscribe,
+		),
+	)
+}
+
+func TestPublishResourceInvalidation_UsesProvider(t *testing.T) {
+	t.Parallel()
+
+	provider := &recordingProvider{}
+	svc := &Service{l: zap.NewNop(), provider: provider}
+
+	orgID := pulid.MustNew("org_")
+	buID := pulid.MustNew("bu_")
+	err := svc.PublishResourceInvalidation(
+		t.Context(),
+		&servicesport.PublishResourceInvalidationRequest{
+			OrganizationID: orgID,
+			BusinessUnitID: buID,
+			Resource:       "shipments",
+			Action:         "updated",
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant:"+orgID.String()+":"+buID.String()+":data-events", provider.channel)
+	assert.Equal(t, resourceInvalidationEventName, provider.name)
+	event, ok := provider.data.(servicesport.ResourceInvalidationEvent)
+	require.True(t, ok)
+	assert.Equal(t, "shipments.updated", event.Type)
+}
+
+func TestPublishResourceInvalidation_FansOutToHub(t *testing.T) {
+	t.Parallel()
+
+	provider := &recordingProvider{}
+	fanout := &recordingProvider{}
+	svc := &Service{l: zap.NewNop(), provider: provider, fanout: fanout}
+
+	err := svc.PublishResourceInvalidation(
+		t.Context(),
+		&servicesport.PublishResourceInvalidationRequest{
+			OrganizationID: pulid.MustNew("org_"),
+			BusinessUnitID: pulid.MustNew("bu_"),
+			Resource:       "notifications",
+			Action:         "created",
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, provider.channel, fanout.channel)
+	assert.Equal(t, provider.data, fanout.data)
+}
+
+type fakeFrameSubscriber struct {
+	channel string
+	frames  chan *realtimehub.ServerFrame
+}
+
+func (f *fakeFrameSubscriber) Subscribe(
+	_ context.Context,
+	channel string,
+) (<-chan *realtimehub.ServerFrame, error) {
+	f.channel = channel
+	return f.frames, nil
+}
+
+func TestEventSource_DecodesResourceEvents(t *testing.T) {
+	t.Parallel()
+
+	hub := &fakeFrameSubscriber{frames: make(chan *realtimehub.ServerFrame, 3)}
+	source := &EventSource{l: zap.NewNop(), hub: hub}
+
+	orgID := pulid.MustNew("org_")
+	buID := pulid.MustNew("bu_")
+	events, err := source.SubscribeResourceEvents(t.Context(), orgID, buID)
+	require.NoError(t, err)
+	assert.Equal(t, tenantDataEventsChannelName(orgID.String(), buID.String()), hub.channel)
+
+	hub.frames <- &realtimehub.ServerFrame{
+		Action: realtimehub.ActionPresence,
+	}
+	hub.frames <- &realtimehub.ServerFrame{
+		Action: realtimehub.ActionMessage,
+		Name:   "typing",
+		Data:   []byte(`{}`),
+	}
+	hub.frames <- &realtimehub.ServerFrame{
+		Action: realtimehub.ActionMessage,
+		Name:   resourceInvalidationEventName,
+		Data: []byte(
+			`{"eventId":"evt_1","resource":"notifications","action":"created",` +
+				`"entity":{"id":"ntf_1"}}`,
+		),
+	}
+	close(hub.frames)
+
+	event, ok := <-events
+	require.True(t, ok)
+	assert.Equal(t, "evt_1", event.EventID)
+	assert.Equal(t, "notifications", event.Resource)
+	raw, ok := event.Entity.(json.RawMessage)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"id":"ntf_1"}`, string(raw))
+
+	_, ok = <-events
+	assert.False(t, ok)
+}
+
+func TestEventSource_RejectsMissingTenant(t *testing.T) {
+	t.Parallel()
+
+	source := &EventSource{l: zap.NewNop(), hub: &fakeFrameSubscriber{}}
+	_, err := source.SubscribeResourceEvents(t.Context(), pulid.Nil, pulid.MustNew("bu_"))
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/emoss08/trenova/internal/core/domain/integration"
 	"github.com/emoss08/trenova/internal/core/domain/worker"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/ports/services"
@@ -676,7 +677,7 @@ func (s *Service) listWorkersForSamsaraSync(
 			break
 		}
 
-		workers = append(workers, page.Items...)
+		workers = append(workers, samsaraSyncCandidates(page.Items)...)
 		if !page.HasNextPage {
 			break
 		}
@@ -695,6 +696,19 @@ func (s *Service) listWorkersForSamsaraSync(
 	return workers, nil
 }
 
+// samsaraSyncCandidates drops workers pinned to another telematics provider; in a
+// mixed fleet their external ID belongs to that provider and must not be rewritten.
+func samsaraSyncCandidates(items []*worker.Worker) []*worker.Worker {
+	out := make([]*worker.Worker, 0, len(items))
+	for _, item := range items {
+		if item.TelematicsProvider != "" && item.TelematicsProvider != integration.TypeSamsara {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
 func (s *Service) ensureSamsaraDriverExternalIDs(
 	ctx context.Context,
 	samsaraClient *sharedsamsara.Client,
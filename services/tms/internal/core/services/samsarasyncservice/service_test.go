@@ -8,6 +8,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/emoss08/trenova/internal/core/domain/integration"
 	"github.com/emoss08/trenova/internal/core/domain/worker"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/testutil/mocks"
@@ -243,6 +244,39 @@ func TestSyncWorkersToSamsara(t *testing.T) {
 		require.NotNil(t, capturedCreateReq.Phone)
 		assert.Equal(t, "+15551234567", *capturedCreateReq.Phone)
 	})
+
+	t.Run("skips workers pinned to another telematics provider", func(t *testing.T) {
+		t.Parallel()
+		svc, repo := setupTestService(t)
+		w := newTestWorker()
+		w.ExternalID = "4821"
+		w.TelematicsProvider = integration.TypeMotive
+
+		svc.samsaraClient = &sharedsamsara.Client{
+			Drivers: &fakeSamsaraDriverService{
+				createFunc: func(drivers.CreateRequest) (drivers.Driver, error) {
+					t.Fatal("motive driver must not be created in samsara")
+					return drivers.Driver{}, nil
+				},
+			},
+		}
+
+		total := 1
+		repo.On("List", mock.Anything, mock.Anything).Return(&pagination.CursorListResult[*worker.Worker]{
+			Items:      []*worker.Worker{w},
+			TotalCount: &total,
+		}, nil).Once()
+
+		result, err := svc.SyncWorkersToSamsara(t.Context(), pagination.TenantInfo{
+			OrgID: w.OrganizationID,
+			BuID:  w.BusinessUnitID,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, 0, result.TotalWorkers)
+		assert.Equal(t, 0, result.CreatedDrivers)
+	})
 }
 
 func TestGetWorkerSyncReadiness(t *testing.T) {
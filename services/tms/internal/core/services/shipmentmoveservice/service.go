@@ -491,6 +491,96 @@ func validateSplitRequest(req *repositories.SplitMoveRequest, move *shipment.Shi
 	return nil
 }
 
+func (s *service) RelayMove(
+	ctx context.Context,
+	req *repositories.RelayMoveRequest,
+) (*repositories.SplitMoveResponse, error) {
+	if multiErr := req.Validate(); multiErr != nil {
+		return nil, multiErr
+	}
+
+	var response *repositories.SplitMoveResponse
+	err := s.db.WithTx(ctx, ports.TxOptions{}, func(txCtx context.Context, _ bun.Tx) error {
+		lockedMove, err := s.repo.GetByID(txCtx, &repositories.GetMoveByIDRequest{
+			MoveID:            req.MoveID,
+			TenantInfo:        req.TenantInfo,
+			ExpandMoveDetails: false,
+			ForUpdate:         true,
+		})
+		if err != nil {
+			return err
+		}
+
+		move, err := s.repo.GetByID(txCtx, &repositories.GetMoveByIDRequest{
+			MoveID:            req.MoveID,
+			TenantInfo:        req.TenantInfo,
+			ExpandMoveDetails: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = validateRelayRequest(req, move); err != nil {
+			return err
+		}
+
+		response, err = s.repo.RelayMove(txCtx, req)
+		if err != nil {
+			return err
+		}
+
+		return s.refreshShipmentState(txCtx, lockedMove.ShipmentID, req.TenantInfo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// validateRelayRequest accepts only a simple pickup-to-delivery move that has not
+// started rolling, and a relay stop that falls between its pickup and its delivery.
+func validateRelayRequest(req *repositories.RelayMoveRequest, move *shipment.ShipmentMove) error {
+	if len(move.Stops) != 2 {
+		return errortypes.NewBusinessError("Only simple two-stop moves can be relayed").
+			WithParam("moveId", move.ID.String())
+	}
+
+	if move.Status != shipment.MoveStatusNew && move.Status != shipment.MoveStatusAssigned {
+		return errortypes.NewBusinessError("Only new or assigned moves can be relayed").
+			WithParam("moveId", move.ID.String())
+	}
+
+	originStop := move.Stops[0]
+	deliveryStop := move.Stops[1]
+	if originStop.Type != shipment.StopTypePickup ||
+		deliveryStop.Type != shipment.StopTypeDelivery {
+		return errortypes.NewBusinessError("Only pickup-to-delivery moves can be relayed").
+			WithParam("moveId", move.ID.String())
+	}
+
+	if req.RelayLocationID == originStop.LocationID ||
+		req.RelayLocationID == deliveryStop.LocationID {
+		return errortypes.NewBusinessError(
+			"Relay location must differ from the pickup and delivery locations",
+		).WithParam("moveId", move.ID.String())
+	}
+
+	if req.RelayArrival.ScheduledWindowStart < originStop.ScheduledWindowStart {
+		return errortypes.NewBusinessError(
+			"Relay arrival must occur after the pickup window opens",
+		).WithParam("moveId", move.ID.String())
+	}
+
+	if req.RelayDeparture.ScheduledWindowStart > deliveryStop.EffectiveScheduledWindowEnd() {
+		return errortypes.NewBusinessError(
+			"Relay departure must occur before the delivery window closes",
+		).WithParam("moveId", move.ID.String())
+	}
+
+	return nil
+}
+
 func (s *service) refreshShipmentState(
 	ctx context.Context,
 	shipmentID pulid.ID,
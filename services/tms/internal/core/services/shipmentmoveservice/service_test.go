@@ -384,6 +384,96 @@ func TestSplitMove_RecomputesShipmentState(t *testing.T) {
 	require.NotNil(t, response)
 }
 
+func TestValidateRelayRequest(t *testing.T) {
+	t.Parallel()
+
+	pickupLocationID := pulid.MustNew("loc_")
+	deliveryLocationID := pulid.MustNew("loc_")
+	relayMove := func() *shipment.ShipmentMove {
+		return &shipment.ShipmentMove{
+			ID:     pulid.MustNew("sm_"),
+			Status: shipment.MoveStatusAssigned,
+			Stops: []*shipment.Stop{
+				{
+					Type:                 shipment.StopTypePickup,
+					LocationID:           pickupLocationID,
+					ScheduledWindowStart: 100,
+				},
+				{
+					Type:                 shipment.StopTypeDelivery,
+					LocationID:           deliveryLocationID,
+					ScheduledWindowStart: 900,
+					ScheduledWindowEnd:   new(int64(1000)),
+				},
+			},
+		}
+	}
+	relayRequest := func() *repositories.RelayMoveRequest {
+		return &repositories.RelayMoveRequest{
+			RelayLocationID: pulid.MustNew("loc_"),
+			RelayArrival:    repositories.SplitStopTimes{ScheduledWindowStart: 400},
+			RelayDeparture:  repositories.SplitStopTimes{ScheduledWindowStart: 450},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*repositories.RelayMoveRequest, *shipment.ShipmentMove)
+		wantErr bool
+	}{
+		{
+			name:   "relay between pickup and delivery",
+			mutate: func(*repositories.RelayMoveRequest, *shipment.ShipmentMove) {},
+		},
+		{
+			name: "move already in transit",
+			mutate: func(_ *repositories.RelayMoveRequest, move *shipment.ShipmentMove) {
+				move.Status = shipment.MoveStatusInTransit
+			},
+			wantErr: true,
+		},
+		{
+			name: "relay at the delivery location",
+			mutate: func(req *repositories.RelayMoveRequest, _ *shipment.ShipmentMove) {
+				req.RelayLocationID = deliveryLocationID
+			},
+			wantErr: true,
+		},
+		{
+			name: "relay before the pickup window opens",
+			mutate: func(req *repositories.RelayMoveRequest, _ *shipment.ShipmentMove) {
+				req.RelayArrival.ScheduledWindowStart = 50
+			},
+			wantErr: true,
+		},
+		{
+			name: "relay departs after the delivery window closes",
+			mutate: func(req *repositories.RelayMoveRequest, _ *shipment.ShipmentMove) {
+				req.RelayDeparture.ScheduledWindowStart = 1100
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := relayRequest()
+			move := relayMove()
+			tt.mutate(req, move)
+
+			err := validateRelayRequest(req, move)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errortypes.IsBusinessError(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestUpdateStatus_RecomputesDelayedShipmentStateUsingControlThreshold(t *testing.T) {
 	t.Parallel()
 
@@ -5,25 +5,24 @@ import (
 
 	"github.com/emoss08/trenova/internal/core/domain/telematics"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
-	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/pkg/pagination"
 	"github.com/emoss08/trenova/shared/pulid"
 	"github.com/emoss08/trenova/shared/timeutils"
 	"go.uber.org/zap"
 )
 
-// hosLogLookbackSeconds covers one day more than the longest 8-day cycle window, so
-// every sweep re-reads the full span the projection engine reasons over and remains
-// self-healing against ELD edits and late certifications.
-const hosLogLookbackSeconds = int64(9 * 86400)
+// hosLogLookbackSeconds covers one day more than the longest window the projection
+// engine reasons over (the Canadian 14-day window), so every sweep re-reads that full
+// span and remains self-healing against ELD edits and late certifications.
+const hosLogLookbackSeconds = int64(15 * 86400)
 
 func (s *Service) syncHOSLogs(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
@@ -33,13 +32,13 @@ func (s *Service) syncHOSLogs(
 
 	now := timeutils.NowUnix()
 	windowStart := now - hosLogLookbackSeconds
-	providerType := string(provider.Type())
+	providerType := scope.providerType()
 
 	workerIDs := make([]pulid.ID, 0, len(workersByExternalID))
 	logs := make([]*telematics.WorkerHOSLog, 0, len(workersByExternalID)*16)
 	failed := 0
 	for externalID, workerID := range workersByExternalID {
-		entries, listErr := provider.ListHOSLogs(ctx, externalID, windowStart, now)
+		entries, listErr := scope.provider.ListHOSLogs(ctx, externalID, windowStart, now)
 		if listErr != nil {
 			failed++
 			s.l.Warn("failed to fetch hos logs for worker",
@@ -83,7 +82,7 @@ func (s *Service) syncHOSLogs(
 	if err != nil {
 		return err
 	}
-	result.HOSLogsUpserted = synced
+	result.HOSLogsUpserted += synced
 
 	if failed > 0 {
 		s.l.Warn("hos log sweep skipped workers after provider errors",
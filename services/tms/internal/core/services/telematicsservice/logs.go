@@ -2,7 +2,9 @@ package telematicsservice
 
 import (
 	"context"
+	"slices"
 
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/pkg/pagination"
 	"github.com/emoss08/trenova/shared/pulid"
@@ -21,21 +23,35 @@ type HOSCertificationSummary struct {
 	TotalDays       int      `json:"totalDays"`
 }
 
-func (s *Service) workerExternalID(
+// workerProvider returns the provider a worker reports through and the worker's
+// driver ID there. The ID is empty when the worker is not mapped or its provider is
+// no longer enabled.
+func (s *Service) workerProvider(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
 	workerID pulid.ID,
-) (string, error) {
+) (services.TelematicsProvider, string, error) {
 	mappings, err := s.repo.ListWorkerMappings(ctx, tenantInfo)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	for _, mapping := range mappings {
-		if mapping.WorkerID == workerID {
-			return mapping.ExternalID, nil
-		}
+
+	idx := slices.IndexFunc(mappings, func(mapping repositories.WorkerTelematicsMapping) bool {
+		return mapping.WorkerID == workerID
+	})
+	if idx < 0 {
+		return nil, "", nil
+	}
+
+	scopes, err := s.resolveScopes(ctx, tenantInfo)
+	if err != nil {
+		return nil, "", err
 	}
-	return "", nil
+	scope, ok := scopeFor(scopes, mappings[idx].Provider)
+	if !ok {
+		return nil, "", nil
+	}
+	return scope.provider, mappings[idx].ExternalID, nil
 }
 
 func (s *Service) GetWorkerHOSLogs(
@@ -45,7 +61,7 @@ func (s *Service) GetWorkerHOSLogs(
 	startAt int64,
 	endAt int64,
 ) ([]*WorkerHOSLogEntry, error) {
-	externalID, err := s.workerExternalID(ctx, tenantInfo, workerID)
+	provider, externalID, err := s.workerProvider(ctx, tenantInfo, workerID)
 	if err != nil {
 		return nil, err
 	}
@@ -53,11 +69,6 @@ func (s *Service) GetWorkerHOSLogs(
 		return []*WorkerHOSLogEntry{}, nil
 	}
 
-	provider, err := s.resolveProvider(ctx, tenantInfo)
-	if err != nil {
-		return nil, err
-	}
-
 	entries, err := provider.ListHOSLogs(ctx, externalID, startAt, endAt)
 	if err != nil {
 		return nil, err
@@ -77,7 +88,7 @@ func (s *Service) GetWorkerHOSDailyLogs(
 	startDate string,
 	endDate string,
 ) ([]*WorkerHOSDailyLog, error) {
-	externalID, err := s.workerExternalID(ctx, tenantInfo, workerID)
+	provider, externalID, err := s.workerProvider(ctx, tenantInfo, workerID)
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +96,6 @@ func (s *Service) GetWorkerHOSDailyLogs(
 		return []*WorkerHOSDailyLog{}, nil
 	}
 
-	provider, err := s.resolveProvider(ctx, tenantInfo)
-	if err != nil {
-		return nil, err
-	}
-
 	days, err := provider.ListHOSDailyLogs(ctx, externalID, startDate, endDate)
 	if err != nil {
 		return nil, err
@@ -109,7 +115,7 @@ func (s *Service) GetWorkerFormSubmissions(
 	startAt int64,
 	endAt int64,
 ) ([]*WorkerFormSubmission, error) {
-	externalID, err := s.workerExternalID(ctx, tenantInfo, workerID)
+	provider, externalID, err := s.workerProvider(ctx, tenantInfo, workerID)
 	if err != nil {
 		return nil, err
 	}
@@ -117,11 +123,6 @@ func (s *Service) GetWorkerFormSubmissions(
 		return []*WorkerFormSubmission{}, nil
 	}
 
-	provider, err := s.resolveProvider(ctx, tenantInfo)
-	if err != nil {
-		return nil, err
-	}
-
 	submissions, err := provider.ListFormSubmissions(ctx, externalID, startAt, endAt)
 	if err != nil {
 		return nil, err
@@ -148,7 +149,7 @@ func (s *Service) GetHOSCertificationSummary(
 		return []*HOSCertificationSummary{}, nil
 	}
 
-	provider, err := s.resolveProvider(ctx, tenantInfo)
+	scopes, err := s.resolveScopes(ctx, tenantInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -159,8 +160,12 @@ func (s *Service) GetHOSCertificationSummary(
 		if mapping.ExternalID == "" {
 			continue
 		}
+		scope, ok := scopeFor(scopes, mapping.Provider)
+		if !ok {
+			continue
+		}
 
-		days, dayErr := provider.ListHOSDailyLogs(ctx, mapping.ExternalID, startDate, endDate)
+		days, dayErr := scope.provider.ListHOSDailyLogs(ctx, mapping.ExternalID, startDate, endDate)
 		if dayErr != nil {
 			return nil, dayErr
 		}
@@ -5,7 +5,6 @@ import (
 	"errors"
 
 	"github.com/emoss08/trenova/internal/core/domain/telematics"
-	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/pkg/errortypes"
 	"github.com/emoss08/trenova/pkg/pagination"
 	"github.com/emoss08/trenova/shared/pulid"
@@ -24,31 +23,36 @@ func (s *Service) PollTenant(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
 ) (*TenantPollResult, error) {
-	provider, err := s.resolveProvider(ctx, tenantInfo)
+	scopes, err := s.resolveScopes(ctx, tenantInfo)
 	if err != nil {
 		return nil, err
 	}
 
 	result := new(TenantPollResult)
-	posErr := s.pollVehiclePositions(ctx, tenantInfo, provider, result)
-	hosErr := s.pollHOSClocks(ctx, tenantInfo, provider, result)
-	return result, errors.Join(posErr, hosErr)
+	errs := make([]error, 0, len(scopes)*2)
+	for _, scope := range scopes {
+		errs = append(errs,
+			s.pollVehiclePositions(ctx, tenantInfo, scope, result),
+			s.pollHOSClocks(ctx, tenantInfo, scope, result),
+		)
+	}
+	return result, errors.Join(errs...)
 }
 
 func (s *Service) pollVehiclePositions(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantPollResult,
 ) error {
-	providerType := string(provider.Type())
+	providerType := scope.providerType()
 
-	tractorsByExternalID, err := s.tractorsByExternalID(ctx, tenantInfo)
+	tractorsByExternalID, err := s.tractorsByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return s.recordFeedFailure(ctx, tenantInfo, providerType, err)
 	}
 
-	providerPositions, err := provider.ListPositions(ctx)
+	providerPositions, err := scope.provider.ListPositions(ctx)
 	if err != nil {
 		return s.recordFeedFailure(ctx, tenantInfo, providerType, err)
 	}
@@ -85,7 +89,7 @@ func (s *Service) pollVehiclePositions(
 	if err = s.repo.UpsertVehiclePositions(ctx, positions); err != nil {
 		return s.recordFeedFailure(ctx, tenantInfo, providerType, err)
 	}
-	result.PositionsUpserted = len(positions)
+	result.PositionsUpserted += len(positions)
 
 	if err = s.recordFeedSuccess(ctx, tenantInfo, providerType); err != nil {
 		s.l.Warn("failed to record telematics feed success",
@@ -102,24 +106,24 @@ func (s *Service) pollVehiclePositions(
 func (s *Service) pollHOSClocks(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantPollResult,
 ) error {
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
-	tractorsByExternalID, err := s.tractorsByExternalID(ctx, tenantInfo)
+	tractorsByExternalID, err := s.tractorsByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
 
-	clocks, err := provider.ListHOSClocks(ctx)
+	clocks, err := scope.provider.ListHOSClocks(ctx)
 	if err != nil {
 		return err
 	}
 
-	providerType := string(provider.Type())
+	providerType := scope.providerType()
 	now := timeutils.NowUnix()
 	states := make([]*telematics.WorkerHOSState, 0, len(clocks))
 	for i := range clocks {
@@ -164,7 +168,7 @@ func (s *Service) pollHOSClocks(
 	if err = s.repo.UpsertWorkerHOSStates(ctx, states); err != nil {
 		return err
 	}
-	result.HOSStatesUpserted = len(states)
+	result.HOSStatesUpserted += len(states)
 
 	if len(states) > 0 {
 		s.publishInvalidation(ctx, tenantInfo, "workerHosState")
@@ -172,9 +176,13 @@ func (s *Service) pollHOSClocks(
 	return nil
 }
 
+// tractorsByExternalID indexes the tractors scope owns by provider vehicle ID.
+// Providers number their vehicles independently, so another provider's IDs must
+// never resolve here.
 func (s *Service) tractorsByExternalID(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
+	scope providerScope,
 ) (map[string]pulid.ID, error) {
 	mappings, err := s.repo.ListTractorMappings(ctx, tenantInfo)
 	if err != nil {
@@ -182,7 +190,7 @@ func (s *Service) tractorsByExternalID(
 	}
 	byExternalID := make(map[string]pulid.ID, len(mappings))
 	for _, mapping := range mappings {
-		if mapping.ExternalID != "" {
+		if mapping.ExternalID != "" && scope.owns(mapping.Provider) {
 			byExternalID[mapping.ExternalID] = mapping.TractorID
 		}
 	}
@@ -192,6 +200,7 @@ func (s *Service) tractorsByExternalID(
 func (s *Service) workersByExternalID(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
+	scope providerScope,
 ) (map[string]pulid.ID, error) {
 	mappings, err := s.repo.ListWorkerMappings(ctx, tenantInfo)
 	if err != nil {
@@ -199,7 +208,9 @@ func (s *Service) workersByExternalID(
 	}
 	byExternalID := make(map[string]pulid.ID, len(mappings))
 	for _, mapping := range mappings {
-		byExternalID[mapping.ExternalID] = mapping.WorkerID
+		if scope.owns(mapping.Provider) {
+			byExternalID[mapping.ExternalID] = mapping.WorkerID
+		}
 	}
 	return byExternalID, nil
 }
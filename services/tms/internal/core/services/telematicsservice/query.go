@@ -12,18 +12,21 @@ import (
 	"github.com/emoss08/trenova/shared/pulid"
 )
 
+// Status reports on the primary provider; Providers lists every provider of a mixed
+// fleet, primary first.
 type Status struct {
-	Provider          string `json:"provider"`
-	Enabled           bool   `json:"enabled"`
-	Configured        bool   `json:"configured"`
-	WebhookConfigured bool   `json:"webhookConfigured"`
-	LastPolledAt      int64  `json:"lastPolledAt"`
-	LastSuccessAt     int64  `json:"lastSuccessAt"`
-	FailureCount      int    `json:"failureCount"`
-	LastError         string `json:"lastError"`
-	MappedTractors    int    `json:"mappedTractors"`
-	TotalTractors     int    `json:"totalTractors"`
-	MappedWorkers     int    `json:"mappedWorkers"`
+	Provider          string   `json:"provider"`
+	Providers         []string `json:"providers"`
+	Enabled           bool     `json:"enabled"`
+	Configured        bool     `json:"configured"`
+	WebhookConfigured bool     `json:"webhookConfigured"`
+	LastPolledAt      int64    `json:"lastPolledAt"`
+	LastSuccessAt     int64    `json:"lastSuccessAt"`
+	FailureCount      int      `json:"failureCount"`
+	LastError         string   `json:"lastError"`
+	MappedTractors    int      `json:"mappedTractors"`
+	TotalTractors     int      `json:"totalTractors"`
+	MappedWorkers     int      `json:"mappedWorkers"`
 }
 
 func (s *Service) ListVehiclePositions(
@@ -156,11 +159,14 @@ func (s *Service) GetStatus(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
 ) (*Status, error) {
-	status := new(Status)
+	status := &Status{Providers: []string{}}
 
 	providerType := integration.TypeSamsara
-	if provider, providerErr := s.resolveProvider(ctx, tenantInfo); providerErr == nil {
-		providerType = provider.Type()
+	if scopes, scopeErr := s.resolveScopes(ctx, tenantInfo); scopeErr == nil {
+		providerType = scopes[0].provider.Type()
+		for _, scope := range scopes {
+			status.Providers = append(status.Providers, scope.providerType())
+		}
 	}
 	status.Provider = string(providerType)
 
@@ -82,14 +82,61 @@ func New(p Params) *Service { //nolint:gocritic // dependency injection
 	}
 }
 
-func (s *Service) resolveProvider(
+// providerScope is one provider of a tenant's fleet. A unit belongs to the provider
+// it is pinned to; units that are not pinned belong to the primary provider, so a
+// tenant with a single provider behaves as before mixed fleets.
+type providerScope struct {
+	provider services.TelematicsProvider
+	primary  bool
+}
+
+func (p providerScope) providerType() string {
+	return string(p.provider.Type())
+}
+
+func (p providerScope) owns(unitProvider string) bool {
+	if unitProvider == "" {
+		return p.primary
+	}
+	return unitProvider == p.providerType()
+}
+
+// canMatch reports whether a unit is a candidate for matching against this scope's
+// vehicles: one it owns, or one with neither a provider nor an external ID yet.
+// The primary is swept first, so it gets the first claim on unpinned units.
+func (p providerScope) canMatch(unitProvider, externalID string) bool {
+	return p.owns(unitProvider) || (unitProvider == "" && externalID == "")
+}
+
+// resolveScopes returns the tenant's providers, primary first.
+func (s *Service) resolveScopes(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-) (services.TelematicsProvider, error) {
+) ([]providerScope, error) {
 	if s.providerOverride != nil {
-		return s.providerOverride, nil
+		return []providerScope{{provider: s.providerOverride, primary: true}}, nil
+	}
+
+	providers, err := s.providerFactory.ProvidersFor(ctx, tenantInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]providerScope, 0, len(providers))
+	for i, provider := range providers {
+		scopes = append(scopes, providerScope{provider: provider, primary: i == 0})
+	}
+	return scopes, nil
+}
+
+// scopeFor returns the scope that owns a unit pinned to unitProvider.
+func scopeFor(scopes []providerScope, unitProvider string) (providerScope, bool) {
+	for _, scope := range scopes {
+		if scope.owns(unitProvider) {
+			return scope, true
+		}
 	}
-	return s.providerFactory.ProviderFor(ctx, tenantInfo)
+	return providerScope{}, false
 }
 
 func (s *Service) publishInvalidation(
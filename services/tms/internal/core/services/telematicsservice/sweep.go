@@ -29,28 +29,25 @@ func (s *Service) SweepTenant(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
 ) (*TenantSweepResult, error) {
-	provider, err := s.resolveProvider(ctx, tenantInfo)
+	scopes, err := s.resolveScopes(ctx, tenantInfo)
 	if err != nil {
 		return nil, err
 	}
 
 	result := new(TenantSweepResult)
-	mapErr := s.syncVehicleMappings(ctx, tenantInfo, provider, result)
-	trailerErr := s.syncTrailerMappings(ctx, tenantInfo, provider, result)
-	rulesetErr := s.syncDriverRulesets(ctx, tenantInfo, provider, result)
-	violationErr := s.pollHOSViolations(ctx, tenantInfo, provider, result)
-	logErr := s.syncHOSLogs(ctx, tenantInfo, provider, result)
-	dvirErr := s.syncDVIRs(ctx, tenantInfo, provider, result)
-	formErr := s.syncForms(ctx, tenantInfo, provider, result)
-	return result, errors.Join(
-		mapErr,
-		trailerErr,
-		rulesetErr,
-		violationErr,
-		logErr,
-		dvirErr,
-		formErr,
-	)
+	errs := make([]error, 0, len(scopes)*7)
+	for _, scope := range scopes {
+		errs = append(errs,
+			s.syncVehicleMappings(ctx, tenantInfo, scope, result),
+			s.syncTrailerMappings(ctx, tenantInfo, scope, result),
+			s.syncDriverRulesets(ctx, tenantInfo, scope, result),
+			s.pollHOSViolations(ctx, tenantInfo, scope, result),
+			s.syncHOSLogs(ctx, tenantInfo, scope, result),
+			s.syncDVIRs(ctx, tenantInfo, scope, result),
+			s.syncForms(ctx, tenantInfo, scope, result),
+		)
+	}
+	return result, errors.Join(errs...)
 }
 
 type unitMappingCandidate struct {
@@ -139,7 +136,7 @@ func matchVehicleToUnit(
 func (s *Service) syncVehicleMappings(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
 	mappings, err := s.repo.ListTractorMappings(ctx, tenantInfo)
@@ -150,6 +147,9 @@ func (s *Service) syncVehicleMappings(
 	candidates := make([]unitMappingCandidate, 0, len(mappings))
 	unmapped := 0
 	for _, mapping := range mappings {
+		if !scope.canMatch(mapping.Provider, mapping.ExternalID) {
+			continue
+		}
 		if mapping.ExternalID == "" {
 			unmapped++
 		}
@@ -164,7 +164,7 @@ func (s *Service) syncVehicleMappings(
 		return nil
 	}
 
-	providerVehicles, err := provider.ListVehicles(ctx)
+	providerVehicles, err := scope.provider.ListVehicles(ctx)
 	if err != nil {
 		return err
 	}
@@ -190,17 +190,18 @@ func (s *Service) syncVehicleMappings(
 		ctx,
 		repositories.AssignTractorExternalIDsRequest{
 			TenantInfo:  tenantInfo,
+			Provider:    scope.providerType(),
 			Assignments: assignments,
 		},
 	)
 	if err != nil {
 		return err
 	}
-	result.VehiclesMatched = assigned
+	result.VehiclesMatched += assigned
 
 	s.l.Info("assigned telematics vehicle mappings",
 		zap.String("organizationId", tenantInfo.OrgID.String()),
-		zap.String("provider", string(provider.Type())),
+		zap.String("provider", scope.providerType()),
 		zap.Int("assigned", assigned))
 	return nil
 }
@@ -208,7 +209,7 @@ func (s *Service) syncVehicleMappings(
 func (s *Service) syncTrailerMappings(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
 	mappings, err := s.repo.ListTrailerMappings(ctx, tenantInfo)
@@ -219,6 +220,9 @@ func (s *Service) syncTrailerMappings(
 	candidates := make([]unitMappingCandidate, 0, len(mappings))
 	unmapped := 0
 	for _, mapping := range mappings {
+		if !scope.canMatch(mapping.Provider, mapping.ExternalID) {
+			continue
+		}
 		if mapping.ExternalID == "" {
 			unmapped++
 		}
@@ -233,7 +237,7 @@ func (s *Service) syncTrailerMappings(
 		return nil
 	}
 
-	providerTrailers, err := provider.ListTrailers(ctx)
+	providerTrailers, err := scope.provider.ListTrailers(ctx)
 	if err != nil {
 		return err
 	}
@@ -255,17 +259,18 @@ func (s *Service) syncTrailerMappings(
 		ctx,
 		repositories.AssignTrailerExternalIDsRequest{
 			TenantInfo:  tenantInfo,
+			Provider:    scope.providerType(),
 			Assignments: assignments,
 		},
 	)
 	if err != nil {
 		return err
 	}
-	result.TrailersMatched = assigned
+	result.TrailersMatched += assigned
 
 	s.l.Info("assigned telematics trailer mappings",
 		zap.String("organizationId", tenantInfo.OrgID.String()),
-		zap.String("provider", string(provider.Type())),
+		zap.String("provider", scope.providerType()),
 		zap.Int("assigned", assigned))
 	return nil
 }
@@ -273,10 +278,10 @@ func (s *Service) syncTrailerMappings(
 func (s *Service) syncDriverRulesets(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
@@ -284,7 +289,7 @@ func (s *Service) syncDriverRulesets(
 		return nil
 	}
 
-	profiles, err := provider.ListDriverProfiles(ctx)
+	profiles, err := scope.provider.ListDriverProfiles(ctx)
 	if err != nil {
 		return err
 	}
@@ -320,17 +325,17 @@ func (s *Service) syncDriverRulesets(
 	if err != nil {
 		return err
 	}
-	result.RulesetsUpdated = updated
+	result.RulesetsUpdated += updated
 	return nil
 }
 
 func (s *Service) pollHOSViolations(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
@@ -339,7 +344,7 @@ func (s *Service) pollHOSViolations(
 	}
 
 	now := timeutils.NowUnix()
-	providerViolations, err := provider.ListHOSViolations(
+	providerViolations, err := scope.provider.ListHOSViolations(
 		ctx,
 		now-violationLookbackSeconds,
 		now,
@@ -372,7 +377,7 @@ func (s *Service) pollHOSViolations(
 	if err = s.repo.UpsertWorkerHOSViolations(ctx, violations); err != nil {
 		return err
 	}
-	result.ViolationsUpserted = len(violations)
+	result.ViolationsUpserted += len(violations)
 
 	if len(violations) > 0 {
 		s.publishInvalidation(ctx, tenantInfo, "workerHosViolation")
@@ -383,25 +388,25 @@ func (s *Service) pollHOSViolations(
 func (s *Service) syncDVIRs(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
-	tractorsByExternalID, err := s.tractorsByExternalID(ctx, tenantInfo)
+	tractorsByExternalID, err := s.tractorsByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
 
 	now := timeutils.NowUnix()
-	records, err := provider.ListDVIRs(ctx, now-dvirLookbackSeconds, now)
+	records, err := scope.provider.ListDVIRs(ctx, now-dvirLookbackSeconds, now)
 	if err != nil {
 		return err
 	}
 
-	providerType := string(provider.Type())
+	providerType := scope.providerType()
 	inspections := make([]*telematics.VehicleInspection, 0, len(records))
 	for i := range records {
 		record := &records[i]
@@ -436,7 +441,7 @@ func (s *Service) syncDVIRs(
 	if err = s.repo.UpsertVehicleInspections(ctx, inspections); err != nil {
 		return err
 	}
-	result.DVIRsUpserted = len(inspections)
+	result.DVIRsUpserted += len(inspections)
 
 	if len(inspections) > 0 {
 		s.publishInvalidation(ctx, tenantInfo, "vehicleInspection")
@@ -5,7 +5,6 @@ import (
 
 	"github.com/emoss08/trenova/internal/core/domain/telematics"
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
-	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/pkg/pagination"
 	"github.com/emoss08/trenova/shared/timeutils"
 )
@@ -15,10 +14,10 @@ const formsLookbackSeconds = int64(48 * 3600)
 func (s *Service) syncForms(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	provider services.TelematicsProvider,
+	scope providerScope,
 	result *TenantSweepResult,
 ) error {
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
@@ -31,13 +30,13 @@ func (s *Service) syncForms(
 		return err
 	}
 
-	providerType := string(provider.Type())
+	providerType := scope.providerType()
 	now := timeutils.NowUnix()
 	startAt := now - formsLookbackSeconds
 
 	upserted := 0
 	for externalID := range workersByExternalID {
-		submissions, listErr := provider.ListFormSubmissions(ctx, externalID, startAt, now)
+		submissions, listErr := scope.provider.ListFormSubmissions(ctx, externalID, startAt, now)
 		if listErr != nil {
 			return listErr
 		}
@@ -66,7 +65,7 @@ func (s *Service) syncForms(
 		}
 	}
 
-	result.FormsUpserted = upserted
+	result.FormsUpserted += upserted
 	return nil
 }
 
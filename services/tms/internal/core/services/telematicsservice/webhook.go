@@ -78,15 +78,38 @@ func (s *Service) ProcessWebhook(
 		)
 	}
 
-	return s.handleEvent(ctx, cfg.TenantInfo, string(provider.Type()), event)
+	scope := s.webhookScope(ctx, cfg.TenantInfo, provider)
+	return s.handleEvent(ctx, cfg.TenantInfo, scope, event)
+}
+
+// webhookScope scopes an event to the provider that sent it. Unpinned units belong
+// to it only when it is the tenant's primary provider.
+func (s *Service) webhookScope(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+	provider services.TelematicsProvider,
+) providerScope {
+	scope := providerScope{provider: provider}
+	scopes, err := s.resolveScopes(ctx, tenantInfo)
+	if err != nil {
+		s.l.Warn("failed to resolve primary telematics provider",
+			zap.String("organizationId", tenantInfo.OrgID.String()),
+			zap.Error(err))
+		return scope
+	}
+	if len(scopes) > 0 {
+		scope.primary = scopes[0].providerType() == scope.providerType()
+	}
+	return scope
 }
 
 func (s *Service) handleEvent(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	providerType string,
+	scope providerScope,
 	event *services.ProviderWebhookEvent,
 ) error {
+	providerType := scope.providerType()
 	record := &telematics.TelematicsEvent{
 		ID:             telematics.NewEventID(),
 		OrganizationID: tenantInfo.OrgID,
@@ -111,10 +134,10 @@ func (s *Service) handleEvent(
 	applyGeofenceEvent(record, event.Geofence, &vehicleID, &vehicleVIN, &driverID)
 
 	if vehicleID != "" || vehicleVIN != "" {
-		s.resolveEventTractor(ctx, tenantInfo, vehicleID, vehicleVIN, record)
+		s.resolveEventTractor(ctx, tenantInfo, scope, vehicleID, vehicleVIN, record)
 	}
 	if driverID != "" {
-		s.resolveEventWorker(ctx, tenantInfo, driverID, record)
+		s.resolveEventWorker(ctx, tenantInfo, scope, driverID, record)
 	}
 
 	if record.LocationID.IsNil() && event.Stop != nil {
@@ -133,7 +156,7 @@ func (s *Service) handleEvent(
 		return nil
 	}
 
-	s.dispatchEventSideEffects(ctx, tenantInfo, providerType, record, event)
+	s.dispatchEventSideEffects(ctx, tenantInfo, scope, record, event)
 
 	s.publishInvalidation(ctx, tenantInfo, "telematicsEvent")
 	return nil
@@ -142,7 +165,7 @@ func (s *Service) handleEvent(
 func (s *Service) dispatchEventSideEffects(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	providerType string,
+	scope providerScope,
 	record *telematics.TelematicsEvent,
 	event *services.ProviderWebhookEvent,
 ) {
@@ -152,7 +175,7 @@ func (s *Service) dispatchEventSideEffects(
 	case services.ProviderEventKindGeofenceExit, services.ProviderEventKindStopDeparture:
 		s.applyStopEvent(ctx, tenantInfo, record, repositories.StopActualActionDepart)
 	case services.ProviderEventKindFormSubmission:
-		if err := s.handleFormEvent(ctx, tenantInfo, providerType, event.Form); err != nil {
+		if err := s.handleFormEvent(ctx, tenantInfo, scope, event.Form); err != nil {
 			s.l.Warn("failed to ingest telematics form event",
 				zap.String("organizationId", tenantInfo.OrgID.String()),
 				zap.Error(err))
@@ -164,13 +187,13 @@ func (s *Service) dispatchEventSideEffects(
 func (s *Service) handleFormEvent(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
-	providerType string,
+	scope providerScope,
 	form *services.ProviderFormEvent,
 ) error {
 	if form == nil {
 		return nil
 	}
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		return err
 	}
@@ -184,7 +207,7 @@ func (s *Service) handleFormEvent(
 		workersByExternalID,
 		mappingsByTemplate,
 		&ingestFormInput{
-			Provider:     providerType,
+			Provider:     scope.providerType(),
 			SubmissionID: form.SubmissionID,
 			TemplateID:   form.TemplateID,
 			TemplateName: form.TemplateName,
@@ -224,6 +247,7 @@ func applyGeofenceEvent(
 func (s *Service) resolveEventTractor(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
+	scope providerScope,
 	providerVehicleID string,
 	vin string,
 	record *telematics.TelematicsEvent,
@@ -238,6 +262,9 @@ func (s *Service) resolveEventTractor(
 
 	normalizedVin := strings.ToUpper(strings.TrimSpace(vin))
 	for _, mapping := range mappings {
+		if !scope.owns(mapping.Provider) {
+			continue
+		}
 		if providerVehicleID != "" && mapping.ExternalID == providerVehicleID {
 			record.TractorID = mapping.TractorID
 			return
@@ -253,10 +280,11 @@ func (s *Service) resolveEventTractor(
 func (s *Service) resolveEventWorker(
 	ctx context.Context,
 	tenantInfo pagination.TenantInfo,
+	scope providerScope,
 	providerDriverID string,
 	record *telematics.TelematicsEvent,
 ) {
-	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo)
+	workersByExternalID, err := s.workersByExternalID(ctx, tenantInfo, scope)
 	if err != nil {
 		s.l.Warn("failed to resolve worker for telematics event",
 			zap.String("organizationId", tenantInfo.OrgID.String()),
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
 	"github.com/emoss08/trenova/internal/core/domain/permission"
 	"github.com/emoss08/trenova/internal/core/domain/tender"
 	"github.com/emoss08/trenova/internal/core/ports"
@@ -262,6 +263,13 @@ func (s *Service) AcceptOffer(
 				return txErr
 			}
 
+			if txErr = s.outboxRepo.Append(
+				txCtx,
+				domainevent.NewTenderAccepted(offer, source, now),
+			); txErr != nil {
+				return txErr
+			}
+
 			accepted = true
 			return nil
 		})
@@ -37,6 +37,7 @@ type Params struct {
 	ShipmentRepo          repositories.ShipmentRepository
 	HoldRepo              repositories.ShipmentHoldRepository
 	OrgRepo               repositories.OrganizationRepository
+	OutboxRepo            repositories.DomainEventOutboxRepository
 	Workflows             portservices.WorkflowStarter
 	EventService          portservices.ShipmentEventService
 	AuditService          portservices.AuditService
@@ -61,6 +62,7 @@ type Service struct {
 	shipmentRepo          repositories.ShipmentRepository
 	holdRepo              repositories.ShipmentHoldRepository
 	orgRepo               repositories.OrganizationRepository
+	outboxRepo            repositories.DomainEventOutboxRepository
 	workflows             portservices.WorkflowStarter
 	eventService          portservices.ShipmentEventService
 	auditService          portservices.AuditService
@@ -87,6 +89,7 @@ func New(p Params) *Service {
 		shipmentRepo:          p.ShipmentRepo,
 		holdRepo:              p.HoldRepo,
 		orgRepo:               p.OrgRepo,
+		outboxRepo:            p.OutboxRepo,
 		workflows:             p.Workflows,
 		eventService:          p.EventService,
 		auditService:          p.AuditService,
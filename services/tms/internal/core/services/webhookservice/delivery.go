@@ -0,0 +1,594 @@
+package webhookservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/pkg/temporaltype"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+)
+
+const (
+	deliveryTimeout    = 15 * time.Second
+	dialTimeout        = 5 * time.Second
+	userAgent          = "Trenova-Webhooks/1.0"
+	maxErrorBodyLength = 256
+)
+
+var errBlockedAddress = errors.New("webhook endpoint resolves to a non-public address")
+
+// Event is a domain event read from the tms:events stream.
+type Event struct {
+	ID             pulid.ID
+	Type           domainevent.Type
+	Version        int
+	IdempotencyKey string
+	OrganizationID pulid.ID
+	BusinessUnitID pulid.ID
+	OccurredAt     int64
+	Payload        map[string]any
+}
+
+type DeliverWebhookPayload struct {
+	DeliveryID pulid.ID               `json:"deliveryId"`
+	TenantInfo pagination.TenantInfo  `json:"tenantInfo"`
+	Trigger    webhook.AttemptTrigger `json:"trigger"`
+}
+
+type DeliverWebhookResult struct {
+	DeliveryID pulid.ID               `json:"deliveryId"`
+	Status     webhook.DeliveryStatus `json:"status"`
+	StatusCode int                    `json:"statusCode"`
+	Skipped    bool                   `json:"skipped"`
+}
+
+type MarkWebhookDeliveryFailedPayload struct {
+	DeliveryID pulid.ID               `json:"deliveryId"`
+	TenantInfo pagination.TenantInfo  `json:"tenantInfo"`
+	Trigger    webhook.AttemptTrigger `json:"trigger"`
+	Reason     string                 `json:"reason"`
+}
+
+// envelope is the body of every delivery. The event payload is nested under
+// data so the envelope can grow without colliding with payload fields.
+type envelope struct {
+	ID             pulid.ID         `json:"id"`
+	Type           domainevent.Type `json:"type"`
+	Version        int              `json:"version"`
+	IdempotencyKey string           `json:"idempotencyKey"`
+	OccurredAt     int64            `json:"occurredAt"`
+	Data           map[string]any   `json:"data"`
+}
+
+// Dispatch records a delivery for every subscription the event matches and
+// starts sending it. An event seen again, from a replay or a redelivered
+// stream message, finds its deliveries already recorded and sends nothing,
+// except for deliveries still pending: their workflow may never have started,
+// and its ID is fixed per delivery, so starting it again is harmless.
+func (s *Service) Dispatch(ctx context.Context, event *Event) error {
+	if !webhook.IsSubscribableEventType(event.Type) {
+		return nil
+	}
+
+	log := s.l.With(
+		zap.String("operation", "Dispatch"),
+		zap.String("eventId", event.ID.String()),
+		zap.String("eventType", string(event.Type)),
+	)
+
+	deliveries, err := s.eventDeliveries(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		delivery, err = s.recordDelivery(ctx, delivery)
+		if err != nil {
+			return err
+		}
+		if delivery.Status != webhook.DeliveryStatusPending {
+			log.Debug("webhook delivery already recorded",
+				zap.String("subscriptionId", delivery.SubscriptionID.String()),
+			)
+			continue
+		}
+
+		if err = s.startDelivery(ctx, delivery, webhook.AttemptTriggerAutomatic); err != nil {
+			log.Error("failed to start webhook delivery",
+				zap.String("deliveryId", delivery.ID.String()),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AbandonEvent runs once the dispatcher gives up on an event. Its deliveries
+// are recorded as failed, along with any still pending, so they show in the
+// delivery log and can be redelivered by hand instead of vanishing with the
+// stream message.
+func (s *Service) AbandonEvent(ctx context.Context, event *Event, reason string) error {
+	if !webhook.IsSubscribableEventType(event.Type) {
+		return nil
+	}
+
+	deliveries, err := s.eventDeliveries(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		delivery.Status = webhook.DeliveryStatusFailed
+		delivery.LastError = reason
+
+		delivery, err = s.recordDelivery(ctx, delivery)
+		if err != nil {
+			return err
+		}
+		if delivery.Status != webhook.DeliveryStatusPending {
+			continue
+		}
+
+		delivery.Status = webhook.DeliveryStatusFailed
+		if delivery.LastError == "" {
+			delivery.LastError = reason
+		}
+		if _, err = s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	s.l.Warn("webhook deliveries recorded as failed for abandoned event",
+		zap.String("eventId", event.ID.String()),
+		zap.String("eventType", string(event.Type)),
+		zap.Int("deliveries", len(deliveries)),
+		zap.String("reason", reason),
+	)
+
+	return nil
+}
+
+// eventDeliveries builds, without saving them, a pending delivery for every
+// subscription the event matches.
+func (s *Service) eventDeliveries(ctx context.Context, event *Event) ([]*webhook.Delivery, error) {
+	subscriptions, err := s.subscriptionRepo.FindForEvent(
+		ctx,
+		repositories.FindWebhookSubscriptionsForEventRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: event.OrganizationID,
+				BuID:  event.BusinessUnitID,
+			},
+			EventType: event.Type,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	customerID := payloadCustomerID(event.Payload)
+	deliveries := make([]*webhook.Delivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Type, customerID) {
+			continue
+		}
+
+		deliveries = append(deliveries, &webhook.Delivery{
+			BusinessUnitID: event.BusinessUnitID,
+			OrganizationID: event.OrganizationID,
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			EventVersion:   event.Version,
+			IdempotencyKey: event.IdempotencyKey,
+			Payload:        event.Payload,
+			OccurredAt:     event.OccurredAt,
+			Status:         webhook.DeliveryStatusPending,
+		})
+	}
+
+	return deliveries, nil
+}
+
+// recordDelivery inserts the delivery, or returns the one already recorded
+// for the subscription and idempotency key.
+func (s *Service) recordDelivery(
+	ctx context.Context,
+	delivery *webhook.Delivery,
+) (*webhook.Delivery, error) {
+	created, err := s.deliveryRepo.CreateIfAbsent(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return delivery, nil
+	}
+
+	return s.deliveryRepo.GetByIdempotencyKey(
+		ctx,
+		repositories.GetWebhookDeliveryByIdempotencyKeyRequest{
+			TenantInfo:     deliveryTenantInfo(delivery),
+			SubscriptionID: delivery.SubscriptionID,
+			IdempotencyKey: delivery.IdempotencyKey,
+		},
+	)
+}
+
+func (s *Service) startDelivery(
+	ctx context.Context,
+	delivery *webhook.Delivery,
+	trigger webhook.AttemptTrigger,
+) error {
+	if s.workflowStarter == nil || !s.workflowStarter.Enabled() {
+		return errortypes.NewBusinessError("Webhook delivery workflow is not configured")
+	}
+
+	workflowID := "webhook-delivery-" + delivery.ID.String()
+	summary := "Deliver webhook " + delivery.ID.String()
+	if trigger == webhook.AttemptTriggerManual {
+		workflowID = "webhook-redelivery-" + delivery.ID.String()
+		summary = "Redeliver webhook " + delivery.ID.String()
+	}
+
+	_, err := s.workflowStarter.StartWorkflow(
+		ctx,
+		client.StartWorkflowOptions{
+			ID:                                       workflowID,
+			TaskQueue:                                temporaltype.WebhookTaskQueue,
+			WorkflowExecutionErrorWhenAlreadyStarted: true,
+			StaticSummary:                            summary,
+		},
+		temporaltype.DeliverWebhookWorkflowName,
+		&DeliverWebhookPayload{
+			DeliveryID: delivery.ID,
+			TenantInfo: deliveryTenantInfo(delivery),
+			Trigger:    trigger,
+		},
+	)
+	if err != nil {
+		var alreadyStartedErr *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStartedErr) {
+			if trigger == webhook.AttemptTriggerManual {
+				return errortypes.NewBusinessError("A redelivery of this webhook is already in progress")
+			}
+			return nil
+		}
+		return errortypes.NewBusinessError("failed to start webhook delivery workflow").
+			WithInternal(err)
+	}
+
+	return nil
+}
+
+// DeliverWebhook makes one attempt at a delivery and records it. Errors it
+// returns are retryable unless marked otherwise, following the same rules as
+// outbound HTTPS EDI: timeouts, throttling, server errors, and network
+// failures retry; any other rejection does not.
+//
+//nolint:funlen // The attempt loads, signs, sends, and records in sequence.
+func (s *Service) DeliverWebhook(
+	ctx context.Context,
+	payload *DeliverWebhookPayload,
+) (*DeliverWebhookResult, error) {
+	if payload == nil || payload.DeliveryID.IsNil() {
+		return nil, temporal.NewNonRetryableApplicationError(
+			"webhook delivery ID is required",
+			"InvalidWebhookDeliveryPayload",
+			nil,
+		)
+	}
+
+	delivery, err := s.deliveryRepo.GetByID(ctx, repositories.GetWebhookDeliveryByIDRequest{
+		ID:         payload.DeliveryID,
+		TenantInfo: payload.TenantInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeliverWebhookResult{DeliveryID: delivery.ID, Status: delivery.Status}
+	automatic := payload.Trigger != webhook.AttemptTriggerManual
+	if automatic && delivery.Status == webhook.DeliveryStatusSucceeded {
+		result.Skipped = true
+		return result, nil
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         delivery.SubscriptionID,
+		TenantInfo: payload.TenantInfo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if automatic && subscription.Status != webhook.StatusActive {
+		delivery.Status = webhook.DeliveryStatusFailed
+		delivery.LastError = "Subscription is disabled"
+		if _, err = s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return nil, err
+		}
+		result.Status = delivery.Status
+		result.Skipped = true
+		return result, nil
+	}
+
+	secrets, err := s.signingSecrets(subscription)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			"webhook signing secret could not be read",
+			"WebhookSecretUnavailable",
+			err,
+		)
+	}
+
+	body, err := json.Marshal(envelope{
+		ID:             delivery.EventID,
+		Type:           delivery.EventType,
+		Version:        delivery.EventVersion,
+		IdempotencyKey: delivery.IdempotencyKey,
+		OccurredAt:     delivery.OccurredAt,
+		Data:           delivery.Payload,
+	})
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(
+			"webhook body could not be encoded",
+			"WebhookBodyInvalid",
+			err,
+		)
+	}
+
+	attempt := &webhook.DeliveryAttempt{
+		BusinessUnitID: delivery.BusinessUnitID,
+		OrganizationID: delivery.OrganizationID,
+		DeliveryID:     delivery.ID,
+		SubscriptionID: subscription.ID,
+		AttemptNumber:  delivery.AttemptCount + 1,
+		Trigger:        payload.Trigger,
+		AttemptedAt:    s.now(),
+	}
+	if attempt.Trigger == "" {
+		attempt.Trigger = webhook.AttemptTriggerAutomatic
+	}
+
+	startedAt := time.Now()
+	statusCode, responseBody, sendErr := s.send(ctx, subscription, delivery, body, secrets)
+	attempt.DurationMs = time.Since(startedAt).Milliseconds()
+	attempt.StatusCode = statusCode
+	attempt.ResponseBody = responseBody
+	deliveryErr := classifyResponse(statusCode, responseBody, sendErr)
+	attempt.Succeeded = deliveryErr == nil
+	if deliveryErr != nil {
+		attempt.Error = deliveryErr.Error()
+	}
+
+	if err = s.deliveryRepo.CreateAttempt(ctx, attempt); err != nil {
+		return nil, err
+	}
+
+	delivery.AttemptCount = attempt.AttemptNumber
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = attempt.Error
+	delivery.LastAttemptAt = attempt.AttemptedAt
+	if attempt.Succeeded {
+		delivery.Status = webhook.DeliveryStatusSucceeded
+		delivery.DeliveredAt = attempt.AttemptedAt
+	}
+	if _, err = s.deliveryRepo.Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	if deliveryErr != nil {
+		return nil, deliveryErr
+	}
+
+	if err = s.subscriptionRepo.RecordDeliverySuccess(
+		ctx,
+		repositories.RecordWebhookDeliverySuccessRequest{
+			ID:          subscription.ID,
+			TenantInfo:  payload.TenantInfo,
+			DeliveredAt: attempt.AttemptedAt,
+		},
+	); err != nil {
+		// The delivery itself is recorded; failing here would resend it.
+		s.l.Warn("failed to record webhook delivery success",
+			zap.String("subscriptionId", subscription.ID.String()),
+			zap.Error(err),
+		)
+	}
+
+	result.Status = delivery.Status
+	result.StatusCode = statusCode
+	return result, nil
+}
+
+// MarkDeliveryFailed runs once a delivery has no attempts left. Only
+// automatic deliveries count toward disabling the subscription; a failed
+// manual resend says nothing new about an endpoint that already failed.
+func (s *Service) MarkDeliveryFailed(
+	ctx context.Context,
+	payload *MarkWebhookDeliveryFailedPayload,
+) error {
+	delivery, err := s.deliveryRepo.GetByID(ctx, repositories.GetWebhookDeliveryByIDRequest{
+		ID:         payload.DeliveryID,
+		TenantInfo: payload.TenantInfo,
+	})
+	if err != nil {
+		return err
+	}
+
+	delivery.Status = webhook.DeliveryStatusFailed
+	if delivery.LastError == "" {
+		delivery.LastError = payload.Reason
+	}
+	if _, err = s.deliveryRepo.Update(ctx, delivery); err != nil {
+		return err
+	}
+
+	if payload.Trigger == webhook.AttemptTriggerManual {
+		return nil
+	}
+
+	subscription, err := s.subscriptionRepo.RecordDeliveryFailure(
+		ctx,
+		repositories.RecordWebhookDeliveryFailureRequest{
+			ID:           delivery.SubscriptionID,
+			TenantInfo:   payload.TenantInfo,
+			DisableAfter: webhook.AutoDisableThreshold,
+			FailedAt:     s.now(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if subscription.Status == webhook.StatusDisabled &&
+		subscription.ConsecutiveFailures == webhook.AutoDisableThreshold {
+		s.l.Warn("webhook subscription disabled after repeated delivery failures",
+			zap.String("subscriptionId", subscription.ID.String()),
+			zap.String("organizationId", subscription.OrganizationID.String()),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) send(
+	ctx context.Context,
+	subscription *webhook.Subscription,
+	delivery *webhook.Delivery,
+	body []byte,
+	secrets []string,
+) (statusCode int, responseBody string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		subscription.URL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return 0, "", temporal.NewNonRetryableApplicationError(
+			"webhook request could not be created",
+			"WebhookRequestInvalid",
+			err,
+		)
+	}
+
+	timestamp := strconv.FormatInt(s.now(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(webhook.HeaderTimestamp, timestamp)
+	request.Header.Set(webhook.HeaderSignature, webhook.SignatureHeader(timestamp, body, secrets...))
+	request.Header.Set(webhook.HeaderDeliveryID, delivery.ID.String())
+	request.Header.Set(webhook.HeaderEventType, string(delivery.EventType))
+	request.Header.Set(webhook.HeaderIdempotencyKey, delivery.IdempotencyKey)
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return 0, "", err
+	}
+	defer response.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(response.Body, webhook.MaxResponseBodyLength))
+	return response.StatusCode, string(raw), nil
+}
+
+func classifyResponse(statusCode int, body string, sendErr error) error {
+	if sendErr != nil {
+		if errors.Is(sendErr, errBlockedAddress) {
+			return temporal.NewNonRetryableApplicationError(
+				errBlockedAddress.Error(),
+				"WebhookEndpointBlocked",
+				sendErr,
+			)
+		}
+		var appErr *temporal.ApplicationError
+		if errors.As(sendErr, &appErr) {
+			return sendErr
+		}
+		return fmt.Errorf("webhook request failed: %w", sendErr)
+	}
+
+	if statusCode >= 200 && statusCode <= 299 {
+		return nil
+	}
+
+	if len(body) > maxErrorBodyLength {
+		body = body[:maxErrorBodyLength]
+	}
+	message := fmt.Sprintf("webhook endpoint responded with status %d: %s", statusCode, body)
+	if statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= 500 {
+		return errors.New(message)
+	}
+
+	return temporal.NewNonRetryableApplicationError(message, "WebhookRequestRejected", nil)
+}
+
+// newDeliveryClient refuses to connect to anything but a public address,
+// checked after DNS resolution so a host name cannot be pointed at the
+// internal network. Redirects are not followed for the same reason.
+func newDeliveryClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: dialTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !webhook.IsPublicIP(ip) {
+				return errBlockedAddress
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func payloadCustomerID(payload map[string]any) pulid.ID {
+	if value, ok := payload["customerId"].(string); ok {
+		return pulid.ID(value)
+	}
+	return pulid.Nil
+}
+
+func deliveryTenantInfo(delivery *webhook.Delivery) pagination.TenantInfo {
+	return pagination.TenantInfo{
+		OrgID: delivery.OrganizationID,
+		BuID:  delivery.BusinessUnitID,
+	}
+}
@@ -0,0 +1,284 @@
+package webhookservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	eventStreamName       = "tms:events"
+	dispatcherGroup       = "webhook-dispatcher"
+	dispatcherReadCount   = 25
+	dispatcherBlock       = 5 * time.Second
+	dispatcherMaxRetries  = 5
+	dispatcherPendingIdle = time.Minute
+	dispatcherRetryEvery  = 15 * time.Second
+)
+
+type DispatcherParams struct {
+	fx.In
+
+	LC      fx.Lifecycle
+	Logger  *zap.Logger
+	Redis   *redis.Client
+	Service *Service
+}
+
+// Dispatcher reads domain events from the tms:events stream and hands them to
+// Service.Dispatch. It runs in a consumer group, so every API instance can run
+// one and each event is still dispatched by only one of them.
+type Dispatcher struct {
+	l        *zap.Logger
+	redis    *redis.Client
+	service  *Service
+	consumer string
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func NewDispatcher(p DispatcherParams) *Dispatcher {
+	hostname, _ := os.Hostname()
+	d := &Dispatcher{
+		l:        p.Logger.Named("webhook.dispatcher"),
+		redis:    p.Redis,
+		service:  p.Service,
+		consumer: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+
+	p.LC.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			d.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return d.Stop(ctx)
+		},
+	})
+
+	return d
+}
+
+func (d *Dispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+
+	err := d.redis.XGroupCreateMkStream(ctx, eventStreamName, dispatcherGroup, "$").Err()
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		d.l.Warn("failed to create consumer group", zap.Error(err))
+	}
+
+	go d.run(ctx)
+
+	d.l.Info("webhook dispatcher started", zap.String("consumer", d.consumer))
+}
+
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+
+	select {
+	case <-d.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	d.l.Info("webhook dispatcher stopped")
+	return nil
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.done)
+
+	retry := time.NewTicker(dispatcherRetryEvery)
+	defer retry.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-retry.C:
+			d.retryPending(ctx)
+		default:
+		}
+
+		streams, err := d.redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    dispatcherGroup,
+			Consumer: d.consumer,
+			Streams:  []string{eventStreamName, ">"},
+			Count:    dispatcherReadCount,
+			Block:    dispatcherBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			d.l.Error("failed to read from stream", zap.Error(err))
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				d.handle(ctx, msg)
+			}
+		}
+	}
+}
+
+// retryPending claims messages another consumer, or this one, read but never
+// acknowledged. Dispatch is idempotent per subscription, so a message handled
+// twice records each delivery once.
+func (d *Dispatcher) retryPending(ctx context.Context) {
+	pending, err := d.redis.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: eventStreamName,
+		Group:  dispatcherGroup,
+		Start:  "-",
+		End:    "+",
+		Count:  50,
+		Idle:   dispatcherPendingIdle,
+	}).Result()
+	if err != nil || len(pending) == 0 {
+		return
+	}
+
+	for _, p := range pending {
+		if p.RetryCount >= dispatcherMaxRetries {
+			d.abandon(ctx, p)
+			continue
+		}
+
+		claimed, claimErr := d.redis.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   eventStreamName,
+			Group:    dispatcherGroup,
+			Consumer: d.consumer,
+			MinIdle:  dispatcherPendingIdle,
+			Messages: []string{p.ID},
+		}).Result()
+		if claimErr != nil {
+			continue
+		}
+
+		for _, msg := range claimed {
+			d.handle(ctx, msg)
+		}
+	}
+}
+
+// abandon gives up on a message that failed every retry. Its deliveries are
+// recorded as failed before it is acknowledged, so the event stays visible
+// and can be redelivered; if even that fails the message stays pending and
+// is tried again on the next pass.
+func (d *Dispatcher) abandon(ctx context.Context, p redis.XPendingExt) {
+	log := d.l.With(
+		zap.String("messageID", p.ID),
+		zap.Int64("retryCount", p.RetryCount),
+	)
+
+	messages, err := d.redis.XRange(ctx, eventStreamName, p.ID, p.ID).Result()
+	if err != nil {
+		log.Error("failed to read abandoned domain event", zap.Error(err))
+		return
+	}
+	if len(messages) == 0 {
+		// Trimmed from the stream; there is nothing left to record.
+		log.Error("abandoned domain event is no longer in the stream")
+		d.redis.XAck(ctx, eventStreamName, dispatcherGroup, p.ID)
+		return
+	}
+
+	event, err := parseStreamEvent(messages[0].Values)
+	if err != nil {
+		log.Error("skipping malformed domain event", zap.Error(err))
+		d.redis.XAck(ctx, eventStreamName, dispatcherGroup, p.ID)
+		return
+	}
+
+	reason := fmt.Sprintf("Event could not be dispatched after %d attempts", p.RetryCount)
+	if err = d.service.AbandonEvent(ctx, event, reason); err != nil {
+		log.Error("failed to record abandoned domain event",
+			zap.String("eventId", event.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	log.Error("abandoned domain event after max retries", zap.String("eventId", event.ID.String()))
+	d.redis.XAck(ctx, eventStreamName, dispatcherGroup, p.ID)
+}
+
+func (d *Dispatcher) handle(ctx context.Context, msg redis.XMessage) {
+	event, err := parseStreamEvent(msg.Values)
+	if err != nil {
+		// A message that cannot be parsed never will be; retrying only delays
+		// the ones behind it.
+		d.l.Error("skipping malformed domain event",
+			zap.String("messageID", msg.ID),
+			zap.Error(err),
+		)
+		d.redis.XAck(ctx, eventStreamName, dispatcherGroup, msg.ID)
+		return
+	}
+
+	if err = d.service.Dispatch(ctx, event); err != nil {
+		d.l.Error("failed to dispatch domain event",
+			zap.String("messageID", msg.ID),
+			zap.String("eventId", event.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	d.redis.XAck(ctx, eventStreamName, dispatcherGroup, msg.ID)
+}
+
+func parseStreamEvent(values map[string]any) (*Event, error) {
+	field := func(name string) string {
+		value, _ := values[name].(string)
+		return value
+	}
+
+	event := &Event{
+		ID:             pulid.ID(field("event_id")),
+		Type:           domainevent.Type(field("event_type")),
+		IdempotencyKey: field("idempotency_key"),
+		OrganizationID: pulid.ID(field("organization_id")),
+		BusinessUnitID: pulid.ID(field("business_unit_id")),
+	}
+	if event.ID.IsNil() || event.Type == "" || event.IdempotencyKey == "" ||
+		event.OrganizationID.IsNil() || event.BusinessUnitID.IsNil() {
+		return nil, errors.New("domain event is missing required fields")
+	}
+
+	version, err := strconv.Atoi(field("event_version"))
+	if err != nil {
+		return nil, fmt.Errorf("parse event_version: %w", err)
+	}
+	event.Version = version
+
+	occurredAt, err := strconv.ParseInt(field("occurred_at"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse occurred_at: %w", err)
+	}
+	event.OccurredAt = occurredAt
+
+	if err = sonic.UnmarshalString(field("payload"), &event.Payload); err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	return event, nil
+}
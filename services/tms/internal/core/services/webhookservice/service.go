@@ -0,0 +1,394 @@
+package webhookservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/encryptionservice"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/emoss08/trenova/shared/timeutils"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	Logger           *zap.Logger
+	SubscriptionRepo repositories.WebhookSubscriptionRepository
+	DeliveryRepo     repositories.WebhookDeliveryRepository
+	Encryption       *encryptionservice.Service
+	WorkflowStarter  services.WorkflowStarter
+}
+
+type Service struct {
+	l                *zap.Logger
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+	encryption       *encryptionservice.Service
+	workflowStarter  services.WorkflowStarter
+	httpClient       *http.Client
+	now              func() int64
+}
+
+func New(p Params) *Service {
+	return &Service{
+		l:                p.Logger.Named("service.webhook"),
+		subscriptionRepo: p.SubscriptionRepo,
+		deliveryRepo:     p.DeliveryRepo,
+		encryption:       p.Encryption,
+		workflowStarter:  p.WorkflowStarter,
+		httpClient:       newDeliveryClient(),
+		now:              timeutils.NowUnix,
+	}
+}
+
+// SubscriptionWithSecret is returned when a signing secret is created or
+// rotated. It is the only time the secret leaves the service.
+type SubscriptionWithSecret struct {
+	Subscription  *webhook.Subscription `json:"subscription"`
+	SigningSecret string                `json:"signingSecret"`
+}
+
+func (s *Service) List(
+	ctx context.Context,
+	req *repositories.ListWebhookSubscriptionsRequest,
+) (*pagination.ListResult[*webhook.Subscription], error) {
+	return s.subscriptionRepo.List(ctx, req)
+}
+
+func (s *Service) ListConnection(
+	ctx context.Context,
+	req *repositories.ListWebhookSubscriptionConnectionRequest,
+) (*pagination.CursorListResult[*webhook.Subscription], error) {
+	return s.subscriptionRepo.ListConnection(ctx, req)
+}
+
+func (s *Service) Get(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) (*webhook.Subscription, error) {
+	return s.subscriptionRepo.GetByID(ctx, req)
+}
+
+func (s *Service) Create(
+	ctx context.Context,
+	entity *webhook.Subscription,
+) (*SubscriptionWithSecret, error) {
+	log := s.l.With(zap.String("operation", "Create"))
+
+	if entity.ID.IsNil() {
+		entity.ID = pulid.MustNew("whs_")
+	}
+	entity.Status = webhook.StatusActive
+	entity.ConsecutiveFailures = 0
+	entity.DisabledAt = 0
+	entity.DisabledReason = ""
+	entity.LastDeliveredAt = 0
+	entity.PreviousSigningSecret = ""
+	entity.PreviousSecretExpiresAt = 0
+	entity.SecretRotatedAt = 0
+
+	secret, err := s.issueSecret(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	multiErr := errortypes.NewMultiError()
+	entity.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	created, err := s.subscriptionRepo.Create(ctx, entity)
+	if err != nil {
+		log.Error("failed to create webhook subscription", zap.Error(err))
+		return nil, err
+	}
+
+	return &SubscriptionWithSecret{Subscription: created, SigningSecret: secret}, nil
+}
+
+// Update applies the caller-editable fields of entity to the stored
+// subscription. Secrets, status, and delivery counters have their own actions.
+func (s *Service) Update(
+	ctx context.Context,
+	entity *webhook.Subscription,
+	apiKeyID pulid.ID,
+) (*webhook.Subscription, error) {
+	log := s.l.With(
+		zap.String("operation", "Update"),
+		zap.String("id", entity.ID.String()),
+	)
+
+	existing, err := s.subscriptionRepo.GetByID(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         entity.ID,
+		TenantInfo: subscriptionTenantInfo(entity),
+		APIKeyID:   apiKeyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing.Name = entity.Name
+	existing.Description = entity.Description
+	existing.URL = entity.URL
+	existing.CustomerID = entity.CustomerID
+	existing.EventTypes = entity.EventTypes
+	existing.Version = entity.Version
+
+	multiErr := errortypes.NewMultiError()
+	existing.Validate(multiErr)
+	if multiErr.HasErrors() {
+		return nil, multiErr
+	}
+
+	updated, err := s.subscriptionRepo.Update(ctx, existing)
+	if err != nil {
+		log.Error("failed to update webhook subscription", zap.Error(err))
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) Delete(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) error {
+	return s.subscriptionRepo.Delete(ctx, req)
+}
+
+// RotateSecret issues a new signing secret. The old one keeps signing
+// alongside it for webhook.SecretRotationGrace so receivers can switch over
+// without rejecting deliveries in between.
+func (s *Service) RotateSecret(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) (*SubscriptionWithSecret, error) {
+	log := s.l.With(
+		zap.String("operation", "RotateSecret"),
+		zap.String("id", req.ID.String()),
+	)
+
+	entity, err := s.subscriptionRepo.GetByID(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	entity.PreviousSigningSecret = entity.SigningSecret
+	entity.PreviousSecretExpiresAt = now + webhook.SecretRotationGrace
+	entity.SecretRotatedAt = now
+
+	secret, err := s.issueSecret(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.subscriptionRepo.Update(ctx, entity)
+	if err != nil {
+		log.Error("failed to rotate webhook signing secret", zap.Error(err))
+		return nil, err
+	}
+
+	return &SubscriptionWithSecret{Subscription: updated, SigningSecret: secret}, nil
+}
+
+// Enable reactivates a subscription and clears its failure count, whether it
+// was disabled by hand or after repeated failures.
+func (s *Service) Enable(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) (*webhook.Subscription, error) {
+	entity, err := s.subscriptionRepo.GetByID(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.Enable()
+	return s.subscriptionRepo.Update(ctx, entity)
+}
+
+func (s *Service) Disable(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) (*webhook.Subscription, error) {
+	entity, err := s.subscriptionRepo.GetByID(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if entity.Status == webhook.StatusDisabled {
+		return entity, nil
+	}
+
+	entity.Status = webhook.StatusDisabled
+	entity.DisabledAt = s.now()
+	entity.DisabledReason = "Disabled manually"
+	return s.subscriptionRepo.Update(ctx, entity)
+}
+
+func (s *Service) ListDeliveries(
+	ctx context.Context,
+	req *repositories.ListWebhookDeliveriesRequest,
+	apiKeyID pulid.ID,
+) (*pagination.ListResult[*webhook.Delivery], error) {
+	if err := s.checkSubscriptionAccess(ctx, req.SubscriptionID, req.Filter.TenantInfo, apiKeyID); err != nil {
+		return nil, err
+	}
+
+	return s.deliveryRepo.List(ctx, req)
+}
+
+func (s *Service) ListDeliveriesConnection(
+	ctx context.Context,
+	req *repositories.ListWebhookDeliveryConnectionRequest,
+	apiKeyID pulid.ID,
+) (*pagination.CursorListResult[*webhook.Delivery], error) {
+	if err := s.checkSubscriptionAccess(ctx, req.SubscriptionID, req.Filter.TenantInfo, apiKeyID); err != nil {
+		return nil, err
+	}
+
+	return s.deliveryRepo.ListConnection(ctx, req)
+}
+
+func (s *Service) ListDeliveryAttempts(
+	ctx context.Context,
+	req repositories.GetWebhookDeliveryByIDRequest,
+	apiKeyID pulid.ID,
+) ([]*webhook.DeliveryAttempt, error) {
+	if _, err := s.getOwnedDelivery(ctx, req, apiKeyID); err != nil {
+		return nil, err
+	}
+
+	return s.deliveryRepo.ListAttempts(ctx, req)
+}
+
+// Redeliver sends a delivery once more, including to a disabled subscription
+// so an integrator can confirm a fixed endpoint before enabling it again. A
+// manual send is a single attempt and never counts toward auto-disable.
+func (s *Service) Redeliver(
+	ctx context.Context,
+	req repositories.GetWebhookDeliveryByIDRequest,
+	apiKeyID pulid.ID,
+) (*webhook.Delivery, error) {
+	delivery, err := s.getOwnedDelivery(ctx, req, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.startDelivery(ctx, delivery, webhook.AttemptTriggerManual); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (s *Service) getOwnedDelivery(
+	ctx context.Context,
+	req repositories.GetWebhookDeliveryByIDRequest,
+	apiKeyID pulid.ID,
+) (*webhook.Delivery, error) {
+	delivery, err := s.deliveryRepo.GetByID(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKeyID.IsNotNil() {
+		if err = s.checkSubscriptionAccess(
+			ctx,
+			delivery.SubscriptionID,
+			req.TenantInfo,
+			apiKeyID,
+		); err != nil {
+			return nil, errortypes.NewNotFoundError(
+				"Webhook delivery not found within your organization",
+			)
+		}
+	}
+
+	return delivery, nil
+}
+
+func (s *Service) checkSubscriptionAccess(
+	ctx context.Context,
+	subscriptionID pulid.ID,
+	tenantInfo pagination.TenantInfo,
+	apiKeyID pulid.ID,
+) error {
+	_, err := s.subscriptionRepo.GetByID(ctx, repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         subscriptionID,
+		TenantInfo: tenantInfo,
+		APIKeyID:   apiKeyID,
+	})
+	return err
+}
+
+func (s *Service) issueSecret(entity *webhook.Subscription) (string, error) {
+	if s.encryption == nil {
+		return "", errortypes.NewBusinessError("Webhook signing secrets are not configured")
+	}
+
+	secret, hint, err := webhook.GenerateSigningSecret()
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := s.encryption.EncryptStringWithAAD(secret, secretAAD(entity))
+	if err != nil {
+		return "", errortypes.NewBusinessError("Webhook signing secret could not be encrypted").
+			WithInternal(err)
+	}
+
+	entity.SigningSecret = encrypted
+	entity.SecretHint = hint
+	return secret, nil
+}
+
+func (s *Service) signingSecrets(entity *webhook.Subscription) ([]string, error) {
+	if s.encryption == nil {
+		return nil, errortypes.NewBusinessError("Webhook signing secrets are not configured")
+	}
+
+	current, err := s.encryption.DecryptStringWithAAD(entity.SigningSecret, secretAAD(entity))
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []string{current}
+	if entity.HasPreviousSecret(s.now()) {
+		previous, prevErr := s.encryption.DecryptStringWithAAD(
+			entity.PreviousSigningSecret,
+			secretAAD(entity),
+		)
+		if prevErr != nil {
+			return nil, prevErr
+		}
+		secrets = append(secrets, previous)
+	}
+
+	return secrets, nil
+}
+
+// secretAAD binds both the current and previous secret to the subscription,
+// so a ciphertext copied to another subscription will not decrypt.
+func secretAAD(entity *webhook.Subscription) encryptionservice.AAD {
+	return encryptionservice.AAD{
+		Purpose:        encryptionservice.PurposeWebhookSigningSecret,
+		OrganizationID: entity.OrganizationID,
+		BusinessUnitID: entity.BusinessUnitID,
+		ResourceID:     entity.ID.String(),
+	}
+}
+
+func subscriptionTenantInfo(entity *webhook.Subscription) pagination.TenantInfo {
+	return pagination.TenantInfo{
+		OrgID: entity.OrganizationID,
+		BuID:  entity.BusinessUnitID,
+	}
+}
@@ -0,0 +1,619 @@
+package webhookservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/core/services/encryptionservice"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/emoss08/trenova/internal/testutil/mocks"
+	"github.com/emoss08/trenova/pkg/errortypes"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+)
+
+const testNow int64 = 1_760_000_000
+
+type testDeps struct {
+	service          *Service
+	subscriptionRepo *mocks.MockWebhookSubscriptionRepository
+	deliveryRepo     *mocks.MockWebhookDeliveryRepository
+	workflowStarter  *mocks.MockWorkflowStarter
+}
+
+func newTestService(t *testing.T) *testDeps {
+	t.Helper()
+
+	deps := &testDeps{
+		subscriptionRepo: mocks.NewMockWebhookSubscriptionRepository(t),
+		deliveryRepo:     mocks.NewMockWebhookDeliveryRepository(t),
+		workflowStarter:  mocks.NewMockWorkflowStarter(t),
+	}
+	deps.service = New(Params{
+		Logger:           zap.NewNop(),
+		SubscriptionRepo: deps.subscriptionRepo,
+		DeliveryRepo:     deps.deliveryRepo,
+		Encryption: encryptionservice.New(encryptionservice.Params{
+			Config: &config.Config{
+				Security: config.SecurityConfig{
+					Encryption: config.EncryptionConfig{
+						Key: "unit-test-encryption-key-with-at-least-32-bytes",
+					},
+				},
+			},
+		}),
+		WorkflowStarter: deps.workflowStarter,
+	})
+	deps.service.now = func() int64 { return testNow }
+
+	return deps
+}
+
+func testSubscription(t *testing.T, s *Service, url string) (*webhook.Subscription, string) {
+	t.Helper()
+
+	entity := &webhook.Subscription{
+		ID:             pulid.MustNew("whs_"),
+		BusinessUnitID: pulid.MustNew("bu_"),
+		OrganizationID: pulid.MustNew("org_"),
+		Name:           "Shipment updates",
+		URL:            url,
+		CustomerID:     pulid.MustNew("cus_"),
+		EventTypes:     []domainevent.Type{domainevent.TypeShipmentStatusChanged},
+		Status:         webhook.StatusActive,
+	}
+	secret, err := s.issueSecret(entity)
+	require.NoError(t, err)
+
+	return entity, secret
+}
+
+func testDelivery(subscription *webhook.Subscription) *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:             pulid.MustNew("whd_"),
+		BusinessUnitID: subscription.BusinessUnitID,
+		OrganizationID: subscription.OrganizationID,
+		SubscriptionID: subscription.ID,
+		EventID:        pulid.MustNew("evt_"),
+		EventType:      domainevent.TypeShipmentStatusChanged,
+		EventVersion:   1,
+		IdempotencyKey: "shipment.status_changed:shp_1:3",
+		Payload:        map[string]any{"customerId": subscription.CustomerID.String()},
+		OccurredAt:     testNow - 5,
+		Status:         webhook.DeliveryStatusPending,
+	}
+}
+
+func TestCreateIssuesEncryptedSigningSecret(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	deps.subscriptionRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entity *webhook.Subscription) (*webhook.Subscription, error) {
+			return entity, nil
+		}).
+		Once()
+
+	result, err := deps.service.Create(t.Context(), &webhook.Subscription{
+		BusinessUnitID:      pulid.MustNew("bu_"),
+		OrganizationID:      pulid.MustNew("org_"),
+		Name:                "Status feed",
+		URL:                 "https://hooks.example.com/trenova",
+		CustomerID:          pulid.MustNew("cus_"),
+		EventTypes:          []domainevent.Type{domainevent.TypeShipmentStatusChanged},
+		Status:              webhook.StatusDisabled,
+		ConsecutiveFailures: 4,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result.SigningSecret, "whsec_"))
+	assert.NotEqual(t, result.SigningSecret, result.Subscription.SigningSecret)
+	assert.True(t, strings.HasPrefix(result.SigningSecret, result.Subscription.SecretHint))
+	assert.Equal(t, webhook.StatusActive, result.Subscription.Status)
+	assert.Zero(t, result.Subscription.ConsecutiveFailures)
+
+	secrets, err := deps.service.signingSecrets(result.Subscription)
+	require.NoError(t, err)
+	assert.Equal(t, []string{result.SigningSecret}, secrets)
+}
+
+func TestRotateSecretKeepsPreviousSecretDuringGrace(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	entity, original := testSubscription(t, deps.service, "https://hooks.example.com")
+	req := repositories.GetWebhookSubscriptionByIDRequest{
+		ID:         entity.ID,
+		TenantInfo: subscriptionTenantInfo(entity),
+	}
+	deps.subscriptionRepo.EXPECT().GetByID(mock.Anything, req).Return(entity, nil).Once()
+	deps.subscriptionRepo.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, entity *webhook.Subscription) (*webhook.Subscription, error) {
+			return entity, nil
+		}).
+		Once()
+
+	result, err := deps.service.RotateSecret(t.Context(), req)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, original, result.SigningSecret)
+	assert.Equal(t, testNow+webhook.SecretRotationGrace, result.Subscription.PreviousSecretExpiresAt)
+
+	secrets, err := deps.service.signingSecrets(result.Subscription)
+	require.NoError(t, err)
+	assert.Equal(t, []string{result.SigningSecret, original}, secrets)
+}
+
+func TestDispatchRecordsMatchingDeliveriesOnce(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	matching, _ := testSubscription(t, deps.service, "https://a.example.com")
+	otherCustomer, _ := testSubscription(t, deps.service, "https://b.example.com")
+	alreadySent, _ := testSubscription(t, deps.service, "https://c.example.com")
+	alreadySent.CustomerID = matching.CustomerID
+
+	event := &Event{
+		ID:             pulid.MustNew("evt_"),
+		Type:           domainevent.TypeShipmentStatusChanged,
+		Version:        1,
+		IdempotencyKey: "shipment.status_changed:shp_1:3",
+		OrganizationID: matching.OrganizationID,
+		BusinessUnitID: matching.BusinessUnitID,
+		OccurredAt:     testNow,
+		Payload:        map[string]any{"customerId": matching.CustomerID.String()},
+	}
+
+	deps.subscriptionRepo.EXPECT().
+		FindForEvent(mock.Anything, repositories.FindWebhookSubscriptionsForEventRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: event.OrganizationID,
+				BuID:  event.BusinessUnitID,
+			},
+			EventType: event.Type,
+		}).
+		Return([]*webhook.Subscription{matching, otherCustomer, alreadySent}, nil).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		CreateIfAbsent(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.SubscriptionID == matching.ID
+		})).
+		RunAndReturn(func(_ context.Context, d *webhook.Delivery) (bool, error) {
+			assert.Equal(t, event.IdempotencyKey, d.IdempotencyKey)
+			assert.Equal(t, event.OccurredAt, d.OccurredAt)
+			d.ID = pulid.MustNew("whd_")
+			return true, nil
+		}).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		CreateIfAbsent(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.SubscriptionID == alreadySent.ID
+		})).
+		Return(false, nil).
+		Once()
+	sent := testDelivery(alreadySent)
+	sent.Status = webhook.DeliveryStatusSucceeded
+	deps.deliveryRepo.EXPECT().
+		GetByIdempotencyKey(mock.Anything, repositories.GetWebhookDeliveryByIdempotencyKeyRequest{
+			TenantInfo: pagination.TenantInfo{
+				OrgID: event.OrganizationID,
+				BuID:  event.BusinessUnitID,
+			},
+			SubscriptionID: alreadySent.ID,
+			IdempotencyKey: event.IdempotencyKey,
+		}).
+		Return(sent, nil).
+		Once()
+	deps.workflowStarter.EXPECT().Enabled().Return(true).Once()
+	deps.workflowStarter.EXPECT().
+		StartWorkflow(
+			mock.Anything,
+			mock.MatchedBy(func(opts client.StartWorkflowOptions) bool {
+				return strings.HasPrefix(opts.ID, "webhook-delivery-whd_")
+			}),
+			mock.Anything,
+			mock.MatchedBy(func(args []any) bool {
+				p, ok := args[0].(*DeliverWebhookPayload)
+				return ok && p.Trigger == webhook.AttemptTriggerAutomatic
+			}),
+		).
+		Return(nil, nil).
+		Once()
+
+	require.NoError(t, deps.service.Dispatch(t.Context(), event))
+}
+
+func TestDispatchRestartsDeliveryStillPending(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	subscription, _ := testSubscription(t, deps.service, "https://a.example.com")
+	pending := testDelivery(subscription)
+	event := &Event{
+		ID:             pending.EventID,
+		Type:           pending.EventType,
+		Version:        1,
+		IdempotencyKey: pending.IdempotencyKey,
+		OrganizationID: subscription.OrganizationID,
+		BusinessUnitID: subscription.BusinessUnitID,
+		Payload:        pending.Payload,
+	}
+
+	deps.subscriptionRepo.EXPECT().
+		FindForEvent(mock.Anything, mock.Anything).
+		Return([]*webhook.Subscription{subscription}, nil).
+		Once()
+	deps.deliveryRepo.EXPECT().CreateIfAbsent(mock.Anything, mock.Anything).Return(false, nil).Once()
+	deps.deliveryRepo.EXPECT().
+		GetByIdempotencyKey(mock.Anything, mock.Anything).
+		Return(pending, nil).
+		Once()
+	deps.workflowStarter.EXPECT().Enabled().Return(true).Once()
+	deps.workflowStarter.EXPECT().
+		StartWorkflow(
+			mock.Anything,
+			mock.MatchedBy(func(opts client.StartWorkflowOptions) bool {
+				return opts.ID == "webhook-delivery-"+pending.ID.String()
+			}),
+			mock.Anything,
+			mock.Anything,
+		).
+		Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("running", "", "")).
+		Once()
+
+	require.NoError(t, deps.service.Dispatch(t.Context(), event))
+}
+
+func TestAbandonEventRecordsFailedDeliveries(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	fresh, _ := testSubscription(t, deps.service, "https://a.example.com")
+	stuck, _ := testSubscription(t, deps.service, "https://b.example.com")
+	stuck.CustomerID = fresh.CustomerID
+	pending := testDelivery(stuck)
+	event := &Event{
+		ID:             pending.EventID,
+		Type:           pending.EventType,
+		Version:        1,
+		IdempotencyKey: pending.IdempotencyKey,
+		OrganizationID: fresh.OrganizationID,
+		BusinessUnitID: fresh.BusinessUnitID,
+		Payload:        map[string]any{"customerId": fresh.CustomerID.String()},
+	}
+	reason := "Event could not be dispatched after 5 attempts"
+
+	deps.subscriptionRepo.EXPECT().
+		FindForEvent(mock.Anything, mock.Anything).
+		Return([]*webhook.Subscription{fresh, stuck}, nil).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		CreateIfAbsent(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.SubscriptionID == fresh.ID
+		})).
+		RunAndReturn(func(_ context.Context, d *webhook.Delivery) (bool, error) {
+			assert.Equal(t, webhook.DeliveryStatusFailed, d.Status)
+			assert.Equal(t, reason, d.LastError)
+			return true, nil
+		}).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		CreateIfAbsent(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.SubscriptionID == stuck.ID
+		})).
+		Return(false, nil).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		GetByIdempotencyKey(mock.Anything, mock.Anything).
+		Return(pending, nil).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.ID == pending.ID && d.Status == webhook.DeliveryStatusFailed && d.LastError == reason
+		})).
+		Return(pending, nil).
+		Once()
+
+	require.NoError(t, deps.service.AbandonEvent(t.Context(), event, reason))
+}
+
+func TestDispatchIgnoresInternalEvents(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+
+	require.NoError(t, deps.service.Dispatch(t.Context(), &Event{
+		ID:   pulid.MustNew("evt_"),
+		Type: domainevent.TypeCarrierSettlementApproved,
+	}))
+}
+
+func TestDeliverWebhookSignsAndRecordsSuccess(t *testing.T) {
+	t.Parallel()
+
+	var (
+		receivedBody    []byte
+		receivedHeaders http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		receivedHeaders = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	deps := newTestService(t)
+	deps.service.httpClient = server.Client()
+	subscription, secret := testSubscription(t, deps.service, server.URL)
+	delivery := testDelivery(subscription)
+	tenantInfo := deliveryTenantInfo(delivery)
+
+	deps.deliveryRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(delivery, nil).Once()
+	deps.subscriptionRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(subscription, nil).Once()
+	deps.deliveryRepo.EXPECT().
+		CreateAttempt(mock.Anything, mock.MatchedBy(func(a *webhook.DeliveryAttempt) bool {
+			return a.Succeeded && a.AttemptNumber == 1 && a.StatusCode == http.StatusNoContent &&
+				a.Trigger == webhook.AttemptTriggerAutomatic
+		})).
+		Return(nil).
+		Once()
+	deps.deliveryRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.Status == webhook.DeliveryStatusSucceeded && d.DeliveredAt == testNow
+		})).
+		Return(delivery, nil).
+		Once()
+	deps.subscriptionRepo.EXPECT().
+		RecordDeliverySuccess(mock.Anything, repositories.RecordWebhookDeliverySuccessRequest{
+			ID:          subscription.ID,
+			TenantInfo:  tenantInfo,
+			DeliveredAt: testNow,
+		}).
+		Return(nil).
+		Once()
+
+	result, err := deps.service.DeliverWebhook(t.Context(), &DeliverWebhookPayload{
+		DeliveryID: delivery.ID,
+		TenantInfo: tenantInfo,
+		Trigger:    webhook.AttemptTriggerAutomatic,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, result.Status)
+
+	timestamp := receivedHeaders.Get(webhook.HeaderTimestamp)
+	assert.Equal(t, "1760000000", timestamp)
+	assert.Equal(t,
+		webhook.Sign(secret, timestamp, receivedBody),
+		receivedHeaders.Get(webhook.HeaderSignature),
+	)
+	assert.Equal(t, delivery.ID.String(), receivedHeaders.Get(webhook.HeaderDeliveryID))
+	assert.Equal(t, delivery.IdempotencyKey, receivedHeaders.Get(webhook.HeaderIdempotencyKey))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(receivedBody, &body))
+	assert.Equal(t, string(domainevent.TypeShipmentStatusChanged), body["type"])
+	assert.Equal(t, delivery.EventID.String(), body["id"])
+	assert.Equal(t, float64(delivery.OccurredAt), body["occurredAt"])
+	assert.Equal(t, subscription.CustomerID.String(), body["data"].(map[string]any)["customerId"])
+}
+
+func TestDeliverWebhookClassifiesFailures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		status       int
+		nonRetryable bool
+	}{
+		{name: "server error retries", status: http.StatusServiceUnavailable},
+		{name: "throttling retries", status: http.StatusTooManyRequests},
+		{name: "rejection does not retry", status: http.StatusBadRequest, nonRetryable: true},
+		{name: "missing endpoint does not retry", status: http.StatusNotFound, nonRetryable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("nope"))
+			}))
+			t.Cleanup(server.Close)
+
+			deps := newTestService(t)
+			deps.service.httpClient = server.Client()
+			subscription, _ := testSubscription(t, deps.service, server.URL)
+			delivery := testDelivery(subscription)
+			delivery.AttemptCount = 2
+
+			deps.deliveryRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(delivery, nil).Once()
+			deps.subscriptionRepo.EXPECT().
+				GetByID(mock.Anything, mock.Anything).
+				Return(subscription, nil).
+				Once()
+			deps.deliveryRepo.EXPECT().
+				CreateAttempt(mock.Anything, mock.MatchedBy(func(a *webhook.DeliveryAttempt) bool {
+					return !a.Succeeded && a.AttemptNumber == 3 && a.StatusCode == tt.status &&
+						a.ResponseBody == "nope"
+				})).
+				Return(nil).
+				Once()
+			deps.deliveryRepo.EXPECT().
+				Update(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+					return d.Status == webhook.DeliveryStatusPending && d.AttemptCount == 3
+				})).
+				Return(delivery, nil).
+				Once()
+
+			_, err := deps.service.DeliverWebhook(t.Context(), &DeliverWebhookPayload{
+				DeliveryID: delivery.ID,
+				TenantInfo: deliveryTenantInfo(delivery),
+				Trigger:    webhook.AttemptTriggerAutomatic,
+			})
+
+			require.Error(t, err)
+			var appErr *temporal.ApplicationError
+			assert.Equal(t, tt.nonRetryable, errors.As(err, &appErr) && appErr.NonRetryable())
+		})
+	}
+}
+
+func TestDeliverWebhookSkipsDisabledSubscription(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	subscription, _ := testSubscription(t, deps.service, "https://hooks.example.com")
+	subscription.Status = webhook.StatusDisabled
+	delivery := testDelivery(subscription)
+
+	deps.deliveryRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(delivery, nil).Once()
+	deps.subscriptionRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(subscription, nil).Once()
+	deps.deliveryRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+			return d.Status == webhook.DeliveryStatusFailed
+		})).
+		Return(delivery, nil).
+		Once()
+
+	result, err := deps.service.DeliverWebhook(t.Context(), &DeliverWebhookPayload{
+		DeliveryID: delivery.ID,
+		TenantInfo: deliveryTenantInfo(delivery),
+		Trigger:    webhook.AttemptTriggerAutomatic,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Skipped)
+}
+
+func TestMarkDeliveryFailedCountsOnlyAutomaticDeliveries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("automatic", func(t *testing.T) {
+		t.Parallel()
+
+		deps := newTestService(t)
+		subscription, _ := testSubscription(t, deps.service, "https://hooks.example.com")
+		delivery := testDelivery(subscription)
+
+		deps.deliveryRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(delivery, nil).Once()
+		deps.deliveryRepo.EXPECT().
+			Update(mock.Anything, mock.MatchedBy(func(d *webhook.Delivery) bool {
+				return d.Status == webhook.DeliveryStatusFailed
+			})).
+			Return(delivery, nil).
+			Once()
+		deps.subscriptionRepo.EXPECT().
+			RecordDeliveryFailure(mock.Anything, repositories.RecordWebhookDeliveryFailureRequest{
+				ID:           subscription.ID,
+				TenantInfo:   deliveryTenantInfo(delivery),
+				DisableAfter: webhook.AutoDisableThreshold,
+				FailedAt:     testNow,
+			}).
+			Return(subscription, nil).
+			Once()
+
+		require.NoError(t, deps.service.MarkDeliveryFailed(t.Context(), &MarkWebhookDeliveryFailedPayload{
+			DeliveryID: delivery.ID,
+			TenantInfo: deliveryTenantInfo(delivery),
+			Trigger:    webhook.AttemptTriggerAutomatic,
+			Reason:     "retries exhausted",
+		}))
+	})
+
+	t.Run("manual", func(t *testing.T) {
+		t.Parallel()
+
+		deps := newTestService(t)
+		subscription, _ := testSubscription(t, deps.service, "https://hooks.example.com")
+		delivery := testDelivery(subscription)
+
+		deps.deliveryRepo.EXPECT().GetByID(mock.Anything, mock.Anything).Return(delivery, nil).Once()
+		deps.deliveryRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(delivery, nil).Once()
+
+		require.NoError(t, deps.service.MarkDeliveryFailed(t.Context(), &MarkWebhookDeliveryFailedPayload{
+			DeliveryID: delivery.ID,
+			TenantInfo: deliveryTenantInfo(delivery),
+			Trigger:    webhook.AttemptTriggerManual,
+		}))
+	})
+}
+
+func TestRedeliverRejectsConcurrentRedelivery(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	subscription, _ := testSubscription(t, deps.service, "https://hooks.example.com")
+	delivery := testDelivery(subscription)
+	req := repositories.GetWebhookDeliveryByIDRequest{
+		ID:         delivery.ID,
+		TenantInfo: deliveryTenantInfo(delivery),
+	}
+
+	deps.deliveryRepo.EXPECT().GetByID(mock.Anything, req).Return(delivery, nil).Once()
+	deps.workflowStarter.EXPECT().Enabled().Return(true).Once()
+	deps.workflowStarter.EXPECT().
+		StartWorkflow(
+			mock.Anything,
+			mock.MatchedBy(func(opts client.StartWorkflowOptions) bool {
+				return opts.ID == "webhook-redelivery-"+delivery.ID.String()
+			}),
+			mock.Anything,
+			mock.Anything,
+		).
+		Return(nil, serviceerror.NewWorkflowExecutionAlreadyStarted("running", "", "")).
+		Once()
+
+	_, err := deps.service.Redeliver(t.Context(), req, pulid.Nil)
+
+	require.Error(t, err)
+	var businessErr *errortypes.BusinessError
+	assert.ErrorAs(t, err, &businessErr)
+}
+
+func TestRedeliverHidesOtherAPIKeysDeliveries(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestService(t)
+	subscription, _ := testSubscription(t, deps.service, "https://hooks.example.com")
+	delivery := testDelivery(subscription)
+	apiKeyID := pulid.MustNew("ak_")
+	req := repositories.GetWebhookDeliveryByIDRequest{
+		ID:         delivery.ID,
+		TenantInfo: deliveryTenantInfo(delivery),
+	}
+
+	deps.deliveryRepo.EXPECT().GetByID(mock.Anything, req).Return(delivery, nil).Once()
+	deps.subscriptionRepo.EXPECT().
+		GetByID(mock.Anything, repositories.GetWebhookSubscriptionByIDRequest{
+			ID:         subscription.ID,
+			TenantInfo: req.TenantInfo,
+			APIKeyID:   apiKeyID,
+		}).
+		Return(nil, errortypes.NewNotFoundError("Webhook subscription not found")).
+		Once()
+
+	_, err := deps.service.Redeliver(t.Context(), req, apiKeyID)
+
+	require.Error(t, err)
+	assert.True(t, errortypes.IsNotFoundError(err))
+}
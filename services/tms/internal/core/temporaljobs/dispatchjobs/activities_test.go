@@ -33,6 +33,13 @@ func (m *stubProposalRepo) ExpirePendingByRun(
 	return args.Int(0), args.Error(1)
 }
 
+func (m *stubProposalRepo) ListPendingByTour(
+	context.Context,
+	repositories.ListAgentProposalsByTourRequest,
+) ([]*agent.AgentProposal, error) {
+	return nil, nil
+}
+
 func (m *stubProposalRepo) List(
 	context.Context,
 	*repositories.ListAgentProposalRequest,
@@ -92,3 +92,33 @@ func (a *Activities) MarkEDIMessageDeadLetteredActivity(
 	)
 	return nil
 }
+
+func (a *Activities) ExpireAS2MDNActivity(
+	ctx context.Context,
+	payload *ExpireAS2MDNPayload,
+) error {
+	if err := a.ediService.ExpireAS2MDN(ctx, payload); err != nil {
+		a.logger.Error("AS2 MDN expiry activity failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (a *Activities) AdvanceEDICertificationRunActivity(
+	ctx context.Context,
+	payload *AdvanceEDICertificationRunPayload,
+) (*AdvanceEDICertificationRunResult, error) {
+	result, err := a.ediService.AdvanceCertificationRun(ctx, payload)
+	if err != nil {
+		a.logger.Error("EDI certification activity failed", zap.Error(err))
+		return nil, err
+	}
+	if result.Completed {
+		a.logger.Info(
+			"EDI certification run completed",
+			zap.String("runId", result.RunID.String()),
+			zap.String("status", string(result.Status)),
+		)
+	}
+	return result, nil
+}
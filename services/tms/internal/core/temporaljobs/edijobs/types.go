@@ -7,3 +7,6 @@ type ApproveLoadTenderTransferWorkflowResult = ediservice.ApproveLoadTenderTrans
 type DeliverEDIMessageWorkflowPayload = ediservice.DeliverEDIMessageWorkflowPayload
 type DeliverEDIMessageWorkflowResult = ediservice.DeliverEDIMessageWorkflowResult
 type MarkEDIMessageDeadLetteredPayload = ediservice.MarkEDIMessageDeadLetteredPayload
+type ExpireAS2MDNPayload = ediservice.ExpireAS2MDNPayload
+type AdvanceEDICertificationRunPayload = ediservice.AdvanceEDICertificationRunPayload
+type AdvanceEDICertificationRunResult = ediservice.AdvanceEDICertificationRunResult
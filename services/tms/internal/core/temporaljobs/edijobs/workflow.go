@@ -57,6 +57,39 @@ var deadLetterActivityOptions = workflow.ActivityOptions{
 	},
 }
 
+var expireAS2MDNActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumAttempts:    5,
+		MaximumInterval:    time.Minute,
+	},
+}
+
+// awaitAS2MDNChangeID versions the wait for an async AS2 MDN. Deliveries
+// started before it replay without the wait; every new one records version 1.
+const awaitAS2MDNChangeID = "await-as2-mdn"
+
+var advanceCertificationActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: 5 * time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumAttempts:    5,
+		MaximumInterval:    time.Minute,
+	},
+}
+
+// certificationPollInterval is how often a running certification re-checks
+// the partner's responses. Step timeouts are enforced by the service, so the
+// workflow only needs to keep polling until the run completes. Long runs
+// continue as new to keep the event history bounded.
+const (
+	certificationPollInterval       = 15 * time.Second
+	certificationPollsBeforeRestart = 500
+)
+
 func RegisterWorkflows() []temporaltype.WorkflowDefinition {
 	return []temporaltype.WorkflowDefinition{
 		{
@@ -71,6 +104,12 @@ func RegisterWorkflows() []temporaltype.WorkflowDefinition {
 			TaskQueue:   temporaltype.EDITaskQueue,
 			Description: "Deliver an outbound EDI message to its trading partner",
 		},
+		{
+			Name:        temporaltype.RunEDICertificationWorkflowName,
+			Fn:          RunEDICertificationWorkflow,
+			TaskQueue:   temporaltype.EDITaskQueue,
+			Description: "Drive a scripted certification exchange with an EDI partner",
+		},
 		{
 			Name:        PollInboundMailboxesWorkflowName,
 			Fn:          PollInboundMailboxesWorkflow,
@@ -131,6 +170,10 @@ func DeliverEDIMessageWorkflow(
 		payload,
 	).Get(activityCtx, result)
 	if err == nil {
+		awaitsMDN := workflow.GetVersion(ctx, awaitAS2MDNChangeID, workflow.DefaultVersion, 1)
+		if awaitsMDN >= 1 && result.AwaitingMDN() {
+			awaitAS2MDN(ctx, payload, result)
+		}
 		workflow.GetLogger(ctx).Info("EDI message delivery workflow completed")
 		return result, nil
 	}
@@ -154,3 +197,85 @@ func DeliverEDIMessageWorkflow(
 	}
 	return nil, err
 }
+
+func RunEDICertificationWorkflow(
+	ctx workflow.Context,
+	payload *AdvanceEDICertificationRunPayload,
+) (*AdvanceEDICertificationRunResult, error) {
+	activityCtx := workflow.WithActivityOptions(ctx, advanceCertificationActivityOptions)
+
+	var a *Activities
+	for range certificationPollsBeforeRestart {
+		result := new(AdvanceEDICertificationRunResult)
+		if err := workflow.ExecuteActivity(
+			activityCtx,
+			a.AdvanceEDICertificationRunActivity,
+			payload,
+		).Get(activityCtx, result); err != nil {
+			workflow.GetLogger(ctx).Error("EDI certification workflow failed", "error", err)
+			return nil, err
+		}
+		if result.Completed {
+			workflow.GetLogger(ctx).Info(
+				"EDI certification workflow completed",
+				"status", string(result.Status),
+			)
+			return result, nil
+		}
+		if err := workflow.Sleep(ctx, certificationPollInterval); err != nil {
+			return nil, err
+		}
+	}
+	return nil, workflow.NewContinueAsNewError(ctx, RunEDICertificationWorkflow, payload)
+}
+
+// awaitAS2MDN keeps an async AS2 delivery open until the partner's MDN is
+// applied or the profile's MDN timeout elapses. Signals for an earlier
+// transmission of the same message are ignored.
+func awaitAS2MDN(
+	ctx workflow.Context,
+	payload *DeliverEDIMessageWorkflowPayload,
+	result *DeliverEDIMessageWorkflowResult,
+) {
+	timeout := time.Duration(result.MDNTimeoutSeconds) * time.Second
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	timer := workflow.NewTimer(timerCtx, timeout)
+	signals := workflow.GetSignalChannel(ctx, temporaltype.AS2MDNReceivedSignalName)
+
+	for {
+		var as2MessageID string
+		timedOut := false
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signals, func(ch workflow.ReceiveChannel, _ bool) {
+			ch.Receive(ctx, &as2MessageID)
+		})
+		selector.AddFuture(timer, func(workflow.Future) {
+			timedOut = true
+		})
+		selector.Select(ctx)
+		if timedOut {
+			break
+		}
+		if as2MessageID == result.AS2MessageID {
+			workflow.GetLogger(ctx).Info("AS2 MDN received for EDI message delivery")
+			return
+		}
+	}
+
+	workflow.GetLogger(ctx).Warn("AS2 MDN overdue for EDI message delivery", "timeout", timeout)
+	expireCtx := workflow.WithActivityOptions(ctx, expireAS2MDNActivityOptions)
+	var a *Activities
+	if err := workflow.ExecuteActivity(
+		expireCtx,
+		a.ExpireAS2MDNActivity,
+		&ExpireAS2MDNPayload{
+			MessageID:         result.MessageID,
+			TenantInfo:        payload.TenantInfo,
+			AS2MessageID:      result.AS2MessageID,
+			MDNTimeoutSeconds: result.MDNTimeoutSeconds,
+		},
+	).Get(expireCtx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("failed to expire overdue AS2 MDN", "error", err)
+	}
+}
@@ -3,14 +3,17 @@ package edijobs
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/emoss08/trenova/internal/core/domain/edi"
 	"github.com/emoss08/trenova/internal/core/services/editransport"
 	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/pkg/temporaltype"
 	"github.com/emoss08/trenova/shared/pulid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
 )
 
 func newDeliverWorkflowTestEnv(t *testing.T) *testsuite.TestWorkflowEnvironment {
@@ -102,3 +105,116 @@ func TestDeliverEDIMessageWorkflow_DeadLettersAfterExhaustedRetries(t *testing.T
 	require.Error(t, env.GetWorkflowError())
 	env.AssertExpectations(t)
 }
+
+func awaitingMDNResult(payload *DeliverEDIMessageWorkflowPayload) *DeliverEDIMessageWorkflowResult {
+	return &DeliverEDIMessageWorkflowResult{
+		MessageID:         payload.MessageID,
+		DeliveryStatus:    edi.MessageDeliveryStatusSending,
+		RemotePath:        "https://partner.example.com/as2",
+		AS2MessageID:      "<async-1@trenova.as2>",
+		MDNTimeoutSeconds: int64((4 * time.Hour).Seconds()),
+	}
+}
+
+func TestDeliverEDIMessageWorkflow_CompletesWhenAS2MDNArrives(t *testing.T) {
+	env := newDeliverWorkflowTestEnv(t)
+	payload := deliverWorkflowPayload()
+	var a *Activities
+
+	env.OnActivity(a.DeliverEDIMessageActivity, mock.Anything, mock.Anything).
+		Return(awaitingMDNResult(payload), nil).
+		Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(temporaltype.AS2MDNReceivedSignalName, "<stale@trenova.as2>")
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(temporaltype.AS2MDNReceivedSignalName, "<async-1@trenova.as2>")
+	}, time.Hour)
+
+	env.ExecuteWorkflow(DeliverEDIMessageWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertNotCalled(t, "ExpireAS2MDNActivity")
+}
+
+func TestDeliverEDIMessageWorkflow_ExpiresOverdueAS2MDN(t *testing.T) {
+	env := newDeliverWorkflowTestEnv(t)
+	payload := deliverWorkflowPayload()
+	var a *Activities
+
+	env.OnActivity(a.DeliverEDIMessageActivity, mock.Anything, mock.Anything).
+		Return(awaitingMDNResult(payload), nil).
+		Once()
+	env.OnActivity(a.ExpireAS2MDNActivity, mock.Anything, mock.MatchedBy(
+		func(expire *ExpireAS2MDNPayload) bool {
+			return expire.MessageID == payload.MessageID &&
+				expire.TenantInfo == payload.TenantInfo &&
+				expire.AS2MessageID == "<async-1@trenova.as2>" &&
+				expire.MDNTimeoutSeconds == int64((4*time.Hour).Seconds())
+		},
+	)).
+		Return(nil).
+		Once()
+
+	env.ExecuteWorkflow(DeliverEDIMessageWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestDeliverEDIMessageWorkflow_HistoryBeforeAsyncMDNDoesNotWait(t *testing.T) {
+	env := newDeliverWorkflowTestEnv(t)
+	payload := deliverWorkflowPayload()
+	var a *Activities
+
+	env.OnGetVersion(awaitAS2MDNChangeID, workflow.DefaultVersion, 1).
+		Return(workflow.DefaultVersion)
+	env.OnActivity(a.DeliverEDIMessageActivity, mock.Anything, mock.Anything).
+		Return(awaitingMDNResult(payload), nil).
+		Once()
+
+	env.ExecuteWorkflow(DeliverEDIMessageWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertNotCalled(t, "ExpireAS2MDNActivity")
+}
+
+func TestRunEDICertificationWorkflow_PollsUntilRunCompletes(t *testing.T) {
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(RunEDICertificationWorkflow)
+	payload := &AdvanceEDICertificationRunPayload{
+		RunID: pulid.MustNew("edicr_"),
+		TenantInfo: pagination.TenantInfo{
+			OrgID: pulid.MustNew("org_"),
+			BuID:  pulid.MustNew("bu_"),
+		},
+	}
+	var a *Activities
+
+	env.OnActivity(a.AdvanceEDICertificationRunActivity, mock.Anything, mock.Anything).
+		Return(&AdvanceEDICertificationRunResult{
+			RunID:  payload.RunID,
+			Status: edi.CertificationRunStatusRunning,
+		}, nil).
+		Twice()
+	env.OnActivity(a.AdvanceEDICertificationRunActivity, mock.Anything, mock.Anything).
+		Return(&AdvanceEDICertificationRunResult{
+			RunID:     payload.RunID,
+			Status:    edi.CertificationRunStatusPassed,
+			Completed: true,
+		}, nil).
+		Once()
+
+	env.ExecuteWorkflow(RunEDICertificationWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	result := new(AdvanceEDICertificationRunResult)
+	require.NoError(t, env.GetWorkflowResult(result))
+	require.Equal(t, edi.CertificationRunStatusPassed, result.Status)
+	env.AssertNumberOfCalls(t, "AdvanceEDICertificationRunActivity", 3)
+}
@@ -17,7 +17,14 @@ import (
 const (
 	eventRetentionSeconds     = int64(90 * 86400)
 	violationRetentionSeconds = int64(365 * 86400)
-	hosLogRetentionSeconds    = int64(9 * 86400)
+
+	// hosLogRetentionSeconds keeps duty logs as long as the HOS projection reads them
+	// back: the Canadian rulesets look across a 14-day window.
+	hosLogRetentionSeconds = int64(15 * 86400)
+
+	// positionHistoryRetentionSeconds covers the four years IFTA requires distance
+	// records to be kept after a return is filed, plus the quarter being filed.
+	positionHistoryRetentionSeconds = int64((4*365 + 120) * 86400)
 )
 
 type ListTelematicsTenantsPayload struct {
@@ -80,6 +87,7 @@ func (a *Activities) CleanupTelematicsActivity(
 		now-eventRetentionSeconds,
 		now-violationRetentionSeconds,
 		now-hosLogRetentionSeconds,
+		now-positionHistoryRetentionSeconds,
 	)
 	if err != nil {
 		return nil, err
@@ -87,30 +95,46 @@ func (a *Activities) CleanupTelematicsActivity(
 	return &RetentionResult{RowsDeleted: deleted}, nil
 }
 
+var telematicsProviderTypes = []integration.Type{
+	integration.TypeSamsara,
+	integration.TypeMotive,
+}
+
 func (a *Activities) ListTelematicsTenantsActivity(
 	ctx context.Context,
 	payload *ListTelematicsTenantsPayload,
 ) (*ListTelematicsTenantsResult, error) {
 	limit := temporaljobs.NormalizeLimit(payload.Limit, temporaljobs.DefaultTenantScanLimit)
-	integrations, err := a.integrationRepo.ListEnabledByType(ctx, integration.TypeSamsara)
-	if err != nil {
-		return nil, err
-	}
 
-	spec := integration.ConfigSpecs[integration.TypeSamsara]
-	tenants := make([]pagination.TenantInfo, 0, min(len(integrations), limit))
-	for idx := range integrations {
-		if len(tenants) >= limit {
-			break
+	// A mixed fleet has one integration per provider but is polled once; the poll
+	// covers every provider it has enabled.
+	tenants := make([]pagination.TenantInfo, 0)
+	seen := make(map[pagination.TenantInfo]struct{})
+	for _, typ := range telematicsProviderTypes {
+		integrations, err := a.integrationRepo.ListEnabledByType(ctx, typ)
+		if err != nil {
+			return nil, err
 		}
-		integ := integrations[idx]
-		if !integration.HasRequiredConfiguration(integ.Configuration, spec) {
-			continue
+
+		spec := integration.ConfigSpecs[typ]
+		for idx := range integrations {
+			if len(tenants) >= limit {
+				break
+			}
+			integ := integrations[idx]
+			if !integration.HasRequiredConfiguration(integ.Configuration, spec) {
+				continue
+			}
+			tenantInfo := pagination.TenantInfo{
+				OrgID: integ.OrganizationID,
+				BuID:  integ.BusinessUnitID,
+			}
+			if _, ok := seen[tenantInfo]; ok {
+				continue
+			}
+			seen[tenantInfo] = struct{}{}
+			tenants = append(tenants, tenantInfo)
 		}
-		tenants = append(tenants, pagination.TenantInfo{
-			OrgID: integ.OrganizationID,
-			BuID:  integ.BusinessUnitID,
-		})
 	}
 
 	return &ListTelematicsTenantsResult{
@@ -0,0 +1,60 @@
+package webhookjobs
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/services/webhookservice"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type ActivitiesParams struct {
+	fx.In
+
+	WebhookService *webhookservice.Service
+	Logger         *zap.Logger
+}
+
+type Activities struct {
+	webhookService *webhookservice.Service
+	logger         *zap.Logger
+}
+
+func NewActivities(p ActivitiesParams) *Activities {
+	return &Activities{
+		webhookService: p.WebhookService,
+		logger:         p.Logger.Named("webhook-activities"),
+	}
+}
+
+func (a *Activities) DeliverWebhookActivity(
+	ctx context.Context,
+	payload *DeliverWebhookPayload,
+) (*DeliverWebhookResult, error) {
+	result, err := a.webhookService.DeliverWebhook(ctx, payload)
+	if err != nil {
+		a.logger.Warn("webhook delivery attempt failed",
+			zap.String("deliveryId", payload.DeliveryID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (a *Activities) MarkWebhookDeliveryFailedActivity(
+	ctx context.Context,
+	payload *MarkWebhookDeliveryFailedPayload,
+) error {
+	if err := a.webhookService.MarkDeliveryFailed(ctx, payload); err != nil {
+		a.logger.Error("webhook delivery failure activity failed", zap.Error(err))
+		return err
+	}
+
+	a.logger.Warn(
+		"webhook delivery failed after exhausted retries",
+		zap.String("deliveryId", payload.DeliveryID.String()),
+	)
+	return nil
+}
@@ -0,0 +1,17 @@
+package webhookjobs
+
+import (
+	"github.com/emoss08/trenova/internal/core/temporaljobs/registry"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module("webhook-jobs",
+	fx.Provide(NewActivities),
+	fx.Provide(
+		fx.Annotate(
+			NewRegistry,
+			fx.As(new(registry.WorkerRegistry)),
+			fx.ResultTags(`group:"worker_registries"`),
+		),
+	),
+)
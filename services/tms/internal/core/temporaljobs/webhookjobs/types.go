@@ -0,0 +1,7 @@
+package webhookjobs
+
+import "github.com/emoss08/trenova/internal/core/services/webhookservice"
+
+type DeliverWebhookPayload = webhookservice.DeliverWebhookPayload
+type DeliverWebhookResult = webhookservice.DeliverWebhookResult
+type MarkWebhookDeliveryFailedPayload = webhookservice.MarkWebhookDeliveryFailedPayload
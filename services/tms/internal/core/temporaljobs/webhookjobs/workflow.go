@@ -0,0 +1,93 @@
+package webhookjobs
+
+import (
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/pkg/temporaltype"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Automatic deliveries back off from 30 seconds to an hour, so ten attempts
+// ride out roughly five hours of receiver downtime before the delivery fails.
+var deliverActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    30 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumAttempts:    10,
+		MaximumInterval:    time.Hour,
+	},
+}
+
+// A manual redelivery is one attempt; whoever asked for it sees the result in
+// the attempt log and decides whether to try again.
+var redeliverActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		MaximumAttempts: 1,
+	},
+}
+
+var markFailedActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumAttempts:    5,
+		MaximumInterval:    time.Minute,
+	},
+}
+
+func RegisterWorkflows() []temporaltype.WorkflowDefinition {
+	return []temporaltype.WorkflowDefinition{
+		{
+			Name:        temporaltype.DeliverWebhookWorkflowName,
+			Fn:          DeliverWebhookWorkflow,
+			TaskQueue:   temporaltype.WebhookTaskQueue,
+			Description: "Deliver a domain event to a customer webhook subscription",
+		},
+	}
+}
+
+func DeliverWebhookWorkflow(
+	ctx workflow.Context,
+	payload *DeliverWebhookPayload,
+) (*DeliverWebhookResult, error) {
+	options := deliverActivityOptions
+	if payload.Trigger == webhook.AttemptTriggerManual {
+		options = redeliverActivityOptions
+	}
+	activityCtx := workflow.WithActivityOptions(ctx, options)
+
+	var a *Activities
+	result := new(DeliverWebhookResult)
+	err := workflow.ExecuteActivity(
+		activityCtx,
+		a.DeliverWebhookActivity,
+		payload,
+	).Get(activityCtx, result)
+	if err == nil {
+		return result, nil
+	}
+
+	workflow.GetLogger(ctx).Warn("webhook delivery exhausted retries", "error", err)
+	failedCtx := workflow.WithActivityOptions(ctx, markFailedActivityOptions)
+	if markErr := workflow.ExecuteActivity(
+		failedCtx,
+		a.MarkWebhookDeliveryFailedActivity,
+		&MarkWebhookDeliveryFailedPayload{
+			DeliveryID: payload.DeliveryID,
+			TenantInfo: payload.TenantInfo,
+			Trigger:    payload.Trigger,
+			Reason:     err.Error(),
+		},
+	).Get(failedCtx, nil); markErr != nil {
+		workflow.GetLogger(ctx).Error(
+			"failed to mark webhook delivery failed",
+			"error", markErr,
+		)
+	}
+	return nil, err
+}
@@ -0,0 +1,100 @@
+package webhookjobs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func newDeliverWebhookTestEnv(t *testing.T) *testsuite.TestWorkflowEnvironment {
+	t.Helper()
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(DeliverWebhookWorkflow)
+	return env
+}
+
+func deliverWebhookPayload(trigger webhook.AttemptTrigger) *DeliverWebhookPayload {
+	return &DeliverWebhookPayload{
+		DeliveryID: pulid.MustNew("whd_"),
+		TenantInfo: pagination.TenantInfo{
+			OrgID: pulid.MustNew("org_"),
+			BuID:  pulid.MustNew("bu_"),
+		},
+		Trigger: trigger,
+	}
+}
+
+func TestDeliverWebhookWorkflow_Success(t *testing.T) {
+	env := newDeliverWebhookTestEnv(t)
+	payload := deliverWebhookPayload(webhook.AttemptTriggerAutomatic)
+	var a *Activities
+
+	env.OnActivity(a.DeliverWebhookActivity, mock.Anything, mock.Anything).
+		Return(&DeliverWebhookResult{
+			DeliveryID: payload.DeliveryID,
+			Status:     webhook.DeliveryStatusSucceeded,
+			StatusCode: 204,
+		}, nil).
+		Once()
+
+	env.ExecuteWorkflow(DeliverWebhookWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	result := new(DeliverWebhookResult)
+	require.NoError(t, env.GetWorkflowResult(result))
+	require.Equal(t, webhook.DeliveryStatusSucceeded, result.Status)
+	env.AssertNotCalled(t, "MarkWebhookDeliveryFailedActivity")
+}
+
+func TestDeliverWebhookWorkflow_AutomaticRetriesThenMarksFailed(t *testing.T) {
+	env := newDeliverWebhookTestEnv(t)
+	payload := deliverWebhookPayload(webhook.AttemptTriggerAutomatic)
+	var a *Activities
+
+	env.OnActivity(a.DeliverWebhookActivity, mock.Anything, mock.Anything).
+		Return(nil, errors.New("webhook endpoint responded with status 503")).
+		Times(10)
+	env.OnActivity(
+		a.MarkWebhookDeliveryFailedActivity,
+		mock.Anything,
+		mock.MatchedBy(func(p *MarkWebhookDeliveryFailedPayload) bool {
+			return p.DeliveryID == payload.DeliveryID &&
+				p.Trigger == webhook.AttemptTriggerAutomatic
+		}),
+	).
+		Return(nil).
+		Once()
+
+	env.ExecuteWorkflow(DeliverWebhookWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestDeliverWebhookWorkflow_ManualMakesOneAttempt(t *testing.T) {
+	env := newDeliverWebhookTestEnv(t)
+	payload := deliverWebhookPayload(webhook.AttemptTriggerManual)
+	var a *Activities
+
+	env.OnActivity(a.DeliverWebhookActivity, mock.Anything, mock.Anything).
+		Return(nil, errors.New("webhook endpoint responded with status 500")).
+		Once()
+	env.OnActivity(a.MarkWebhookDeliveryFailedActivity, mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	env.ExecuteWorkflow(DeliverWebhookWorkflow, payload)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
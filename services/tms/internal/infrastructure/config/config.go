@@ -40,7 +40,44 @@ type TwilioConfig struct {
 }
 
 type FoonyConfig struct {
-	APIKey string `mapstructure:"apiKey" validate:"required"`
+	APIKey string `mapstructure:"apiKey"`
+}
+
+const (
+	RealtimeProviderFoony   = "foony"
+	RealtimeProviderBuiltin = "builtin"
+)
+
+type RealtimeConfig struct {
+	Provider       string        `mapstructure:"provider"       validate:"omitempty,oneof=foony builtin"`
+	TokenTTL       time.Duration `mapstructure:"tokenTTL"`
+	PresenceTTL    time.Duration `mapstructure:"presenceTTL"`
+	MaxConnections int           `mapstructure:"maxConnections" validate:"min=0"`
+}
+
+func (c *RealtimeConfig) GetProvider() string {
+	if c.Provider == "" {
+		return RealtimeProviderFoony
+	}
+	return c.Provider
+}
+
+func (c *RealtimeConfig) IsBuiltin() bool {
+	return c.GetProvider() == RealtimeProviderBuiltin
+}
+
+func (c *RealtimeConfig) GetTokenTTL() time.Duration {
+	if c.TokenTTL <= 0 {
+		return time.Hour
+	}
+	return c.TokenTTL
+}
+
+func (c *RealtimeConfig) GetPresenceTTL() time.Duration {
+	if c.PresenceTTL <= 0 {
+		return 60 * time.Second
+	}
+	return c.PresenceTTL
 }
 
 type MetricsConfig struct {
@@ -1204,7 +1241,8 @@ type Config struct {
 	Temporal             TemporalConfig             `mapstructure:"temporal"             validate:"required"`
 	Storage              StorageConfig              `mapstructure:"storage"              validate:"required"`
 	System               SystemConfig               `mapstructure:"system"               validate:"required"`
-	Foony                FoonyConfig                `mapstructure:"foony"                validate:"required"`
+	Foony                FoonyConfig                `mapstructure:"foony"`
+	Realtime             RealtimeConfig             `mapstructure:"realtime"`
 	Search               SearchConfig               `mapstructure:"search"`
 	DocumentIntelligence DocumentIntelligenceConfig `mapstructure:"documentIntelligence"`
 	Audit                AuditConfig                `mapstructure:"audit"`
@@ -1262,6 +1300,8 @@ func (c *Config) GetTwilioConfig() *TwilioConfig { return &c.Twilio }
 
 func (c *Config) GetFoonyConfig() *FoonyConfig { return &c.Foony }
 
+func (c *Config) GetRealtimeConfig() *RealtimeConfig { return &c.Realtime }
+
 func (c *Config) GetSystemConfig() *SystemConfig { return &c.System }
 
 func (c *Config) GetPlatformConfig() *PlatformConfig { return &c.Platform }
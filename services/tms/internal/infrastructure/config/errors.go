@@ -54,4 +54,7 @@ var (
 	ErrRequestTimeoutExceedsWriteTimeout = errors.New(
 		"server request timeout must be shorter than server write timeout",
 	)
+	ErrFoonyAPIKeyIsRequired = errors.New(
+		"foony.apiKey is required when realtime.provider is foony",
+	)
 )
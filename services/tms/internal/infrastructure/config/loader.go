@@ -264,6 +264,12 @@ func (l *Loader) setDefaults() { //nolint:funlen // sets default configs
 	// Storage defaults
 	l.viper.SetDefault("storage.provider", StorageProviderMinio)
 	l.viper.SetDefault("storage.autoCreateBucket", true)
+
+	// Realtime defaults
+	l.viper.SetDefault("realtime.provider", RealtimeProviderFoony)
+	l.viper.SetDefault("realtime.tokenTTL", "1h")
+	l.viper.SetDefault("realtime.presenceTTL", "60s")
+	l.viper.SetDefault("realtime.maxConnections", 0)
 }
 
 func (l *Loader) bindControlPlaneEnvAliases() {
@@ -328,6 +334,7 @@ func (l *Loader) validateConfig(config *Config) error {
 		validateLoggingConfig,
 		validatePlatformConfig,
 		validateR2PublicEndpoint,
+		validateRealtimeConfig,
 	}
 	for _, validator := range validators {
 		if err := validator(config); err != nil {
@@ -442,6 +449,14 @@ func validateR2PublicEndpoint(config *Config) error {
 	return nil
 }
 
+func validateRealtimeConfig(config *Config) error {
+	if config.Realtime.GetProvider() == RealtimeProviderFoony && config.Foony.APIKey == "" {
+		return ErrFoonyAPIKeyIsRequired
+	}
+
+	return nil
+}
+
 func validateProductionSecurity(config *Config) error {
 	if strings.EqualFold(config.Database.SSLMode, "disable") {
 		return ErrProductionDatabaseSSLRequired
@@ -318,6 +318,32 @@ func TestValidateConfig(t *testing.T) {
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrLoggingOutputIsFileButFileConfigIsMissing)
 	})
+
+	t.Run("foony provider requires an api key", func(t *testing.T) {
+		t.Parallel()
+
+		l := NewLoader()
+		cfg := newValidConfig()
+		cfg.Foony.APIKey = ""
+
+		err := l.validateConfig(cfg)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrFoonyAPIKeyIsRequired)
+	})
+
+	t.Run("builtin realtime provider needs no foony key", func(t *testing.T) {
+		t.Parallel()
+
+		l := NewLoader()
+		cfg := newValidConfig()
+		cfg.Foony.APIKey = ""
+		cfg.Realtime.Provider = RealtimeProviderBuiltin
+
+		err := l.validateConfig(cfg)
+
+		require.NoError(t, err)
+	})
 }
 
 func TestApplyEnvironmentOverrides(t *testing.T) {
@@ -15,6 +15,10 @@ type Params struct {
 }
 
 func New(p Params) *realtime.Rest {
+	if p.Config.GetRealtimeConfig().IsBuiltin() {
+		return nil
+	}
+
 	cfg := p.Config.GetFoonyConfig()
 	log := p.Logger.Named("infrastructure.foony")
 
@@ -0,0 +1,12 @@
+-- Enum values cannot be added inside a migration transaction, so the EDIFACT
+-- catalog seed lives in the following .tx migration.
+ALTER TYPE "edi_standard_enum" ADD VALUE IF NOT EXISTS 'EDIFACT';
+
+--bun:split
+ALTER TYPE "edi_transaction_set_enum" ADD VALUE IF NOT EXISTS 'IFTMIN';
+
+--bun:split
+ALTER TYPE "edi_transaction_set_enum" ADD VALUE IF NOT EXISTS 'IFTSTA';
+
+--bun:split
+ALTER TYPE "edi_transaction_set_enum" ADD VALUE IF NOT EXISTS 'INVOIC';
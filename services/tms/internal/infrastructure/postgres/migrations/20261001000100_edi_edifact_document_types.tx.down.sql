@@ -0,0 +1,6 @@
+DELETE FROM "edi_document_types"
+WHERE "standard" = 'EDIFACT';
+
+--bun:split
+DELETE FROM "edi_transaction_sets"
+WHERE "standard" = 'EDIFACT';
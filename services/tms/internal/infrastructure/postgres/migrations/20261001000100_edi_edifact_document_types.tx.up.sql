@@ -0,0 +1,17 @@
+INSERT INTO "edi_transaction_sets"("id", "standard", "code", "name", "description", "default_version", "status")
+    VALUES
+    ('edits_edifact_iftmin', 'EDIFACT', 'IFTMIN', 'Instruction Message', 'UN/EDIFACT transport instruction (load tender).', 'D96A', 'Active'),
+    ('edits_edifact_iftsta', 'EDIFACT', 'IFTSTA', 'International Multimodal Status Report', 'UN/EDIFACT shipment status report.', 'D96A', 'Active'),
+    ('edits_edifact_invoic', 'EDIFACT', 'INVOIC', 'Invoice Message', 'UN/EDIFACT freight invoice.', 'D96A', 'Active')
+ON CONFLICT ("standard", "code") DO NOTHING;
+
+--bun:split
+INSERT INTO "edi_document_types"("id", "code", "name", "standard", "transaction_set", "transaction_set_id", "direction", "default_version", "status")
+    VALUES
+    ('edidt_edifact_iftmin_outbound', 'EDIFACT-IFTMIN-OUT', 'EDIFACT IFTMIN Instruction', 'EDIFACT', 'IFTMIN', 'edits_edifact_iftmin', 'Outbound', 'D96A', 'Active'),
+    ('edidt_edifact_iftmin_inbound', 'EDIFACT-IFTMIN-IN', 'EDIFACT IFTMIN Instruction', 'EDIFACT', 'IFTMIN', 'edits_edifact_iftmin', 'Inbound', 'D96A', 'Active'),
+    ('edidt_edifact_iftsta_outbound', 'EDIFACT-IFTSTA-OUT', 'EDIFACT IFTSTA Status Report', 'EDIFACT', 'IFTSTA', 'edits_edifact_iftsta', 'Outbound', 'D96A', 'Active'),
+    ('edidt_edifact_iftsta_inbound', 'EDIFACT-IFTSTA-IN', 'EDIFACT IFTSTA Status Report', 'EDIFACT', 'IFTSTA', 'edits_edifact_iftsta', 'Inbound', 'D96A', 'Active'),
+    ('edidt_edifact_invoic_outbound', 'EDIFACT-INVOIC-OUT', 'EDIFACT INVOIC Invoice', 'EDIFACT', 'INVOIC', 'edits_edifact_invoic', 'Outbound', 'D96A', 'Active'),
+    ('edidt_edifact_invoic_inbound', 'EDIFACT-INVOIC-IN', 'EDIFACT INVOIC Invoice', 'EDIFACT', 'INVOIC', 'edits_edifact_invoic', 'Inbound', 'D96A', 'Active')
+ON CONFLICT ("code") DO NOTHING;
@@ -0,0 +1,3 @@
+-- Enum values cannot be added inside a migration transaction, so the 820
+-- catalog seed lives in the following .tx migration.
+ALTER TYPE "edi_transaction_set_enum" ADD VALUE IF NOT EXISTS '820';
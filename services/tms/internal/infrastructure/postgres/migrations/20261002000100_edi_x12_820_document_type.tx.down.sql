@@ -0,0 +1,6 @@
+DELETE FROM "edi_document_types"
+WHERE "id" = 'edidt_x12_820_inbound';
+
+--bun:split
+DELETE FROM "edi_transaction_sets"
+WHERE "id" = 'edits_x12_820';
@@ -0,0 +1,8 @@
+INSERT INTO "edi_transaction_sets"("id", "standard", "code", "name", "description", "default_version", "status")
+    VALUES ('edits_x12_820', 'X12', '820', 'Payment Order/Remittance Advice', 'Customer remittance advice describing the invoices a payment covers.', '004010', 'Active')
+ON CONFLICT ("standard", "code") DO NOTHING;
+
+--bun:split
+INSERT INTO "edi_document_types"("id", "code", "name", "standard", "transaction_set", "transaction_set_id", "direction", "default_version", "status")
+    VALUES ('edidt_x12_820_inbound', 'X12-820-IN', 'X12 820 Remittance Advice', 'X12', '820', 'edits_x12_820', 'Inbound', '004010', 'Active')
+ON CONFLICT ("code") DO NOTHING;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "edi_implementation_guides";
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS "edi_implementation_guides"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "edi_partner_id" varchar(100),
+    "name" varchar(200) NOT NULL,
+    "description" text,
+    "direction" edi_document_direction_enum NOT NULL,
+    "transaction_set" edi_transaction_set_enum NOT NULL,
+    "x12_version" varchar(20) NOT NULL,
+    "guide_revision" varchar(50) NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT FALSE,
+    "definition" jsonb NOT NULL DEFAULT '{}'::jsonb,
+    "version" bigint NOT NULL DEFAULT 0,
+    "created_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    "updated_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    CONSTRAINT "pk_edi_implementation_guides" PRIMARY KEY ("id", "business_unit_id", "organization_id"),
+    CONSTRAINT "fk_edi_implementation_guides_partner" FOREIGN KEY ("edi_partner_id", "business_unit_id", "organization_id") REFERENCES "edi_partners"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_edi_implementation_guides_active"
+    ON "edi_implementation_guides"("organization_id", "business_unit_id", COALESCE("edi_partner_id", ''), "transaction_set", "x12_version", "direction")
+    WHERE "is_active";
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_edi_implementation_guides_partner"
+    ON "edi_implementation_guides"("edi_partner_id", "business_unit_id", "organization_id");
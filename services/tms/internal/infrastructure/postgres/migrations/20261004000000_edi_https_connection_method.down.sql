@@ -0,0 +1,2 @@
+-- Removing an enum value is not supported by PostgreSQL; the HTTPS value stays behind.
+SELECT 1;
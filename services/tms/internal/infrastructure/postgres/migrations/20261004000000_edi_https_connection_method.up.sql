@@ -0,0 +1 @@
+ALTER TYPE "edi_connection_method_enum" ADD VALUE IF NOT EXISTS 'HTTPS';
@@ -0,0 +1,2 @@
+-- Removing an enum value is not supported by PostgreSQL; the FTPS and S3 values stay behind.
+SELECT 1;
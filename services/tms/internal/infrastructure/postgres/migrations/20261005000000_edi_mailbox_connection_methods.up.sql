@@ -0,0 +1,2 @@
+ALTER TYPE "edi_connection_method_enum" ADD VALUE IF NOT EXISTS 'FTPS';
+ALTER TYPE "edi_connection_method_enum" ADD VALUE IF NOT EXISTS 'S3';
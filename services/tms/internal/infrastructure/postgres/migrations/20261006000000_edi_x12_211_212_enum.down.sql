@@ -0,0 +1,2 @@
+-- Removing an enum value is not supported by PostgreSQL; the 211 and 212 values stay behind.
+SELECT 1;
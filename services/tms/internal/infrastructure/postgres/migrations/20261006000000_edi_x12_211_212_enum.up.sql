@@ -0,0 +1,6 @@
+-- Enum values cannot be added inside a migration transaction, so the 211 and
+-- 212 catalog seed lives in the following .tx migration.
+ALTER TYPE "edi_transaction_set_enum" ADD VALUE IF NOT EXISTS '211';
+
+--bun:split
+ALTER TYPE "edi_transaction_set_enum" ADD VALUE IF NOT EXISTS '212';
@@ -0,0 +1,6 @@
+DELETE FROM "edi_document_types"
+WHERE "id" IN ('edidt_x12_211_outbound', 'edidt_x12_212_outbound');
+
+--bun:split
+DELETE FROM "edi_transaction_sets"
+WHERE "id" IN ('edits_x12_211', 'edits_x12_212');
@@ -0,0 +1,12 @@
+INSERT INTO "edi_transaction_sets"("id", "standard", "code", "name", "description", "default_version", "status")
+    VALUES
+    ('edits_x12_211', 'X12', '211', 'Motor Carrier Bill of Lading', 'Bill of lading tendered to a motor carrier, including hazardous material detail.', '004010', 'Active'),
+    ('edits_x12_212', 'X12', '212', 'Motor Carrier Delivery Trailer Manifest', 'Manifest of the deliveries carried on one trip.', '004010', 'Active')
+ON CONFLICT ("standard", "code") DO NOTHING;
+
+--bun:split
+INSERT INTO "edi_document_types"("id", "code", "name", "standard", "transaction_set", "transaction_set_id", "direction", "default_version", "status")
+    VALUES
+    ('edidt_x12_211_outbound', 'X12-211-OUT', 'X12 211 Motor Carrier Bill of Lading', 'X12', '211', 'edits_x12_211', 'Outbound', '004010', 'Active'),
+    ('edidt_x12_212_outbound', 'X12-212-OUT', 'X12 212 Delivery Trailer Manifest', 'X12', '212', 'edits_x12_212', 'Outbound', '004010', 'Active')
+ON CONFLICT ("code") DO NOTHING;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_edi_certification_runs_connection";
+DROP INDEX IF EXISTS "idx_edi_certification_runs_partner";
+DROP TABLE IF EXISTS "edi_certification_runs";
+
+DROP TYPE IF EXISTS edi_certification_run_status_enum;
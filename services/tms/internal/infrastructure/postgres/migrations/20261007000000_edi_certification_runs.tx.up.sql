@@ -0,0 +1,41 @@
+CREATE TYPE edi_certification_run_status_enum AS ENUM(
+    'Running',
+    'Passed',
+    'Failed'
+);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "edi_certification_runs"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "edi_partner_id" varchar(100) NOT NULL,
+    "communication_profile_id" varchar(100) NOT NULL,
+    "edi_connection_id" varchar(100),
+    "shipment_id" varchar(100) NOT NULL,
+    "invoice_id" varchar(100) NOT NULL,
+    "status" edi_certification_run_status_enum NOT NULL DEFAULT 'Running',
+    "steps" jsonb NOT NULL DEFAULT '[]'::jsonb,
+    "response_timeout_seconds" bigint NOT NULL,
+    "expected_status_count" bigint NOT NULL DEFAULT 1,
+    "failure_reason" text,
+    "started_by_id" varchar(100),
+    "started_at" bigint NOT NULL,
+    "completed_at" bigint,
+    "version" bigint NOT NULL DEFAULT 0,
+    "created_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    "updated_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    CONSTRAINT "pk_edi_certification_runs" PRIMARY KEY ("id", "business_unit_id", "organization_id"),
+    CONSTRAINT "fk_edi_certification_runs_partner" FOREIGN KEY ("edi_partner_id", "business_unit_id", "organization_id") REFERENCES "edi_partners"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_edi_certification_runs_profile" FOREIGN KEY ("communication_profile_id", "business_unit_id", "organization_id") REFERENCES "edi_communication_profiles"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_edi_certification_runs_connection" FOREIGN KEY ("edi_connection_id") REFERENCES "edi_connections"("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_edi_certification_runs_partner"
+    ON "edi_certification_runs"("edi_partner_id", "business_unit_id", "organization_id", "created_at" DESC);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_edi_certification_runs_connection"
+    ON "edi_certification_runs"("edi_connection_id", "business_unit_id", "created_at" DESC)
+    WHERE "edi_connection_id" IS NOT NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS "idx_edi_control_number_ledger_transaction_claim";
+DROP INDEX IF EXISTS "idx_edi_control_number_ledger_content";
+DROP INDEX IF EXISTS "idx_edi_control_number_ledger_partner";
+DROP INDEX IF EXISTS "idx_edi_control_number_ledger_sequence";
+DROP TABLE IF EXISTS "edi_control_number_ledger_entries";
+
+DROP TYPE IF EXISTS edi_control_number_outcome_enum;
+DROP TYPE IF EXISTS edi_control_number_anomaly_enum;
@@ -0,0 +1,63 @@
+CREATE TYPE edi_control_number_anomaly_enum AS ENUM(
+    'None',
+    'Gap',
+    'Duplicate',
+    'OutOfOrder'
+);
+
+--bun:split
+CREATE TYPE edi_control_number_outcome_enum AS ENUM(
+    'Received',
+    'Processed',
+    'Failed'
+);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "edi_control_number_ledger_entries"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "edi_partner_id" varchar(100) NOT NULL,
+    "direction" edi_document_direction_enum NOT NULL,
+    "kind" edi_control_number_kind_enum NOT NULL,
+    "sequence_scope" varchar(255) NOT NULL DEFAULT '',
+    "control_number" varchar(20) NOT NULL,
+    "sequence" bigint NOT NULL DEFAULT 0,
+    "transaction_set" edi_transaction_set_enum,
+    "inbound_file_id" varchar(100),
+    "message_id" varchar(100),
+    "content_hash" varchar(64),
+    "anomaly" edi_control_number_anomaly_enum NOT NULL DEFAULT 'None',
+    "expected_control_number" varchar(20),
+    "duplicate_of_id" varchar(100),
+    "rejected" boolean NOT NULL DEFAULT FALSE,
+    "outcome" edi_control_number_outcome_enum NOT NULL DEFAULT 'Processed',
+    "recorded_at" bigint NOT NULL,
+    "created_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    CONSTRAINT "pk_edi_control_number_ledger_entries" PRIMARY KEY ("id", "business_unit_id", "organization_id"),
+    CONSTRAINT "fk_edi_control_number_ledger_entries_partner" FOREIGN KEY ("edi_partner_id", "business_unit_id", "organization_id") REFERENCES "edi_partners"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_edi_control_number_ledger_sequence"
+    ON "edi_control_number_ledger_entries"("edi_partner_id", "business_unit_id", "organization_id", "direction", "kind", "sequence_scope", "control_number");
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_edi_control_number_ledger_partner"
+    ON "edi_control_number_ledger_entries"("edi_partner_id", "business_unit_id", "organization_id", "recorded_at" DESC);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_edi_control_number_ledger_content"
+    ON "edi_control_number_ledger_entries"("edi_partner_id", "business_unit_id", "organization_id", "content_hash")
+    WHERE "content_hash" IS NOT NULL;
+
+--bun:split
+-- One live claim per transaction content: a resend inserts against this index
+-- and loses, while a failed original frees its content for the next attempt.
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_edi_control_number_ledger_transaction_claim"
+    ON "edi_control_number_ledger_entries"("organization_id", "business_unit_id", "edi_partner_id", "transaction_set", "content_hash")
+    WHERE "direction" = 'Inbound'
+        AND "kind" = 'Transaction'
+        AND "content_hash" IS NOT NULL
+        AND NOT "rejected"
+        AND "outcome" <> 'Failed';
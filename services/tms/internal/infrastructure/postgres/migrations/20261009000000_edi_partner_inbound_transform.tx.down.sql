@@ -0,0 +1,2 @@
+ALTER TABLE "edi_partners"
+    DROP COLUMN IF EXISTS "inbound_transform_script";
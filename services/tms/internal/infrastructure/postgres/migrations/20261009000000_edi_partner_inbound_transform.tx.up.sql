@@ -0,0 +1,2 @@
+ALTER TABLE "edi_partners"
+    ADD COLUMN IF NOT EXISTS "inbound_transform_script" TEXT;
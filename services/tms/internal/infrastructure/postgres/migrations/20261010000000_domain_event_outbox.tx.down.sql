@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS shipments_outbox_status_changed_trigger ON shipments;
+
+--bun:split
+DROP FUNCTION IF EXISTS shipments_outbox_status_changed();
+
+--bun:split
+DROP INDEX IF EXISTS "idx_domain_event_outbox_aggregate";
+
+--bun:split
+DROP INDEX IF EXISTS "idx_domain_event_outbox_occurred";
+
+--bun:split
+DROP INDEX IF EXISTS "idx_domain_event_outbox_idempotency_key";
+
+--bun:split
+DROP TABLE IF EXISTS "domain_event_outbox";
@@ -0,0 +1,84 @@
+CREATE TABLE IF NOT EXISTS "domain_event_outbox"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "event_type" varchar(100) NOT NULL,
+    "event_version" integer NOT NULL DEFAULT 1 CHECK ("event_version" > 0),
+    "aggregate_type" varchar(100) NOT NULL,
+    "aggregate_id" varchar(100) NOT NULL,
+    "idempotency_key" varchar(255) NOT NULL,
+    "payload" jsonb NOT NULL DEFAULT '{}'::jsonb,
+    "occurred_at" bigint NOT NULL,
+    "created_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    CONSTRAINT "pk_domain_event_outbox" PRIMARY KEY ("id", "business_unit_id", "organization_id")
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_domain_event_outbox_idempotency_key"
+    ON "domain_event_outbox"("idempotency_key");
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_domain_event_outbox_occurred"
+    ON "domain_event_outbox"("occurred_at", "id");
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_domain_event_outbox_aggregate"
+    ON "domain_event_outbox"("aggregate_id", "occurred_at");
+
+--bun:split
+-- Shipment status moves through dozens of write paths, including bulk delay
+-- and auto-cancel updates, so the event is written by a trigger rather than
+-- by each caller. The payload must match domainevent.ShipmentStatusChangedV1.
+-- Bulk writes change a row without bumping its version, so the idempotency key
+-- uses the writing transaction's id: it differs on every write, and a row
+-- changed twice to the same value in one transaction still yields one event.
+CREATE OR REPLACE FUNCTION shipments_outbox_status_changed()
+    RETURNS TRIGGER
+    AS $$
+DECLARE
+    occurred bigint := extract(epoch FROM current_timestamp)::bigint;
+BEGIN
+    INSERT INTO "domain_event_outbox"(
+        "id",
+        "business_unit_id",
+        "organization_id",
+        "event_type",
+        "event_version",
+        "aggregate_type",
+        "aggregate_id",
+        "idempotency_key",
+        "payload",
+        "occurred_at")
+    VALUES (
+        'devt_' || upper(substr(replace(gen_random_uuid()::text, '-', ''), 1, 26)),
+        NEW.business_unit_id,
+        NEW.organization_id,
+        'shipment.status_changed',
+        1,
+        'shipment',
+        NEW.id,
+        concat_ws(':', 'shipment.status_changed', NEW.id, txid_current(), OLD.status, NEW.status),
+        jsonb_build_object(
+            'shipmentId', NEW.id,
+            'proNumber', NEW.pro_number,
+            'customerId', NEW.customer_id,
+            'previousStatus', OLD.status,
+            'status', NEW.status,
+            'version', NEW.version,
+            'changedAt', occurred),
+        occurred)
+    ON CONFLICT ("idempotency_key") DO NOTHING;
+    RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+
+--bun:split
+DROP TRIGGER IF EXISTS shipments_outbox_status_changed_trigger ON shipments;
+
+--bun:split
+CREATE TRIGGER shipments_outbox_status_changed_trigger
+    AFTER UPDATE OF status ON shipments
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status)
+    EXECUTE FUNCTION shipments_outbox_status_changed();
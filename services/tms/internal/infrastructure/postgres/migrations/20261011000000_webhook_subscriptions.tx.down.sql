@@ -0,0 +1,25 @@
+DROP TRIGGER IF EXISTS documents_outbox_pod_available_trigger ON documents;
+
+--bun:split
+DROP FUNCTION IF EXISTS documents_outbox_pod_available();
+
+--bun:split
+DROP TRIGGER IF EXISTS stops_outbox_eta_changed_trigger ON stops;
+
+--bun:split
+DROP FUNCTION IF EXISTS stops_outbox_eta_changed();
+
+--bun:split
+DROP TABLE IF EXISTS "webhook_delivery_attempts";
+
+--bun:split
+DROP TABLE IF EXISTS "webhook_deliveries";
+
+--bun:split
+DROP TABLE IF EXISTS "webhook_subscriptions";
+
+--bun:split
+DROP TYPE IF EXISTS "webhook_delivery_status_enum";
+
+--bun:split
+DROP TYPE IF EXISTS "webhook_subscription_status_enum";
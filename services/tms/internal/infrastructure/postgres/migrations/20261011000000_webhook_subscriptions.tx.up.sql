@@ -0,0 +1,285 @@
+CREATE TYPE "webhook_subscription_status_enum" AS ENUM(
+    'Active',
+    'Disabled'
+);
+
+--bun:split
+CREATE TYPE "webhook_delivery_status_enum" AS ENUM(
+    'Pending',
+    'Succeeded',
+    'Failed'
+);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "webhook_subscriptions"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "name" varchar(255) NOT NULL,
+    "description" text,
+    "url" varchar(2048) NOT NULL,
+    "customer_id" varchar(100),
+    "api_key_id" varchar(100),
+    "event_types" jsonb NOT NULL DEFAULT '[]'::jsonb,
+    "status" webhook_subscription_status_enum NOT NULL DEFAULT 'Active',
+    "signing_secret" text NOT NULL,
+    "secret_hint" varchar(32) NOT NULL,
+    "previous_signing_secret" text,
+    "previous_secret_expires_at" bigint,
+    "secret_rotated_at" bigint,
+    "consecutive_failures" integer NOT NULL DEFAULT 0 CHECK ("consecutive_failures" >= 0),
+    "disabled_at" bigint,
+    "disabled_reason" text,
+    "last_delivered_at" bigint,
+    "created_by_id" varchar(100),
+    "version" bigint NOT NULL DEFAULT 0,
+    "created_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    "updated_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    CONSTRAINT "pk_webhook_subscriptions" PRIMARY KEY ("id", "business_unit_id", "organization_id"),
+    CONSTRAINT "fk_webhook_subscriptions_business_unit" FOREIGN KEY ("business_unit_id") REFERENCES "business_units"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_webhook_subscriptions_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_webhook_subscriptions_customer" FOREIGN KEY ("customer_id", "organization_id", "business_unit_id") REFERENCES "customers"("id", "organization_id", "business_unit_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_webhook_subscriptions_api_key" FOREIGN KEY ("api_key_id") REFERENCES "api_keys"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_webhook_subscriptions_created_by" FOREIGN KEY ("created_by_id") REFERENCES "users"("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+    -- A subscription must be scoped: to a customer's shipments, to the
+    -- integrator's API key, or both.
+    CONSTRAINT "chk_webhook_subscriptions_scope" CHECK ("customer_id" IS NOT NULL OR "api_key_id" IS NOT NULL)
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_webhook_subscriptions_tenant_status"
+    ON "webhook_subscriptions"("organization_id", "business_unit_id", "status");
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_webhook_subscriptions_api_key"
+    ON "webhook_subscriptions"("api_key_id")
+    WHERE "api_key_id" IS NOT NULL;
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "webhook_deliveries"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "subscription_id" varchar(100) NOT NULL,
+    "event_id" varchar(100) NOT NULL,
+    "event_type" varchar(100) NOT NULL,
+    "event_version" integer NOT NULL DEFAULT 1,
+    "idempotency_key" varchar(255) NOT NULL,
+    "payload" jsonb NOT NULL DEFAULT '{}'::jsonb,
+    "occurred_at" bigint NOT NULL,
+    "status" webhook_delivery_status_enum NOT NULL DEFAULT 'Pending',
+    "attempt_count" integer NOT NULL DEFAULT 0 CHECK ("attempt_count" >= 0),
+    "last_status_code" integer,
+    "last_error" text,
+    "last_attempt_at" bigint,
+    "delivered_at" bigint,
+    "created_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    "updated_at" bigint NOT NULL DEFAULT extract(epoch FROM current_timestamp)::bigint,
+    CONSTRAINT "pk_webhook_deliveries" PRIMARY KEY ("id", "business_unit_id", "organization_id"),
+    CONSTRAINT "fk_webhook_deliveries_subscription" FOREIGN KEY ("subscription_id", "business_unit_id", "organization_id") REFERENCES "webhook_subscriptions"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+--bun:split
+-- The stream is at-least-once and replays publish again on purpose, so each
+-- subscription receives a given fact once no matter how often it is relayed.
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_webhook_deliveries_subscription_event"
+    ON "webhook_deliveries"("subscription_id", "idempotency_key");
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_subscription_created"
+    ON "webhook_deliveries"("subscription_id", "created_at" DESC);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "webhook_delivery_attempts"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "delivery_id" varchar(100) NOT NULL,
+    "subscription_id" varchar(100) NOT NULL,
+    "attempt_number" integer NOT NULL CHECK ("attempt_number" > 0),
+    "trigger" varchar(20) NOT NULL DEFAULT 'Automatic',
+    "status_code" integer,
+    "response_body" text,
+    "error" text,
+    "duration_ms" bigint NOT NULL DEFAULT 0,
+    "succeeded" boolean NOT NULL DEFAULT FALSE,
+    "attempted_at" bigint NOT NULL,
+    CONSTRAINT "pk_webhook_delivery_attempts" PRIMARY KEY ("id", "business_unit_id", "organization_id"),
+    CONSTRAINT "fk_webhook_delivery_attempts_delivery" FOREIGN KEY ("delivery_id", "business_unit_id", "organization_id") REFERENCES "webhook_deliveries"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "chk_webhook_delivery_attempts_trigger" CHECK ("trigger" IN ('Automatic', 'Manual'))
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_webhook_delivery_attempts_delivery"
+    ON "webhook_delivery_attempts"("delivery_id", "attempted_at");
+
+--bun:split
+-- An appointment moves through the stop editor, EDI tender changes, and
+-- rescheduling, so the ETA event is written by a trigger like the status
+-- event. The payload must match domainevent.ShipmentETAChangedV1.
+-- The idempotency key uses the writing transaction's id for the same reason.
+CREATE OR REPLACE FUNCTION stops_outbox_eta_changed()
+    RETURNS TRIGGER
+    AS $$
+DECLARE
+    occurred bigint := extract(epoch FROM current_timestamp)::bigint;
+    shp record;
+BEGIN
+    SELECT
+        s.id,
+        s.pro_number,
+        s.customer_id INTO shp
+    FROM
+        shipment_moves sm
+        JOIN shipments s ON s.id = sm.shipment_id
+            AND s.organization_id = sm.organization_id
+            AND s.business_unit_id = sm.business_unit_id
+    WHERE
+        sm.id = NEW.shipment_move_id
+        AND sm.organization_id = NEW.organization_id
+        AND sm.business_unit_id = NEW.business_unit_id;
+    IF NOT FOUND THEN
+        RETURN NEW;
+    END IF;
+    INSERT INTO "domain_event_outbox"(
+        "id",
+        "business_unit_id",
+        "organization_id",
+        "event_type",
+        "event_version",
+        "aggregate_type",
+        "aggregate_id",
+        "idempotency_key",
+        "payload",
+        "occurred_at")
+    VALUES (
+        'devt_' || upper(substr(replace(gen_random_uuid()::text, '-', ''), 1, 26)),
+        NEW.business_unit_id,
+        NEW.organization_id,
+        'shipment.eta_changed',
+        1,
+        'shipment',
+        shp.id,
+        concat_ws(':', 'shipment.eta_changed', NEW.id, txid_current(), NEW.scheduled_window_start, NEW.scheduled_window_end),
+        jsonb_build_object(
+            'shipmentId', shp.id,
+            'proNumber', shp.pro_number,
+            'customerId', shp.customer_id,
+            'shipmentMoveId', NEW.shipment_move_id,
+            'stopId', NEW.id,
+            'stopType', NEW.type,
+            'stopSequence', NEW.sequence,
+            'previousWindowStart', OLD.scheduled_window_start,
+            'previousWindowEnd', OLD.scheduled_window_end,
+            'windowStart', NEW.scheduled_window_start,
+            'windowEnd', NEW.scheduled_window_end,
+            'changedAt', occurred),
+        occurred)
+    ON CONFLICT ("idempotency_key") DO NOTHING;
+    RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+
+--bun:split
+DROP TRIGGER IF EXISTS stops_outbox_eta_changed_trigger ON stops;
+
+--bun:split
+CREATE TRIGGER stops_outbox_eta_changed_trigger
+    AFTER UPDATE OF scheduled_window_start, scheduled_window_end ON stops
+    FOR EACH ROW
+    WHEN ((OLD.scheduled_window_start IS DISTINCT FROM NEW.scheduled_window_start
+        OR OLD.scheduled_window_end IS DISTINCT FROM NEW.scheduled_window_end)
+        AND NEW.status NOT IN ('Completed', 'Canceled'))
+    EXECUTE FUNCTION stops_outbox_eta_changed();
+
+--bun:split
+-- A POD becomes available when an active document typed POD is attached to a
+-- shipment. Classification often assigns the type after upload, so the
+-- trigger also fires when the type or status changes later. The payload must
+-- match domainevent.ShipmentPODAvailableV1.
+CREATE OR REPLACE FUNCTION documents_outbox_pod_available()
+    RETURNS TRIGGER
+    AS $$
+DECLARE
+    occurred bigint := extract(epoch FROM current_timestamp)::bigint;
+    shp record;
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND OLD.document_type_id IS NOT DISTINCT FROM NEW.document_type_id
+        AND OLD.status = NEW.status THEN
+        RETURN NEW;
+    END IF;
+    IF NOT EXISTS (
+        SELECT
+            1
+        FROM
+            document_types dt
+        WHERE
+            dt.id = NEW.document_type_id
+            AND dt.organization_id = NEW.organization_id
+            AND dt.business_unit_id = NEW.business_unit_id
+            AND upper(dt.code) = 'POD') THEN
+        RETURN NEW;
+    END IF;
+    SELECT
+        s.id,
+        s.pro_number,
+        s.customer_id INTO shp
+    FROM
+        shipments s
+    WHERE
+        s.id = NEW.resource_id
+        AND s.organization_id = NEW.organization_id
+        AND s.business_unit_id = NEW.business_unit_id;
+    IF NOT FOUND THEN
+        RETURN NEW;
+    END IF;
+    INSERT INTO "domain_event_outbox"(
+        "id",
+        "business_unit_id",
+        "organization_id",
+        "event_type",
+        "event_version",
+        "aggregate_type",
+        "aggregate_id",
+        "idempotency_key",
+        "payload",
+        "occurred_at")
+    VALUES (
+        'devt_' || upper(substr(replace(gen_random_uuid()::text, '-', ''), 1, 26)),
+        NEW.business_unit_id,
+        NEW.organization_id,
+        'shipment.pod_available',
+        1,
+        'shipment',
+        shp.id,
+        concat_ws(':', 'shipment.pod_available', NEW.id),
+        jsonb_build_object(
+            'shipmentId', shp.id,
+            'proNumber', shp.pro_number,
+            'customerId', shp.customer_id,
+            'documentId', NEW.id,
+            'lineageId', NEW.lineage_id,
+            'documentTypeId', NEW.document_type_id,
+            'fileName', NEW.original_name,
+            'availableAt', occurred),
+        occurred)
+    ON CONFLICT ("idempotency_key") DO NOTHING;
+    RETURN NEW;
+END;
+$$
+LANGUAGE plpgsql;
+
+--bun:split
+DROP TRIGGER IF EXISTS documents_outbox_pod_available_trigger ON documents;
+
+--bun:split
+CREATE TRIGGER documents_outbox_pod_available_trigger
+    AFTER INSERT OR UPDATE OF document_type_id, status ON documents
+    FOR EACH ROW
+    WHEN (NEW.resource_type = 'shipment'
+        AND NEW.status = 'Active'
+        AND NEW.is_current_version
+        AND NEW.document_type_id IS NOT NULL)
+    EXECUTE FUNCTION documents_outbox_pod_available();
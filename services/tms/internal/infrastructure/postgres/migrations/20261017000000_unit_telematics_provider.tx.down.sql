@@ -0,0 +1,10 @@
+ALTER TABLE "workers"
+    DROP COLUMN IF EXISTS "telematics_provider";
+
+--bun:split
+ALTER TABLE "trailers"
+    DROP COLUMN IF EXISTS "telematics_provider";
+
+--bun:split
+ALTER TABLE "tractors"
+    DROP COLUMN IF EXISTS "telematics_provider";
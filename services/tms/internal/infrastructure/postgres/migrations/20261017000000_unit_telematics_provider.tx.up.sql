@@ -0,0 +1,13 @@
+-- A unit's telematics provider pins it to one of the tenant's telematics
+-- integrations. NULL means the tenant's primary provider, which is how every
+-- unit behaved before mixed fleets.
+ALTER TABLE "tractors"
+    ADD COLUMN IF NOT EXISTS "telematics_provider" VARCHAR(50);
+
+--bun:split
+ALTER TABLE "trailers"
+    ADD COLUMN IF NOT EXISTS "telematics_provider" VARCHAR(50);
+
+--bun:split
+ALTER TABLE "workers"
+    ADD COLUMN IF NOT EXISTS "telematics_provider" VARCHAR(50);
@@ -0,0 +1,14 @@
+ALTER TABLE "dispatch_controls"
+    DROP COLUMN IF EXISTS "horizon_max_days_out";
+
+--bun:split
+ALTER TABLE "workers"
+    DROP CONSTRAINT IF EXISTS "fk_workers_home_location";
+
+--bun:split
+ALTER TABLE "workers"
+    DROP COLUMN IF EXISTS "home_time_max_days_out";
+
+--bun:split
+ALTER TABLE "workers"
+    DROP COLUMN IF EXISTS "home_location_id";
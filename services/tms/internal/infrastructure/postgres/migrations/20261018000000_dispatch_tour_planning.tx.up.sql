@@ -0,0 +1,23 @@
+ALTER TABLE "workers"
+    ADD COLUMN IF NOT EXISTS "home_location_id" VARCHAR(100);
+
+--bun:split
+ALTER TABLE "workers"
+    ADD CONSTRAINT "fk_workers_home_location" FOREIGN KEY ("home_location_id", "business_unit_id", "organization_id") REFERENCES "locations"("id", "business_unit_id", "organization_id") ON UPDATE NO ACTION ON DELETE SET NULL ("home_location_id");
+
+--bun:split
+COMMENT ON COLUMN "workers"."home_location_id" IS 'Where the driver goes home to; horizon planning measures the return leg of a tour to this location';
+
+--bun:split
+ALTER TABLE "workers"
+    ADD COLUMN IF NOT EXISTS "home_time_max_days_out" SMALLINT;
+
+--bun:split
+COMMENT ON COLUMN "workers"."home_time_max_days_out" IS 'Home-time window this driver must be back home within, in days from the tour start; overrides the dispatch control default, NULL uses it';
+
+--bun:split
+ALTER TABLE "dispatch_controls"
+    ADD COLUMN IF NOT EXISTS "horizon_max_days_out" SMALLINT;
+
+--bun:split
+COMMENT ON COLUMN "dispatch_controls"."horizon_max_days_out" IS 'Default home-time window for horizon tours; a tour must return a driver without their own window to their home location within this many days of the tour start, NULL disables the requirement, ignored when planning_mode is Immediate';
@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS "ifta_jurisdiction_boundaries";
+
+--bun:split
+DROP TABLE IF EXISTS "ifta_tax_rates";
+
+--bun:split
+DROP TABLE IF EXISTS "ifta_fuel_purchases";
+
+--bun:split
+DROP TYPE IF EXISTS "ifta_purchase_source_enum";
+
+--bun:split
+DROP TYPE IF EXISTS "ifta_fuel_type_enum";
+
+--bun:split
+DROP TABLE IF EXISTS "telematics_vehicle_position_history";
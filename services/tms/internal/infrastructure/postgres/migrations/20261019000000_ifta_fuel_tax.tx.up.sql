@@ -0,0 +1,105 @@
+CREATE TABLE IF NOT EXISTS "telematics_vehicle_position_history"(
+    "organization_id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "tractor_id" varchar(100) NOT NULL,
+    "recorded_at" bigint NOT NULL,
+    "provider" varchar(32) NOT NULL,
+    "latitude" double precision NOT NULL,
+    "longitude" double precision NOT NULL,
+    "speed_mph" double precision NOT NULL DEFAULT 0,
+    "odometer_meters" bigint,
+    "formatted_location" text,
+    CONSTRAINT "pk_telematics_vehicle_position_history" PRIMARY KEY ("organization_id", "business_unit_id", "tractor_id", "recorded_at")
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_telematics_vehicle_position_history_recorded_at" ON "telematics_vehicle_position_history"("organization_id", "business_unit_id", "recorded_at");
+
+--bun:split
+COMMENT ON TABLE "telematics_vehicle_position_history" IS 'Every position fix a tractor reported, appended on each poll; fuel tax reporting attributes jurisdiction miles from it and it is kept for the four-year IFTA record retention period';
+
+--bun:split
+CREATE TYPE "ifta_fuel_type_enum" AS ENUM(
+    'Diesel',
+    'Gasoline'
+);
+
+--bun:split
+CREATE TYPE "ifta_purchase_source_enum" AS ENUM(
+    'Manual',
+    'Import'
+);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "ifta_fuel_purchases"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "tractor_id" varchar(100) NOT NULL,
+    "jurisdiction" varchar(2) NOT NULL,
+    "fuel_type" ifta_fuel_type_enum NOT NULL DEFAULT 'Diesel',
+    "purchased_at" bigint NOT NULL,
+    "gallons" numeric(12, 3) NOT NULL,
+    "total_cost" numeric(19, 4),
+    "tax_paid" boolean NOT NULL DEFAULT TRUE,
+    "vendor" varchar(255),
+    "receipt_number" varchar(100),
+    "source" ifta_purchase_source_enum NOT NULL DEFAULT 'Manual',
+    "version" bigint NOT NULL DEFAULT 0,
+    "created_at" bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::bigint,
+    "updated_at" bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::bigint,
+    CONSTRAINT "pk_ifta_fuel_purchases" PRIMARY KEY ("id", "organization_id", "business_unit_id"),
+    CONSTRAINT "fk_ifta_fuel_purchases_business_unit" FOREIGN KEY ("business_unit_id") REFERENCES "business_units"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_ifta_fuel_purchases_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_ifta_fuel_purchases_tractor" FOREIGN KEY ("tractor_id", "organization_id", "business_unit_id") REFERENCES "tractors"("id", "organization_id", "business_unit_id") ON UPDATE NO ACTION ON DELETE RESTRICT,
+    CONSTRAINT "chk_ifta_fuel_purchases_gallons" CHECK ("gallons" > 0),
+    CONSTRAINT "chk_ifta_fuel_purchases_total_cost" CHECK ("total_cost" IS NULL OR "total_cost" >= 0)
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS "idx_ifta_fuel_purchases_purchased_at" ON "ifta_fuel_purchases"("organization_id", "business_unit_id", "purchased_at");
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_ifta_fuel_purchases_receipt" ON "ifta_fuel_purchases"("organization_id", "business_unit_id", "tractor_id", "receipt_number")
+WHERE
+    "receipt_number" IS NOT NULL;
+
+--bun:split
+COMMENT ON TABLE "ifta_fuel_purchases" IS 'Fuel purchases keyed in or imported from card statements; tax-paid gallons credit the quarterly IFTA return. A receipt number is unique per tractor so re-importing a statement does not double count';
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "ifta_tax_rates"(
+    "id" varchar(100) NOT NULL,
+    "business_unit_id" varchar(100) NOT NULL,
+    "organization_id" varchar(100) NOT NULL,
+    "year" smallint NOT NULL,
+    "quarter" smallint NOT NULL,
+    "jurisdiction" varchar(2) NOT NULL,
+    "fuel_type" ifta_fuel_type_enum NOT NULL DEFAULT 'Diesel',
+    "rate" numeric(10, 4) NOT NULL,
+    "surcharge_rate" numeric(10, 4) NOT NULL DEFAULT 0,
+    "version" bigint NOT NULL DEFAULT 0,
+    "created_at" bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::bigint,
+    "updated_at" bigint NOT NULL DEFAULT EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::bigint,
+    CONSTRAINT "pk_ifta_tax_rates" PRIMARY KEY ("id", "organization_id", "business_unit_id"),
+    CONSTRAINT "fk_ifta_tax_rates_business_unit" FOREIGN KEY ("business_unit_id") REFERENCES "business_units"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_ifta_tax_rates_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "chk_ifta_tax_rates_quarter" CHECK ("quarter" BETWEEN 1 AND 4),
+    CONSTRAINT "chk_ifta_tax_rates_rate" CHECK ("rate" >= 0 AND "surcharge_rate" >= 0)
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_ifta_tax_rates_period" ON "ifta_tax_rates"("organization_id", "business_unit_id", "year", "quarter", "jurisdiction", "fuel_type");
+
+--bun:split
+COMMENT ON TABLE "ifta_tax_rates" IS 'Quarterly IFTA fuel tax rates per jurisdiction and fuel type in dollars per US gallon, as published by IFTA, Inc.; the surcharge applies to every taxable gallon with no credit for fuel purchased';
+
+--bun:split
+CREATE TABLE IF NOT EXISTS "ifta_jurisdiction_boundaries"(
+    "jurisdiction" varchar(2) NOT NULL,
+    "geometry" geometry(MultiPolygon, 4326) NOT NULL,
+    CONSTRAINT "pk_ifta_jurisdiction_boundaries" PRIMARY KEY ("jurisdiction")
+);
+
+--bun:split
+COMMENT ON TABLE "ifta_jurisdiction_boundaries" IS 'State and province outlines shared by every tenant, loaded from the Census Bureau TIGER/Line state file and the Statistics Canada province boundary file; fuel tax reporting places position fixes in them and cuts segments where they cross a border. While empty, fixes are placed from their geocoded location text';
@@ -201,7 +201,7 @@ func (r *repository) UpdateStatus(
 
 	entity := new(agent.AgentProposal)
 	cols := buncolgen.AgentProposalColumns
-	results, err := r.db.DB().
+	results, err := r.db.DBForContext(ctx).
 		NewUpdate().
 		Model(entity).
 		WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
@@ -257,3 +257,34 @@ func (r *repository) ExpirePendingByRun(
 
 	return int(affected), nil
 }
+
+func (r *repository) ListPendingByTour(
+	ctx context.Context,
+	req repositories.ListAgentProposalsByTourRequest,
+) ([]*agent.AgentProposal, error) {
+	log := r.l.With(
+		zap.String("operation", "ListPendingByTour"),
+		zap.String("runId", req.RunID.String()),
+		zap.String("tourId", req.TourID.String()),
+	)
+
+	cols := buncolgen.AgentProposalColumns
+	entities := make([]*agent.AgentProposal, 0, 4)
+	err := r.db.DB().
+		NewSelect().
+		Model(&entities).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.AgentProposalScopeTenant(sq, req.TenantInfo).
+				Where(cols.RunID.Eq(), req.RunID).
+				Where(cols.Status.Eq(), agent.ProposalStatusPending).
+				Where(cols.ToolParams.Expr("{} ->> 'tourId'")+" = ?", req.TourID.String())
+		}).
+		Order(cols.CreatedAt.OrderAsc()).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to list pending agent proposals for tour", zap.Error(err))
+		return nil, err
+	}
+
+	return entities, nil
+}
@@ -35,6 +35,11 @@ var (
 
 	workerStateJoin = "LEFT JOIN " + buncolgen.UsStateTable.As(buncolgen.UsStateTable.Alias) +
 		" ON " + buncolgen.UsStateColumns.ID.EqColumn(buncolgen.WorkerColumns.StateID)
+
+	workerHomeJoin = "LEFT JOIN locations AS home ON home.id = " +
+		buncolgen.WorkerColumns.HomeLocationID.Qualified() +
+		" AND home.organization_id = " + buncolgen.WorkerColumns.OrganizationID.Qualified() +
+		" AND home.business_unit_id = " + buncolgen.WorkerColumns.BusinessUnitID.Qualified()
 )
 
 func (r *repository) ListBoardDrivers(
@@ -80,8 +85,13 @@ func (r *repository) ListBoardDrivers(
 		ColumnExpr("COALESCE(wtrac.status = ?, FALSE) AS tractor_status_ok",
 			domaintypes.EquipmentStatusAvailable).
 		ColumnExpr("COALESCE(oa.open_assignments, 0) AS open_assignments").
+		ColumnExpr(cols.HomeLocationID.Expr("COALESCE({}, '') AS home_location_id")).
+		ColumnExpr("home.latitude AS home_latitude").
+		ColumnExpr("home.longitude AS home_longitude").
+		ColumnExpr(cols.HomeTimeMaxDaysOut.As("home_time_max_days_out")).
 		Join(fleetCodeJoin).
 		Join(workerStateJoin).
+		Join(workerHomeJoin).
 		Join(tractorLateral, domaintypes.EquipmentStatusAvailable).
 		Join(openAssignmentLateral, bun.List(openMoveStatuses)).
 		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
@@ -0,0 +1,67 @@
+package dispatchconsolerepository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emoss08/trenova/internal/core/domain/location"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/domaintypes"
+	"github.com/uptrace/bun"
+)
+
+var (
+	locationCategoryJoin = "JOIN " + buncolgen.LocationCategoryTable.As(
+		buncolgen.LocationCategoryTable.Alias) +
+		" ON " + buncolgen.LocationCategoryColumns.ID.EqColumn(
+		buncolgen.LocationColumns.LocationCategoryID) +
+		" AND " + buncolgen.LocationCategoryColumns.OrganizationID.EqColumn(
+		buncolgen.LocationColumns.OrganizationID) +
+		" AND " + buncolgen.LocationCategoryColumns.BusinessUnitID.EqColumn(
+		buncolgen.LocationColumns.BusinessUnitID)
+
+	locationStateJoin = "LEFT JOIN " + buncolgen.UsStateTable.As(buncolgen.UsStateTable.Alias) +
+		" ON " + buncolgen.UsStateColumns.ID.EqColumn(buncolgen.LocationColumns.StateID)
+)
+
+func (r *repository) ListRelayPoints(
+	ctx context.Context,
+	req *repositories.ListRelayPointsRequest,
+) ([]*repositories.RelayPoint, error) {
+	cols := buncolgen.LocationColumns
+	catCols := buncolgen.LocationCategoryColumns
+
+	entities := make([]*repositories.RelayPoint, 0, boardLimit(req.Limit))
+
+	q := r.db.DB().NewSelect().
+		Model((*location.Location)(nil)).
+		ColumnExpr(cols.ID.As("location_id")).
+		ColumnExpr(cols.Name.As("name")).
+		ColumnExpr(cols.City.As("city")).
+		ColumnExpr(cols.Latitude.As("latitude")).
+		ColumnExpr(cols.Longitude.As("longitude")).
+		ColumnExpr(catCols.Type.As("category")).
+		ColumnExpr(buncolgen.UsStateColumns.Abbreviation.Expr("COALESCE({}, '') AS state_abbr")).
+		Join(locationCategoryJoin).
+		Join(locationStateJoin).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq = buncolgen.LocationScopeTenant(sq, req.TenantInfo).
+				Where(cols.Status.Eq(), domaintypes.StatusActive).
+				Where(cols.Latitude.Between(), req.MinLatitude, req.MaxLatitude).
+				Where(cols.Longitude.Between(), req.MinLongitude, req.MaxLongitude)
+			if len(req.Categories) > 0 {
+				sq = sq.Where(catCols.Type.In(), bun.List(req.Categories))
+			}
+			return sq
+		}).
+		Order(cols.Name.OrderAsc()).
+		Order(cols.ID.OrderAsc()).
+		Limit(boardLimit(req.Limit))
+
+	if err := q.Scan(ctx, &entities); err != nil {
+		return nil, fmt.Errorf("list relay points: %w", err)
+	}
+
+	return entities, nil
+}
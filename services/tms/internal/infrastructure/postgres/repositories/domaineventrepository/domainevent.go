@@ -0,0 +1,47 @@
+package domaineventrepository
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/domainevent"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type repository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func New(p Params) repositories.DomainEventOutboxRepository {
+	return &repository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.domain-event-outbox-repository"),
+	}
+}
+
+func (r *repository) Append(ctx context.Context, events ...*domainevent.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(&events).
+		On("CONFLICT (idempotency_key) DO NOTHING").
+		Exec(ctx); err != nil {
+		r.l.Error("failed to append domain events", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+//nolint:gocritic // Repository request structs follow the existing value-parameter port contracts.
+package edicertificationrepository
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type repository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func New(p Params) repositories.EDICertificationRunRepository {
+	return &repository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.edi-certification-repository"),
+	}
+}
+
+func (r *repository) ListCertificationRuns(
+	ctx context.Context,
+	req *repositories.ListEDICertificationRunsRequest,
+) (*pagination.ListResult[*edi.EDICertificationRun], error) {
+	entities := make([]*edi.EDICertificationRun, 0, req.Filter.Pagination.SafeLimit())
+	cols := buncolgen.EDICertificationRunColumns
+
+	query := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Relation(buncolgen.EDICertificationRunRelations.Partner).
+		Apply(buncolgen.EDICertificationRunApplyTenant(req.Filter.TenantInfo))
+	if !req.EDIPartnerID.IsNil() {
+		query = query.Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID)
+	}
+	if req.Status != "" {
+		query = query.Where(cols.Status.Eq(), req.Status)
+	}
+	total, err := query.
+		Order(cols.CreatedAt.OrderDesc()).
+		Limit(req.Filter.Pagination.SafeLimit()).
+		Offset(req.Filter.Pagination.SafeOffset()).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pagination.ListResult[*edi.EDICertificationRun]{Items: entities, Total: total}, nil
+}
+
+func (r *repository) GetCertificationRunByID(
+	ctx context.Context,
+	req repositories.GetEDICertificationRunByIDRequest,
+) (*edi.EDICertificationRun, error) {
+	entity := new(edi.EDICertificationRun)
+	cols := buncolgen.EDICertificationRunColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		Relation(buncolgen.EDICertificationRunRelations.Partner).
+		Relation(buncolgen.EDICertificationRunRelations.CommunicationProfile).
+		Where(cols.ID.Eq(), req.ID).
+		Apply(buncolgen.EDICertificationRunApplyTenant(req.TenantInfo)).
+		Scan(ctx)
+	if err != nil {
+		return nil, dberror.HandleNotFoundError(err, "EDICertificationRun")
+	}
+	return entity, nil
+}
+
+func (r *repository) GetLatestCertificationRun(
+	ctx context.Context,
+	req repositories.GetLatestEDICertificationRunRequest,
+) (*edi.EDICertificationRun, error) {
+	entity := new(edi.EDICertificationRun)
+	cols := buncolgen.EDICertificationRunColumns
+
+	query := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity)
+	if req.EDIConnectionID.IsNil() {
+		query = query.
+			Apply(buncolgen.EDICertificationRunApplyTenant(req.TenantInfo)).
+			Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID)
+	} else {
+		query = query.
+			Where(cols.BusinessUnitID.Eq(), req.TenantInfo.BuID).
+			Where(cols.EDIConnectionID.Eq(), req.EDIConnectionID)
+	}
+	err := query.
+		Order(cols.CreatedAt.OrderDesc()).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return nil, dberror.HandleNotFoundError(err, "EDICertificationRun")
+	}
+	return entity, nil
+}
+
+func (r *repository) CreateCertificationRun(
+	ctx context.Context,
+	entity *edi.EDICertificationRun,
+) (*edi.EDICertificationRun, error) {
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (r *repository) UpdateCertificationRun(
+	ctx context.Context,
+	entity *edi.EDICertificationRun,
+) (*edi.EDICertificationRun, error) {
+	ov := entity.Version
+	entity.Version++
+	results, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model(entity).
+		WherePK().
+		Where("version = ?", ov).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		entity.Version = ov
+		return nil, err
+	}
+	if err = dberror.CheckRowsAffected(results, "EDICertificationRun", entity.ID.String()); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (r *repository) ListCertificationMessages(
+	ctx context.Context,
+	req repositories.ListEDICertificationMessagesRequest,
+) ([]*edi.EDIMessage, error) {
+	entities := make([]*edi.EDIMessage, 0)
+	cols := buncolgen.EDIMessageColumns
+
+	query := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Apply(buncolgen.EDIMessageApplyTenant(req.TenantInfo)).
+		Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID)
+	if len(req.MessageIDs) > 0 {
+		query = query.Where(cols.ID.In(), bun.List(req.MessageIDs))
+	} else {
+		query = query.
+			Where(cols.Direction.Eq(), req.Direction).
+			Where(cols.TransactionSet.Eq(), req.TransactionSet).
+			Where(cols.CreatedAt.Gte(), req.Since)
+	}
+	if err := query.Order(cols.CreatedAt.OrderAsc()).Scan(ctx); err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
@@ -188,6 +188,15 @@ func (r *repository) GetActiveProfileByPartner(
 	return entity, nil
 }
 
+// inboundPollingMethods are the mailbox transports the inbound poller picks up
+// files from.
+var inboundPollingMethods = []edi.ConnectionMethod{
+	edi.ConnectionMethodSFTP,
+	edi.ConnectionMethodVAN,
+	edi.ConnectionMethodFTPS,
+	edi.ConnectionMethodS3,
+}
+
 func (r *repository) ListInboundPollingProfiles(
 	ctx context.Context,
 ) ([]*edi.EDICommunicationProfile, error) {
@@ -198,10 +207,7 @@ func (r *repository) ListInboundPollingProfiles(
 		NewSelect().
 		Model(&entities).
 		Where(cols.Status.Eq(), domaintypes.StatusActive).
-		Where(cols.Method.In(), bun.List([]edi.ConnectionMethod{
-			edi.ConnectionMethodSFTP,
-			edi.ConnectionMethodVAN,
-		})).
+		Where(cols.Method.In(), bun.List(inboundPollingMethods)).
 		Where("ecp.edi_partner_id IS NOT NULL").
 		Where("COALESCE(TRIM(ecp.config->>'inboundDirectory'), '') <> ''").
 		Order(cols.CreatedAt.OrderAsc()).
@@ -244,10 +250,7 @@ func (r *repository) CountStaleInboundPollingProfiles(
 		NewSelect().
 		Model((*edi.EDICommunicationProfile)(nil)).
 		Where(cols.Status.Eq(), domaintypes.StatusActive).
-		Where(cols.Method.In(), bun.List([]edi.ConnectionMethod{
-			edi.ConnectionMethodSFTP,
-			edi.ConnectionMethodVAN,
-		})).
+		Where(cols.Method.In(), bun.List(inboundPollingMethods)).
 		Where("ecp.edi_partner_id IS NOT NULL").
 		Where("COALESCE(TRIM(ecp.config->>'inboundDirectory'), '') <> ''").
 		Where("COALESCE(ecp.last_poll_success_at, ecp.created_at) < ?", staleBefore).
@@ -282,6 +285,30 @@ func (r *repository) GetActiveAS2ProfileByIdentifiers(
 	return entity, nil
 }
 
+// GetActiveHTTPSProfileByID resolves the profile addressed by a partner's
+// inbound HTTPS request. The request is unauthenticated until its signature is
+// checked against the profile, so the lookup cannot be scoped to a tenant.
+func (r *repository) GetActiveHTTPSProfileByID(
+	ctx context.Context,
+	req repositories.GetActiveHTTPSProfileByIDRequest,
+) (*edi.EDICommunicationProfile, error) {
+	entity := new(edi.EDICommunicationProfile)
+	cols := buncolgen.EDICommunicationProfileColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		Where(cols.ID.Eq(), req.ID).
+		Where(cols.Status.Eq(), domaintypes.StatusActive).
+		Where(cols.Method.Eq(), edi.ConnectionMethodHTTPS).
+		Where("ecp.edi_partner_id IS NOT NULL").
+		Scan(ctx)
+	if err != nil {
+		return nil, dberror.HandleNotFoundError(err, "EDICommunicationProfile")
+	}
+	return entity, nil
+}
+
 func (r *repository) CreateProfile(
 	ctx context.Context,
 	entity *edi.EDICommunicationProfile,
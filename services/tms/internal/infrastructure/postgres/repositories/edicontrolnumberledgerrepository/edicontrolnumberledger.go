@@ -0,0 +1,251 @@
+//nolint:gocritic // Repository request structs follow the existing value-parameter port contracts.
+package edicontrolnumberledgerrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type repository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func New(p Params) repositories.EDIControlNumberLedgerRepository {
+	return &repository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.edi-control-number-ledger-repository"),
+	}
+}
+
+func (r *repository) ListLedgerEntries(
+	ctx context.Context,
+	req *repositories.ListEDIControlNumberLedgerRequest,
+) (*pagination.ListResult[*edi.EDIControlNumberLedgerEntry], error) {
+	entities := make([]*edi.EDIControlNumberLedgerEntry, 0, req.Filter.Pagination.SafeLimit())
+	cols := buncolgen.EDIControlNumberLedgerEntryColumns
+
+	query := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Apply(buncolgen.EDIControlNumberLedgerEntryApplyTenant(req.Filter.TenantInfo)).
+		Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID)
+	if req.Direction != "" {
+		query = query.Where(cols.Direction.Eq(), req.Direction)
+	}
+	if req.Kind != "" {
+		query = query.Where(cols.Kind.Eq(), req.Kind)
+	}
+	if req.Anomaly != "" {
+		query = query.Where(cols.Anomaly.Eq(), req.Anomaly)
+	}
+	total, err := query.
+		Order(cols.RecordedAt.OrderDesc()).
+		Order(cols.ID.OrderDesc()).
+		Limit(req.Filter.Pagination.SafeLimit()).
+		Offset(req.Filter.Pagination.SafeOffset()).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pagination.ListResult[*edi.EDIControlNumberLedgerEntry]{
+		Items: entities,
+		Total: total,
+	}, nil
+}
+
+func (r *repository) SummarizeLedger(
+	ctx context.Context,
+	req repositories.SummarizeEDIControlNumberLedgerRequest,
+) ([]*edi.ControlNumberLedgerSummary, error) {
+	summaries := make([]*edi.ControlNumberLedgerSummary, 0)
+	cols := buncolgen.EDIControlNumberLedgerEntryColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model((*edi.EDIControlNumberLedgerEntry)(nil)).
+		Column(cols.Direction.String(), cols.Kind.String()).
+		ColumnExpr("COUNT(*) AS entry_count").
+		ColumnExpr("COALESCE(MAX(ecnl.sequence), 0) AS highest_number").
+		ColumnExpr("COUNT(*) FILTER (WHERE ecnl.anomaly = 'Gap') AS gap_count").
+		ColumnExpr("COUNT(*) FILTER (WHERE ecnl.anomaly = 'Duplicate') AS duplicate_count").
+		ColumnExpr("COUNT(*) FILTER (WHERE ecnl.anomaly = 'OutOfOrder') AS out_of_order").
+		ColumnExpr("COUNT(*) FILTER (WHERE ecnl.rejected) AS rejected_count").
+		ColumnExpr("MAX(ecnl.recorded_at) AS last_recorded_at").
+		Apply(buncolgen.EDIControlNumberLedgerEntryApplyTenant(req.TenantInfo)).
+		Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID).
+		Group(cols.Direction.String(), cols.Kind.String()).
+		Order(cols.Direction.OrderAsc(), cols.Kind.OrderAsc()).
+		Scan(ctx, &summaries)
+	if err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+func (r *repository) GetHighestSequence(
+	ctx context.Context,
+	req repositories.ControlNumberSequenceRequest,
+) (int64, error) {
+	var highest int64
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model((*edi.EDIControlNumberLedgerEntry)(nil)).
+		ColumnExpr("COALESCE(MAX(ecnl.sequence), 0)").
+		Apply(sequenceScope(req)).
+		Scan(ctx, &highest)
+	if err != nil {
+		return 0, err
+	}
+	return highest, nil
+}
+
+func (r *repository) FindControlNumberEntry(
+	ctx context.Context,
+	req repositories.FindControlNumberEntryRequest,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	entity := new(edi.EDIControlNumberLedgerEntry)
+	cols := buncolgen.EDIControlNumberLedgerEntryColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		Apply(sequenceScope(req.Sequence)).
+		Where(cols.ControlNumber.Eq(), req.ControlNumber).
+		Order(cols.RecordedAt.OrderAsc()).
+		Order(cols.ID.OrderAsc()).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil //nolint:nilnil // an unseen control number is not an error
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (r *repository) FindResentTransaction(
+	ctx context.Context,
+	req repositories.FindResentTransactionRequest,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	entity := new(edi.EDIControlNumberLedgerEntry)
+	cols := buncolgen.EDIControlNumberLedgerEntryColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		Apply(buncolgen.EDIControlNumberLedgerEntryApplyTenant(req.TenantInfo)).
+		Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID).
+		Where(cols.Direction.Eq(), edi.DocumentDirectionInbound).
+		Where(cols.Kind.Eq(), edi.ControlNumberKindTransaction).
+		Where(cols.TransactionSet.Eq(), req.TransactionSet).
+		Where(cols.ContentHash.Eq(), req.ContentHash).
+		Where(cols.Rejected.IsFalse()).
+		Where(cols.Outcome.NotEq(), edi.ControlNumberOutcomeFailed).
+		Order(cols.RecordedAt.OrderAsc()).
+		Order(cols.ID.OrderAsc()).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil //nolint:nilnil // a transaction seen for the first time is not an error
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (r *repository) CreateLedgerEntry(
+	ctx context.Context,
+	entity *edi.EDIControlNumberLedgerEntry,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// transactionClaimConflict targets idx_edi_control_number_ledger_transaction_claim,
+// which allows one live entry per transaction content.
+const transactionClaimConflict = `CONFLICT (organization_id, business_unit_id, edi_partner_id,
+	transaction_set, content_hash)
+	WHERE direction = 'Inbound' AND kind = 'Transaction' AND content_hash IS NOT NULL
+	AND NOT rejected AND outcome <> 'Failed' DO NOTHING`
+
+func (r *repository) ClaimTransactionEntry(
+	ctx context.Context,
+	entity *edi.EDIControlNumberLedgerEntry,
+) (*edi.EDIControlNumberLedgerEntry, error) {
+	result, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		On(transactionClaimConflict).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, nil //nolint:nilnil // another file holds the transaction
+	}
+	return entity, nil
+}
+
+func (r *repository) UpdateTransactionOutcome(
+	ctx context.Context,
+	req repositories.UpdateTransactionOutcomeRequest,
+) error {
+	cols := buncolgen.EDIControlNumberLedgerEntryColumns
+	result, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model((*edi.EDIControlNumberLedgerEntry)(nil)).
+		Set(cols.Outcome.Set(), req.Outcome).
+		Where(cols.ID.Eq(), req.EntryID).
+		Where(cols.OrganizationID.Eq(), req.TenantInfo.OrgID).
+		Where(cols.BusinessUnitID.Eq(), req.TenantInfo.BuID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return dberror.CheckRowsAffected(result, "EDIControlNumberLedgerEntry", req.EntryID.String())
+}
+
+func sequenceScope(
+	req repositories.ControlNumberSequenceRequest,
+) func(*bun.SelectQuery) *bun.SelectQuery {
+	cols := buncolgen.EDIControlNumberLedgerEntryColumns
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		return buncolgen.EDIControlNumberLedgerEntryScopeTenant(q, req.TenantInfo).
+			Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID).
+			Where(cols.Direction.Eq(), req.Direction).
+			Where(cols.Kind.Eq(), req.Kind).
+			Where(cols.SequenceScope.Eq(), req.SequenceScope)
+	}
+}
@@ -0,0 +1,198 @@
+//nolint:gocritic // Repository request structs follow the existing value-parameter port contracts.
+package ediimplementationguiderepository
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/edi"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type repository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func New(p Params) repositories.EDIImplementationGuideRepository {
+	return &repository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.edi-implementation-guide-repository"),
+	}
+}
+
+func (r *repository) ListImplementationGuides(
+	ctx context.Context,
+	req *repositories.ListEDIImplementationGuidesRequest,
+) (*pagination.ListResult[*edi.EDIImplementationGuide], error) {
+	entities := make([]*edi.EDIImplementationGuide, 0, req.Filter.Pagination.SafeLimit())
+	cols := buncolgen.EDIImplementationGuideColumns
+
+	query := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Relation(buncolgen.EDIImplementationGuideRelations.Partner).
+		Apply(buncolgen.EDIImplementationGuideApplyTenant(req.Filter.TenantInfo))
+	if !req.EDIPartnerID.IsNil() {
+		query = query.Where(cols.EDIPartnerID.Eq(), req.EDIPartnerID)
+	}
+	if req.TransactionSet != "" {
+		query = query.Where(cols.TransactionSet.Eq(), req.TransactionSet)
+	}
+	total, err := query.
+		Order(cols.CreatedAt.OrderDesc()).
+		Limit(req.Filter.Pagination.SafeLimit()).
+		Offset(req.Filter.Pagination.SafeOffset()).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pagination.ListResult[*edi.EDIImplementationGuide]{Items: entities, Total: total}, nil
+}
+
+func (r *repository) GetImplementationGuideByID(
+	ctx context.Context,
+	req repositories.GetEDIImplementationGuideByIDRequest,
+) (*edi.EDIImplementationGuide, error) {
+	entity := new(edi.EDIImplementationGuide)
+	cols := buncolgen.EDIImplementationGuideColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		Relation(buncolgen.EDIImplementationGuideRelations.Partner).
+		Where(cols.ID.Eq(), req.ID).
+		Apply(buncolgen.EDIImplementationGuideApplyTenant(req.TenantInfo)).
+		Scan(ctx)
+	if err != nil {
+		return nil, dberror.HandleNotFoundError(err, "EDIImplementationGuide")
+	}
+	return entity, nil
+}
+
+func (r *repository) GetActiveImplementationGuide(
+	ctx context.Context,
+	req repositories.GetActiveEDIImplementationGuideRequest,
+) (*edi.EDIImplementationGuide, error) {
+	entity := new(edi.EDIImplementationGuide)
+	cols := buncolgen.EDIImplementationGuideColumns
+
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		Apply(buncolgen.EDIImplementationGuideApplyTenant(req.TenantInfo)).
+		Where(cols.TransactionSet.Eq(), req.TransactionSet).
+		Where(cols.X12Version.Eq(), req.X12Version).
+		Where(cols.Direction.Eq(), req.Direction).
+		Where(cols.IsActive.IsTrue()).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			if !req.PartnerID.IsNil() {
+				sq = sq.WhereOr(cols.EDIPartnerID.Eq(), req.PartnerID)
+			}
+			return sq.WhereOr(cols.EDIPartnerID.IsNull())
+		}).
+		OrderExpr(cols.EDIPartnerID.Qualified() + " IS NULL").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return nil, dberror.HandleNotFoundError(err, "EDIImplementationGuide")
+	}
+	return entity, nil
+}
+
+func (r *repository) CreateImplementationGuide(
+	ctx context.Context,
+	entity *edi.EDIImplementationGuide,
+) (*edi.EDIImplementationGuide, error) {
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		Returning("*").
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (r *repository) UpdateImplementationGuide(
+	ctx context.Context,
+	entity *edi.EDIImplementationGuide,
+) (*edi.EDIImplementationGuide, error) {
+	ov := entity.Version
+	entity.Version++
+	results, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model(entity).
+		WherePK().
+		Where("version = ?", ov).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		entity.Version = ov
+		return nil, err
+	}
+	if err = dberror.CheckRowsAffected(results, "EDIImplementationGuide", entity.ID.String()); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (r *repository) DeactivateImplementationGuides(
+	ctx context.Context,
+	req repositories.DeactivateEDIImplementationGuidesRequest,
+) error {
+	cols := buncolgen.EDIImplementationGuideColumns
+	query := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model((*edi.EDIImplementationGuide)(nil)).
+		Set(cols.IsActive.Set(), false).
+		Set("version = version + 1").
+		Set("updated_at = "+r.db.NowEpoch()).
+		Where(cols.OrganizationID.Eq(), req.TenantInfo.OrgID).
+		Where(cols.BusinessUnitID.Eq(), req.TenantInfo.BuID).
+		Where(cols.TransactionSet.Eq(), req.TransactionSet).
+		Where(cols.X12Version.Eq(), req.X12Version).
+		Where(cols.Direction.Eq(), req.Direction).
+		Where(cols.IsActive.IsTrue())
+	if req.PartnerID.IsNil() {
+		query = query.Where(cols.EDIPartnerID.IsNull())
+	} else {
+		query = query.Where(cols.EDIPartnerID.Eq(), req.PartnerID)
+	}
+	if !req.ExceptID.IsNil() {
+		query = query.Where(cols.ID.Ne(), req.ExceptID)
+	}
+	_, err := query.Exec(ctx)
+	return err
+}
+
+func (r *repository) DeleteImplementationGuide(
+	ctx context.Context,
+	req repositories.DeleteEDIImplementationGuideRequest,
+) error {
+	cols := buncolgen.EDIImplementationGuideColumns
+	results, err := r.db.DBForContext(ctx).
+		NewDelete().
+		Model((*edi.EDIImplementationGuide)(nil)).
+		Where(cols.ID.Eq(), req.ID).
+		Where(cols.OrganizationID.Eq(), req.TenantInfo.OrgID).
+		Where(cols.BusinessUnitID.Eq(), req.TenantInfo.BuID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return dberror.CheckRowsAffected(results, "EDIImplementationGuide", req.ID.String())
+}
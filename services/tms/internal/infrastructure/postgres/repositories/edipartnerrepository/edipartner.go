@@ -286,6 +286,7 @@ func (r *repository) Update(ctx context.Context, entity *edi.EDIPartner) (*edi.E
 			cols.EnabledForInbound.Bare(),
 			cols.EnabledForOutbound.Bare(),
 			cols.Settings.Bare(),
+			cols.InboundTransformScript.Bare(),
 			cols.Version.Bare(),
 			cols.UpdatedAt.Bare(),
 		).
@@ -846,7 +846,8 @@ func (r *repository) EnsureBaseTemplate(
 ) (*edi.EDITemplate, *edi.EDITemplateVersion, error) {
 	template := new(edi.EDITemplate)
 	version := new(edi.EDITemplateVersion)
-	templateName := fmt.Sprintf("Base X12 %s Outbound", transactionSet)
+	standard := transactionSet.Standard()
+	templateName := fmt.Sprintf("Base %s %s Outbound", standard, transactionSet)
 	err := r.db.WithTx(ctx, ports.TxOptions{}, func(c context.Context, _ bun.Tx) error {
 		cols := buncolgen.EDITemplateColumns
 
@@ -855,7 +856,7 @@ func (r *repository) EnsureBaseTemplate(
 			Model(template).
 			WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
 				return buncolgen.EDITemplateScopeTenant(sq, tenantInfo).
-					Where(cols.Standard.Eq(), edi.EDIStandardX12).
+					Where(cols.Standard.Eq(), standard).
 					Where(cols.TransactionSet.Eq(), transactionSet).
 					Where(cols.Direction.Eq(), edi.DocumentDirectionOutbound).
 					Where(cols.Name.Eq(), templateName)
@@ -881,7 +882,7 @@ func (r *repository) EnsureBaseTemplate(
 		}
 
 		documentTypes, err := r.listDocumentTypes(c, repositories.ListEDIDocumentTypesRequest{
-			Standard:       edi.EDIStandardX12,
+			Standard:       standard,
 			TransactionSet: transactionSet,
 			Direction:      edi.DocumentDirectionOutbound,
 		})
@@ -889,7 +890,11 @@ func (r *repository) EnsureBaseTemplate(
 			return err
 		}
 		if len(documentTypes) == 0 {
-			return fmt.Errorf("x12 %s outbound document type is not seeded", transactionSet)
+			return fmt.Errorf(
+				"%s %s outbound document type is not seeded",
+				standard,
+				transactionSet,
+			)
 		}
 
 		template = &edi.EDITemplate{
@@ -898,11 +903,12 @@ func (r *repository) EnsureBaseTemplate(
 			DocumentTypeID: documentTypes[0].ID,
 			Name:           templateName,
 			Description: fmt.Sprintf(
-				"Tenant-scoped base outbound X12 %s template",
+				"Tenant-scoped base outbound %s %s template",
+				standard,
 				transactionSet,
 			),
 			Direction:      edi.DocumentDirectionOutbound,
-			Standard:       edi.EDIStandardX12,
+			Standard:       standard,
 			TransactionSet: transactionSet,
 			Status:         edi.TemplateStatusActive,
 		}
@@ -915,8 +921,11 @@ func (r *repository) EnsureBaseTemplate(
 		}
 
 		x12Version := edi.DefaultX12204Version
-		if transactionSet == edi.TransactionSet999 {
+		switch {
+		case transactionSet == edi.TransactionSet999:
 			x12Version = "005010"
+		case standard == edi.EDIStandardEDIFACT:
+			x12Version = edi.DefaultEDIFACTVersion
 		}
 		activatedAt := timeutils.NowUnix()
 		version = &edi.EDITemplateVersion{
@@ -929,8 +938,9 @@ func (r *repository) EnsureBaseTemplate(
 			Status:            edi.TemplateStatusActive,
 			IsActive:          true,
 			Notes: fmt.Sprintf(
-				"Seeded base %s X12 %s outbound profile",
+				"Seeded base %s %s %s outbound profile",
 				x12Version,
+				standard,
 				transactionSet,
 			),
 			ActivatedAt: &activatedAt,
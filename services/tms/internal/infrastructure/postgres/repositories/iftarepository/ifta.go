@@ -0,0 +1,345 @@
+package iftarepository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emoss08/trenova/internal/core/domain/ifta"
+	"github.com/emoss08/trenova/internal/core/domain/telematics"
+	"github.com/emoss08/trenova/internal/core/domain/tractor"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Params struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type repository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func New(p Params) repositories.IFTARepository {
+	return &repository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.ifta-repository"),
+	}
+}
+
+func (r *repository) ListTrackedTractors(
+	ctx context.Context,
+	req *repositories.ListTrackedTractorsRequest,
+) ([]*repositories.TrackedTractor, error) {
+	cols := buncolgen.VehiclePositionHistoryColumns
+	tractorCols := buncolgen.TractorColumns
+
+	rows := make([]*repositories.TrackedTractor, 0)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model((*telematics.VehiclePositionHistory)(nil)).
+		ColumnExpr(cols.TractorID.Qualified()).
+		ColumnExpr(tractorCols.Code.Qualified()).
+		Join("JOIN "+buncolgen.TractorTable.As(buncolgen.TractorTable.Alias)+
+			" ON "+tractorCols.ID.EqColumn(cols.TractorID)+
+			" AND "+tractorCols.OrganizationID.EqColumn(cols.OrganizationID)+
+			" AND "+tractorCols.BusinessUnitID.EqColumn(cols.BusinessUnitID)).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.VehiclePositionHistoryScopeTenant(sq, req.TenantInfo).
+				Where(cols.RecordedAt.Gte(), req.Start).
+				Where(cols.RecordedAt.Lt(), req.End)
+		}).
+		GroupExpr(cols.TractorID.Qualified()).
+		GroupExpr(tractorCols.Code.Qualified()).
+		OrderExpr(tractorCols.Code.OrderAsc()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("list tracked tractors: %w", err)
+	}
+
+	return rows, nil
+}
+
+func (r *repository) ListPositionHistory(
+	ctx context.Context,
+	req *repositories.ListPositionHistoryRequest,
+) ([]*telematics.VehiclePositionHistory, error) {
+	cols := buncolgen.VehiclePositionHistoryColumns
+
+	entities := make([]*telematics.VehiclePositionHistory, 0, 1024)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.VehiclePositionHistoryScopeTenant(sq, req.TenantInfo).
+				Where(cols.TractorID.Eq(), req.TractorID).
+				Where(cols.RecordedAt.Gte(), req.Start).
+				Where(cols.RecordedAt.Lt(), req.End)
+		}).
+		Order(cols.RecordedAt.OrderAsc()).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list position history: %w", err)
+	}
+
+	return entities, nil
+}
+
+func (r *repository) ListJurisdictionBoundaries(
+	ctx context.Context,
+) ([]*ifta.JurisdictionBoundary, error) {
+	entities := make([]*ifta.JurisdictionBoundary, 0, 64)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Order("ijb.jurisdiction ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list jurisdiction boundaries: %w", err)
+	}
+
+	return entities, nil
+}
+
+func (r *repository) ListFuelPurchases(
+	ctx context.Context,
+	req *repositories.ListFuelPurchasesRequest,
+) ([]*ifta.FuelPurchase, error) {
+	cols := buncolgen.FuelPurchaseColumns
+
+	entities := make([]*ifta.FuelPurchase, 0, 64)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Relation(buncolgen.FuelPurchaseRelations.Tractor, func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return sq.Column(buncolgen.TractorColumns.ID.Bare(), buncolgen.TractorColumns.Code.Bare())
+		}).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq = buncolgen.FuelPurchaseScopeTenant(sq, req.TenantInfo).
+				Where(cols.PurchasedAt.Gte(), req.Start).
+				Where(cols.PurchasedAt.Lt(), req.End)
+			if req.TractorID.IsNotNil() {
+				sq = sq.Where(cols.TractorID.Eq(), req.TractorID)
+			}
+			if req.FuelType != "" {
+				sq = sq.Where(cols.FuelType.Eq(), req.FuelType)
+			}
+			return sq
+		}).
+		Order(cols.PurchasedAt.OrderAsc()).
+		Scan(ctx)
+	if err != nil {
+		r.l.Error("failed to list fuel purchases", zap.Error(err))
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func (r *repository) GetFuelPurchaseByID(
+	ctx context.Context,
+	req *repositories.GetFuelPurchaseByIDRequest,
+) (*ifta.FuelPurchase, error) {
+	entity := new(ifta.FuelPurchase)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.FuelPurchaseScopeTenant(sq, req.TenantInfo).
+				Where(buncolgen.FuelPurchaseColumns.ID.Eq(), req.PurchaseID)
+		}).
+		Scan(ctx)
+	if err != nil {
+		r.l.Error("failed to get fuel purchase", zap.Error(err))
+		return nil, dberror.HandleNotFoundError(err, "Fuel purchase")
+	}
+
+	return entity, nil
+}
+
+func (r *repository) CreateFuelPurchase(
+	ctx context.Context,
+	entity *ifta.FuelPurchase,
+) (*ifta.FuelPurchase, error) {
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		Returning("*").
+		Exec(ctx); err != nil {
+		r.l.Error("failed to create fuel purchase", zap.Error(err))
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *repository) UpdateFuelPurchase(
+	ctx context.Context,
+	entity *ifta.FuelPurchase,
+) (*ifta.FuelPurchase, error) {
+	ov := entity.Version
+	entity.Version++
+
+	results, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model(entity).
+		WherePK().
+		Where("version = ?", ov).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		r.l.Error("failed to update fuel purchase", zap.Error(err))
+		return nil, err
+	}
+
+	if err = dberror.CheckRowsAffected(results, "Fuel purchase", entity.ID.String()); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *repository) DeleteFuelPurchase(
+	ctx context.Context,
+	req *repositories.GetFuelPurchaseByIDRequest,
+) error {
+	result, err := r.db.DBForContext(ctx).
+		NewDelete().
+		Model((*ifta.FuelPurchase)(nil)).
+		WhereGroup(" AND ", func(dq *bun.DeleteQuery) *bun.DeleteQuery {
+			return buncolgen.FuelPurchaseScopeTenantDelete(dq, req.TenantInfo).
+				Where(buncolgen.FuelPurchaseColumns.ID.Eq(), req.PurchaseID)
+		}).
+		Exec(ctx)
+	if err != nil {
+		r.l.Error("failed to delete fuel purchase", zap.Error(err))
+		return err
+	}
+
+	return dberror.CheckRowsAffected(result, "Fuel purchase", req.PurchaseID.String())
+}
+
+func (r *repository) ImportFuelPurchases(
+	ctx context.Context,
+	entities []*ifta.FuelPurchase,
+) (int, error) {
+	if len(entities) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(&entities).
+		On("CONFLICT (organization_id, business_unit_id, tractor_id, receipt_number) " +
+			"WHERE receipt_number IS NOT NULL DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		r.l.Error("failed to import fuel purchases", zap.Error(err))
+		return 0, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(inserted), nil
+}
+
+func (r *repository) ListTaxRates(
+	ctx context.Context,
+	req *repositories.ListIFTATaxRatesRequest,
+) ([]*ifta.TaxRate, error) {
+	cols := buncolgen.TaxRateColumns
+
+	entities := make([]*ifta.TaxRate, 0, 64)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq = buncolgen.TaxRateScopeTenant(sq, req.TenantInfo).
+				Where(cols.Year.Eq(), req.Period.Year).
+				Where(cols.Quarter.Eq(), req.Period.Quarter)
+			if req.FuelType != "" {
+				sq = sq.Where(cols.FuelType.Eq(), req.FuelType)
+			}
+			return sq
+		}).
+		Order(cols.FuelType.OrderAsc(), cols.Jurisdiction.OrderAsc()).
+		Scan(ctx)
+	if err != nil {
+		r.l.Error("failed to list ifta tax rates", zap.Error(err))
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func (r *repository) UpsertTaxRates(
+	ctx context.Context,
+	entities []*ifta.TaxRate,
+) ([]*ifta.TaxRate, error) {
+	if len(entities) == 0 {
+		return entities, nil
+	}
+
+	cols := buncolgen.TaxRateColumns
+	_, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(&entities).
+		On("CONFLICT (organization_id, business_unit_id, year, quarter, jurisdiction, fuel_type) " +
+			"DO UPDATE").
+		Set(cols.Rate.SetExcluded()).
+		Set(cols.SurchargeRate.SetExcluded()).
+		Set(cols.UpdatedAt.SetExcluded()).
+		Set(cols.Version.SetExpr(cols.Version.Qualified() + " + 1")).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		r.l.Error("failed to upsert ifta tax rates", zap.Error(err))
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func (r *repository) ResolveTractorCodes(
+	ctx context.Context,
+	req *repositories.ResolveTractorCodesRequest,
+) (map[string]pulid.ID, error) {
+	if len(req.Codes) == 0 {
+		return map[string]pulid.ID{}, nil
+	}
+
+	cols := buncolgen.TractorColumns
+	rows := make([]*tractor.Tractor, 0, len(req.Codes))
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&rows).
+		Column(cols.ID.Bare(), cols.Code.Bare()).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.TractorScopeTenant(sq, req.TenantInfo).
+				Where(cols.Code.In(), bun.List(req.Codes))
+		}).
+		Scan(ctx)
+	if err != nil {
+		r.l.Error("failed to resolve tractor codes", zap.Error(err))
+		return nil, err
+	}
+
+	ids := make(map[string]pulid.ID, len(rows))
+	for _, row := range rows {
+		ids[row.Code] = row.ID
+	}
+
+	return ids, nil
+}
@@ -202,6 +202,29 @@ func (r *repository) GetByBillingQueueItemID(
 	})
 }
 
+func (r *repository) GetByNumber(
+	ctx context.Context,
+	req repositories.GetInvoiceByNumberRequest,
+) (*invoice.Invoice, error) {
+	var entity invoice.Invoice
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entity).
+		Column("inv.id").
+		Where("inv.number = ?", req.Number).
+		Where("inv.organization_id = ?", req.TenantInfo.OrgID).
+		Where("inv.business_unit_id = ?", req.TenantInfo.BuID).
+		Scan(ctx)
+	if err != nil {
+		return nil, dberror.HandleNotFoundError(err, "Invoice")
+	}
+
+	return r.GetByID(ctx, repositories.GetInvoiceByIDRequest{
+		ID:         entity.ID,
+		TenantInfo: req.TenantInfo,
+	})
+}
+
 func (r *repository) CountPostedReconciliationDiscrepancies(
 	ctx context.Context,
 	req repositories.CountPostedInvoiceReconciliationDiscrepanciesRequest,
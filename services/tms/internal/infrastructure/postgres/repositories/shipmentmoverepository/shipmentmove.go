@@ -500,6 +500,48 @@ func (r *repository) SplitMove(
 	return response, nil
 }
 
+func (r *repository) RelayMove(
+	ctx context.Context,
+	req *repositories.RelayMoveRequest,
+) (*repositories.SplitMoveResponse, error) {
+	var response *repositories.SplitMoveResponse
+	err := r.db.WithTx(ctx, ports.TxOptions{}, func(c context.Context, tx bun.Tx) error {
+		originalMove, err := r.GetByID(c, &repositories.GetMoveByIDRequest{
+			MoveID:            req.MoveID,
+			TenantInfo:        req.TenantInfo,
+			ExpandMoveDetails: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The delivery is rewritten below, so capture where the load was really going
+		// before the first leg is cut short at the relay point.
+		finalDelivery := *originalMove.Stops[1]
+
+		if err = r.shiftSubsequentMoveSequences(c, tx, originalMove); err != nil {
+			return err
+		}
+
+		if err = r.updateOriginalMoveForRelay(c, tx, originalMove, req); err != nil {
+			return err
+		}
+
+		newMove, err := r.insertRelayMove(c, tx, originalMove, &finalDelivery, req)
+		if err != nil {
+			return err
+		}
+
+		response, err = r.loadSplitMoveResponse(c, tx, req.TenantInfo, originalMove.ID, newMove.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func (r *repository) getExistingMoves(
 	ctx context.Context,
 	tx bun.IDB,
@@ -956,6 +998,135 @@ func (r *repository) insertSplitMove(
 	return newMove, nil
 }
 
+func (r *repository) updateOriginalMoveForRelay(
+	ctx context.Context,
+	tx bun.IDB,
+	originalMove *shipment.ShipmentMove,
+	req *repositories.RelayMoveRequest,
+) error {
+	stp := buncolgen.StopColumns
+	deliveryStop := originalMove.Stops[1]
+	deliveryStop.LocationID = req.RelayLocationID
+	deliveryStop.Type = shipment.StopTypeSplitDelivery
+	deliveryStop.Status = shipment.StopStatusNew
+	deliveryStop.ScheduledWindowStart = req.RelayArrival.ScheduledWindowStart
+	deliveryStop.ScheduledWindowEnd = req.RelayArrival.ScheduledWindowEnd
+	deliveryStop.AddressLine = ""
+	deliveryStop.Version++
+
+	results, err := tx.NewUpdate().
+		Model(deliveryStop).
+		Column(
+			stp.LocationID.String(),
+			stp.Status.String(),
+			stp.Type.String(),
+			stp.ScheduledWindowStart.String(),
+			stp.ScheduledWindowEnd.String(),
+			stp.AddressLine.String(),
+			stp.Version.String(),
+			stp.UpdatedAt.String(),
+		).
+		Where(stp.ID.Eq(), deliveryStop.ID).
+		Where(stp.ShipmentMoveID.Eq(), originalMove.ID).
+		Where(stp.OrganizationID.Eq(), originalMove.OrganizationID).
+		Where(stp.BusinessUnitID.Eq(), originalMove.BusinessUnitID).
+		Where(stp.Version.Eq(), deliveryStop.Version-1).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update original relay stop %s: %w", deliveryStop.ID, err)
+	}
+
+	if err = dberror.CheckRowsAffected(results, "Shipment stop", deliveryStop.ID.String()); err != nil {
+		return err
+	}
+
+	// The first leg now ends at the relay point, so it no longer runs the whole
+	// move's miles.
+	sm := buncolgen.ShipmentMoveColumns
+	originalMove.Distance = req.LeadLegDistance
+	originalMove.Version++
+
+	results, err = tx.NewUpdate().
+		Model(originalMove).
+		Column(
+			sm.Distance.String(),
+			sm.Version.String(),
+			sm.UpdatedAt.String(),
+		).
+		Where(sm.ID.Eq(), originalMove.ID).
+		Where(sm.OrganizationID.Eq(), originalMove.OrganizationID).
+		Where(sm.BusinessUnitID.Eq(), originalMove.BusinessUnitID).
+		Where(sm.Version.Eq(), originalMove.Version-1).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update original relay move %s: %w", originalMove.ID, err)
+	}
+
+	return dberror.CheckRowsAffected(results, "Shipment move", originalMove.ID.String())
+}
+
+func (r *repository) insertRelayMove(
+	ctx context.Context,
+	tx bun.IDB,
+	originalMove *shipment.ShipmentMove,
+	finalDelivery *shipment.Stop,
+	req *repositories.RelayMoveRequest,
+) (*shipment.ShipmentMove, error) {
+	newMove := &shipment.ShipmentMove{
+		ID:             pulid.MustNew("sm_"),
+		BusinessUnitID: originalMove.BusinessUnitID,
+		OrganizationID: originalMove.OrganizationID,
+		ShipmentID:     originalMove.ShipmentID,
+		Status:         shipment.MoveStatusNew,
+		Loaded:         true,
+		Sequence:       originalMove.Sequence + 1,
+		Distance:       req.ReliefLegDistance,
+	}
+
+	if _, err := tx.NewInsert().Model(newMove).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("insert relay move %s: %w", newMove.ID, err)
+	}
+
+	newStops := []*shipment.Stop{
+		{
+			ID:                   pulid.MustNew("stp_"),
+			BusinessUnitID:       originalMove.BusinessUnitID,
+			OrganizationID:       originalMove.OrganizationID,
+			ShipmentMoveID:       newMove.ID,
+			LocationID:           req.RelayLocationID,
+			Status:               shipment.StopStatusNew,
+			Type:                 shipment.StopTypeSplitPickup,
+			Sequence:             0,
+			Pieces:               finalDelivery.Pieces,
+			Weight:               finalDelivery.Weight,
+			ScheduledWindowStart: req.RelayDeparture.ScheduledWindowStart,
+			ScheduledWindowEnd:   req.RelayDeparture.ScheduledWindowEnd,
+		},
+		{
+			ID:                   pulid.MustNew("stp_"),
+			BusinessUnitID:       originalMove.BusinessUnitID,
+			OrganizationID:       originalMove.OrganizationID,
+			ShipmentMoveID:       newMove.ID,
+			LocationID:           finalDelivery.LocationID,
+			Status:               shipment.StopStatusNew,
+			Type:                 finalDelivery.Type,
+			ScheduleType:         finalDelivery.ScheduleType,
+			Sequence:             1,
+			Pieces:               finalDelivery.Pieces,
+			Weight:               finalDelivery.Weight,
+			ScheduledWindowStart: finalDelivery.ScheduledWindowStart,
+			ScheduledWindowEnd:   finalDelivery.ScheduledWindowEnd,
+			AddressLine:          finalDelivery.AddressLine,
+		},
+	}
+
+	if _, err := tx.NewInsert().Model(&newStops).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("insert relay move stops for move %s: %w", newMove.ID, err)
+	}
+
+	return newMove, nil
+}
+
 func (r *repository) loadSplitMoveResponse(
 	ctx context.Context,
 	tx bun.IDB,
@@ -350,6 +350,106 @@ func TestSplitMove_CreatesDownstreamMoveAndUpdatesOriginalStop(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestRelayMove_HandsTheLoadOffAtTheRelayPoint(t *testing.T) {
+	t.Parallel()
+
+	repo, _, mock := newTestRepository(t)
+	moveID := pulid.MustNew("sm_")
+	shipmentID := pulid.MustNew("shp_")
+	orgID := pulid.MustNew("org_")
+	buID := pulid.MustNew("bu_")
+	pickupLocationID := pulid.MustNew("loc_")
+	deliveryLocationID := pulid.MustNew("loc_")
+	relayLocationID := pulid.MustNew("loc_")
+	newMoveID := pulid.MustNew("sm_")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .*FROM "shipment_moves" AS "sm".*sm\.id = .*sm\.organization_id = .*sm\.business_unit_id = .*`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_id", "status", "loaded", "sequence", "distance", "version", "created_at", "updated_at",
+		}).AddRow(moveID, buID, orgID, shipmentID, shipment.MoveStatusAssigned, true, 0, nil, 1, 1, 1))
+	mock.ExpectQuery(`SELECT .*FROM "stops" AS "stp".*shipment_move_id.*IN`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "location_id", "status", "type", "sequence", "scheduled_window_start", "scheduled_window_end", "version", "created_at", "updated_at",
+		}).
+			AddRow(pulid.MustNew("stp_"), buID, orgID, moveID, pickupLocationID, shipment.StopStatusNew, shipment.StopTypePickup, 0, 1, 2, 1, 1, 1).
+			AddRow(pulid.MustNew("stp_"), buID, orgID, moveID, deliveryLocationID, shipment.StopStatusNew, shipment.StopTypeDelivery, 1, 3, 4, 1, 1, 1))
+	mock.ExpectQuery(`SELECT .*FROM "assignments" AS "a".*a\.shipment_move_id IN.*a\.organization_id = .*a\.business_unit_id = .*a\.archived_at IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "primary_worker_id", "tractor_id", "trailer_id", "secondary_worker_id", "status", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectQuery(`SELECT .*FROM "carrier_assignments" AS "casn".*casn\.shipment_move_id IN.*casn\.organization_id = .*casn\.business_unit_id = .*casn\.status != `).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "carrier_id", "status", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectExec(`UPDATE .*shipment_moves.*sequence = sequence \+ 1.*`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE .*stops.*location_id.*status.*type.*scheduled_window_start.*address_line.*version.*`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE .*shipment_moves.*"distance" = 412\.5.*"version" = 2.*`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO .*shipment_moves.*387\.5.*RETURNING .*`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow(newMoveID, 1))
+	mock.ExpectQuery(`INSERT INTO .*stops.*RETURNING .*`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow(pulid.MustNew("stp_"), 1).AddRow(pulid.MustNew("stp_"), 1))
+	mock.ExpectQuery(`SELECT .*FROM "shipment_moves" AS "sm".*sm\.id = .*`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_id", "status", "loaded", "sequence", "version", "created_at", "updated_at",
+		}).AddRow(moveID, buID, orgID, shipmentID, shipment.MoveStatusAssigned, true, 0, 1, 1, 1))
+	mock.ExpectQuery(`SELECT .*FROM "stops" AS "stp".*shipment_move_id.*IN`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "location_id", "status", "type", "sequence", "scheduled_window_start", "scheduled_window_end", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectQuery(`SELECT .*FROM "assignments" AS "a".*a\.shipment_move_id IN.*a\.organization_id = .*a\.business_unit_id = .*a\.archived_at IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "primary_worker_id", "tractor_id", "trailer_id", "secondary_worker_id", "status", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectQuery(`SELECT .*FROM "carrier_assignments" AS "casn".*casn\.shipment_move_id IN.*casn\.organization_id = .*casn\.business_unit_id = .*casn\.status != `).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "carrier_id", "status", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectQuery(`SELECT .*FROM "shipment_moves" AS "sm".*sm\.id = .*`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_id", "status", "loaded", "sequence", "version", "created_at", "updated_at",
+		}).AddRow(newMoveID, buID, orgID, shipmentID, shipment.MoveStatusNew, true, 1, 1, 1, 1))
+	mock.ExpectQuery(`SELECT .*FROM "stops" AS "stp".*shipment_move_id.*IN`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "location_id", "status", "type", "sequence", "scheduled_window_start", "scheduled_window_end", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectQuery(`SELECT .*FROM "assignments" AS "a".*a\.shipment_move_id IN.*a\.organization_id = .*a\.business_unit_id = .*a\.archived_at IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "primary_worker_id", "tractor_id", "trailer_id", "secondary_worker_id", "status", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectQuery(`SELECT .*FROM "carrier_assignments" AS "casn".*casn\.shipment_move_id IN.*casn\.organization_id = .*casn\.business_unit_id = .*casn\.status != `).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "business_unit_id", "organization_id", "shipment_move_id", "carrier_id", "status", "version", "created_at", "updated_at",
+		}))
+	mock.ExpectCommit()
+
+	response, err := repo.RelayMove(t.Context(), &repositories.RelayMoveRequest{
+		TenantInfo: pagination.TenantInfo{
+			OrgID: orgID,
+			BuID:  buID,
+		},
+		MoveID:          moveID,
+		RelayLocationID: relayLocationID,
+		RelayArrival: repositories.SplitStopTimes{
+			ScheduledWindowStart: 2,
+		},
+		RelayDeparture: repositories.SplitStopTimes{
+			ScheduledWindowStart: 3,
+		},
+		LeadLegDistance:   new(412.5),
+		ReliefLegDistance: new(387.5),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, response)
+	require.NotNil(t, response.OriginalMove)
+	require.NotNil(t, response.NewMove)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func newShipmentEntity() *shipment.Shipment {
 	stopOne := &shipment.Stop{
 		LocationID:           pulid.MustNew("loc_"),
@@ -68,6 +68,20 @@ func (r *repository) UpsertVehiclePositions(
 	if err != nil {
 		return fmt.Errorf("upsert vehicle positions: %w", err)
 	}
+
+	// The same fix is polled again until the vehicle reports a new one, so the
+	// history keeps the first copy and ignores the rest.
+	history := make([]*telematics.VehiclePositionHistory, 0, len(positions))
+	for _, position := range positions {
+		history = append(history, telematics.HistoryFromPosition(position))
+	}
+	if _, err = r.db.DB().NewInsert().
+		Model(&history).
+		On("CONFLICT DO NOTHING").
+		Exec(ctx); err != nil {
+		return fmt.Errorf("record vehicle position history: %w", err)
+	}
+
 	return nil
 }
 
@@ -489,6 +503,7 @@ func (r *repository) ListWorkerMappings(
 		TableExpr(buncolgen.WorkerTable.Name+" AS "+buncolgen.WorkerTable.Alias).
 		ColumnExpr(cols.ID.As("worker_id")).
 		ColumnExpr(cols.ExternalID.As("external_id")).
+		ColumnExpr(buncolgen.Coalesce(cols.TelematicsProvider, "''", "provider")).
 		ColumnExpr(cols.FirstName.As("first_name")).
 		ColumnExpr(cols.LastName.As("last_name")).
 		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
@@ -515,6 +530,7 @@ func (r *repository) ListTractorMappings(
 		TableExpr(buncolgen.TractorTable.Name+" AS "+buncolgen.TractorTable.Alias).
 		ColumnExpr(cols.ID.As("tractor_id")).
 		ColumnExpr(buncolgen.Coalesce(cols.ExternalID, "''", "external_id")).
+		ColumnExpr(buncolgen.Coalesce(cols.TelematicsProvider, "''", "provider")).
 		ColumnExpr(buncolgen.Coalesce(cols.Vin, "''", "vin")).
 		ColumnExpr(cols.Code.As("code")).
 		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
@@ -544,6 +560,7 @@ func (r *repository) AssignTractorExternalIDs(
 			result, execErr := tx.NewUpdate().
 				Model((*tractor.Tractor)(nil)).
 				Set(cols.ExternalID.Set(), assignment.ExternalID).
+				Set(cols.TelematicsProvider.Set(), req.Provider).
 				WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
 					return uq.
 						Where(cols.OrganizationID.Eq(), req.TenantInfo.OrgID).
@@ -579,6 +596,7 @@ func (r *repository) ListTrailerMappings(
 		TableExpr(buncolgen.TrailerTable.Name+" AS "+buncolgen.TrailerTable.Alias).
 		ColumnExpr(cols.ID.As("trailer_id")).
 		ColumnExpr(buncolgen.Coalesce(cols.ExternalID, "''", "external_id")).
+		ColumnExpr(buncolgen.Coalesce(cols.TelematicsProvider, "''", "provider")).
 		ColumnExpr(buncolgen.Coalesce(cols.Vin, "''", "vin")).
 		ColumnExpr(cols.Code.As("code")).
 		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
@@ -608,6 +626,7 @@ func (r *repository) AssignTrailerExternalIDs(
 			result, execErr := tx.NewUpdate().
 				Model((*trailer.Trailer)(nil)).
 				Set(cols.ExternalID.Set(), assignment.ExternalID).
+				Set(cols.TelematicsProvider.Set(), req.Provider).
 				WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
 					return uq.
 						Where(cols.OrganizationID.Eq(), req.TenantInfo.OrgID).
@@ -724,6 +743,7 @@ func (r *repository) CleanupExpired(
 	eventsOlderThan int64,
 	violationsOlderThan int64,
 	hosLogsOlderThan int64,
+	positionHistoryOlderThan int64,
 ) (int64, error) {
 	total := int64(0)
 
@@ -760,5 +780,16 @@ func (r *repository) CleanupExpired(
 		total += rows
 	}
 
+	historyResult, err := r.db.DB().NewDelete().
+		Model((*telematics.VehiclePositionHistory)(nil)).
+		Where(buncolgen.VehiclePositionHistoryColumns.RecordedAt.Lt(), positionHistoryOlderThan).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cleanup vehicle position history: %w", err)
+	}
+	if rows, raErr := historyResult.RowsAffected(); raErr == nil {
+		total += rows
+	}
+
 	return total, nil
 }
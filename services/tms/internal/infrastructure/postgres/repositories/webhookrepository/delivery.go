@@ -0,0 +1,293 @@
+package webhookrepository
+
+import (
+	"context"
+
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/pkg/dbhelper"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/pkg/querybuilder"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type DeliveryParams struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type deliveryRepository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func NewDeliveryRepository(p DeliveryParams) repositories.WebhookDeliveryRepository {
+	return &deliveryRepository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.webhook-delivery-repository"),
+	}
+}
+
+func (r *deliveryRepository) CreateIfAbsent(
+	ctx context.Context,
+	entity *webhook.Delivery,
+) (bool, error) {
+	log := r.l.With(
+		zap.String("operation", "CreateIfAbsent"),
+		zap.String("subscriptionID", entity.SubscriptionID.String()),
+	)
+
+	result, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		On("CONFLICT (subscription_id, idempotency_key) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to create webhook delivery", zap.Error(err))
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *deliveryRepository) GetByID(
+	ctx context.Context,
+	req repositories.GetWebhookDeliveryByIDRequest,
+) (*webhook.Delivery, error) {
+	log := r.l.With(
+		zap.String("operation", "GetByID"),
+		zap.String("id", req.ID.String()),
+	)
+
+	entity := new(webhook.Delivery)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.DeliveryScopeTenant(sq, req.TenantInfo).
+				Where(buncolgen.DeliveryColumns.ID.Eq(), req.ID)
+		}).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to get webhook delivery", zap.Error(err))
+		return nil, dberror.HandleNotFoundError(err, "Webhook delivery")
+	}
+
+	return entity, nil
+}
+
+func (r *deliveryRepository) GetByIdempotencyKey(
+	ctx context.Context,
+	req repositories.GetWebhookDeliveryByIdempotencyKeyRequest,
+) (*webhook.Delivery, error) {
+	log := r.l.With(
+		zap.String("operation", "GetByIdempotencyKey"),
+		zap.String("subscriptionID", req.SubscriptionID.String()),
+	)
+
+	cols := buncolgen.DeliveryColumns
+	entity := new(webhook.Delivery)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return buncolgen.DeliveryScopeTenant(sq, req.TenantInfo).
+				Where(cols.SubscriptionID.Eq(), req.SubscriptionID).
+				Where(cols.IdempotencyKey.Eq(), req.IdempotencyKey)
+		}).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to get webhook delivery by idempotency key", zap.Error(err))
+		return nil, dberror.HandleNotFoundError(err, "Webhook delivery")
+	}
+
+	return entity, nil
+}
+
+func (r *deliveryRepository) Update(
+	ctx context.Context,
+	entity *webhook.Delivery,
+) (*webhook.Delivery, error) {
+	log := r.l.With(
+		zap.String("operation", "Update"),
+		zap.String("id", entity.ID.String()),
+	)
+
+	cols := buncolgen.DeliveryColumns
+	result, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model(entity).
+		Column(
+			cols.Status.Bare(),
+			cols.AttemptCount.Bare(),
+			cols.LastStatusCode.Bare(),
+			cols.LastError.Bare(),
+			cols.LastAttemptAt.Bare(),
+			cols.DeliveredAt.Bare(),
+			cols.UpdatedAt.Bare(),
+		).
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to update webhook delivery", zap.Error(err))
+		return nil, err
+	}
+
+	if err = dberror.CheckRowsAffected(result, "Webhook delivery", entity.ID.String()); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *deliveryRepository) List(
+	ctx context.Context,
+	req *repositories.ListWebhookDeliveriesRequest,
+) (*pagination.ListResult[*webhook.Delivery], error) {
+	log := r.l.With(zap.String("operation", "List"))
+
+	cols := buncolgen.DeliveryColumns
+	entities := make([]*webhook.Delivery, 0, req.Filter.Pagination.SafeLimit())
+	total, err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Apply(func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq = querybuilder.ApplyFilters(
+				sq,
+				buncolgen.DeliveryTable.Alias,
+				req.Filter,
+				(*webhook.Delivery)(nil),
+			)
+			return sq.Apply(buncolgen.DeliveryApplyTenant(req.Filter.TenantInfo)).
+				Where(cols.SubscriptionID.Eq(), req.SubscriptionID).
+				Limit(req.Filter.Pagination.SafeLimit()).
+				Offset(req.Filter.Pagination.SafeOffset())
+		}).ScanAndCount(ctx)
+	if err != nil {
+		log.Error("failed to list webhook deliveries", zap.Error(err))
+		return nil, err
+	}
+
+	return &pagination.ListResult[*webhook.Delivery]{
+		Items: entities,
+		Total: total,
+	}, nil
+}
+
+func (r *deliveryRepository) ListConnection(
+	ctx context.Context,
+	req *repositories.ListWebhookDeliveryConnectionRequest,
+) (*pagination.CursorListResult[*webhook.Delivery], error) {
+	log := r.l.With(zap.String("operation", "ListConnection"))
+
+	cols := buncolgen.DeliveryColumns
+	dba := r.db.DBForContext(ctx)
+	total, err := dba.
+		NewSelect().
+		Model((*webhook.Delivery)(nil)).
+		Apply(func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return querybuilder.ApplyFiltersWithoutSort(
+				sq,
+				buncolgen.DeliveryTable.Alias,
+				req.Filter,
+				(*webhook.Delivery)(nil),
+			).Where(cols.SubscriptionID.Eq(), req.SubscriptionID)
+		}).
+		Count(ctx)
+	if err != nil {
+		log.Error("failed to count webhook deliveries", zap.Error(err))
+		return nil, err
+	}
+
+	result, err := dbhelper.CursorList(
+		ctx,
+		dbhelper.CursorListParams[*webhook.Delivery]{
+			Filter:     req.Filter,
+			Cursor:     req.Cursor,
+			TotalCount: &total,
+			Query: func(entities *[]*webhook.Delivery) *bun.SelectQuery {
+				return dba.
+					NewSelect().
+					Model(entities).
+					ColumnExpr(buncolgen.DeliveryTable.All())
+			},
+			Apply: func(sq *bun.SelectQuery) (*bun.SelectQuery, error) {
+				sq, err := querybuilder.ApplyCursorFilters(
+					sq,
+					buncolgen.DeliveryTable.Alias,
+					req.Filter,
+					req.Cursor,
+					(*webhook.Delivery)(nil),
+				)
+				if err != nil {
+					return nil, err
+				}
+				return sq.Where(cols.SubscriptionID.Eq(), req.SubscriptionID), nil
+			},
+		})
+	if err != nil {
+		log.Error("failed to scan webhook deliveries", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *deliveryRepository) CreateAttempt(
+	ctx context.Context,
+	entity *webhook.DeliveryAttempt,
+) error {
+	log := r.l.With(
+		zap.String("operation", "CreateAttempt"),
+		zap.String("deliveryID", entity.DeliveryID.String()),
+	)
+
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		Exec(ctx); err != nil {
+		log.Error("failed to create webhook delivery attempt", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *deliveryRepository) ListAttempts(
+	ctx context.Context,
+	req repositories.GetWebhookDeliveryByIDRequest,
+) ([]*webhook.DeliveryAttempt, error) {
+	log := r.l.With(
+		zap.String("operation", "ListAttempts"),
+		zap.String("deliveryID", req.ID.String()),
+	)
+
+	cols := buncolgen.DeliveryAttemptColumns
+	entities := make([]*webhook.DeliveryAttempt, 0)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Apply(buncolgen.DeliveryAttemptApplyTenant(req.TenantInfo)).
+		Where(cols.DeliveryID.Eq(), req.ID).
+		Order(cols.AttemptedAt.OrderDesc(), cols.AttemptNumber.OrderDesc()).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to list webhook delivery attempts", zap.Error(err))
+		return nil, err
+	}
+
+	return entities, nil
+}
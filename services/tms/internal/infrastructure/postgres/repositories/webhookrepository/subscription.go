@@ -0,0 +1,375 @@
+package webhookrepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emoss08/trenova/internal/core/domain/webhook"
+	"github.com/emoss08/trenova/internal/core/ports/repositories"
+	"github.com/emoss08/trenova/internal/infrastructure/postgres"
+	"github.com/emoss08/trenova/pkg/buncolgen"
+	"github.com/emoss08/trenova/pkg/dberror"
+	"github.com/emoss08/trenova/pkg/dbhelper"
+	"github.com/emoss08/trenova/pkg/pagination"
+	"github.com/emoss08/trenova/pkg/querybuilder"
+	"github.com/emoss08/trenova/shared/pulid"
+	"github.com/uptrace/bun"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const autoDisabledReason = "Disabled after repeated delivery failures"
+
+type SubscriptionParams struct {
+	fx.In
+
+	DB     *postgres.Connection
+	Logger *zap.Logger
+}
+
+type subscriptionRepository struct {
+	db *postgres.Connection
+	l  *zap.Logger
+}
+
+func NewSubscriptionRepository(
+	p SubscriptionParams,
+) repositories.WebhookSubscriptionRepository {
+	return &subscriptionRepository{
+		db: p.DB,
+		l:  p.Logger.Named("postgres.webhook-subscription-repository"),
+	}
+}
+
+func applyAPIKeyScope(q *bun.SelectQuery, apiKeyID pulid.ID) *bun.SelectQuery {
+	if apiKeyID.IsNil() {
+		return q
+	}
+	return q.Where(buncolgen.SubscriptionColumns.APIKeyID.Eq(), apiKeyID)
+}
+
+func (r *subscriptionRepository) filterQuery(
+	q *bun.SelectQuery,
+	req *repositories.ListWebhookSubscriptionsRequest,
+) *bun.SelectQuery {
+	q = querybuilder.ApplyFilters(
+		q,
+		buncolgen.SubscriptionTable.Alias,
+		req.Filter,
+		(*webhook.Subscription)(nil),
+	)
+	q = applyAPIKeyScope(q.Apply(buncolgen.SubscriptionApplyTenant(req.Filter.TenantInfo)), req.APIKeyID)
+
+	return q.Limit(req.Filter.Pagination.SafeLimit()).Offset(req.Filter.Pagination.SafeOffset())
+}
+
+func (r *subscriptionRepository) List(
+	ctx context.Context,
+	req *repositories.ListWebhookSubscriptionsRequest,
+) (*pagination.ListResult[*webhook.Subscription], error) {
+	log := r.l.With(zap.String("operation", "List"))
+
+	entities := make([]*webhook.Subscription, 0, req.Filter.Pagination.SafeLimit())
+	total, err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Apply(func(sq *bun.SelectQuery) *bun.SelectQuery {
+			return r.filterQuery(sq, req)
+		}).ScanAndCount(ctx)
+	if err != nil {
+		log.Error("failed to list webhook subscriptions", zap.Error(err))
+		return nil, err
+	}
+
+	return &pagination.ListResult[*webhook.Subscription]{
+		Items: entities,
+		Total: total,
+	}, nil
+}
+
+func (r *subscriptionRepository) ListConnection(
+	ctx context.Context,
+	req *repositories.ListWebhookSubscriptionConnectionRequest,
+) (*pagination.CursorListResult[*webhook.Subscription], error) {
+	log := r.l.With(zap.String("operation", "ListConnection"))
+
+	dba := r.db.DBForContext(ctx)
+	total, err := dba.
+		NewSelect().
+		Model((*webhook.Subscription)(nil)).
+		Apply(func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq = querybuilder.ApplyFiltersWithoutSort(
+				sq,
+				buncolgen.SubscriptionTable.Alias,
+				req.Filter,
+				(*webhook.Subscription)(nil),
+			)
+			return applyAPIKeyScope(sq, req.APIKeyID)
+		}).
+		Count(ctx)
+	if err != nil {
+		log.Error("failed to count webhook subscriptions", zap.Error(err))
+		return nil, err
+	}
+
+	result, err := dbhelper.CursorList(
+		ctx,
+		dbhelper.CursorListParams[*webhook.Subscription]{
+			Filter:     req.Filter,
+			Cursor:     req.Cursor,
+			TotalCount: &total,
+			Query: func(entities *[]*webhook.Subscription) *bun.SelectQuery {
+				return dba.
+					NewSelect().
+					Model(entities).
+					ColumnExpr(buncolgen.SubscriptionTable.All())
+			},
+			Apply: func(sq *bun.SelectQuery) (*bun.SelectQuery, error) {
+				sq, err := querybuilder.ApplyCursorFilters(
+					sq,
+					buncolgen.SubscriptionTable.Alias,
+					req.Filter,
+					req.Cursor,
+					(*webhook.Subscription)(nil),
+				)
+				if err != nil {
+					return nil, err
+				}
+				return applyAPIKeyScope(sq, req.APIKeyID), nil
+			},
+		})
+	if err != nil {
+		log.Error("failed to scan webhook subscriptions", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *subscriptionRepository) GetByID(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) (*webhook.Subscription, error) {
+	log := r.l.With(
+		zap.String("operation", "GetByID"),
+		zap.String("id", req.ID.String()),
+	)
+
+	entity := new(webhook.Subscription)
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(entity).
+		WhereGroup(" AND ", func(sq *bun.SelectQuery) *bun.SelectQuery {
+			sq = buncolgen.SubscriptionScopeTenant(sq, req.TenantInfo).
+				Where(buncolgen.SubscriptionColumns.ID.Eq(), req.ID)
+			return applyAPIKeyScope(sq, req.APIKeyID)
+		}).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to get webhook subscription", zap.Error(err))
+		return nil, dberror.HandleNotFoundError(err, "Webhook subscription")
+	}
+
+	return entity, nil
+}
+
+func (r *subscriptionRepository) Create(
+	ctx context.Context,
+	entity *webhook.Subscription,
+) (*webhook.Subscription, error) {
+	log := r.l.With(zap.String("operation", "Create"))
+
+	if _, err := r.db.DBForContext(ctx).
+		NewInsert().
+		Model(entity).
+		Returning("*").
+		Exec(ctx); err != nil {
+		log.Error("failed to create webhook subscription", zap.Error(err))
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// Update writes every caller-controlled column, including zero values, so
+// enabling a subscription can clear its disabled fields. LastDeliveredAt is
+// left to RecordDeliverySuccess.
+func (r *subscriptionRepository) Update(
+	ctx context.Context,
+	entity *webhook.Subscription,
+) (*webhook.Subscription, error) {
+	log := r.l.With(
+		zap.String("operation", "Update"),
+		zap.String("id", entity.ID.String()),
+	)
+
+	ov := entity.Version
+	entity.Version++
+	cols := buncolgen.SubscriptionColumns
+
+	result, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model(entity).
+		Column(
+			cols.Name.Bare(),
+			cols.Description.Bare(),
+			cols.URL.Bare(),
+			cols.CustomerID.Bare(),
+			cols.EventTypes.Bare(),
+			cols.Status.Bare(),
+			cols.SigningSecret.Bare(),
+			cols.SecretHint.Bare(),
+			cols.PreviousSigningSecret.Bare(),
+			cols.PreviousSecretExpiresAt.Bare(),
+			cols.SecretRotatedAt.Bare(),
+			cols.ConsecutiveFailures.Bare(),
+			cols.DisabledAt.Bare(),
+			cols.DisabledReason.Bare(),
+			cols.Version.Bare(),
+			cols.UpdatedAt.Bare(),
+		).
+		WherePK().
+		Where(cols.Version.Eq(), ov).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to update webhook subscription", zap.Error(err))
+		return nil, err
+	}
+
+	if err = dberror.CheckRowsAffected(result, "Webhook subscription", entity.ID.String()); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *subscriptionRepository) Delete(
+	ctx context.Context,
+	req repositories.GetWebhookSubscriptionByIDRequest,
+) error {
+	log := r.l.With(
+		zap.String("operation", "Delete"),
+		zap.String("id", req.ID.String()),
+	)
+
+	cols := buncolgen.SubscriptionColumns
+	result, err := r.db.DBForContext(ctx).
+		NewDelete().
+		Model((*webhook.Subscription)(nil)).
+		WhereGroup(" AND ", func(dq *bun.DeleteQuery) *bun.DeleteQuery {
+			dq = buncolgen.SubscriptionScopeTenantDelete(dq, req.TenantInfo).
+				Where(cols.ID.Eq(), req.ID)
+			if req.APIKeyID.IsNotNil() {
+				dq = dq.Where(cols.APIKeyID.Eq(), req.APIKeyID)
+			}
+			return dq
+		}).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to delete webhook subscription", zap.Error(err))
+		return err
+	}
+
+	return dberror.CheckRowsAffected(result, "Webhook subscription", req.ID.String())
+}
+
+func (r *subscriptionRepository) FindForEvent(
+	ctx context.Context,
+	req repositories.FindWebhookSubscriptionsForEventRequest,
+) ([]*webhook.Subscription, error) {
+	log := r.l.With(
+		zap.String("operation", "FindForEvent"),
+		zap.String("eventType", string(req.EventType)),
+	)
+
+	entities := make([]*webhook.Subscription, 0)
+	cols := buncolgen.SubscriptionColumns
+	err := r.db.DBForContext(ctx).
+		NewSelect().
+		Model(&entities).
+		Apply(buncolgen.SubscriptionApplyTenant(req.TenantInfo)).
+		Where(cols.Status.Eq(), webhook.StatusActive).
+		Where(cols.EventTypes.Expr("{} @> ?::jsonb"), `["`+string(req.EventType)+`"]`).
+		Scan(ctx)
+	if err != nil {
+		log.Error("failed to find webhook subscriptions for event", zap.Error(err))
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+func (r *subscriptionRepository) RecordDeliveryFailure(
+	ctx context.Context,
+	req repositories.RecordWebhookDeliveryFailureRequest,
+) (*webhook.Subscription, error) {
+	log := r.l.With(
+		zap.String("operation", "RecordDeliveryFailure"),
+		zap.String("id", req.ID.String()),
+	)
+
+	entity := new(webhook.Subscription)
+	cols := buncolgen.SubscriptionColumns
+	// SET expressions read the row as it was, so "disabling" below means the
+	// increment that reaches the threshold on a still-active subscription.
+	disabling := "status = 'Active' AND consecutive_failures + 1 >= ?"
+
+	_, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model(entity).
+		WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
+			return buncolgen.SubscriptionScopeTenantUpdate(uq, req.TenantInfo).
+				Where(cols.ID.Eq(), req.ID)
+		}).
+		Set(cols.ConsecutiveFailures.Inc(1)).
+		Set(cols.Status.SetExpr("CASE WHEN "+disabling+" THEN ? ELSE {} END"),
+			req.DisableAfter, webhook.StatusDisabled).
+		Set(cols.DisabledAt.SetExpr("CASE WHEN "+disabling+" THEN ? ELSE {} END"),
+			req.DisableAfter, req.FailedAt).
+		Set(cols.DisabledReason.SetExpr("CASE WHEN "+disabling+" THEN ? ELSE {} END"),
+			req.DisableAfter, autoDisabledReason).
+		Set(cols.Version.SetExpr("CASE WHEN "+disabling+" THEN {} + 1 ELSE {} END"),
+			req.DisableAfter).
+		Set(cols.UpdatedAt.Set(), req.FailedAt).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to record webhook delivery failure", zap.Error(err))
+		return nil, dberror.HandleNotFoundError(err, "Webhook subscription")
+	}
+
+	if entity.ID.IsNil() {
+		return nil, dberror.HandleNotFoundError(sql.ErrNoRows, "Webhook subscription")
+	}
+
+	return entity, nil
+}
+
+func (r *subscriptionRepository) RecordDeliverySuccess(
+	ctx context.Context,
+	req repositories.RecordWebhookDeliverySuccessRequest,
+) error {
+	log := r.l.With(
+		zap.String("operation", "RecordDeliverySuccess"),
+		zap.String("id", req.ID.String()),
+	)
+
+	cols := buncolgen.SubscriptionColumns
+	_, err := r.db.DBForContext(ctx).
+		NewUpdate().
+		Model((*webhook.Subscription)(nil)).
+		WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
+			return buncolgen.SubscriptionScopeTenantUpdate(uq, req.TenantInfo).
+				Where(cols.ID.Eq(), req.ID)
+		}).
+		Set(cols.ConsecutiveFailures.Set(), 0).
+		Set(cols.LastDeliveredAt.Set(), req.DeliveredAt).
+		Exec(ctx)
+	if err != nil {
+		log.Error("failed to record webhook delivery success", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
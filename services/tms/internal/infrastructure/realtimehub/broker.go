@@ -0,0 +1,155 @@
+package realtimehub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	topicPrefix       = "realtime:channel:"
+	presenceKeyPrefix = "realtime:presence:"
+)
+
+// Broker carries frames between API replicas and holds presence state that
+// every replica must agree on.
+type Broker interface {
+	Publish(ctx context.Context, channel string, frame []byte) error
+	// Listen delivers every published frame until ctx is done.
+	Listen(ctx context.Context, deliver func(channel string, frame []byte)) error
+	SetMember(ctx context.Context, channel string, member *Member, ttl time.Duration) error
+	// RemoveMember reports whether the member was still present, so only one
+	// caller announces a leave.
+	RemoveMember(ctx context.Context, channel, connectionID string) (bool, error)
+	Members(ctx context.Context, channel string, now time.Time) ([]*Member, error)
+	// PruneMembers removes expired members and returns the ones this call
+	// removed.
+	PruneMembers(ctx context.Context, channel string, now time.Time) ([]*Member, error)
+}
+
+type redisBroker struct {
+	client *redis.Client
+}
+
+func NewRedisBroker(client *redis.Client) Broker {
+	return &redisBroker{client: client}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, channel string, frame []byte) error {
+	return b.client.Publish(ctx, topicPrefix+channel, frame).Err()
+}
+
+func (b *redisBroker) Listen(
+	ctx context.Context,
+	deliver func(channel string, frame []byte),
+) error {
+	pubsub := b.client.PSubscribe(ctx, topicPrefix+"*")
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			deliver(strings.TrimPrefix(msg.Channel, topicPrefix), []byte(msg.Payload))
+		}
+	}
+}
+
+func (b *redisBroker) SetMember(
+	ctx context.Context,
+	channel string,
+	member *Member,
+	ttl time.Duration,
+) error {
+	raw, err := sonic.Marshal(member)
+	if err != nil {
+		return fmt.Errorf("marshal presence member: %w", err)
+	}
+
+	key := presenceKeyPrefix + channel
+	pipe := b.client.TxPipeline()
+	pipe.HSet(ctx, key, member.ConnectionID, raw)
+	pipe.PExpire(ctx, key, 2*ttl)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (b *redisBroker) RemoveMember(
+	ctx context.Context,
+	channel, connectionID string,
+) (bool, error) {
+	removed, err := b.client.HDel(ctx, presenceKeyPrefix+channel, connectionID).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return removed > 0, nil
+}
+
+func (b *redisBroker) Members(
+	ctx context.Context,
+	channel string,
+	now time.Time,
+) ([]*Member, error) {
+	live, _, err := b.loadMembers(ctx, channel, now)
+	return live, err
+}
+
+func (b *redisBroker) PruneMembers(
+	ctx context.Context,
+	channel string,
+	now time.Time,
+) ([]*Member, error) {
+	_, expired, err := b.loadMembers(ctx, channel, now)
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := make([]*Member, 0, len(expired))
+	for _, member := range expired {
+		removed, removeErr := b.RemoveMember(ctx, channel, member.ConnectionID)
+		if removeErr != nil {
+			return pruned, removeErr
+		}
+		if removed {
+			pruned = append(pruned, member)
+		}
+	}
+
+	return pruned, nil
+}
+
+func (b *redisBroker) loadMembers(
+	ctx context.Context,
+	channel string,
+	now time.Time,
+) (live, expired []*Member, err error) {
+	entries, err := b.client.HGetAll(ctx, presenceKeyPrefix+channel).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nowMillis := now.UnixMilli()
+	for _, raw := range entries {
+		member := new(Member)
+		if err = sonic.UnmarshalString(raw, member); err != nil {
+			continue
+		}
+		if member.ExpiresAt <= nowMillis {
+			expired = append(expired, member)
+			continue
+		}
+		live = append(live, member)
+	}
+
+	return live, expired, nil
+}
@@ -0,0 +1,419 @@
+package realtimehub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	listenRetryDelay = time.Second
+	brokerTimeout    = 5 * time.Second
+)
+
+type Params struct {
+	fx.In
+
+	LC     fx.Lifecycle
+	Config *config.Config
+	Logger *zap.Logger
+	Redis  *redis.Client
+}
+
+// subscriber is a local connection, WebSocket or SSE, that frames for its
+// channels are forwarded to.
+type subscriber interface {
+	deliver(frame []byte)
+	shutdown()
+}
+
+// Hub is the builtin realtime provider. Every replica keeps its own
+// connections and forwards frames the broker hands it; nothing is delivered
+// directly between local connections, so a replica is never a special case.
+type Hub struct {
+	l              *zap.Logger
+	broker         Broker
+	enabled        bool
+	signingKey     []byte
+	presenceTTL    time.Duration
+	maxConnections int
+	originPatterns []string
+	anyOrigin      bool
+	now            func() time.Time
+
+	mu       sync.RWMutex
+	channels map[string]map[subscriber]struct{}
+	conns    map[subscriber]struct{}
+
+	startOnce sync.Once
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func New(p Params) *Hub {
+	hub := newHub(p.Logger, NewRedisBroker(p.Redis), p.Config)
+
+	p.LC.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			hub.stop(ctx)
+			return nil
+		},
+	})
+
+	return hub
+}
+
+func newHub(logger *zap.Logger, broker Broker, cfg *config.Config) *Hub {
+	realtimeCfg := cfg.GetRealtimeConfig()
+	patterns, anyOrigin := originPatterns(cfg.Server.CORS.AllowedOrigins)
+
+	return &Hub{
+		l:              logger.Named("infrastructure.realtimehub"),
+		broker:         broker,
+		enabled:        realtimeCfg.IsBuiltin(),
+		signingKey:     deriveSigningKey(cfg.Security.Session.Secret),
+		presenceTTL:    realtimeCfg.GetPresenceTTL(),
+		maxConnections: realtimeCfg.MaxConnections,
+		originPatterns: patterns,
+		anyOrigin:      anyOrigin,
+		now:            time.Now,
+		channels:       make(map[string]map[subscriber]struct{}),
+		conns:          make(map[subscriber]struct{}),
+		done:           make(chan struct{}),
+	}
+}
+
+// Enabled reports whether the builtin provider is the configured one; the
+// endpoints refuse connections otherwise.
+func (h *Hub) Enabled() bool {
+	return h.enabled
+}
+
+// CreateToken mints a token the WebSocket and SSE endpoints accept.
+func (h *Hub) CreateToken(
+	clientID string,
+	capability Capability,
+	ttl time.Duration,
+) (string, error) {
+	return issueToken(h.signingKey, clientID, capability, h.now(), ttl)
+}
+
+// Publish sends a server event to every subscriber of channel on every replica.
+func (h *Hub) Publish(ctx context.Context, channel, name string, data any) error {
+	if err := ValidateChannelName(channel); err != nil {
+		return err
+	}
+
+	raw, err := sonic.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal realtime message: %w", err)
+	}
+
+	return h.publishFrame(ctx, &ServerFrame{
+		Action:  ActionMessage,
+		Channel: channel,
+		Name:    name,
+		Data:    raw,
+	})
+}
+
+// Subscribe streams the message and presence frames published on channel, from
+// any replica, to a consumer inside this process until ctx is done. It works
+// whichever provider clients use, so server-side consumers need not care. A
+// consumer that falls behind is cut off by closing the returned channel, the
+// same as a slow client.
+func (h *Hub) Subscribe(ctx context.Context, channel string) (<-chan *ServerFrame, error) {
+	if err := ValidateChannelName(channel); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	local := &stream{send: make(chan []byte, sendBufferSize), cancel: cancel}
+
+	h.mu.Lock()
+	h.conns[local] = struct{}{}
+	h.mu.Unlock()
+	h.subscribe(channel, local)
+
+	frames := make(chan *ServerFrame)
+	go func() {
+		defer close(frames)
+		defer h.unregister(local)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case raw := <-local.send:
+				frame := new(ServerFrame)
+				if err := sonic.Unmarshal(raw, frame); err != nil {
+					h.l.Warn("dropping undecodable realtime frame",
+						zap.String("channel", channel), zap.Error(err))
+					continue
+				}
+
+				select {
+				case frames <- frame:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return frames, nil
+}
+
+func (h *Hub) publishFrame(ctx context.Context, frame *ServerFrame) error {
+	raw, err := sonic.Marshal(frame)
+	if err != nil {
+		return fmt.Errorf("marshal realtime frame: %w", err)
+	}
+
+	if err = h.broker.Publish(ctx, frame.Channel, raw); err != nil {
+		return fmt.Errorf("publish realtime frame: %w", err)
+	}
+
+	return nil
+}
+
+func (h *Hub) register(sub subscriber) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.maxConnections > 0 && len(h.conns) >= h.maxConnections {
+		return false
+	}
+
+	h.conns[sub] = struct{}{}
+	return true
+}
+
+func (h *Hub) unregister(sub subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.conns, sub)
+	for channel, subs := range h.channels {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(h.channels, channel)
+		}
+	}
+}
+
+func (h *Hub) subscribe(channel string, sub subscriber) {
+	h.startOnce.Do(h.start)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.channels[channel]
+	if !ok {
+		subs = make(map[subscriber]struct{})
+		h.channels[channel] = subs
+	}
+	subs[sub] = struct{}{}
+}
+
+func (h *Hub) unsubscribe(channel string, sub subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.channels[channel]
+	if !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.channels, channel)
+	}
+}
+
+func (h *Hub) dispatch(channel string, frame []byte) {
+	h.mu.RLock()
+	subs := make([]subscriber, 0, len(h.channels[channel]))
+	for sub := range h.channels[channel] {
+		subs = append(subs, sub)
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.deliver(frame)
+	}
+}
+
+func (h *Hub) localChannels() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	channels := make([]string, 0, len(h.channels))
+	for channel := range h.channels {
+		channels = append(channels, channel)
+	}
+
+	return channels
+}
+
+// start begins listening on the first local subscription, so processes that
+// only publish, like the worker, never hold a broker subscription.
+func (h *Hub) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.cancel = cancel
+
+	go h.run(ctx)
+}
+
+func (h *Hub) run(ctx context.Context) {
+	defer close(h.done)
+
+	go h.sweepPresence(ctx)
+
+	for {
+		err := h.broker.Listen(ctx, h.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			h.l.Error("realtime broker subscription failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+func (h *Hub) stop(ctx context.Context) {
+	// Claim startOnce so a connection racing shutdown cannot start listening.
+	h.startOnce.Do(func() {})
+
+	h.mu.RLock()
+	conns := make([]subscriber, 0, len(h.conns))
+	for sub := range h.conns {
+		conns = append(conns, sub)
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range conns {
+		sub.shutdown()
+	}
+
+	if h.cancel == nil {
+		return
+	}
+
+	h.cancel()
+	select {
+	case <-h.done:
+	case <-ctx.Done():
+	}
+}
+
+// sweepPresence announces leaves for members whose connection stopped
+// refreshing them, which is how a crashed replica's members disappear. Only
+// channels with local subscribers are swept; a channel nobody here watches is
+// swept by the replicas that do, and snapshots skip expired members anyway.
+func (h *Hub) sweepPresence(ctx context.Context) {
+	ticker := time.NewTicker(h.presenceTTL / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, channel := range h.localChannels() {
+				h.pruneChannel(ctx, channel)
+			}
+		}
+	}
+}
+
+func (h *Hub) pruneChannel(ctx context.Context, channel string) {
+	ctx, cancel := context.WithTimeout(ctx, brokerTimeout)
+	defer cancel()
+
+	pruned, err := h.broker.PruneMembers(ctx, channel, h.now())
+	if err != nil {
+		h.l.Warn("failed to prune realtime presence",
+			zap.String("channel", channel), zap.Error(err))
+	}
+
+	for _, member := range pruned {
+		h.announcePresence(ctx, channel, PresenceLeave, member)
+	}
+}
+
+func (h *Hub) setPresence(ctx context.Context, channel string, member *Member) error {
+	member.ExpiresAt = h.now().Add(h.presenceTTL).UnixMilli()
+	return h.broker.SetMember(ctx, channel, member, h.presenceTTL)
+}
+
+func (h *Hub) removePresence(ctx context.Context, channel string, member *Member) error {
+	removed, err := h.broker.RemoveMember(ctx, channel, member.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if removed {
+		h.announcePresence(ctx, channel, PresenceLeave, member)
+	}
+
+	return nil
+}
+
+func (h *Hub) presenceSnapshot(ctx context.Context, channel string) ([]*Member, error) {
+	return h.broker.Members(ctx, channel, h.now())
+}
+
+func (h *Hub) announcePresence(ctx context.Context, channel, action string, member *Member) {
+	err := h.publishFrame(ctx, &ServerFrame{
+		Action:  ActionPresence,
+		Channel: channel,
+		Presence: &PresenceMessage{
+			Action:       action,
+			ClientID:     member.ClientID,
+			ConnectionID: member.ConnectionID,
+			Data:         member.Data,
+		},
+	})
+	if err != nil {
+		h.l.Warn("failed to announce realtime presence",
+			zap.String("channel", channel),
+			zap.String("action", action),
+			zap.Error(err))
+	}
+}
+
+// originPatterns turns the CORS allowed origins into the patterns checked on
+// the WebSocket handshake, reporting whether any origin is allowed. Tokens ride
+// in the URL rather than a cookie, so this is defense in depth rather than the
+// only thing standing between a page and a socket.
+func originPatterns(origins []string) ([]string, bool) {
+	patterns := make([]string, 0, len(origins))
+	for _, origin := range origins {
+		origin = strings.TrimSpace(origin)
+		switch origin {
+		case "":
+			continue
+		case "*":
+			return nil, true
+		default:
+			patterns = append(patterns, origin)
+		}
+	}
+
+	return patterns, false
+}
@@ -0,0 +1,533 @@
+package realtimehub
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/coder/websocket"
+	"github.com/emoss08/trenova/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testOrgChannel    = "tenant:org_1:bu_1:"
+	testTypingChannel = testOrgChannel + "typing:shipment:shp_1"
+	testDataChannel   = testOrgChannel + "data-events"
+)
+
+// memoryBroker stands in for Redis: every hub sharing one behaves like a
+// replica behind the same pub/sub.
+type memoryBroker struct {
+	mu        sync.Mutex
+	listeners map[int]func(string, []byte)
+	nextID    int
+	members   map[string]map[string]*Member
+}
+
+func newMemoryBroker() *memoryBroker {
+	return &memoryBroker{
+		listeners: make(map[int]func(string, []byte)),
+		members:   make(map[string]map[string]*Member),
+	}
+}
+
+func (b *memoryBroker) Publish(_ context.Context, channel string, frame []byte) error {
+	b.mu.Lock()
+	listeners := make([]func(string, []byte), 0, len(b.listeners))
+	for _, listener := range b.listeners {
+		listeners = append(listeners, listener)
+	}
+	b.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(channel, frame)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Listen(ctx context.Context, deliver func(string, []byte)) error {
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.listeners[id] = deliver
+	b.mu.Unlock()
+
+	<-ctx.Done()
+
+	b.mu.Lock()
+	delete(b.listeners, id)
+	b.mu.Unlock()
+	return nil
+}
+
+func (b *memoryBroker) listenerCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.listeners)
+}
+
+func (b *memoryBroker) SetMember(
+	_ context.Context,
+	channel string,
+	member *Member,
+	_ time.Duration,
+) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.members[channel] == nil {
+		b.members[channel] = make(map[string]*Member)
+	}
+	stored := *member
+	b.members[channel][member.ConnectionID] = &stored
+	return nil
+}
+
+func (b *memoryBroker) RemoveMember(_ context.Context, channel, connectionID string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.members[channel][connectionID]; !ok {
+		return false, nil
+	}
+	delete(b.members[channel], connectionID)
+	return true, nil
+}
+
+func (b *memoryBroker) Members(
+	_ context.Context,
+	channel string,
+	now time.Time,
+) ([]*Member, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	members := make([]*Member, 0)
+	for _, member := range b.members[channel] {
+		if member.ExpiresAt > now.UnixMilli() {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (b *memoryBroker) PruneMembers(
+	_ context.Context,
+	channel string,
+	now time.Time,
+) ([]*Member, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	pruned := make([]*Member, 0)
+	for id, member := range b.members[channel] {
+		if member.ExpiresAt <= now.UnixMilli() {
+			pruned = append(pruned, member)
+			delete(b.members[channel], id)
+		}
+	}
+	return pruned, nil
+}
+
+func newTestHub(t *testing.T, broker Broker) (*Hub, *httptest.Server) {
+	t.Helper()
+
+	hub := newHub(zap.NewNop(), broker, &config.Config{
+		Security: config.SecurityConfig{
+			Session: config.SessionConfig{Secret: "unit-test-session-secret-with-32-bytes"},
+		},
+		Realtime: config.RealtimeConfig{Provider: config.RealtimeProviderBuiltin},
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws/", hub.ServeWebSocket)
+	mux.HandleFunc("/stream/", hub.ServeStream)
+	server := httptest.NewServer(mux)
+
+	t.Cleanup(func() {
+		hub.stop(context.Background())
+		server.Close()
+	})
+
+	return hub, server
+}
+
+func testCapability() Capability {
+	return Capability{
+		testOrgChannel + "*":        {OperationSubscribe, OperationPresence, OperationHistory},
+		testOrgChannel + "typing:*": {OperationSubscribe, OperationPublish, OperationPresence},
+	}
+}
+
+type testClient struct {
+	t    *testing.T
+	conn *websocket.Conn
+	id   int64
+}
+
+func dialHub(t *testing.T, hub *Hub, server *httptest.Server, clientID string) *testClient {
+	t.Helper()
+
+	token, err := hub.CreateToken(clientID, testCapability(), time.Hour)
+	require.NoError(t, err)
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/?token=" + url.QueryEscape(token)
+	conn, _, err := websocket.Dial(t.Context(), wsURL, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.CloseNow() })
+
+	client := &testClient{t: t, conn: conn}
+	connected := client.next()
+	require.Equal(t, ActionConnected, connected.Action)
+	require.Equal(t, clientID, connected.ClientID)
+
+	return client
+}
+
+func (c *testClient) send(frame *ClientFrame) *ServerFrame {
+	c.t.Helper()
+
+	c.id++
+	frame.ID = c.id
+	raw, err := sonic.Marshal(frame)
+	require.NoError(c.t, err)
+	require.NoError(c.t, c.conn.Write(c.t.Context(), websocket.MessageText, raw))
+
+	for {
+		reply := c.next()
+		if reply.ID == frame.ID {
+			return reply
+		}
+	}
+}
+
+func (c *testClient) next() *ServerFrame {
+	c.t.Helper()
+
+	ctx, cancel := context.WithTimeout(c.t.Context(), 5*time.Second)
+	defer cancel()
+
+	_, raw, err := c.conn.Read(ctx)
+	require.NoError(c.t, err)
+
+	frame := new(ServerFrame)
+	require.NoError(c.t, sonic.Unmarshal(raw, frame))
+	return frame
+}
+
+// nextMatching skips frames until one satisfies match, since presence and
+// message frames interleave with acks.
+func (c *testClient) nextMatching(match func(*ServerFrame) bool) *ServerFrame {
+	c.t.Helper()
+
+	for {
+		frame := c.next()
+		if match(frame) {
+			return frame
+		}
+	}
+}
+
+func waitForListeners(t *testing.T, broker *memoryBroker, n int) {
+	t.Helper()
+	require.Eventually(
+		t,
+		func() bool { return broker.listenerCount() >= n },
+		time.Second,
+		5*time.Millisecond,
+	)
+}
+
+func TestCapabilityAllows(t *testing.T) {
+	t.Parallel()
+
+	capability := testCapability()
+
+	assert.True(t, capability.Allows(testDataChannel, OperationSubscribe))
+	assert.False(t, capability.Allows(testDataChannel, OperationPublish))
+	assert.True(t, capability.Allows(testTypingChannel, OperationPublish))
+	assert.False(t, capability.Allows("tenant:org_2:bu_1:data-events", OperationSubscribe))
+	assert.False(
+		t,
+		Capability{
+			testDataChannel: {OperationSubscribe},
+		}.Allows(
+			testDataChannel+"x",
+			OperationSubscribe,
+		),
+	)
+}
+
+func TestParseToken(t *testing.T) {
+	t.Parallel()
+
+	key := deriveSigningKey("unit-test-session-secret-with-32-bytes")
+	now := time.Unix(1760000000, 0)
+
+	token, err := issueToken(key, "usr_1", testCapability(), now, time.Minute)
+	require.NoError(t, err)
+
+	claims, err := parseToken(key, token, now.Add(30*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, "usr_1", claims.ClientID())
+	assert.True(t, claims.Capability.Allows(testDataChannel, OperationSubscribe))
+
+	_, err = parseToken(key, token, now.Add(2*time.Minute))
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = parseToken(deriveSigningKey("another-session-secret-with-32-bytes"), token, now)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestValidateChannelName(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateChannelName(testTypingChannel))
+	require.Error(t, ValidateChannelName(""))
+	require.Error(t, ValidateChannelName(testOrgChannel+"*"))
+	require.Error(t, ValidateChannelName("tenant:org_1 bu_1"))
+	require.Error(t, ValidateChannelName(strings.Repeat("a", maxChannelNameLength+1)))
+}
+
+func TestServeWebSocketRejectsBadToken(t *testing.T) {
+	t.Parallel()
+
+	_, server := newTestHub(t, newMemoryBroker())
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/?token=nope"
+	_, resp, err := websocket.Dial(t.Context(), wsURL, nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestHubFansOutAcrossReplicas(t *testing.T) {
+	t.Parallel()
+
+	broker := newMemoryBroker()
+	hubA, serverA := newTestHub(t, broker)
+	hubB, serverB := newTestHub(t, broker)
+
+	alice := dialHub(t, hubA, serverA, "usr_alice")
+	bob := dialHub(t, hubB, serverB, "usr_bob")
+
+	ack := bob.send(&ClientFrame{Action: ActionAttach, Channel: testTypingChannel})
+	require.Equal(t, ActionAck, ack.Action)
+	waitForListeners(t, broker, 1)
+
+	ack = alice.send(&ClientFrame{
+		Action:  ActionPresenceEnter,
+		Channel: testTypingChannel,
+		Data:    []byte(`{"name":"Alice"}`),
+	})
+	require.Equal(t, ActionAck, ack.Action)
+	waitForListeners(t, broker, 2)
+
+	enter := bob.nextMatching(func(f *ServerFrame) bool { return f.Action == ActionPresence })
+	assert.Equal(t, PresenceEnter, enter.Presence.Action)
+	assert.Equal(t, "usr_alice", enter.Presence.ClientID)
+	assert.JSONEq(t, `{"name":"Alice"}`, string(enter.Presence.Data))
+
+	snapshot := bob.send(&ClientFrame{Action: ActionPresenceGet, Channel: testTypingChannel})
+	require.Equal(t, ActionPresenceSnapshot, snapshot.Action)
+	require.Len(t, snapshot.Members, 1)
+	assert.Equal(t, "usr_alice", snapshot.Members[0].ClientID)
+
+	ack = alice.send(&ClientFrame{
+		Action:  ActionPublish,
+		Channel: testTypingChannel,
+		Name:    "typing",
+		Data:    []byte(`{"userId":"usr_alice"}`),
+	})
+	require.Equal(t, ActionAck, ack.Action)
+
+	message := bob.nextMatching(func(f *ServerFrame) bool { return f.Action == ActionMessage })
+	assert.Equal(t, "typing", message.Name)
+	assert.Equal(t, "usr_alice", message.ClientID)
+	assert.JSONEq(t, `{"userId":"usr_alice"}`, string(message.Data))
+
+	require.NoError(t, alice.conn.Close(websocket.StatusNormalClosure, ""))
+
+	leave := bob.nextMatching(func(f *ServerFrame) bool { return f.Action == ActionPresence })
+	assert.Equal(t, PresenceLeave, leave.Presence.Action)
+	assert.Equal(t, enter.Presence.ConnectionID, leave.Presence.ConnectionID)
+}
+
+func TestHubEnforcesCapability(t *testing.T) {
+	t.Parallel()
+
+	hub, server := newTestHub(t, newMemoryBroker())
+	client := dialHub(t, hub, server, "usr_alice")
+
+	reply := client.send(&ClientFrame{
+		Action:  ActionPublish,
+		Channel: testDataChannel,
+		Name:    "resource.invalidation",
+	})
+	assert.Equal(t, ActionError, reply.Action)
+
+	reply = client.send(
+		&ClientFrame{Action: ActionAttach, Channel: "tenant:org_2:bu_1:data-events"},
+	)
+	assert.Equal(t, ActionError, reply.Action)
+}
+
+func TestServeStreamReceivesPublishedEvents(t *testing.T) {
+	t.Parallel()
+
+	broker := newMemoryBroker()
+	hub, server := newTestHub(t, broker)
+
+	token, err := hub.CreateToken("usr_alice", testCapability(), time.Hour)
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(
+		t.Context(),
+		http.MethodGet,
+		server.URL+"/stream/?token="+url.QueryEscape(
+			token,
+		)+"&channel="+url.QueryEscape(
+			testDataChannel,
+		),
+		nil,
+	)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	reader := bufio.NewReader(resp.Body)
+	readFrame := func() *ServerFrame {
+		for {
+			line, readErr := reader.ReadString('\n')
+			require.NoError(t, readErr)
+			if data, ok := strings.CutPrefix(strings.TrimSpace(line), "data: "); ok {
+				frame := new(ServerFrame)
+				require.NoError(t, sonic.UnmarshalString(data, frame))
+				return frame
+			}
+		}
+	}
+
+	assert.Equal(t, ActionConnected, readFrame().Action)
+	waitForListeners(t, broker, 1)
+
+	require.NoError(
+		t,
+		hub.Publish(t.Context(), testDataChannel, "resource.invalidation", map[string]string{
+			"resource": "shipments",
+		}),
+	)
+
+	frame := readFrame()
+	assert.Equal(t, ActionMessage, frame.Action)
+	assert.Equal(t, "resource.invalidation", frame.Name)
+	assert.JSONEq(t, `{"resource":"shipments"}`, string(frame.Data))
+}
+
+func TestServeStreamRejectsUnauthorizedChannel(t *testing.T) {
+	t.Parallel()
+
+	hub, server := newTestHub(t, newMemoryBroker())
+	token, err := hub.CreateToken("usr_alice", testCapability(), time.Hour)
+	require.NoError(t, err)
+
+	resp, err := http.Get(
+		server.URL + "/stream/?token=" + url.QueryEscape(
+			token,
+		) + "&channel=" + url.QueryEscape(
+			"tenant:org_2:bu_1:data-events",
+		),
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestPruneChannelAnnouncesExpiredMembers(t *testing.T) {
+	t.Parallel()
+
+	broker := newMemoryBroker()
+	hub, _ := newTestHub(t, broker)
+	now := time.Unix(1760000000, 0)
+	hub.now = func() time.Time { return now }
+
+	require.NoError(t, hub.setPresence(t.Context(), testTypingChannel, &Member{
+		ClientID:     "usr_gone",
+		ConnectionID: "rtc_gone",
+	}))
+
+	var announced []*ServerFrame
+	var mu sync.Mutex
+	broker.mu.Lock()
+	broker.listeners[99] = func(_ string, frame []byte) {
+		decoded := new(ServerFrame)
+		_ = sonic.Unmarshal(frame, decoded)
+		mu.Lock()
+		announced = append(announced, decoded)
+		mu.Unlock()
+	}
+	broker.mu.Unlock()
+
+	hub.pruneChannel(t.Context(), testTypingChannel)
+	mu.Lock()
+	assert.Empty(t, announced)
+	mu.Unlock()
+
+	now = now.Add(2 * hub.presenceTTL)
+	hub.pruneChannel(t.Context(), testTypingChannel)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, announced, 1)
+	assert.Equal(t, PresenceLeave, announced[0].Presence.Action)
+	assert.Equal(t, "rtc_gone", announced[0].Presence.ConnectionID)
+}
+
+func TestSubscribeDeliversToInProcessConsumers(t *testing.T) {
+	t.Parallel()
+
+	broker := newMemoryBroker()
+	publisher, _ := newTestHub(t, broker)
+	consumer, _ := newTestHub(t, broker)
+	consumer.enabled = false
+
+	ctx, cancel := context.WithCancel(t.Context())
+	frames, err := consumer.Subscribe(ctx, testDataChannel)
+	require.NoError(t, err)
+	waitForListeners(t, broker, 1)
+
+	require.NoError(t, publisher.Publish(t.Context(), testDataChannel, "resource.invalidation",
+		map[string]string{"resource": "shipments"}))
+
+	select {
+	case frame := <-frames:
+		assert.Equal(t, ActionMessage, frame.Action)
+		assert.Equal(t, testDataChannel, frame.Channel)
+		assert.JSONEq(t, `{"resource":"shipments"}`, string(frame.Data))
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for frame")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-frames:
+		assert.False(t, ok)
+	case <-time.After(2 * time.Second):
+		t.Fatal("frames channel was not closed")
+	}
+
+	_, err = consumer.Subscribe(t.Context(), "bad channel")
+	require.ErrorIs(t, err, ErrInvalidChannel)
+}
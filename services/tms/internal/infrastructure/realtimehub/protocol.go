@@ -0,0 +1,76 @@
+package realtimehub
+
+import "encoding/json"
+
+// Client frame actions.
+const (
+	ActionAttach         = "attach"
+	ActionDetach         = "detach"
+	ActionPublish        = "publish"
+	ActionPresenceEnter  = "presence.enter"
+	ActionPresenceUpdate = "presence.update"
+	ActionPresenceLeave  = "presence.leave"
+	ActionPresenceGet    = "presence.get"
+)
+
+// Server frame actions.
+const (
+	ActionConnected        = "connected"
+	ActionAck              = "ack"
+	ActionError            = "error"
+	ActionMessage          = "message"
+	ActionPresence         = "presence"
+	ActionPresenceSnapshot = "presence.snapshot"
+)
+
+// Presence event actions, matching the ones Foony emits so clients handle both
+// providers the same way.
+const (
+	PresenceEnter  = "enter"
+	PresenceUpdate = "update"
+	PresenceLeave  = "leave"
+	PresenceAbsent = "absent"
+)
+
+// ClientFrame is a request sent by a WebSocket client. ID, when set, is echoed
+// on the ack or error that answers it.
+type ClientFrame struct {
+	ID      int64           `json:"id,omitempty"`
+	Action  string          `json:"action"`
+	Channel string          `json:"channel,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// ServerFrame is everything the hub sends to clients, over WebSocket or SSE.
+// Message and presence frames are also what travels through the broker, so a
+// frame published on one replica is forwarded unchanged by every other.
+type ServerFrame struct {
+	Action       string           `json:"action"`
+	ID           int64            `json:"id,omitempty"`
+	Channel      string           `json:"channel,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	Data         json.RawMessage  `json:"data,omitempty"`
+	ClientID     string           `json:"clientId,omitempty"`
+	ConnectionID string           `json:"connectionId,omitempty"`
+	Presence     *PresenceMessage `json:"presence,omitempty"`
+	Members      []*Member        `json:"members,omitempty"`
+	Error        string           `json:"error,omitempty"`
+}
+
+type PresenceMessage struct {
+	Action       string          `json:"action"`
+	ClientID     string          `json:"clientId"`
+	ConnectionID string          `json:"connectionId"`
+	Data         json.RawMessage `json:"data,omitempty"`
+}
+
+// Member is one connection's presence on a channel. ExpiresAt (Unix
+// milliseconds) is pushed forward while the connection is alive, so members of
+// a replica that died without saying goodbye age out.
+type Member struct {
+	ClientID     string          `json:"clientId"`
+	ConnectionID string          `json:"connectionId"`
+	Data         json.RawMessage `json:"data,omitempty"`
+	ExpiresAt    int64           `json:"expiresAt"`
+}
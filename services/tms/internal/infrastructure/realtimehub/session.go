@@ -0,0 +1,501 @@
+package realtimehub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/coder/websocket"
+	"github.com/emoss08/trenova/shared/pulid"
+	"go.uber.org/zap"
+)
+
+const (
+	sendBufferSize   = 256
+	maxFrameBytes    = 64 << 10
+	maxEventNameLen  = 128
+	writeTimeout     = 10 * time.Second
+	pingInterval     = 25 * time.Second
+	streamKeepAlive  = 25 * time.Second
+	connectionPrefix = "rtc_"
+
+	// StatusTokenExpired tells the client to fetch a new token and reconnect.
+	StatusTokenExpired websocket.StatusCode = 4001
+)
+
+var errOperationNotAllowed = errors.New("operation not permitted on channel")
+
+// ServeWebSocket upgrades an authenticated request to a realtime connection.
+// The token travels in the `token` query parameter because browsers cannot set
+// headers on a WebSocket handshake.
+func (h *Hub) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	claims, ok := h.authorizeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	sess := &session{
+		hub:          h,
+		claims:       claims,
+		connectionID: pulid.MustNew(connectionPrefix).String(),
+		send:         make(chan []byte, sendBufferSize),
+		attached:     make(map[string]struct{}),
+		entered:      make(map[string]*Member),
+	}
+
+	clearDeadlines(w)
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		OriginPatterns:     h.originPatterns,
+		InsecureSkipVerify: h.anyOrigin,
+	})
+	if err != nil {
+		h.l.Debug("realtime websocket handshake failed", zap.Error(err))
+		return
+	}
+	conn.SetReadLimit(maxFrameBytes)
+	sess.conn = conn
+
+	if !h.register(sess) {
+		_ = conn.Close(websocket.StatusTryAgainLater, "realtime connection limit reached")
+		return
+	}
+
+	sess.run(r.Context(), conn)
+}
+
+// ServeStream is the receive-only SSE alternative for clients that cannot hold
+// a WebSocket open. Channels are named by repeated `channel` query parameters.
+func (h *Hub) ServeStream(w http.ResponseWriter, r *http.Request) {
+	claims, ok := h.authorizeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	channels := r.URL.Query()["channel"]
+	if len(channels) == 0 {
+		http.Error(w, "at least one channel is required", http.StatusBadRequest)
+		return
+	}
+	for _, channel := range channels {
+		if ValidateChannelName(channel) != nil ||
+			!claims.Capability.Allows(channel, OperationSubscribe) {
+			http.Error(w, errOperationNotAllowed.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	stream := &stream{send: make(chan []byte, sendBufferSize), cancel: cancel}
+	if !h.register(stream) {
+		http.Error(w, "realtime connection limit reached", http.StatusServiceUnavailable)
+		return
+	}
+	defer h.unregister(stream)
+
+	for _, channel := range channels {
+		h.subscribe(channel, stream)
+	}
+
+	clearDeadlines(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	connected, _ := sonic.Marshal(&ServerFrame{
+		Action:   ActionConnected,
+		ClientID: claims.ClientID(),
+	})
+	writeEvent(w, connected)
+	flusher.Flush()
+
+	expiry := time.NewTimer(time.Until(claims.ExpiresAt.Time))
+	defer expiry.Stop()
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expiry.C:
+			return
+		case <-keepAlive.C:
+			_, _ = w.Write([]byte(": keepalive\n\n"))
+			flusher.Flush()
+		case frame := <-stream.send:
+			writeEvent(w, frame)
+			flusher.Flush()
+		}
+	}
+}
+
+func (h *Hub) authorizeRequest(w http.ResponseWriter, r *http.Request) (*Claims, bool) {
+	if !h.enabled {
+		http.NotFound(w, r)
+		return nil, false
+	}
+
+	claims, err := parseToken(h.signingKey, r.URL.Query().Get("token"), h.now())
+	if err != nil {
+		http.Error(w, ErrInvalidToken.Error(), http.StatusUnauthorized)
+		return nil, false
+	}
+
+	return claims, true
+}
+
+// clearDeadlines lifts the server's read and write timeouts, which are sized
+// for ordinary requests and would otherwise cut long-lived connections.
+func clearDeadlines(w http.ResponseWriter) {
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+}
+
+func writeEvent(w http.ResponseWriter, frame []byte) {
+	_, _ = w.Write([]byte("data: "))
+	_, _ = w.Write(frame)
+	_, _ = w.Write([]byte("\n\n"))
+}
+
+type stream struct {
+	send   chan []byte
+	cancel context.CancelFunc
+}
+
+func (s *stream) deliver(frame []byte) {
+	select {
+	case s.send <- frame:
+	default:
+		s.cancel()
+	}
+}
+
+func (s *stream) shutdown() {
+	s.cancel()
+}
+
+type session struct {
+	hub          *Hub
+	conn         *websocket.Conn // set before the session is registered
+	claims       *Claims
+	connectionID string
+	send         chan []byte
+
+	mu       sync.Mutex
+	attached map[string]struct{}
+	entered  map[string]*Member
+
+	closeOnce sync.Once
+}
+
+func (s *session) deliver(frame []byte) {
+	select {
+	case s.send <- frame:
+	default:
+		s.close(websocket.StatusPolicyViolation, "client is not keeping up")
+	}
+}
+
+func (s *session) shutdown() {
+	s.close(websocket.StatusGoingAway, "server shutting down")
+}
+
+// close ends the connection from any goroutine. Closing unblocks the read loop,
+// which then runs the session teardown.
+func (s *session) close(code websocket.StatusCode, reason string) {
+	s.closeOnce.Do(func() {
+		go func() { _ = s.conn.Close(code, reason) }()
+	})
+}
+
+func (s *session) run(ctx context.Context, conn *websocket.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer s.teardown()
+
+	s.reply(&ServerFrame{
+		Action:       ActionConnected,
+		ClientID:     s.claims.ClientID(),
+		ConnectionID: s.connectionID,
+	})
+
+	go s.writeLoop(ctx)
+
+	for {
+		_, raw, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+
+		frame := new(ClientFrame)
+		if err = sonic.Unmarshal(raw, frame); err != nil {
+			s.reply(&ServerFrame{Action: ActionError, Error: "malformed frame"})
+			continue
+		}
+
+		if err = s.handle(ctx, frame); err != nil {
+			s.reply(&ServerFrame{
+				Action:  ActionError,
+				ID:      frame.ID,
+				Channel: frame.Channel,
+				Error:   err.Error(),
+			})
+		}
+	}
+}
+
+func (s *session) writeLoop(ctx context.Context) {
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+	refresh := time.NewTicker(s.hub.presenceTTL / 3)
+	defer refresh.Stop()
+	expiry := time.NewTimer(time.Until(s.claims.ExpiresAt.Time))
+	defer expiry.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expiry.C:
+			s.close(StatusTokenExpired, "token expired")
+			return
+		case <-refresh.C:
+			s.refreshPresence(ctx)
+		case <-ping.C:
+			pingCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+			err := s.conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				s.close(websocket.StatusGoingAway, "ping timeout")
+				return
+			}
+		case frame := <-s.send:
+			writeCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+			err := s.conn.Write(writeCtx, websocket.MessageText, frame)
+			cancel()
+			if err != nil {
+				s.close(websocket.StatusGoingAway, "write failed")
+				return
+			}
+		}
+	}
+}
+
+func (s *session) handle(ctx context.Context, frame *ClientFrame) error {
+	if err := ValidateChannelName(frame.Channel); err != nil {
+		return err
+	}
+
+	switch frame.Action {
+	case ActionAttach:
+		if err := s.attach(frame.Channel); err != nil {
+			return err
+		}
+	case ActionDetach:
+		s.detach(ctx, frame.Channel)
+	case ActionPublish:
+		if err := s.publish(ctx, frame); err != nil {
+			return err
+		}
+	case ActionPresenceEnter, ActionPresenceUpdate:
+		if err := s.enter(ctx, frame); err != nil {
+			return err
+		}
+	case ActionPresenceLeave:
+		if err := s.leave(ctx, frame.Channel); err != nil {
+			return err
+		}
+	case ActionPresenceGet:
+		return s.snapshot(ctx, frame)
+	default:
+		return errors.New("unknown action")
+	}
+
+	s.reply(&ServerFrame{Action: ActionAck, ID: frame.ID, Channel: frame.Channel})
+	return nil
+}
+
+func (s *session) allows(channel, op string) error {
+	if !s.claims.Capability.Allows(channel, op) {
+		return errOperationNotAllowed
+	}
+
+	return nil
+}
+
+func (s *session) attach(channel string) error {
+	if !s.claims.Capability.Allows(channel, OperationSubscribe) &&
+		!s.claims.Capability.Allows(channel, OperationPresence) {
+		return errOperationNotAllowed
+	}
+
+	s.mu.Lock()
+	s.attached[channel] = struct{}{}
+	s.mu.Unlock()
+
+	s.hub.subscribe(channel, s)
+	return nil
+}
+
+// detach also leaves presence, as detaching a Foony channel does.
+func (s *session) detach(ctx context.Context, channel string) {
+	s.mu.Lock()
+	delete(s.attached, channel)
+	s.mu.Unlock()
+
+	s.hub.unsubscribe(channel, s)
+	if err := s.leave(ctx, channel); err != nil {
+		s.hub.l.Warn("failed to leave realtime presence on detach",
+			zap.String("channel", channel), zap.Error(err))
+	}
+}
+
+func (s *session) publish(ctx context.Context, frame *ClientFrame) error {
+	if err := s.allows(frame.Channel, OperationPublish); err != nil {
+		return err
+	}
+
+	if frame.Name == "" || len(frame.Name) > maxEventNameLen {
+		return errors.New("event name is required and must be at most 128 characters")
+	}
+
+	return s.hub.publishFrame(ctx, &ServerFrame{
+		Action:       ActionMessage,
+		Channel:      frame.Channel,
+		Name:         frame.Name,
+		Data:         frame.Data,
+		ClientID:     s.claims.ClientID(),
+		ConnectionID: s.connectionID,
+	})
+}
+
+// enter handles both enter and update. Entering implicitly attaches the
+// channel, and entering twice is an update, matching Foony.
+func (s *session) enter(ctx context.Context, frame *ClientFrame) error {
+	if err := s.allows(frame.Channel, OperationPresence); err != nil {
+		return err
+	}
+
+	if err := s.attach(frame.Channel); err != nil {
+		return err
+	}
+
+	member := &Member{
+		ClientID:     s.claims.ClientID(),
+		ConnectionID: s.connectionID,
+		Data:         frame.Data,
+	}
+	if err := s.hub.setPresence(ctx, frame.Channel, member); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	_, wasPresent := s.entered[frame.Channel]
+	s.entered[frame.Channel] = member
+	s.mu.Unlock()
+
+	action := PresenceEnter
+	if wasPresent {
+		action = PresenceUpdate
+	}
+	s.hub.announcePresence(ctx, frame.Channel, action, member)
+
+	return nil
+}
+
+func (s *session) leave(ctx context.Context, channel string) error {
+	s.mu.Lock()
+	member, ok := s.entered[channel]
+	delete(s.entered, channel)
+	s.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return s.hub.removePresence(ctx, channel, member)
+}
+
+func (s *session) snapshot(ctx context.Context, frame *ClientFrame) error {
+	if err := s.allows(frame.Channel, OperationPresence); err != nil {
+		return err
+	}
+
+	members, err := s.hub.presenceSnapshot(ctx, frame.Channel)
+	if err != nil {
+		return err
+	}
+
+	s.reply(&ServerFrame{
+		Action:  ActionPresenceSnapshot,
+		ID:      frame.ID,
+		Channel: frame.Channel,
+		Members: members,
+	})
+	return nil
+}
+
+func (s *session) refreshPresence(ctx context.Context) {
+	s.mu.Lock()
+	entered := make(map[string]*Member, len(s.entered))
+	for channel, member := range s.entered {
+		entered[channel] = member
+	}
+	s.mu.Unlock()
+
+	for channel, member := range entered {
+		if err := s.hub.setPresence(ctx, channel, member); err != nil {
+			s.hub.l.Warn("failed to refresh realtime presence",
+				zap.String("channel", channel), zap.Error(err))
+		}
+	}
+}
+
+// teardown leaves every channel the session entered. It runs after the
+// connection is gone, so it uses its own deadline rather than the request's.
+func (s *session) teardown() {
+	s.hub.unregister(s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+	defer cancel()
+
+	s.mu.Lock()
+	channels := make([]string, 0, len(s.entered))
+	for channel := range s.entered {
+		channels = append(channels, channel)
+	}
+	s.mu.Unlock()
+
+	for _, channel := range channels {
+		if err := s.leave(ctx, channel); err != nil {
+			s.hub.l.Warn("failed to leave realtime presence on disconnect",
+				zap.String("channel", channel), zap.Error(err))
+		}
+	}
+
+	s.close(websocket.StatusNormalClosure, "")
+}
+
+func (s *session) reply(frame *ServerFrame) {
+	raw, err := sonic.Marshal(frame)
+	if err != nil {
+		s.hub.l.Error("failed to marshal realtime frame", zap.Error(err))
+		return
+	}
+
+	s.deliver(raw)
+}
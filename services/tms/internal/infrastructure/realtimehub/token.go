@@ -0,0 +1,134 @@
+package realtimehub
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	tokenIssuer     = "trenova-realtime"
+	signingKeyLabel = "trenova:realtime:token"
+
+	OperationSubscribe = "subscribe"
+	OperationPublish   = "publish"
+	OperationPresence  = "presence"
+	OperationHistory   = "history"
+)
+
+var (
+	ErrInvalidToken   = errors.New("invalid realtime token")
+	ErrInvalidChannel = errors.New("invalid realtime channel name")
+)
+
+// Capability maps channel patterns to the operations a token may perform on
+// them. A pattern ending in "*" matches every channel with that prefix, the
+// same form Foony capabilities use.
+type Capability map[string][]string
+
+// Allows reports whether any pattern matching the channel grants op.
+func (c Capability) Allows(channel, op string) bool {
+	for pattern, ops := range c {
+		if matchesPattern(pattern, channel) && slices.Contains(ops, op) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesPattern(pattern, channel string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(channel, prefix)
+	}
+
+	return pattern == channel
+}
+
+// Claims identify the client a builtin token was minted for and what it may do.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	Capability Capability `json:"capability"`
+}
+
+func (c *Claims) ClientID() string {
+	return c.Subject
+}
+
+// deriveSigningKey keeps realtime tokens from being valid anywhere else the
+// session secret is used.
+func deriveSigningKey(secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(signingKeyLabel))
+	return mac.Sum(nil)
+}
+
+func issueToken(
+	key []byte,
+	clientID string,
+	capability Capability,
+	now time.Time,
+	ttl time.Duration,
+) (string, error) {
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			Subject:   clientID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Capability: capability,
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	if err != nil {
+		return "", fmt.Errorf("sign realtime token: %w", err)
+	}
+
+	return token, nil
+}
+
+func parseToken(key []byte, raw string, now time.Time) (*Claims, error) {
+	claims := new(Claims)
+	_, err := jwt.ParseWithClaims(
+		raw,
+		claims,
+		func(*jwt.Token) (any, error) { return key, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.Subject == "" || len(claims.Capability) == 0 {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+const maxChannelNameLength = 256
+
+// ValidateChannelName rejects names that could not have come from a tenant
+// channel helper, including glob characters that would widen a Redis pattern.
+func ValidateChannelName(channel string) error {
+	if channel == "" || len(channel) > maxChannelNameLength {
+		return ErrInvalidChannel
+	}
+
+	if strings.ContainsAny(channel, "*?[] \t\r\n") {
+		return ErrInvalidChannel
+	}
+
+	return nil
+}
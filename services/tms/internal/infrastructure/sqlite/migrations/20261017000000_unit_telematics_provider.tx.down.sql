@@ -0,0 +1,6 @@
+-- Code generated from the PostgreSQL migrations by
+-- scripts/dialect-convert/convert.py. Hand-edits are preserved only if you
+-- stop regenerating this file; see docs/databases.md.
+-- Source: 20261017000000_unit_telematics_provider.tx.down.sql
+
+SELECT 1;
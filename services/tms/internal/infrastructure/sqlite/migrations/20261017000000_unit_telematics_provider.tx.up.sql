@@ -0,0 +1,14 @@
+-- Code generated from the PostgreSQL migrations by
+-- scripts/dialect-convert/convert.py. Hand-edits are preserved only if you
+-- stop regenerating this file; see docs/databases.md.
+-- Source: 20261017000000_unit_telematics_provider.tx.up.sql
+
+ALTER TABLE "tractors" ADD COLUMN "telematics_provider" TEXT;
+
+--bun:split
+
+ALTER TABLE "trailers" ADD COLUMN "telematics_provider" TEXT;
+
+--bun:split
+
+ALTER TABLE "workers" ADD COLUMN "telematics_provider" TEXT;
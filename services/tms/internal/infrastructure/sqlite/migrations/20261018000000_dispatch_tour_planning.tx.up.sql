@@ -0,0 +1,14 @@
+-- Code generated from the PostgreSQL migrations by
+-- scripts/dialect-convert/convert.py. Hand-edits are preserved only if you
+-- stop regenerating this file; see docs/databases.md.
+-- Source: 20261018000000_dispatch_tour_planning.tx.up.sql
+
+ALTER TABLE "workers" ADD COLUMN "home_location_id" TEXT;
+
+--bun:split
+
+ALTER TABLE "workers" ADD COLUMN "home_time_max_days_out" INTEGER;
+
+--bun:split
+
+ALTER TABLE "dispatch_controls" ADD COLUMN "horizon_max_days_out" INTEGER;
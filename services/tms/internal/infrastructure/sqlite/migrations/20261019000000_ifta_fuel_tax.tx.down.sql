@@ -0,0 +1,6 @@
+-- Code generated from the PostgreSQL migrations by
+-- scripts/dialect-convert/convert.py. Hand-edits are preserved only if you
+-- stop regenerating this file; see docs/databases.md.
+-- Source: 20261019000000_ifta_fuel_tax.tx.down.sql
+
+SELECT 1;
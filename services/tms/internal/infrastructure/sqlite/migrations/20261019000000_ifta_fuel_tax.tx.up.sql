@@ -0,0 +1,91 @@
+-- Code generated from the PostgreSQL migrations by
+-- scripts/dialect-convert/convert.py. Hand-edits are preserved only if you
+-- stop regenerating this file; see docs/databases.md.
+-- Source: 20261019000000_ifta_fuel_tax.tx.up.sql
+
+CREATE TABLE IF NOT EXISTS "telematics_vehicle_position_history"(
+    "organization_id" TEXT NOT NULL,
+    "business_unit_id" TEXT NOT NULL,
+    "tractor_id" TEXT NOT NULL,
+    "recorded_at" INTEGER NOT NULL,
+    "provider" TEXT NOT NULL,
+    "latitude" REAL NOT NULL,
+    "longitude" REAL NOT NULL,
+    "speed_mph" REAL NOT NULL DEFAULT 0,
+    "odometer_meters" INTEGER,
+    "formatted_location" TEXT,
+    CONSTRAINT "pk_telematics_vehicle_position_history" PRIMARY KEY ("organization_id", "business_unit_id", "tractor_id", "recorded_at")
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS "idx_telematics_vehicle_position_history_recorded_at" ON "telematics_vehicle_position_history" ("organization_id", "business_unit_id", "recorded_at");
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS "ifta_fuel_purchases"(
+    "id" TEXT NOT NULL,
+    "business_unit_id" TEXT NOT NULL,
+    "organization_id" TEXT NOT NULL,
+    "tractor_id" TEXT NOT NULL,
+    "jurisdiction" TEXT NOT NULL,
+    "fuel_type" TEXT NOT NULL DEFAULT 'Diesel',
+    "purchased_at" INTEGER NOT NULL,
+    "gallons" REAL NOT NULL,
+    "total_cost" REAL,
+    "tax_paid" INTEGER NOT NULL DEFAULT 1,
+    "vendor" TEXT,
+    "receipt_number" TEXT,
+    "source" TEXT NOT NULL DEFAULT 'Manual',
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" INTEGER NOT NULL DEFAULT (unixepoch()),
+    "updated_at" INTEGER NOT NULL DEFAULT (unixepoch()),
+    CONSTRAINT "pk_ifta_fuel_purchases" PRIMARY KEY ("id", "organization_id", "business_unit_id"),
+    CONSTRAINT "fk_ifta_fuel_purchases_business_unit" FOREIGN KEY ("business_unit_id") REFERENCES "business_units"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_ifta_fuel_purchases_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_ifta_fuel_purchases_tractor" FOREIGN KEY ("tractor_id", "organization_id", "business_unit_id") REFERENCES "tractors"("id", "organization_id", "business_unit_id") ON UPDATE NO ACTION ON DELETE RESTRICT,
+    CONSTRAINT "chk_ifta_fuel_purchases_gallons" CHECK ("gallons" > 0),
+    CONSTRAINT "chk_ifta_fuel_purchases_total_cost" CHECK ("total_cost" IS NULL OR "total_cost" >= 0)
+);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS "idx_ifta_fuel_purchases_purchased_at" ON "ifta_fuel_purchases" ("organization_id", "business_unit_id", "purchased_at");
+
+--bun:split
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_ifta_fuel_purchases_receipt" ON "ifta_fuel_purchases" ("organization_id", "business_unit_id", "tractor_id", "receipt_number")WHERE
+    "receipt_number" IS NOT NULL;
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS "ifta_tax_rates"(
+    "id" TEXT NOT NULL,
+    "business_unit_id" TEXT NOT NULL,
+    "organization_id" TEXT NOT NULL,
+    "year" INTEGER NOT NULL,
+    "quarter" INTEGER NOT NULL,
+    "jurisdiction" TEXT NOT NULL,
+    "fuel_type" TEXT NOT NULL DEFAULT 'Diesel',
+    "rate" REAL NOT NULL,
+    "surcharge_rate" REAL NOT NULL DEFAULT 0,
+    "version" INTEGER NOT NULL DEFAULT 0,
+    "created_at" INTEGER NOT NULL DEFAULT (unixepoch()),
+    "updated_at" INTEGER NOT NULL DEFAULT (unixepoch()),
+    CONSTRAINT "pk_ifta_tax_rates" PRIMARY KEY ("id", "organization_id", "business_unit_id"),
+    CONSTRAINT "fk_ifta_tax_rates_business_unit" FOREIGN KEY ("business_unit_id") REFERENCES "business_units"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "fk_ifta_tax_rates_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations"("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+    CONSTRAINT "chk_ifta_tax_rates_quarter" CHECK ("quarter" BETWEEN 1 AND 4),
+    CONSTRAINT "chk_ifta_tax_rates_rate" CHECK ("rate" >= 0 AND "surcharge_rate" >= 0)
+);
+
+--bun:split
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_ifta_tax_rates_period" ON "ifta_tax_rates" ("organization_id", "business_unit_id", "year", "quarter", "jurisdiction", "fuel_type");
+
+--bun:split
+
+CREATE TABLE IF NOT EXISTS "ifta_jurisdiction_boundaries"(
+    "jurisdiction" TEXT NOT NULL,
+    CONSTRAINT "pk_ifta_jurisdiction_boundaries" PRIMARY KEY ("jurisdiction")
+);
@@ -7,6 +7,7 @@ import (
 	"github.com/emoss08/trenova/internal/core/ports/repositories"
 	"github.com/emoss08/trenova/internal/core/ports/services"
 	"github.com/emoss08/trenova/internal/core/services/integrationservice"
+	"github.com/emoss08/trenova/internal/infrastructure/telematics/motiveprovider"
 	"github.com/emoss08/trenova/internal/infrastructure/telematics/samsaraprovider"
 	"github.com/emoss08/trenova/pkg/errortypes"
 	"github.com/emoss08/trenova/pkg/pagination"
@@ -45,19 +46,36 @@ func (f *Factory) ProviderFor(
 		return nil, err
 	}
 
-	for _, record := range integrations {
-		if record.Category != integration.CategoryTelematics || !record.Enabled {
-			continue
-		}
-		if !record.Type.SupportsTelematics() {
-			continue
-		}
-		return f.ProviderOfType(ctx, tenantInfo, record.Type)
+	types := integration.EnabledTelematicsTypes(integrations)
+	if len(types) == 0 {
+		return nil, errNoTelematicsProvider()
 	}
+	return f.ProviderOfType(ctx, tenantInfo, types[0])
+}
 
-	return nil, errortypes.NewBusinessError(
-		"no telematics provider is enabled for this organization",
-	)
+func (f *Factory) ProvidersFor(
+	ctx context.Context,
+	tenantInfo pagination.TenantInfo,
+) ([]services.TelematicsProvider, error) {
+	integrations, err := f.integrationRepo.ListByTenant(ctx, tenantInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	types := integration.EnabledTelematicsTypes(integrations)
+	if len(types) == 0 {
+		return nil, errNoTelematicsProvider()
+	}
+
+	providers := make([]services.TelematicsProvider, 0, len(types))
+	for _, typ := range types {
+		provider, providerErr := f.ProviderOfType(ctx, tenantInfo, typ)
+		if providerErr != nil {
+			return nil, providerErr
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
 }
 
 func (f *Factory) ProviderOfType(
@@ -72,9 +90,21 @@ func (f *Factory) ProviderOfType(
 			return nil, err
 		}
 		return samsaraprovider.New(client), nil
+	case integration.TypeMotive:
+		client, err := f.integrationService.MotiveClient(ctx, tenantInfo)
+		if err != nil {
+			return nil, err
+		}
+		return motiveprovider.New(client), nil
 	default:
 		return nil, errortypes.NewBusinessError(
 			string(typ) + " is not a supported telematics provider",
 		)
 	}
 }
+
+func errNoTelematicsProvider() error {
+	return errortypes.NewBusinessError(
+		"no telematics provider is enabled for this organization",
+	)
+}
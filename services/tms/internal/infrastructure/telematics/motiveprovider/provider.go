@@ -0,0 +1,711 @@
+package motiveprovider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/internal/core/domain/integration"
+	"github.com/emoss08/trenova/internal/core/domain/telematics"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	sharedmotive "github.com/emoss08/trenova/shared/motive"
+	"github.com/emoss08/trenova/shared/motive/assets"
+	"github.com/emoss08/trenova/shared/motive/hos"
+	"github.com/emoss08/trenova/shared/motive/inspections"
+	"github.com/emoss08/trenova/shared/motive/users"
+	"github.com/emoss08/trenova/shared/motive/vehicles"
+	"github.com/emoss08/trenova/shared/motive/webhooks"
+)
+
+const (
+	metersPerMile = 1609.344
+	dateLayout    = "2006-01-02"
+)
+
+// Event type names recorded for Motive webhook actions. They match the names
+// Samsara uses for the same happenings so the event log reads the same
+// whichever provider a unit reports through.
+const (
+	eventTypeGeofenceEntry      = "GeofenceEntry"
+	eventTypeGeofenceExit       = "GeofenceExit"
+	eventTypeVehicleUpdated     = "VehicleUpdated"
+	eventTypeVehicleLocation    = "VehicleLocationUpdated"
+	eventTypeDriverUpdated      = "DriverUpdated"
+	eventTypeDutyStatusUpdated  = "DriverDutyStatusUpdated"
+	eventTypeHOSViolation       = "HosViolation"
+	eventTypeInspectionReported = "DvirSubmitted"
+)
+
+type Provider struct {
+	client *sharedmotive.Client
+}
+
+func New(client *sharedmotive.Client) *Provider {
+	return &Provider{client: client}
+}
+
+func (p *Provider) Type() integration.Type {
+	return integration.TypeMotive
+}
+
+func (p *Provider) ListVehicles(ctx context.Context) ([]services.ProviderVehicle, error) {
+	remoteVehicles, err := p.client.Vehicles.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list motive vehicles: %w", err)
+	}
+
+	out := make([]services.ProviderVehicle, 0, len(remoteVehicles))
+	for i := range remoteVehicles {
+		vehicle := &remoteVehicles[i]
+		out = append(out, services.ProviderVehicle{
+			ID:           formatID(vehicle.ID),
+			Name:         vehicle.Number,
+			VIN:          vehicle.VIN,
+			LicensePlate: vehicle.LicensePlateNumber,
+		})
+	}
+	return out, nil
+}
+
+func (p *Provider) ListTrailers(ctx context.Context) ([]services.ProviderVehicle, error) {
+	remoteAssets, err := p.client.Assets.ListAll(ctx, assets.TypeTrailer)
+	if err != nil {
+		return nil, fmt.Errorf("list motive trailer assets: %w", err)
+	}
+
+	out := make([]services.ProviderVehicle, 0, len(remoteAssets))
+	for i := range remoteAssets {
+		asset := &remoteAssets[i]
+		out = append(out, services.ProviderVehicle{
+			ID:           formatID(asset.ID),
+			Name:         asset.Name,
+			VIN:          asset.VIN,
+			LicensePlate: asset.LicensePlateNumber,
+		})
+	}
+	return out, nil
+}
+
+func (p *Provider) ListPositions(ctx context.Context) ([]services.ProviderPosition, error) {
+	locations, err := p.client.Vehicles.LocationsAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch motive vehicle locations: %w", err)
+	}
+
+	out := make([]services.ProviderPosition, 0, len(locations))
+	for i := range locations {
+		vehicle := &locations[i]
+		if vehicle.CurrentLocation == nil {
+			continue
+		}
+		out = append(out, mapPosition(vehicle))
+	}
+	return out, nil
+}
+
+func mapPosition(vehicle *vehicles.VehicleLocation) services.ProviderPosition {
+	location := vehicle.CurrentLocation
+	position := services.ProviderPosition{
+		VehicleID:         formatID(vehicle.ID),
+		Latitude:          location.Lat,
+		Longitude:         location.Lon,
+		HeadingDegrees:    location.Bearing,
+		SpeedMph:          location.Speed,
+		EngineState:       engineState(location),
+		FuelPercent:       location.FuelPrimaryRemainingPercentage,
+		FormattedLocation: location.Description,
+		RecordedAt:        parseTime(location.LocatedAt),
+	}
+	if location.Odometer != nil {
+		odometer := milesToMeters(*location.Odometer)
+		position.OdometerMeters = &odometer
+	}
+	return position
+}
+
+// engineState infers the engine state Samsara reports directly. Motive only
+// says what kind of fix this was, so a moving vehicle is running, an ignition
+// or engine-off fix is off, and anything else is idling.
+func engineState(location *vehicles.Location) telematics.EngineState {
+	switch {
+	case location.Type == "ignition_off" || location.Type == "engine_stop":
+		return telematics.EngineStateOff
+	case location.Speed > 0 || location.Type == "vehicle_moving":
+		return telematics.EngineStateOn
+	default:
+		return telematics.EngineStateIdle
+	}
+}
+
+func (p *Provider) ListHOSClocks(ctx context.Context) ([]services.ProviderHOSClocks, error) {
+	drivers, err := p.client.Users.AvailableTimeAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch motive available time: %w", err)
+	}
+
+	out := make([]services.ProviderHOSClocks, 0, len(drivers))
+	for i := range drivers {
+		out = append(out, mapClocks(&drivers[i]))
+	}
+	return out, nil
+}
+
+// mapClocks converts Motive's seconds to milliseconds. Motive lets a clock run
+// negative once it is exhausted; the overrun becomes the violation duration so
+// dispatch eligibility sees it the same way it sees Samsara's.
+func mapClocks(driver *users.DriverAvailableTime) services.ProviderHOSClocks {
+	clocks := driver.AvailableTime
+	record := services.ProviderHOSClocks{
+		DriverID:         formatID(driver.ID),
+		DutyStatus:       mapDutyStatus(driver.DutyStatus),
+		DriveRemainingMs: remainingMs(clocks.Drive),
+		ShiftRemainingMs: remainingMs(clocks.Shift),
+		CycleRemainingMs: remainingMs(clocks.Cycle),
+		BreakRemainingMs: remainingMs(clocks.Break),
+		CycleViolationMs: overrunMs(clocks.Cycle),
+	}
+	record.ShiftDrivingViolationMs = max(overrunMs(clocks.Drive), overrunMs(clocks.Shift))
+	if driver.Recap != nil {
+		record.CycleTomorrowMs = remainingMs(driver.Recap.CycleTomorrow)
+	}
+	if driver.LastCycleReset != nil {
+		if startedAt := parseTime(driver.LastCycleReset.EndTime); startedAt > 0 {
+			record.CycleStartedAt = &startedAt
+		}
+	}
+	if driver.CurrentVehicle != nil && driver.CurrentVehicle.ID != 0 {
+		record.CurrentVehicleID = formatID(driver.CurrentVehicle.ID)
+	}
+	return record
+}
+
+func (p *Provider) ListDriverProfiles(
+	ctx context.Context,
+) ([]services.ProviderDriverProfile, error) {
+	drivers, err := p.client.Users.ListDrivers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list motive drivers: %w", err)
+	}
+
+	out := make([]services.ProviderDriverProfile, 0, len(drivers))
+	for i := range drivers {
+		driver := &drivers[i]
+		out = append(out, services.ProviderDriverProfile{
+			DriverID: formatID(driver.ID),
+			Name:     refName(driver.FirstName, driver.LastName),
+			Ruleset:  mapRuleset(driver),
+		})
+	}
+	return out, nil
+}
+
+// mapRuleset translates Motive's cycle code into the ruleset labels the HOS
+// projection reads, which follow Samsara's wording: the cycle label carries the
+// hours, the shift label the rule family, and the jurisdiction marks Canada.
+func mapRuleset(driver *users.User) *services.ProviderRuleset {
+	code := strings.ToLower(strings.TrimSpace(driver.Cycle))
+	if code == "" {
+		return nil
+	}
+
+	ruleset := &services.ProviderRuleset{
+		Cycle: cycleLabel(code),
+		Shift: "US Interstate Property",
+		Break: "Property (off-duty/sleeper)",
+	}
+	switch {
+	case strings.HasPrefix(code, "passenger_"):
+		ruleset.Shift = "US Interstate Passenger"
+		ruleset.Break = ""
+	case strings.HasPrefix(code, "texas_"):
+		ruleset.Shift = "Texas Intrastate"
+	case strings.HasPrefix(code, "canada_south_"):
+		ruleset.Shift = "Canada South"
+		ruleset.Jurisdiction = "CS"
+		ruleset.Break = ""
+	case strings.HasPrefix(code, "canada_north_"):
+		ruleset.Shift = "Canada North"
+		ruleset.Jurisdiction = "CN"
+		ruleset.Break = ""
+	case strings.HasPrefix(code, "alaska_"):
+		ruleset.Shift = "Alaska Property"
+	}
+	if driver.Exception24HourRestart {
+		ruleset.Restart = "24-hour Restart"
+	} else if ruleset.Jurisdiction == "" {
+		ruleset.Restart = "34-hour Restart"
+	}
+	return ruleset
+}
+
+// cycleLabel renders a code such as "70_8" or "canada_south_120_14" as
+// "USA 70 hour / 8 day" or "Canada 120 hour / 14 day".
+func cycleLabel(code string) string {
+	parts := strings.Split(code, "_")
+	if len(parts) < 2 {
+		return code
+	}
+	hours, days := parts[len(parts)-2], parts[len(parts)-1]
+	if _, err := strconv.Atoi(hours); err != nil {
+		return code
+	}
+
+	region := "USA"
+	switch {
+	case strings.HasPrefix(code, "canada_"):
+		region = "Canada"
+	case strings.HasPrefix(code, "texas_"):
+		region = "Texas"
+	case strings.HasPrefix(code, "alaska_"):
+		region = "Alaska"
+	case strings.HasPrefix(code, "california_"):
+		region = "California"
+	}
+	return region + " " + hours + " hour / " + days + " day"
+}
+
+func (p *Provider) ListHOSViolations(
+	ctx context.Context,
+	startAt int64,
+	endAt int64,
+) ([]services.ProviderViolation, error) {
+	startTime := time.Unix(startAt, 0).UTC()
+	endTime := time.Unix(endAt, 0).UTC()
+
+	violations, err := p.client.HOS.ViolationsAll(ctx, hos.ViolationsParams{
+		MinStartTime: &startTime,
+		MaxStartTime: &endTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch motive hos violations: %w", err)
+	}
+
+	out := make([]services.ProviderViolation, 0, len(violations))
+	for i := range violations {
+		record, ok := mapViolation(&violations[i])
+		if !ok {
+			continue
+		}
+		out = append(out, record)
+	}
+	return out, nil
+}
+
+func mapViolation(violation *hos.Violation) (services.ProviderViolation, bool) {
+	startAt := parseTime(violation.StartTime)
+	if violation.Type == "" || startAt == 0 || violation.User.ID == 0 {
+		return services.ProviderViolation{}, false
+	}
+
+	record := services.ProviderViolation{
+		DriverID:    formatID(violation.User.ID),
+		Type:        violation.Type,
+		Description: violation.Name,
+		DurationMs:  violation.Duration * 1000,
+		StartAt:     startAt,
+	}
+	if day, err := time.Parse(dateLayout, violation.LogDate); err == nil {
+		dayStart := day.Unix()
+		dayEnd := day.AddDate(0, 0, 1).Unix()
+		record.DayStartAt = &dayStart
+		record.DayEndAt = &dayEnd
+	}
+	return record, true
+}
+
+func (p *Provider) ListHOSLogs(
+	ctx context.Context,
+	driverID string,
+	startAt int64,
+	endAt int64,
+) ([]services.ProviderHOSLogEntry, error) {
+	id, err := parseID(driverID)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate := time.Unix(startAt, 0).UTC()
+	endDate := time.Unix(endAt, 0).UTC()
+	logs, err := p.client.HOS.LogsAll(ctx, hos.LogsParams{
+		DriverIDs: []int64{id},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch motive hos logs: %w", err)
+	}
+
+	out := make([]services.ProviderHOSLogEntry, 0)
+	for i := range logs {
+		for j := range logs[i].Events {
+			entry := mapLogEvent(&logs[i].Events[j].Event)
+			if !logEntryOverlaps(&entry, startAt, endAt) {
+				continue
+			}
+			out = append(out, entry)
+		}
+	}
+	return out, nil
+}
+
+// logEntryOverlaps keeps the segments that touch the window; day logs are
+// fetched by date, so their first and last segments can fall outside it.
+func logEntryOverlaps(entry *services.ProviderHOSLogEntry, startAt, endAt int64) bool {
+	if entry.LogStartAt == 0 || entry.LogStartAt > endAt {
+		return false
+	}
+	return entry.LogEndAt == nil || *entry.LogEndAt >= startAt
+}
+
+func mapLogEvent(event *hos.LogEvent) services.ProviderHOSLogEntry {
+	out := services.ProviderHOSLogEntry{
+		HosStatusType: string(mapDutyStatus(event.Type)),
+		LogStartAt:    parseTime(event.StartTime),
+		Remark:        event.Notes,
+		Latitude:      event.Lat,
+		Longitude:     event.Lon,
+	}
+	if endAt := parseTime(event.EndTime); endAt > 0 {
+		out.LogEndAt = &endAt
+	}
+	if event.Vehicle != nil && event.Vehicle.ID != 0 {
+		out.VehicleID = formatID(event.Vehicle.ID)
+		out.VehicleName = event.Vehicle.Number
+	}
+	for _, codriver := range event.Codrivers {
+		if name := refName(codriver.FirstName, codriver.LastName); name != "" {
+			out.Codrivers = append(out.Codrivers, name)
+		}
+	}
+	return out
+}
+
+func (p *Provider) ListHOSDailyLogs(
+	ctx context.Context,
+	driverID string,
+	startDate string,
+	endDate string,
+) ([]services.ProviderHOSDailyLog, error) {
+	id, err := parseID(driverID)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(dateLayout, startDate)
+	if err != nil {
+		return nil, fmt.Errorf("parse motive daily log start date: %w", err)
+	}
+	end, err := time.Parse(dateLayout, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("parse motive daily log end date: %w", err)
+	}
+
+	logs, err := p.client.HOS.LogsAll(ctx, hos.LogsParams{
+		DriverIDs: []int64{id},
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch motive hos daily logs: %w", err)
+	}
+
+	out := make([]services.ProviderHOSDailyLog, 0, len(logs))
+	for i := range logs {
+		out = append(out, mapDailyLog(&logs[i]))
+	}
+	return out, nil
+}
+
+func mapDailyLog(log *hos.Log) services.ProviderHOSDailyLog {
+	out := services.ProviderHOSDailyLog{
+		StartAt:             parseTime(log.StartTime),
+		EndAt:               parseTime(log.EndTime),
+		DriveDistanceMeters: milesToMeters(log.TotalMiles),
+		IsCertified:         log.Certified,
+		ShippingDocs:        log.ShippingDocs,
+	}
+	if out.StartAt == 0 {
+		if day, err := time.Parse(dateLayout, log.Date); err == nil {
+			out.StartAt = day.Unix()
+			out.EndAt = day.AddDate(0, 0, 1).Unix()
+		}
+	}
+	if certifiedAt := parseTime(log.CertifiedAt); certifiedAt > 0 {
+		out.CertifiedAt = &certifiedAt
+	}
+	for _, vehicle := range log.Vehicles {
+		if vehicle.Number != "" {
+			out.VehicleNames = append(out.VehicleNames, vehicle.Number)
+		}
+	}
+	applyDailyDurations(&out, log.Events)
+	return out
+}
+
+// applyDailyDurations totals the day's segments by status, clipped to the day
+// so a segment that started the evening before only counts from midnight.
+func applyDailyDurations(out *services.ProviderHOSDailyLog, events []hos.LogEventEnvelope) {
+	for i := range events {
+		event := &events[i].Event
+		startAt := max(parseTime(event.StartTime), out.StartAt)
+		endAt := parseTime(event.EndTime)
+		if endAt == 0 && event.Duration > 0 {
+			endAt = parseTime(event.StartTime) + event.Duration
+		}
+		if out.EndAt > 0 && (endAt == 0 || endAt > out.EndAt) {
+			endAt = out.EndAt
+		}
+		if endAt <= startAt {
+			continue
+		}
+
+		durationMs := (endAt - startAt) * 1000
+		switch mapDutyStatus(event.Type) {
+		case telematics.DutyStatusDriving:
+			out.DriveDurationMs += durationMs
+		case telematics.DutyStatusOnDuty:
+			out.OnDutyDurationMs += durationMs
+		case telematics.DutyStatusOffDuty:
+			out.OffDutyDurationMs += durationMs
+		case telematics.DutyStatusSleeperBed:
+			out.SleeperBerthDurationMs += durationMs
+		case telematics.DutyStatusPersonalConveyance:
+			out.PersonalConveyanceDurationMs += durationMs
+		case telematics.DutyStatusYardMove:
+			out.YardMoveDurationMs += durationMs
+		}
+	}
+	out.ActiveDurationMs = out.DriveDurationMs + out.OnDutyDurationMs
+}
+
+func (p *Provider) ListDVIRs(
+	ctx context.Context,
+	startAt int64,
+	endAt int64,
+) ([]services.ProviderDVIR, error) {
+	startDate := time.Unix(startAt, 0).UTC()
+	endDate := time.Unix(endAt, 0).UTC()
+
+	reports, err := p.client.Inspections.ListAll(ctx, inspections.ListParams{
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch motive inspection reports: %w", err)
+	}
+
+	out := make([]services.ProviderDVIR, 0, len(reports))
+	for i := range reports {
+		out = append(out, mapDVIR(&reports[i]))
+	}
+	return out, nil
+}
+
+func mapDVIR(report *inspections.Report) services.ProviderDVIR {
+	inspectedAt := parseTime(report.Time)
+	out := services.ProviderDVIR{
+		ID:           formatID(report.ID),
+		Type:         report.Type,
+		SafetyStatus: report.Status,
+		StartAt:      inspectedAt,
+		EndAt:        inspectedAt,
+		Location:     report.Location,
+		Signed:       parseTime(report.DriverSignedAt) > 0,
+	}
+	if report.Driver != nil && report.Driver.ID != 0 {
+		out.DriverID = formatID(report.Driver.ID)
+		out.DriverName = refName(report.Driver.FirstName, report.Driver.LastName)
+	}
+	if report.Vehicle != nil && report.Vehicle.ID != 0 {
+		out.VehicleID = formatID(report.Vehicle.ID)
+	}
+	if report.Asset != nil && report.Asset.ID != 0 {
+		out.TrailerID = formatID(report.Asset.ID)
+		out.TrailerName = report.Asset.Name
+	}
+	if report.Odometer != nil {
+		odometer := milesToMeters(*report.Odometer)
+		out.OdometerMeters = &odometer
+	}
+	out.Defects = mapDVIRDefects(report.Defects)
+	return out
+}
+
+func mapDVIRDefects(defects []inspections.DefectEnvelope) []services.ProviderDVIRDefect {
+	if len(defects) == 0 {
+		return nil
+	}
+
+	out := make([]services.ProviderDVIRDefect, 0, len(defects))
+	for i := range defects {
+		item := &defects[i].Defect
+		defect := services.ProviderDVIRDefect{
+			ID:         formatID(item.ID),
+			DefectType: item.Category,
+			Comment:    item.Notes,
+		}
+		if resolvedAt := parseTime(item.ResolvedAt); resolvedAt > 0 {
+			defect.ResolvedAt = &resolvedAt
+		}
+		defect.Resolved = item.Status == inspections.StatusResolved || defect.ResolvedAt != nil
+		out = append(out, defect)
+	}
+	return out
+}
+
+// ListFormSubmissions returns nothing: Motive has no driver form submissions
+// in its public API, so form mappings only apply to Samsara units.
+func (p *Provider) ListFormSubmissions(
+	context.Context,
+	string,
+	int64,
+	int64,
+) ([]services.ProviderFormSubmission, error) {
+	return []services.ProviderFormSubmission{}, nil
+}
+
+// VerifyWebhookSignature checks the HMAC of the body alone. Motive sends no
+// timestamp, so timestamp, now and maxSkew do not apply; redeliveries are
+// absorbed by event deduplication.
+func (p *Provider) VerifyWebhookSignature(
+	secret string,
+	_ string,
+	body []byte,
+	signature string,
+	_ time.Time,
+	_ time.Duration,
+) error {
+	return webhooks.VerifySignature(secret, body, signature)
+}
+
+func (p *Provider) ParseWebhookEvent(body []byte) (*services.ProviderWebhookEvent, error) {
+	event, err := webhooks.ParseEvent(body)
+	if err != nil {
+		return nil, err
+	}
+
+	occurredAt := event.OccurredAt()
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	out := &services.ProviderWebhookEvent{
+		EventID:    event.EventID(),
+		EventType:  string(event.Action),
+		Kind:       services.ProviderEventKindOther,
+		OccurredAt: occurredAt.Unix(),
+		Payload:    event.Raw(),
+	}
+	if vehicleID := event.SubjectVehicleID(); vehicleID != 0 {
+		out.VehicleID = formatID(vehicleID)
+	}
+	if driverID := event.SubjectDriverID(); driverID != 0 {
+		out.DriverID = formatID(driverID)
+	}
+
+	switch event.Action {
+	case webhooks.ActionVehicleGeofenceEvent:
+		applyGeofence(&event, out)
+	case webhooks.ActionVehicleUpserted:
+		out.EventType = eventTypeVehicleUpdated
+	case webhooks.ActionVehicleLocationUpdated:
+		out.EventType = eventTypeVehicleLocation
+	case webhooks.ActionUserUpserted:
+		out.EventType = eventTypeDriverUpdated
+	case webhooks.ActionUserDutyStatusUpdated:
+		out.EventType = eventTypeDutyStatusUpdated
+	case webhooks.ActionHOSViolationUpserted:
+		out.EventType = eventTypeHOSViolation
+	case webhooks.ActionInspectionReportUpserted:
+		out.EventType = eventTypeInspectionReported
+	}
+
+	return out, nil
+}
+
+func applyGeofence(event *webhooks.Event, out *services.ProviderWebhookEvent) {
+	switch event.EventType {
+	case webhooks.GeofenceEventEnter:
+		out.Kind = services.ProviderEventKindGeofenceEntry
+		out.EventType = eventTypeGeofenceEntry
+	case webhooks.GeofenceEventExit:
+		out.Kind = services.ProviderEventKindGeofenceExit
+		out.EventType = eventTypeGeofenceExit
+	default:
+		return
+	}
+
+	geofence := &services.ProviderGeofenceEvent{
+		AddressName: event.GeofenceName,
+		VehicleID:   out.VehicleID,
+		VehicleVIN:  event.VIN,
+		DriverID:    out.DriverID,
+	}
+	if event.GeofenceExternalID != "" {
+		geofence.AddressExternalIDs = map[string]string{
+			"trenovaLocationId": event.GeofenceExternalID,
+		}
+	}
+	out.Geofence = geofence
+}
+
+// mapDutyStatus maps Motive's statuses onto the platform's. Oilfield waiting
+// time is on duty not driving, and anything unrecognized is kept as on duty,
+// as with Samsara statuses.
+func mapDutyStatus(status string) telematics.DutyStatus {
+	switch status {
+	case hos.DutyStatusOffDuty:
+		return telematics.DutyStatusOffDuty
+	case hos.DutyStatusSleeper:
+		return telematics.DutyStatusSleeperBed
+	case hos.DutyStatusDriving:
+		return telematics.DutyStatusDriving
+	case hos.DutyStatusYardMove:
+		return telematics.DutyStatusYardMove
+	case hos.DutyStatusPersonalConveyance:
+		return telematics.DutyStatusPersonalConveyance
+	default:
+		return telematics.DutyStatusOnDuty
+	}
+}
+
+func remainingMs(seconds int64) int64 {
+	return max(seconds, 0) * 1000
+}
+
+func overrunMs(seconds int64) int64 {
+	return max(-seconds, 0) * 1000
+}
+
+func milesToMeters(miles float64) int64 {
+	return int64(math.Round(miles * metersPerMile))
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func parseID(value string) (int64, error) {
+	id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid motive id %q", value)
+	}
+	return id, nil
+}
+
+func refName(firstName, lastName string) string {
+	return strings.TrimSpace(strings.TrimSpace(firstName) + " " + strings.TrimSpace(lastName))
+}
+
+func parseTime(value string) int64 {
+	if value == "" {
+		return 0
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0
+	}
+	return parsed.Unix()
+}
@@ -0,0 +1,164 @@
+package motiveprovider
+
+import (
+	"testing"
+
+	"github.com/emoss08/trenova/internal/core/domain/telematics"
+	"github.com/emoss08/trenova/internal/core/ports/services"
+	"github.com/emoss08/trenova/internal/core/services/hosprojection"
+	"github.com/emoss08/trenova/shared/motive/hos"
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+	"github.com/emoss08/trenova/shared/motive/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapDutyStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, telematics.DutyStatusSleeperBed, mapDutyStatus(hos.DutyStatusSleeper))
+	assert.Equal(t, telematics.DutyStatusDriving, mapDutyStatus(hos.DutyStatusDriving))
+	assert.Equal(t, telematics.DutyStatusYardMove, mapDutyStatus(hos.DutyStatusYardMove))
+	assert.Equal(t, telematics.DutyStatusOnDuty, mapDutyStatus(hos.DutyStatusWaiting))
+	assert.Equal(t, telematics.DutyStatusOnDuty, mapDutyStatus("somethingNew"),
+		"unknown statuses must count as on-duty, never as rest")
+}
+
+func TestMapClocksConvertsOverrunToViolation(t *testing.T) {
+	t.Parallel()
+
+	record := mapClocks(&users.DriverAvailableTime{
+		ID:         42,
+		DutyStatus: hos.DutyStatusDriving,
+		AvailableTime: users.AvailableTimeClocks{
+			Break: 1800,
+			Drive: -600,
+			Shift: 3600,
+			Cycle: 36000,
+		},
+		Recap:          &users.Recap{CycleTomorrow: 43200},
+		LastCycleReset: &users.CycleReset{EndTime: "2026-10-12T06:00:00Z"},
+		CurrentVehicle: &motivetypes.Ref{ID: 7},
+	})
+
+	assert.Equal(t, "42", record.DriverID)
+	assert.Equal(t, telematics.DutyStatusDriving, record.DutyStatus)
+	assert.Equal(t, int64(0), record.DriveRemainingMs)
+	assert.Equal(t, int64(600_000), record.ShiftDrivingViolationMs)
+	assert.Equal(t, int64(3_600_000), record.ShiftRemainingMs)
+	assert.Equal(t, int64(36_000_000), record.CycleRemainingMs)
+	assert.Equal(t, int64(43_200_000), record.CycleTomorrowMs)
+	assert.Equal(t, int64(0), record.CycleViolationMs)
+	assert.Equal(t, "7", record.CurrentVehicleID)
+	require.NotNil(t, record.CycleStartedAt)
+	assert.Equal(t, int64(1791784800), *record.CycleStartedAt)
+}
+
+func TestMapRulesetProducesProjectionLabels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		cycle     string
+		wantCycle int64
+		wantCan   bool
+	}{
+		{name: "property 70/8", cycle: "70_8", wantCycle: 70},
+		{name: "property 60/7", cycle: "60_7", wantCycle: 60},
+		{name: "canada south", cycle: "canada_south_70_7", wantCycle: 70, wantCan: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ruleset := mapRuleset(&users.User{Cycle: tt.cycle})
+			require.NotNil(t, ruleset)
+
+			limits := hosprojection.LimitsForRuleset(
+				ruleset.Cycle,
+				ruleset.Shift,
+				ruleset.Jurisdiction,
+			)
+			assert.Equal(t, tt.wantCycle*3_600_000, limits.CycleMs)
+			assert.Equal(t, tt.wantCan, ruleset.Jurisdiction != "")
+		})
+	}
+
+	assert.Nil(t, mapRuleset(&users.User{}))
+}
+
+func TestApplyDailyDurationsClipsToDay(t *testing.T) {
+	t.Parallel()
+
+	log := mapDailyLog(&hos.Log{
+		Date:       "2026-10-12",
+		StartTime:  "2026-10-12T00:00:00Z",
+		EndTime:    "2026-10-13T00:00:00Z",
+		TotalMiles: 100,
+		Events: []hos.LogEventEnvelope{
+			{Event: hos.LogEvent{
+				Type:      hos.DutyStatusSleeper,
+				StartTime: "2026-10-11T20:00:00Z",
+				EndTime:   "2026-10-12T06:00:00Z",
+			}},
+			{Event: hos.LogEvent{
+				Type:      hos.DutyStatusOnDuty,
+				StartTime: "2026-10-12T06:00:00Z",
+				EndTime:   "2026-10-12T07:00:00Z",
+			}},
+			{Event: hos.LogEvent{
+				Type:      hos.DutyStatusDriving,
+				StartTime: "2026-10-12T07:00:00Z",
+				EndTime:   "2026-10-12T12:00:00Z",
+			}},
+		},
+	})
+
+	assert.Equal(t, int64(6*3_600_000), log.SleeperBerthDurationMs)
+	assert.Equal(t, int64(3_600_000), log.OnDutyDurationMs)
+	assert.Equal(t, int64(5*3_600_000), log.DriveDurationMs)
+	assert.Equal(t, int64(6*3_600_000), log.ActiveDurationMs)
+	assert.Equal(t, int64(160934), log.DriveDistanceMeters)
+}
+
+func TestParseWebhookEventGeofence(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{
+		"action": "vehicle_geofence_event",
+		"id": 991,
+		"event_type": "enter",
+		"geofence_name": "Acme DC",
+		"geofence_external_id": "loc_123",
+		"vehicle_id": 7,
+		"vin": "1XKYD49X0NJ123456",
+		"driver_id": 42,
+		"start_time": "2026-10-12T15:04:05Z"
+	}`)
+
+	event, err := New(nil).ParseWebhookEvent(body)
+	require.NoError(t, err)
+
+	assert.Equal(t, services.ProviderEventKindGeofenceEntry, event.Kind)
+	assert.Equal(t, eventTypeGeofenceEntry, event.EventType)
+	assert.Equal(t, "7", event.VehicleID)
+	assert.Equal(t, "42", event.DriverID)
+	require.NotNil(t, event.Geofence)
+	assert.Equal(t, "loc_123", event.Geofence.AddressExternalIDs["trenovaLocationId"])
+	assert.Equal(t, "1XKYD49X0NJ123456", event.Geofence.VehicleVIN)
+	assert.NotEmpty(t, event.EventID)
+}
+
+func TestParseWebhookEventOtherAction(t *testing.T) {
+	t.Parallel()
+
+	event, err := New(nil).ParseWebhookEvent(
+		[]byte(`{"action":"user_duty_status_updated","id":42,"duty_status":"driving"}`),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, services.ProviderEventKindOther, event.Kind)
+	assert.Equal(t, eventTypeDutyStatusUpdated, event.EventType)
+	assert.Nil(t, event.Geofence)
+}
@@ -38,6 +38,74 @@ func (_m *MockDispatchAutoAssignService) EXPECT() *MockDispatchAutoAssignService
 	return &MockDispatchAutoAssignService_Expecter{mock: &_m.Mock}
 }
 
+// AcceptTour provides a mock function for the type MockDispatchAutoAssignService
+func (_mock *MockDispatchAutoAssignService) AcceptTour(ctx context.Context, req *services.DispatchAcceptTourRequest) (*services.DispatchTourAcceptance, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptTour")
+	}
+
+	var r0 *services.DispatchTourAcceptance
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.DispatchAcceptTourRequest) (*services.DispatchTourAcceptance, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.DispatchAcceptTourRequest) *services.DispatchTourAcceptance); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.DispatchTourAcceptance)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.DispatchAcceptTourRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDispatchAutoAssignService_AcceptTour_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptTour'
+type MockDispatchAutoAssignService_AcceptTour_Call struct {
+	*mock.Call
+}
+
+// AcceptTour is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *services.DispatchAcceptTourRequest
+func (_e *MockDispatchAutoAssignService_Expecter) AcceptTour(ctx any, req any) *MockDispatchAutoAssignService_AcceptTour_Call {
+	return &MockDispatchAutoAssignService_AcceptTour_Call{Call: _e.mock.On("AcceptTour", ctx, req)}
+}
+
+func (_c *MockDispatchAutoAssignService_AcceptTour_Call) Run(run func(ctx context.Context, req *services.DispatchAcceptTourRequest)) *MockDispatchAutoAssignService_AcceptTour_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.DispatchAcceptTourRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.DispatchAcceptTourRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDispatchAutoAssignService_AcceptTour_Call) Return(dispatchTourAcceptance *services.DispatchTourAcceptance, err error) *MockDispatchAutoAssignService_AcceptTour_Call {
+	_c.Call.Return(dispatchTourAcceptance, err)
+	return _c
+}
+
+func (_c *MockDispatchAutoAssignService_AcceptTour_Call) RunAndReturn(run func(ctx context.Context, req *services.DispatchAcceptTourRequest) (*services.DispatchTourAcceptance, error)) *MockDispatchAutoAssignService_AcceptTour_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Plan provides a mock function for the type MockDispatchAutoAssignService
 func (_mock *MockDispatchAutoAssignService) Plan(ctx context.Context, req *services.DispatchPlanRequest) (*services.DispatchPlan, error) {
 	ret := _mock.Called(ctx, req)
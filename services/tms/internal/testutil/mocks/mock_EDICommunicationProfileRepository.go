@@ -242,6 +242,74 @@ func (_c *MockEDICommunicationProfileRepository_GetActiveAS2ProfileByIdentifiers
 	return _c
 }
 
+// GetActiveHTTPSProfileByID provides a mock function for the type MockEDICommunicationProfileRepository
+func (_mock *MockEDICommunicationProfileRepository) GetActiveHTTPSProfileByID(ctx context.Context, req repositories.GetActiveHTTPSProfileByIDRequest) (*edi.EDICommunicationProfile, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveHTTPSProfileByID")
+	}
+
+	var r0 *edi.EDICommunicationProfile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.GetActiveHTTPSProfileByIDRequest) (*edi.EDICommunicationProfile, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.GetActiveHTTPSProfileByIDRequest) *edi.EDICommunicationProfile); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*edi.EDICommunicationProfile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repositories.GetActiveHTTPSProfileByIDRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveHTTPSProfileByID'
+type MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call struct {
+	*mock.Call
+}
+
+// GetActiveHTTPSProfileByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repositories.GetActiveHTTPSProfileByIDRequest
+func (_e *MockEDICommunicationProfileRepository_Expecter) GetActiveHTTPSProfileByID(ctx any, req any) *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call {
+	return &MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call{Call: _e.mock.On("GetActiveHTTPSProfileByID", ctx, req)}
+}
+
+func (_c *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call) Run(run func(ctx context.Context, req repositories.GetActiveHTTPSProfileByIDRequest)) *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.GetActiveHTTPSProfileByIDRequest
+		if args[1] != nil {
+			arg1 = args[1].(repositories.GetActiveHTTPSProfileByIDRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call) Return(eDICommunicationProfile *edi.EDICommunicationProfile, err error) *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call {
+	_c.Call.Return(eDICommunicationProfile, err)
+	return _c
+}
+
+func (_c *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call) RunAndReturn(run func(ctx context.Context, req repositories.GetActiveHTTPSProfileByIDRequest) (*edi.EDICommunicationProfile, error)) *MockEDICommunicationProfileRepository_GetActiveHTTPSProfileByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetActiveProfileByPartner provides a mock function for the type MockEDICommunicationProfileRepository
 func (_mock *MockEDICommunicationProfileRepository) GetActiveProfileByPartner(ctx context.Context, req repositories.GetActiveEDICommunicationProfileByPartnerRequest) (*edi.EDICommunicationProfile, error) {
 	ret := _mock.Called(ctx, req)
@@ -453,6 +453,74 @@ func (_c *MockInvoiceRepository_GetByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetByNumber provides a mock function for the type MockInvoiceRepository
+func (_mock *MockInvoiceRepository) GetByNumber(ctx context.Context, req repositories.GetInvoiceByNumberRequest) (*invoice.Invoice, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNumber")
+	}
+
+	var r0 *invoice.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.GetInvoiceByNumberRequest) (*invoice.Invoice, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.GetInvoiceByNumberRequest) *invoice.Invoice); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invoice.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repositories.GetInvoiceByNumberRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvoiceRepository_GetByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByNumber'
+type MockInvoiceRepository_GetByNumber_Call struct {
+	*mock.Call
+}
+
+// GetByNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req repositories.GetInvoiceByNumberRequest
+func (_e *MockInvoiceRepository_Expecter) GetByNumber(ctx any, req any) *MockInvoiceRepository_GetByNumber_Call {
+	return &MockInvoiceRepository_GetByNumber_Call{Call: _e.mock.On("GetByNumber", ctx, req)}
+}
+
+func (_c *MockInvoiceRepository_GetByNumber_Call) Run(run func(ctx context.Context, req repositories.GetInvoiceByNumberRequest)) *MockInvoiceRepository_GetByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.GetInvoiceByNumberRequest
+		if args[1] != nil {
+			arg1 = args[1].(repositories.GetInvoiceByNumberRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvoiceRepository_GetByNumber_Call) Return(invoice1 *invoice.Invoice, err error) *MockInvoiceRepository_GetByNumber_Call {
+	_c.Call.Return(invoice1, err)
+	return _c
+}
+
+func (_c *MockInvoiceRepository_GetByNumber_Call) RunAndReturn(run func(ctx context.Context, req repositories.GetInvoiceByNumberRequest) (*invoice.Invoice, error)) *MockInvoiceRepository_GetByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDocumentShareToken provides a mock function for the type MockInvoiceRepository
 func (_mock *MockInvoiceRepository) GetDocumentShareToken(ctx context.Context, req repositories.GetInvoiceDocumentShareTokenRequest) (*invoice.DocumentShareToken, error) {
 	ret := _mock.Called(ctx, req)
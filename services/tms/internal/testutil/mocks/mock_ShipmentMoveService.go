@@ -107,6 +107,74 @@ func (_c *MockShipmentMoveService_BulkUpdateStatus_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RelayMove provides a mock function for the type MockShipmentMoveService
+func (_mock *MockShipmentMoveService) RelayMove(ctx context.Context, req *repositories.RelayMoveRequest) (*repositories.SplitMoveResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RelayMove")
+	}
+
+	var r0 *repositories.SplitMoveResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *repositories.RelayMoveRequest) (*repositories.SplitMoveResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *repositories.RelayMoveRequest) *repositories.SplitMoveResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repositories.SplitMoveResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *repositories.RelayMoveRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockShipmentMoveService_RelayMove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RelayMove'
+type MockShipmentMoveService_RelayMove_Call struct {
+	*mock.Call
+}
+
+// RelayMove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *repositories.RelayMoveRequest
+func (_e *MockShipmentMoveService_Expecter) RelayMove(ctx any, req any) *MockShipmentMoveService_RelayMove_Call {
+	return &MockShipmentMoveService_RelayMove_Call{Call: _e.mock.On("RelayMove", ctx, req)}
+}
+
+func (_c *MockShipmentMoveService_RelayMove_Call) Run(run func(ctx context.Context, req *repositories.RelayMoveRequest)) *MockShipmentMoveService_RelayMove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *repositories.RelayMoveRequest
+		if args[1] != nil {
+			arg1 = args[1].(*repositories.RelayMoveRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShipmentMoveService_RelayMove_Call) Return(splitMoveResponse *repositories.SplitMoveResponse, err error) *MockShipmentMoveService_RelayMove_Call {
+	_c.Call.Return(splitMoveResponse, err)
+	return _c
+}
+
+func (_c *MockShipmentMoveService_RelayMove_Call) RunAndReturn(run func(ctx context.Context, req *repositories.RelayMoveRequest) (*repositories.SplitMoveResponse, error)) *MockShipmentMoveService_RelayMove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SplitMove provides a mock function for the type MockShipmentMoveService
 func (_mock *MockShipmentMoveService) SplitMove(ctx context.Context, req *repositories.SplitMoveRequest) (*repositories.SplitMoveResponse, error) {
 	ret := _mock.Called(ctx, req)
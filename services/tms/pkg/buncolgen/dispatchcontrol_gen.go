@@ -71,6 +71,7 @@ var DispatchControlColumns = struct {
 	PlanningMode                         Column // "planning_mode" → qualified: "dc.planning_mode"
 	HorizonMaxMovesPerDriver             Column // "horizon_max_moves_per_driver" → qualified: "dc.horizon_max_moves_per_driver"
 	HorizonSearchIterations              Column // "horizon_search_iterations" → qualified: "dc.horizon_search_iterations"
+	HorizonMaxDaysOut                    Column // "horizon_max_days_out" → qualified: "dc.horizon_max_days_out"
 	ComplianceEnforcementLevel           Column // "compliance_enforcement_level" → qualified: "dc.compliance_enforcement_level"
 	RecordServiceFailures                Column // "record_service_failures" → qualified: "dc.record_service_failures"
 	ServiceFailureTarget                 Column // "service_failure_target" → qualified: "dc.service_failure_target"
@@ -101,6 +102,7 @@ var DispatchControlColumns = struct {
 	PlanningMode:                         NewColumn("planning_mode", "dc"),
 	HorizonMaxMovesPerDriver:             NewColumn("horizon_max_moves_per_driver", "dc"),
 	HorizonSearchIterations:              NewColumn("horizon_search_iterations", "dc"),
+	HorizonMaxDaysOut:                    NewColumn("horizon_max_days_out", "dc"),
 	ComplianceEnforcementLevel:           NewColumn("compliance_enforcement_level", "dc"),
 	RecordServiceFailures:                NewColumn("record_service_failures", "dc"),
 	ServiceFailureTarget:                 NewColumn("service_failure_target", "dc"),
@@ -137,6 +139,7 @@ var DispatchControlFieldMap = map[string]string{
 	"planningMode":                         "planning_mode",
 	"horizonMaxMovesPerDriver":             "horizon_max_moves_per_driver",
 	"horizonSearchIterations":              "horizon_search_iterations",
+	"horizonMaxDaysOut":                    "horizon_max_days_out",
 	"complianceEnforcementLevel":           "compliance_enforcement_level",
 	"recordServiceFailures":                "record_service_failures",
 	"serviceFailureTarget":                 "service_failure_target",
@@ -171,6 +174,7 @@ var DispatchControlInsertableColumns = []string{
 	"planning_mode",
 	"horizon_max_moves_per_driver",
 	"horizon_search_iterations",
+	"horizon_max_days_out",
 	"compliance_enforcement_level",
 	"record_service_failures",
 	"service_failure_target",
@@ -252,6 +256,7 @@ var DispatchControlFilter = struct {
 	PlanningMode                         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "planningMode" → DB: "planning_mode"
 	HorizonMaxMovesPerDriver             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "horizonMaxMovesPerDriver" → DB: "horizon_max_moves_per_driver"
 	HorizonSearchIterations              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "horizonSearchIterations" → DB: "horizon_search_iterations"
+	HorizonMaxDaysOut                    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "horizonMaxDaysOut" → DB: "horizon_max_days_out"
 	ComplianceEnforcementLevel           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "complianceEnforcementLevel" → DB: "compliance_enforcement_level"
 	RecordServiceFailures                func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "recordServiceFailures" → DB: "record_service_failures"
 	ServiceFailureTarget                 func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "serviceFailureTarget" → DB: "service_failure_target"
@@ -326,6 +331,9 @@ var DispatchControlFilter = struct {
 	HorizonSearchIterations: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("horizonSearchIterations", op, value)
 	},
+	HorizonMaxDaysOut: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("horizonMaxDaysOut", op, value)
+	},
 	ComplianceEnforcementLevel: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("complianceEnforcementLevel", op, value)
 	},
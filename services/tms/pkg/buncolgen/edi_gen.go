@@ -352,6 +352,265 @@ var CarrierInvoiceFilter = struct {
 	},
 }
 
+// ---------------------------------------------------------------------------
+// EDICertificationRun — table "edi_certification_runs", alias "ecr"
+// ---------------------------------------------------------------------------
+
+// EDICertificationRunTable holds the table name, alias, and primary key columns
+// for the "edi_certification_runs" table. The alias "ecr" is used in all generated
+// SQL fragments (e.g. "ecr.id = ?").
+var EDICertificationRunTable = TableInfo{
+	Name:       "edi_certification_runs",
+	Alias:      "ecr",
+	PrimaryKey: []string{"id", "business_unit_id", "organization_id"},
+}
+
+// EDICertificationRunColumns provides type-safe column references for the "edi_certification_runs" table.
+// Each field is a [Column] whose methods return pre-computed SQL fragments.
+//
+// Use String() when Bun manages the alias (model-aware queries):
+//
+//	q.Column(EDICertificationRunColumns.ID.String())
+//	// SELECT ecr.id FROM edi_certification_runs AS ecr
+//
+// Use expression helpers for raw WHERE/ORDER BY clauses:
+//
+//	q.Where(EDICertificationRunColumns.ID.Eq(), id)           // WHERE ecr.id = ?
+//	q.Order(EDICertificationRunColumns.CreatedAt.OrderDesc())  // ORDER BY ecr.created_at DESC
+var EDICertificationRunColumns = struct {
+	ID                     Column // "id" → qualified: "ecr.id"
+	BusinessUnitID         Column // "business_unit_id" → qualified: "ecr.business_unit_id"
+	OrganizationID         Column // "organization_id" → qualified: "ecr.organization_id"
+	EDIPartnerID           Column // "edi_partner_id" → qualified: "ecr.edi_partner_id"
+	CommunicationProfileID Column // "communication_profile_id" → qualified: "ecr.communication_profile_id"
+	EDIConnectionID        Column // "edi_connection_id" → qualified: "ecr.edi_connection_id"
+	ShipmentID             Column // "shipment_id" → qualified: "ecr.shipment_id"
+	InvoiceID              Column // "invoice_id" → qualified: "ecr.invoice_id"
+	Status                 Column // "status" → qualified: "ecr.status"
+	Steps                  Column // "steps" → qualified: "ecr.steps"
+	ResponseTimeoutSeconds Column // "response_timeout_seconds" → qualified: "ecr.response_timeout_seconds"
+	ExpectedStatusCount    Column // "expected_status_count" → qualified: "ecr.expected_status_count"
+	FailureReason          Column // "failure_reason" → qualified: "ecr.failure_reason"
+	StartedByID            Column // "started_by_id" → qualified: "ecr.started_by_id"
+	StartedAt              Column // "started_at" → qualified: "ecr.started_at"
+	CompletedAt            Column // "completed_at" → qualified: "ecr.completed_at"
+	Version                Column // "version" → qualified: "ecr.version"
+	CreatedAt              Column // "created_at" → qualified: "ecr.created_at"
+	UpdatedAt              Column // "updated_at" → qualified: "ecr.updated_at"
+}{
+	ID:                     NewColumn("id", "ecr"),
+	BusinessUnitID:         NewColumn("business_unit_id", "ecr"),
+	OrganizationID:         NewColumn("organization_id", "ecr"),
+	EDIPartnerID:           NewColumn("edi_partner_id", "ecr"),
+	CommunicationProfileID: NewColumn("communication_profile_id", "ecr"),
+	EDIConnectionID:        NewColumn("edi_connection_id", "ecr"),
+	ShipmentID:             NewColumn("shipment_id", "ecr"),
+	InvoiceID:              NewColumn("invoice_id", "ecr"),
+	Status:                 NewColumn("status", "ecr"),
+	Steps:                  NewColumn("steps", "ecr"),
+	ResponseTimeoutSeconds: NewColumn("response_timeout_seconds", "ecr"),
+	ExpectedStatusCount:    NewColumn("expected_status_count", "ecr"),
+	FailureReason:          NewColumn("failure_reason", "ecr"),
+	StartedByID:            NewColumn("started_by_id", "ecr"),
+	StartedAt:              NewColumn("started_at", "ecr"),
+	CompletedAt:            NewColumn("completed_at", "ecr"),
+	Version:                NewColumn("version", "ecr"),
+	CreatedAt:              NewColumn("created_at", "ecr"),
+	UpdatedAt:              NewColumn("updated_at", "ecr"),
+}
+
+// EDICertificationRunFieldMap maps JSON API field names to database column names.
+// The QueryBuilder uses this to translate filter/sort requests from the frontend
+// (e.g. "firstName") into SQL column references (e.g. "first_name") without reflection.
+// This is returned by EDICertificationRun.GetStaticFieldMap().
+var EDICertificationRunFieldMap = map[string]string{
+	"id":                     "id",
+	"businessUnitId":         "business_unit_id",
+	"organizationId":         "organization_id",
+	"ediPartnerId":           "edi_partner_id",
+	"communicationProfileId": "communication_profile_id",
+	"ediConnectionId":        "edi_connection_id",
+	"shipmentId":             "shipment_id",
+	"invoiceId":              "invoice_id",
+	"status":                 "status",
+	"steps":                  "steps",
+	"responseTimeoutSeconds": "response_timeout_seconds",
+	"expectedStatusCount":    "expected_status_count",
+	"failureReason":          "failure_reason",
+	"startedById":            "started_by_id",
+	"startedAt":              "started_at",
+	"completedAt":            "completed_at",
+	"version":                "version",
+	"createdAt":              "created_at",
+	"updatedAt":              "updated_at",
+}
+
+// EDICertificationRunInsertableColumns lists column names suitable for INSERT statements on the "edi_certification_runs" table.
+// Excludes scanonly columns (e.g. search_vector, rank) that are computed by PostgreSQL.
+var EDICertificationRunInsertableColumns = []string{
+	"id",
+	"business_unit_id",
+	"organization_id",
+	"edi_partner_id",
+	"communication_profile_id",
+	"edi_connection_id",
+	"shipment_id",
+	"invoice_id",
+	"status",
+	"steps",
+	"response_timeout_seconds",
+	"expected_status_count",
+	"failure_reason",
+	"started_by_id",
+	"started_at",
+	"completed_at",
+	"version",
+	"created_at",
+	"updated_at",
+}
+
+// EDICertificationRunRelations provides type-safe names for Bun eager-loading.
+// Use these instead of string literals in .Relation() calls to get compile-time safety.
+//
+//	q.Relation(EDICertificationRunRelations.Partner)
+//	// Bun eager-loads the Partner association via a separate query
+var EDICertificationRunRelations = struct {
+	Partner              string
+	CommunicationProfile string
+}{
+	Partner:              "Partner",
+	CommunicationProfile: "CommunicationProfile",
+}
+
+// EDICertificationRunScopeTenant restricts a query to a single tenant by adding:
+//
+//	WHERE ecr.organization_id = ? AND ecr.business_unit_id = ?
+//
+// Returns the same *bun.SelectQuery so it can be chained fluently:
+//
+//	buncolgen.EDICertificationRunScopeTenant(sq, ti).
+//		Where(buncolgen.EDICertificationRunColumns.ID.Eq(), id)
+func EDICertificationRunScopeTenant(q *bun.SelectQuery, ti pagination.TenantInfo) *bun.SelectQuery {
+	return ScopeTenant(q, EDICertificationRunColumns.OrganizationID, EDICertificationRunColumns.BusinessUnitID, ti)
+}
+
+// EDICertificationRunScopeTenantUpdate restricts an update query to a single tenant.
+// Use this inside UpdateQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
+//		return buncolgen.EDICertificationRunScopeTenantUpdate(uq, req.TenantInfo).
+//			Where(buncolgen.EDICertificationRunColumns.ID.In(), bun.List(ids))
+//	})
+func EDICertificationRunScopeTenantUpdate(q *bun.UpdateQuery, ti pagination.TenantInfo) *bun.UpdateQuery {
+	return ScopeTenantUpdate(q, EDICertificationRunColumns.OrganizationID, EDICertificationRunColumns.BusinessUnitID, ti)
+}
+
+// EDICertificationRunScopeTenantDelete restricts a delete query to a single tenant.
+// Use this inside DeleteQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(dq *bun.DeleteQuery) *bun.DeleteQuery {
+//		return buncolgen.EDICertificationRunScopeTenantDelete(dq, req.TenantInfo).
+//			Where(buncolgen.EDICertificationRunColumns.ID.Eq(), id)
+//	})
+func EDICertificationRunScopeTenantDelete(q *bun.DeleteQuery, ti pagination.TenantInfo) *bun.DeleteQuery {
+	return ScopeTenantDelete(q, EDICertificationRunColumns.OrganizationID, EDICertificationRunColumns.BusinessUnitID, ti)
+}
+
+// EDICertificationRunApplyTenant returns a closure for SelectQuery.Apply() that scopes to a single tenant.
+// Use this instead of wrapping ScopeTenant in an anonymous function:
+//
+//	q.Apply(buncolgen.EDICertificationRunApplyTenant(tenantInfo))
+func EDICertificationRunApplyTenant(ti pagination.TenantInfo) func(*bun.SelectQuery) *bun.SelectQuery {
+	return ApplyTenant(EDICertificationRunColumns.OrganizationID, EDICertificationRunColumns.BusinessUnitID, ti)
+}
+
+// EDICertificationRunFilter builds [domaintypes.FieldFilter] values using the correct JSON
+// field names for the "edi_certification_runs" table. Pass these to the QueryBuilder's ApplyFilters.
+//
+// The JSON field name is baked in — you only provide the operator and value:
+//
+//	EDICertificationRunFilter.ID(dbtype.OpEq, value)
+//	// produces FieldFilter{Field: "id", Operator: "eq", Value: value}
+var EDICertificationRunFilter = struct {
+	ID                     func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "id" → DB: "id"
+	BusinessUnitID         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "businessUnitId" → DB: "business_unit_id"
+	OrganizationID         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "organizationId" → DB: "organization_id"
+	EDIPartnerID           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ediPartnerId" → DB: "edi_partner_id"
+	CommunicationProfileID func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "communicationProfileId" → DB: "communication_profile_id"
+	EDIConnectionID        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ediConnectionId" → DB: "edi_connection_id"
+	ShipmentID             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "shipmentId" → DB: "shipment_id"
+	InvoiceID              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "invoiceId" → DB: "invoice_id"
+	Status                 func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "status" → DB: "status"
+	Steps                  func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "steps" → DB: "steps"
+	ResponseTimeoutSeconds func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "responseTimeoutSeconds" → DB: "response_timeout_seconds"
+	ExpectedStatusCount    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "expectedStatusCount" → DB: "expected_status_count"
+	FailureReason          func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "failureReason" → DB: "failure_reason"
+	StartedByID            func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "startedById" → DB: "started_by_id"
+	StartedAt              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "startedAt" → DB: "started_at"
+	CompletedAt            func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "completedAt" → DB: "completed_at"
+	Version                func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "version" → DB: "version"
+	CreatedAt              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "createdAt" → DB: "created_at"
+	UpdatedAt              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "updatedAt" → DB: "updated_at"
+}{
+	ID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("id", op, value)
+	},
+	BusinessUnitID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("businessUnitId", op, value)
+	},
+	OrganizationID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("organizationId", op, value)
+	},
+	EDIPartnerID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("ediPartnerId", op, value)
+	},
+	CommunicationProfileID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("communicationProfileId", op, value)
+	},
+	EDIConnectionID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("ediConnectionId", op, value)
+	},
+	ShipmentID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("shipmentId", op, value)
+	},
+	InvoiceID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("invoiceId", op, value)
+	},
+	Status: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("status", op, value)
+	},
+	Steps: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("steps", op, value)
+	},
+	ResponseTimeoutSeconds: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("responseTimeoutSeconds", op, value)
+	},
+	ExpectedStatusCount: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("expectedStatusCount", op, value)
+	},
+	FailureReason: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("failureReason", op, value)
+	},
+	StartedByID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("startedById", op, value)
+	},
+	StartedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("startedAt", op, value)
+	},
+	CompletedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("completedAt", op, value)
+	},
+	Version: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("version", op, value)
+	},
+	CreatedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("createdAt", op, value)
+	},
+	UpdatedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("updatedAt", op, value)
+	},
+}
+
 // ---------------------------------------------------------------------------
 // EDICodeListDefinition — table "edi_code_list_definitions", alias "ecld"
 // ---------------------------------------------------------------------------
@@ -993,6 +1252,260 @@ var EDIConnectionFilter = struct {
 	},
 }
 
+// ---------------------------------------------------------------------------
+// EDIControlNumberLedgerEntry — table "edi_control_number_ledger_entries", alias "ecnl"
+// ---------------------------------------------------------------------------
+
+// EDIControlNumberLedgerEntryTable holds the table name, alias, and primary key columns
+// for the "edi_control_number_ledger_entries" table. The alias "ecnl" is used in all generated
+// SQL fragments (e.g. "ecnl.id = ?").
+var EDIControlNumberLedgerEntryTable = TableInfo{
+	Name:       "edi_control_number_ledger_entries",
+	Alias:      "ecnl",
+	PrimaryKey: []string{"id", "business_unit_id", "organization_id"},
+}
+
+// EDIControlNumberLedgerEntryColumns provides type-safe column references for the "edi_control_number_ledger_entries" table.
+// Each field is a [Column] whose methods return pre-computed SQL fragments.
+//
+// Use String() when Bun manages the alias (model-aware queries):
+//
+//	q.Column(EDIControlNumberLedgerEntryColumns.ID.String())
+//	// SELECT ecnl.id FROM edi_control_number_ledger_entries AS ecnl
+//
+// Use expression helpers for raw WHERE/ORDER BY clauses:
+//
+//	q.Where(EDIControlNumberLedgerEntryColumns.ID.Eq(), id)           // WHERE ecnl.id = ?
+//	q.Order(EDIControlNumberLedgerEntryColumns.CreatedAt.OrderDesc())  // ORDER BY ecnl.created_at DESC
+var EDIControlNumberLedgerEntryColumns = struct {
+	ID                    Column // "id" → qualified: "ecnl.id"
+	BusinessUnitID        Column // "business_unit_id" → qualified: "ecnl.business_unit_id"
+	OrganizationID        Column // "organization_id" → qualified: "ecnl.organization_id"
+	EDIPartnerID          Column // "edi_partner_id" → qualified: "ecnl.edi_partner_id"
+	Direction             Column // "direction" → qualified: "ecnl.direction"
+	Kind                  Column // "kind" → qualified: "ecnl.kind"
+	SequenceScope         Column // "sequence_scope" → qualified: "ecnl.sequence_scope"
+	ControlNumber         Column // "control_number" → qualified: "ecnl.control_number"
+	Sequence              Column // "sequence" → qualified: "ecnl.sequence"
+	TransactionSet        Column // "transaction_set" → qualified: "ecnl.transaction_set"
+	InboundFileID         Column // "inbound_file_id" → qualified: "ecnl.inbound_file_id"
+	MessageID             Column // "message_id" → qualified: "ecnl.message_id"
+	ContentHash           Column // "content_hash" → qualified: "ecnl.content_hash"
+	Anomaly               Column // "anomaly" → qualified: "ecnl.anomaly"
+	ExpectedControlNumber Column // "expected_control_number" → qualified: "ecnl.expected_control_number"
+	DuplicateOfID         Column // "duplicate_of_id" → qualified: "ecnl.duplicate_of_id"
+	Rejected              Column // "rejected" → qualified: "ecnl.rejected"
+	Outcome               Column // "outcome" → qualified: "ecnl.outcome"
+	RecordedAt            Column // "recorded_at" → qualified: "ecnl.recorded_at"
+	CreatedAt             Column // "created_at" → qualified: "ecnl.created_at"
+}{
+	ID:                    NewColumn("id", "ecnl"),
+	BusinessUnitID:        NewColumn("business_unit_id", "ecnl"),
+	OrganizationID:        NewColumn("organization_id", "ecnl"),
+	EDIPartnerID:          NewColumn("edi_partner_id", "ecnl"),
+	Direction:             NewColumn("direction", "ecnl"),
+	Kind:                  NewColumn("kind", "ecnl"),
+	SequenceScope:         NewColumn("sequence_scope", "ecnl"),
+	ControlNumber:         NewColumn("control_number", "ecnl"),
+	Sequence:              NewColumn("sequence", "ecnl"),
+	TransactionSet:        NewColumn("transaction_set", "ecnl"),
+	InboundFileID:         NewColumn("inbound_file_id", "ecnl"),
+	MessageID:             NewColumn("message_id", "ecnl"),
+	ContentHash:           NewColumn("content_hash", "ecnl"),
+	Anomaly:               NewColumn("anomaly", "ecnl"),
+	ExpectedControlNumber: NewColumn("expected_control_number", "ecnl"),
+	DuplicateOfID:         NewColumn("duplicate_of_id", "ecnl"),
+	Rejected:              NewColumn("rejected", "ecnl"),
+	Outcome:               NewColumn("outcome", "ecnl"),
+	RecordedAt:            NewColumn("recorded_at", "ecnl"),
+	CreatedAt:             NewColumn("created_at", "ecnl"),
+}
+
+// EDIControlNumberLedgerEntryFieldMap maps JSON API field names to database column names.
+// The QueryBuilder uses this to translate filter/sort requests from the frontend
+// (e.g. "firstName") into SQL column references (e.g. "first_name") without reflection.
+// This is returned by EDIControlNumberLedgerEntry.GetStaticFieldMap().
+var EDIControlNumberLedgerEntryFieldMap = map[string]string{
+	"id":                    "id",
+	"businessUnitId":        "business_unit_id",
+	"organizationId":        "organization_id",
+	"ediPartnerId":          "edi_partner_id",
+	"direction":             "direction",
+	"kind":                  "kind",
+	"sequenceScope":         "sequence_scope",
+	"controlNumber":         "control_number",
+	"sequence":              "sequence",
+	"transactionSet":        "transaction_set",
+	"inboundFileId":         "inbound_file_id",
+	"messageId":             "message_id",
+	"contentHash":           "content_hash",
+	"anomaly":               "anomaly",
+	"expectedControlNumber": "expected_control_number",
+	"duplicateOfId":         "duplicate_of_id",
+	"rejected":              "rejected",
+	"outcome":               "outcome",
+	"recordedAt":            "recorded_at",
+	"createdAt":             "created_at",
+}
+
+// EDIControlNumberLedgerEntryInsertableColumns lists column names suitable for INSERT statements on the "edi_control_number_ledger_entries" table.
+// Excludes scanonly columns (e.g. search_vector, rank) that are computed by PostgreSQL.
+var EDIControlNumberLedgerEntryInsertableColumns = []string{
+	"id",
+	"business_unit_id",
+	"organization_id",
+	"edi_partner_id",
+	"direction",
+	"kind",
+	"sequence_scope",
+	"control_number",
+	"sequence",
+	"transaction_set",
+	"inbound_file_id",
+	"message_id",
+	"content_hash",
+	"anomaly",
+	"expected_control_number",
+	"duplicate_of_id",
+	"rejected",
+	"outcome",
+	"recorded_at",
+	"created_at",
+}
+
+// EDIControlNumberLedgerEntryScopeTenant restricts a query to a single tenant by adding:
+//
+//	WHERE ecnl.organization_id = ? AND ecnl.business_unit_id = ?
+//
+// Returns the same *bun.SelectQuery so it can be chained fluently:
+//
+//	buncolgen.EDIControlNumberLedgerEntryScopeTenant(sq, ti).
+//		Where(buncolgen.EDIControlNumberLedgerEntryColumns.ID.Eq(), id)
+func EDIControlNumberLedgerEntryScopeTenant(q *bun.SelectQuery, ti pagination.TenantInfo) *bun.SelectQuery {
+	return ScopeTenant(q, EDIControlNumberLedgerEntryColumns.OrganizationID, EDIControlNumberLedgerEntryColumns.BusinessUnitID, ti)
+}
+
+// EDIControlNumberLedgerEntryScopeTenantUpdate restricts an update query to a single tenant.
+// Use this inside UpdateQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
+//		return buncolgen.EDIControlNumberLedgerEntryScopeTenantUpdate(uq, req.TenantInfo).
+//			Where(buncolgen.EDIControlNumberLedgerEntryColumns.ID.In(), bun.List(ids))
+//	})
+func EDIControlNumberLedgerEntryScopeTenantUpdate(q *bun.UpdateQuery, ti pagination.TenantInfo) *bun.UpdateQuery {
+	return ScopeTenantUpdate(q, EDIControlNumberLedgerEntryColumns.OrganizationID, EDIControlNumberLedgerEntryColumns.BusinessUnitID, ti)
+}
+
+// EDIControlNumberLedgerEntryScopeTenantDelete restricts a delete query to a single tenant.
+// Use this inside DeleteQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(dq *bun.DeleteQuery) *bun.DeleteQuery {
+//		return buncolgen.EDIControlNumberLedgerEntryScopeTenantDelete(dq, req.TenantInfo).
+//			Where(buncolgen.EDIControlNumberLedgerEntryColumns.ID.Eq(), id)
+//	})
+func EDIControlNumberLedgerEntryScopeTenantDelete(q *bun.DeleteQuery, ti pagination.TenantInfo) *bun.DeleteQuery {
+	return ScopeTenantDelete(q, EDIControlNumberLedgerEntryColumns.OrganizationID, EDIControlNumberLedgerEntryColumns.BusinessUnitID, ti)
+}
+
+// EDIControlNumberLedgerEntryApplyTenant returns a closure for SelectQuery.Apply() that scopes to a single tenant.
+// Use this instead of wrapping ScopeTenant in an anonymous function:
+//
+//	q.Apply(buncolgen.EDIControlNumberLedgerEntryApplyTenant(tenantInfo))
+func EDIControlNumberLedgerEntryApplyTenant(ti pagination.TenantInfo) func(*bun.SelectQuery) *bun.SelectQuery {
+	return ApplyTenant(EDIControlNumberLedgerEntryColumns.OrganizationID, EDIControlNumberLedgerEntryColumns.BusinessUnitID, ti)
+}
+
+// EDIControlNumberLedgerEntryFilter builds [domaintypes.FieldFilter] values using the correct JSON
+// field names for the "edi_control_number_ledger_entries" table. Pass these to the QueryBuilder's ApplyFilters.
+//
+// The JSON field name is baked in — you only provide the operator and value:
+//
+//	EDIControlNumberLedgerEntryFilter.ID(dbtype.OpEq, value)
+//	// produces FieldFilter{Field: "id", Operator: "eq", Value: value}
+var EDIControlNumberLedgerEntryFilter = struct {
+	ID                    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "id" → DB: "id"
+	BusinessUnitID        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "businessUnitId" → DB: "business_unit_id"
+	OrganizationID        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "organizationId" → DB: "organization_id"
+	EDIPartnerID          func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ediPartnerId" → DB: "edi_partner_id"
+	Direction             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "direction" → DB: "direction"
+	Kind                  func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "kind" → DB: "kind"
+	SequenceScope         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "sequenceScope" → DB: "sequence_scope"
+	ControlNumber         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "controlNumber" → DB: "control_number"
+	Sequence              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "sequence" → DB: "sequence"
+	TransactionSet        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "transactionSet" → DB: "transaction_set"
+	InboundFileID         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "inboundFileId" → DB: "inbound_file_id"
+	MessageID             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "messageId" → DB: "message_id"
+	ContentHash           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "contentHash" → DB: "content_hash"
+	Anomaly               func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "anomaly" → DB: "anomaly"
+	ExpectedControlNumber func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "expectedControlNumber" → DB: "expected_control_number"
+	DuplicateOfID         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "duplicateOfId" → DB: "duplicate_of_id"
+	Rejected              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "rejected" → DB: "rejected"
+	Outcome               func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "outcome" → DB: "outcome"
+	RecordedAt            func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "recordedAt" → DB: "recorded_at"
+	CreatedAt             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "createdAt" → DB: "created_at"
+}{
+	ID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("id", op, value)
+	},
+	BusinessUnitID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("businessUnitId", op, value)
+	},
+	OrganizationID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("organizationId", op, value)
+	},
+	EDIPartnerID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("ediPartnerId", op, value)
+	},
+	Direction: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("direction", op, value)
+	},
+	Kind: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("kind", op, value)
+	},
+	SequenceScope: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("sequenceScope", op, value)
+	},
+	ControlNumber: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("controlNumber", op, value)
+	},
+	Sequence: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("sequence", op, value)
+	},
+	TransactionSet: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("transactionSet", op, value)
+	},
+	InboundFileID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("inboundFileId", op, value)
+	},
+	MessageID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("messageId", op, value)
+	},
+	ContentHash: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("contentHash", op, value)
+	},
+	Anomaly: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("anomaly", op, value)
+	},
+	ExpectedControlNumber: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("expectedControlNumber", op, value)
+	},
+	DuplicateOfID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("duplicateOfId", op, value)
+	},
+	Rejected: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("rejected", op, value)
+	},
+	Outcome: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("outcome", op, value)
+	},
+	RecordedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("recordedAt", op, value)
+	},
+	CreatedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("createdAt", op, value)
+	},
+}
+
 // ---------------------------------------------------------------------------
 // EDIControlNumberSequence — table "edi_control_number_sequences", alias "ecns"
 // ---------------------------------------------------------------------------
@@ -1334,6 +1847,231 @@ var EDIDocumentTypeFilter = struct {
 	},
 }
 
+// ---------------------------------------------------------------------------
+// EDIImplementationGuide — table "edi_implementation_guides", alias "eig"
+// ---------------------------------------------------------------------------
+
+// EDIImplementationGuideTable holds the table name, alias, and primary key columns
+// for the "edi_implementation_guides" table. The alias "eig" is used in all generated
+// SQL fragments (e.g. "eig.id = ?").
+var EDIImplementationGuideTable = TableInfo{
+	Name:       "edi_implementation_guides",
+	Alias:      "eig",
+	PrimaryKey: []string{"id", "business_unit_id", "organization_id"},
+}
+
+// EDIImplementationGuideColumns provides type-safe column references for the "edi_implementation_guides" table.
+// Each field is a [Column] whose methods return pre-computed SQL fragments.
+//
+// Use String() when Bun manages the alias (model-aware queries):
+//
+//	q.Column(EDIImplementationGuideColumns.ID.String())
+//	// SELECT eig.id FROM edi_implementation_guides AS eig
+//
+// Use expression helpers for raw WHERE/ORDER BY clauses:
+//
+//	q.Where(EDIImplementationGuideColumns.ID.Eq(), id)           // WHERE eig.id = ?
+//	q.Order(EDIImplementationGuideColumns.CreatedAt.OrderDesc())  // ORDER BY eig.created_at DESC
+var EDIImplementationGuideColumns = struct {
+	ID             Column // "id" → qualified: "eig.id"
+	BusinessUnitID Column // "business_unit_id" → qualified: "eig.business_unit_id"
+	OrganizationID Column // "organization_id" → qualified: "eig.organization_id"
+	EDIPartnerID   Column // "edi_partner_id" → qualified: "eig.edi_partner_id"
+	Name           Column // "name" → qualified: "eig.name"
+	Description    Column // "description" → qualified: "eig.description"
+	Direction      Column // "direction" → qualified: "eig.direction"
+	TransactionSet Column // "transaction_set" → qualified: "eig.transaction_set"
+	X12Version     Column // "x12_version" → qualified: "eig.x12_version"
+	GuideRevision  Column // "guide_revision" → qualified: "eig.guide_revision"
+	IsActive       Column // "is_active" → qualified: "eig.is_active"
+	Definition     Column // "definition" → qualified: "eig.definition"
+	Version        Column // "version" → qualified: "eig.version"
+	CreatedAt      Column // "created_at" → qualified: "eig.created_at"
+	UpdatedAt      Column // "updated_at" → qualified: "eig.updated_at"
+}{
+	ID:             NewColumn("id", "eig"),
+	BusinessUnitID: NewColumn("business_unit_id", "eig"),
+	OrganizationID: NewColumn("organization_id", "eig"),
+	EDIPartnerID:   NewColumn("edi_partner_id", "eig"),
+	Name:           NewColumn("name", "eig"),
+	Description:    NewColumn("description", "eig"),
+	Direction:      NewColumn("direction", "eig"),
+	TransactionSet: NewColumn("transaction_set", "eig"),
+	X12Version:     NewColumn("x12_version", "eig"),
+	GuideRevision:  NewColumn("guide_revision", "eig"),
+	IsActive:       NewColumn("is_active", "eig"),
+	Definition:     NewColumn("definition", "eig"),
+	Version:        NewColumn("version", "eig"),
+	CreatedAt:      NewColumn("created_at", "eig"),
+	UpdatedAt:      NewColumn("updated_at", "eig"),
+}
+
+// EDIImplementationGuideFieldMap maps JSON API field names to database column names.
+// The QueryBuilder uses this to translate filter/sort requests from the frontend
+// (e.g. "firstName") into SQL column references (e.g. "first_name") without reflection.
+// This is returned by EDIImplementationGuide.GetStaticFieldMap().
+var EDIImplementationGuideFieldMap = map[string]string{
+	"id":             "id",
+	"businessUnitId": "business_unit_id",
+	"organizationId": "organization_id",
+	"ediPartnerId":   "edi_partner_id",
+	"name":           "name",
+	"description":    "description",
+	"direction":      "direction",
+	"transactionSet": "transaction_set",
+	"x12Version":     "x12_version",
+	"guideRevision":  "guide_revision",
+	"isActive":       "is_active",
+	"definition":     "definition",
+	"version":        "version",
+	"createdAt":      "created_at",
+	"updatedAt":      "updated_at",
+}
+
+// EDIImplementationGuideInsertableColumns lists column names suitable for INSERT statements on the "edi_implementation_guides" table.
+// Excludes scanonly columns (e.g. search_vector, rank) that are computed by PostgreSQL.
+var EDIImplementationGuideInsertableColumns = []string{
+	"id",
+	"business_unit_id",
+	"organization_id",
+	"edi_partner_id",
+	"name",
+	"description",
+	"direction",
+	"transaction_set",
+	"x12_version",
+	"guide_revision",
+	"is_active",
+	"definition",
+	"version",
+	"created_at",
+	"updated_at",
+}
+
+// EDIImplementationGuideRelations provides type-safe names for Bun eager-loading.
+// Use these instead of string literals in .Relation() calls to get compile-time safety.
+//
+//	q.Relation(EDIImplementationGuideRelations.Partner)
+//	// Bun eager-loads the Partner association via a separate query
+var EDIImplementationGuideRelations = struct {
+	Partner string
+}{
+	Partner: "Partner",
+}
+
+// EDIImplementationGuideScopeTenant restricts a query to a single tenant by adding:
+//
+//	WHERE eig.organization_id = ? AND eig.business_unit_id = ?
+//
+// Returns the same *bun.SelectQuery so it can be chained fluently:
+//
+//	buncolgen.EDIImplementationGuideScopeTenant(sq, ti).
+//		Where(buncolgen.EDIImplementationGuideColumns.ID.Eq(), id)
+func EDIImplementationGuideScopeTenant(q *bun.SelectQuery, ti pagination.TenantInfo) *bun.SelectQuery {
+	return ScopeTenant(q, EDIImplementationGuideColumns.OrganizationID, EDIImplementationGuideColumns.BusinessUnitID, ti)
+}
+
+// EDIImplementationGuideScopeTenantUpdate restricts an update query to a single tenant.
+// Use this inside UpdateQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
+//		return buncolgen.EDIImplementationGuideScopeTenantUpdate(uq, req.TenantInfo).
+//			Where(buncolgen.EDIImplementationGuideColumns.ID.In(), bun.List(ids))
+//	})
+func EDIImplementationGuideScopeTenantUpdate(q *bun.UpdateQuery, ti pagination.TenantInfo) *bun.UpdateQuery {
+	return ScopeTenantUpdate(q, EDIImplementationGuideColumns.OrganizationID, EDIImplementationGuideColumns.BusinessUnitID, ti)
+}
+
+// EDIImplementationGuideScopeTenantDelete restricts a delete query to a single tenant.
+// Use this inside DeleteQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(dq *bun.DeleteQuery) *bun.DeleteQuery {
+//		return buncolgen.EDIImplementationGuideScopeTenantDelete(dq, req.TenantInfo).
+//			Where(buncolgen.EDIImplementationGuideColumns.ID.Eq(), id)
+//	})
+func EDIImplementationGuideScopeTenantDelete(q *bun.DeleteQuery, ti pagination.TenantInfo) *bun.DeleteQuery {
+	return ScopeTenantDelete(q, EDIImplementationGuideColumns.OrganizationID, EDIImplementationGuideColumns.BusinessUnitID, ti)
+}
+
+// EDIImplementationGuideApplyTenant returns a closure for SelectQuery.Apply() that scopes to a single tenant.
+// Use this instead of wrapping ScopeTenant in an anonymous function:
+//
+//	q.Apply(buncolgen.EDIImplementationGuideApplyTenant(tenantInfo))
+func EDIImplementationGuideApplyTenant(ti pagination.TenantInfo) func(*bun.SelectQuery) *bun.SelectQuery {
+	return ApplyTenant(EDIImplementationGuideColumns.OrganizationID, EDIImplementationGuideColumns.BusinessUnitID, ti)
+}
+
+// EDIImplementationGuideFilter builds [domaintypes.FieldFilter] values using the correct JSON
+// field names for the "edi_implementation_guides" table. Pass these to the QueryBuilder's ApplyFilters.
+//
+// The JSON field name is baked in — you only provide the operator and value:
+//
+//	EDIImplementationGuideFilter.ID(dbtype.OpEq, value)
+//	// produces FieldFilter{Field: "id", Operator: "eq", Value: value}
+var EDIImplementationGuideFilter = struct {
+	ID             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "id" → DB: "id"
+	BusinessUnitID func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "businessUnitId" → DB: "business_unit_id"
+	OrganizationID func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "organizationId" → DB: "organization_id"
+	EDIPartnerID   func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ediPartnerId" → DB: "edi_partner_id"
+	Name           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "name" → DB: "name"
+	Description    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "description" → DB: "description"
+	Direction      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "direction" → DB: "direction"
+	TransactionSet func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "transactionSet" → DB: "transaction_set"
+	X12Version     func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "x12Version" → DB: "x12_version"
+	GuideRevision  func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "guideRevision" → DB: "guide_revision"
+	IsActive       func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "isActive" → DB: "is_active"
+	Definition     func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "definition" → DB: "definition"
+	Version        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "version" → DB: "version"
+	CreatedAt      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "createdAt" → DB: "created_at"
+	UpdatedAt      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "updatedAt" → DB: "updated_at"
+}{
+	ID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("id", op, value)
+	},
+	BusinessUnitID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("businessUnitId", op, value)
+	},
+	OrganizationID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("organizationId", op, value)
+	},
+	EDIPartnerID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("ediPartnerId", op, value)
+	},
+	Name: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("name", op, value)
+	},
+	Description: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("description", op, value)
+	},
+	Direction: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("direction", op, value)
+	},
+	TransactionSet: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("transactionSet", op, value)
+	},
+	X12Version: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("x12Version", op, value)
+	},
+	GuideRevision: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("guideRevision", op, value)
+	},
+	IsActive: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("isActive", op, value)
+	},
+	Definition: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("definition", op, value)
+	},
+	Version: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("version", op, value)
+	},
+	CreatedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("createdAt", op, value)
+	},
+	UpdatedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("updatedAt", op, value)
+	},
+}
+
 // ---------------------------------------------------------------------------
 // EDIInboundFile — table "edi_inbound_files", alias "eif"
 // ---------------------------------------------------------------------------
@@ -2736,6 +3474,7 @@ var EDIPartnerColumns = struct {
 	EnabledForInbound       Column // "enabled_for_inbound" → qualified: "ep.enabled_for_inbound"
 	EnabledForOutbound      Column // "enabled_for_outbound" → qualified: "ep.enabled_for_outbound"
 	Settings                Column // "settings" → qualified: "ep.settings"
+	InboundTransformScript  Column // "inbound_transform_script" → qualified: "ep.inbound_transform_script"
 	SearchVector            Column // "search_vector" → qualified: "ep.search_vector"
 	Rank                    Column // "rank" → qualified: "ep.rank"
 	Version                 Column // "version" → qualified: "ep.version"
@@ -2763,6 +3502,7 @@ var EDIPartnerColumns = struct {
 	EnabledForInbound:       NewColumn("enabled_for_inbound", "ep"),
 	EnabledForOutbound:      NewColumn("enabled_for_outbound", "ep"),
 	Settings:                NewColumn("settings", "ep"),
+	InboundTransformScript:  NewColumn("inbound_transform_script", "ep"),
 	SearchVector:            NewColumn("search_vector", "ep"),
 	Rank:                    NewColumn("rank", "ep"),
 	Version:                 NewColumn("version", "ep"),
@@ -2796,6 +3536,7 @@ var EDIPartnerFieldMap = map[string]string{
 	"enabledForInbound":       "enabled_for_inbound",
 	"enabledForOutbound":      "enabled_for_outbound",
 	"settings":                "settings",
+	"inboundTransformScript":  "inbound_transform_script",
 	"version":                 "version",
 	"createdAt":               "created_at",
 	"updatedAt":               "updated_at",
@@ -2825,6 +3566,7 @@ var EDIPartnerInsertableColumns = []string{
 	"enabled_for_inbound",
 	"enabled_for_outbound",
 	"settings",
+	"inbound_transform_script",
 	"version",
 	"created_at",
 	"updated_at",
@@ -2926,6 +3668,7 @@ var EDIPartnerFilter = struct {
 	EnabledForInbound       func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "enabledForInbound" → DB: "enabled_for_inbound"
 	EnabledForOutbound      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "enabledForOutbound" → DB: "enabled_for_outbound"
 	Settings                func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "settings" → DB: "settings"
+	InboundTransformScript  func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "inboundTransformScript" → DB: "inbound_transform_script"
 	Version                 func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "version" → DB: "version"
 	CreatedAt               func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "createdAt" → DB: "created_at"
 	UpdatedAt               func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "updatedAt" → DB: "updated_at"
@@ -2993,6 +3736,9 @@ var EDIPartnerFilter = struct {
 	Settings: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("settings", op, value)
 	},
+	InboundTransformScript: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("inboundTransformScript", op, value)
+	},
 	Version: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("version", op, value)
 	},
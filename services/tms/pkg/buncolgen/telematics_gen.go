@@ -1447,6 +1447,180 @@ var VehiclePositionFilter = struct {
 	},
 }
 
+// ---------------------------------------------------------------------------
+// VehiclePositionHistory — table "telematics_vehicle_position_history", alias "tvph"
+// ---------------------------------------------------------------------------
+
+// VehiclePositionHistoryTable holds the table name, alias, and primary key columns
+// for the "telematics_vehicle_position_history" table. The alias "tvph" is used in all generated
+// SQL fragments (e.g. "tvph.id = ?").
+var VehiclePositionHistoryTable = TableInfo{
+	Name:       "telematics_vehicle_position_history",
+	Alias:      "tvph",
+	PrimaryKey: []string{"organization_id", "business_unit_id", "tractor_id", "recorded_at"},
+}
+
+// VehiclePositionHistoryColumns provides type-safe column references for the "telematics_vehicle_position_history" table.
+// Each field is a [Column] whose methods return pre-computed SQL fragments.
+//
+// Use String() when Bun manages the alias (model-aware queries):
+//
+//	q.Column(VehiclePositionHistoryColumns.ID.String())
+//	// SELECT tvph.id FROM telematics_vehicle_position_history AS tvph
+//
+// Use expression helpers for raw WHERE/ORDER BY clauses:
+//
+//	q.Where(VehiclePositionHistoryColumns.ID.Eq(), id)           // WHERE tvph.id = ?
+//	q.Order(VehiclePositionHistoryColumns.CreatedAt.OrderDesc())  // ORDER BY tvph.created_at DESC
+var VehiclePositionHistoryColumns = struct {
+	OrganizationID    Column // "organization_id" → qualified: "tvph.organization_id"
+	BusinessUnitID    Column // "business_unit_id" → qualified: "tvph.business_unit_id"
+	TractorID         Column // "tractor_id" → qualified: "tvph.tractor_id"
+	RecordedAt        Column // "recorded_at" → qualified: "tvph.recorded_at"
+	Provider          Column // "provider" → qualified: "tvph.provider"
+	Latitude          Column // "latitude" → qualified: "tvph.latitude"
+	Longitude         Column // "longitude" → qualified: "tvph.longitude"
+	SpeedMph          Column // "speed_mph" → qualified: "tvph.speed_mph"
+	OdometerMeters    Column // "odometer_meters" → qualified: "tvph.odometer_meters"
+	FormattedLocation Column // "formatted_location" → qualified: "tvph.formatted_location"
+}{
+	OrganizationID:    NewColumn("organization_id", "tvph"),
+	BusinessUnitID:    NewColumn("business_unit_id", "tvph"),
+	TractorID:         NewColumn("tractor_id", "tvph"),
+	RecordedAt:        NewColumn("recorded_at", "tvph"),
+	Provider:          NewColumn("provider", "tvph"),
+	Latitude:          NewColumn("latitude", "tvph"),
+	Longitude:         NewColumn("longitude", "tvph"),
+	SpeedMph:          NewColumn("speed_mph", "tvph"),
+	OdometerMeters:    NewColumn("odometer_meters", "tvph"),
+	FormattedLocation: NewColumn("formatted_location", "tvph"),
+}
+
+// VehiclePositionHistoryFieldMap maps JSON API field names to database column names.
+// The QueryBuilder uses this to translate filter/sort requests from the frontend
+// (e.g. "firstName") into SQL column references (e.g. "first_name") without reflection.
+// This is returned by VehiclePositionHistory.GetStaticFieldMap().
+var VehiclePositionHistoryFieldMap = map[string]string{
+	"organizationId":    "organization_id",
+	"businessUnitId":    "business_unit_id",
+	"tractorId":         "tractor_id",
+	"recordedAt":        "recorded_at",
+	"provider":          "provider",
+	"latitude":          "latitude",
+	"longitude":         "longitude",
+	"speedMph":          "speed_mph",
+	"odometerMeters":    "odometer_meters",
+	"formattedLocation": "formatted_location",
+}
+
+// VehiclePositionHistoryInsertableColumns lists column names suitable for INSERT statements on the "telematics_vehicle_position_history" table.
+// Excludes scanonly columns (e.g. search_vector, rank) that are computed by PostgreSQL.
+var VehiclePositionHistoryInsertableColumns = []string{
+	"organization_id",
+	"business_unit_id",
+	"tractor_id",
+	"recorded_at",
+	"provider",
+	"latitude",
+	"longitude",
+	"speed_mph",
+	"odometer_meters",
+	"formatted_location",
+}
+
+// VehiclePositionHistoryScopeTenant restricts a query to a single tenant by adding:
+//
+//	WHERE tvph.organization_id = ? AND tvph.business_unit_id = ?
+//
+// Returns the same *bun.SelectQuery so it can be chained fluently:
+//
+//	buncolgen.VehiclePositionHistoryScopeTenant(sq, ti).
+//		Where(buncolgen.VehiclePositionHistoryColumns.ID.Eq(), id)
+func VehiclePositionHistoryScopeTenant(q *bun.SelectQuery, ti pagination.TenantInfo) *bun.SelectQuery {
+	return ScopeTenant(q, VehiclePositionHistoryColumns.OrganizationID, VehiclePositionHistoryColumns.BusinessUnitID, ti)
+}
+
+// VehiclePositionHistoryScopeTenantUpdate restricts an update query to a single tenant.
+// Use this inside UpdateQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(uq *bun.UpdateQuery) *bun.UpdateQuery {
+//		return buncolgen.VehiclePositionHistoryScopeTenantUpdate(uq, req.TenantInfo).
+//			Where(buncolgen.VehiclePositionHistoryColumns.ID.In(), bun.List(ids))
+//	})
+func VehiclePositionHistoryScopeTenantUpdate(q *bun.UpdateQuery, ti pagination.TenantInfo) *bun.UpdateQuery {
+	return ScopeTenantUpdate(q, VehiclePositionHistoryColumns.OrganizationID, VehiclePositionHistoryColumns.BusinessUnitID, ti)
+}
+
+// VehiclePositionHistoryScopeTenantDelete restricts a delete query to a single tenant.
+// Use this inside DeleteQuery.WhereGroup callbacks:
+//
+//	WhereGroup(" AND ", func(dq *bun.DeleteQuery) *bun.DeleteQuery {
+//		return buncolgen.VehiclePositionHistoryScopeTenantDelete(dq, req.TenantInfo).
+//			Where(buncolgen.VehiclePositionHistoryColumns.ID.Eq(), id)
+//	})
+func VehiclePositionHistoryScopeTenantDelete(q *bun.DeleteQuery, ti pagination.TenantInfo) *bun.DeleteQuery {
+	return ScopeTenantDelete(q, VehiclePositionHistoryColumns.OrganizationID, VehiclePositionHistoryColumns.BusinessUnitID, ti)
+}
+
+// VehiclePositionHistoryApplyTenant returns a closure for SelectQuery.Apply() that scopes to a single tenant.
+// Use this instead of wrapping ScopeTenant in an anonymous function:
+//
+//	q.Apply(buncolgen.VehiclePositionHistoryApplyTenant(tenantInfo))
+func VehiclePositionHistoryApplyTenant(ti pagination.TenantInfo) func(*bun.SelectQuery) *bun.SelectQuery {
+	return ApplyTenant(VehiclePositionHistoryColumns.OrganizationID, VehiclePositionHistoryColumns.BusinessUnitID, ti)
+}
+
+// VehiclePositionHistoryFilter builds [domaintypes.FieldFilter] values using the correct JSON
+// field names for the "telematics_vehicle_position_history" table. Pass these to the QueryBuilder's ApplyFilters.
+//
+// The JSON field name is baked in — you only provide the operator and value:
+//
+//	VehiclePositionHistoryFilter.OrganizationID(dbtype.OpEq, value)
+//	// produces FieldFilter{Field: "organizationId", Operator: "eq", Value: value}
+var VehiclePositionHistoryFilter = struct {
+	OrganizationID    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "organizationId" → DB: "organization_id"
+	BusinessUnitID    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "businessUnitId" → DB: "business_unit_id"
+	TractorID         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "tractorId" → DB: "tractor_id"
+	RecordedAt        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "recordedAt" → DB: "recorded_at"
+	Provider          func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "provider" → DB: "provider"
+	Latitude          func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "latitude" → DB: "latitude"
+	Longitude         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "longitude" → DB: "longitude"
+	SpeedMph          func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "speedMph" → DB: "speed_mph"
+	OdometerMeters    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "odometerMeters" → DB: "odometer_meters"
+	FormattedLocation func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "formattedLocation" → DB: "formatted_location"
+}{
+	OrganizationID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("organizationId", op, value)
+	},
+	BusinessUnitID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("businessUnitId", op, value)
+	},
+	TractorID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("tractorId", op, value)
+	},
+	RecordedAt: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("recordedAt", op, value)
+	},
+	Provider: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("provider", op, value)
+	},
+	Latitude: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("latitude", op, value)
+	},
+	Longitude: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("longitude", op, value)
+	},
+	SpeedMph: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("speedMph", op, value)
+	},
+	OdometerMeters: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("odometerMeters", op, value)
+	},
+	FormattedLocation: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("formattedLocation", op, value)
+	},
+}
+
 // ---------------------------------------------------------------------------
 // WorkerHOSLog — table "worker_hos_logs", alias "whl"
 // ---------------------------------------------------------------------------
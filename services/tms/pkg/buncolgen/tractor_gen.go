@@ -68,6 +68,7 @@ var TractorColumns = struct {
 	RegistrationExpiry      Column // "registration_expiry" → qualified: "trac.registration_expiry"
 	Vin                     Column // "vin" → qualified: "trac.vin"
 	ExternalID              Column // "external_id" → qualified: "trac.external_id"
+	TelematicsProvider      Column // "telematics_provider" → qualified: "trac.telematics_provider"
 	OwnershipType           Column // "ownership_type" → qualified: "trac.ownership_type"
 	OwnerWorkerID           Column // "owner_worker_id" → qualified: "trac.owner_worker_id"
 	LessorName              Column // "lessor_name" → qualified: "trac.lessor_name"
@@ -98,6 +99,7 @@ var TractorColumns = struct {
 	RegistrationExpiry:      NewColumn("registration_expiry", "trac"),
 	Vin:                     NewColumn("vin", "trac"),
 	ExternalID:              NewColumn("external_id", "trac"),
+	TelematicsProvider:      NewColumn("telematics_provider", "trac"),
 	OwnershipType:           NewColumn("ownership_type", "trac"),
 	OwnerWorkerID:           NewColumn("owner_worker_id", "trac"),
 	LessorName:              NewColumn("lessor_name", "trac"),
@@ -134,6 +136,7 @@ var TractorFieldMap = map[string]string{
 	"registrationExpiry":      "registration_expiry",
 	"vin":                     "vin",
 	"externalId":              "external_id",
+	"telematicsProvider":      "telematics_provider",
 	"ownershipType":           "ownership_type",
 	"ownerWorkerId":           "owner_worker_id",
 	"lessorName":              "lessor_name",
@@ -168,6 +171,7 @@ var TractorInsertableColumns = []string{
 	"registration_expiry",
 	"vin",
 	"external_id",
+	"telematics_provider",
 	"ownership_type",
 	"owner_worker_id",
 	"lessor_name",
@@ -274,6 +278,7 @@ var TractorFilter = struct {
 	RegistrationExpiry      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "registrationExpiry" → DB: "registration_expiry"
 	Vin                     func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "vin" → DB: "vin"
 	ExternalID              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "externalId" → DB: "external_id"
+	TelematicsProvider      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "telematicsProvider" → DB: "telematics_provider"
 	OwnershipType           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ownershipType" → DB: "ownership_type"
 	OwnerWorkerID           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ownerWorkerId" → DB: "owner_worker_id"
 	LessorName              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "lessorName" → DB: "lessor_name"
@@ -340,6 +345,9 @@ var TractorFilter = struct {
 	ExternalID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("externalId", op, value)
 	},
+	TelematicsProvider: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("telematicsProvider", op, value)
+	},
 	OwnershipType: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("ownershipType", op, value)
 	},
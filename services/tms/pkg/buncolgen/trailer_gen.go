@@ -64,6 +64,7 @@ var TrailerColumns = struct {
 	LicensePlateNumber      Column // "license_plate_number" → qualified: "tr.license_plate_number"
 	Vin                     Column // "vin" → qualified: "tr.vin"
 	ExternalID              Column // "external_id" → qualified: "tr.external_id"
+	TelematicsProvider      Column // "telematics_provider" → qualified: "tr.telematics_provider"
 	RegistrationNumber      Column // "registration_number" → qualified: "tr.registration_number"
 	MaxLoadWeight           Column // "max_load_weight" → qualified: "tr.max_load_weight"
 	OwnershipType           Column // "ownership_type" → qualified: "tr.ownership_type"
@@ -96,6 +97,7 @@ var TrailerColumns = struct {
 	LicensePlateNumber:      NewColumn("license_plate_number", "tr"),
 	Vin:                     NewColumn("vin", "tr"),
 	ExternalID:              NewColumn("external_id", "tr"),
+	TelematicsProvider:      NewColumn("telematics_provider", "tr"),
 	RegistrationNumber:      NewColumn("registration_number", "tr"),
 	MaxLoadWeight:           NewColumn("max_load_weight", "tr"),
 	OwnershipType:           NewColumn("ownership_type", "tr"),
@@ -134,6 +136,7 @@ var TrailerFieldMap = map[string]string{
 	"licensePlateNumber":      "license_plate_number",
 	"vin":                     "vin",
 	"externalId":              "external_id",
+	"telematicsProvider":      "telematics_provider",
 	"registrationNumber":      "registration_number",
 	"maxLoadWeight":           "max_load_weight",
 	"ownershipType":           "ownership_type",
@@ -168,6 +171,7 @@ var TrailerInsertableColumns = []string{
 	"license_plate_number",
 	"vin",
 	"external_id",
+	"telematics_provider",
 	"registration_number",
 	"max_load_weight",
 	"ownership_type",
@@ -268,6 +272,7 @@ var TrailerFilter = struct {
 	LicensePlateNumber      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "licensePlateNumber" → DB: "license_plate_number"
 	Vin                     func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "vin" → DB: "vin"
 	ExternalID              func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "externalId" → DB: "external_id"
+	TelematicsProvider      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "telematicsProvider" → DB: "telematics_provider"
 	RegistrationNumber      func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "registrationNumber" → DB: "registration_number"
 	MaxLoadWeight           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "maxLoadWeight" → DB: "max_load_weight"
 	OwnershipType           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "ownershipType" → DB: "ownership_type"
@@ -326,6 +331,9 @@ var TrailerFilter = struct {
 	ExternalID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("externalId", op, value)
 	},
+	TelematicsProvider: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("telematicsProvider", op, value)
+	},
 	RegistrationNumber: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("registrationNumber", op, value)
 	},
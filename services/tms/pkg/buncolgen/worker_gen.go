@@ -268,6 +268,8 @@ var WorkerColumns = struct {
 	OrganizationID        Column // "organization_id" → qualified: "wrk.organization_id"
 	StateID               Column // "state_id" → qualified: "wrk.state_id"
 	FleetCodeID           Column // "fleet_code_id" → qualified: "wrk.fleet_code_id"
+	HomeLocationID        Column // "home_location_id" → qualified: "wrk.home_location_id"
+	HomeTimeMaxDaysOut    Column // "home_time_max_days_out" → qualified: "wrk.home_time_max_days_out"
 	ManagerID             Column // "manager_id" → qualified: "wrk.manager_id"
 	UserID                Column // "user_id" → qualified: "wrk.user_id"
 	Status                Column // "status" → qualified: "wrk.status"
@@ -286,6 +288,7 @@ var WorkerColumns = struct {
 	EmergencyContactName  Column // "emergency_contact_name" → qualified: "wrk.emergency_contact_name"
 	EmergencyContactPhone Column // "emergency_contact_phone" → qualified: "wrk.emergency_contact_phone"
 	ExternalID            Column // "external_id" → qualified: "wrk.external_id"
+	TelematicsProvider    Column // "telematics_provider" → qualified: "wrk.telematics_provider"
 	SearchVector          Column // "search_vector" → qualified: "wrk.search_vector"
 	Rank                  Column // "rank" → qualified: "wrk.rank"
 	AssignmentBlocked     Column // "assignment_blocked" → qualified: "wrk.assignment_blocked"
@@ -301,6 +304,8 @@ var WorkerColumns = struct {
 	OrganizationID:        NewColumn("organization_id", "wrk"),
 	StateID:               NewColumn("state_id", "wrk"),
 	FleetCodeID:           NewColumn("fleet_code_id", "wrk"),
+	HomeLocationID:        NewColumn("home_location_id", "wrk"),
+	HomeTimeMaxDaysOut:    NewColumn("home_time_max_days_out", "wrk"),
 	ManagerID:             NewColumn("manager_id", "wrk"),
 	UserID:                NewColumn("user_id", "wrk"),
 	Status:                NewColumn("status", "wrk"),
@@ -319,6 +324,7 @@ var WorkerColumns = struct {
 	EmergencyContactName:  NewColumn("emergency_contact_name", "wrk"),
 	EmergencyContactPhone: NewColumn("emergency_contact_phone", "wrk"),
 	ExternalID:            NewColumn("external_id", "wrk"),
+	TelematicsProvider:    NewColumn("telematics_provider", "wrk"),
 	SearchVector:          NewColumn("search_vector", "wrk"),
 	Rank:                  NewColumn("rank", "wrk"),
 	AssignmentBlocked:     NewColumn("assignment_blocked", "wrk"),
@@ -340,6 +346,8 @@ var WorkerFieldMap = map[string]string{
 	"organizationId":        "organization_id",
 	"stateId":               "state_id",
 	"fleetCodeId":           "fleet_code_id",
+	"homeLocationId":        "home_location_id",
+	"homeTimeMaxDaysOut":    "home_time_max_days_out",
 	"managerId":             "manager_id",
 	"userId":                "user_id",
 	"status":                "status",
@@ -358,6 +366,7 @@ var WorkerFieldMap = map[string]string{
 	"emergencyContactName":  "emergency_contact_name",
 	"emergencyContactPhone": "emergency_contact_phone",
 	"externalId":            "external_id",
+	"telematicsProvider":    "telematics_provider",
 	"assignmentBlocked":     "assignment_blocked",
 	"gender":                "gender",
 	"canBeAssigned":         "can_be_assigned",
@@ -375,6 +384,8 @@ var WorkerInsertableColumns = []string{
 	"organization_id",
 	"state_id",
 	"fleet_code_id",
+	"home_location_id",
+	"home_time_max_days_out",
 	"manager_id",
 	"user_id",
 	"status",
@@ -392,6 +403,7 @@ var WorkerInsertableColumns = []string{
 	"emergency_contact_name",
 	"emergency_contact_phone",
 	"external_id",
+	"telematics_provider",
 	"assignment_blocked",
 	"gender",
 	"can_be_assigned",
@@ -481,6 +493,8 @@ var WorkerFilter = struct {
 	OrganizationID        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "organizationId" → DB: "organization_id"
 	StateID               func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "stateId" → DB: "state_id"
 	FleetCodeID           func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "fleetCodeId" → DB: "fleet_code_id"
+	HomeLocationID        func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "homeLocationId" → DB: "home_location_id"
+	HomeTimeMaxDaysOut    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "homeTimeMaxDaysOut" → DB: "home_time_max_days_out"
 	ManagerID             func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "managerId" → DB: "manager_id"
 	UserID                func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "userId" → DB: "user_id"
 	Status                func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "status" → DB: "status"
@@ -498,6 +512,7 @@ var WorkerFilter = struct {
 	EmergencyContactName  func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "emergencyContactName" → DB: "emergency_contact_name"
 	EmergencyContactPhone func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "emergencyContactPhone" → DB: "emergency_contact_phone"
 	ExternalID            func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "externalId" → DB: "external_id"
+	TelematicsProvider    func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "telematicsProvider" → DB: "telematics_provider"
 	AssignmentBlocked     func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "assignmentBlocked" → DB: "assignment_blocked"
 	Gender                func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "gender" → DB: "gender"
 	CanBeAssigned         func(op dbtype.Operator, value any) domaintypes.FieldFilter // JSON: "canBeAssigned" → DB: "can_be_assigned"
@@ -521,6 +536,12 @@ var WorkerFilter = struct {
 	FleetCodeID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("fleetCodeId", op, value)
 	},
+	HomeLocationID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("homeLocationId", op, value)
+	},
+	HomeTimeMaxDaysOut: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("homeTimeMaxDaysOut", op, value)
+	},
 	ManagerID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("managerId", op, value)
 	},
@@ -572,6 +593,9 @@ var WorkerFilter = struct {
 	ExternalID: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("externalId", op, value)
 	},
+	TelematicsProvider: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
+		return NewFieldFilter("telematicsProvider", op, value)
+	},
 	AssignmentBlocked: func(op dbtype.Operator, value any) domaintypes.FieldFilter {
 		return NewFieldFilter("assignmentBlocked", op, value)
 	},
@@ -18,6 +18,7 @@ const (
 	TaskQueueFiscal               TaskQueue = "fiscal-queue"
 	TaskQueueEDI                  TaskQueue = "edi-queue"
 	TaskQueueReport               TaskQueue = "report-queue"
+	TaskQueueWebhook              TaskQueue = "webhook-queue"
 )
 
 func (t TaskQueue) String() string {
@@ -36,3 +37,4 @@ const DistanceMileageTaskQueue = string(TaskQueueDistanceMileage)
 const WeatherAlertTaskQueue = string(TaskQueueWeatherAlert)
 const EDITaskQueue = string(TaskQueueEDI)
 const ReportTaskQueue = string(TaskQueueReport)
+const WebhookTaskQueue = string(TaskQueueWebhook)
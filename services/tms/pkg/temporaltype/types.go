@@ -34,8 +34,14 @@ const ApproveLoadTenderTransferWorkflowName = "ApproveLoadTenderTransferWorkflow
 
 const DeliverEDIMessageWorkflowName = "DeliverEDIMessageWorkflow"
 
+const AS2MDNReceivedSignalName = "as2_mdn_received"
+
 const ProcessInboundEDIFileWorkflowName = "ProcessInboundEDIFileWorkflow"
 
+const RunEDICertificationWorkflowName = "RunEDICertificationWorkflow"
+
+const DeliverWebhookWorkflowName = "DeliverWebhookWorkflow"
+
 var DefaultRetryPolicy = &temporal.RetryPolicy{
 	InitialInterval:    time.Second,
 	BackoffCoefficient: 2.0,
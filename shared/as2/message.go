@@ -35,6 +35,9 @@ const (
 	contentTypeMultipartSigned = "multipart/signed"
 	contentTypeEDIX12          = "application/edi-x12"
 
+	// ContentTypeEDIFACT is the RFC 1767 media type for UN/EDIFACT payloads.
+	ContentTypeEDIFACT = "application/EDIFACT"
+
 	smimeTypeEnvelopedData  = "enveloped-data"
 	smimeTypeSignedData     = "signed-data"
 	smimeTypeCompressedData = "compressed-data"
@@ -60,6 +63,7 @@ type BuildMessageOptions struct {
 	MessageID             string
 	Subject               string
 	FileName              string
+	ContentType           string
 	Payload               []byte
 	SigningCertificate    *x509.Certificate
 	SigningKey            crypto.PrivateKey
@@ -98,6 +102,9 @@ func BuildMessage(opts *BuildMessageOptions) (*BuiltMessage, error) {
 		fileName = "payload.edi"
 	}
 	contentType := contentTypeEDIX12
+	if opts.ContentType != "" {
+		contentType = opts.ContentType
+	}
 	entity := buildEntity(textproto.MIMEHeader{
 		"Content-Type": {contentType},
 		"Content-Disposition": {
@@ -0,0 +1,5 @@
+package assets
+
+import "errors"
+
+var ErrPerPageInvalid = errors.New("asset per_page must be between 1 and 100")
@@ -0,0 +1,27 @@
+package assets
+
+import (
+	"net/url"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+)
+
+type ListParams struct {
+	Type    string
+	PerPage int
+	PageNo  int
+}
+
+func (p ListParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	return nil
+}
+
+func (p ListParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetString(values, "type", p.Type)
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
@@ -0,0 +1,56 @@
+package assets
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+)
+
+type Service interface {
+	List(ctx context.Context, params ListParams) (ListResponse, error)
+	ListAll(ctx context.Context, assetType string) ([]Asset, error)
+}
+
+type service struct {
+	client httpx.Requester
+}
+
+func NewService(client httpx.Requester) Service {
+	return &service{client: client}
+}
+
+func (s *service) List(ctx context.Context, params ListParams) (ListResponse, error) {
+	if err := params.Validate(); err != nil {
+		return ListResponse{}, err
+	}
+
+	out := ListResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/assets",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return ListResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) ListAll(ctx context.Context, assetType string) ([]Asset, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]Asset, motivetypes.Pagination, error) {
+		page, err := s.List(ctx, ListParams{Type: assetType, PageNo: pageNo})
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]Asset, 0, len(page.Assets))
+		for i := range page.Assets {
+			items = append(items, page.Assets[i].Asset)
+		}
+		return items, page.Pagination, nil
+	})
+}
@@ -0,0 +1,28 @@
+package assets
+
+import motivetypes "github.com/emoss08/trenova/shared/motive/types"
+
+const TypeTrailer = "trailer"
+
+// Asset is non-powered equipment tracked by an asset gateway, such as a trailer.
+type Asset struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	Status             string `json:"status"`
+	Type               string `json:"type"`
+	Make               string `json:"make"`
+	Model              string `json:"model"`
+	Year               string `json:"year"`
+	VIN                string `json:"vin"`
+	LicensePlateState  string `json:"license_plate_state"`
+	LicensePlateNumber string `json:"license_plate_number"`
+}
+
+type AssetEnvelope struct {
+	Asset Asset `json:"asset"`
+}
+
+type ListResponse struct {
+	Assets     []AssetEnvelope        `json:"assets"`
+	Pagination motivetypes.Pagination `json:"pagination"`
+}
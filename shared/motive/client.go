@@ -0,0 +1,152 @@
+package motive
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/emoss08/trenova/shared/motive/assets"
+	"github.com/emoss08/trenova/shared/motive/hos"
+	"github.com/emoss08/trenova/shared/motive/inspections"
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	"github.com/emoss08/trenova/shared/motive/users"
+	"github.com/emoss08/trenova/shared/motive/vehicles"
+)
+
+const (
+	defaultBaseURL        = "https://api.gomotive.com"
+	defaultTimeout        = 30 * time.Second
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = 500 * time.Millisecond
+	defaultMaxBackoff     = 10 * time.Second
+)
+
+type Client struct {
+	Assets      assets.Service
+	HOS         hos.Service
+	Inspections inspections.Service
+	Users       users.Service
+	Vehicles    vehicles.Service
+}
+
+func New(apiKey string, opts ...Option) (*Client, error) {
+	mergedCfg, err := applyConfigDefaults(apiKey, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := httpx.New(httpx.Config{
+		APIKey:     mergedCfg.APIKey,
+		BaseURL:    mergedCfg.BaseURL,
+		Timeout:    mergedCfg.Timeout,
+		UserAgent:  mergedCfg.UserAgent,
+		HTTPClient: mergedCfg.httpClient,
+		Retry: httpx.RetryConfig{
+			Enabled:        mergedCfg.Retry.Enabled,
+			MaxAttempts:    mergedCfg.Retry.MaxAttempts,
+			InitialBackoff: mergedCfg.Retry.InitialBackoff,
+			MaxBackoff:     mergedCfg.Retry.MaxBackoff,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Assets:      assets.NewService(transport),
+		HOS:         hos.NewService(transport),
+		Inspections: inspections.NewService(transport),
+		Users:       users.NewService(transport),
+		Vehicles:    vehicles.NewService(transport),
+	}, nil
+}
+
+type mergedConfig struct {
+	APIKey     string
+	BaseURL    string
+	Timeout    time.Duration
+	Retry      RetryConfig
+	UserAgent  string
+	httpClient *http.Client
+}
+
+//nolint:cyclop,funlen,gocognit,nestif,gocritic // constructor validation intentionally centralizes all defaults and guardrails.
+func applyConfigDefaults(apiKey string, opts ...Option) (*mergedConfig, error) {
+	cfg := &mergedConfig{
+		APIKey:  strings.TrimSpace(apiKey),
+		BaseURL: defaultBaseURL,
+		Timeout: defaultTimeout,
+		Retry: RetryConfig{
+			Enabled:        true,
+			MaxAttempts:    defaultMaxAttempts,
+			InitialBackoff: defaultInitialBackoff,
+			MaxBackoff:     defaultMaxBackoff,
+		},
+	}
+
+	if cfg.APIKey == "" {
+		return nil, ErrAPIKeyRequired
+	}
+
+	if _, err := url.ParseRequestURI(cfg.BaseURL); err != nil {
+		return nil, fmt.Errorf("invalid motive base URL: %w", err)
+	}
+
+	optSet := &options{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(optSet)
+	}
+
+	if optSet.baseURL != nil {
+		baseURL := strings.TrimSpace(*optSet.baseURL)
+		cfg.BaseURL = baseURL
+		if cfg.BaseURL == "" {
+			return nil, ErrBaseURLOverrideEmpty
+		}
+		if _, err := url.ParseRequestURI(cfg.BaseURL); err != nil {
+			return nil, fmt.Errorf("invalid motive base URL override: %w", err)
+		}
+	}
+
+	if optSet.userAgent != nil {
+		cfg.UserAgent = strings.TrimSpace(*optSet.userAgent)
+	}
+
+	if optSet.timeout != nil {
+		if *optSet.timeout <= 0 {
+			return nil, ErrTimeoutInvalid
+		}
+		cfg.Timeout = *optSet.timeout
+	}
+
+	if optSet.retry != nil {
+		cfg.Retry = *optSet.retry
+		if cfg.Retry.MaxAttempts <= 0 {
+			return nil, ErrRetryMaxAttemptsInvalid
+		}
+		if cfg.Retry.MaxAttempts > 10 {
+			return nil, ErrRetryMaxAttemptsTooHigh
+		}
+		if cfg.Retry.InitialBackoff <= 0 {
+			return nil, ErrRetryInitialBackoffInvalid
+		}
+		if cfg.Retry.MaxBackoff <= 0 {
+			return nil, ErrRetryMaxBackoffInvalid
+		}
+		if cfg.Retry.InitialBackoff > cfg.Retry.MaxBackoff {
+			return nil, ErrRetryBackoffOrderInvalid
+		}
+	}
+
+	if optSet.httpClient != nil && optSet.httpClient.Transport == nil {
+		return nil, ErrCustomHTTPClientTransportEmpty
+	}
+
+	cfg.httpClient = optSet.httpClient
+	return cfg, nil
+}
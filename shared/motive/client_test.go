@@ -0,0 +1,106 @@
+package motive
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := New("")
+	require.ErrorIs(t, err, ErrAPIKeyRequired)
+
+	_, err = New("key", WithBaseURL("::://bad-url"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid motive base URL")
+
+	_, err = New("key", WithRetry(RetryConfig{Enabled: true, MaxAttempts: 11}))
+	require.ErrorIs(t, err, ErrRetryMaxAttemptsTooHigh)
+}
+
+func TestListAllVehiclesPaginates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/vehicles", r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page_no") {
+		case "1":
+			_, _ = w.Write([]byte(`{"vehicles":[{"vehicle":{"id":1,"number":"T-1"}}],` +
+				`"pagination":{"per_page":1,"page_no":1,"total":2}}`))
+		default:
+			_, _ = w.Write([]byte(`{"vehicles":[{"vehicle":{"id":2,"number":"T-2"}}],` +
+				`"pagination":{"per_page":1,"page_no":2,"total":2}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("key", WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	items, err := client.Vehicles.ListAll(t.Context())
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "T-1", items[0].Number)
+	assert.Equal(t, int64(2), items[1].ID)
+}
+
+func TestRetry429ThenSuccess(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"assets":[],"pagination":{"per_page":100,"page_no":1,"total":0}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("key", WithBaseURL(server.URL), WithRetry(RetryConfig{
+		Enabled:        true,
+		MaxAttempts:    2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	_, err = client.Assets.ListAll(t.Context(), "trailer")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRequestReturnsTypedAPIError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error_message":"Invalid API key"}`))
+	}))
+	defer server.Close()
+
+	client, err := New("key", WithBaseURL(server.URL), WithRetry(RetryConfig{
+		Enabled:        false,
+		MaxAttempts:    1,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	_, err = client.Users.ListDrivers(t.Context())
+	require.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Contains(t, err.Error(), "Invalid API key")
+}
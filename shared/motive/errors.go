@@ -0,0 +1,12 @@
+package motive
+
+import motivetypes "github.com/emoss08/trenova/shared/motive/types"
+
+type APIError = motivetypes.APIError
+
+var (
+	IsRateLimit    = motivetypes.IsRateLimit
+	IsUnauthorized = motivetypes.IsUnauthorized
+	IsNotFound     = motivetypes.IsNotFound
+	Temporary      = motivetypes.Temporary
+)
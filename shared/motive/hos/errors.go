@@ -0,0 +1,11 @@
+package hos
+
+import "errors"
+
+var (
+	ErrPerPageInvalid        = errors.New("hos per_page must be between 1 and 100")
+	ErrDateRangeRequired     = errors.New("hos logs start date and end date are required")
+	ErrDateRangeOrderInvalid = errors.New("hos logs end date must not precede start date")
+	ErrTimeRangeRequired     = errors.New("hos violations time range is required")
+	ErrTimeRangeOrderInvalid = errors.New("hos violations time range end must not precede start")
+)
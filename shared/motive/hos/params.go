@@ -0,0 +1,68 @@
+package hos
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+)
+
+type LogsParams struct {
+	DriverIDs []int64
+	StartDate *time.Time
+	EndDate   *time.Time
+	PerPage   int
+	PageNo    int
+}
+
+func (p LogsParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	if p.StartDate == nil || p.EndDate == nil {
+		return ErrDateRangeRequired
+	}
+	if p.EndDate.Before(*p.StartDate) {
+		return ErrDateRangeOrderInvalid
+	}
+	return nil
+}
+
+func (p LogsParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetIDs(values, "driver_ids", p.DriverIDs)
+	httpx.SetDate(values, "start_date", p.StartDate)
+	httpx.SetDate(values, "end_date", p.EndDate)
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
+
+type ViolationsParams struct {
+	DriverIDs    []int64
+	MinStartTime *time.Time
+	MaxStartTime *time.Time
+	PerPage      int
+	PageNo       int
+}
+
+func (p ViolationsParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	if p.MinStartTime == nil || p.MaxStartTime == nil {
+		return ErrTimeRangeRequired
+	}
+	if p.MaxStartTime.Before(*p.MinStartTime) {
+		return ErrTimeRangeOrderInvalid
+	}
+	return nil
+}
+
+func (p ViolationsParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetIDs(values, "driver_ids", p.DriverIDs)
+	httpx.SetTime(values, "min_start_time", p.MinStartTime)
+	httpx.SetTime(values, "max_start_time", p.MaxStartTime)
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
@@ -0,0 +1,100 @@
+package hos
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+)
+
+type Service interface {
+	Logs(ctx context.Context, params LogsParams) (LogsResponse, error)
+	LogsAll(ctx context.Context, params LogsParams) ([]Log, error)
+	Violations(ctx context.Context, params ViolationsParams) (ViolationsResponse, error)
+	ViolationsAll(ctx context.Context, params ViolationsParams) ([]Violation, error)
+}
+
+type service struct {
+	client httpx.Requester
+}
+
+func NewService(client httpx.Requester) Service {
+	return &service{client: client}
+}
+
+func (s *service) Logs(ctx context.Context, params LogsParams) (LogsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return LogsResponse{}, err
+	}
+
+	out := LogsResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/logs",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return LogsResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) LogsAll(ctx context.Context, params LogsParams) ([]Log, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]Log, motivetypes.Pagination, error) {
+		params.PageNo = pageNo
+		page, err := s.Logs(ctx, params)
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]Log, 0, len(page.Logs))
+		for i := range page.Logs {
+			items = append(items, page.Logs[i].Log)
+		}
+		return items, page.Pagination, nil
+	})
+}
+
+func (s *service) Violations(
+	ctx context.Context,
+	params ViolationsParams,
+) (ViolationsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return ViolationsResponse{}, err
+	}
+
+	out := ViolationsResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/hos_violations",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return ViolationsResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) ViolationsAll(
+	ctx context.Context,
+	params ViolationsParams,
+) ([]Violation, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]Violation, motivetypes.Pagination, error) {
+		params.PageNo = pageNo
+		page, err := s.Violations(ctx, params)
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]Violation, 0, len(page.Violations))
+		for i := range page.Violations {
+			items = append(items, page.Violations[i].Violation)
+		}
+		return items, page.Pagination, nil
+	})
+}
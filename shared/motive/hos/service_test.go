@@ -0,0 +1,77 @@
+package hos
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	"github.com/emoss08/trenova/shared/motive/internal/httpxtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsValidation(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(&httpxtest.MockRequester{})
+
+	_, err := svc.Logs(t.Context(), LogsParams{})
+	require.ErrorIs(t, err, ErrDateRangeRequired)
+
+	start := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, -1)
+	_, err = svc.Logs(t.Context(), LogsParams{StartDate: &start, EndDate: &end})
+	require.ErrorIs(t, err, ErrDateRangeOrderInvalid)
+}
+
+func TestLogsQueryAndPath(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(
+		&httpxtest.MockRequester{DoFunc: func(_ context.Context, req httpx.Request) error {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/v1/logs", req.Path)
+			assert.Equal(t, []string{"42"}, req.Query["driver_ids[]"])
+			assert.Equal(t, "2026-10-01", req.Query.Get("start_date"))
+			assert.Equal(t, "2026-10-03", req.Query.Get("end_date"))
+
+			out := req.Out.(*LogsResponse)
+			out.Logs = []LogEnvelope{{Log: Log{ID: 1, Date: "2026-10-01"}}}
+			return nil
+		}},
+	)
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)
+	logs, err := svc.LogsAll(t.Context(), LogsParams{
+		DriverIDs: []int64{42},
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "2026-10-01", logs[0].Date)
+}
+
+func TestViolationsQueryAndPath(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(
+		&httpxtest.MockRequester{DoFunc: func(_ context.Context, req httpx.Request) error {
+			assert.Equal(t, "/v1/hos_violations", req.Path)
+			assert.Equal(t, "2026-10-01T00:00:00Z", req.Query.Get("min_start_time"))
+			assert.Equal(t, "2026-10-02T00:00:00Z", req.Query.Get("max_start_time"))
+			return nil
+		}},
+	)
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	_, err := svc.Violations(t.Context(), ViolationsParams{
+		MinStartTime: &start,
+		MaxStartTime: &end,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+package hos
+
+import motivetypes "github.com/emoss08/trenova/shared/motive/types"
+
+// Duty statuses as Motive reports them on log events and users.
+const (
+	DutyStatusOffDuty            = "off_duty"
+	DutyStatusSleeper            = "sleeper"
+	DutyStatusDriving            = "driving"
+	DutyStatusOnDuty             = "on_duty"
+	DutyStatusYardMove           = "yard_move"
+	DutyStatusPersonalConveyance = "personal_conveyance"
+	DutyStatusWaiting            = "waiting"
+)
+
+// LogEvent is one duty-status segment. Duration is in seconds; EndTime is
+// empty for the segment still open.
+type LogEvent struct {
+	ID        int64             `json:"id"`
+	Type      string            `json:"type"`
+	StartTime string            `json:"start_time"`
+	EndTime   string            `json:"end_time"`
+	Duration  int64             `json:"duration"`
+	Location  string            `json:"location"`
+	Lat       *float64          `json:"lat"`
+	Lon       *float64          `json:"lon"`
+	Notes     string            `json:"notes"`
+	Vehicle   *motivetypes.Ref  `json:"vehicle"`
+	Codrivers []motivetypes.Ref `json:"codrivers"`
+}
+
+type LogEventEnvelope struct {
+	Event LogEvent `json:"event"`
+}
+
+// Log is a driver's log for one day. StartTime and EndTime bound the day in
+// the driver's home terminal time zone.
+type Log struct {
+	ID           int64              `json:"id"`
+	Date         string             `json:"date"`
+	StartTime    string             `json:"start_time"`
+	EndTime      string             `json:"end_time"`
+	Driver       motivetypes.Ref    `json:"driver"`
+	TotalMiles   float64            `json:"total_miles"`
+	Certified    bool               `json:"certified"`
+	CertifiedAt  string             `json:"certified_at"`
+	ShippingDocs string             `json:"shipping_docs"`
+	Vehicles     []motivetypes.Ref  `json:"vehicles"`
+	Events       []LogEventEnvelope `json:"events"`
+}
+
+type LogEnvelope struct {
+	Log Log `json:"log"`
+}
+
+type LogsResponse struct {
+	Logs       []LogEnvelope          `json:"logs"`
+	Pagination motivetypes.Pagination `json:"pagination"`
+}
+
+// Violation is an HOS violation. Type is a Motive code such as
+// "shift_driving", "shift", "cycle" or "break"; Duration is in seconds.
+type Violation struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	StartTime string          `json:"start_time"`
+	EndTime   string          `json:"end_time"`
+	Duration  int64           `json:"duration"`
+	LogDate   string          `json:"log_date"`
+	User      motivetypes.Ref `json:"user"`
+}
+
+type ViolationEnvelope struct {
+	Violation Violation `json:"hos_violation"`
+}
+
+type ViolationsResponse struct {
+	Violations []ViolationEnvelope    `json:"hos_violations"`
+	Pagination motivetypes.Pagination `json:"pagination"`
+}
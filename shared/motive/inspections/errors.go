@@ -0,0 +1,11 @@
+package inspections
+
+import "errors"
+
+var (
+	ErrPerPageInvalid        = errors.New("inspection report per_page must be between 1 and 100")
+	ErrDateRangeRequired     = errors.New("inspection report start date and end date are required")
+	ErrDateRangeOrderInvalid = errors.New(
+		"inspection report end date must not precede start date",
+	)
+)
@@ -0,0 +1,36 @@
+package inspections
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+)
+
+type ListParams struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	PerPage   int
+	PageNo    int
+}
+
+func (p ListParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	if p.StartDate == nil || p.EndDate == nil {
+		return ErrDateRangeRequired
+	}
+	if p.EndDate.Before(*p.StartDate) {
+		return ErrDateRangeOrderInvalid
+	}
+	return nil
+}
+
+func (p ListParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetDate(values, "start_date", p.StartDate)
+	httpx.SetDate(values, "end_date", p.EndDate)
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
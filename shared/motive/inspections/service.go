@@ -0,0 +1,57 @@
+package inspections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+)
+
+type Service interface {
+	List(ctx context.Context, params ListParams) (ListResponse, error)
+	ListAll(ctx context.Context, params ListParams) ([]Report, error)
+}
+
+type service struct {
+	client httpx.Requester
+}
+
+func NewService(client httpx.Requester) Service {
+	return &service{client: client}
+}
+
+func (s *service) List(ctx context.Context, params ListParams) (ListResponse, error) {
+	if err := params.Validate(); err != nil {
+		return ListResponse{}, err
+	}
+
+	out := ListResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v2/inspection_reports",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return ListResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) ListAll(ctx context.Context, params ListParams) ([]Report, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]Report, motivetypes.Pagination, error) {
+		params.PageNo = pageNo
+		page, err := s.List(ctx, params)
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]Report, 0, len(page.Reports))
+		for i := range page.Reports {
+			items = append(items, page.Reports[i].Report)
+		}
+		return items, page.Pagination, nil
+	})
+}
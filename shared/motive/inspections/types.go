@@ -0,0 +1,49 @@
+package inspections
+
+import motivetypes "github.com/emoss08/trenova/shared/motive/types"
+
+const (
+	TypePreTrip  = "pre_trip"
+	TypePostTrip = "post_trip"
+
+	StatusSafe     = "safe"
+	StatusUnsafe   = "unsafe"
+	StatusResolved = "resolved"
+)
+
+type Defect struct {
+	ID         int64  `json:"id"`
+	Category   string `json:"category"`
+	Notes      string `json:"notes"`
+	Status     string `json:"status"`
+	ResolvedAt string `json:"resolved_at"`
+}
+
+type DefectEnvelope struct {
+	Defect Defect `json:"defect"`
+}
+
+// Report is a driver vehicle inspection report. Odometer is in miles.
+type Report struct {
+	ID             int64            `json:"id"`
+	Date           string           `json:"date"`
+	Time           string           `json:"time"`
+	Type           string           `json:"type"`
+	Status         string           `json:"status"`
+	Location       string           `json:"location"`
+	Odometer       *float64         `json:"odometer"`
+	DriverSignedAt string           `json:"driver_signed_at"`
+	Driver         *motivetypes.Ref `json:"driver"`
+	Vehicle        *motivetypes.Ref `json:"vehicle"`
+	Asset          *motivetypes.Ref `json:"asset"`
+	Defects        []DefectEnvelope `json:"defects"`
+}
+
+type ReportEnvelope struct {
+	Report Report `json:"inspection_report"`
+}
+
+type ListResponse struct {
+	Reports    []ReportEnvelope       `json:"inspection_reports"`
+	Pagination motivetypes.Pagination `json:"pagination"`
+}
@@ -0,0 +1,83 @@
+package httpx
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+)
+
+const (
+	// MaxPerPage is the largest page Motive serves.
+	MaxPerPage = 100
+	dateLayout = "2006-01-02"
+)
+
+func SetInt(values url.Values, key string, value int) {
+	if value <= 0 {
+		return
+	}
+	values.Set(key, strconv.Itoa(value))
+}
+
+func SetString(values url.Values, key, value string) {
+	if value == "" {
+		return
+	}
+	values.Set(key, value)
+}
+
+// SetIDs writes ids in Motive's array form, key[]=1&key[]=2.
+func SetIDs(values url.Values, key string, ids []int64) {
+	for _, id := range ids {
+		values.Add(key+"[]", strconv.FormatInt(id, 10))
+	}
+}
+
+func SetDate(values url.Values, key string, t *time.Time) {
+	if t == nil {
+		return
+	}
+	values.Set(key, t.Format(dateLayout))
+}
+
+func SetTime(values url.Values, key string, t *time.Time) {
+	if t == nil {
+		return
+	}
+	values.Set(key, t.UTC().Format(time.RFC3339))
+}
+
+// SetPage writes the page parameters, defaulting to the largest page from the
+// first page on.
+func SetPage(values url.Values, perPage, pageNo int) {
+	if perPage <= 0 {
+		perPage = MaxPerPage
+	}
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+	values.Set("per_page", strconv.Itoa(perPage))
+	values.Set("page_no", strconv.Itoa(pageNo))
+}
+
+// Collect walks every page fetch returns until the pagination envelope says
+// there are no more.
+func Collect[T any](
+	ctx context.Context,
+	fetch func(ctx context.Context, pageNo int) ([]T, motivetypes.Pagination, error),
+) ([]T, error) {
+	items := make([]T, 0)
+	for pageNo := 1; ; pageNo++ {
+		page, pagination, err := fetch(ctx, pageNo)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) == 0 || !pagination.HasNextPage() {
+			return items, nil
+		}
+	}
+}
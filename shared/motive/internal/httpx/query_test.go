@@ -0,0 +1,58 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryHelpers(t *testing.T) {
+	t.Parallel()
+
+	values := url.Values{}
+	SetIDs(values, "driver_ids", []int64{4, 9})
+	assert.Equal(t, []string{"4", "9"}, values["driver_ids[]"])
+
+	day := time.Date(2026, 10, 1, 23, 0, 0, 0, time.UTC)
+	SetDate(values, "start_date", &day)
+	assert.Equal(t, "2026-10-01", values.Get("start_date"))
+
+	SetPage(values, 0, 0)
+	assert.Equal(t, "100", values.Get("per_page"))
+	assert.Equal(t, "1", values.Get("page_no"))
+}
+
+func TestCollectStopsOnLastPage(t *testing.T) {
+	t.Parallel()
+
+	pages := 0
+	items, err := Collect(t.Context(), func(
+		_ context.Context,
+		pageNo int,
+	) ([]int, motivetypes.Pagination, error) {
+		pages++
+		return []int{pageNo}, motivetypes.Pagination{PerPage: 1, PageNo: pageNo, Total: 3}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, 3, pages)
+}
+
+func TestCollectReturnsPageError(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	_, err := Collect(t.Context(), func(
+		_ context.Context,
+		_ int,
+	) ([]int, motivetypes.Pagination, error) {
+		return nil, motivetypes.Pagination{}, boom
+	})
+	require.ErrorIs(t, err, boom)
+}
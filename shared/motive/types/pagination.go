@@ -0,0 +1,26 @@
+package motivetypes
+
+// Pagination is the page envelope Motive returns with every list. Pages are
+// 1-based and total counts records, not pages.
+type Pagination struct {
+	PerPage int `json:"per_page"`
+	PageNo  int `json:"page_no"`
+	Total   int `json:"total"`
+}
+
+// HasNextPage reports whether another page follows this one.
+func (p Pagination) HasNextPage() bool {
+	if p.PerPage <= 0 || p.PageNo <= 0 {
+		return false
+	}
+	return p.PageNo*p.PerPage < p.Total
+}
+
+// Ref is the abbreviated record Motive nests for related users, vehicles and assets.
+type Ref struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Number    string `json:"number,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
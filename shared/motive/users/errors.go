@@ -0,0 +1,5 @@
+package users
+
+import "errors"
+
+var ErrPerPageInvalid = errors.New("user per_page must be between 1 and 100")
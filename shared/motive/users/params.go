@@ -0,0 +1,49 @@
+package users
+
+import (
+	"net/url"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+)
+
+type ListParams struct {
+	Role    string
+	Status  string
+	PerPage int
+	PageNo  int
+}
+
+func (p ListParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	return nil
+}
+
+func (p ListParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetString(values, "role", p.Role)
+	httpx.SetString(values, "status", p.Status)
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
+
+type AvailableTimeParams struct {
+	DriverIDs []int64
+	PerPage   int
+	PageNo    int
+}
+
+func (p AvailableTimeParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	return nil
+}
+
+func (p AvailableTimeParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetIDs(values, "driver_ids", p.DriverIDs)
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
@@ -0,0 +1,100 @@
+package users
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+)
+
+type Service interface {
+	List(ctx context.Context, params ListParams) (ListResponse, error)
+	ListDrivers(ctx context.Context) ([]User, error)
+	AvailableTime(ctx context.Context, params AvailableTimeParams) (AvailableTimeResponse, error)
+	AvailableTimeAll(ctx context.Context) ([]DriverAvailableTime, error)
+}
+
+type service struct {
+	client httpx.Requester
+}
+
+func NewService(client httpx.Requester) Service {
+	return &service{client: client}
+}
+
+func (s *service) List(ctx context.Context, params ListParams) (ListResponse, error) {
+	if err := params.Validate(); err != nil {
+		return ListResponse{}, err
+	}
+
+	out := ListResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/users",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return ListResponse{}, err
+	}
+	return out, nil
+}
+
+// ListDrivers returns every active driver.
+func (s *service) ListDrivers(ctx context.Context) ([]User, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]User, motivetypes.Pagination, error) {
+		page, err := s.List(ctx, ListParams{
+			Role:   RoleDriver,
+			Status: StatusActive,
+			PageNo: pageNo,
+		})
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]User, 0, len(page.Users))
+		for i := range page.Users {
+			items = append(items, page.Users[i].User)
+		}
+		return items, page.Pagination, nil
+	})
+}
+
+func (s *service) AvailableTime(
+	ctx context.Context,
+	params AvailableTimeParams,
+) (AvailableTimeResponse, error) {
+	if err := params.Validate(); err != nil {
+		return AvailableTimeResponse{}, err
+	}
+
+	out := AvailableTimeResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/available_time",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return AvailableTimeResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) AvailableTimeAll(ctx context.Context) ([]DriverAvailableTime, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]DriverAvailableTime, motivetypes.Pagination, error) {
+		page, err := s.AvailableTime(ctx, AvailableTimeParams{PageNo: pageNo})
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]DriverAvailableTime, 0, len(page.Users))
+		for i := range page.Users {
+			items = append(items, page.Users[i].User)
+		}
+		return items, page.Pagination, nil
+	})
+}
@@ -0,0 +1,79 @@
+package users
+
+import motivetypes "github.com/emoss08/trenova/shared/motive/types"
+
+const (
+	RoleDriver = "driver"
+
+	StatusActive = "active"
+)
+
+// User is a Motive user. Drivers carry their ELD settings: cycle is a rule
+// code such as "70_8" or "canada_south_70_7".
+type User struct {
+	ID                     int64            `json:"id"`
+	FirstName              string           `json:"first_name"`
+	LastName               string           `json:"last_name"`
+	Email                  string           `json:"email"`
+	Phone                  string           `json:"phone"`
+	Username               string           `json:"username"`
+	Role                   string           `json:"role"`
+	Status                 string           `json:"status"`
+	DutyStatus             string           `json:"duty_status"`
+	DriverCompanyID        string           `json:"driver_company_id"`
+	TimeZone               string           `json:"time_zone"`
+	EldMode                string           `json:"eld_mode"`
+	Cycle                  string           `json:"cycle"`
+	Exception24HourRestart bool             `json:"exception_24_hour_restart"`
+	Exception8HourBreak    bool             `json:"exception_8_hour_break"`
+	ExceptionShortHaul     bool             `json:"exception_short_haul"`
+	CurrentVehicle         *motivetypes.Ref `json:"current_vehicle"`
+}
+
+type UserEnvelope struct {
+	User User `json:"user"`
+}
+
+type ListResponse struct {
+	Users      []UserEnvelope         `json:"users"`
+	Pagination motivetypes.Pagination `json:"pagination"`
+}
+
+// AvailableTimeClocks are the seconds a driver has left on each HOS clock.
+type AvailableTimeClocks struct {
+	Break int64 `json:"break"`
+	Drive int64 `json:"drive"`
+	Shift int64 `json:"shift"`
+	Cycle int64 `json:"cycle"`
+}
+
+type Recap struct {
+	OnDutyDuration int64 `json:"on_duty_duration"`
+	CycleTomorrow  int64 `json:"cycle_tomorrow"`
+}
+
+type CycleReset struct {
+	Type      string `json:"type"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+type DriverAvailableTime struct {
+	ID             int64               `json:"id"`
+	FirstName      string              `json:"first_name"`
+	LastName       string              `json:"last_name"`
+	DutyStatus     string              `json:"duty_status"`
+	CurrentVehicle *motivetypes.Ref    `json:"current_vehicle"`
+	AvailableTime  AvailableTimeClocks `json:"available_time"`
+	Recap          *Recap              `json:"recap"`
+	LastCycleReset *CycleReset         `json:"last_cycle_reset"`
+}
+
+type AvailableTimeEnvelope struct {
+	User DriverAvailableTime `json:"user"`
+}
+
+type AvailableTimeResponse struct {
+	Users      []AvailableTimeEnvelope `json:"users"`
+	Pagination motivetypes.Pagination  `json:"pagination"`
+}
@@ -0,0 +1,19 @@
+package motive
+
+import "errors"
+
+var (
+	ErrAPIKeyRequired           = errors.New("motive api key is required")
+	ErrRetryMaxAttemptsTooHigh  = errors.New("motive retry maxAttempts must be <= 10")
+	ErrRetryBackoffOrderInvalid = errors.New(
+		"motive retry initialBackoff must be <= maxBackoff",
+	)
+	ErrBaseURLOverrideEmpty           = errors.New("motive base URL override cannot be empty")
+	ErrRetryMaxAttemptsInvalid        = errors.New("motive retry maxAttempts must be > 0")
+	ErrTimeoutInvalid                 = errors.New("motive timeout must be > 0")
+	ErrRetryInitialBackoffInvalid     = errors.New("motive retry initialBackoff must be > 0")
+	ErrRetryMaxBackoffInvalid         = errors.New("motive retry maxBackoff must be > 0")
+	ErrCustomHTTPClientTransportEmpty = errors.New(
+		"motive custom http client must have a transport configured",
+	)
+)
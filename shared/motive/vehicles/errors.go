@@ -0,0 +1,5 @@
+package vehicles
+
+import "errors"
+
+var ErrPerPageInvalid = errors.New("vehicle per_page must be between 1 and 100")
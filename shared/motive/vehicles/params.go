@@ -0,0 +1,25 @@
+package vehicles
+
+import (
+	"net/url"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+)
+
+type ListParams struct {
+	PerPage int
+	PageNo  int
+}
+
+func (p ListParams) Validate() error {
+	if p.PerPage < 0 || p.PerPage > httpx.MaxPerPage {
+		return ErrPerPageInvalid
+	}
+	return nil
+}
+
+func (p ListParams) Query() url.Values {
+	values := url.Values{}
+	httpx.SetPage(values, p.PerPage, p.PageNo)
+	return values
+}
@@ -0,0 +1,92 @@
+package vehicles
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/emoss08/trenova/shared/motive/internal/httpx"
+	motivetypes "github.com/emoss08/trenova/shared/motive/types"
+)
+
+type Service interface {
+	List(ctx context.Context, params ListParams) (ListResponse, error)
+	ListAll(ctx context.Context) ([]Vehicle, error)
+	Locations(ctx context.Context, params ListParams) (LocationsResponse, error)
+	LocationsAll(ctx context.Context) ([]VehicleLocation, error)
+}
+
+type service struct {
+	client httpx.Requester
+}
+
+func NewService(client httpx.Requester) Service {
+	return &service{client: client}
+}
+
+func (s *service) List(ctx context.Context, params ListParams) (ListResponse, error) {
+	if err := params.Validate(); err != nil {
+		return ListResponse{}, err
+	}
+
+	out := ListResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/vehicles",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return ListResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) ListAll(ctx context.Context) ([]Vehicle, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]Vehicle, motivetypes.Pagination, error) {
+		page, err := s.List(ctx, ListParams{PageNo: pageNo})
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]Vehicle, 0, len(page.Vehicles))
+		for i := range page.Vehicles {
+			items = append(items, page.Vehicles[i].Vehicle)
+		}
+		return items, page.Pagination, nil
+	})
+}
+
+func (s *service) Locations(ctx context.Context, params ListParams) (LocationsResponse, error) {
+	if err := params.Validate(); err != nil {
+		return LocationsResponse{}, err
+	}
+
+	out := LocationsResponse{}
+	if err := s.client.Do(ctx, httpx.Request{
+		Method: http.MethodGet,
+		Path:   "/v1/vehicle_locations",
+		Query:  params.Query(),
+		Out:    &out,
+	}); err != nil {
+		return LocationsResponse{}, err
+	}
+	return out, nil
+}
+
+func (s *service) LocationsAll(ctx context.Context) ([]VehicleLocation, error) {
+	return httpx.Collect(ctx, func(
+		ctx context.Context,
+		pageNo int,
+	) ([]VehicleLocation, motivetypes.Pagination, error) {
+		page, err := s.Locations(ctx, ListParams{PageNo: pageNo})
+		if err != nil {
+			return nil, motivetypes.Pagination{}, err
+		}
+		items := make([]VehicleLocation, 0, len(page.Vehicles))
+		for i := range page.Vehicles {
+			items = append(items, page.Vehicles[i].Vehicle)
+		}
+		return items, page.Pagination, nil
+	})
+}
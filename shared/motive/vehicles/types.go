@@ -0,0 +1,59 @@
+package vehicles
+
+import motivetypes "github.com/emoss08/trenova/shared/motive/types"
+
+type Vehicle struct {
+	ID                 int64            `json:"id"`
+	CompanyID          int64            `json:"company_id"`
+	Number             string           `json:"number"`
+	Status             string           `json:"status"`
+	Make               string           `json:"make"`
+	Model              string           `json:"model"`
+	Year               string           `json:"year"`
+	VIN                string           `json:"vin"`
+	LicensePlateState  string           `json:"license_plate_state"`
+	LicensePlateNumber string           `json:"license_plate_number"`
+	CurrentDriver      *motivetypes.Ref `json:"current_driver"`
+}
+
+type VehicleEnvelope struct {
+	Vehicle Vehicle `json:"vehicle"`
+}
+
+type ListResponse struct {
+	Vehicles   []VehicleEnvelope      `json:"vehicles"`
+	Pagination motivetypes.Pagination `json:"pagination"`
+}
+
+// Location is a vehicle's last reported fix. Speed is in miles per hour and
+// odometer in miles.
+type Location struct {
+	ID                             string   `json:"id"`
+	Lat                            float64  `json:"lat"`
+	Lon                            float64  `json:"lon"`
+	Bearing                        float64  `json:"bearing"`
+	LocatedAt                      string   `json:"located_at"`
+	Description                    string   `json:"description"`
+	Speed                          float64  `json:"speed"`
+	Odometer                       *float64 `json:"odometer"`
+	FuelPrimaryRemainingPercentage *float64 `json:"fuel_primary_remaining_percentage"`
+	EngineHours                    *float64 `json:"engine_hours"`
+	Type                           string   `json:"type"`
+}
+
+type VehicleLocation struct {
+	ID              int64            `json:"id"`
+	Number          string           `json:"number"`
+	VIN             string           `json:"vin"`
+	CurrentLocation *Location        `json:"current_location"`
+	CurrentDriver   *motivetypes.Ref `json:"current_driver"`
+}
+
+type VehicleLocationEnvelope struct {
+	Vehicle VehicleLocation `json:"vehicle"`
+}
+
+type LocationsResponse struct {
+	Vehicles   []VehicleLocationEnvelope `json:"vehicles"`
+	Pagination motivetypes.Pagination    `json:"pagination"`
+}
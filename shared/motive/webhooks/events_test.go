@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGeofenceEvent(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{
+		"action": "vehicle_geofence_event",
+		"id": 9001,
+		"event_type": "enter",
+		"geofence_id": 55,
+		"geofence_name": "Acme DC",
+		"geofence_external_id": "loc_01",
+		"vehicle_id": 12,
+		"vin": "1FUJ",
+		"driver_id": 34,
+		"start_time": "2026-10-01T14:00:00Z"
+	}`)
+
+	event, err := ParseEvent(body)
+	require.NoError(t, err)
+	assert.Equal(t, ActionVehicleGeofenceEvent, event.Action)
+	assert.Equal(t, GeofenceEventEnter, event.EventType)
+	assert.Equal(t, int64(12), event.SubjectVehicleID())
+	assert.Equal(t, int64(34), event.SubjectDriverID())
+	assert.Equal(t, time.Date(2026, 10, 1, 14, 0, 0, 0, time.UTC), event.OccurredAt().UTC())
+	assert.Equal(t, body, event.Raw())
+}
+
+func TestEventIDStableAcrossRetries(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"action":"user_duty_status_updated","id":34,"duty_status":"driving",` +
+		`"updated_at":"2026-10-01T14:00:00Z"}`)
+	first, err := ParseEvent(body)
+	require.NoError(t, err)
+	retry, err := ParseEvent(body)
+	require.NoError(t, err)
+	assert.Equal(t, first.EventID(), retry.EventID())
+	assert.Equal(t, int64(34), first.SubjectDriverID())
+
+	next, err := ParseEvent([]byte(`{"action":"user_duty_status_updated","id":34,` +
+		`"duty_status":"on_duty","updated_at":"2026-10-01T15:00:00Z"}`))
+	require.NoError(t, err)
+	assert.NotEqual(t, first.EventID(), next.EventID())
+}
+
+func TestParseEventInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseEvent([]byte(`{"id":1}`))
+	require.ErrorIs(t, err, ErrEventInvalid)
+
+	_, err = ParseEvent([]byte(`not json`))
+	require.ErrorIs(t, err, ErrEventInvalid)
+}
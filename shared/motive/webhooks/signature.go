@@ -0,0 +1,55 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // Motive signs webhooks with HMAC-SHA1.
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// HeaderSignature carries the hex HMAC-SHA1 of the raw body, keyed by the
+// webhook's shared secret. Motive sends no timestamp, so replays are caught by
+// event deduplication rather than by clock skew.
+const HeaderSignature = "X-KT-Webhook-Signature"
+
+var (
+	ErrSignatureSecretRequired = errors.New("webhook signature secret is required")
+	ErrSignatureInvalid        = errors.New("webhook signature does not match")
+	ErrSignatureFormatInvalid  = errors.New("webhook signature header format is invalid")
+)
+
+func Sign(secret string, body []byte) (string, error) {
+	digest, err := computeDigest(secret, body)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(digest), nil
+}
+
+func VerifySignature(secret string, body []byte, signatureHeader string) error {
+	provided, err := hex.DecodeString(strings.TrimSpace(signatureHeader))
+	if err != nil || len(provided) == 0 {
+		return ErrSignatureFormatInvalid
+	}
+
+	expected, err := computeDigest(secret, body)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal(expected, provided) {
+		return ErrSignatureInvalid
+	}
+	return nil
+}
+
+func computeDigest(secret string, body []byte) ([]byte, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, ErrSignatureSecretRequired
+	}
+
+	mac := hmac.New(sha1.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return mac.Sum(nil), nil
+}
@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"action":"vehicle_geofence_event","id":1}`)
+	signature, err := Sign("secret", body)
+	require.NoError(t, err)
+	require.Len(t, signature, 40)
+
+	require.NoError(t, VerifySignature("secret", body, signature))
+}
+
+func TestVerifySignatureRejects(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"action":"user_upserted","id":7}`)
+	signature, err := Sign("secret", body)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		want      error
+	}{
+		{
+			"tampered body",
+			"secret",
+			[]byte(`{"action":"user_upserted","id":8}`),
+			signature,
+			ErrSignatureInvalid,
+		},
+		{"wrong secret", "other", body, signature, ErrSignatureInvalid},
+		{"missing secret", " ", body, signature, ErrSignatureSecretRequired},
+		{"not hex", "secret", body, "sha1=zz", ErrSignatureFormatInvalid},
+		{"empty header", "secret", body, "", ErrSignatureFormatInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, VerifySignature(tt.secret, tt.body, tt.signature), tt.want)
+		})
+	}
+}